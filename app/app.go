@@ -131,6 +131,9 @@ import (
 	delaytypes "github.com/CoreumFoundation/coreum/v3/x/delay/types"
 	"github.com/CoreumFoundation/coreum/v3/x/deterministicgas"
 	deterministicgastypes "github.com/CoreumFoundation/coreum/v3/x/deterministicgas/types"
+	"github.com/CoreumFoundation/coreum/v3/x/dex"
+	dexkeeper "github.com/CoreumFoundation/coreum/v3/x/dex/keeper"
+	dextypes "github.com/CoreumFoundation/coreum/v3/x/dex/types"
 	"github.com/CoreumFoundation/coreum/v3/x/feemodel"
 	feemodelkeeper "github.com/CoreumFoundation/coreum/v3/x/feemodel/keeper"
 	feemodeltypes "github.com/CoreumFoundation/coreum/v3/x/feemodel/types"
@@ -201,6 +204,7 @@ var (
 		assetnft.AppModuleBasic{},
 		customparams.AppModuleBasic{},
 		delay.AppModuleBasic{},
+		dex.AppModuleBasic{},
 	)
 
 	// module account permissions.
@@ -275,6 +279,7 @@ type App struct {
 	NFTKeeper          wnftkeeper.Wrapper
 	CustomParamsKeeper customparamskeeper.Keeper
 	DelayKeeper        delaykeeper.Keeper
+	DEXKeeper          dexkeeper.Keeper
 
 	// make scoped keepers public for test purposes
 	ScopedIBCKeeper      capabilitykeeper.ScopedKeeper
@@ -323,7 +328,7 @@ func New(
 		distrtypes.StoreKey, slashingtypes.StoreKey, govtypes.StoreKey, paramstypes.StoreKey, upgradetypes.StoreKey,
 		feegrant.StoreKey, evidencetypes.StoreKey, capabilitytypes.StoreKey, consensusparamtypes.StoreKey, wasmtypes.StoreKey, feemodeltypes.StoreKey,
 		assetfttypes.StoreKey, assetnfttypes.StoreKey, nftkeeper.StoreKey, ibcexported.StoreKey, ibctransfertypes.StoreKey,
-		delaytypes.StoreKey, customparamstypes.StoreKey,
+		delaytypes.StoreKey, customparamstypes.StoreKey, dextypes.StoreKey,
 	)
 	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey, feemodeltypes.TransientStoreKey)
 	memKeys := sdk.NewMemoryStoreKeys(capabilitytypes.MemStoreKey)
@@ -381,7 +386,13 @@ func New(
 	)
 
 	delayRouter := delaytypes.NewRouter()
-	app.DelayKeeper = delaykeeper.NewKeeper(appCodec, keys[delaytypes.StoreKey], delayRouter, app.interfaceRegistry)
+	app.DelayKeeper = delaykeeper.NewKeeper(
+		appCodec,
+		keys[delaytypes.StoreKey],
+		delayRouter,
+		app.interfaceRegistry,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
 
 	originalBankKeeper := bankkeeper.NewBaseKeeper(
 		appCodec,
@@ -405,6 +416,18 @@ func New(
 	if err != nil {
 		panic(err)
 	}
+	err = delayRouter.RegisterHandler(&assetfttypes.DelayedTokenUpgrade{}, assetfttypes.NewTokenUpgradeHandler(app.AssetFTKeeper))
+	if err != nil {
+		panic(err)
+	}
+	err = delayRouter.RegisterHandler(&assetfttypes.DelayedRatesUpdate{}, assetfttypes.NewRatesUpdateHandler(app.AssetFTKeeper))
+	if err != nil {
+		panic(err)
+	}
+	err = delayRouter.RegisterHandler(&assetfttypes.DelayedUnfreeze{}, assetfttypes.NewUnfreezeHandler(app.AssetFTKeeper))
+	if err != nil {
+		panic(err)
+	}
 
 	app.BankKeeper = wbankkeeper.NewKeeper(
 		appCodec, keys[banktypes.StoreKey], app.AccountKeeper, app.ModuleAccountAddrs(), app.AssetFTKeeper, authtypes.NewModuleAddress(govtypes.ModuleName).String(),
@@ -500,12 +523,20 @@ func New(
 		appCodec,
 		keys[assetnfttypes.StoreKey],
 		nftKeeper,
-		// for the assetnft we use the clear bank keeper without the assets integration because it interacts only with native token.
-		originalBankKeeper,
+		// the wrapped bank keeper is used to apply the asset rules on the marketplace payments.
+		app.BankKeeper,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 	app.NFTKeeper = wnftkeeper.NewWrappedNFTKeeper(nftKeeper, app.AssetNFTKeeper)
 
+	app.DEXKeeper = dexkeeper.NewKeeper(
+		appCodec,
+		keys[dextypes.StoreKey],
+		// the wrapped bank keeper is used to apply the asset rules on the executed orders.
+		app.BankKeeper,
+		app.AssetFTKeeper,
+	)
+
 	// Create Transfer Keepers
 	app.TransferKeeper = wibctransferkeeper.NewTransferKeeperWrapper(
 		appCodec, keys[ibctransfertypes.StoreKey], app.GetSubspace(ibctransfertypes.ModuleName),
@@ -638,6 +669,7 @@ func New(
 	wstakingModule := wstaking.NewAppModule(appCodec, app.StakingKeeper, app.AccountKeeper, app.BankKeeper, app.GetSubspace(stakingtypes.ModuleName), app.CustomParamsKeeper)
 
 	delayModule := delay.NewAppModule(app.DelayKeeper)
+	dexModule := dex.NewAppModule(appCodec, app.DEXKeeper)
 	// NOTE: Any module instantiated in the module manager that is later modified
 	// must be passed by reference here.
 
@@ -668,6 +700,7 @@ func New(
 		wnftModule,
 		customParamsModule,
 		delayModule,
+		dexModule,
 		crisis.NewAppModule(app.CrisisKeeper, skipGenesisInvariants, app.GetSubspace(crisistypes.ModuleName)), // always be last to make sure that it checks for all invariants and not only part of them
 	)
 
@@ -702,6 +735,7 @@ func New(
 		assetnfttypes.ModuleName,
 		nft.ModuleName,
 		delaytypes.ModuleName,
+		dextypes.ModuleName,
 	)
 
 	app.ModuleManager.SetOrderEndBlockers(
@@ -731,6 +765,7 @@ func New(
 		assetnfttypes.ModuleName,
 		nft.ModuleName,
 		delaytypes.ModuleName,
+		dextypes.ModuleName,
 	)
 
 	// NOTE: The genutils module must occur after staking so that pools are
@@ -766,6 +801,7 @@ func New(
 		assetfttypes.ModuleName,
 		assetnfttypes.ModuleName,
 		delaytypes.ModuleName,
+		dextypes.ModuleName,
 	}
 
 	app.ModuleManager.SetOrderInitGenesis(genesisModuleOrder...)
@@ -36,6 +36,7 @@ import (
 	assetfttypes "github.com/CoreumFoundation/coreum/v3/x/asset/ft/types"
 	assetnfttypes "github.com/CoreumFoundation/coreum/v3/x/asset/nft/types"
 	customparamstypes "github.com/CoreumFoundation/coreum/v3/x/customparams/types"
+	dextypes "github.com/CoreumFoundation/coreum/v3/x/dex/types"
 	feemodeltypes "github.com/CoreumFoundation/coreum/v3/x/feemodel/types"
 	cnftkeeper "github.com/CoreumFoundation/coreum/v3/x/nft/keeper"
 )
@@ -71,6 +72,7 @@ func New(
 				// https://github.com/cosmos/cosmos-sdk/blob/release/v0.47.x/UPGRADING.md#xconsensus
 				consensustypes.StoreKey,
 				customparamstypes.StoreKey,
+				dextypes.StoreKey,
 			},
 			Renamed: []storetypes.StoreRename{
 				{
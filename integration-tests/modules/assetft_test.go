@@ -290,6 +290,7 @@ func TestAssetIssueAndQueryTokens(t *testing.T) {
 		BurnRate:           msg1.BurnRate,
 		SendCommissionRate: msg1.SendCommissionRate,
 		Version:            gotToken.Tokens[0].Version, // test should work with all versions
+		Admin:              issuer1.String(),
 	}, gotToken.Tokens[0])
 }
 
@@ -534,7 +535,7 @@ func TestAssetFTMint(t *testing.T) {
 		Sender: issuer.String(),
 		Coin:   mintCoin,
 	}
-	_, err = client.BroadcastTx(
+	res, err = client.BroadcastTx(
 		ctx,
 		chain.ClientContext.WithFromAddress(issuer),
 		chain.TxFactory().WithGas(chain.GasLimitByMsgs(mintMsg)),
@@ -542,6 +543,14 @@ func TestAssetFTMint(t *testing.T) {
 	)
 	requireT.NoError(err)
 
+	mintedEvts, err := event.FindTypedEvents[*assetfttypes.EventMinted](res.Events)
+	requireT.NoError(err)
+	assertT.EqualValues([]*assetfttypes.EventMinted{{
+		Denom:     mintableDenom,
+		Recipient: issuer.String(),
+		Amount:    mintCoin.Amount,
+	}}, mintedEvts)
+
 	balance, err := bankClient.Balance(ctx, &banktypes.QueryBalanceRequest{Address: issuer.String(), Denom: mintableDenom})
 	requireT.NoError(err)
 	assertT.EqualValues(mintCoin.Add(sdk.NewCoin(mintableDenom, sdkmath.NewInt(1000))).String(), balance.GetBalance().String())
@@ -557,7 +566,7 @@ func TestAssetFTMint(t *testing.T) {
 		Recipient: recipient.String(),
 		Coin:      mintCoin,
 	}
-	_, err = client.BroadcastTx(
+	res, err = client.BroadcastTx(
 		ctx,
 		chain.ClientContext.WithFromAddress(issuer),
 		chain.TxFactory().WithGas(chain.GasLimitByMsgs(mintMsg)),
@@ -565,6 +574,14 @@ func TestAssetFTMint(t *testing.T) {
 	)
 	requireT.NoError(err)
 
+	mintedEvts, err = event.FindTypedEvents[*assetfttypes.EventMinted](res.Events)
+	requireT.NoError(err)
+	assertT.EqualValues([]*assetfttypes.EventMinted{{
+		Denom:     mintableDenom,
+		Recipient: recipient.String(),
+		Amount:    mintCoin.Amount,
+	}}, mintedEvts)
+
 	balance, err = bankClient.Balance(ctx, &banktypes.QueryBalanceRequest{Address: recipient.String(), Denom: mintableDenom})
 	requireT.NoError(err)
 	assertT.EqualValues(mintCoin.String(), balance.GetBalance().String())
@@ -740,7 +757,7 @@ func TestAssetFTBurn(t *testing.T) {
 		Sender: issuer.String(),
 		Coin:   burnCoin,
 	}
-	_, err = client.BroadcastTx(
+	res, err = client.BroadcastTx(
 		ctx,
 		chain.ClientContext.WithFromAddress(issuer),
 		chain.TxFactory().WithGas(chain.GasLimitByMsgs(burnMsg)),
@@ -748,6 +765,14 @@ func TestAssetFTBurn(t *testing.T) {
 	)
 	requireT.NoError(err)
 
+	burntEvts, err := event.FindTypedEvents[*assetfttypes.EventBurnt](res.Events)
+	requireT.NoError(err)
+	assertT.EqualValues([]*assetfttypes.EventBurnt{{
+		Denom:   burnableDenom,
+		Account: issuer.String(),
+		Amount:  burnCoin.Amount,
+	}}, burntEvts)
+
 	balance, err := bankClient.Balance(ctx, &banktypes.QueryBalanceRequest{Address: issuer.String(), Denom: burnableDenom})
 	requireT.NoError(err)
 	assertT.EqualValues(sdk.NewCoin(burnableDenom, sdkmath.NewInt(300)).String(), balance.GetBalance().String())
@@ -839,7 +864,7 @@ func TestAssetFTBurnRate(t *testing.T) {
 		Amount:      sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(100))),
 	}
 
-	_, err = client.BroadcastTx(
+	res, err = client.BroadcastTx(
 		ctx,
 		chain.ClientContext.WithFromAddress(recipient1),
 		chain.TxFactory().WithGas(chain.GasLimitByMsgs(sendMsg)),
@@ -851,6 +876,14 @@ func TestAssetFTBurnRate(t *testing.T) {
 		&recipient1: 290,
 		&recipient2: 100,
 	})
+	rateAppliedEvts, err := event.FindTypedEvents[*assetfttypes.EventRateApplied](res.Events)
+	requireT.NoError(err)
+	requireT.EqualValues([]*assetfttypes.EventRateApplied{{
+		Denom:            denom,
+		Sender:           recipient1.String(),
+		BurnAmount:       sdkmath.NewInt(10),
+		CommissionAmount: sdkmath.ZeroInt(),
+	}}, rateAppliedEvts)
 
 	// send from recipient2 to issuer (burn must not apply)
 	sendMsg = &banktypes.MsgSend{
@@ -986,7 +1019,7 @@ func TestAssetFTSendCommissionRate(t *testing.T) {
 		Amount:      sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(100))),
 	}
 
-	_, err = client.BroadcastTx(
+	res, err = client.BroadcastTx(
 		ctx,
 		chain.ClientContext.WithFromAddress(recipient1),
 		chain.TxFactory().WithGas(chain.GasLimitByMsgs(sendMsg)),
@@ -998,6 +1031,14 @@ func TestAssetFTSendCommissionRate(t *testing.T) {
 		&recipient1: 290,
 		&recipient2: 100,
 	})
+	rateAppliedEvts, err := event.FindTypedEvents[*assetfttypes.EventRateApplied](res.Events)
+	requireT.NoError(err)
+	requireT.EqualValues([]*assetfttypes.EventRateApplied{{
+		Denom:            denom,
+		Sender:           recipient1.String(),
+		BurnAmount:       sdkmath.ZeroInt(),
+		CommissionAmount: sdkmath.NewInt(10),
+	}}, rateAppliedEvts)
 
 	// send from recipient2 to issuer (send commission rate must not apply)
 	sendMsg = &banktypes.MsgSend{
@@ -1543,13 +1584,16 @@ func TestAssetFTGloballyFreeze(t *testing.T) {
 		Sender: issuer.String(),
 		Denom:  denom,
 	}
-	_, err = client.BroadcastTx(
+	res, err = client.BroadcastTx(
 		ctx,
 		chain.ClientContext.WithFromAddress(issuer),
 		chain.TxFactory().WithGas(chain.GasLimitByMsgs(globFreezeMsg)),
 		globFreezeMsg,
 	)
 	requireT.NoError(err)
+	globallyFrozenEvts, err := event.FindTypedEvents[*assetfttypes.EventGloballyFrozen](res.Events)
+	requireT.NoError(err)
+	requireT.EqualValues([]*assetfttypes.EventGloballyFrozen{{Denom: denom}}, globallyFrozenEvts)
 
 	// Try to send Token.
 	coinsToSend := sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(50)))
@@ -1612,13 +1656,16 @@ func TestAssetFTGloballyFreeze(t *testing.T) {
 		Sender: issuer.String(),
 		Denom:  denom,
 	}
-	_, err = client.BroadcastTx(
+	res, err = client.BroadcastTx(
 		ctx,
 		chain.ClientContext.WithFromAddress(issuer),
 		chain.TxFactory().WithGas(chain.GasLimitByMsgs(globUnfreezeMsg)),
 		globUnfreezeMsg,
 	)
 	requireT.NoError(err)
+	globallyUnfrozenEvts, err := event.FindTypedEvents[*assetfttypes.EventGloballyUnfrozen](res.Events)
+	requireT.NoError(err)
+	requireT.EqualValues([]*assetfttypes.EventGloballyUnfrozen{{Denom: denom}}, globallyUnfrozenEvts)
 
 	// Try to send Token from issuer.
 	sendMsg = &banktypes.MsgSend{
@@ -0,0 +1,52 @@
+package store
+
+import (
+	"bytes"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// RangeStore limits the iteration over the parent store to the keys in the [start, end) range, so the pagination
+// doesn't iterate over the keys outside of it.
+type RangeStore struct {
+	storetypes.KVStore
+
+	start, end []byte
+}
+
+// NewRangeStore returns the store iterating over the keys of the parent store in the [start, end) range.
+// The nil start or end means that the range is not limited on that side.
+func NewRangeStore(parent storetypes.KVStore, start, end []byte) RangeStore {
+	return RangeStore{
+		KVStore: parent,
+		start:   start,
+		end:     end,
+	}
+}
+
+// Iterator returns the iterator over the keys in the intersection of the requested range and the range of the store.
+func (s RangeStore) Iterator(start, end []byte) storetypes.Iterator {
+	start, end = s.bounds(start, end)
+	return s.KVStore.Iterator(start, end)
+}
+
+// ReverseIterator returns the reverse iterator over the keys in the intersection of the requested range and the range
+// of the store.
+func (s RangeStore) ReverseIterator(start, end []byte) storetypes.Iterator {
+	start, end = s.bounds(start, end)
+	return s.KVStore.ReverseIterator(start, end)
+}
+
+func (s RangeStore) bounds(start, end []byte) ([]byte, []byte) {
+	if start == nil || (s.start != nil && bytes.Compare(start, s.start) < 0) {
+		start = s.start
+	}
+	if end == nil || (s.end != nil && bytes.Compare(end, s.end) > 0) {
+		end = s.end
+	}
+	// the empty range is returned if the requested range doesn't intersect with the range of the store
+	if start != nil && end != nil && bytes.Compare(start, end) > 0 {
+		end = start
+	}
+	return start, end
+}
@@ -0,0 +1,82 @@
+package store
+
+import (
+	"testing"
+
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRangeStore(t *testing.T) {
+	parent := dbadapter.Store{DB: dbm.NewMemDB()}
+	for _, key := range []string{"a", "b", "c", "d", "e"} {
+		parent.Set([]byte(key), []byte(key))
+	}
+
+	collect := func(iterator storetypes.Iterator) string {
+		defer iterator.Close()
+		var keys string
+		for ; iterator.Valid(); iterator.Next() {
+			keys += string(iterator.Key())
+		}
+		return keys
+	}
+
+	testCases := []struct {
+		name            string
+		storeStart      []byte
+		storeEnd        []byte
+		start           []byte
+		end             []byte
+		expectedKeys    string
+		expectedReverse string
+	}{
+		{
+			name:            "unlimited",
+			expectedKeys:    "abcde",
+			expectedReverse: "edcba",
+		},
+		{
+			name:            "store range",
+			storeStart:      []byte("b"),
+			storeEnd:        []byte("d"),
+			expectedKeys:    "bc",
+			expectedReverse: "cb",
+		},
+		{
+			name:            "requested range inside",
+			storeStart:      []byte("a"),
+			storeEnd:        []byte("e"),
+			start:           []byte("b"),
+			end:             []byte("d"),
+			expectedKeys:    "bc",
+			expectedReverse: "cb",
+		},
+		{
+			name:            "requested range overlapping",
+			storeStart:      []byte("b"),
+			storeEnd:        []byte("d"),
+			start:           []byte("c"),
+			end:             []byte("e"),
+			expectedKeys:    "c",
+			expectedReverse: "c",
+		},
+		{
+			name:       "requested range outside",
+			storeStart: []byte("b"),
+			storeEnd:   []byte("c"),
+			start:      []byte("d"),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			rangeStore := NewRangeStore(parent, tc.storeStart, tc.storeEnd)
+			require.Equal(t, tc.expectedKeys, collect(rangeStore.Iterator(tc.start, tc.end)))
+			require.Equal(t, tc.expectedReverse, collect(rangeStore.ReverseIterator(tc.start, tc.end)))
+		})
+	}
+}
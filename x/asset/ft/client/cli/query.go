@@ -26,6 +26,15 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(CmdQueryToken())
 	cmd.AddCommand(CmdQueryTokens())
 	cmd.AddCommand(CmdTokenUpgradeStatuses())
+	cmd.AddCommand(CmdQueryRateExemptions())
+	cmd.AddCommand(CmdQueryBlockedAccounts())
+	cmd.AddCommand(CmdQueryBlockedAccount())
+	cmd.AddCommand(CmdQueryDistribution())
+	cmd.AddCommand(CmdQueryDistributionClaimable())
+	cmd.AddCommand(CmdQueryHolders())
+	cmd.AddCommand(CmdQueryTransferLimits())
+	cmd.AddCommand(CmdQueryTransferLimit())
+	cmd.AddCommand(CmdQueryVestingSchedules())
 	cmd.AddCommand(CmdQueryBalance())
 	cmd.AddCommand(CmdQueryFrozenBalance())
 	cmd.AddCommand(CmdQueryFrozenBalances())
@@ -45,7 +54,7 @@ func CmdQueryTokens() *cobra.Command {
 		Args:  cobra.ExactArgs(1),
 		Short: "Query fungible tokens",
 		Long: strings.TrimSpace(
-			fmt.Sprintf(`Query fungible tokens.
+			fmt.Sprintf(`Query fungible tokens administered by the account.
 
 Example:
 $ %[1]s query %s tokens [issuer]
@@ -153,6 +162,364 @@ $ %[1]s query %s token-upgrade-statuses [denom]
 	return cmd
 }
 
+// CmdQueryRateExemptions returns the QueryRateExemptions cobra command.
+func CmdQueryRateExemptions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rate-exemptions [denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query accounts exempted from the rates of fungible token",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query accounts exempted from the burn rate and send commission rate of fungible token.
+
+Example:
+$ %[1]s query %s rate-exemptions [denom]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			denom := args[0]
+			res, err := queryClient.RateExemptions(cmd.Context(), &types.QueryRateExemptionsRequest{
+				Pagination: pageReq,
+				Denom:      denom,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "rate-exemptions")
+
+	return cmd
+}
+
+// CmdQueryBlockedAccounts returns the QueryBlockedAccounts cobra command.
+func CmdQueryBlockedAccounts() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "blocked-accounts [denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query accounts blocked from sending and receiving fungible token",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query accounts blocked from sending and receiving fungible token.
+
+Example:
+$ %[1]s query %s blocked-accounts [denom]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			denom := args[0]
+			res, err := queryClient.BlockedAccounts(cmd.Context(), &types.QueryBlockedAccountsRequest{
+				Pagination: pageReq,
+				Denom:      denom,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "blocked-accounts")
+
+	return cmd
+}
+
+// CmdQueryBlockedAccount returns the QueryBlockedAccount cobra command.
+func CmdQueryBlockedAccount() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "blocked-account [denom] [account]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query whether the account is blocked from sending and receiving fungible token",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query whether the account is blocked from sending and receiving fungible token.
+
+Example:
+$ %[1]s query %s blocked-account [denom] [account]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.BlockedAccount(cmd.Context(), &types.QueryBlockedAccountRequest{
+				Denom:   args[0],
+				Account: args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryDistribution returns the QueryDistribution cobra command.
+func CmdQueryDistribution() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "distribution [denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the distribution in progress of fungible token",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the progress of the distribution to the holders of fungible token.
+
+Example:
+$ %[1]s query %s distribution [denom]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			denom := args[0]
+			res, err := queryClient.Distribution(cmd.Context(), &types.QueryDistributionRequest{
+				Denom: denom,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryDistributionClaimable returns the QueryDistributionClaimable cobra command.
+func CmdQueryDistributionClaimable() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "distribution-claimable [denom] [account]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query the amount the account receives from the distribution in progress of fungible token",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the amount the account receives from the distribution in progress of fungible token.
+
+Example:
+$ %[1]s query %s distribution-claimable [denom] [account]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			denom := args[0]
+			account := args[1]
+			res, err := queryClient.DistributionClaimable(cmd.Context(), &types.QueryDistributionClaimableRequest{
+				Denom:   denom,
+				Account: account,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryTransferLimits returns the QueryTransferLimits cobra command.
+func CmdQueryTransferLimits() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-limits [denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query transfer limits of fungible token",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the transfer limits of fungible token, the limit with the empty account is the default one.
+
+Example:
+$ %[1]s query %s transfer-limits [denom]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			denom := args[0]
+			res, err := queryClient.TransferLimits(cmd.Context(), &types.QueryTransferLimitsRequest{
+				Pagination: pageReq,
+				Denom:      denom,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "transfer-limits")
+
+	return cmd
+}
+
+// CmdQueryTransferLimit returns the QueryTransferLimit cobra command.
+func CmdQueryTransferLimit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-limit [denom] [account]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query the transfer limit applied to the account and the amount it might still send",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the transfer limit of fungible token applied to the account and the amount it might still send.
+
+Example:
+$ %[1]s query %s transfer-limit [denom] [account]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			denom := args[0]
+			account := args[1]
+			res, err := queryClient.TransferLimit(cmd.Context(), &types.QueryTransferLimitRequest{
+				Denom:   denom,
+				Account: account,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryVestingSchedules returns the QueryVestingSchedules cobra command.
+func CmdQueryVestingSchedules() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vesting-schedules [denom] [account]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query the vesting schedules of the account with the vested and unvested amounts",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the vesting schedules of fungible token created for the account with the vested and unvested amounts.
+
+Example:
+$ %[1]s query %s vesting-schedules [denom] [account]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			denom := args[0]
+			account := args[1]
+			res, err := queryClient.VestingSchedules(cmd.Context(), &types.QueryVestingSchedulesRequest{
+				Denom:   denom,
+				Account: account,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryHolders returns the QueryHolders cobra command.
+func CmdQueryHolders() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "holders [denom]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query holders of fungible token",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query holders of fungible token with their balance, whitelisted, frozen and locked amounts.
+
+Example:
+$ %[1]s query %s holders [denom]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			denom := args[0]
+			res, err := queryClient.Holders(cmd.Context(), &types.QueryHoldersRequest{
+				Pagination: pageReq,
+				Denom:      denom,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "holders")
+
+	return cmd
+}
+
 // CmdQueryBalance returns the QueryFrozenBalance cobra command.
 func CmdQueryBalance() *cobra.Command {
 	cmd := &cobra.Command{
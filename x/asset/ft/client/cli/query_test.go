@@ -1,9 +1,12 @@
 package cli_test
 
 import (
+	"fmt"
 	"testing"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
@@ -46,6 +49,7 @@ func TestQueryTokens(t *testing.T) {
 	expectedToken.Denom = denom
 	expectedToken.Issuer = testNetwork.Validators[0].Address.String()
 	expectedToken.Version = types.CurrentTokenVersion
+	expectedToken.Admin = expectedToken.Issuer
 	requireT.Equal(expectedToken, resp.Tokens[0])
 }
 
@@ -76,6 +80,7 @@ func TestQueryToken(t *testing.T) {
 	expectedToken.Denom = denom
 	expectedToken.Issuer = testNetwork.Validators[0].Address.String()
 	expectedToken.Version = types.CurrentTokenVersion
+	expectedToken.Admin = expectedToken.Issuer
 	requireT.Equal(expectedToken, resp.Token)
 
 	// query balance
@@ -84,6 +89,50 @@ func TestQueryToken(t *testing.T) {
 	requireT.Equal(initialAmount.String(), respBalance.Balance.String())
 }
 
+func TestQueryHolders(t *testing.T) {
+	requireT := require.New(t)
+
+	testNetwork := network.New(t)
+
+	token := types.Token{
+		Symbol:      "btc" + uuid.NewString()[:4],
+		Subunit:     "satoshi" + uuid.NewString()[:4],
+		Precision:   8,
+		Description: "description",
+	}
+	ctx := testNetwork.Validators[0].ClientCtx
+	initialAmount := sdkmath.NewInt(100)
+	denom := issue(requireT, ctx, token, initialAmount, testNetwork)
+
+	// the amount locked by the vesting schedule is reported
+	account := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	args := append([]string{
+		account.String(), "40" + denom, "linear", fmt.Sprintf("%d", time.Now().Add(time.Hour).Unix()),
+		fmt.Sprintf("--%s=%d", cli.EndTimeFlag, time.Now().Add(2*time.Hour).Unix()),
+	}, txValidator1Args(testNetwork)...)
+	_, err := coreumclitestutil.ExecTxCmd(ctx, testNetwork, cli.CmdTxCreateVestingSchedule(), args)
+	requireT.NoError(err)
+
+	var resp types.QueryHoldersResponse
+	requireT.NoError(coreumclitestutil.ExecQueryCmd(ctx, cli.CmdQueryHolders(), []string{denom}, &resp))
+	requireT.ElementsMatch([]types.Holder{
+		{
+			Account:     testNetwork.Validators[0].Address.String(),
+			Balance:     sdkmath.NewInt(60),
+			Whitelisted: sdkmath.ZeroInt(),
+			Frozen:      sdkmath.ZeroInt(),
+			Locked:      sdkmath.ZeroInt(),
+		},
+		{
+			Account:     account.String(),
+			Balance:     sdkmath.NewInt(40),
+			Whitelisted: sdkmath.ZeroInt(),
+			Frozen:      sdkmath.ZeroInt(),
+			Locked:      sdkmath.NewInt(40),
+		},
+	}, resp.Holders)
+}
+
 func TestCmdTokenUpgradeStatuses(t *testing.T) {
 	requireT := require.New(t)
 
@@ -1,7 +1,11 @@
 package cli
 
 import (
+	"encoding/csv"
+	"encoding/json"
 	"fmt"
+	"os"
+	"path/filepath"
 	"sort"
 	"strconv"
 	"strings"
@@ -32,6 +36,16 @@ const (
 	BurnLimitFlag          = "burn-limit"
 	ExpirationFlag         = "expiration"
 	RecipientFlag          = "recipient"
+	UnfreezeTimeFlag       = "unfreeze-time"
+	DescriptionFlag        = "description"
+	URIFlag                = "uri"
+	URIHashFlag            = "uri-hash"
+	MaxSupplyFlag          = "max-supply"
+	MintAllowanceFlag      = "mint-allowance"
+	MintPeriodFlag         = "mint-period"
+	AccountFlag            = "account"
+	EndTimeFlag            = "end-time"
+	PeriodsFlag            = "periods"
 )
 
 // GetTxCmd returns the transaction commands for this module.
@@ -47,14 +61,31 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(
 		CmdTxIssue(),
 		CmdTxMint(),
+		CmdTxMultiMint(),
 		CmdTxBurn(),
 		CmdTxFreeze(),
+		CmdTxMultiFreeze(),
 		CmdTxUnfreeze(),
 		CmdTxSetFrozen(),
 		CmdTxGloballyFreeze(),
 		CmdTxGloballyUnfreeze(),
 		CmdTxSetWhitelistedLimit(),
+		CmdTxMultiSetWhitelistedLimit(),
+		CmdTxClawback(),
+		CmdTxTransferAdmin(),
+		CmdTxClearAdmin(),
+		CmdTxUpdateMetadata(),
+		CmdTxSetRateExemption(),
+		CmdTxRemoveRateExemption(),
+		CmdTxUpdateRates(),
+		CmdTxSetTransferLimit(),
+		CmdTxRemoveTransferLimit(),
+		CmdTxBlockAccounts(),
+		CmdTxUnblockAccounts(),
+		CmdTxCreateVestingSchedule(),
+		CmdTxDistribute(),
 		CmdTxUpgradeV1(),
+		CmdTxUpgrade(),
 		CmdGrantAuthorization(),
 	)
 
@@ -146,6 +177,48 @@ $ %s tx %s issue WBTC wsatoshi 8 100000 "Wrapped Bitcoin Token" --from [issuer]
 			}
 			description := args[4]
 
+			uri, err := cmd.Flags().GetString(URIFlag)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			uriHash, err := cmd.Flags().GetString(URIHashFlag)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			var maxSupply *sdkmath.Int
+			maxSupplyStr, err := cmd.Flags().GetString(MaxSupplyFlag)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			if len(maxSupplyStr) > 0 {
+				amount, ok := sdkmath.NewIntFromString(maxSupplyStr)
+				if !ok {
+					return errors.Errorf("invalid max-supply")
+				}
+				maxSupply = &amount
+			}
+
+			var mintAllowance *types.MintAllowance
+			mintAllowanceStr, err := cmd.Flags().GetString(MintAllowanceFlag)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			if len(mintAllowanceStr) > 0 {
+				amount, ok := sdkmath.NewIntFromString(mintAllowanceStr)
+				if !ok {
+					return errors.Errorf("invalid mint-allowance")
+				}
+				period, err := cmd.Flags().GetDuration(MintPeriodFlag)
+				if err != nil {
+					return errors.WithStack(err)
+				}
+				mintAllowance = &types.MintAllowance{
+					Amount: amount,
+					Period: period,
+				}
+			}
+
 			msg := &types.MsgIssue{
 				Issuer:             issuer.String(),
 				Symbol:             symbol,
@@ -156,6 +229,10 @@ $ %s tx %s issue WBTC wsatoshi 8 100000 "Wrapped Bitcoin Token" --from [issuer]
 				Features:           features,
 				BurnRate:           burnRate,
 				SendCommissionRate: sendCommissionRate,
+				URI:                uri,
+				URIHash:            uriHash,
+				MaxSupply:          maxSupply,
+				MintAllowance:      mintAllowance,
 			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
@@ -164,6 +241,11 @@ $ %s tx %s issue WBTC wsatoshi 8 100000 "Wrapped Bitcoin Token" --from [issuer]
 	cmd.Flags().StringSlice(FeaturesFlag, []string{}, "Features to be enabled on fungible token. e.g --features="+strings.Join(allowedFeatures, ","))
 	cmd.Flags().String(BurnRateFlag, "0", "Indicates the rate at which coins will be burnt on top of the sent amount in every send action. Must be between 0 and 1.")
 	cmd.Flags().String(SendCommissionRateFlag, "0", "Indicates the rate at which coins will be sent to the issuer on top of the sent amount in every send action. Must be between 0 and 1.")
+	cmd.Flags().String(URIFlag, "", "URI of the token metadata.")
+	cmd.Flags().String(URIHashFlag, "", "Hash of the URI content.")
+	cmd.Flags().String(MaxSupplyFlag, "", "Maximum total supply of the token. The supply is not capped if it is not set.")
+	cmd.Flags().String(MintAllowanceFlag, "", "Amount which might be minted within the mint period. Minting is not limited if it is not set.")
+	cmd.Flags().Duration(MintPeriodFlag, 24*time.Hour, "Period of the mint allowance.")
 
 	flags.AddTxFlagsToCmd(cmd)
 
@@ -218,6 +300,58 @@ $ %s tx %s mint 100000ABC-%s --from [sender]
 	return cmd
 }
 
+// CmdTxMultiMint returns MultiMint cobra command.
+//
+//nolint:dupl // most code is identical between the multi-account commands, but reusing logic is not beneficial here.
+func CmdTxMultiMint() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "multi-mint [entries_file] --from [sender]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Mint new amount of fungible token to multiple accounts",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Mint new amount of fungible token to multiple accounts atomically.
+
+The entries file is either the JSON file (.json) containing the list of account and amount pairs or
+the CSV file containing the account and the amount in each line.
+
+Example:
+$ %s tx %s multi-mint entries.json --from [sender]
+
+entries.json:
+[
+  {"account": "[account_address]", "amount": "100000ABC-%s"}
+]
+
+entries.csv:
+[account_address],100000ABC-%s
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			entries, err := readAccountCoins(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgMultiMint{
+				Sender:  clientCtx.GetFromAddress().String(),
+				Entries: entries,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // CmdTxBurn returns Burn cobra command.
 func CmdTxBurn() *cobra.Command {
 	cmd := &cobra.Command{
@@ -272,8 +406,10 @@ func CmdTxFreeze() *cobra.Command {
 
 Example:
 $ %s tx %s freeze [account_address] 100000ABC-%s --from [sender]
+$ %s tx %s freeze [account_address] 100000ABC-%s --unfreeze-time 1735689600 --from [sender]
 `,
 				version.AppName, types.ModuleName, constant.AddressSampleTest,
+				version.AppName, types.ModuleName, constant.AddressSampleTest,
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -289,10 +425,69 @@ $ %s tx %s freeze [account_address] 100000ABC-%s --from [sender]
 				return sdkerrors.Wrap(err, "invalid amount")
 			}
 
+			unfreezeTime, err := getUnfreezeTime(cmd)
+			if err != nil {
+				return err
+			}
+
 			msg := &types.MsgFreeze{
-				Sender:  sender.String(),
-				Account: account,
-				Coin:    amount,
+				Sender:       sender.String(),
+				Account:      account,
+				Coin:         amount,
+				UnfreezeTime: unfreezeTime,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().Int64(UnfreezeTimeFlag, 0, "Time as Unix timestamp when the frozen amount is unfrozen automatically. Set zero (0) to keep it frozen until unfrozen by the issuer.")
+
+	return cmd
+}
+
+// CmdTxMultiFreeze returns MultiFreeze cobra command.
+//
+//nolint:dupl // most code is identical between the multi-account commands, but reusing logic is not beneficial here.
+func CmdTxMultiFreeze() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "multi-freeze [entries_file] --from [sender]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Freeze any amount of fungible token for multiple accounts",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Freeze a portion of fungible token for multiple accounts atomically.
+
+The entries file is either the JSON file (.json) containing the list of account and amount pairs or
+the CSV file containing the account and the amount in each line.
+
+Example:
+$ %s tx %s multi-freeze entries.json --from [sender]
+
+entries.json:
+[
+  {"account": "[account_address]", "amount": "100000ABC-%s"}
+]
+
+entries.csv:
+[account_address],100000ABC-%s
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			entries, err := readAccountCoins(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgMultiFreeze{
+				Sender:  clientCtx.GetFromAddress().String(),
+				Entries: entries,
 			}
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
@@ -436,20 +631,32 @@ $ %s tx %s set-whitelisted-limit [account_address] 100000ABC-%s --from [sender]
 	return cmd
 }
 
-// CmdTxGloballyFreeze returns GlobalFreeze cobra command.
-func CmdTxGloballyFreeze() *cobra.Command {
+// CmdTxMultiSetWhitelistedLimit returns MultiSetWhitelistedLimit cobra command.
+//
+//nolint:dupl // most code is identical between the multi-account commands, but reusing logic is not beneficial here.
+func CmdTxMultiSetWhitelistedLimit() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "globally-freeze [denom] --from [sender]",
+		Use:   "multi-set-whitelisted-limit [entries_file] --from [sender]",
 		Args:  cobra.ExactArgs(1),
-		Short: "freezes fungible token so no operations are allowed with it before unfrozen",
+		Short: "Set whitelisted limit on multiple accounts",
 		Long: strings.TrimSpace(
-			fmt.Sprintf(`Freezes fungible token so no operations are allowed with it before unfrozen.
-This operation is idempotent so global freeze of already frozen token does nothing.
+			fmt.Sprintf(`Set whitelisted limit on multiple accounts atomically.
+
+The entries file is either the JSON file (.json) containing the list of account and amount pairs or
+the CSV file containing the account and the amount in each line.
 
 Example:
-$ %s tx %s globally-freeze ABC-%s --from [sender]
+$ %s tx %s multi-set-whitelisted-limit entries.json --from [sender]
+
+entries.json:
+[
+  {"account": "[account_address]", "amount": "100000ABC-%s"}
+]
+
+entries.csv:
+[account_address],100000ABC-%s
 `,
-				version.AppName, types.ModuleName, constant.AddressSampleTest,
+				version.AppName, types.ModuleName, constant.AddressSampleTest, constant.AddressSampleTest,
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -458,12 +665,14 @@ $ %s tx %s globally-freeze ABC-%s --from [sender]
 				return errors.WithStack(err)
 			}
 
-			sender := clientCtx.GetFromAddress()
-			denom := args[0]
+			entries, err := readAccountCoins(args[0])
+			if err != nil {
+				return err
+			}
 
-			msg := &types.MsgGloballyFreeze{
-				Sender: sender.String(),
-				Denom:  denom,
+			msg := &types.MsgMultiSetWhitelistedLimit{
+				Sender:  clientCtx.GetFromAddress().String(),
+				Entries: entries,
 			}
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
@@ -474,18 +683,19 @@ $ %s tx %s globally-freeze ABC-%s --from [sender]
 	return cmd
 }
 
-// CmdTxGloballyUnfreeze returns GlobalUnfreeze cobra command.
-func CmdTxGloballyUnfreeze() *cobra.Command {
+// CmdTxClawback returns Clawback cobra command.
+//
+//nolint:dupl // most code is identical, but reusing logic is not beneficial here.
+func CmdTxClawback() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "globally-unfreeze [denom] --from [sender]",
-		Args:  cobra.ExactArgs(1),
-		Short: "unfreezes fungible token and unblocks basic operations on it",
+		Use:   "clawback [account_address] [amount] --from [sender]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Return any amount of fungible token from the specific account to the issuer",
 		Long: strings.TrimSpace(
-			fmt.Sprintf(`Unfreezes fungible token and unblocks basic operations on it.
-This operation is idempotent so global unfreezing of non-frozen token does nothing.
+			fmt.Sprintf(`Return a portion of fungible token from the account to the issuer.
 
 Example:
-$ %s tx %s globally-unfreeze ABC-%s --from [sender]
+$ %s tx %s clawback [account_address] 100000ABC-%s --from [sender]
 `,
 				version.AppName, types.ModuleName, constant.AddressSampleTest,
 			),
@@ -497,11 +707,16 @@ $ %s tx %s globally-unfreeze ABC-%s --from [sender]
 			}
 
 			sender := clientCtx.GetFromAddress()
-			denom := args[0]
+			account := args[0]
+			amount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid amount")
+			}
 
-			msg := &types.MsgGloballyUnfreeze{
-				Sender: sender.String(),
-				Denom:  denom,
+			msg := &types.MsgClawback{
+				Sender:  sender.String(),
+				Account: account,
+				Coin:    amount,
 			}
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
@@ -512,121 +727,836 @@ $ %s tx %s globally-unfreeze ABC-%s --from [sender]
 	return cmd
 }
 
-// CmdTxUpgradeV1 returns UpgradeV1 cobra command.
-func CmdTxUpgradeV1() *cobra.Command {
-	var ibcEnabled bool
+// CmdTxTransferAdmin returns TransferAdmin cobra command.
+func CmdTxTransferAdmin() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   fmt.Sprintf("upgrade-v1 [denom] --%s=true --from [sender]", IBCEnabledFlag),
-		Args:  cobra.ExactArgs(1),
-		Short: "upgrades denom to version v1 and specifies if IBC should be enabled or disabled",
+		Use:   "transfer-admin [account_address] [denom] --from [sender]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Transfer the admin of fungible token to the specific account",
 		Long: strings.TrimSpace(
-			fmt.Sprintf(`Upgrades denom to version v1 and specifies if IBC should be enabled or disabled.
-This is a one-time operation!!! Once executed, it can never be done again.
+			fmt.Sprintf(`Transfer the admin of fungible token to the specific account.
+The new admin is allowed to manage the token, and the sender loses that permission.
 
 Example:
-$ %s tx %s upgrade-v1 ABC-%s --%s=true --from [sender]
+$ %s tx %s transfer-admin [account_address] ABC-%s --from [sender]
 `,
-				version.AppName, types.ModuleName, constant.AddressSampleTest, IBCEnabledFlag,
+				version.AppName, types.ModuleName, constant.AddressSampleTest,
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			if !cmd.Flags().Changed(IBCEnabledFlag) {
-				return errors.Errorf("flag --%s must be explicitly set", IBCEnabledFlag)
-			}
-
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return errors.WithStack(err)
 			}
 
 			sender := clientCtx.GetFromAddress()
-			denom := args[0]
+			account := args[0]
+			denom := args[1]
 
-			msg := &types.MsgUpgradeTokenV1{
-				Sender:     sender.String(),
-				Denom:      denom,
-				IbcEnabled: ibcEnabled,
+			msg := &types.MsgTransferAdmin{
+				Sender:  sender.String(),
+				Account: account,
+				Denom:   denom,
 			}
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
-	cmd.Flags().BoolVar(&ibcEnabled, IBCEnabledFlag, false, "Specifies if IBC should be enabled or disabled for the token")
+
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
 }
 
-// CmdGrantAuthorization returns a CLI command handler for creating a MsgGrant transaction.
-func CmdGrantAuthorization() *cobra.Command {
+// CmdTxClearAdmin returns ClearAdmin cobra command.
+//
+//nolint:dupl // most code is identical, but reusing logic is not beneficial here.
+func CmdTxClearAdmin() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "grant [grantee] [message_type=\"mint\"|\"burn\"] --from <granter> --burn-limit 10ucore --mint-limit 10ucore",
-		Short: "Grant authorization to an address",
-		Long: fmt.Sprintf(`Grant authorization to an address.
-Examples:
-$ %s tx grant <grantee_addr> mint --mint-limit 100ucore --expiration 1667979596
+		Use:   "clear-admin [denom] --from [sender]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Remove the admin of fungible token, so it can't be managed anymore",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Remove the admin of fungible token, so it can't be managed anymore.
+This operation is irreversible.
 
-$ %s tx grant <grantee_addr> burn --burn-limit 100ucore --expiration 1667979596
-`, version.AppName, version.AppName),
-		Args: cobra.ExactArgs(2),
+Example:
+$ %s tx %s clear-admin ABC-%s --from [sender]
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest,
+			),
+		),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
-				return err
+				return errors.WithStack(err)
 			}
 
-			grantee, err := sdk.AccAddressFromBech32(args[0])
-			if err != nil {
-				return err
-			}
+			sender := clientCtx.GetFromAddress()
+			denom := args[0]
 
-			var authorization authz.Authorization
-			switch args[1] {
-			case "mint":
-				limit, err := cmd.Flags().GetString(MintLimitFlag)
-				if err != nil {
-					return err
-				}
+			msg := &types.MsgClearAdmin{
+				Sender: sender.String(),
+				Denom:  denom,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
 
-				limitCoins, err := sdk.ParseCoinsNormalized(limit)
-				if err != nil {
-					return err
-				}
+	flags.AddTxFlagsToCmd(cmd)
 
-				if !limitCoins.IsAllPositive() {
-					return fmt.Errorf("mint-limit should be greater than zero")
-				}
-				authorization = types.NewMintAuthorization(limitCoins)
-			case "burn":
-				limit, err := cmd.Flags().GetString(BurnLimitFlag)
-				if err != nil {
-					return err
-				}
+	return cmd
+}
 
-				limitCoins, err := sdk.ParseCoinsNormalized(limit)
-				if err != nil {
-					return err
-				}
+// CmdTxUpdateMetadata returns UpdateMetadata cobra command.
+func CmdTxUpdateMetadata() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-metadata [denom] --from [sender] --description [description] --uri [uri] --uri-hash [uri_hash]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Update the description, URI and URI hash of fungible token",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Update the description, URI and URI hash of fungible token.
+All the values are replaced, so the values not provided are cleared.
 
-				if !limitCoins.IsAllPositive() {
-					return fmt.Errorf("burn-limit should be greater than zero")
-				}
-				authorization = types.NewBurnAuthorization(limitCoins)
-			default:
-				return errors.Errorf("invalid authorization types, %s", args[1])
+Example:
+$ %s tx %s update-metadata ABC-%s --description "ABC token" --uri https://my-token-meta.invalid/1 --uri-hash e000624 --from [sender]
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
 			}
 
-			expire, err := getExpireTime(cmd)
+			sender := clientCtx.GetFromAddress()
+			denom := args[0]
+
+			description, err := cmd.Flags().GetString(DescriptionFlag)
 			if err != nil {
-				return err
+				return errors.WithStack(err)
 			}
-
-			grantMsg, err := authz.NewMsgGrant(clientCtx.GetFromAddress(), grantee, authorization, expire)
+			uri, err := cmd.Flags().GetString(URIFlag)
 			if err != nil {
-				return err
+				return errors.WithStack(err)
 			}
-			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), grantMsg)
-		},
-	}
+			uriHash, err := cmd.Flags().GetString(URIHashFlag)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			msg := &types.MsgUpdateMetadata{
+				Sender:      sender.String(),
+				Denom:       denom,
+				Description: description,
+				URI:         uri,
+				URIHash:     uriHash,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(DescriptionFlag, "", "Description of the token.")
+	cmd.Flags().String(URIFlag, "", "URI of the token metadata.")
+	cmd.Flags().String(URIHashFlag, "", "Hash of the URI content.")
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxGloballyFreeze returns GlobalFreeze cobra command.
+func CmdTxGloballyFreeze() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "globally-freeze [denom] --from [sender]",
+		Args:  cobra.ExactArgs(1),
+		Short: "freezes fungible token so no operations are allowed with it before unfrozen",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Freezes fungible token so no operations are allowed with it before unfrozen.
+This operation is idempotent so global freeze of already frozen token does nothing.
+
+Example:
+$ %s tx %s globally-freeze ABC-%s --from [sender]
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			sender := clientCtx.GetFromAddress()
+			denom := args[0]
+
+			msg := &types.MsgGloballyFreeze{
+				Sender: sender.String(),
+				Denom:  denom,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxGloballyUnfreeze returns GlobalUnfreeze cobra command.
+func CmdTxGloballyUnfreeze() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "globally-unfreeze [denom] --from [sender]",
+		Args:  cobra.ExactArgs(1),
+		Short: "unfreezes fungible token and unblocks basic operations on it",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Unfreezes fungible token and unblocks basic operations on it.
+This operation is idempotent so global unfreezing of non-frozen token does nothing.
+
+Example:
+$ %s tx %s globally-unfreeze ABC-%s --from [sender]
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			sender := clientCtx.GetFromAddress()
+			denom := args[0]
+
+			msg := &types.MsgGloballyUnfreeze{
+				Sender: sender.String(),
+				Denom:  denom,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxSetRateExemption returns SetRateExemption cobra command.
+//
+//nolint:dupl // most code is identical, but reusing logic is not beneficial here.
+func CmdTxSetRateExemption() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-rate-exemption [account_address] [denom] --from [sender]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Exempt the account from the burn rate and send commission rate of fungible token",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Exempt the account from the burn rate and send commission rate of fungible token.
+The rates are not applied when the exempted account sends or receives the token.
+
+Example:
+$ %s tx %s set-rate-exemption [account_address] ABC-%s --from [sender]
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			sender := clientCtx.GetFromAddress()
+			account := args[0]
+			denom := args[1]
+
+			msg := &types.MsgSetRateExemption{
+				Sender:  sender.String(),
+				Account: account,
+				Denom:   denom,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxRemoveRateExemption returns RemoveRateExemption cobra command.
+//
+//nolint:dupl // most code is identical, but reusing logic is not beneficial here.
+func CmdTxRemoveRateExemption() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-rate-exemption [account_address] [denom] --from [sender]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Remove the account from the rate exemptions of fungible token",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Remove the account from the rate exemptions of fungible token.
+
+Example:
+$ %s tx %s remove-rate-exemption [account_address] ABC-%s --from [sender]
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			sender := clientCtx.GetFromAddress()
+			account := args[0]
+			denom := args[1]
+
+			msg := &types.MsgRemoveRateExemption{
+				Sender:  sender.String(),
+				Account: account,
+				Denom:   denom,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxUpdateRates returns UpdateRates cobra command.
+func CmdTxUpdateRates() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-rates [denom] --burn-rate [rate] --send-commission-rate [rate] --from [sender]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Change the burn rate and send commission rate of fungible token",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Change the burn rate and send commission rate of fungible token.
+The decreased rates are applied immediately, while the increased ones are applied when the notice period ends.
+The rates which are not provided are not changed.
+
+Example:
+$ %s tx %s update-rates ABC-%s --%s 0.1 --%s 0.05 --from [sender]
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest, BurnRateFlag, SendCommissionRateFlag,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			burnRate, sendCommissionRate, err := getOptionalRates(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgUpdateRates{
+				Sender:             clientCtx.GetFromAddress().String(),
+				Denom:              args[0],
+				BurnRate:           burnRate,
+				SendCommissionRate: sendCommissionRate,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(BurnRateFlag, "", "New burn rate of the token")
+	cmd.Flags().String(SendCommissionRateFlag, "", "New send commission rate of the token")
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxSetTransferLimit returns SetTransferLimit cobra command.
+func CmdTxSetTransferLimit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-transfer-limit [denom] [amount] [period] --account [account_address] --from [sender]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Set the amount the account might send within the rolling period",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Set the amount of fungible token the account might send within the rolling period.
+The default limit applied to all the accounts without their own limits is set if the account is not provided.
+
+Example:
+$ %s tx %s set-transfer-limit ABC-%s 100000 24h --account [account_address] --from [sender]
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			sender := clientCtx.GetFromAddress()
+			denom := args[0]
+			amount, ok := sdkmath.NewIntFromString(args[1])
+			if !ok {
+				return errors.Errorf("invalid amount")
+			}
+			period, err := time.ParseDuration(args[2])
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid period")
+			}
+			account, err := cmd.Flags().GetString(AccountFlag)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			msg := &types.MsgSetTransferLimit{
+				Sender:  sender.String(),
+				Denom:   denom,
+				Account: account,
+				Amount:  amount,
+				Period:  period,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(AccountFlag, "", "Account the limit is applied to, the default limit is set if it is empty.")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxRemoveTransferLimit returns RemoveTransferLimit cobra command.
+func CmdTxRemoveTransferLimit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-transfer-limit [denom] --account [account_address] --from [sender]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Remove the transfer limit of the account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Remove the transfer limit of fungible token set for the account.
+The default limit is removed if the account is not provided.
+
+Example:
+$ %s tx %s remove-transfer-limit ABC-%s --account [account_address] --from [sender]
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			sender := clientCtx.GetFromAddress()
+			denom := args[0]
+			account, err := cmd.Flags().GetString(AccountFlag)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			msg := &types.MsgRemoveTransferLimit{
+				Sender:  sender.String(),
+				Denom:   denom,
+				Account: account,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(AccountFlag, "", "Account the limit is removed from, the default limit is removed if it is empty.")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxBlockAccounts returns BlockAccounts cobra command.
+//
+//nolint:dupl // most code is identical, but reusing logic is not beneficial here.
+func CmdTxBlockAccounts() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "block-accounts [denom] [account_address]... --from [sender]",
+		Args:  cobra.MinimumNArgs(2),
+		Short: "Block the accounts from sending and receiving fungible token",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Add the accounts to the blocklist of fungible token, so they can't send and receive it.
+
+Example:
+$ %s tx %s block-accounts ABC-%s [account_address1] [account_address2] --from [sender]
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			msg := &types.MsgBlockAccounts{
+				Sender:   clientCtx.GetFromAddress().String(),
+				Denom:    args[0],
+				Accounts: args[1:],
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxUnblockAccounts returns UnblockAccounts cobra command.
+//
+//nolint:dupl // most code is identical, but reusing logic is not beneficial here.
+func CmdTxUnblockAccounts() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unblock-accounts [denom] [account_address]... --from [sender]",
+		Args:  cobra.MinimumNArgs(2),
+		Short: "Remove the accounts from the blocklist of fungible token",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Remove the accounts from the blocklist of fungible token.
+
+Example:
+$ %s tx %s unblock-accounts ABC-%s [account_address1] [account_address2] --from [sender]
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			msg := &types.MsgUnblockAccounts{
+				Sender:   clientCtx.GetFromAddress().String(),
+				Denom:    args[0],
+				Accounts: args[1:],
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxCreateVestingSchedule returns CreateVestingSchedule cobra command.
+func CmdTxCreateVestingSchedule() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-vesting-schedule [account_address] [amount] [vesting_type] [start_time] --from [sender]",
+		Args:  cobra.ExactArgs(4),
+		Short: "Send the amount to the account locking it until it is vested",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Send the amount of fungible token to the account and lock it until it is vested according to the schedule.
+The vesting type is one of linear, cliff and periodic, the start time is the Unix timestamp.
+The end time is required for the linear and cliff schedules, the periods are required for the periodic schedule.
+
+Example:
+$ %s tx %s create-vesting-schedule [account_address] 100000ABC-%s linear 1735689600 --end-time 1767225600 --from [sender]
+$ %s tx %s create-vesting-schedule [account_address] 100000ABC-%s periodic 1735689600 --periods 720h:50000,720h:50000 --from [sender]
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest,
+				version.AppName, types.ModuleName, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			sender := clientCtx.GetFromAddress()
+			account := args[0]
+			amount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid amount")
+			}
+			vestingType, ok := types.VestingType_value[args[2]]
+			if !ok {
+				return errors.Errorf("unknown vesting type '%s'", args[2])
+			}
+			startTime, err := strconv.ParseInt(args[3], 10, 64)
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid start time")
+			}
+
+			var endTime *time.Time
+			endTimeUnix, err := cmd.Flags().GetInt64(EndTimeFlag)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			if endTimeUnix != 0 {
+				t := time.Unix(endTimeUnix, 0)
+				endTime = &t
+			}
+
+			periodsString, err := cmd.Flags().GetStringSlice(PeriodsFlag)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			periods, err := parseVestingPeriods(periodsString)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgCreateVestingSchedule{
+				Sender:      sender.String(),
+				Account:     account,
+				Coin:        amount,
+				VestingType: types.VestingType(vestingType),
+				StartTime:   time.Unix(startTime, 0),
+				EndTime:     endTime,
+				Periods:     periods,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Int64(EndTimeFlag, 0, "Time as Unix timestamp when the whole amount of the linear or cliff schedule is vested.")
+	cmd.Flags().StringSlice(PeriodsFlag, []string{}, "Periods of the periodic schedule in the [length]:[amount] format, e.g. 720h:50000.")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxDistribute returns Distribute cobra command.
+func CmdTxDistribute() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "distribute [denom] [amount] --from [sender]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Distribute the amount to the holders of fungible token",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Distribute the amount to the holders of fungible token proportionally to their balances at the current height.
+The holders are paid in the end blockers of the next blocks, the part which can't be paid is returned to the sender.
+
+Example:
+$ %s tx %s distribute ABC-%s 100000ucore --from [sender]
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			sender := clientCtx.GetFromAddress()
+			denom := args[0]
+			amount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid amount")
+			}
+
+			msg := &types.MsgDistribute{
+				Sender: sender.String(),
+				Denom:  denom,
+				Amount: amount,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxUpgradeV1 returns UpgradeV1 cobra command.
+func CmdTxUpgradeV1() *cobra.Command {
+	var ibcEnabled bool
+	cmd := &cobra.Command{
+		Use:   fmt.Sprintf("upgrade-v1 [denom] --%s=true --from [sender]", IBCEnabledFlag),
+		Args:  cobra.ExactArgs(1),
+		Short: "upgrades denom to version v1 and specifies if IBC should be enabled or disabled",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Upgrades denom to version v1 and specifies if IBC should be enabled or disabled.
+This is a one-time operation!!! Once executed, it can never be done again.
+
+Example:
+$ %s tx %s upgrade-v1 ABC-%s --%s=true --from [sender]
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest, IBCEnabledFlag,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !cmd.Flags().Changed(IBCEnabledFlag) {
+				return errors.Errorf("flag --%s must be explicitly set", IBCEnabledFlag)
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			sender := clientCtx.GetFromAddress()
+			denom := args[0]
+
+			msg := &types.MsgUpgradeTokenV1{
+				Sender:     sender.String(),
+				Denom:      denom,
+				IbcEnabled: ibcEnabled,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().BoolVar(&ibcEnabled, IBCEnabledFlag, false, "Specifies if IBC should be enabled or disabled for the token")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxUpgrade returns Upgrade cobra command.
+func CmdTxUpgrade() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: fmt.Sprintf(
+			"upgrade [denom] [version] --%s=true --%s=minting,burning --%s=0.1 --%s=0.1 --from [sender]",
+			IBCEnabledFlag, FeaturesFlag, BurnRateFlag, SendCommissionRateFlag,
+		),
+		Args:  cobra.ExactArgs(2),
+		Short: "upgrades denom to the version using the version specific options",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Upgrades denom to the version using the version specific options.
+The v1 upgrade uses the --%s flag, the v2 upgrade uses the --%s, --%s and --%s flags.
+Only minting and burning features might be enabled by the v2 upgrade.
+The versions must be upgraded to in sequence and each of them only once!!!
+
+Example:
+$ %s tx %s upgrade ABC-%s 2 --%s=minting --%s=0.01 --from [sender]
+`,
+				IBCEnabledFlag, FeaturesFlag, BurnRateFlag, SendCommissionRateFlag,
+				version.AppName, types.ModuleName, constant.AddressSampleTest, FeaturesFlag, BurnRateFlag,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			sender := clientCtx.GetFromAddress()
+			denom := args[0]
+			upgradeVersion, err := strconv.ParseUint(args[1], 10, 32)
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid version")
+			}
+
+			var options types.TokenUpgradeOptions
+			switch upgradeVersion {
+			case 1:
+				if !cmd.Flags().Changed(IBCEnabledFlag) {
+					return errors.Errorf("flag --%s must be explicitly set", IBCEnabledFlag)
+				}
+				ibcEnabled, err := cmd.Flags().GetBool(IBCEnabledFlag)
+				if err != nil {
+					return errors.WithStack(err)
+				}
+				options.V1 = &types.TokenUpgradeV1Options{
+					IbcEnabled: ibcEnabled,
+				}
+			case 2:
+				options.V2, err = getTokenUpgradeV2Options(cmd)
+				if err != nil {
+					return err
+				}
+			default:
+				return errors.Errorf("upgrade to version %d is not supported", upgradeVersion)
+			}
+
+			msg := &types.MsgUpgradeToken{
+				Sender:  sender.String(),
+				Denom:   denom,
+				Version: uint32(upgradeVersion),
+				Options: options,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().Bool(IBCEnabledFlag, false, "Specifies if IBC should be enabled or disabled for the token")
+	cmd.Flags().StringSlice(FeaturesFlag, []string{}, "Features to be enabled on the token")
+	cmd.Flags().String(BurnRateFlag, "", "New burn rate of the token, it can't be higher than the current one")
+	cmd.Flags().String(
+		SendCommissionRateFlag, "", "New send commission rate of the token, it can't be higher than the current one",
+	)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdGrantAuthorization returns a CLI command handler for creating a MsgGrant transaction.
+func CmdGrantAuthorization() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant [grantee] [message_type=\"mint\"|\"burn\"] --from <granter> --burn-limit 10ucore --mint-limit 10ucore",
+		Short: "Grant authorization to an address",
+		Long: fmt.Sprintf(`Grant authorization to an address.
+Examples:
+$ %s tx grant <grantee_addr> mint --mint-limit 100ucore --expiration 1667979596
+
+$ %s tx grant <grantee_addr> burn --burn-limit 100ucore --expiration 1667979596
+`, version.AppName, version.AppName),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			var authorization authz.Authorization
+			switch args[1] {
+			case "mint":
+				limit, err := cmd.Flags().GetString(MintLimitFlag)
+				if err != nil {
+					return err
+				}
+
+				limitCoins, err := sdk.ParseCoinsNormalized(limit)
+				if err != nil {
+					return err
+				}
+
+				if !limitCoins.IsAllPositive() {
+					return fmt.Errorf("mint-limit should be greater than zero")
+				}
+				authorization = types.NewMintAuthorization(limitCoins)
+			case "burn":
+				limit, err := cmd.Flags().GetString(BurnLimitFlag)
+				if err != nil {
+					return err
+				}
+
+				limitCoins, err := sdk.ParseCoinsNormalized(limit)
+				if err != nil {
+					return err
+				}
+
+				if !limitCoins.IsAllPositive() {
+					return fmt.Errorf("burn-limit should be greater than zero")
+				}
+				authorization = types.NewBurnAuthorization(limitCoins)
+			default:
+				return errors.Errorf("invalid authorization types, %s", args[1])
+			}
+
+			expire, err := getExpireTime(cmd)
+			if err != nil {
+				return err
+			}
+
+			grantMsg, err := authz.NewMsgGrant(clientCtx.GetFromAddress(), grantee, authorization, expire)
+			if err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), grantMsg)
+		},
+	}
 	flags.AddTxFlagsToCmd(cmd)
 	cmd.Flags().Int64(ExpirationFlag, 0, "Expire time as Unix timestamp. Set zero (0) for no expiry.")
 	cmd.Flags().String(BurnLimitFlag, "", "The Amount that is allowed to be burnt.")
@@ -645,3 +1575,138 @@ func getExpireTime(cmd *cobra.Command) (*time.Time, error) {
 	e := time.Unix(exp, 0)
 	return &e, nil
 }
+
+func getUnfreezeTime(cmd *cobra.Command) (*time.Time, error) {
+	unfreezeTime, err := cmd.Flags().GetInt64(UnfreezeTimeFlag)
+	if err != nil {
+		return nil, err
+	}
+	if unfreezeTime == 0 {
+		return nil, nil //nolint:nilnil //the intent of this function is to simplify return nil time.
+	}
+	t := time.Unix(unfreezeTime, 0)
+	return &t, nil
+}
+
+func getTokenUpgradeV2Options(cmd *cobra.Command) (*types.TokenUpgradeV2Options, error) {
+	featuresString, err := cmd.Flags().GetStringSlice(FeaturesFlag)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	options := &types.TokenUpgradeV2Options{}
+	for _, str := range featuresString {
+		feature, ok := types.Feature_value[str]
+		if !ok {
+			return nil, errors.Errorf("unknown feature '%s'", str)
+		}
+		options.Features = append(options.Features, types.Feature(feature))
+	}
+
+	options.BurnRate, options.SendCommissionRate, err = getOptionalRates(cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	return options, nil
+}
+
+func getOptionalRates(cmd *cobra.Command) (*sdk.Dec, *sdk.Dec, error) {
+	burnRateStr, err := cmd.Flags().GetString(BurnRateFlag)
+	if err != nil {
+		return nil, nil, errors.WithStack(err)
+	}
+	var burnRate *sdk.Dec
+	if len(burnRateStr) > 0 {
+		rate, err := sdk.NewDecFromStr(burnRateStr)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "invalid burn-rate")
+		}
+		burnRate = &rate
+	}
+
+	sendCommissionRateStr, err := cmd.Flags().GetString(SendCommissionRateFlag)
+	if err != nil {
+		return nil, nil, errors.WithStack(err)
+	}
+	var sendCommissionRate *sdk.Dec
+	if len(sendCommissionRateStr) > 0 {
+		rate, err := sdk.NewDecFromStr(sendCommissionRateStr)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "invalid send-commission-rate")
+		}
+		sendCommissionRate = &rate
+	}
+
+	return burnRate, sendCommissionRate, nil
+}
+
+func parseVestingPeriods(periodsString []string) ([]types.VestingPeriod, error) {
+	periods := make([]types.VestingPeriod, 0, len(periodsString))
+	for _, str := range periodsString {
+		lengthString, amountString, ok := strings.Cut(str, ":")
+		if !ok {
+			return nil, errors.Errorf("invalid vesting period '%s'", str)
+		}
+		length, err := time.ParseDuration(lengthString)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "invalid length of the vesting period '%s'", str)
+		}
+		amount, ok := sdkmath.NewIntFromString(amountString)
+		if !ok {
+			return nil, errors.Errorf("invalid amount of the vesting period '%s'", str)
+		}
+		periods = append(periods, types.VestingPeriod{
+			Length: length,
+			Amount: amount,
+		})
+	}
+
+	return periods, nil
+}
+
+// readAccountCoins reads the account and amount pairs from the JSON or CSV file.
+func readAccountCoins(path string) ([]types.AccountCoin, error) {
+	type entry struct {
+		Account string `json:"account"`
+		Amount  string `json:"amount"`
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to open entries file %s", path)
+	}
+	defer f.Close()
+
+	var rawEntries []entry
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		if err := json.NewDecoder(f).Decode(&rawEntries); err != nil {
+			return nil, errors.Wrapf(err, "failed to decode entries file %s", path)
+		}
+	} else {
+		reader := csv.NewReader(f)
+		reader.FieldsPerRecord = 2
+		reader.TrimLeadingSpace = true
+		records, err := reader.ReadAll()
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read entries file %s", path)
+		}
+		for _, record := range records {
+			rawEntries = append(rawEntries, entry{Account: record[0], Amount: record[1]})
+		}
+	}
+
+	entries := make([]types.AccountCoin, 0, len(rawEntries))
+	for _, rawEntry := range rawEntries {
+		amount, err := sdk.ParseCoinNormalized(rawEntry.Amount)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "invalid amount %s", rawEntry.Amount)
+		}
+		entries = append(entries, types.AccountCoin{
+			Account: rawEntry.Account,
+			Coin:    amount,
+		})
+	}
+
+	return entries, nil
+}
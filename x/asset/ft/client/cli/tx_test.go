@@ -2,8 +2,11 @@ package cli_test
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/client"
@@ -49,6 +52,37 @@ func TestIssue(t *testing.T) {
 	requireT.Equal(types.BuildDenom(token.Subunit, testNetwork.Validators[0].Address), denom)
 }
 
+func TestIssueWithMaxSupplyAndMintAllowance(t *testing.T) {
+	requireT := require.New(t)
+	testNetwork := network.New(t)
+
+	maxSupply := sdkmath.NewInt(1000)
+	token := types.Token{
+		Symbol:      "btc" + uuid.NewString()[:4],
+		Subunit:     "satoshi" + uuid.NewString()[:4],
+		Precision:   8,
+		Description: "description",
+		Features: []types.Feature{
+			types.Feature_minting,
+		},
+		MaxSupply: &maxSupply,
+		MintAllowance: &types.MintAllowance{
+			Amount: sdkmath.NewInt(100),
+			Period: time.Hour,
+		},
+	}
+
+	ctx := testNetwork.Validators[0].ClientCtx
+	denom := issue(requireT, ctx, token, sdkmath.NewInt(777), testNetwork)
+
+	var resp types.QueryTokenResponse
+	requireT.NoError(coreumclitestutil.ExecQueryCmd(ctx, cli.CmdQueryToken(), []string{denom}, &resp))
+	requireT.Equal(maxSupply.String(), resp.Token.MaxSupply.String())
+	requireT.Equal(token.MintAllowance.Amount.String(), resp.Token.MintAllowance.Amount.String())
+	requireT.Equal(token.MintAllowance.Period, resp.Token.MintAllowance.Period)
+	requireT.Equal("100", resp.Token.MintableAmount.String())
+}
+
 func TestMintBurn(t *testing.T) {
 	requireT := require.New(t)
 	testNetwork := network.New(t)
@@ -267,6 +301,430 @@ func TestWhitelistAndQueryWhitelisted(t *testing.T) {
 	requireT.Len(balancesResp.Balances, 1)
 }
 
+func TestMultiSetWhitelistedLimitMintAndFreeze(t *testing.T) {
+	requireT := require.New(t)
+	testNetwork := network.New(t)
+
+	token := types.Token{
+		Symbol:      "btc" + uuid.NewString()[:4],
+		Subunit:     "satoshi" + uuid.NewString()[:4],
+		Precision:   8,
+		Description: "description",
+		Features: []types.Feature{
+			types.Feature_minting,
+			types.Feature_freezing,
+			types.Feature_whitelisting,
+		},
+	}
+
+	ctx := testNetwork.Validators[0].ClientCtx
+	denom := issue(requireT, ctx, token, sdkmath.NewInt(777), testNetwork)
+
+	recipient1 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	recipient2 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	dir := t.TempDir()
+
+	// set the whitelisted limits using the CSV file
+	csvFile := filepath.Join(dir, "whitelist.csv")
+	requireT.NoError(os.WriteFile(csvFile, []byte(fmt.Sprintf(
+		"%s,%s\n%s,%s\n",
+		recipient1, sdk.NewInt64Coin(denom, 1000), recipient2, sdk.NewInt64Coin(denom, 2000),
+	)), 0o600))
+	args := append([]string{csvFile}, txValidator1Args(testNetwork)...)
+	_, err := coreumclitestutil.ExecTxCmd(ctx, testNetwork, cli.CmdTxMultiSetWhitelistedLimit(), args)
+	requireT.NoError(err)
+
+	// mint to both recipients using the JSON file
+	mintFile := filepath.Join(dir, "mint.json")
+	requireT.NoError(os.WriteFile(mintFile, []byte(fmt.Sprintf(
+		`[{"account":"%s","amount":"%s"},{"account":"%s","amount":"%s"}]`,
+		recipient1, sdk.NewInt64Coin(denom, 100), recipient2, sdk.NewInt64Coin(denom, 200),
+	)), 0o600))
+	args = append([]string{mintFile}, txValidator1Args(testNetwork)...)
+	_, err = coreumclitestutil.ExecTxCmd(ctx, testNetwork, cli.CmdTxMultiMint(), args)
+	requireT.NoError(err)
+
+	// freeze part of the minted tokens using the JSON file
+	freezeFile := filepath.Join(dir, "freeze.json")
+	requireT.NoError(os.WriteFile(freezeFile, []byte(fmt.Sprintf(
+		`[{"account":"%s","amount":"%s"},{"account":"%s","amount":"%s"}]`,
+		recipient1, sdk.NewInt64Coin(denom, 10), recipient2, sdk.NewInt64Coin(denom, 20),
+	)), 0o600))
+	args = append([]string{freezeFile}, txValidator1Args(testNetwork)...)
+	_, err = coreumclitestutil.ExecTxCmd(ctx, testNetwork, cli.CmdTxMultiFreeze(), args)
+	requireT.NoError(err)
+
+	for recipient, expected := range map[string]types.QueryBalanceResponse{
+		recipient1.String(): {
+			Balance:     sdkmath.NewInt(100),
+			Whitelisted: sdkmath.NewInt(1000),
+			Frozen:      sdkmath.NewInt(10),
+		},
+		recipient2.String(): {
+			Balance:     sdkmath.NewInt(200),
+			Whitelisted: sdkmath.NewInt(2000),
+			Frozen:      sdkmath.NewInt(20),
+		},
+	} {
+		var respBalance types.QueryBalanceResponse
+		requireT.NoError(coreumclitestutil.ExecQueryCmd(ctx, cli.CmdQueryBalance(), []string{recipient, denom}, &respBalance))
+		requireT.Equal(expected.Balance.String(), respBalance.Balance.String())
+		requireT.Equal(expected.Whitelisted.String(), respBalance.Whitelisted.String())
+		requireT.Equal(expected.Frozen.String(), respBalance.Frozen.String())
+	}
+}
+
+func TestClawback(t *testing.T) {
+	requireT := require.New(t)
+	testNetwork := network.New(t)
+
+	token := types.Token{
+		Symbol:      "btc" + uuid.NewString()[:4],
+		Subunit:     "satoshi" + uuid.NewString()[:4],
+		Precision:   8,
+		Description: "description",
+		Features: []types.Feature{
+			types.Feature_minting,
+			types.Feature_clawback,
+		},
+	}
+
+	ctx := testNetwork.Validators[0].ClientCtx
+	denom := issue(requireT, ctx, token, sdkmath.NewInt(777), testNetwork)
+	recipient := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	// mint to recipient
+	args := append([]string{sdk.NewInt64Coin(denom, 100).String(), "--recipient", recipient.String()}, txValidator1Args(testNetwork)...)
+	_, err := coreumclitestutil.ExecTxCmd(ctx, testNetwork, cli.CmdTxMint(), args)
+	requireT.NoError(err)
+
+	// clawback part of the tokens
+	args = append([]string{recipient.String(), sdk.NewInt64Coin(denom, 40).String()}, txValidator1Args(testNetwork)...)
+	_, err = coreumclitestutil.ExecTxCmd(ctx, testNetwork, cli.CmdTxClawback(), args)
+	requireT.NoError(err)
+
+	var balanceRsp banktypes.QueryAllBalancesResponse
+	requireT.NoError(coreumclitestutil.ExecQueryCmd(ctx, bankcli.GetBalancesCmd(), []string{recipient.String()}, &balanceRsp))
+	requireT.Equal(sdkmath.NewInt(60).String(), balanceRsp.Balances.AmountOf(denom).String())
+}
+
+func TestTransferAndClearAdmin(t *testing.T) {
+	requireT := require.New(t)
+	testNetwork := network.New(t)
+
+	token := types.Token{
+		Symbol:      "btc" + uuid.NewString()[:4],
+		Subunit:     "satoshi" + uuid.NewString()[:4],
+		Precision:   8,
+		Description: "description",
+		Features: []types.Feature{
+			types.Feature_minting,
+		},
+	}
+
+	ctx := testNetwork.Validators[0].ClientCtx
+	denom := issue(requireT, ctx, token, sdkmath.NewInt(777), testNetwork)
+	admin := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	// transfer the admin
+	args := append([]string{admin.String(), denom}, txValidator1Args(testNetwork)...)
+	_, err := coreumclitestutil.ExecTxCmd(ctx, testNetwork, cli.CmdTxTransferAdmin(), args)
+	requireT.NoError(err)
+
+	var resp types.QueryTokenResponse
+	requireT.NoError(coreumclitestutil.ExecQueryCmd(ctx, cli.CmdQueryToken(), []string{denom}, &resp))
+	requireT.Equal(admin.String(), resp.Token.Admin)
+
+	// clear the admin of another token
+	token.Symbol = "btc" + uuid.NewString()[:4]
+	token.Subunit = "satoshi" + uuid.NewString()[:4]
+	denom = issue(requireT, ctx, token, sdkmath.NewInt(777), testNetwork)
+
+	args = append([]string{denom}, txValidator1Args(testNetwork)...)
+	_, err = coreumclitestutil.ExecTxCmd(ctx, testNetwork, cli.CmdTxClearAdmin(), args)
+	requireT.NoError(err)
+
+	requireT.NoError(coreumclitestutil.ExecQueryCmd(ctx, cli.CmdQueryToken(), []string{denom}, &resp))
+	requireT.Empty(resp.Token.Admin)
+}
+
+func TestUpdateMetadata(t *testing.T) {
+	requireT := require.New(t)
+	testNetwork := network.New(t)
+
+	token := types.Token{
+		Symbol:      "btc" + uuid.NewString()[:4],
+		Subunit:     "satoshi" + uuid.NewString()[:4],
+		Precision:   8,
+		Description: "description",
+	}
+
+	ctx := testNetwork.Validators[0].ClientCtx
+	denom := issue(requireT, ctx, token, sdkmath.NewInt(777), testNetwork)
+
+	args := append([]string{
+		denom,
+		fmt.Sprintf("--%s=%s", cli.DescriptionFlag, "new description"),
+		fmt.Sprintf("--%s=%s", cli.URIFlag, "https://my.invalid"),
+		fmt.Sprintf("--%s=%s", cli.URIHashFlag, "e000624"),
+	}, txValidator1Args(testNetwork)...)
+	_, err := coreumclitestutil.ExecTxCmd(ctx, testNetwork, cli.CmdTxUpdateMetadata(), args)
+	requireT.NoError(err)
+
+	var resp types.QueryTokenResponse
+	requireT.NoError(coreumclitestutil.ExecQueryCmd(ctx, cli.CmdQueryToken(), []string{denom}, &resp))
+	requireT.Equal("new description", resp.Token.Description)
+	requireT.Equal("https://my.invalid", resp.Token.URI)
+	requireT.Equal("e000624", resp.Token.URIHash)
+}
+
+func TestUpdateRatesAndRateExemption(t *testing.T) {
+	requireT := require.New(t)
+	testNetwork := network.New(t)
+
+	token := types.Token{
+		Symbol:             "btc" + uuid.NewString()[:4],
+		Subunit:            "satoshi" + uuid.NewString()[:4],
+		Precision:          8,
+		Description:        "description",
+		BurnRate:           sdk.MustNewDecFromStr("0.1"),
+		SendCommissionRate: sdk.MustNewDecFromStr("0.1"),
+	}
+
+	ctx := testNetwork.Validators[0].ClientCtx
+	denom := issue(requireT, ctx, token, sdkmath.NewInt(777), testNetwork)
+	account := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	// set the exemption
+	args := append([]string{account.String(), denom}, txValidator1Args(testNetwork)...)
+	_, err := coreumclitestutil.ExecTxCmd(ctx, testNetwork, cli.CmdTxSetRateExemption(), args)
+	requireT.NoError(err)
+
+	var resp types.QueryRateExemptionsResponse
+	requireT.NoError(coreumclitestutil.ExecQueryCmd(ctx, cli.CmdQueryRateExemptions(), []string{denom}, &resp))
+	requireT.Equal([]string{account.String()}, resp.Accounts)
+
+	// remove the exemption
+	args = append([]string{account.String(), denom}, txValidator1Args(testNetwork)...)
+	_, err = coreumclitestutil.ExecTxCmd(ctx, testNetwork, cli.CmdTxRemoveRateExemption(), args)
+	requireT.NoError(err)
+
+	requireT.NoError(coreumclitestutil.ExecQueryCmd(ctx, cli.CmdQueryRateExemptions(), []string{denom}, &resp))
+	requireT.Empty(resp.Accounts)
+
+	// update the rates without providing them
+	args = append([]string{denom}, txValidator1Args(testNetwork)...)
+	_, err = coreumclitestutil.ExecTxCmd(ctx, testNetwork, cli.CmdTxUpdateRates(), args)
+	requireT.Error(err)
+
+	// decrease the burn rate and increase the send commission rate
+	args = append([]string{
+		denom,
+		fmt.Sprintf("--%s=%s", cli.BurnRateFlag, "0.05"),
+		fmt.Sprintf("--%s=%s", cli.SendCommissionRateFlag, "0.2"),
+	}, txValidator1Args(testNetwork)...)
+	_, err = coreumclitestutil.ExecTxCmd(ctx, testNetwork, cli.CmdTxUpdateRates(), args)
+	requireT.NoError(err)
+
+	var tokenResp types.QueryTokenResponse
+	requireT.NoError(coreumclitestutil.ExecQueryCmd(ctx, cli.CmdQueryToken(), []string{denom}, &tokenResp))
+	requireT.Equal(sdk.MustNewDecFromStr("0.05").String(), tokenResp.Token.BurnRate.String())
+	requireT.Equal(sdk.MustNewDecFromStr("0.1").String(), tokenResp.Token.SendCommissionRate.String())
+	requireT.NotNil(tokenResp.Token.PendingRatesUpdate)
+	requireT.Nil(tokenResp.Token.PendingRatesUpdate.BurnRate)
+	requireT.Equal(
+		sdk.MustNewDecFromStr("0.2").String(), tokenResp.Token.PendingRatesUpdate.SendCommissionRate.String(),
+	)
+}
+
+func TestSetAndRemoveTransferLimit(t *testing.T) {
+	requireT := require.New(t)
+	testNetwork := network.New(t)
+
+	token := types.Token{
+		Symbol:      "btc" + uuid.NewString()[:4],
+		Subunit:     "satoshi" + uuid.NewString()[:4],
+		Precision:   8,
+		Description: "description",
+		Features: []types.Feature{
+			types.Feature_transfer_limits,
+		},
+	}
+
+	ctx := testNetwork.Validators[0].ClientCtx
+	denom := issue(requireT, ctx, token, sdkmath.NewInt(777), testNetwork)
+	account := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	// set the default limit and the limit of the account
+	args := append([]string{denom, "100", "24h"}, txValidator1Args(testNetwork)...)
+	_, err := coreumclitestutil.ExecTxCmd(ctx, testNetwork, cli.CmdTxSetTransferLimit(), args)
+	requireT.NoError(err)
+
+	args = append([]string{denom, "200", "1h", fmt.Sprintf("--%s=%s", cli.AccountFlag, account.String())},
+		txValidator1Args(testNetwork)...)
+	_, err = coreumclitestutil.ExecTxCmd(ctx, testNetwork, cli.CmdTxSetTransferLimit(), args)
+	requireT.NoError(err)
+
+	var limitsResp types.QueryTransferLimitsResponse
+	requireT.NoError(coreumclitestutil.ExecQueryCmd(ctx, cli.CmdQueryTransferLimits(), []string{denom}, &limitsResp))
+	requireT.Len(limitsResp.TransferLimits, 2)
+
+	var limitResp types.QueryTransferLimitResponse
+	requireT.NoError(coreumclitestutil.ExecQueryCmd(
+		ctx, cli.CmdQueryTransferLimit(), []string{denom, account.String()}, &limitResp,
+	))
+	requireT.NotNil(limitResp.TransferLimit)
+	requireT.Equal(account.String(), limitResp.TransferLimit.Account)
+	requireT.Equal(time.Hour, limitResp.TransferLimit.Period)
+	requireT.Equal(sdkmath.NewInt(200).String(), limitResp.Remaining.String())
+
+	// remove the limit of the account, the default one is applied
+	args = append([]string{denom, fmt.Sprintf("--%s=%s", cli.AccountFlag, account.String())},
+		txValidator1Args(testNetwork)...)
+	_, err = coreumclitestutil.ExecTxCmd(ctx, testNetwork, cli.CmdTxRemoveTransferLimit(), args)
+	requireT.NoError(err)
+
+	requireT.NoError(coreumclitestutil.ExecQueryCmd(
+		ctx, cli.CmdQueryTransferLimit(), []string{denom, account.String()}, &limitResp,
+	))
+	requireT.NotNil(limitResp.TransferLimit)
+	requireT.Equal("", limitResp.TransferLimit.Account)
+	requireT.Equal(sdkmath.NewInt(100).String(), limitResp.Remaining.String())
+}
+
+func TestBlockAndUnblockAccounts(t *testing.T) {
+	requireT := require.New(t)
+	testNetwork := network.New(t)
+
+	token := types.Token{
+		Symbol:      "btc" + uuid.NewString()[:4],
+		Subunit:     "satoshi" + uuid.NewString()[:4],
+		Precision:   8,
+		Description: "description",
+		Features: []types.Feature{
+			types.Feature_blocklisting,
+		},
+	}
+
+	ctx := testNetwork.Validators[0].ClientCtx
+	denom := issue(requireT, ctx, token, sdkmath.NewInt(777), testNetwork)
+	account1 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	account2 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	// block the accounts
+	args := append([]string{denom, account1.String(), account2.String()}, txValidator1Args(testNetwork)...)
+	_, err := coreumclitestutil.ExecTxCmd(ctx, testNetwork, cli.CmdTxBlockAccounts(), args)
+	requireT.NoError(err)
+
+	var accountsResp types.QueryBlockedAccountsResponse
+	requireT.NoError(coreumclitestutil.ExecQueryCmd(ctx, cli.CmdQueryBlockedAccounts(), []string{denom}, &accountsResp))
+	requireT.ElementsMatch([]string{account1.String(), account2.String()}, accountsResp.Accounts)
+
+	var accountResp types.QueryBlockedAccountResponse
+	requireT.NoError(coreumclitestutil.ExecQueryCmd(
+		ctx, cli.CmdQueryBlockedAccount(), []string{denom, account1.String()}, &accountResp,
+	))
+	requireT.True(accountResp.Blocked)
+
+	// unblock the account
+	args = append([]string{denom, account1.String()}, txValidator1Args(testNetwork)...)
+	_, err = coreumclitestutil.ExecTxCmd(ctx, testNetwork, cli.CmdTxUnblockAccounts(), args)
+	requireT.NoError(err)
+
+	requireT.NoError(coreumclitestutil.ExecQueryCmd(
+		ctx, cli.CmdQueryBlockedAccount(), []string{denom, account1.String()}, &accountResp,
+	))
+	requireT.False(accountResp.Blocked)
+
+	requireT.NoError(coreumclitestutil.ExecQueryCmd(ctx, cli.CmdQueryBlockedAccounts(), []string{denom}, &accountsResp))
+	requireT.Equal([]string{account2.String()}, accountsResp.Accounts)
+}
+
+func TestCreateVestingSchedule(t *testing.T) {
+	requireT := require.New(t)
+	testNetwork := network.New(t)
+
+	token := types.Token{
+		Symbol:      "btc" + uuid.NewString()[:4],
+		Subunit:     "satoshi" + uuid.NewString()[:4],
+		Precision:   8,
+		Description: "description",
+	}
+
+	ctx := testNetwork.Validators[0].ClientCtx
+	denom := issue(requireT, ctx, token, sdkmath.NewInt(777), testNetwork)
+	account := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	startTime := time.Now().Add(time.Hour).Unix()
+	endTime := time.Now().Add(2 * time.Hour).Unix()
+
+	// create the linear schedule
+	args := append([]string{
+		account.String(), "100" + denom, "linear", fmt.Sprintf("%d", startTime),
+		fmt.Sprintf("--%s=%d", cli.EndTimeFlag, endTime),
+	}, txValidator1Args(testNetwork)...)
+	_, err := coreumclitestutil.ExecTxCmd(ctx, testNetwork, cli.CmdTxCreateVestingSchedule(), args)
+	requireT.NoError(err)
+
+	// create the periodic schedule
+	args = append([]string{
+		account.String(), "50" + denom, "periodic", fmt.Sprintf("%d", startTime),
+		fmt.Sprintf("--%s=%s", cli.PeriodsFlag, "1h:20,1h:30"),
+	}, txValidator1Args(testNetwork)...)
+	_, err = coreumclitestutil.ExecTxCmd(ctx, testNetwork, cli.CmdTxCreateVestingSchedule(), args)
+	requireT.NoError(err)
+
+	var resp types.QueryVestingSchedulesResponse
+	requireT.NoError(coreumclitestutil.ExecQueryCmd(
+		ctx, cli.CmdQueryVestingSchedules(), []string{denom, account.String()}, &resp,
+	))
+	requireT.Len(resp.VestingSchedules, 2)
+	requireT.Equal(types.VestingType_linear, resp.VestingSchedules[0].VestingType)
+	requireT.Equal(types.VestingType_periodic, resp.VestingSchedules[1].VestingType)
+	requireT.Len(resp.VestingSchedules[1].Periods, 2)
+	requireT.Equal(sdkmath.ZeroInt().String(), resp.Vested.String())
+	requireT.Equal(sdkmath.NewInt(150).String(), resp.Unvested.String())
+}
+
+func TestDistribute(t *testing.T) {
+	requireT := require.New(t)
+	testNetwork := network.New(t)
+
+	token := types.Token{
+		Symbol:      "btc" + uuid.NewString()[:4],
+		Subunit:     "satoshi" + uuid.NewString()[:4],
+		Precision:   8,
+		Description: "description",
+	}
+
+	ctx := testNetwork.Validators[0].ClientCtx
+	denom := issue(requireT, ctx, token, sdkmath.NewInt(777), testNetwork)
+
+	// the distribution of the amount exceeding the balance fails
+	args := append([]string{denom, sdk.NewInt64Coin(denom, 1000).String()}, txValidator1Args(testNetwork)...)
+	_, err := coreumclitestutil.ExecTxCmd(ctx, testNetwork, cli.CmdTxDistribute(), args)
+	requireT.Error(err)
+
+	args = append([]string{denom, sdk.NewInt64Coin(denom, 100).String()}, txValidator1Args(testNetwork)...)
+	_, err = coreumclitestutil.ExecTxCmd(ctx, testNetwork, cli.CmdTxDistribute(), args)
+	requireT.NoError(err)
+
+	// the only holder is paid in the end blocker, so the distribution is already completed
+	var distributionResp types.QueryDistributionResponse
+	requireT.Error(coreumclitestutil.ExecQueryCmd(ctx, cli.CmdQueryDistribution(), []string{denom}, &distributionResp))
+
+	var claimableResp types.QueryDistributionClaimableResponse
+	requireT.Error(coreumclitestutil.ExecQueryCmd(
+		ctx, cli.CmdQueryDistributionClaimable(), []string{denom, testNetwork.Validators[0].Address.String()}, &claimableResp,
+	))
+
+	var balanceResp types.QueryBalanceResponse
+	requireT.NoError(coreumclitestutil.ExecQueryCmd(
+		ctx, cli.CmdQueryBalance(), []string{testNetwork.Validators[0].Address.String(), denom}, &balanceResp,
+	))
+	requireT.Equal(sdkmath.NewInt(777).String(), balanceResp.Balance.String())
+}
+
 func TestUpgradeV1(t *testing.T) {
 	requireT := require.New(t)
 	networkCfg, err := config.NetworkConfigByChainID(constant.ChainIDDev)
@@ -311,6 +769,40 @@ func TestUpgradeV1(t *testing.T) {
 	}, txValidator1Args(testNetwork)...)
 	_, err = coreumclitestutil.ExecTxCmd(ctx, testNetwork, cli.CmdTxUpgradeV1(), args)
 	requireT.ErrorIs(err, cosmoserrors.ErrUnauthorized)
+
+	// --ibc-enabled is missing for the generic v1 upgrade
+	args = append([]string{denom, "1"}, txValidator1Args(testNetwork)...)
+	_, err = coreumclitestutil.ExecTxCmd(ctx, testNetwork, cli.CmdTxUpgrade(), args)
+	requireT.Error(err)
+
+	// the generic v1 upgrade fails because the token is already of v1
+	args = append([]string{
+		denom, "1",
+		fmt.Sprintf("--%s=false", cli.IBCEnabledFlag),
+	}, txValidator1Args(testNetwork)...)
+	_, err = coreumclitestutil.ExecTxCmd(ctx, testNetwork, cli.CmdTxUpgrade(), args)
+	requireT.ErrorIs(err, cosmoserrors.ErrUnauthorized)
+
+	// no v2 options provided
+	args = append([]string{denom, "2"}, txValidator1Args(testNetwork)...)
+	_, err = coreumclitestutil.ExecTxCmd(ctx, testNetwork, cli.CmdTxUpgrade(), args)
+	requireT.Error(err)
+
+	// upgrade the token to v2
+	args = append([]string{
+		denom, "2",
+		fmt.Sprintf("--%s=%s", cli.FeaturesFlag, types.Feature_minting.String()),
+		fmt.Sprintf("--%s=0", cli.SendCommissionRateFlag),
+	}, txValidator1Args(testNetwork)...)
+	_, err = coreumclitestutil.ExecTxCmd(ctx, testNetwork, cli.CmdTxUpgrade(), args)
+	requireT.NoError(err)
+
+	var statusesRes types.QueryTokenUpgradeStatusesResponse
+	requireT.NoError(coreumclitestutil.ExecQueryCmd(ctx, cli.CmdTokenUpgradeStatuses(), []string{denom}, &statusesRes))
+	requireT.NotNil(statusesRes.Statuses.V2)
+	requireT.Equal([]types.Feature{types.Feature_minting}, statusesRes.Statuses.V2.Options.Features)
+	requireT.Nil(statusesRes.Statuses.V2.Options.BurnRate)
+	requireT.True(statusesRes.Statuses.V2.Options.SendCommissionRate.IsZero())
 }
 
 func issue(requireT *require.Assertions, ctx client.Context, token types.Token, initialAmount sdkmath.Int, testNetwork *network.Network) string {
@@ -332,6 +824,15 @@ func issue(requireT *require.Assertions, ctx client.Context, token types.Token,
 	if !token.SendCommissionRate.IsNil() {
 		args = append(args, fmt.Sprintf("--%s=%s", cli.SendCommissionRateFlag, token.SendCommissionRate.String()))
 	}
+	if token.MaxSupply != nil {
+		args = append(args, fmt.Sprintf("--%s=%s", cli.MaxSupplyFlag, token.MaxSupply.String()))
+	}
+	if token.MintAllowance != nil {
+		args = append(args,
+			fmt.Sprintf("--%s=%s", cli.MintAllowanceFlag, token.MintAllowance.Amount.String()),
+			fmt.Sprintf("--%s=%s", cli.MintPeriodFlag, token.MintAllowance.Period.String()),
+		)
+	}
 
 	args = append(args, txValidator1Args(testNetwork)...)
 	res, err := coreumclitestutil.ExecTxCmd(ctx, testNetwork, cli.CmdTxIssue(), args)
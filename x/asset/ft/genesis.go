@@ -32,6 +32,10 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 			BurnRate:           token.BurnRate,
 			SendCommissionRate: token.SendCommissionRate,
 			Version:            token.Version,
+			Admin:              token.Admin,
+			Data:               token.Data,
+			MaxSupply:          token.MaxSupply,
+			MintAllowance:      token.MintAllowance,
 		}
 
 		k.SetDefinition(ctx, issuer, subunit, definition)
@@ -67,6 +71,44 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	if err := k.ImportPendingTokenUpgrades(ctx, genState.PendingTokenUpgrades); err != nil {
 		panic(err)
 	}
+
+	// Init scheduled unfreezes
+	if err := k.ImportScheduledUnfreezes(ctx, genState.ScheduledUnfreezes); err != nil {
+		panic(err)
+	}
+
+	// Init rate exemptions
+	if err := k.ImportRateExemptions(ctx, genState.RateExemptions); err != nil {
+		panic(err)
+	}
+
+	// Init mint allowance usages
+	if err := k.ImportMintAllowanceUsages(ctx, genState.MintAllowanceUsages); err != nil {
+		panic(err)
+	}
+
+	// Init distributions
+	if err := k.ImportDistributions(ctx, genState.Distributions, genState.DistributionHolders); err != nil {
+		panic(err)
+	}
+
+	// Init transfer limits
+	if err := k.ImportTransferLimits(ctx, genState.TransferLimits, genState.TransferLimitUsages); err != nil {
+		panic(err)
+	}
+
+	// Init blocked accounts
+	if err := k.ImportBlockedAccounts(ctx, genState.BlockedAccounts); err != nil {
+		panic(err)
+	}
+
+	if err := k.ImportVestingSchedules(ctx, genState.VestingSchedules); err != nil {
+		panic(err)
+	}
+
+	if err := k.ImportPendingRatesUpdates(ctx, genState.PendingRatesUpdates); err != nil {
+		panic(err)
+	}
 }
 
 // ExportGenesis returns the asset module's exported genesis.
@@ -94,11 +136,61 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		panic(err)
 	}
 
+	scheduledUnfreezes, err := k.ExportScheduledUnfreezes(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	rateExemptions, err := k.ExportRateExemptions(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	mintAllowanceUsages, err := k.ExportMintAllowanceUsages(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	distributions, distributionHolders, err := k.ExportDistributions(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	transferLimits, transferLimitUsages, err := k.ExportTransferLimits(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	blockedAccounts, err := k.ExportBlockedAccounts(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	vestingSchedules, err := k.ExportVestingSchedules(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	pendingRatesUpdates, err := k.ExportPendingRatesUpdates(ctx)
+	if err != nil {
+		panic(err)
+	}
+
 	return &types.GenesisState{
 		Params:               k.GetParams(ctx),
 		Tokens:               tokens,
 		FrozenBalances:       frozenBalances,
 		WhitelistedBalances:  whitelistedBalances,
 		PendingTokenUpgrades: pendingTokenUpgrades,
+		ScheduledUnfreezes:   scheduledUnfreezes,
+		RateExemptions:       rateExemptions,
+		MintAllowanceUsages:  mintAllowanceUsages,
+		Distributions:        distributions,
+		DistributionHolders:  distributionHolders,
+		TransferLimits:       transferLimits,
+		TransferLimitUsages:  transferLimitUsages,
+		BlockedAccounts:      blockedAccounts,
+		VestingSchedules:     vestingSchedules,
+		PendingRatesUpdates:  pendingRatesUpdates,
 	}
 }
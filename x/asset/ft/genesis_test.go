@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"math/rand"
 	"testing"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 	"github.com/cometbft/cometbft/crypto/ed25519"
@@ -23,7 +24,8 @@ func TestInitAndExportGenesis(t *testing.T) {
 
 	testApp := simapp.New()
 
-	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+	blockTime := time.Date(2023, 2, 13, 1, 2, 3, 0, time.UTC)
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{}).WithBlockTime(blockTime)
 	ftKeeper := testApp.AssetFTKeeper
 	issuer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
 
@@ -46,13 +48,45 @@ func TestInitAndExportGenesis(t *testing.T) {
 				types.Feature_whitelisting,
 			},
 			Version: i,
+			Admin:   issuer.String(),
 		}
 		// Globally freeze some Tokens.
 		if i%2 == 0 {
 			token.GloballyFrozen = true
 		}
+		// Clear admin of some Tokens.
+		if i%3 == 0 {
+			token.Admin = ""
+		}
+		// Cap the supply of some Tokens.
+		if i%2 == 1 {
+			maxSupply := sdkmath.NewInt(int64(1000 * (i + 1)))
+			token.MaxSupply = &maxSupply
+			token.MintableAmount = &maxSupply
+		}
+		if i == 1 {
+			token.MintAllowance = &types.MintAllowance{
+				Amount: sdkmath.NewInt(100),
+				Period: time.Hour,
+			}
+			// 40 tokens are minted within the current period, look at the mint allowance usages below
+			mintableAmount := sdkmath.NewInt(60)
+			token.MintableAmount = &mintableAmount
+			token.Features = append(token.Features, types.Feature_transfer_limits, types.Feature_blocklisting)
+		}
+		// Schedule the rates increase of some Tokens.
+		if i == 2 {
+			pendingBurnRate := sdk.MustNewDecFromStr("0.5")
+			token.PendingRatesUpdate = &types.PendingRatesUpdate{
+				Denom:         token.Denom,
+				BurnRate:      &pendingBurnRate,
+				EffectiveTime: blockTime.Add(time.Hour),
+			}
+		}
 		tokens = append(tokens, token)
-		requireT.NoError(ftKeeper.SetDenomMetadata(ctx, token.Denom, token.Symbol, token.Description, token.Precision))
+		requireT.NoError(ftKeeper.SetDenomMetadata(
+			ctx, token.Denom, token.Symbol, token.Description, token.URI, token.URIHash, token.Precision,
+		))
 		if i == 0 {
 			pendingTokenUpgrades = append(pendingTokenUpgrades, types.PendingTokenUpgrade{
 				Denom:   token.Denom,
@@ -89,12 +123,140 @@ func TestInitAndExportGenesis(t *testing.T) {
 			})
 	}
 
+	// scheduled unfreezes
+	var scheduledUnfreezes []types.ScheduledUnfreeze
+	for i := 0; i < 5; i++ {
+		addr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+		scheduledUnfreezes = append(scheduledUnfreezes,
+			types.ScheduledUnfreeze{
+				Account:      addr.String(),
+				Coin:         sdk.NewCoin(tokens[0].Denom, sdkmath.NewInt(rand.Int63())),
+				UnfreezeTime: time.Unix(rand.Int63n(1<<32)+1, 0).UTC(),
+			})
+	}
+
+	// rate exemptions
+	var rateExemptions []types.RateExemption
+	for i := 0; i < 5; i++ {
+		addr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+		rateExemptions = append(rateExemptions,
+			types.RateExemption{
+				Denom:   tokens[i%2].Denom,
+				Account: addr.String(),
+			})
+	}
+
+	// mint allowance usages
+	mintAllowanceUsages := []types.MintAllowanceUsage{
+		{
+			Denom:       tokens[1].Denom,
+			PeriodStart: blockTime.Add(-time.Minute),
+			Minted:      sdkmath.NewInt(40),
+		},
+	}
+
+	// distributions
+	distributions := []types.Distribution{
+		{
+			Denom:          tokens[1].Denom,
+			Distributor:    issuer.String(),
+			Pool:           sdk.NewInt64Coin(tokens[2].Denom, 1000),
+			SnapshotHeight: 10,
+			SnapshotSupply: sdkmath.NewInt(5000),
+			Distributed:    sdkmath.NewInt(100),
+			PaidHolders:    2,
+			Phase:          types.DistributionPhase_paying_holders,
+			NextKey:        []byte{0x01, 0x02},
+		},
+	}
+	var distributionHolders []types.DistributionHolder
+	for i := 0; i < 3; i++ {
+		addr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+		distributionHolders = append(distributionHolders,
+			types.DistributionHolder{
+				Denom:   tokens[1].Denom,
+				Account: addr.String(),
+				Balance: sdkmath.NewInt(int64(i * 100)),
+				Paid:    i%2 == 0,
+			})
+	}
+
+	// transfer limits
+	transferLimitedAccount := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	transferLimits := []types.TransferLimit{
+		{
+			Denom:  tokens[1].Denom,
+			Amount: sdkmath.NewInt(100),
+			Period: time.Hour,
+		},
+		{
+			Denom:   tokens[1].Denom,
+			Account: transferLimitedAccount.String(),
+			Amount:  sdkmath.NewInt(200),
+			Period:  2 * time.Hour,
+		},
+	}
+	transferLimitUsages := []types.TransferLimitUsage{
+		{
+			Denom:       tokens[1].Denom,
+			Account:     transferLimitedAccount.String(),
+			WindowStart: blockTime.Add(-time.Minute),
+			Sent:        sdkmath.NewInt(50),
+		},
+	}
+
+	// blocked accounts
+	var blockedAccounts []types.BlockedAccount
+	for i := 0; i < 3; i++ {
+		blockedAccounts = append(blockedAccounts, types.BlockedAccount{
+			Denom:   tokens[1].Denom,
+			Account: sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String(),
+		})
+	}
+
+	// vesting schedules
+	vestingAccount := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	vestingEndTime := blockTime.Add(time.Hour)
+	vestingSchedules := []types.VestingSchedule{
+		{
+			Denom:       tokens[1].Denom,
+			Account:     vestingAccount.String(),
+			VestingType: types.VestingType_cliff,
+			Amount:      sdkmath.NewInt(100),
+			StartTime:   blockTime,
+			EndTime:     &vestingEndTime,
+		},
+		{
+			Denom:       tokens[1].Denom,
+			Account:     vestingAccount.String(),
+			VestingType: types.VestingType_periodic,
+			Amount:      sdkmath.NewInt(50),
+			StartTime:   blockTime,
+			Periods: []types.VestingPeriod{
+				{Length: time.Hour, Amount: sdkmath.NewInt(50)},
+			},
+		},
+	}
+
+	// pending rates updates
+	pendingRatesUpdates := []types.PendingRatesUpdate{*tokens[2].PendingRatesUpdate}
+
 	genState := types.GenesisState{
 		Params:               types.DefaultParams(),
 		Tokens:               tokens,
 		FrozenBalances:       frozenBalances,
 		WhitelistedBalances:  whitelistedBalances,
 		PendingTokenUpgrades: pendingTokenUpgrades,
+		ScheduledUnfreezes:   scheduledUnfreezes,
+		RateExemptions:       rateExemptions,
+		MintAllowanceUsages:  mintAllowanceUsages,
+		Distributions:        distributions,
+		DistributionHolders:  distributionHolders,
+		TransferLimits:       transferLimits,
+		TransferLimitUsages:  transferLimitUsages,
+		BlockedAccounts:      blockedAccounts,
+		VestingSchedules:     vestingSchedules,
+		PendingRatesUpdates:  pendingRatesUpdates,
 	}
 
 	// init the keeper
@@ -141,6 +303,56 @@ func TestInitAndExportGenesis(t *testing.T) {
 		assertT.EqualValues(balance.Coins.String(), coins.String())
 	}
 
+	// scheduled unfreezes
+	for _, scheduledUnfreeze := range scheduledUnfreezes {
+		address, err := sdk.AccAddressFromBech32(scheduledUnfreeze.Account)
+		requireT.NoError(err)
+		storedScheduledUnfreezes, err := ftKeeper.GetScheduledUnfreezes(ctx, address, scheduledUnfreeze.Coin.Denom)
+		requireT.NoError(err)
+		assertT.EqualValues([]types.ScheduledUnfreeze{scheduledUnfreeze}, storedScheduledUnfreezes)
+	}
+
+	// rate exemptions
+	for _, rateExemption := range rateExemptions {
+		requireT.True(ftKeeper.IsRateExempt(ctx, rateExemption.Denom, sdk.MustAccAddressFromBech32(rateExemption.Account)))
+	}
+
+	// distributions
+	for _, distribution := range distributions {
+		storedDistribution, err := ftKeeper.GetDistribution(ctx, distribution.Denom)
+		requireT.NoError(err)
+		assertT.EqualValues(distribution, storedDistribution)
+	}
+
+	// transfer limits
+	transferLimit, sent, err := ftKeeper.GetTransferLimit(ctx, tokens[1].Denom, transferLimitedAccount)
+	requireT.NoError(err)
+	assertT.EqualValues(&transferLimits[1], transferLimit)
+	assertT.EqualValues(sdkmath.NewInt(50).String(), sent.String())
+
+	// blocked accounts
+	for _, blockedAccount := range blockedAccounts {
+		requireT.True(ftKeeper.IsAccountBlocked(
+			ctx, blockedAccount.Denom, sdk.MustAccAddressFromBech32(blockedAccount.Account),
+		))
+	}
+
+	// vesting schedules
+	storedVestingSchedules, err := ftKeeper.GetVestingSchedules(ctx, tokens[1].Denom, vestingAccount)
+	requireT.NoError(err)
+	assertT.EqualValues(vestingSchedules, storedVestingSchedules)
+	lockedAmount, err := ftKeeper.GetVestingLockedAmount(ctx, tokens[1].Denom, vestingAccount)
+	requireT.NoError(err)
+	assertT.Equal(sdkmath.NewInt(150).String(), lockedAmount.String())
+
+	// pending rates updates
+	for _, pendingRatesUpdate := range pendingRatesUpdates {
+		storedPendingRatesUpdate, err := ftKeeper.GetPendingRatesUpdate(ctx, pendingRatesUpdate.Denom)
+		requireT.NoError(err)
+		requireT.NotNil(storedPendingRatesUpdate)
+		assertT.EqualValues(pendingRatesUpdate, *storedPendingRatesUpdate)
+	}
+
 	// check that export is equal import
 	exportedGenState := ft.ExportGenesis(ctx, ftKeeper)
 
@@ -149,4 +361,14 @@ func TestInitAndExportGenesis(t *testing.T) {
 	assertT.ElementsMatch(genState.PendingTokenUpgrades, exportedGenState.PendingTokenUpgrades)
 	assertT.ElementsMatch(genState.FrozenBalances, exportedGenState.FrozenBalances)
 	assertT.ElementsMatch(genState.WhitelistedBalances, exportedGenState.WhitelistedBalances)
+	assertT.ElementsMatch(genState.ScheduledUnfreezes, exportedGenState.ScheduledUnfreezes)
+	assertT.ElementsMatch(genState.RateExemptions, exportedGenState.RateExemptions)
+	assertT.ElementsMatch(genState.MintAllowanceUsages, exportedGenState.MintAllowanceUsages)
+	assertT.ElementsMatch(genState.Distributions, exportedGenState.Distributions)
+	assertT.ElementsMatch(genState.DistributionHolders, exportedGenState.DistributionHolders)
+	assertT.ElementsMatch(genState.TransferLimits, exportedGenState.TransferLimits)
+	assertT.ElementsMatch(genState.TransferLimitUsages, exportedGenState.TransferLimitUsages)
+	assertT.ElementsMatch(genState.BlockedAccounts, exportedGenState.BlockedAccounts)
+	assertT.ElementsMatch(genState.VestingSchedules, exportedGenState.VestingSchedules)
+	assertT.ElementsMatch(genState.PendingRatesUpdates, exportedGenState.PendingRatesUpdates)
 }
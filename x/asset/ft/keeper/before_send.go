@@ -7,9 +7,11 @@ import (
 	sdkmath "cosmossdk.io/math"
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	"github.com/CoreumFoundation/coreum/v3/x/asset/ft/types"
+	assetnfttypes "github.com/CoreumFoundation/coreum/v3/x/asset/nft/types"
 	wibctransfertypes "github.com/CoreumFoundation/coreum/v3/x/wibctransfer/types"
 )
 
@@ -33,6 +35,18 @@ func (k Keeper) BeforeInputOutputCoins(ctx sdk.Context, inputs []banktypes.Input
 	return k.applyFeatures(ctx, inputs[0], outputs)
 }
 
+// BeforeDelegateCoins checks that the delegated coins are not frozen or locked by the vesting schedules or
+// the dex orders. The rates and the transfer limits are not applied to the delegations.
+func (k Keeper) BeforeDelegateCoins(ctx sdk.Context, delegatorAddress sdk.AccAddress, coins sdk.Coins) error {
+	for _, coin := range coins {
+		if err := k.availableBalanceChecks(ctx, delegatorAddress, coin); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 type accountOperationMap map[string]sdkmath.Int
 
 type groupedByDenomAccountOperations map[string]accountOperationMap
@@ -72,29 +86,33 @@ func (k Keeper) applyRules(ctx sdk.Context, input banktypes.Input, outputs group
 	for _, coin := range input.Coins {
 		def, err := k.GetDefinition(ctx, coin.Denom)
 		if types.ErrInvalidDenom.Is(err) || types.ErrTokenNotFound.Is(err) {
+			// the dex orders might lock the coins of any denom
+			if err := k.availableBalanceChecks(ctx, sender, coin); err != nil {
+				return err
+			}
 			continue
 		}
 
 		outOps := outputs[coin.Denom]
 
-		issuer, err := sdk.AccAddressFromBech32(def.Issuer)
-		if err != nil {
-			return sdkerrors.Wrapf(err, "invalid address %s", def.Issuer)
-		}
-
-		burnAmount := k.ApplyRate(ctx, def.BurnRate, issuer, sender, outOps)
-		if err := k.burnIfSpendable(ctx, sender, def, burnAmount); err != nil {
-			return err
+		var admin sdk.AccAddress
+		if def.Admin != "" {
+			admin, err = sdk.AccAddressFromBech32(def.Admin)
+			if err != nil {
+				return sdkerrors.Wrapf(err, "invalid address %s", def.Admin)
+			}
 		}
 
-		commissionAmount := k.ApplyRate(ctx, def.SendCommissionRate, issuer, sender, outOps)
-		commissionCoin := sdk.NewCoins(sdk.NewCoin(def.Denom, commissionAmount))
-		if err := k.bankKeeper.SendCoins(ctx, sender, issuer, commissionCoin); err != nil {
+		if err := k.recordTransferDistributionHolders(ctx, def.Denom, sender, admin, outOps); err != nil {
 			return err
 		}
 
-		if err := k.isCoinSpendable(ctx, sender, def, coin.Amount); err != nil {
-			return err
+		// the marketplace escrow keeps the auction bids only, so they are returned and paid out in full, and
+		// can't be blocked by the admin
+		if !isMarketplaceEscrow(sender) {
+			if err := k.applySenderRules(ctx, def, admin, sender, coin.Amount, outOps); err != nil {
+				return err
+			}
 		}
 
 		if err := iterateMapDeterministic(outOps, func(account string, amount sdkmath.Int) error {
@@ -102,6 +120,9 @@ func (k Keeper) applyRules(ctx sdk.Context, input banktypes.Input, outputs group
 			if err != nil {
 				return sdkerrors.Wrapf(err, "invalid address %s", account)
 			}
+			if isMarketplaceEscrow(accountAddr) {
+				return nil
+			}
 			return k.isCoinReceivable(ctx, accountAddr, def, amount)
 		}); err != nil {
 			return err
@@ -114,8 +135,65 @@ func (k Keeper) applyRules(ctx sdk.Context, input banktypes.Input, outputs group
 	return nil
 }
 
+// applySenderRules charges the rates from the sender and checks that the amount can be sent by the sender.
+func (k Keeper) applySenderRules(
+	ctx sdk.Context,
+	def types.Definition,
+	admin, sender sdk.AccAddress,
+	amount sdkmath.Int,
+	outOps accountOperationMap,
+) error {
+	burnAmount := k.ApplyRate(ctx, def.Denom, def.BurnRate, admin, sender, outOps)
+	if err := k.burnIfSpendable(ctx, sender, def, burnAmount); err != nil {
+		return err
+	}
+
+	commissionAmount := sdkmath.ZeroInt()
+	// the send commission is not charged if the admin is cleared because there is no one to receive it
+	if admin != nil {
+		commissionAmount = k.ApplyRate(ctx, def.Denom, def.SendCommissionRate, admin, sender, outOps)
+		commissionCoin := sdk.NewCoins(sdk.NewCoin(def.Denom, commissionAmount))
+		if err := k.bankKeeper.SendCoins(ctx, sender, admin, commissionCoin); err != nil {
+			return err
+		}
+	}
+
+	if burnAmount.IsPositive() || commissionAmount.IsPositive() {
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventRateApplied{
+			Denom:            def.Denom,
+			Sender:           sender.String(),
+			BurnAmount:       burnAmount,
+			CommissionAmount: commissionAmount,
+		}); err != nil {
+			return sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit EventRateApplied event: %s", err)
+		}
+	}
+
+	if err := k.isCoinSpendable(ctx, sender, def, amount); err != nil {
+		return err
+	}
+
+	if err := k.useTransferLimit(ctx, def, sender, amount); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// isMarketplaceEscrow returns true if the address is the escrow of the asset nft marketplace keeping the auction bids.
+func isMarketplaceEscrow(addr sdk.AccAddress) bool {
+	return addr.Equals(authtypes.NewModuleAddress(assetnfttypes.ModuleName))
+}
+
 // ApplyRate calculates how the burn or commission amount should be calculated.
-func (k Keeper) ApplyRate(ctx sdk.Context, rate sdk.Dec, issuer, sender sdk.AccAddress, outOps accountOperationMap) sdkmath.Int {
+// If the admin is nil, the rate is applied to all the outputs except the ones exempted from the rates.
+func (k Keeper) ApplyRate(
+	ctx sdk.Context,
+	denom string,
+	rate sdk.Dec,
+	admin, sender sdk.AccAddress,
+	outOps accountOperationMap,
+) sdkmath.Int {
 	// We decided that rates should not be charged on incoming IBC transfers.
 	// According to our current protocol, it cannot be done because sender pays the rates, meaning that escrow address
 	// would be charged leading to breaking the IBC mechanics.
@@ -136,9 +214,9 @@ func (k Keeper) ApplyRate(ctx sdk.Context, rate sdk.Dec, issuer, sender sdk.AccA
 	if wibctransfertypes.IsPurposeTimeout(ctx) {
 		return sdk.ZeroInt()
 	}
-	// Since burning & send commissions are not applied when sending to/from token issuer or from any smart contract,
-	// we can't simply apply original burn rate or send commission rates when bank multisend contains issuer or smart contract in
-	// input or issuer in outputs. To recalculate new adjusted amount we exclude amount sent to issuers.
+	// Since burning & send commissions are not applied when sending to/from token admin or from any smart contract,
+	// we can't simply apply original burn rate or send commission rates when bank multisend contains admin or smart contract in
+	// input or admin in outputs. To recalculate new adjusted amount we exclude amount sent to admins.
 
 	// Examples
 	// burn_rate: 10%
@@ -148,7 +226,7 @@ func (k Keeper) ApplyRate(ctx sdk.Context, rate sdk.Dec, issuer, sender sdk.AccA
 
 	// outputs:
 	// 75
-	// 25 <-- issuer
+	// 25 <-- admin
 
 	// In this case commissioned amount is: 75
 	// Expected commission: 75 * 10% = 7.5
@@ -157,7 +235,7 @@ func (k Keeper) ApplyRate(ctx sdk.Context, rate sdk.Dec, issuer, sender sdk.AccA
 		return sdk.ZeroInt()
 	}
 
-	if sender.String() == issuer.String() {
+	if admin != nil && sender.String() == admin.String() {
 		return sdk.ZeroInt()
 	}
 
@@ -166,10 +244,19 @@ func (k Keeper) ApplyRate(ctx sdk.Context, rate sdk.Dec, issuer, sender sdk.AccA
 		return sdk.ZeroInt()
 	}
 
+	// we do not apply burn and commission rate if sender is exempted by the admin.
+	if k.IsRateExempt(ctx, denom, sender) {
+		return sdk.ZeroInt()
+	}
+
 	taxableOutputSum := sdk.NewInt(0)
-	issuerStr := issuer.String()
+	adminStr := admin.String()
 	for account, amount := range outOps {
-		if account == issuerStr {
+		if account == adminStr {
+			continue
+		}
+		accountAddr, err := sdk.AccAddressFromBech32(account)
+		if err == nil && k.IsRateExempt(ctx, denom, accountAddr) {
 			continue
 		}
 		taxableOutputSum = taxableOutputSum.Add(amount)
@@ -6,8 +6,8 @@ import (
 
 	sdkmath "cosmossdk.io/math"
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
-	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/assert"
 
@@ -57,6 +57,8 @@ func TestApplyRate(t *testing.T) {
 
 	issuer := genAccount()
 	dummyAddress := genAccount()
+	exemptAccount := genAccount()
+	denom := types.BuildDenom("abc", sdk.MustAccAddressFromBech32(issuer))
 	key := sdk.NewKVStoreKey(types.StoreKey)
 	assetFTKeeper := assetftkeeper.NewKeeper(nil, key, nil, nil, wasmKeeper, "")
 
@@ -96,6 +98,25 @@ func TestApplyRate(t *testing.T) {
 			},
 			appliedRate: sdkmath.NewInt(100),
 		},
+		{
+			name:   "exempt_sender",
+			rate:   "0.1",
+			sender: exemptAccount,
+			receivers: map[string]sdkmath.Int{
+				accounts[10]: sdkmath.NewInt(1000),
+			},
+			appliedRate: sdkmath.ZeroInt(),
+		},
+		{
+			name:   "exempt_receiver",
+			rate:   "0.1",
+			sender: accounts[0],
+			receivers: map[string]sdkmath.Int{
+				exemptAccount: sdkmath.NewInt(1000),
+				accounts[10]:  sdkmath.NewInt(1000),
+			},
+			appliedRate: sdkmath.NewInt(100),
+		},
 		{
 			name:   "one_receiver_with_rounding",
 			rate:   "0.1",
@@ -241,7 +262,10 @@ func TestApplyRate(t *testing.T) {
 		tc := tc
 		t.Run(tc.name, func(t *testing.T) {
 			assertT := assert.New(t)
-			ctx := sdk.NewContext(nil, tmproto.Header{}, false, nil)
+			ctx := testutil.DefaultContext(key, sdk.NewTransientStoreKey("transient_test"))
+			assertT.NoError(assetFTKeeper.ImportRateExemptions(ctx, []types.RateExemption{
+				{Denom: denom, Account: exemptAccount},
+			}))
 
 			if tc.ibcDirection != "" {
 				ctx = wibctransfertypes.WithPurpose(ctx, tc.ibcDirection)
@@ -249,6 +273,7 @@ func TestApplyRate(t *testing.T) {
 
 			appliedRate := assetFTKeeper.ApplyRate(
 				ctx,
+				denom,
 				sdk.MustNewDecFromStr(tc.rate),
 				sdk.MustAccAddressFromBech32(issuer),
 				sdk.MustAccAddressFromBech32(tc.sender),
@@ -0,0 +1,146 @@
+package keeper
+
+import (
+	"bytes"
+
+	sdkerrors "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/CoreumFoundation/coreum/v3/x/asset/ft/types"
+)
+
+// BlockAccounts adds the accounts to the blocklist of the token, so they can't send and receive it.
+func (k Keeper) BlockAccounts(ctx sdk.Context, sender sdk.AccAddress, denom string, addrs []sdk.AccAddress) error {
+	def, err := k.GetDefinition(ctx, denom)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "not able to get token info for denom:%s", denom)
+	}
+
+	if err := def.CheckFeatureAllowed(sender, types.Feature_blocklisting); err != nil {
+		return err
+	}
+
+	for _, addr := range addrs {
+		if def.IsAdmin(addr) {
+			return sdkerrors.Wrap(cosmoserrors.ErrUnauthorized, "admin can't be blocked")
+		}
+
+		k.setBlockedAccount(ctx, denom, addr)
+
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventAccountBlocked{
+			Denom:   denom,
+			Account: addr.String(),
+		}); err != nil {
+			return sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit EventAccountBlocked event: %s", err)
+		}
+	}
+
+	return nil
+}
+
+// UnblockAccounts removes the accounts from the blocklist of the token.
+func (k Keeper) UnblockAccounts(ctx sdk.Context, sender sdk.AccAddress, denom string, addrs []sdk.AccAddress) error {
+	def, err := k.GetDefinition(ctx, denom)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "not able to get token info for denom:%s", denom)
+	}
+
+	if err := def.CheckFeatureAllowed(sender, types.Feature_blocklisting); err != nil {
+		return err
+	}
+
+	for _, addr := range addrs {
+		if !k.IsAccountBlocked(ctx, denom, addr) {
+			return sdkerrors.Wrapf(types.ErrInvalidInput, "account %s is not blocked for %s", addr, denom)
+		}
+
+		ctx.KVStore(k.storeKey).Delete(types.CreateBlockedAccountKey(denom, addr))
+
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventAccountUnblocked{
+			Denom:   denom,
+			Account: addr.String(),
+		}); err != nil {
+			return sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit EventAccountUnblocked event: %s", err)
+		}
+	}
+
+	return nil
+}
+
+// IsAccountBlocked returns true if the account is blocked from sending and receiving the token.
+func (k Keeper) IsAccountBlocked(ctx sdk.Context, denom string, addr sdk.AccAddress) bool {
+	return bytes.Equal(ctx.KVStore(k.storeKey).Get(types.CreateBlockedAccountKey(denom, addr)), types.StoreTrue)
+}
+
+// GetBlockedAccounts returns the accounts blocked from sending and receiving the token.
+func (k Keeper) GetBlockedAccounts(
+	ctx sdk.Context,
+	denom string,
+	pagination *query.PageRequest,
+) ([]string, *query.PageResponse, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.CreateBlockedAccountsPrefix(denom))
+	accounts := make([]string, 0)
+	pageRes, err := query.Paginate(store, pagination, func(key, _ []byte) error {
+		accounts = append(accounts, sdk.AccAddress(key).String())
+		return nil
+	})
+	if err != nil {
+		return nil, nil, sdkerrors.Wrapf(types.ErrInvalidInput, "failed to paginate: %s", err)
+	}
+
+	return accounts, pageRes, nil
+}
+
+// ImportBlockedAccounts imports the blocked accounts from genesis state.
+func (k Keeper) ImportBlockedAccounts(ctx sdk.Context, blockedAccounts []types.BlockedAccount) error {
+	for _, blockedAccount := range blockedAccounts {
+		addr, err := sdk.AccAddressFromBech32(blockedAccount.Account)
+		if err != nil {
+			return sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid account address")
+		}
+		k.setBlockedAccount(ctx, blockedAccount.Denom, addr)
+	}
+	return nil
+}
+
+// ExportBlockedAccounts exports the blocked accounts.
+func (k Keeper) ExportBlockedAccounts(ctx sdk.Context) ([]types.BlockedAccount, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BlockedAccountsKeyPrefix)
+	blockedAccounts := make([]types.BlockedAccount, 0)
+	_, err := query.Paginate(store, &query.PageRequest{Limit: query.MaxLimit}, func(key, _ []byte) error {
+		denom, addr, err := types.DenomAndAddressFromBlockedAccountKey(key)
+		if err != nil {
+			return err
+		}
+		blockedAccounts = append(blockedAccounts, types.BlockedAccount{
+			Denom:   denom,
+			Account: addr.String(),
+		})
+
+		return nil
+	})
+	if err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidInput, "failed to paginate: %s", err)
+	}
+
+	return blockedAccounts, nil
+}
+
+func (k Keeper) setBlockedAccount(ctx sdk.Context, denom string, addr sdk.AccAddress) {
+	ctx.KVStore(k.storeKey).Set(types.CreateBlockedAccountKey(denom, addr), types.StoreTrue)
+}
+
+func (k Keeper) blocklistChecks(ctx sdk.Context, def types.Definition, addr sdk.AccAddress) error {
+	if !def.IsFeatureEnabled(types.Feature_blocklisting) || def.IsAdmin(addr) {
+		return nil
+	}
+
+	if k.IsAccountBlocked(ctx, def.Denom, addr) {
+		return sdkerrors.Wrapf(types.ErrAccountBlocked, "account %s is blocked for %s", addr, def.Denom)
+	}
+
+	return nil
+}
@@ -0,0 +1,131 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreum/v3/testutil/event"
+	"github.com/CoreumFoundation/coreum/v3/testutil/simapp"
+	"github.com/CoreumFoundation/coreum/v3/x/asset/ft/types"
+)
+
+func TestKeeper_Blocklist(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+
+	ftKeeper := testApp.AssetFTKeeper
+	bankKeeper := testApp.BankKeeper
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	denom, err := ftKeeper.Issue(ctx, types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "DEF",
+		Subunit:       "def",
+		Precision:     1,
+		InitialAmount: sdkmath.NewInt(1000),
+		Features: []types.Feature{
+			types.Feature_minting,
+			types.Feature_blocklisting,
+		},
+	})
+	requireT.NoError(err)
+
+	unblockableDenom, err := ftKeeper.Issue(ctx, types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "ABC",
+		Subunit:       "abc",
+		Precision:     1,
+		InitialAmount: sdkmath.NewInt(1000),
+	})
+	requireT.NoError(err)
+
+	blocked1 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	blocked2 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	recipient := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	requireT.NoError(bankKeeper.SendCoins(ctx, issuer, blocked1, sdk.NewCoins(sdk.NewInt64Coin(denom, 100))))
+
+	// the feature is disabled
+	err = ftKeeper.BlockAccounts(ctx, issuer, unblockableDenom, []sdk.AccAddress{blocked1})
+	requireT.ErrorIs(err, types.ErrFeatureDisabled)
+
+	// try to block the accounts from non admin account
+	err = ftKeeper.BlockAccounts(ctx, blocked1, denom, []sdk.AccAddress{blocked2})
+	requireT.ErrorIs(err, cosmoserrors.ErrUnauthorized)
+
+	// the admin can't be blocked
+	err = ftKeeper.BlockAccounts(ctx, issuer, denom, []sdk.AccAddress{blocked1, issuer})
+	requireT.ErrorIs(err, cosmoserrors.ErrUnauthorized)
+
+	// block the accounts
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	requireT.NoError(ftKeeper.BlockAccounts(ctx, issuer, denom, []sdk.AccAddress{blocked1, blocked2}))
+	requireT.True(ftKeeper.IsAccountBlocked(ctx, denom, blocked1))
+	requireT.True(ftKeeper.IsAccountBlocked(ctx, denom, blocked2))
+	requireT.False(ftKeeper.IsAccountBlocked(ctx, denom, recipient))
+
+	blockedEvents, err := event.FindTypedEvents[*types.EventAccountBlocked](ctx.EventManager().Events().ToABCIEvents())
+	requireT.NoError(err)
+	requireT.Equal([]*types.EventAccountBlocked{
+		{Denom: denom, Account: blocked1.String()},
+		{Denom: denom, Account: blocked2.String()},
+	}, blockedEvents)
+
+	blockedAccounts, _, err := ftKeeper.GetBlockedAccounts(ctx, denom, nil)
+	requireT.NoError(err)
+	requireT.ElementsMatch([]string{blocked1.String(), blocked2.String()}, blockedAccounts)
+
+	// the blocked account can't send the token
+	err = bankKeeper.SendCoins(ctx, blocked1, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 10)))
+	requireT.ErrorIs(err, types.ErrAccountBlocked)
+
+	// the blocked account can't receive the token
+	err = bankKeeper.SendCoins(ctx, issuer, blocked2, sdk.NewCoins(sdk.NewInt64Coin(denom, 10)))
+	requireT.ErrorIs(err, types.ErrAccountBlocked)
+	err = ftKeeper.Mint(ctx, issuer, blocked2, sdk.NewInt64Coin(denom, 10))
+	requireT.ErrorIs(err, types.ErrAccountBlocked)
+
+	// other denoms are not affected
+	requireT.NoError(bankKeeper.SendCoins(ctx, issuer, blocked1, sdk.NewCoins(sdk.NewInt64Coin(unblockableDenom, 10))))
+	requireT.NoError(bankKeeper.SendCoins(ctx, blocked1, recipient, sdk.NewCoins(sdk.NewInt64Coin(unblockableDenom, 10))))
+
+	// other accounts are not affected
+	requireT.NoError(bankKeeper.SendCoins(ctx, issuer, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 10))))
+
+	// the account which is not blocked can't be unblocked
+	err = ftKeeper.UnblockAccounts(ctx, issuer, denom, []sdk.AccAddress{recipient})
+	requireT.ErrorIs(err, types.ErrInvalidInput)
+
+	// try to unblock the accounts from non admin account
+	err = ftKeeper.UnblockAccounts(ctx, blocked1, denom, []sdk.AccAddress{blocked1})
+	requireT.ErrorIs(err, cosmoserrors.ErrUnauthorized)
+
+	// unblock the account
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	requireT.NoError(ftKeeper.UnblockAccounts(ctx, issuer, denom, []sdk.AccAddress{blocked1}))
+	requireT.False(ftKeeper.IsAccountBlocked(ctx, denom, blocked1))
+
+	unblockedEvents, err := event.FindTypedEvents[*types.EventAccountUnblocked](ctx.EventManager().Events().ToABCIEvents())
+	requireT.NoError(err)
+	requireT.Equal([]*types.EventAccountUnblocked{{Denom: denom, Account: blocked1.String()}}, unblockedEvents)
+
+	requireT.NoError(bankKeeper.SendCoins(ctx, blocked1, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 10))))
+	requireT.NoError(bankKeeper.SendCoins(ctx, issuer, blocked1, sdk.NewCoins(sdk.NewInt64Coin(denom, 10))))
+
+	blockedAccounts, _, err = ftKeeper.GetBlockedAccounts(ctx, denom, nil)
+	requireT.NoError(err)
+	requireT.Equal([]string{blocked2.String()}, blockedAccounts)
+
+	// the blocked accounts are exported
+	exported, err := ftKeeper.ExportBlockedAccounts(ctx)
+	requireT.NoError(err)
+	requireT.Equal([]types.BlockedAccount{{Denom: denom, Account: blocked2.String()}}, exported)
+}
@@ -0,0 +1,107 @@
+package keeper
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/CoreumFoundation/coreum/v3/x/asset/ft/types"
+)
+
+// DEXLock locks the coin on the account, so it can't be spent until it is unlocked by the dex module.
+// The coin might be of any denom, not only the fungible token issued by this module.
+func (k Keeper) DEXLock(ctx sdk.Context, addr sdk.AccAddress, coin sdk.Coin) error {
+	if !coin.IsPositive() {
+		return sdkerrors.Wrap(cosmoserrors.ErrInvalidCoins, "lock amount should be positive")
+	}
+
+	// the locked coin must be spendable, so the fungible token rules (e.g. freezing) are applied too
+	if err := k.DEXCheckSpendable(ctx, addr, coin); err != nil {
+		return err
+	}
+
+	lockedStore := k.dexLockedAccountBalanceStore(ctx, addr)
+	lockedStore.SetBalance(lockedStore.Balance(coin.Denom).Add(coin))
+
+	return nil
+}
+
+// ImportDEXLock locks the coin on the account without checking if it is spendable. It is used by the dex module
+// to restore the locks of the orders imported from the genesis.
+func (k Keeper) ImportDEXLock(ctx sdk.Context, addr sdk.AccAddress, coin sdk.Coin) error {
+	if !coin.IsPositive() {
+		return sdkerrors.Wrap(cosmoserrors.ErrInvalidCoins, "lock amount should be positive")
+	}
+
+	lockedStore := k.dexLockedAccountBalanceStore(ctx, addr)
+	lockedStore.SetBalance(lockedStore.Balance(coin.Denom).Add(coin))
+
+	return nil
+}
+
+// DEXUnlock unlocks the coin previously locked by the dex module.
+func (k Keeper) DEXUnlock(ctx sdk.Context, addr sdk.AccAddress, coin sdk.Coin) error {
+	if !coin.IsPositive() {
+		return sdkerrors.Wrap(cosmoserrors.ErrInvalidCoins, "unlock amount should be positive")
+	}
+
+	lockedStore := k.dexLockedAccountBalanceStore(ctx, addr)
+	lockedBalance := lockedStore.Balance(coin.Denom)
+	if !lockedBalance.IsGTE(coin) {
+		return sdkerrors.Wrapf(types.ErrInvalidState,
+			"unlock request %s is greater than the locked balance %s", coin, lockedBalance)
+	}
+	lockedStore.SetBalance(lockedBalance.Sub(coin))
+
+	return nil
+}
+
+// DEXCheckSpendable checks that the account can spend the coin, so the dex module can reject the order before
+// it is matched. The amount which is frozen or locked by the vesting schedules or the dex orders can't be spent.
+// The coin might be of any denom, not only the fungible token issued by this module.
+func (k Keeper) DEXCheckSpendable(ctx sdk.Context, addr sdk.AccAddress, coin sdk.Coin) error {
+	def, err := k.GetDefinition(ctx, coin.Denom)
+	switch {
+	case err == nil:
+		return k.isCoinSpendable(ctx, addr, def, coin.Amount)
+	case types.ErrInvalidDenom.Is(err) || types.ErrTokenNotFound.Is(err):
+		return k.availableBalanceChecks(ctx, addr, coin)
+	default:
+		return err
+	}
+}
+
+// DEXCheckReceivable checks that the account can receive the coin, so the dex module can find out if the order
+// execution fails because of the account. The coin might be of any denom, not only the fungible token issued
+// by this module.
+func (k Keeper) DEXCheckReceivable(ctx sdk.Context, addr sdk.AccAddress, coin sdk.Coin) error {
+	def, err := k.GetDefinition(ctx, coin.Denom)
+	switch {
+	case err == nil:
+		return k.isCoinReceivable(ctx, addr, def, coin.Amount)
+	case types.ErrInvalidDenom.Is(err) || types.ErrTokenNotFound.Is(err):
+		return nil
+	default:
+		return err
+	}
+}
+
+// GetDEXLockedBalance returns the balance of a denom locked by the dex orders of the account.
+func (k Keeper) GetDEXLockedBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin {
+	return k.dexLockedAccountBalanceStore(ctx, addr).Balance(denom)
+}
+
+// capDEXLock reduces the amount locked by the dex orders if it is greater than the balance, e.g. after the clawback.
+// The dex orders which can't be executed because of it are closed by the dex module once they are matched.
+func (k Keeper) capDEXLock(ctx sdk.Context, addr sdk.AccAddress, denom string) {
+	lockedStore := k.dexLockedAccountBalanceStore(ctx, addr)
+	balance := k.bankKeeper.GetBalance(ctx, addr, denom)
+	if balance.IsLT(lockedStore.Balance(denom)) {
+		lockedStore.SetBalance(balance)
+	}
+}
+
+// dexLockedAccountBalanceStore gets the store for the balances of an account locked by the dex orders.
+func (k Keeper) dexLockedAccountBalanceStore(ctx sdk.Context, addr sdk.AccAddress) balanceStore {
+	return newBalanceStore(k.cdc, ctx.KVStore(k.storeKey), types.CreateDEXLockedBalancesKey(addr))
+}
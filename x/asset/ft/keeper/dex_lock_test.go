@@ -0,0 +1,202 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreum/v3/testutil/simapp"
+	"github.com/CoreumFoundation/coreum/v3/x/asset/ft/keeper"
+	"github.com/CoreumFoundation/coreum/v3/x/asset/ft/types"
+)
+
+func TestKeeper_DEXLock(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+
+	ftKeeper := testApp.AssetFTKeeper
+	bankKeeper := testApp.BankKeeper
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	denom, err := ftKeeper.Issue(ctx, types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "DEF",
+		Subunit:       "def",
+		Precision:     1,
+		InitialAmount: sdkmath.NewInt(1000),
+		Features: []types.Feature{
+			types.Feature_burning,
+			types.Feature_freezing,
+			types.Feature_clawback,
+		},
+	})
+	requireT.NoError(err)
+
+	account := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	recipient := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	requireT.NoError(bankKeeper.SendCoins(ctx, issuer, account, sdk.NewCoins(sdk.NewInt64Coin(denom, 100))))
+	requireT.NoError(testApp.FundAccount(ctx, account, sdk.NewCoins(sdk.NewInt64Coin("uaaa", 100))))
+
+	// the frozen coins can't be locked
+	requireT.NoError(ftKeeper.Freeze(ctx, issuer, account, sdk.NewInt64Coin(denom, 30)))
+	requireT.ErrorIs(ftKeeper.DEXLock(ctx, account, sdk.NewInt64Coin(denom, 80)), cosmoserrors.ErrInsufficientFunds)
+	requireT.NoError(ftKeeper.DEXLock(ctx, account, sdk.NewInt64Coin(denom, 60)))
+	requireT.ErrorIs(ftKeeper.DEXLock(ctx, account, sdk.NewInt64Coin(denom, 20)), cosmoserrors.ErrInsufficientFunds)
+
+	// the coins of any denom might be locked
+	requireT.ErrorIs(ftKeeper.DEXLock(ctx, account, sdk.NewInt64Coin("uaaa", 101)), cosmoserrors.ErrInsufficientFunds)
+	requireT.NoError(ftKeeper.DEXLock(ctx, account, sdk.NewInt64Coin("uaaa", 70)))
+
+	// the locked coins can't be sent or burnt
+	requireT.ErrorIs(
+		bankKeeper.SendCoins(ctx, account, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 20))),
+		cosmoserrors.ErrInsufficientFunds,
+	)
+	requireT.ErrorIs(
+		bankKeeper.SendCoins(ctx, account, recipient, sdk.NewCoins(sdk.NewInt64Coin("uaaa", 40))),
+		cosmoserrors.ErrInsufficientFunds,
+	)
+	requireT.ErrorIs(ftKeeper.Burn(ctx, account, sdk.NewInt64Coin(denom, 20)), cosmoserrors.ErrInsufficientFunds)
+	requireT.NoError(bankKeeper.SendCoins(ctx, account, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 10))))
+	requireT.NoError(bankKeeper.SendCoins(ctx, account, recipient, sdk.NewCoins(sdk.NewInt64Coin("uaaa", 30))))
+
+	// the unlocked coins might be sent
+	requireT.ErrorIs(ftKeeper.DEXUnlock(ctx, account, sdk.NewInt64Coin(denom, 61)), types.ErrInvalidState)
+	requireT.NoError(ftKeeper.DEXUnlock(ctx, account, sdk.NewInt64Coin(denom, 60)))
+	requireT.True(ftKeeper.GetDEXLockedBalance(ctx, account, denom).IsZero())
+	requireT.NoError(bankKeeper.SendCoins(ctx, account, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 60))))
+	requireT.Equal(sdk.NewInt64Coin("uaaa", 70).String(), ftKeeper.GetDEXLockedBalance(ctx, account, "uaaa").String())
+}
+
+func TestKeeper_DEXLockDelegation(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+
+	ftKeeper := testApp.AssetFTKeeper
+	bankKeeper := testApp.BankKeeper
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	denom, err := ftKeeper.Issue(ctx, types.IssueSettings{
+		Issuer:             issuer,
+		Symbol:             "DEF",
+		Subunit:            "def",
+		Precision:          1,
+		InitialAmount:      sdkmath.NewInt(1000),
+		Features:           []types.Feature{types.Feature_freezing},
+		BurnRate:           sdk.MustNewDecFromStr("0.1"),
+		SendCommissionRate: sdk.MustNewDecFromStr("0.1"),
+	})
+	requireT.NoError(err)
+
+	account := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	requireT.NoError(bankKeeper.SendCoins(ctx, issuer, account, sdk.NewCoins(sdk.NewInt64Coin(denom, 100))))
+	requireT.NoError(ftKeeper.DEXLock(ctx, account, sdk.NewInt64Coin(denom, 30)))
+	requireT.NoError(ftKeeper.Freeze(ctx, issuer, account, sdk.NewInt64Coin(denom, 20)))
+
+	// the locked and frozen coins can't be delegated
+	requireT.ErrorIs(bankKeeper.DelegateCoinsFromAccountToModule(
+		ctx, account, stakingtypes.BondedPoolName, sdk.NewCoins(sdk.NewInt64Coin(denom, 51)),
+	), cosmoserrors.ErrInsufficientFunds)
+
+	// the rates are not charged on the delegation
+	requireT.NoError(bankKeeper.DelegateCoinsFromAccountToModule(
+		ctx, account, stakingtypes.BondedPoolName, sdk.NewCoins(sdk.NewInt64Coin(denom, 50)),
+	))
+	requireT.Equal(sdk.NewInt64Coin(denom, 50).String(), bankKeeper.GetBalance(ctx, account, denom).String())
+}
+
+func TestKeeper_DEXLockClawback(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+
+	ftKeeper := testApp.AssetFTKeeper
+	bankKeeper := testApp.BankKeeper
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	denom, err := ftKeeper.Issue(ctx, types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "DEF",
+		Subunit:       "def",
+		Precision:     1,
+		InitialAmount: sdkmath.NewInt(1000),
+		Features:      []types.Feature{types.Feature_clawback},
+	})
+	requireT.NoError(err)
+
+	account := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	requireT.NoError(bankKeeper.SendCoins(ctx, issuer, account, sdk.NewCoins(sdk.NewInt64Coin(denom, 100))))
+	requireT.NoError(ftKeeper.DEXLock(ctx, account, sdk.NewInt64Coin(denom, 80)))
+
+	// the locked coins might be clawed back, and the lock is reduced to the remaining balance
+	requireT.NoError(ftKeeper.Clawback(ctx, issuer, account, sdk.NewInt64Coin(denom, 50)))
+	requireT.Equal(sdk.NewInt64Coin(denom, 50).String(), ftKeeper.GetDEXLockedBalance(ctx, account, denom).String())
+
+	requireT.NoError(ftKeeper.Clawback(ctx, issuer, account, sdk.NewInt64Coin(denom, 50)))
+	requireT.True(ftKeeper.GetDEXLockedBalance(ctx, account, denom).IsZero())
+	requireT.True(bankKeeper.GetBalance(ctx, account, denom).IsZero())
+}
+
+func TestKeeper_DEXLockQueries(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+
+	ftKeeper := testApp.AssetFTKeeper
+	bankKeeper := testApp.BankKeeper
+	queryService := keeper.NewQueryService(ftKeeper, bankKeeper)
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	denom, err := ftKeeper.Issue(ctx, types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "DEF",
+		Subunit:       "def",
+		Precision:     1,
+		InitialAmount: sdkmath.NewInt(1000),
+	})
+	requireT.NoError(err)
+
+	account := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	requireT.NoError(bankKeeper.SendCoins(ctx, issuer, account, sdk.NewCoins(sdk.NewInt64Coin(denom, 100))))
+	requireT.NoError(ftKeeper.DEXLock(ctx, account, sdk.NewInt64Coin(denom, 30)))
+
+	// the amount locked by the dex orders is reported as locked
+	balanceRes, err := queryService.Balance(sdk.WrapSDKContext(ctx), &types.QueryBalanceRequest{
+		Account: account.String(),
+		Denom:   denom,
+	})
+	requireT.NoError(err)
+	requireT.Equal(sdkmath.NewInt(100).String(), balanceRes.Balance.String())
+	requireT.Equal(sdkmath.NewInt(30).String(), balanceRes.Locked.String())
+
+	holdersRes, err := queryService.Holders(sdk.WrapSDKContext(ctx), &types.QueryHoldersRequest{Denom: denom})
+	requireT.NoError(err)
+	requireT.ElementsMatch([]types.Holder{
+		{
+			Account:     issuer.String(),
+			Balance:     sdkmath.NewInt(900),
+			Whitelisted: sdkmath.ZeroInt(),
+			Frozen:      sdkmath.ZeroInt(),
+			Locked:      sdkmath.ZeroInt(),
+		},
+		{
+			Account:     account.String(),
+			Balance:     sdkmath.NewInt(100),
+			Whitelisted: sdkmath.ZeroInt(),
+			Frozen:      sdkmath.ZeroInt(),
+			Locked:      sdkmath.NewInt(30),
+		},
+	}, holdersRes.Holders)
+}
@@ -0,0 +1,659 @@
+package keeper
+
+import (
+	"sort"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/CoreumFoundation/coreum/v3/x/asset/ft/types"
+)
+
+// Distribute starts the distribution of the pool to the holders of the token proportionally to their balances at
+// the current height.
+func (k Keeper) Distribute(ctx sdk.Context, sender sdk.AccAddress, denom string, amount sdk.Coin) error {
+	if !amount.IsPositive() {
+		return sdkerrors.Wrap(types.ErrInvalidInput, "distributed amount should be positive")
+	}
+
+	if _, err := k.adminChecks(ctx, sender, denom); err != nil {
+		return err
+	}
+
+	if _, found, err := k.getDistribution(ctx, denom); err != nil {
+		return err
+	} else if found {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "distribution of %s is already in progress", denom)
+	}
+
+	supply := k.bankKeeper.GetSupply(ctx, denom)
+	if !supply.IsPositive() {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "there are no holders of %s", denom)
+	}
+
+	// the bank keeper used by the module doesn't call the asset hooks, so the frozen checks are applied and the
+	// holders of the pool denom are recorded here
+	if err := k.recordDistributionHolders(
+		ctx, amount.Denom, sender, authtypes.NewModuleAddress(types.ModuleName),
+	); err != nil {
+		return err
+	}
+	if poolDef, err := k.GetDefinition(ctx, amount.Denom); err == nil {
+		if err := k.isCoinSpendable(ctx, sender, poolDef, amount.Amount); err != nil {
+			return sdkerrors.Wrapf(err, "coins are not spendable")
+		}
+	} else if !types.ErrInvalidDenom.Is(err) && !types.ErrTokenNotFound.Is(err) {
+		return err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, sdk.NewCoins(amount)); err != nil {
+		return sdkerrors.Wrapf(err, "can't send the distributed amount to the module %s", types.ModuleName)
+	}
+
+	distribution := types.Distribution{
+		Denom:          denom,
+		Distributor:    sender.String(),
+		Pool:           amount,
+		SnapshotHeight: ctx.BlockHeight(),
+		SnapshotSupply: supply.Amount,
+		Distributed:    sdkmath.ZeroInt(),
+		Phase:          types.DistributionPhase_paying_holders,
+	}
+	if err := k.setDistribution(ctx, distribution); err != nil {
+		return err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventDistributionStarted{
+		Denom:          denom,
+		Distributor:    sender.String(),
+		Pool:           amount,
+		SnapshotHeight: distribution.SnapshotHeight,
+		SnapshotSupply: distribution.SnapshotSupply,
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit EventDistributionStarted event: %s", err)
+	}
+
+	return nil
+}
+
+// GetDistribution returns the distribution in progress for the token.
+func (k Keeper) GetDistribution(ctx sdk.Context, denom string) (types.Distribution, error) {
+	distribution, found, err := k.getDistribution(ctx, denom)
+	if err != nil {
+		return types.Distribution{}, err
+	}
+	if !found {
+		return types.Distribution{}, sdkerrors.Wrapf(types.ErrDistributionNotFound, "denom: %s", denom)
+	}
+
+	return distribution, nil
+}
+
+// GetDistributionClaimable returns the amount the account is going to receive from the distribution in progress.
+func (k Keeper) GetDistributionClaimable(ctx sdk.Context, denom string, addr sdk.AccAddress) (sdk.Coin, error) {
+	distribution, err := k.GetDistribution(ctx, denom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	holder, found, err := k.getDistributionHolder(ctx, denom, addr)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	balance := sdkmath.ZeroInt()
+	switch {
+	case found && !holder.Paid:
+		balance = holder.Balance
+	// balances which are not recorded yet are still equal to the ones at the snapshot height
+	case !found && distribution.Phase == types.DistributionPhase_paying_holders:
+		balance = k.bankKeeper.GetBalance(ctx, addr, denom).Amount
+	}
+
+	return sdk.NewCoin(distribution.Pool.Denom, k.distributionShare(distribution, addr, balance)), nil
+}
+
+// ProcessDistributions pays the holders of the distributions in progress, processing at most
+// MaxDistributionOperationsPerBlock holders in one block. The processing starts from the distribution following the
+// last one processed in the previous block, so the distributions in progress are processed in turns.
+// The distribution which fails is not retried, its state changes are reverted and it is completed returning the
+// undistributed part of the pool to the distributor.
+func (k Keeper) ProcessDistributions(ctx sdk.Context) error {
+	var distributions []types.Distribution
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DistributionKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var distribution types.Distribution
+		if err := k.cdc.Unmarshal(iterator.Value(), &distribution); err != nil {
+			return sdkerrors.Wrapf(types.ErrInvalidState, "failed to unmarshal distribution: %s", err)
+		}
+		distributions = append(distributions, distribution)
+	}
+	if len(distributions) == 0 {
+		ctx.KVStore(k.storeKey).Delete(types.NextDistributionKey)
+		return nil
+	}
+
+	// the distributions are sorted by denom, so the processing is resumed from the first one not preceding the denom
+	// stored in the previous block
+	first := 0
+	if next := ctx.KVStore(k.storeKey).Get(types.NextDistributionKey); next != nil {
+		first = sort.Search(len(distributions), func(i int) bool {
+			return distributions[i].Denom >= string(next)
+		}) % len(distributions)
+	}
+
+	budget := uint64(k.GetParams(ctx).MaxDistributionOperationsPerBlock)
+	processed := 0
+	for ; processed < len(distributions) && budget > 0; processed++ {
+		distribution := distributions[(first+processed)%len(distributions)]
+		cacheCtx, writeCache := ctx.CacheContext()
+		used, err := k.processDistribution(cacheCtx, distribution, budget)
+		if err != nil {
+			k.logger(ctx).Error("distribution can't be processed", "denom", distribution.Denom, "error", err)
+			if err := k.failDistribution(ctx, distribution); err != nil {
+				return err
+			}
+			continue
+		}
+		writeCache()
+		budget -= used
+	}
+
+	ctx.KVStore(k.storeKey).Set(
+		types.NextDistributionKey, []byte(distributions[(first+processed)%len(distributions)].Denom),
+	)
+
+	return nil
+}
+
+// failDistribution stops paying the holders of the distribution which can't be processed, so it is completed in the
+// next blocks returning the undistributed part of the pool to the distributor.
+func (k Keeper) failDistribution(ctx sdk.Context, distribution types.Distribution) error {
+	if distribution.Phase == types.DistributionPhase_cleaning_up {
+		return nil
+	}
+
+	distribution.Phase = types.DistributionPhase_cleaning_up
+	distribution.NextKey = nil
+
+	return k.setDistribution(ctx, distribution)
+}
+
+// ImportDistributions imports the distributions and the recorded balances of their holders from genesis state.
+func (k Keeper) ImportDistributions(
+	ctx sdk.Context,
+	distributions []types.Distribution,
+	distributionHolders []types.DistributionHolder,
+) error {
+	for _, distribution := range distributions {
+		if err := k.setDistribution(ctx, distribution); err != nil {
+			return err
+		}
+	}
+	for _, holder := range distributionHolders {
+		if err := k.setDistributionHolder(ctx, holder); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ExportDistributions exports the distributions and the recorded balances of their holders.
+func (k Keeper) ExportDistributions(ctx sdk.Context) ([]types.Distribution, []types.DistributionHolder, error) {
+	distributions := make([]types.Distribution, 0)
+	_, err := query.Paginate(
+		prefix.NewStore(ctx.KVStore(k.storeKey), types.DistributionKeyPrefix),
+		&query.PageRequest{Limit: query.MaxLimit},
+		func(_, value []byte) error {
+			var distribution types.Distribution
+			if err := k.cdc.Unmarshal(value, &distribution); err != nil {
+				return sdkerrors.Wrapf(types.ErrInvalidState, "failed to unmarshal distribution: %s", err)
+			}
+			distributions = append(distributions, distribution)
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, nil, sdkerrors.Wrapf(types.ErrInvalidInput, "failed to paginate: %s", err)
+	}
+
+	holders := make([]types.DistributionHolder, 0)
+	_, err = query.Paginate(
+		prefix.NewStore(ctx.KVStore(k.storeKey), types.DistributionHolderKeyPrefix),
+		&query.PageRequest{Limit: query.MaxLimit},
+		func(_, value []byte) error {
+			var holder types.DistributionHolder
+			if err := k.cdc.Unmarshal(value, &holder); err != nil {
+				return sdkerrors.Wrapf(types.ErrInvalidState, "failed to unmarshal distribution holder: %s", err)
+			}
+			holders = append(holders, holder)
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, nil, sdkerrors.Wrapf(types.ErrInvalidInput, "failed to paginate: %s", err)
+	}
+
+	return distributions, holders, nil
+}
+
+// recordTransferDistributionHolders records the balances of the accounts affected by the transfer of the token
+// before they are changed.
+func (k Keeper) recordTransferDistributionHolders(
+	ctx sdk.Context,
+	denom string,
+	sender, admin sdk.AccAddress,
+	outOps accountOperationMap,
+) error {
+	// the admin receives the send commission
+	holders := []sdk.AccAddress{sender, admin}
+	if err := iterateMapDeterministic(outOps, func(account string, _ sdkmath.Int) error {
+		addr, err := sdk.AccAddressFromBech32(account)
+		if err != nil {
+			return sdkerrors.Wrapf(err, "invalid address %s", account)
+		}
+		holders = append(holders, addr)
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	return k.recordDistributionHolders(ctx, denom, holders...)
+}
+
+// recordDistributionHolders records the balances the accounts had at the snapshot height of the distribution in
+// progress before the balances are changed for the first time.
+func (k Keeper) recordDistributionHolders(ctx sdk.Context, denom string, addrs ...sdk.AccAddress) error {
+	distribution, found, err := k.getDistribution(ctx, denom)
+	if err != nil {
+		return err
+	}
+	// balances are recorded only while the current holders are paid, later on all the holders having the balance
+	// at the snapshot height are already recorded
+	if !found || distribution.Phase != types.DistributionPhase_paying_holders {
+		return nil
+	}
+
+	for _, addr := range addrs {
+		if addr == nil {
+			continue
+		}
+		if err := k.recordDistributionHolder(ctx, denom, addr); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (k Keeper) recordDistributionHolder(ctx sdk.Context, denom string, addr sdk.AccAddress) error {
+	if _, found, err := k.getDistributionHolder(ctx, denom, addr); err != nil || found {
+		return err
+	}
+
+	return k.setDistributionHolder(ctx, types.DistributionHolder{
+		Denom:   denom,
+		Account: addr.String(),
+		Balance: k.bankKeeper.GetBalance(ctx, addr, denom).Amount,
+	})
+}
+
+func (k Keeper) processDistribution(ctx sdk.Context, distribution types.Distribution, budget uint64) (uint64, error) {
+	var used uint64
+	for used < budget {
+		var (
+			processed uint64
+			err       error
+		)
+		switch distribution.Phase {
+		case types.DistributionPhase_paying_holders:
+			processed, err = k.payDistributionHolders(ctx, &distribution, budget-used)
+		case types.DistributionPhase_paying_recorded_holders:
+			processed, err = k.payRecordedDistributionHolders(ctx, &distribution, budget-used)
+		case types.DistributionPhase_cleaning_up:
+			var completed bool
+			processed, completed, err = k.cleanUpDistribution(ctx, distribution, budget-used)
+			if err != nil {
+				return 0, err
+			}
+			if completed {
+				return used + processed, nil
+			}
+			// the clean-up is continued in the next block
+			return used + processed, k.setDistribution(ctx, distribution)
+		default:
+			return 0, sdkerrors.Wrapf(types.ErrInvalidState, "unknown distribution phase %s", distribution.Phase)
+		}
+		if err != nil {
+			return 0, err
+		}
+		used += processed
+	}
+
+	return used, k.setDistribution(ctx, distribution)
+}
+
+// payDistributionHolders pays the current holders of the token, using the recorded balances if they were changed
+// after the snapshot height.
+func (k Keeper) payDistributionHolders(
+	ctx sdk.Context,
+	distribution *types.Distribution,
+	limit uint64,
+) (uint64, error) {
+	res, err := k.bankKeeper.DenomOwners(sdk.WrapSDKContext(ctx), &banktypes.QueryDenomOwnersRequest{
+		Denom: distribution.Denom,
+		Pagination: &query.PageRequest{
+			Key:   distribution.NextKey,
+			Limit: limit,
+		},
+	})
+	if err != nil {
+		return 0, sdkerrors.Wrapf(types.ErrInvalidState, "failed to get the holders of %s: %s", distribution.Denom, err)
+	}
+
+	for _, owner := range res.DenomOwners {
+		addr, err := sdk.AccAddressFromBech32(owner.Address)
+		if err != nil {
+			return 0, sdkerrors.Wrapf(types.ErrInvalidState, "invalid holder address %s", owner.Address)
+		}
+
+		holder, found, err := k.getDistributionHolder(ctx, distribution.Denom, addr)
+		if err != nil {
+			return 0, err
+		}
+		if found && holder.Paid {
+			continue
+		}
+		if !found {
+			holder = types.DistributionHolder{
+				Denom:   distribution.Denom,
+				Account: owner.Address,
+				Balance: owner.Balance.Amount,
+			}
+		}
+		if err := k.payDistributionHolder(ctx, distribution, holder); err != nil {
+			return 0, err
+		}
+	}
+
+	distribution.NextKey = nil
+	if res.Pagination != nil {
+		distribution.NextKey = res.Pagination.NextKey
+	}
+	if distribution.NextKey == nil {
+		distribution.Phase = types.DistributionPhase_paying_recorded_holders
+	}
+
+	return uint64(len(res.DenomOwners)), nil
+}
+
+// payRecordedDistributionHolders pays the holders which had the balance at the snapshot height but were not holders
+// anymore when the current holders were paid.
+func (k Keeper) payRecordedDistributionHolders(
+	ctx sdk.Context,
+	distribution *types.Distribution,
+	limit uint64,
+) (uint64, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.CreateDistributionHoldersPrefix(distribution.Denom))
+	iterator := store.Iterator(distribution.NextKey, nil)
+	defer iterator.Close()
+
+	var processed uint64
+	for ; iterator.Valid(); iterator.Next() {
+		if processed == limit {
+			distribution.NextKey = iterator.Key()
+			return processed, nil
+		}
+		processed++
+
+		var holder types.DistributionHolder
+		if err := k.cdc.Unmarshal(iterator.Value(), &holder); err != nil {
+			return 0, sdkerrors.Wrapf(types.ErrInvalidState, "failed to unmarshal distribution holder: %s", err)
+		}
+		if holder.Paid {
+			continue
+		}
+		if err := k.payDistributionHolder(ctx, distribution, holder); err != nil {
+			return 0, err
+		}
+	}
+
+	distribution.NextKey = nil
+	distribution.Phase = types.DistributionPhase_cleaning_up
+
+	return processed, nil
+}
+
+// cleanUpDistribution removes the recorded balances and completes the distribution returning the undistributed
+// part of the pool to the distributor once all of them are removed.
+func (k Keeper) cleanUpDistribution(
+	ctx sdk.Context,
+	distribution types.Distribution,
+	limit uint64,
+) (uint64, bool, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.CreateDistributionHoldersPrefix(distribution.Denom))
+	iterator := store.Iterator(nil, nil)
+	var keys [][]byte
+	for ; iterator.Valid() && uint64(len(keys)) < limit; iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	hasMore := iterator.Valid()
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+	if hasMore {
+		return uint64(len(keys)), false, nil
+	}
+
+	returned := k.refundDistribution(ctx, distribution)
+	ctx.KVStore(k.storeKey).Delete(types.CreateDistributionKey(distribution.Denom))
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventDistributionCompleted{
+		Denom:       distribution.Denom,
+		Distributor: distribution.Distributor,
+		Distributed: sdk.NewCoin(distribution.Pool.Denom, distribution.Distributed),
+		Returned:    returned,
+		PaidHolders: distribution.PaidHolders,
+	}); err != nil {
+		return 0, false, sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit EventDistributionCompleted event: %s", err)
+	}
+
+	return uint64(len(keys)), true, nil
+}
+
+// refundDistribution returns the undistributed part of the pool to the distributor. If it can't be returned, it is
+// left in the module account, so the distribution is completed anyway and not retried forever.
+func (k Keeper) refundDistribution(ctx sdk.Context, distribution types.Distribution) sdk.Coin {
+	returned := sdk.NewCoin(distribution.Pool.Denom, distributionEscrow(distribution))
+	if !returned.IsPositive() {
+		return returned
+	}
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	if err := k.sendDistributionFunds(cacheCtx, distribution.Distributor, returned); err != nil {
+		k.logger(ctx).Error(
+			"failed to return the undistributed amount", "denom", distribution.Denom, "error", err,
+		)
+		return sdk.NewCoin(distribution.Pool.Denom, sdkmath.ZeroInt())
+	}
+	writeCache()
+
+	return returned
+}
+
+// payDistributionHolder sends the share of the pool to the holder and marks it as paid. If the share can't be
+// received by the holder, e.g. because of the whitelisting or freezing of the distributed denom, it is returned to
+// the distributor when the distribution is completed.
+func (k Keeper) payDistributionHolder(
+	ctx sdk.Context,
+	distribution *types.Distribution,
+	holder types.DistributionHolder,
+) error {
+	addr, err := sdk.AccAddressFromBech32(holder.Account)
+	if err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "invalid holder address %s", holder.Account)
+	}
+
+	holder.Paid = true
+	if err := k.setDistributionHolder(ctx, holder); err != nil {
+		return err
+	}
+
+	share := k.distributionShare(*distribution, addr, holder.Balance)
+	// the holder is never paid more than the part of the pool left in the escrow of the distribution, so the
+	// funds of the other distributions held by the module account are never used
+	if escrow := distributionEscrow(*distribution); share.GT(escrow) {
+		share = escrow
+	}
+	if !share.IsPositive() {
+		return nil
+	}
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	if err := k.sendDistributionShare(cacheCtx, addr, sdk.NewCoin(distribution.Pool.Denom, share)); err != nil {
+		k.logger(ctx).Info(
+			"distribution share is not receivable", "denom", distribution.Denom, "account", holder.Account, "error", err,
+		)
+		return nil
+	}
+	writeCache()
+
+	distribution.Distributed = distribution.Distributed.Add(share)
+	distribution.PaidHolders++
+
+	return nil
+}
+
+// sendDistributionShare sends the share to the holder. The bank keeper used by the module doesn't call the asset hooks,
+// so the freezing and whitelisting rules of the paid token are checked here.
+func (k Keeper) sendDistributionShare(ctx sdk.Context, addr sdk.AccAddress, share sdk.Coin) error {
+	if err := k.recordDistributionHolders(
+		ctx, share.Denom, authtypes.NewModuleAddress(types.ModuleName), addr,
+	); err != nil {
+		return err
+	}
+
+	def, err := k.GetDefinition(ctx, share.Denom)
+	switch {
+	case types.ErrInvalidDenom.Is(err) || types.ErrTokenNotFound.Is(err):
+	case err != nil:
+		return err
+	default:
+		if err := k.isCoinSpendable(ctx, authtypes.NewModuleAddress(types.ModuleName), def, share.Amount); err != nil {
+			return sdkerrors.Wrapf(err, "coins are not spendable")
+		}
+		if err := k.isCoinReceivable(ctx, addr, def, share.Amount); err != nil {
+			return sdkerrors.Wrapf(err, "coins are not receivable")
+		}
+	}
+
+	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, sdk.NewCoins(share))
+}
+
+// sendDistributionFunds returns the coins to the distributor, recording its balance and the one of the module account
+// for the distribution of the returned denom in progress.
+func (k Keeper) sendDistributionFunds(ctx sdk.Context, distributor string, coin sdk.Coin) error {
+	addr, err := sdk.AccAddressFromBech32(distributor)
+	if err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "invalid distributor address %s", distributor)
+	}
+
+	if err := k.recordDistributionHolders(
+		ctx, coin.Denom, authtypes.NewModuleAddress(types.ModuleName), addr,
+	); err != nil {
+		return err
+	}
+
+	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, sdk.NewCoins(coin))
+}
+
+// distributionEscrow returns the part of the pool held by the module account for the distribution.
+func distributionEscrow(distribution types.Distribution) sdkmath.Int {
+	return distribution.Pool.Amount.Sub(distribution.Distributed)
+}
+
+// distributionShare returns the part of the pool the holder having the balance at the snapshot height receives.
+func (k Keeper) distributionShare(
+	distribution types.Distribution,
+	addr sdk.AccAddress,
+	balance sdkmath.Int,
+) sdkmath.Int {
+	// the module account holds the pool, so it is not paid
+	if addr.Equals(authtypes.NewModuleAddress(types.ModuleName)) {
+		return sdkmath.ZeroInt()
+	}
+
+	return distribution.Pool.Amount.Mul(balance).Quo(distribution.SnapshotSupply)
+}
+
+func (k Keeper) getDistribution(ctx sdk.Context, denom string) (types.Distribution, bool, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.CreateDistributionKey(denom))
+	if bz == nil {
+		return types.Distribution{}, false, nil
+	}
+
+	var distribution types.Distribution
+	if err := k.cdc.Unmarshal(bz, &distribution); err != nil {
+		return types.Distribution{}, false, sdkerrors.Wrapf(
+			types.ErrInvalidState, "failed to unmarshal distribution: %s", err,
+		)
+	}
+
+	return distribution, true, nil
+}
+
+func (k Keeper) setDistribution(ctx sdk.Context, distribution types.Distribution) error {
+	bz, err := k.cdc.Marshal(&distribution)
+	if err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "failed to marshal distribution: %s", err)
+	}
+
+	ctx.KVStore(k.storeKey).Set(types.CreateDistributionKey(distribution.Denom), bz)
+
+	return nil
+}
+
+func (k Keeper) getDistributionHolder(
+	ctx sdk.Context,
+	denom string,
+	addr sdk.AccAddress,
+) (types.DistributionHolder, bool, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.CreateDistributionHolderKey(denom, addr))
+	if bz == nil {
+		return types.DistributionHolder{}, false, nil
+	}
+
+	var holder types.DistributionHolder
+	if err := k.cdc.Unmarshal(bz, &holder); err != nil {
+		return types.DistributionHolder{}, false, sdkerrors.Wrapf(
+			types.ErrInvalidState, "failed to unmarshal distribution holder: %s", err,
+		)
+	}
+
+	return holder, true, nil
+}
+
+func (k Keeper) setDistributionHolder(ctx sdk.Context, holder types.DistributionHolder) error {
+	addr, err := sdk.AccAddressFromBech32(holder.Account)
+	if err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "invalid holder address %s", holder.Account)
+	}
+
+	bz, err := k.cdc.Marshal(&holder)
+	if err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "failed to marshal distribution holder: %s", err)
+	}
+
+	ctx.KVStore(k.storeKey).Set(types.CreateDistributionHolderKey(holder.Denom, addr), bz)
+
+	return nil
+}
@@ -0,0 +1,352 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreum/v3/testutil/simapp"
+	"github.com/CoreumFoundation/coreum/v3/x/asset/ft/types"
+)
+
+func TestKeeper_Distribution(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{Height: 10})
+
+	ftKeeper := testApp.AssetFTKeeper
+	bankKeeper := testApp.BankKeeper
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	denom, err := ftKeeper.Issue(ctx, types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "DEF",
+		Subunit:       "def",
+		Precision:     1,
+		InitialAmount: sdkmath.NewInt(1000),
+	})
+	requireT.NoError(err)
+
+	payoutDenom, err := ftKeeper.Issue(ctx, types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "PAY",
+		Subunit:       "pay",
+		Precision:     1,
+		InitialAmount: sdkmath.NewInt(10000),
+		Features:      []types.Feature{types.Feature_whitelisting},
+	})
+	requireT.NoError(err)
+
+	holder1 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	holder2 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	holder3 := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	requireT.NoError(bankKeeper.SendCoins(ctx, issuer, holder1, sdk.NewCoins(sdk.NewInt64Coin(denom, 300))))
+	requireT.NoError(bankKeeper.SendCoins(ctx, issuer, holder2, sdk.NewCoins(sdk.NewInt64Coin(denom, 100))))
+
+	// only holder1 is allowed to receive the payout denom
+	requireT.NoError(ftKeeper.SetWhitelistedBalance(ctx, issuer, holder1, sdk.NewInt64Coin(payoutDenom, 1000)))
+
+	pool := sdk.NewInt64Coin(payoutDenom, 1000)
+
+	// try to distribute from non admin account
+	err = ftKeeper.Distribute(ctx, holder1, denom, pool)
+	requireT.ErrorIs(err, cosmoserrors.ErrUnauthorized)
+
+	// try to query the distribution which doesn't exist
+	_, err = ftKeeper.GetDistribution(ctx, denom)
+	requireT.ErrorIs(err, types.ErrDistributionNotFound)
+
+	requireT.NoError(ftKeeper.Distribute(ctx, issuer, denom, pool))
+
+	// try to start another distribution of the same token
+	err = ftKeeper.Distribute(ctx, issuer, denom, pool)
+	requireT.ErrorIs(err, types.ErrInvalidInput)
+
+	distribution, err := ftKeeper.GetDistribution(ctx, denom)
+	requireT.NoError(err)
+	requireT.Equal(types.Distribution{
+		Denom:          denom,
+		Distributor:    issuer.String(),
+		Pool:           pool,
+		SnapshotHeight: 10,
+		SnapshotSupply: sdkmath.NewInt(1000),
+		Distributed:    sdkmath.ZeroInt(),
+		Phase:          types.DistributionPhase_paying_holders,
+	}, distribution)
+
+	// change the balances after the snapshot
+	requireT.NoError(bankKeeper.SendCoins(ctx, holder1, holder3, sdk.NewCoins(sdk.NewInt64Coin(denom, 300))))
+	requireT.NoError(bankKeeper.SendCoins(ctx, issuer, holder2, sdk.NewCoins(sdk.NewInt64Coin(denom, 50))))
+
+	// the claimable amounts are based on the balances at the snapshot height
+	assertClaimable := func(expected map[*sdk.AccAddress]int64) {
+		for addr, amount := range expected {
+			claimable, err := ftKeeper.GetDistributionClaimable(ctx, denom, *addr)
+			requireT.NoError(err)
+			requireT.Equal(sdk.NewInt64Coin(payoutDenom, amount).String(), claimable.String())
+		}
+	}
+	assertClaimable(map[*sdk.AccAddress]int64{
+		&issuer:  600,
+		&holder1: 300,
+		&holder2: 100,
+		&holder3: 0,
+	})
+
+	requireT.NoError(ftKeeper.ProcessDistributions(ctx))
+
+	// the distribution is completed
+	_, err = ftKeeper.GetDistribution(ctx, denom)
+	requireT.ErrorIs(err, types.ErrDistributionNotFound)
+
+	distributions, holders, err := ftKeeper.ExportDistributions(ctx)
+	requireT.NoError(err)
+	requireT.Empty(distributions)
+	requireT.Empty(holders)
+
+	// holder2 is not whitelisted, so its share is returned to the issuer
+	ba := newBankAsserter(ctx, t, bankKeeper)
+	ba.assertCoinDistribution(payoutDenom, map[*sdk.AccAddress]int64{
+		&issuer:  9700,
+		&holder1: 300,
+		&holder2: 0,
+		&holder3: 0,
+	})
+}
+
+func TestKeeper_DistributionAcrossBlocks(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+
+	ftKeeper := testApp.AssetFTKeeper
+	bankKeeper := testApp.BankKeeper
+
+	maxOperations := 20
+	params := ftKeeper.GetParams(ctx)
+	params.MaxDistributionOperationsPerBlock = uint32(maxOperations)
+	requireT.NoError(ftKeeper.SetParams(ctx, params))
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	denom, err := ftKeeper.Issue(ctx, types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "DEF",
+		Subunit:       "def",
+		Precision:     1,
+		InitialAmount: sdkmath.NewInt(1000),
+	})
+	requireT.NoError(err)
+
+	payoutDenom, err := ftKeeper.Issue(ctx, types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "PAY",
+		Subunit:       "pay",
+		Precision:     1,
+		InitialAmount: sdkmath.NewInt(2000),
+	})
+	requireT.NoError(err)
+
+	holdersCount := maxOperations + maxOperations/2
+	holders := make([]sdk.AccAddress, 0, holdersCount)
+	for i := 0; i < holdersCount; i++ {
+		holder := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+		requireT.NoError(bankKeeper.SendCoins(ctx, issuer, holder, sdk.NewCoins(sdk.NewInt64Coin(denom, 2))))
+		holders = append(holders, holder)
+	}
+
+	requireT.NoError(ftKeeper.Distribute(ctx, issuer, denom, sdk.NewInt64Coin(payoutDenom, 1000)))
+
+	// first block pays only part of the holders
+	requireT.NoError(ftKeeper.ProcessDistributions(ctx))
+	distribution, err := ftKeeper.GetDistribution(ctx, denom)
+	requireT.NoError(err)
+	requireT.Equal(types.DistributionPhase_paying_holders, distribution.Phase)
+	requireT.Equal(uint64(maxOperations), distribution.PaidHolders)
+
+	distributions, distributionHolders, err := ftKeeper.ExportDistributions(ctx)
+	requireT.NoError(err)
+	requireT.Equal([]types.Distribution{distribution}, distributions)
+	requireT.Len(distributionHolders, maxOperations)
+
+	blocks := 1
+	for {
+		if _, err := ftKeeper.GetDistribution(ctx, denom); err != nil {
+			requireT.ErrorIs(err, types.ErrDistributionNotFound)
+			break
+		}
+		requireT.NoError(ftKeeper.ProcessDistributions(ctx))
+		blocks++
+		requireT.Less(blocks, 10)
+	}
+	requireT.Greater(blocks, 2)
+
+	// each holder had 2 out of 1000 tokens
+	for _, holder := range holders {
+		requireT.Equal(sdkmath.NewInt(2).String(), bankKeeper.GetBalance(ctx, holder, payoutDenom).Amount.String())
+	}
+	requireT.Equal(
+		sdkmath.NewInt(2000-2*int64(holdersCount)).String(),
+		bankKeeper.GetBalance(ctx, issuer, payoutDenom).Amount.String(),
+	)
+}
+
+func TestKeeper_DistributionsInTurns(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+
+	ftKeeper := testApp.AssetFTKeeper
+	bankKeeper := testApp.BankKeeper
+
+	maxOperations := 5
+	params := ftKeeper.GetParams(ctx)
+	params.MaxDistributionOperationsPerBlock = uint32(maxOperations)
+	requireT.NoError(ftKeeper.SetParams(ctx, params))
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	var denoms []string
+	for _, subunit := range []string{"abc", "def"} {
+		denom, err := ftKeeper.Issue(ctx, types.IssueSettings{
+			Issuer:        issuer,
+			Symbol:        subunit,
+			Subunit:       subunit,
+			Precision:     1,
+			InitialAmount: sdkmath.NewInt(1000),
+		})
+		requireT.NoError(err)
+		denoms = append(denoms, denom)
+	}
+	payoutDenom, err := ftKeeper.Issue(ctx, types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "PAY",
+		Subunit:       "pay",
+		Precision:     1,
+		InitialAmount: sdkmath.NewInt(2000),
+	})
+	requireT.NoError(err)
+
+	for _, denom := range denoms {
+		for i := 0; i < 3*maxOperations; i++ {
+			holder := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+			requireT.NoError(bankKeeper.SendCoins(ctx, issuer, holder, sdk.NewCoins(sdk.NewInt64Coin(denom, 2))))
+		}
+		requireT.NoError(ftKeeper.Distribute(ctx, issuer, denom, sdk.NewInt64Coin(payoutDenom, 1000)))
+	}
+
+	// the distributions are processed in turns, so the first one doesn't use the whole budget of every block
+	assertPaidHolders := func(expected ...uint64) {
+		for i, denom := range denoms {
+			distribution, err := ftKeeper.GetDistribution(ctx, denom)
+			requireT.NoError(err)
+			requireT.Equal(expected[i], distribution.PaidHolders)
+		}
+	}
+	requireT.NoError(ftKeeper.ProcessDistributions(ctx))
+	assertPaidHolders(uint64(maxOperations), 0)
+	requireT.NoError(ftKeeper.ProcessDistributions(ctx))
+	assertPaidHolders(uint64(maxOperations), uint64(maxOperations))
+	requireT.NoError(ftKeeper.ProcessDistributions(ctx))
+	assertPaidHolders(uint64(2*maxOperations), uint64(maxOperations))
+}
+
+func TestKeeper_DistributionFailure(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+
+	ftKeeper := testApp.AssetFTKeeper
+	bankKeeper := testApp.BankKeeper
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	denom, err := ftKeeper.Issue(ctx, types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "DEF",
+		Subunit:       "def",
+		Precision:     1,
+		InitialAmount: sdkmath.NewInt(1000),
+	})
+	requireT.NoError(err)
+	payoutDenom, err := ftKeeper.Issue(ctx, types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "PAY",
+		Subunit:       "pay",
+		Precision:     1,
+		InitialAmount: sdkmath.NewInt(2000),
+	})
+	requireT.NoError(err)
+
+	holder := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	requireT.NoError(bankKeeper.SendCoins(ctx, issuer, holder, sdk.NewCoins(sdk.NewInt64Coin(denom, 500))))
+	requireT.NoError(ftKeeper.Distribute(ctx, issuer, denom, sdk.NewInt64Coin(payoutDenom, 1000)))
+
+	// break the distribution, so it can't be processed
+	distribution, err := ftKeeper.GetDistribution(ctx, denom)
+	requireT.NoError(err)
+	distribution.Phase = types.DistributionPhase(100)
+	requireT.NoError(ftKeeper.ImportDistributions(ctx, []types.Distribution{distribution}, nil))
+
+	// the failed distribution is not retried, the holders are not paid and the pool is returned to the distributor
+	requireT.NoError(ftKeeper.ProcessDistributions(ctx))
+	distribution, err = ftKeeper.GetDistribution(ctx, denom)
+	requireT.NoError(err)
+	requireT.Equal(types.DistributionPhase_cleaning_up, distribution.Phase)
+
+	requireT.NoError(ftKeeper.ProcessDistributions(ctx))
+	_, err = ftKeeper.GetDistribution(ctx, denom)
+	requireT.ErrorIs(err, types.ErrDistributionNotFound)
+
+	ba := newBankAsserter(ctx, t, bankKeeper)
+	ba.assertCoinDistribution(payoutDenom, map[*sdk.AccAddress]int64{
+		&issuer: 2000,
+		&holder: 0,
+	})
+}
+
+func TestKeeper_DistributionOfPoolDenom(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+
+	ftKeeper := testApp.AssetFTKeeper
+	bankKeeper := testApp.BankKeeper
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	issue := func(subunit string) string {
+		denom, err := ftKeeper.Issue(ctx, types.IssueSettings{
+			Issuer:        issuer,
+			Symbol:        subunit,
+			Subunit:       subunit,
+			Precision:     1,
+			InitialAmount: sdkmath.NewInt(1000),
+		})
+		requireT.NoError(err)
+		return denom
+	}
+	// the distribution of the first denom is processed first
+	denom := issue("aaa")
+	poolDenom := issue("pay")
+	payoutDenom := issue("zzz")
+
+	holder := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	requireT.NoError(bankKeeper.SendCoins(ctx, issuer, holder, sdk.NewCoins(sdk.NewInt64Coin(denom, 500))))
+
+	// the holder doesn't hold the pool denom at the snapshot height of its distribution
+	requireT.NoError(ftKeeper.Distribute(ctx, issuer, poolDenom, sdk.NewInt64Coin(payoutDenom, 1000)))
+	requireT.NoError(ftKeeper.Distribute(ctx, issuer, denom, sdk.NewInt64Coin(poolDenom, 100)))
+
+	// the payout of the pool denom records the balance of the holder before it is changed
+	requireT.NoError(ftKeeper.ProcessDistributions(ctx))
+	requireT.Equal(sdkmath.NewInt(50).String(), bankKeeper.GetBalance(ctx, holder, poolDenom).Amount.String())
+	requireT.True(bankKeeper.GetBalance(ctx, holder, payoutDenom).IsZero())
+}
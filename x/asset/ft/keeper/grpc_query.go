@@ -4,9 +4,11 @@ import (
 	"context"
 
 	sdkerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	"github.com/CoreumFoundation/coreum/v3/x/asset/ft/types"
 )
@@ -19,8 +21,23 @@ type QueryKeeper interface {
 	GetIssuerTokens(ctx sdk.Context, issuer sdk.AccAddress, pagination *query.PageRequest) ([]types.Token, *query.PageResponse, error)
 	GetToken(ctx sdk.Context, denom string) (types.Token, error)
 	GetTokenUpgradeStatuses(ctx sdk.Context, denom string) types.TokenUpgradeStatuses
+	GetRateExemptions(ctx sdk.Context, denom string, pagination *query.PageRequest) ([]string, *query.PageResponse, error)
+	GetBlockedAccounts(ctx sdk.Context, denom string, pagination *query.PageRequest) ([]string, *query.PageResponse, error)
+	IsAccountBlocked(ctx sdk.Context, denom string, addr sdk.AccAddress) bool
+	GetTransferLimits(
+		ctx sdk.Context,
+		denom string,
+		pagination *query.PageRequest,
+	) ([]types.TransferLimit, *query.PageResponse, error)
+	GetTransferLimit(ctx sdk.Context, denom string, addr sdk.AccAddress) (*types.TransferLimit, sdkmath.Int, error)
+	GetDistribution(ctx sdk.Context, denom string) (types.Distribution, error)
+	GetDistributionClaimable(ctx sdk.Context, denom string, addr sdk.AccAddress) (sdk.Coin, error)
 	GetFrozenBalances(ctx sdk.Context, addr sdk.AccAddress, pagination *query.PageRequest) (sdk.Coins, *query.PageResponse, error)
 	GetFrozenBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
+	GetScheduledUnfreezes(ctx sdk.Context, addr sdk.AccAddress, denom string) ([]types.ScheduledUnfreeze, error)
+	GetVestingSchedules(ctx sdk.Context, denom string, addr sdk.AccAddress) ([]types.VestingSchedule, error)
+	GetVestingLockedAmount(ctx sdk.Context, denom string, addr sdk.AccAddress) (sdkmath.Int, error)
+	GetDEXLockedBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
 	GetWhitelistedBalances(ctx sdk.Context, addr sdk.AccAddress, pagination *query.PageRequest) (sdk.Coins, *query.PageResponse, error)
 	GetWhitelistedBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
 }
@@ -29,6 +46,7 @@ type QueryKeeper interface {
 type BankKeeper interface {
 	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
 	LockedCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+	DenomOwners(goCtx context.Context, req *banktypes.QueryDenomOwnersRequest) (*banktypes.QueryDenomOwnersResponse, error)
 }
 
 // QueryService serves grpc query requests for assets module.
@@ -90,6 +108,192 @@ func (qs QueryService) TokenUpgradeStatuses(ctx context.Context, req *types.Quer
 	}, nil
 }
 
+// RateExemptions returns the accounts exempted from the rates of a specified denom.
+func (qs QueryService) RateExemptions(goCtx context.Context, req *types.QueryRateExemptionsRequest) (*types.QueryRateExemptionsResponse, error) {
+	accounts, pageRes, err := qs.keeper.GetRateExemptions(sdk.UnwrapSDKContext(goCtx), req.Denom, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryRateExemptionsResponse{
+		Accounts:   accounts,
+		Pagination: pageRes,
+	}, nil
+}
+
+// BlockedAccounts returns the accounts blocked from sending and receiving a specified denom.
+func (qs QueryService) BlockedAccounts(goCtx context.Context, req *types.QueryBlockedAccountsRequest) (*types.QueryBlockedAccountsResponse, error) {
+	accounts, pageRes, err := qs.keeper.GetBlockedAccounts(sdk.UnwrapSDKContext(goCtx), req.Denom, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryBlockedAccountsResponse{
+		Accounts:   accounts,
+		Pagination: pageRes,
+	}, nil
+}
+
+// BlockedAccount returns whether the account is blocked from sending and receiving a specified denom.
+func (qs QueryService) BlockedAccount(goCtx context.Context, req *types.QueryBlockedAccountRequest) (*types.QueryBlockedAccountResponse, error) {
+	account, err := sdk.AccAddressFromBech32(req.Account)
+	if err != nil {
+		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid account address")
+	}
+
+	return &types.QueryBlockedAccountResponse{
+		Blocked: qs.keeper.IsAccountBlocked(sdk.UnwrapSDKContext(goCtx), req.Denom, account),
+	}, nil
+}
+
+// VestingSchedules returns the vesting schedules of the account with the vested and unvested amounts.
+func (qs QueryService) VestingSchedules(
+	goCtx context.Context,
+	req *types.QueryVestingSchedulesRequest,
+) (*types.QueryVestingSchedulesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	account, err := sdk.AccAddressFromBech32(req.Account)
+	if err != nil {
+		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid account address")
+	}
+
+	schedules, err := qs.keeper.GetVestingSchedules(ctx, req.Denom, account)
+	if err != nil {
+		return nil, err
+	}
+
+	vested := sdkmath.ZeroInt()
+	unvested := sdkmath.ZeroInt()
+	for _, schedule := range schedules {
+		vested = vested.Add(schedule.VestedAmount(ctx.BlockTime()))
+		unvested = unvested.Add(schedule.UnvestedAmount(ctx.BlockTime()))
+	}
+
+	return &types.QueryVestingSchedulesResponse{
+		VestingSchedules: schedules,
+		Vested:           vested,
+		Unvested:         unvested,
+	}, nil
+}
+
+// Distribution returns the distribution in progress for a specified denom.
+func (qs QueryService) Distribution(goCtx context.Context, req *types.QueryDistributionRequest) (*types.QueryDistributionResponse, error) {
+	distribution, err := qs.keeper.GetDistribution(sdk.UnwrapSDKContext(goCtx), req.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDistributionResponse{
+		Distribution: distribution,
+	}, nil
+}
+
+// DistributionClaimable returns the amount the account is going to receive from the distribution in progress.
+func (qs QueryService) DistributionClaimable(
+	goCtx context.Context,
+	req *types.QueryDistributionClaimableRequest,
+) (*types.QueryDistributionClaimableResponse, error) {
+	account, err := sdk.AccAddressFromBech32(req.Account)
+	if err != nil {
+		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid account address")
+	}
+
+	amount, err := qs.keeper.GetDistributionClaimable(sdk.UnwrapSDKContext(goCtx), req.Denom, account)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDistributionClaimableResponse{
+		Amount: amount,
+	}, nil
+}
+
+// TransferLimits returns the transfer limits of the token.
+func (qs QueryService) TransferLimits(
+	goCtx context.Context,
+	req *types.QueryTransferLimitsRequest,
+) (*types.QueryTransferLimitsResponse, error) {
+	transferLimits, pageRes, err := qs.keeper.GetTransferLimits(sdk.UnwrapSDKContext(goCtx), req.Denom, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryTransferLimitsResponse{
+		TransferLimits: transferLimits,
+		Pagination:     pageRes,
+	}, nil
+}
+
+// TransferLimit returns the transfer limit applied to the account and the amount it might still send.
+func (qs QueryService) TransferLimit(
+	goCtx context.Context,
+	req *types.QueryTransferLimitRequest,
+) (*types.QueryTransferLimitResponse, error) {
+	account, err := sdk.AccAddressFromBech32(req.Account)
+	if err != nil {
+		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid account address")
+	}
+
+	transferLimit, sent, err := qs.keeper.GetTransferLimit(sdk.UnwrapSDKContext(goCtx), req.Denom, account)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &types.QueryTransferLimitResponse{
+		TransferLimit: transferLimit,
+		Sent:          sent,
+	}
+	if transferLimit != nil {
+		remaining := sdkmath.MaxInt(transferLimit.Amount.Sub(sent), sdkmath.ZeroInt())
+		res.Remaining = &remaining
+	}
+
+	return res, nil
+}
+
+// Holders returns the holders of the token with their balances.
+func (qs QueryService) Holders(goCtx context.Context, req *types.QueryHoldersRequest) (*types.QueryHoldersResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, err := qs.keeper.GetToken(ctx, req.Denom); err != nil {
+		return nil, err
+	}
+
+	res, err := qs.bankKeeper.DenomOwners(goCtx, &banktypes.QueryDenomOwnersRequest{
+		Denom:      req.Denom,
+		Pagination: req.Pagination,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	holders := make([]types.Holder, 0, len(res.DenomOwners))
+	for _, owner := range res.DenomOwners {
+		account, err := sdk.AccAddressFromBech32(owner.Address)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(types.ErrInvalidState, "invalid holder address %s", owner.Address)
+		}
+
+		locked, err := qs.lockedAmount(ctx, account, req.Denom)
+		if err != nil {
+			return nil, err
+		}
+
+		holders = append(holders, types.Holder{
+			Account:     owner.Address,
+			Balance:     owner.Balance.Amount,
+			Whitelisted: qs.keeper.GetWhitelistedBalance(ctx, account, req.Denom).Amount,
+			Frozen:      qs.keeper.GetFrozenBalance(ctx, account, req.Denom).Amount,
+			Locked:      locked,
+			Blocked:     qs.keeper.IsAccountBlocked(ctx, req.Denom, account),
+		})
+	}
+
+	return &types.QueryHoldersResponse{
+		Holders:    holders,
+		Pagination: res.Pagination,
+	}, nil
+}
+
 // Balance returns balance of the denom for the account.
 func (qs QueryService) Balance(goCtx context.Context, req *types.QueryBalanceRequest) (*types.QueryBalanceResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -98,17 +302,32 @@ func (qs QueryService) Balance(goCtx context.Context, req *types.QueryBalanceReq
 		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid account address")
 	}
 
-	locked := qs.bankKeeper.LockedCoins(ctx, account)
-
 	denom := req.GetDenom()
+	locked, err := qs.lockedAmount(ctx, account, denom)
+	if err != nil {
+		return nil, err
+	}
+
 	return &types.QueryBalanceResponse{
 		Balance:     qs.bankKeeper.GetBalance(ctx, account, denom).Amount,
 		Whitelisted: qs.keeper.GetWhitelistedBalance(ctx, account, denom).Amount,
 		Frozen:      qs.keeper.GetFrozenBalance(ctx, account, denom).Amount,
-		Locked:      locked.AmountOf(denom),
+		Locked:      locked,
 	}, nil
 }
 
+// lockedAmount returns the amount locked by the vesting accounts, the vesting schedules and the dex orders.
+func (qs QueryService) lockedAmount(ctx sdk.Context, account sdk.AccAddress, denom string) (sdkmath.Int, error) {
+	vestingLocked, err := qs.keeper.GetVestingLockedAmount(ctx, denom, account)
+	if err != nil {
+		return sdkmath.Int{}, err
+	}
+
+	return qs.bankKeeper.LockedCoins(ctx, account).AmountOf(denom).
+		Add(vestingLocked).
+		Add(qs.keeper.GetDEXLockedBalance(ctx, account, denom).Amount), nil
+}
+
 // FrozenBalances lists frozen balances on a given account.
 func (qs QueryService) FrozenBalances(goCtx context.Context, req *types.QueryFrozenBalancesRequest) (*types.QueryFrozenBalancesResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -135,9 +354,14 @@ func (qs QueryService) FrozenBalance(goCtx context.Context, req *types.QueryFroz
 		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid account address")
 	}
 	balance := qs.keeper.GetFrozenBalance(ctx, account, req.GetDenom())
+	scheduledUnfreezes, err := qs.keeper.GetScheduledUnfreezes(ctx, account, req.GetDenom())
+	if err != nil {
+		return nil, err
+	}
 
 	return &types.QueryFrozenBalanceResponse{
-		Balance: balance,
+		Balance:            balance,
+		ScheduledUnfreezes: scheduledUnfreezes,
 	}, nil
 }
 
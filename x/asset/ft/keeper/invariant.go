@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	"github.com/CoreumFoundation/coreum/v3/x/asset/ft/types"
 )
@@ -15,6 +16,10 @@ const (
 	WhitelistingInvariantName = "whitelisting"
 	// BankMetadataExistsInvariantName is bank metadata exist name.
 	BankMetadataExistsInvariantName = "bank-metadata-exist"
+	// MaxSupplyInvariantName is max supply invariant name.
+	MaxSupplyInvariantName = "max-supply"
+	// DistributionEscrowInvariantName is distribution escrow invariant name.
+	DistributionEscrowInvariantName = "distribution-escrow"
 )
 
 // RegisterInvariants registers the bank module invariants.
@@ -22,6 +27,8 @@ func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
 	ir.RegisterRoute(types.ModuleName, FreezingInvariantName, FreezingInvariant(k))
 	ir.RegisterRoute(types.ModuleName, WhitelistingInvariantName, WhitelistingInvariant(k))
 	ir.RegisterRoute(types.ModuleName, BankMetadataExistsInvariantName, BankMetadataExistInvariant(k))
+	ir.RegisterRoute(types.ModuleName, MaxSupplyInvariantName, MaxSupplyInvariant(k))
+	ir.RegisterRoute(types.ModuleName, DistributionEscrowInvariantName, DistributionEscrowInvariant(k))
 }
 
 // FreezingInvariant checks that all accounts in the application have non-negative frozen balances.
@@ -103,6 +110,75 @@ func BankMetadataExistInvariant(k Keeper) sdk.Invariant {
 	}
 }
 
+// MaxSupplyInvariant checks that the total supply of the fungible tokens never exceeds their max supply.
+func MaxSupplyInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   string
+			count int
+		)
+
+		err := k.IterateAllDefinitions(ctx, func(definition types.Definition) (bool, error) {
+			if definition.MaxSupply == nil {
+				return false, nil
+			}
+			supply := k.bankKeeper.GetSupply(ctx, definition.Denom)
+			if supply.Amount.GT(*definition.MaxSupply) {
+				count++
+				msg += fmt.Sprintf(
+					"	%s denom supply %s exceeds the max supply %s\n", definition.Denom, supply.Amount, definition.MaxSupply,
+				)
+			}
+
+			return false, nil
+		})
+		if err != nil {
+			// impossible
+			panic(err)
+		}
+
+		return sdk.FormatInvariant(
+			types.ModuleName, MaxSupplyInvariantName,
+			fmt.Sprintf("number of tokens exceeding the max supply %d\n%s", count, msg),
+		), count != 0
+	}
+}
+
+// DistributionEscrowInvariant checks that the module account holds the undistributed parts of the pools of all the
+// distributions in progress.
+func DistributionEscrowInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   string
+			count int
+		)
+
+		distributions, _, err := k.ExportDistributions(ctx)
+		if err != nil {
+			// impossible
+			panic(err)
+		}
+
+		escrow := sdk.NewCoins()
+		for _, distribution := range distributions {
+			escrow = escrow.Add(sdk.NewCoin(distribution.Pool.Denom, distributionEscrow(distribution)))
+		}
+		moduleAddr := authtypes.NewModuleAddress(types.ModuleName)
+		for _, coin := range escrow {
+			balance := k.bankKeeper.GetBalance(ctx, moduleAddr, coin.Denom)
+			if balance.IsLT(coin) {
+				count++
+				msg += fmt.Sprintf("\tmodule balance %s is less than the escrow of the distributions %s\n", balance, coin)
+			}
+		}
+
+		return sdk.FormatInvariant(
+			types.ModuleName, DistributionEscrowInvariantName,
+			fmt.Sprintf("number of denoms with insufficient distribution escrow %d\n%s", count, msg),
+		), count != 0
+	}
+}
+
 func applyFeatureBalanceInvariant(
 	ctx sdk.Context,
 	k Keeper,
@@ -203,3 +203,66 @@ func TestBankMetadataExistInvariant(t *testing.T) {
 	_, isBroken = keeper.BankMetadataExistInvariant(ftKeeper)(ctx)
 	requireT.True(isBroken)
 }
+
+func TestMaxSupplyInvariant(t *testing.T) {
+	requireT := require.New(t)
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false, tmproto.Header{})
+	ftKeeper := testApp.AssetFTKeeper
+	bankKeeper := testApp.BankKeeper
+
+	issuer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	maxSupply := sdkmath.NewInt(1000)
+	denom, err := ftKeeper.Issue(ctx, types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "DEF",
+		Subunit:       "def",
+		Precision:     1,
+		Description:   "DEF Desc",
+		InitialAmount: sdkmath.NewInt(1000),
+		MaxSupply:     &maxSupply,
+	})
+	requireT.NoError(err)
+
+	// check that current state is valid
+	_, isBroken := keeper.MaxSupplyInvariant(ftKeeper)(ctx)
+	requireT.False(isBroken)
+
+	// break the state by minting the coins bypassing the keeper
+	requireT.NoError(bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(denom, 1))))
+	_, isBroken = keeper.MaxSupplyInvariant(ftKeeper)(ctx)
+	requireT.True(isBroken)
+}
+
+func TestDistributionEscrowInvariant(t *testing.T) {
+	requireT := require.New(t)
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false, tmproto.Header{})
+	ftKeeper := testApp.AssetFTKeeper
+
+	issuer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	denom, err := ftKeeper.Issue(ctx, types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "DEF",
+		Subunit:       "def",
+		Precision:     1,
+		Description:   "DEF Desc",
+		InitialAmount: sdkmath.NewInt(1000),
+	})
+	requireT.NoError(err)
+	requireT.NoError(ftKeeper.Distribute(ctx, issuer, denom, sdk.NewInt64Coin(denom, 100)))
+
+	// check that current state is valid
+	_, isBroken := keeper.DistributionEscrowInvariant(ftKeeper)(ctx)
+	requireT.False(isBroken)
+
+	// break the state by increasing the pool bypassing the keeper
+	distribution, err := ftKeeper.GetDistribution(ctx, denom)
+	requireT.NoError(err)
+	distribution.Pool = sdk.NewInt64Coin(denom, 101)
+	requireT.NoError(ftKeeper.ImportDistributions(ctx, []types.Distribution{distribution}, nil))
+	_, isBroken = keeper.DistributionEscrowInvariant(ftKeeper)(ctx)
+	requireT.True(isBroken)
+}
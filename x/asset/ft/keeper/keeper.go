@@ -8,6 +8,7 @@ import (
 	sdkmath "cosmossdk.io/math"
 	"github.com/cometbft/cometbft/libs/log"
 	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -28,6 +29,8 @@ type Keeper struct {
 	delayKeeper types.DelayKeeper
 	wasmKeeper  types.WASMKeeper
 	authority   string
+
+	tokenUpgradeHandlers map[uint32]tokenUpgradeHandler
 }
 
 // NewKeeper creates a new instance of the Keeper.
@@ -46,6 +49,10 @@ func NewKeeper(
 		delayKeeper: delayKeeper,
 		wasmKeeper:  wasmKeeper,
 		authority:   authority,
+		tokenUpgradeHandlers: map[uint32]tokenUpgradeHandler{
+			tokenUpgradeV1Version: tokenUpgradeV1Handler{},
+			tokenUpgradeV2Version: tokenUpgradeV2Handler{},
+		},
 	}
 }
 
@@ -93,9 +100,9 @@ func (k Keeper) GetTokens(ctx sdk.Context, pagination *query.PageRequest) ([]typ
 	return tokens, pageResponse, nil
 }
 
-// GetIssuerTokens returns fungible tokens issued by the issuer.
-func (k Keeper) GetIssuerTokens(ctx sdk.Context, issuer sdk.AccAddress, pagination *query.PageRequest) ([]types.Token, *query.PageResponse, error) {
-	definitions, pageResponse, err := k.getIssuerDefinitions(ctx, issuer, pagination)
+// GetIssuerTokens returns fungible tokens managed by the admin.
+func (k Keeper) GetIssuerTokens(ctx sdk.Context, admin sdk.AccAddress, pagination *query.PageRequest) ([]types.Token, *query.PageResponse, error) {
+	definitions, pageResponse, err := k.getAdminDefinitions(ctx, admin, pagination)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -182,6 +189,14 @@ func (k Keeper) IssueVersioned(ctx sdk.Context, settings types.IssueSettings, ve
 		return "", err
 	}
 
+	if err := types.ValidateMaxSupply(settings.MaxSupply, settings.InitialAmount); err != nil {
+		return "", err
+	}
+
+	if err := types.ValidateMintAllowance(settings.MintAllowance); err != nil {
+		return "", err
+	}
+
 	err := types.ValidateSymbol(settings.Symbol)
 	if err != nil {
 		return "", sdkerrors.Wrapf(err, "provided symbol: %s", settings.Symbol)
@@ -215,9 +230,15 @@ func (k Keeper) IssueVersioned(ctx sdk.Context, settings types.IssueSettings, ve
 		BurnRate:           settings.BurnRate,
 		SendCommissionRate: settings.SendCommissionRate,
 		Version:            version,
+		Admin:              settings.Issuer.String(),
+		Data:               settings.Data,
+		MaxSupply:          settings.MaxSupply,
+		MintAllowance:      settings.MintAllowance,
 	}
 
-	if err := k.SetDenomMetadata(ctx, denom, settings.Symbol, settings.Description, settings.Precision); err != nil {
+	if err := k.SetDenomMetadata(
+		ctx, denom, settings.Symbol, settings.Description, settings.URI, settings.URIHash, settings.Precision,
+	); err != nil {
 		return "", err
 	}
 
@@ -238,6 +259,10 @@ func (k Keeper) IssueVersioned(ctx sdk.Context, settings types.IssueSettings, ve
 		Features:           settings.Features,
 		BurnRate:           settings.BurnRate,
 		SendCommissionRate: settings.SendCommissionRate,
+		URI:                settings.URI,
+		URIHash:            settings.URIHash,
+		MaxSupply:          settings.MaxSupply,
+		MintAllowance:      settings.MintAllowance,
 	}); err != nil {
 		return "", sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit EventIssued event: %s", err)
 	}
@@ -258,17 +283,36 @@ func (k Keeper) SetSymbol(ctx sdk.Context, symbol string, issuer sdk.AccAddress)
 	return nil
 }
 
-// SetDefinition stores the Definition.
+// SetDefinition stores the Definition and keeps the admin index up to date.
 func (k Keeper) SetDefinition(ctx sdk.Context, issuer sdk.AccAddress, subunit string, definition types.Definition) {
-	ctx.KVStore(k.storeKey).Set(types.CreateTokenKey(issuer, subunit), k.cdc.MustMarshal(&definition))
+	store := ctx.KVStore(k.storeKey)
+	tokenKey := types.CreateTokenKey(issuer, subunit)
+	if bz := store.Get(tokenKey); bz != nil {
+		var prevDefinition types.Definition
+		k.cdc.MustUnmarshal(bz, &prevDefinition)
+		if prevDefinition.Admin != "" {
+			store.Delete(types.CreateAdminTokenKey(sdk.MustAccAddressFromBech32(prevDefinition.Admin), prevDefinition.Denom))
+		}
+	}
+
+	store.Set(tokenKey, k.cdc.MustMarshal(&definition))
+	if definition.Admin != "" {
+		store.Set(types.CreateAdminTokenKey(sdk.MustAccAddressFromBech32(definition.Admin), definition.Denom), types.StoreTrue)
+	}
 }
 
 // SetDenomMetadata registers denom metadata on the bank keeper.
-func (k Keeper) SetDenomMetadata(ctx sdk.Context, denom, symbol, description string, precision uint32) error {
+func (k Keeper) SetDenomMetadata(
+	ctx sdk.Context,
+	denom, symbol, description, uri, uriHash string,
+	precision uint32,
+) error {
 	denomMetadata := banktypes.Metadata{
 		Name:        symbol,
 		Symbol:      symbol,
 		Description: description,
+		URI:         uri,
+		URIHash:     uriHash,
 
 		// This is a cosmos sdk requirement that the first denomination unit MUST be the base
 		DenomUnits: []*banktypes.DenomUnit{
@@ -306,7 +350,23 @@ func (k Keeper) Mint(ctx sdk.Context, sender, recipient sdk.AccAddress, coin sdk
 		return err
 	}
 
-	return k.mintIfReceivable(ctx, def, coin.Amount, recipient)
+	if err := k.useMintAllowance(ctx, def, coin.Amount); err != nil {
+		return err
+	}
+
+	if err := k.mintIfReceivable(ctx, def, coin.Amount, recipient); err != nil {
+		return err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventMinted{
+		Denom:     coin.Denom,
+		Recipient: recipient.String(),
+		Amount:    coin.Amount,
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit EventMinted event: %s", err)
+	}
+
+	return nil
 }
 
 // Burn burns fungible token.
@@ -321,7 +381,19 @@ func (k Keeper) Burn(ctx sdk.Context, sender sdk.AccAddress, coin sdk.Coin) erro
 		return err
 	}
 
-	return k.burnIfSpendable(ctx, sender, def, coin.Amount)
+	if err := k.burnIfSpendable(ctx, sender, def, coin.Amount); err != nil {
+		return err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventBurnt{
+		Denom:   coin.Denom,
+		Account: sender.String(),
+		Amount:  coin.Amount,
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit EventBurnt event: %s", err)
+	}
+
+	return nil
 }
 
 // Freeze freezes specified token from the specified account.
@@ -373,6 +445,9 @@ func (k Keeper) Unfreeze(ctx sdk.Context, sender, addr sdk.AccAddress, coin sdk.
 
 	newFrozenBalance := frozenBalance.Sub(coin)
 	frozenStore.SetBalance(newFrozenBalance)
+	if err := k.reduceScheduledUnfreezes(ctx, addr, coin.Denom, coin.Amount); err != nil {
+		return err
+	}
 
 	if err := ctx.EventManager().EmitTypedEvent(&types.EventFrozenAmountChanged{
 		Account:        addr.String(),
@@ -399,6 +474,11 @@ func (k Keeper) SetFrozen(ctx sdk.Context, sender, addr sdk.AccAddress, coin sdk
 	frozenStore := k.frozenAccountBalanceStore(ctx, addr)
 	frozenBalance := frozenStore.Balance(coin.Denom)
 	frozenStore.SetBalance(coin)
+	if frozenBalance.Amount.GT(coin.Amount) {
+		if err := k.reduceScheduledUnfreezes(ctx, addr, coin.Denom, frozenBalance.Amount.Sub(coin.Amount)); err != nil {
+			return err
+		}
+	}
 
 	if err := ctx.EventManager().EmitTypedEvent(&types.EventFrozenAmountChanged{
 		Account:        addr.String(),
@@ -412,6 +492,143 @@ func (k Keeper) SetFrozen(ctx sdk.Context, sender, addr sdk.AccAddress, coin sdk
 	return nil
 }
 
+// Clawback returns specified tokens from the specified account to the admin.
+// The frozen balance, the global freeze and the dex orders don't prevent the clawback, and the amount locked by
+// the vesting schedules is reduced by the clawed back amount.
+func (k Keeper) Clawback(ctx sdk.Context, sender, addr sdk.AccAddress, coin sdk.Coin) error {
+	if !coin.IsPositive() {
+		return sdkerrors.Wrap(cosmoserrors.ErrInvalidCoins, "clawback amount should be positive")
+	}
+
+	def, err := k.GetDefinition(ctx, coin.Denom)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "not able to get token info for denom:%s", coin.Denom)
+	}
+
+	if def.IsAdmin(addr) {
+		return sdkerrors.Wrap(cosmoserrors.ErrUnauthorized, "admin's balance can't be clawed back")
+	}
+
+	if err := def.CheckFeatureAllowed(sender, types.Feature_clawback); err != nil {
+		return err
+	}
+
+	if k.bankKeeper.BlockedAddr(addr) {
+		return sdkerrors.Wrap(cosmoserrors.ErrUnauthorized, "clawback from module accounts is prohibited")
+	}
+
+	if err := k.recordDistributionHolders(ctx, coin.Denom, addr, sender); err != nil {
+		return err
+	}
+
+	// the bank keeper used by the module doesn't call the asset hooks, so the frozen checks and the dex locks
+	// are not applied
+	if err := k.bankKeeper.SendCoins(ctx, addr, sender, sdk.NewCoins(coin)); err != nil {
+		return sdkerrors.Wrapf(err, "can't send coins from account %s to issuer %s", addr.String(), sender.String())
+	}
+	k.capDEXLock(ctx, addr, coin.Denom)
+	if err := k.reduceVestingSchedules(ctx, coin.Denom, addr, coin.Amount); err != nil {
+		return err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventClawback{
+		Account: addr.String(),
+		Denom:   coin.Denom,
+		Amount:  coin.Amount,
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit EventClawback event: %s", err)
+	}
+
+	return nil
+}
+
+// TransferAdmin changes the admin of the fungible token.
+func (k Keeper) TransferAdmin(ctx sdk.Context, sender, addr sdk.AccAddress, denom string) error {
+	def, err := k.adminChecks(ctx, sender, denom)
+	if err != nil {
+		return err
+	}
+
+	def.Admin = addr.String()
+	if err := k.updateDefinition(ctx, def); err != nil {
+		return err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventAdminTransferred{
+		Denom:         denom,
+		PreviousAdmin: sender.String(),
+		CurrentAdmin:  addr.String(),
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit EventAdminTransferred event: %s", err)
+	}
+
+	return nil
+}
+
+// ClearAdmin removes the admin of the fungible token, so the token can't be managed anymore.
+func (k Keeper) ClearAdmin(ctx sdk.Context, sender sdk.AccAddress, denom string) error {
+	def, err := k.adminChecks(ctx, sender, denom)
+	if err != nil {
+		return err
+	}
+
+	def.Admin = ""
+	if err := k.updateDefinition(ctx, def); err != nil {
+		return err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventAdminCleared{
+		Denom:         denom,
+		PreviousAdmin: sender.String(),
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit EventAdminCleared event: %s", err)
+	}
+
+	return nil
+}
+
+// UpdateMetadata replaces the description, URI, URI hash and data of the fungible token.
+func (k Keeper) UpdateMetadata(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	denom, description, uri, uriHash string,
+	data *codectypes.Any,
+) error {
+	def, err := k.adminChecks(ctx, sender, denom)
+	if err != nil {
+		return err
+	}
+
+	if err := types.ValidateMetadata(description, uri, uriHash, data); err != nil {
+		return err
+	}
+
+	metadata, found := k.bankKeeper.GetDenomMetaData(ctx, denom)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrTokenNotFound, "metadata for %s denom not found", denom)
+	}
+	metadata.Description = description
+	metadata.URI = uri
+	metadata.URIHash = uriHash
+	k.bankKeeper.SetDenomMetaData(ctx, metadata)
+
+	def.Data = data
+	if err := k.updateDefinition(ctx, def); err != nil {
+		return err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventMetadataUpdated{
+		Denom:       denom,
+		Description: description,
+		URI:         uri,
+		URIHash:     uriHash,
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit EventMetadataUpdated event: %s", err)
+	}
+
+	return nil
+}
+
 // GloballyFreeze enables global freeze on a fungible token. This function is idempotent.
 func (k Keeper) GloballyFreeze(ctx sdk.Context, sender sdk.AccAddress, denom string) error {
 	def, err := k.GetDefinition(ctx, denom)
@@ -424,6 +641,13 @@ func (k Keeper) GloballyFreeze(ctx sdk.Context, sender sdk.AccAddress, denom str
 	}
 
 	k.SetGlobalFreeze(ctx, denom, true)
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventGloballyFrozen{
+		Denom: denom,
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit EventGloballyFrozen event: %s", err)
+	}
+
 	return nil
 }
 
@@ -439,6 +663,13 @@ func (k Keeper) GloballyUnfreeze(ctx sdk.Context, sender sdk.AccAddress, denom s
 	}
 
 	k.SetGlobalFreeze(ctx, denom, false)
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventGloballyUnfrozen{
+		Denom: denom,
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit EventGloballyUnfrozen event: %s", err)
+	}
+
 	return nil
 }
 
@@ -492,8 +723,8 @@ func (k Keeper) SetWhitelistedBalance(ctx sdk.Context, sender, addr sdk.AccAddre
 		return sdkerrors.Wrapf(err, "not able to get token info for denom:%s", coin.Denom)
 	}
 
-	if def.IsIssuer(addr) {
-		return sdkerrors.Wrap(cosmoserrors.ErrUnauthorized, "issuer's balance can't be whitelisted")
+	if def.IsAdmin(addr) {
+		return sdkerrors.Wrap(cosmoserrors.ErrUnauthorized, "admin's balance can't be whitelisted")
 	}
 
 	if err = def.CheckFeatureAllowed(sender, types.Feature_whitelisting); err != nil {
@@ -554,6 +785,21 @@ func (k Keeper) mintIfReceivable(ctx sdk.Context, def types.Definition, amount s
 		return sdkerrors.Wrapf(err, "coins are not receivable")
 	}
 
+	if def.MaxSupply != nil {
+		supply := k.bankKeeper.GetSupply(ctx, def.Denom)
+		if supply.Amount.Add(amount).GT(*def.MaxSupply) {
+			return sdkerrors.Wrapf(
+				types.ErrMaxSupplyExceeded,
+				"minting %s%s would exceed the max supply %s, current supply: %s",
+				amount, def.Denom, def.MaxSupply, supply.Amount,
+			)
+		}
+	}
+
+	if err := k.recordDistributionHolders(ctx, def.Denom, recipient); err != nil {
+		return err
+	}
+
 	coinsToMint := sdk.NewCoins(sdk.NewCoin(def.Denom, amount))
 	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, coinsToMint); err != nil {
 		return sdkerrors.Wrapf(err, "can't mint %s for the module %s", coinsToMint.String(), types.ModuleName)
@@ -575,6 +821,12 @@ func (k Keeper) burnIfSpendable(ctx sdk.Context, account sdk.AccAddress, def typ
 }
 
 func (k Keeper) burn(ctx sdk.Context, account sdk.AccAddress, coinsToBurn sdk.Coins) error {
+	for _, coin := range coinsToBurn {
+		if err := k.recordDistributionHolders(ctx, coin.Denom, account); err != nil {
+			return err
+		}
+	}
+
 	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, account, types.ModuleName, coinsToBurn); err != nil {
 		return sdkerrors.Wrapf(err, "can't send coins from account %s to module %s", account.String(), types.ModuleName)
 	}
@@ -604,11 +856,12 @@ func (k Keeper) isCoinSpendable(ctx sdk.Context, addr sdk.AccAddress, def types.
 	if wibctransfertypes.IsPurposeTimeout(ctx) {
 		return nil
 	}
-	if !def.IsFeatureEnabled(types.Feature_freezing) || def.IsIssuer(addr) {
-		return nil
+
+	if err := k.blocklistChecks(ctx, def, addr); err != nil {
+		return err
 	}
 
-	if k.isGloballyFrozen(ctx, def.Denom) {
+	if def.IsFeatureEnabled(types.Feature_freezing) && !def.IsAdmin(addr) && k.isGloballyFrozen(ctx, def.Denom) {
 		return sdkerrors.Wrapf(types.ErrGloballyFrozen, "%s is globally frozen", def.Denom)
 	}
 
@@ -620,12 +873,7 @@ func (k Keeper) isCoinSpendable(ctx sdk.Context, addr sdk.AccAddress, def types.
 		return nil
 	}
 
-	availableBalance := k.availableBalance(ctx, addr, def.Denom)
-	if !availableBalance.Amount.GTE(amount) {
-		return sdkerrors.Wrapf(cosmoserrors.ErrInsufficientFunds, "%s is not available, available %s",
-			sdk.NewCoin(def.Denom, amount), availableBalance)
-	}
-	return nil
+	return k.availableBalanceChecks(ctx, addr, sdk.NewCoin(def.Denom, amount))
 }
 
 func (k Keeper) isCoinReceivable(ctx sdk.Context, addr sdk.AccAddress, def types.Definition, amount sdkmath.Int) error {
@@ -661,8 +909,12 @@ func (k Keeper) isCoinReceivable(ctx sdk.Context, addr sdk.AccAddress, def types
 		return nil
 	}
 
+	if err := k.blocklistChecks(ctx, def, addr); err != nil {
+		return err
+	}
+
 	if !def.IsFeatureEnabled(types.Feature_whitelisting) ||
-		def.IsIssuer(addr) {
+		def.IsAdmin(addr) {
 		return nil
 	}
 
@@ -683,25 +935,75 @@ func (k Keeper) isSymbolDuplicated(ctx sdk.Context, symbol string, issuer sdk.Ac
 	return rawBytes != nil
 }
 
-func (k Keeper) availableBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin {
+// availableBalance returns the balance of the account which can be spent. The amount locked by the dex orders is
+// not available for any denom, and for the fungible token the amount locked by the vesting schedules and the frozen
+// amount are not available either, unless the account is the admin.
+func (k Keeper) availableBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) (sdk.Coin, error) {
 	balance := k.bankKeeper.GetBalance(ctx, addr, denom)
 	if balance.IsZero() {
-		return balance
+		return balance, nil
 	}
 
-	frozenBalance := k.GetFrozenBalance(ctx, addr, denom)
-	if frozenBalance.IsGTE(balance) {
-		return sdk.NewCoin(denom, sdkmath.ZeroInt())
+	notAvailable := k.GetDEXLockedBalance(ctx, addr, denom).Amount
+	def, err := k.GetDefinition(ctx, denom)
+	switch {
+	case err == nil:
+		if !def.IsAdmin(addr) {
+			vestingLocked, err := k.GetVestingLockedAmount(ctx, denom, addr)
+			if err != nil {
+				return sdk.Coin{}, err
+			}
+			notAvailable = notAvailable.Add(vestingLocked)
+			if def.IsFeatureEnabled(types.Feature_freezing) {
+				notAvailable = notAvailable.Add(k.GetFrozenBalance(ctx, addr, denom).Amount)
+			}
+		}
+	case types.ErrInvalidDenom.Is(err) || types.ErrTokenNotFound.Is(err):
+	default:
+		return sdk.Coin{}, err
+	}
+
+	if notAvailable.GTE(balance.Amount) {
+		return sdk.NewCoin(denom, sdkmath.ZeroInt()), nil
 	}
-	return balance.Sub(frozenBalance)
+	return balance.SubAmount(notAvailable), nil
+}
+
+// availableBalanceChecks checks that the coin doesn't include the amount which is frozen or locked by
+// the vesting schedules or the dex orders.
+func (k Keeper) availableBalanceChecks(ctx sdk.Context, addr sdk.AccAddress, coin sdk.Coin) error {
+	availableBalance, err := k.availableBalance(ctx, addr, coin.Denom)
+	if err != nil {
+		return err
+	}
+	if availableBalance.IsLT(coin) {
+		return sdkerrors.Wrapf(cosmoserrors.ErrInsufficientFunds, "%s is not available, available %s",
+			coin, availableBalance)
+	}
+
+	return nil
 }
 
 func (k Keeper) getDefinitions(ctx sdk.Context, pagination *query.PageRequest) ([]types.Definition, *query.PageResponse, error) {
 	return k.getDefinitionsFromStore(prefix.NewStore(ctx.KVStore(k.storeKey), types.TokenKeyPrefix), pagination)
 }
 
-func (k Keeper) getIssuerDefinitions(ctx sdk.Context, issuer sdk.AccAddress, pagination *query.PageRequest) ([]types.Definition, *query.PageResponse, error) {
-	return k.getDefinitionsFromStore(prefix.NewStore(ctx.KVStore(k.storeKey), types.CreateIssuerTokensPrefix(issuer)), pagination)
+func (k Keeper) getAdminDefinitions(ctx sdk.Context, admin sdk.AccAddress, pagination *query.PageRequest) ([]types.Definition, *query.PageResponse, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.CreateAdminTokensPrefix(admin))
+	definitions := make([]types.Definition, 0)
+	pageRes, err := query.Paginate(store, pagination, func(key, _ []byte) error {
+		definition, err := k.GetDefinition(ctx, string(key))
+		if err != nil {
+			return err
+		}
+		definitions = append(definitions, definition)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, sdkerrors.Wrapf(types.ErrInvalidInput, "failed to paginate: %s", err)
+	}
+
+	return definitions, pageRes, nil
 }
 
 func (k Keeper) getTokenFullInfo(ctx sdk.Context, definition types.Definition) (types.Token, error) {
@@ -715,6 +1017,11 @@ func (k Keeper) getTokenFullInfo(ctx sdk.Context, definition types.Definition) (
 		return types.Token{}, sdkerrors.Wrapf(types.ErrTokenNotFound, "metadata for %s denom not found", definition.Denom)
 	}
 
+	mintableAmount, err := k.getMintableAmount(ctx, definition)
+	if err != nil {
+		return types.Token{}, err
+	}
+
 	precision := -1
 	for _, unit := range metadata.DenomUnits {
 		if unit.Denom == metadata.Symbol {
@@ -727,6 +1034,11 @@ func (k Keeper) getTokenFullInfo(ctx sdk.Context, definition types.Definition) (
 		return types.Token{}, sdkerrors.Wrap(types.ErrInvalidInput, "precision not found")
 	}
 
+	pendingRatesUpdate, err := k.GetPendingRatesUpdate(ctx, definition.Denom)
+	if err != nil {
+		return types.Token{}, err
+	}
+
 	return types.Token{
 		Denom:              definition.Denom,
 		Issuer:             definition.Issuer,
@@ -739,6 +1051,14 @@ func (k Keeper) getTokenFullInfo(ctx sdk.Context, definition types.Definition) (
 		SendCommissionRate: definition.SendCommissionRate,
 		GloballyFrozen:     k.isGloballyFrozen(ctx, definition.Denom),
 		Version:            definition.Version,
+		Admin:              definition.Admin,
+		URI:                metadata.URI,
+		URIHash:            metadata.URIHash,
+		Data:               definition.Data,
+		MaxSupply:          definition.MaxSupply,
+		MintAllowance:      definition.MintAllowance,
+		MintableAmount:     mintableAmount,
+		PendingRatesUpdate: pendingRatesUpdate,
 	}, nil
 }
 
@@ -802,13 +1122,36 @@ func (k Keeper) freezingChecks(ctx sdk.Context, sender, addr sdk.AccAddress, coi
 		return sdkerrors.Wrapf(err, "not able to get token info for denom:%s", coin.Denom)
 	}
 
-	if def.IsIssuer(addr) {
-		return sdkerrors.Wrap(cosmoserrors.ErrUnauthorized, "issuer's balance can't be frozen")
+	if def.IsAdmin(addr) {
+		return sdkerrors.Wrap(cosmoserrors.ErrUnauthorized, "admin's balance can't be frozen")
 	}
 
 	return def.CheckFeatureAllowed(sender, types.Feature_freezing)
 }
 
+func (k Keeper) adminChecks(ctx sdk.Context, sender sdk.AccAddress, denom string) (types.Definition, error) {
+	def, err := k.GetDefinition(ctx, denom)
+	if err != nil {
+		return types.Definition{}, sdkerrors.Wrapf(err, "not able to get token info for denom:%s", denom)
+	}
+
+	if !def.IsAdmin(sender) {
+		return types.Definition{}, sdkerrors.Wrap(cosmoserrors.ErrUnauthorized, "address is unauthorized to perform this operation")
+	}
+
+	return def, nil
+}
+
+func (k Keeper) updateDefinition(ctx sdk.Context, def types.Definition) error {
+	subunit, issuer, err := types.DeconstructDenom(def.Denom)
+	if err != nil {
+		return err
+	}
+
+	k.SetDefinition(ctx, issuer, subunit, def)
+	return nil
+}
+
 func (k Keeper) isGloballyFrozen(ctx sdk.Context, denom string) bool {
 	return bytes.Equal(ctx.KVStore(k.storeKey).Get(types.CreateGlobalFreezeKey(denom)), types.StoreTrue)
 }
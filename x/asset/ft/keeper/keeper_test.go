@@ -8,12 +8,14 @@ import (
 	sdkerrors "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/gogoproto/proto"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -50,6 +52,8 @@ func TestKeeper_Issue(t *testing.T) {
 		Precision:     8,
 		InitialAmount: sdkmath.NewInt(777),
 		Features:      []types.Feature{types.Feature_freezing},
+		URI:           "https://my-token-meta.invalid/1",
+		URIHash:       "e000624",
 	}
 
 	denom, err := ftKeeper.Issue(ctx, settings)
@@ -81,6 +85,9 @@ func TestKeeper_Issue(t *testing.T) {
 		BurnRate:           sdk.NewDec(0),
 		SendCommissionRate: sdk.NewDec(0),
 		Version:            types.CurrentTokenVersion,
+		Admin:              settings.Issuer.String(),
+		URI:                settings.URI,
+		URIHash:            settings.URIHash,
 	}, gotToken)
 
 	// check the metadata
@@ -90,6 +97,8 @@ func TestKeeper_Issue(t *testing.T) {
 		Name:        settings.Symbol,
 		Symbol:      settings.Symbol,
 		Description: settings.Description,
+		URI:         settings.URI,
+		URIHash:     settings.URIHash,
 		DenomUnits: []*banktypes.DenomUnit{
 			{
 				Denom:    denom,
@@ -1076,6 +1085,92 @@ func TestKeeper_FreezeUnfreeze(t *testing.T) {
 	requireT.Equal(sdk.NewCoin(denom, sdkmath.NewInt(100)), balance)
 }
 
+func TestKeeper_Clawback(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+
+	ftKeeper := testApp.AssetFTKeeper
+	bankKeeper := testApp.BankKeeper
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	denom, err := ftKeeper.Issue(ctx, types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "DEF",
+		Subunit:       "def",
+		Precision:     1,
+		InitialAmount: sdkmath.NewInt(666),
+		Features:      []types.Feature{types.Feature_freezing, types.Feature_clawback},
+	})
+	requireT.NoError(err)
+
+	nonClawbackDenom, err := ftKeeper.Issue(ctx, types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "ABC",
+		Subunit:       "abc",
+		Precision:     1,
+		InitialAmount: sdkmath.NewInt(666),
+	})
+	requireT.NoError(err)
+
+	recipient := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	requireT.NoError(bankKeeper.SendCoins(ctx, issuer, recipient, sdk.NewCoins(
+		sdk.NewCoin(denom, sdkmath.NewInt(100)),
+		sdk.NewCoin(nonClawbackDenom, sdkmath.NewInt(100)),
+	)))
+
+	// freeze the whole balance and the token globally, clawback must work anyway
+	requireT.NoError(ftKeeper.Freeze(ctx, issuer, recipient, sdk.NewCoin(denom, sdkmath.NewInt(100))))
+	requireT.NoError(ftKeeper.GloballyFreeze(ctx, issuer, denom))
+
+	// try to clawback the token without the clawback feature
+	err = ftKeeper.Clawback(ctx, issuer, recipient, sdk.NewCoin(nonClawbackDenom, sdkmath.NewInt(10)))
+	requireT.ErrorIs(err, types.ErrFeatureDisabled)
+
+	// try to clawback from non issuer address
+	randomAddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	err = ftKeeper.Clawback(ctx, randomAddr, recipient, sdk.NewCoin(denom, sdkmath.NewInt(10)))
+	requireT.ErrorIs(err, cosmoserrors.ErrUnauthorized)
+
+	// try to clawback from the issuer
+	err = ftKeeper.Clawback(ctx, issuer, issuer, sdk.NewCoin(denom, sdkmath.NewInt(10)))
+	requireT.ErrorIs(err, cosmoserrors.ErrUnauthorized)
+
+	// try to clawback from the module account
+	moduleAddr := testApp.AccountKeeper.GetModuleAddress(types.ModuleName)
+	err = ftKeeper.Clawback(ctx, issuer, moduleAddr, sdk.NewCoin(denom, sdkmath.NewInt(10)))
+	requireT.ErrorIs(err, cosmoserrors.ErrUnauthorized)
+
+	// try to clawback 0 amount
+	err = ftKeeper.Clawback(ctx, issuer, recipient, sdk.NewCoin(denom, sdkmath.NewInt(0)))
+	requireT.ErrorIs(err, cosmoserrors.ErrInvalidCoins)
+
+	// try to clawback more than the balance
+	err = ftKeeper.Clawback(ctx, issuer, recipient, sdk.NewCoin(denom, sdkmath.NewInt(110)))
+	requireT.ErrorIs(err, cosmoserrors.ErrInsufficientFunds)
+
+	// clawback part of the balance
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	requireT.NoError(ftKeeper.Clawback(ctx, issuer, recipient, sdk.NewCoin(denom, sdkmath.NewInt(40))))
+	requireT.Equal(sdk.NewCoin(denom, sdkmath.NewInt(60)).String(), bankKeeper.GetBalance(ctx, recipient, denom).String())
+	requireT.Equal(sdk.NewCoin(denom, sdkmath.NewInt(606)).String(), bankKeeper.GetBalance(ctx, issuer, denom).String())
+
+	clawbackEvents, err := event.FindTypedEvents[*types.EventClawback](ctx.EventManager().Events().ToABCIEvents())
+	requireT.NoError(err)
+	requireT.Equal([]*types.EventClawback{
+		{
+			Account: recipient.String(),
+			Denom:   denom,
+			Amount:  sdkmath.NewInt(40),
+		},
+	}, clawbackEvents)
+
+	// the frozen balance is not changed
+	requireT.Equal(sdk.NewCoin(denom, sdkmath.NewInt(100)).String(), ftKeeper.GetFrozenBalance(ctx, recipient, denom).String())
+}
+
 func TestKeeper_GlobalFreezeUnfreeze(t *testing.T) {
 	requireT := require.New(t)
 	assertT := assert.New(t)
@@ -1594,6 +1689,200 @@ func TestKeeper_GetIssuerTokens(t *testing.T) {
 	requireT.Equal(numberOfTokens, len(tokens))
 }
 
+func TestKeeper_TransferAdmin(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+	ftKeeper := testApp.AssetFTKeeper
+	bankKeeper := testApp.BankKeeper
+
+	issuer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	admin := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	recipient := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	settings := types.IssueSettings{
+		Issuer:             issuer,
+		Symbol:             "DEF",
+		Subunit:            "def",
+		Precision:          1,
+		InitialAmount:      sdkmath.NewInt(1000),
+		Features:           []types.Feature{types.Feature_minting, types.Feature_freezing},
+		SendCommissionRate: sdk.MustNewDecFromStr("0.1"),
+	}
+	denom, err := ftKeeper.Issue(ctx, settings)
+	requireT.NoError(err)
+
+	// try to transfer the admin from non admin account
+	err = ftKeeper.TransferAdmin(ctx, recipient, admin, denom)
+	requireT.ErrorIs(err, cosmoserrors.ErrUnauthorized)
+
+	// transfer the admin
+	requireT.NoError(ftKeeper.TransferAdmin(ctx, issuer, admin, denom))
+	token, err := ftKeeper.GetToken(ctx, denom)
+	requireT.NoError(err)
+	requireT.Equal(issuer.String(), token.Issuer)
+	requireT.Equal(admin.String(), token.Admin)
+
+	// the token is listed for the new admin only
+	tokens, _, err := ftKeeper.GetIssuerTokens(ctx, issuer, &query.PageRequest{})
+	requireT.NoError(err)
+	requireT.Empty(tokens)
+	tokens, _, err = ftKeeper.GetIssuerTokens(ctx, admin, &query.PageRequest{})
+	requireT.NoError(err)
+	requireT.Len(tokens, 1)
+	requireT.Equal(denom, tokens[0].Denom)
+
+	// the issuer is not allowed to manage the token anymore
+	err = ftKeeper.Mint(ctx, issuer, issuer, sdk.NewCoin(denom, sdkmath.NewInt(10)))
+	requireT.ErrorIs(err, cosmoserrors.ErrUnauthorized)
+	err = ftKeeper.TransferAdmin(ctx, issuer, recipient, denom)
+	requireT.ErrorIs(err, cosmoserrors.ErrUnauthorized)
+
+	// the new admin manages the token
+	requireT.NoError(ftKeeper.Mint(ctx, admin, admin, sdk.NewCoin(denom, sdkmath.NewInt(10))))
+	err = ftKeeper.Freeze(ctx, admin, admin, sdk.NewCoin(denom, sdkmath.NewInt(10)))
+	requireT.ErrorIs(err, cosmoserrors.ErrUnauthorized)
+	requireT.NoError(ftKeeper.Freeze(ctx, admin, issuer, sdk.NewCoin(denom, sdkmath.NewInt(10))))
+
+	// the send commission goes to the new admin
+	err = bankKeeper.SendCoins(ctx, issuer, recipient, sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(100))))
+	requireT.NoError(err)
+	requireT.Equal(sdkmath.NewInt(20).String(), bankKeeper.GetBalance(ctx, admin, denom).Amount.String())
+	requireT.Equal(sdkmath.NewInt(890).String(), bankKeeper.GetBalance(ctx, issuer, denom).Amount.String())
+}
+
+func TestKeeper_UpdateMetadata(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+	ftKeeper := testApp.AssetFTKeeper
+	bankKeeper := testApp.BankKeeper
+
+	issuer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	randomAddr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	issueData, err := codectypes.NewAnyWithValue(&types.DataBytes{Data: []byte("issue data")})
+	requireT.NoError(err)
+	settings := types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "DEF",
+		Subunit:       "def",
+		Description:   "DEF Desc",
+		Precision:     1,
+		InitialAmount: sdkmath.NewInt(1000),
+		URI:           "https://my-token-meta.invalid/1",
+		URIHash:       "e000624",
+		Data:          issueData,
+	}
+	denom, err := ftKeeper.Issue(ctx, settings)
+	requireT.NoError(err)
+
+	token, err := ftKeeper.GetToken(ctx, denom)
+	requireT.NoError(err)
+	requireT.Equal(issueData.TypeUrl, token.Data.TypeUrl)
+	requireT.Equal(issueData.Value, token.Data.Value)
+
+	updateData, err := codectypes.NewAnyWithValue(&types.DataBytes{Data: []byte("update data")})
+	requireT.NoError(err)
+
+	// try to update the metadata from non admin account
+	err = ftKeeper.UpdateMetadata(ctx, randomAddr, denom, "new desc", "https://new.invalid", "new-hash", updateData)
+	requireT.ErrorIs(err, cosmoserrors.ErrUnauthorized)
+
+	// try to update the metadata with invalid data type
+	err = ftKeeper.UpdateMetadata(ctx, issuer, denom, "new desc", "https://new.invalid", "new-hash", &codectypes.Any{
+		TypeUrl: "/" + proto.MessageName((*types.DelayedTokenUpgradeV1)(nil)),
+		Value:   []byte{0x01},
+	})
+	requireT.ErrorIs(err, types.ErrInvalidInput)
+
+	// update the metadata
+	requireT.NoError(ftKeeper.UpdateMetadata(ctx, issuer, denom, "new desc", "https://new.invalid", "new-hash", updateData))
+	token, err = ftKeeper.GetToken(ctx, denom)
+	requireT.NoError(err)
+	requireT.Equal("new desc", token.Description)
+	requireT.Equal("https://new.invalid", token.URI)
+	requireT.Equal("new-hash", token.URIHash)
+	requireT.Equal(updateData.Value, token.Data.Value)
+	requireT.Equal(settings.Symbol, token.Symbol)
+	requireT.Equal(settings.Precision, token.Precision)
+
+	metadata, found := bankKeeper.GetDenomMetaData(ctx, denom)
+	requireT.True(found)
+	requireT.Equal("new desc", metadata.Description)
+	requireT.Equal("https://new.invalid", metadata.URI)
+	requireT.Equal("new-hash", metadata.URIHash)
+
+	// clear the metadata
+	requireT.NoError(ftKeeper.UpdateMetadata(ctx, issuer, denom, "", "", "", nil))
+	token, err = ftKeeper.GetToken(ctx, denom)
+	requireT.NoError(err)
+	requireT.Empty(token.Description)
+	requireT.Empty(token.URI)
+	requireT.Empty(token.URIHash)
+	requireT.Nil(token.Data)
+
+	// the metadata can't be updated once the admin is cleared
+	requireT.NoError(ftKeeper.ClearAdmin(ctx, issuer, denom))
+	err = ftKeeper.UpdateMetadata(ctx, issuer, denom, "new desc", "", "", nil)
+	requireT.ErrorIs(err, cosmoserrors.ErrUnauthorized)
+}
+
+func TestKeeper_ClearAdmin(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+	ftKeeper := testApp.AssetFTKeeper
+	bankKeeper := testApp.BankKeeper
+
+	issuer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	recipient := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	settings := types.IssueSettings{
+		Issuer:             issuer,
+		Symbol:             "DEF",
+		Subunit:            "def",
+		Precision:          1,
+		InitialAmount:      sdkmath.NewInt(1000),
+		Features:           []types.Feature{types.Feature_minting},
+		BurnRate:           sdk.MustNewDecFromStr("0.1"),
+		SendCommissionRate: sdk.MustNewDecFromStr("0.2"),
+	}
+	denom, err := ftKeeper.Issue(ctx, settings)
+	requireT.NoError(err)
+
+	// try to clear the admin from non admin account
+	err = ftKeeper.ClearAdmin(ctx, recipient, denom)
+	requireT.ErrorIs(err, cosmoserrors.ErrUnauthorized)
+
+	// clear the admin
+	requireT.NoError(ftKeeper.ClearAdmin(ctx, issuer, denom))
+	token, err := ftKeeper.GetToken(ctx, denom)
+	requireT.NoError(err)
+	requireT.Empty(token.Admin)
+
+	tokens, _, err := ftKeeper.GetIssuerTokens(ctx, issuer, &query.PageRequest{})
+	requireT.NoError(err)
+	requireT.Empty(tokens)
+
+	// nobody can manage the token anymore
+	err = ftKeeper.Mint(ctx, issuer, issuer, sdk.NewCoin(denom, sdkmath.NewInt(10)))
+	requireT.ErrorIs(err, cosmoserrors.ErrUnauthorized)
+	err = ftKeeper.ClearAdmin(ctx, issuer, denom)
+	requireT.ErrorIs(err, cosmoserrors.ErrUnauthorized)
+	err = ftKeeper.TransferAdmin(ctx, issuer, recipient, denom)
+	requireT.ErrorIs(err, cosmoserrors.ErrUnauthorized)
+
+	// the burn rate is applied to the former admin too, the send commission is not charged
+	err = bankKeeper.SendCoins(ctx, issuer, recipient, sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(100))))
+	requireT.NoError(err)
+	requireT.Equal(sdkmath.NewInt(890).String(), bankKeeper.GetBalance(ctx, issuer, denom).Amount.String())
+	requireT.Equal(sdkmath.NewInt(100).String(), bankKeeper.GetBalance(ctx, recipient, denom).Amount.String())
+}
+
 type bankAssertion struct {
 	t   require.TestingT
 	bk  wbankkeeper.BaseKeeperWrapper
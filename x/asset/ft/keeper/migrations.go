@@ -5,6 +5,7 @@ import (
 
 	v1 "github.com/CoreumFoundation/coreum/v3/x/asset/ft/migrations/v1"
 	v2 "github.com/CoreumFoundation/coreum/v3/x/asset/ft/migrations/v2"
+	v3 "github.com/CoreumFoundation/coreum/v3/x/asset/ft/migrations/v3"
 )
 
 // Migrator is a struct for handling in-place store migrations.
@@ -33,3 +34,11 @@ func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 func (m Migrator) Migrate2to3(ctx sdk.Context) error {
 	return v2.MigrateParams(ctx, m.ftKeeper, m.paramsKeeper)
 }
+
+// Migrate3to4 migrates from version 3 to 4.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	if err := v3.MigrateParams(ctx, m.ftKeeper); err != nil {
+		return err
+	}
+	return v3.MigrateAdmin(ctx, m.ftKeeper)
+}
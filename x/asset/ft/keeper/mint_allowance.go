@@ -0,0 +1,123 @@
+package keeper
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/CoreumFoundation/coreum/v3/x/asset/ft/types"
+)
+
+// ImportMintAllowanceUsages imports the mint allowance usages from genesis state.
+func (k Keeper) ImportMintAllowanceUsages(ctx sdk.Context, mintAllowanceUsages []types.MintAllowanceUsage) error {
+	for _, mintAllowanceUsage := range mintAllowanceUsages {
+		if err := k.setMintAllowanceUsage(ctx, mintAllowanceUsage); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ExportMintAllowanceUsages exports the mint allowance usages.
+func (k Keeper) ExportMintAllowanceUsages(ctx sdk.Context) ([]types.MintAllowanceUsage, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.MintAllowanceUsageKeyPrefix)
+	mintAllowanceUsages := make([]types.MintAllowanceUsage, 0)
+	_, err := query.Paginate(store, &query.PageRequest{Limit: query.MaxLimit}, func(key, value []byte) error {
+		var mintAllowanceUsage types.MintAllowanceUsage
+		if err := k.cdc.Unmarshal(value, &mintAllowanceUsage); err != nil {
+			return sdkerrors.Wrapf(types.ErrInvalidState, "failed to unmarshal mint allowance usage: %s", err)
+		}
+		mintAllowanceUsages = append(mintAllowanceUsages, mintAllowanceUsage)
+
+		return nil
+	})
+	if err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidInput, "failed to paginate: %s", err)
+	}
+
+	return mintAllowanceUsages, nil
+}
+
+// getMintableAmount returns the amount which might be minted currently without exceeding the max supply and
+// the mint allowance of the token. Nil is returned if neither of them is set.
+func (k Keeper) getMintableAmount(ctx sdk.Context, def types.Definition) (*sdkmath.Int, error) {
+	var mintableAmount *sdkmath.Int
+	if def.MaxSupply != nil {
+		supply := k.bankKeeper.GetSupply(ctx, def.Denom)
+		amount := sdkmath.MaxInt(def.MaxSupply.Sub(supply.Amount), sdkmath.ZeroInt())
+		mintableAmount = &amount
+	}
+
+	if def.MintAllowance != nil {
+		usage, err := k.getCurrentMintAllowanceUsage(ctx, def)
+		if err != nil {
+			return nil, err
+		}
+		amount := sdkmath.MaxInt(def.MintAllowance.Amount.Sub(usage.Minted), sdkmath.ZeroInt())
+		if mintableAmount == nil || amount.LT(*mintableAmount) {
+			mintableAmount = &amount
+		}
+	}
+
+	return mintableAmount, nil
+}
+
+// useMintAllowance increases the amount minted within the current mint allowance period, if the mint allowance is
+// set for the token, and returns an error if the allowance is exceeded.
+func (k Keeper) useMintAllowance(ctx sdk.Context, def types.Definition, amount sdkmath.Int) error {
+	if def.MintAllowance == nil {
+		return nil
+	}
+
+	usage, err := k.getCurrentMintAllowanceUsage(ctx, def)
+	if err != nil {
+		return err
+	}
+
+	usage.Minted = usage.Minted.Add(amount)
+	if usage.Minted.GT(def.MintAllowance.Amount) {
+		return sdkerrors.Wrapf(
+			types.ErrMintAllowanceExceeded,
+			"minting %s%s would exceed the mint allowance %s for the period started at %s",
+			amount, def.Denom, def.MintAllowance.Amount, usage.PeriodStart,
+		)
+	}
+
+	return k.setMintAllowanceUsage(ctx, usage)
+}
+
+// getCurrentMintAllowanceUsage returns the usage of the mint allowance in the current period. New period is started
+// if the previous one has ended.
+func (k Keeper) getCurrentMintAllowanceUsage(ctx sdk.Context, def types.Definition) (types.MintAllowanceUsage, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.CreateMintAllowanceUsageKey(def.Denom))
+	if bz != nil {
+		var usage types.MintAllowanceUsage
+		if err := k.cdc.Unmarshal(bz, &usage); err != nil {
+			return types.MintAllowanceUsage{}, sdkerrors.Wrapf(
+				types.ErrInvalidState, "failed to unmarshal mint allowance usage: %s", err,
+			)
+		}
+		if ctx.BlockTime().Before(usage.PeriodStart.Add(def.MintAllowance.Period)) {
+			return usage, nil
+		}
+	}
+
+	return types.MintAllowanceUsage{
+		Denom:       def.Denom,
+		PeriodStart: ctx.BlockTime().UTC(),
+		Minted:      sdkmath.ZeroInt(),
+	}, nil
+}
+
+func (k Keeper) setMintAllowanceUsage(ctx sdk.Context, usage types.MintAllowanceUsage) error {
+	bz, err := k.cdc.Marshal(&usage)
+	if err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "failed to marshal mint allowance usage: %s", err)
+	}
+
+	ctx.KVStore(k.storeKey).Set(types.CreateMintAllowanceUsageKey(usage.Denom), bz)
+
+	return nil
+}
@@ -0,0 +1,151 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreum/v3/testutil/simapp"
+	"github.com/CoreumFoundation/coreum/v3/x/asset/ft/types"
+)
+
+func TestKeeper_MaxSupply(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+
+	ftKeeper := testApp.AssetFTKeeper
+	bankKeeper := testApp.BankKeeper
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	// try to issue the token with initial amount exceeding the max supply
+	maxSupply := sdkmath.NewInt(1000)
+	settings := types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "DEF",
+		Subunit:       "def",
+		Precision:     1,
+		InitialAmount: sdkmath.NewInt(1001),
+		Features:      []types.Feature{types.Feature_minting, types.Feature_burning},
+		MaxSupply:     &maxSupply,
+	}
+	_, err := ftKeeper.Issue(ctx, settings)
+	requireT.ErrorIs(err, types.ErrInvalidInput)
+
+	settings.InitialAmount = sdkmath.NewInt(600)
+	denom, err := ftKeeper.Issue(ctx, settings)
+	requireT.NoError(err)
+
+	token, err := ftKeeper.GetToken(ctx, denom)
+	requireT.NoError(err)
+	requireT.Equal(maxSupply.String(), token.MaxSupply.String())
+	requireT.Equal("400", token.MintableAmount.String())
+
+	// try to mint more than the max supply
+	err = ftKeeper.Mint(ctx, issuer, issuer, sdk.NewInt64Coin(denom, 401))
+	requireT.ErrorIs(err, types.ErrMaxSupplyExceeded)
+
+	// mint up to the max supply
+	requireT.NoError(ftKeeper.Mint(ctx, issuer, issuer, sdk.NewInt64Coin(denom, 400)))
+	requireT.Equal(maxSupply.String(), bankKeeper.GetSupply(ctx, denom).Amount.String())
+
+	token, err = ftKeeper.GetToken(ctx, denom)
+	requireT.NoError(err)
+	requireT.Equal("0", token.MintableAmount.String())
+
+	err = ftKeeper.Mint(ctx, issuer, issuer, sdk.NewInt64Coin(denom, 1))
+	requireT.ErrorIs(err, types.ErrMaxSupplyExceeded)
+
+	// burning makes the room for minting again
+	requireT.NoError(ftKeeper.Burn(ctx, issuer, sdk.NewInt64Coin(denom, 100)))
+	token, err = ftKeeper.GetToken(ctx, denom)
+	requireT.NoError(err)
+	requireT.Equal("100", token.MintableAmount.String())
+	requireT.NoError(ftKeeper.Mint(ctx, issuer, issuer, sdk.NewInt64Coin(denom, 100)))
+
+	// the mintable amount is not set if neither max supply nor mint allowance is set
+	settings.Subunit = "abc"
+	settings.Symbol = "ABC"
+	settings.MaxSupply = nil
+	denom, err = ftKeeper.Issue(ctx, settings)
+	requireT.NoError(err)
+	token, err = ftKeeper.GetToken(ctx, denom)
+	requireT.NoError(err)
+	requireT.Nil(token.MaxSupply)
+	requireT.Nil(token.MintableAmount)
+}
+
+func TestKeeper_MintAllowance(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	blockTime := time.Date(2023, 2, 13, 1, 2, 3, 0, time.UTC)
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{}).WithBlockTime(blockTime)
+
+	ftKeeper := testApp.AssetFTKeeper
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	recipient := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	maxSupply := sdkmath.NewInt(1000)
+	denom, err := ftKeeper.Issue(ctx, types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "DEF",
+		Subunit:       "def",
+		Precision:     1,
+		InitialAmount: sdkmath.NewInt(500),
+		Features:      []types.Feature{types.Feature_minting},
+		MaxSupply:     &maxSupply,
+		MintAllowance: &types.MintAllowance{
+			Amount: sdkmath.NewInt(200),
+			Period: time.Hour,
+		},
+	})
+	requireT.NoError(err)
+
+	// the initial amount doesn't use the mint allowance
+	token, err := ftKeeper.GetToken(ctx, denom)
+	requireT.NoError(err)
+	requireT.Equal("200", token.MintableAmount.String())
+
+	requireT.NoError(ftKeeper.Mint(ctx, issuer, recipient, sdk.NewInt64Coin(denom, 150)))
+	token, err = ftKeeper.GetToken(ctx, denom)
+	requireT.NoError(err)
+	requireT.Equal("50", token.MintableAmount.String())
+
+	// try to exceed the allowance within the period
+	ctx = ctx.WithBlockTime(blockTime.Add(59 * time.Minute))
+	err = ftKeeper.Mint(ctx, issuer, recipient, sdk.NewInt64Coin(denom, 51))
+	requireT.ErrorIs(err, types.ErrMintAllowanceExceeded)
+	requireT.NoError(ftKeeper.Mint(ctx, issuer, recipient, sdk.NewInt64Coin(denom, 50)))
+
+	// the allowance is renewed in the next period
+	ctx = ctx.WithBlockTime(blockTime.Add(time.Hour))
+	token, err = ftKeeper.GetToken(ctx, denom)
+	requireT.NoError(err)
+	requireT.Equal("200", token.MintableAmount.String())
+	requireT.NoError(ftKeeper.Mint(ctx, issuer, recipient, sdk.NewInt64Coin(denom, 200)))
+
+	// the usage is exported
+	usages, err := ftKeeper.ExportMintAllowanceUsages(ctx)
+	requireT.NoError(err)
+	requireT.Len(usages, 1)
+	requireT.Equal(denom, usages[0].Denom)
+	requireT.Equal(blockTime.Add(time.Hour), usages[0].PeriodStart)
+	requireT.Equal("200", usages[0].Minted.String())
+
+	// the max supply is lower than the renewed allowance
+	ctx = ctx.WithBlockTime(blockTime.Add(2 * time.Hour))
+	token, err = ftKeeper.GetToken(ctx, denom)
+	requireT.NoError(err)
+	requireT.Equal("100", token.MintableAmount.String())
+	err = ftKeeper.Mint(ctx, issuer, recipient, sdk.NewInt64Coin(denom, 101))
+	requireT.ErrorIs(err, types.ErrMaxSupplyExceeded)
+}
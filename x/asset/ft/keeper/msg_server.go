@@ -2,8 +2,11 @@ package keeper
 
 import (
 	"context"
+	"time"
 
 	sdkerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
 
@@ -22,10 +25,42 @@ type MsgKeeper interface {
 	Freeze(ctx sdk.Context, sender, addr sdk.AccAddress, coin sdk.Coin) error
 	Unfreeze(ctx sdk.Context, sender, addr sdk.AccAddress, coin sdk.Coin) error
 	SetFrozen(ctx sdk.Context, sender, addr sdk.AccAddress, coin sdk.Coin) error
+	FreezeUntil(ctx sdk.Context, sender, addr sdk.AccAddress, coin sdk.Coin, unfreezeTime time.Time) error
+	Clawback(ctx sdk.Context, sender, addr sdk.AccAddress, coin sdk.Coin) error
 	GloballyFreeze(ctx sdk.Context, sender sdk.AccAddress, denom string) error
 	GloballyUnfreeze(ctx sdk.Context, sender sdk.AccAddress, denom string) error
 	SetWhitelistedBalance(ctx sdk.Context, sender, addr sdk.AccAddress, coin sdk.Coin) error
+	TransferAdmin(ctx sdk.Context, sender, addr sdk.AccAddress, denom string) error
+	ClearAdmin(ctx sdk.Context, sender sdk.AccAddress, denom string) error
+	SetRateExemption(ctx sdk.Context, sender, addr sdk.AccAddress, denom string) error
+	RemoveRateExemption(ctx sdk.Context, sender, addr sdk.AccAddress, denom string) error
+	UpdateRates(ctx sdk.Context, sender sdk.AccAddress, denom string, burnRate, sendCommissionRate *sdk.Dec) error
+	SetTransferLimit(
+		ctx sdk.Context,
+		sender, addr sdk.AccAddress,
+		denom string,
+		amount sdkmath.Int,
+		period time.Duration,
+	) error
+	RemoveTransferLimit(ctx sdk.Context, sender, addr sdk.AccAddress, denom string) error
+	BlockAccounts(ctx sdk.Context, sender sdk.AccAddress, denom string, addrs []sdk.AccAddress) error
+	UnblockAccounts(ctx sdk.Context, sender sdk.AccAddress, denom string, addrs []sdk.AccAddress) error
+	CreateVestingSchedule(ctx sdk.Context, sender sdk.AccAddress, schedule types.VestingSchedule) error
+	Distribute(ctx sdk.Context, sender sdk.AccAddress, denom string, amount sdk.Coin) error
+	UpdateMetadata(
+		ctx sdk.Context,
+		sender sdk.AccAddress,
+		denom, description, uri, uriHash string,
+		data *codectypes.Any,
+	) error
 	AddDelayedTokenUpgradeV1(ctx sdk.Context, sender sdk.AccAddress, denom string, ibcEnabled bool) error
+	UpgradeToken(
+		ctx sdk.Context,
+		sender sdk.AccAddress,
+		denom string,
+		version uint32,
+		options types.TokenUpgradeOptions,
+	) error
 	UpdateParams(ctx sdk.Context, authority string, params types.Params) error
 }
 
@@ -57,6 +92,11 @@ func (ms MsgServer) Issue(ctx context.Context, req *types.MsgIssue) (*types.Empt
 		Features:           req.Features,
 		BurnRate:           req.BurnRate,
 		SendCommissionRate: req.SendCommissionRate,
+		URI:                req.URI,
+		URIHash:            req.URIHash,
+		Data:               req.Data,
+		MaxSupply:          req.MaxSupply,
+		MintAllowance:      req.MintAllowance,
 	})
 	if err != nil {
 		return nil, err
@@ -89,6 +129,28 @@ func (ms MsgServer) Mint(goCtx context.Context, req *types.MsgMint) (*types.Empt
 	return &types.EmptyResponse{}, nil
 }
 
+// MultiMint mints new tokens to multiple recipients.
+func (ms MsgServer) MultiMint(goCtx context.Context, req *types.MsgMultiMint) (*types.EmptyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	for _, entry := range req.Entries {
+		recipient, err := sdk.AccAddressFromBech32(entry.Account)
+		if err != nil {
+			return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid recipient address")
+		}
+
+		if err := ms.keeper.Mint(ctx, sender, recipient, entry.Coin); err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
 // Burn a part of the token.
 func (ms MsgServer) Burn(goCtx context.Context, req *types.MsgBurn) (*types.EmptyResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -118,7 +180,11 @@ func (ms MsgServer) Freeze(goCtx context.Context, req *types.MsgFreeze) (*types.
 		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid account address")
 	}
 
-	err = ms.keeper.Freeze(ctx, sender, account, req.Coin)
+	if req.UnfreezeTime != nil {
+		err = ms.keeper.FreezeUntil(ctx, sender, account, req.Coin, *req.UnfreezeTime)
+	} else {
+		err = ms.keeper.Freeze(ctx, sender, account, req.Coin)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -126,6 +192,28 @@ func (ms MsgServer) Freeze(goCtx context.Context, req *types.MsgFreeze) (*types.
 	return &types.EmptyResponse{}, nil
 }
 
+// MultiFreeze freezes coins in multiple accounts.
+func (ms MsgServer) MultiFreeze(goCtx context.Context, req *types.MsgMultiFreeze) (*types.EmptyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	for _, entry := range req.Entries {
+		account, err := sdk.AccAddressFromBech32(entry.Account)
+		if err != nil {
+			return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid account address")
+		}
+
+		if err := ms.keeper.Freeze(ctx, sender, account, entry.Coin); err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
 // Unfreeze unfreezes coins on an account.
 func (ms MsgServer) Unfreeze(goCtx context.Context, req *types.MsgUnfreeze) (*types.EmptyResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -219,6 +307,298 @@ func (ms MsgServer) SetWhitelistedLimit(goCtx context.Context, req *types.MsgSet
 	return &types.EmptyResponse{}, nil
 }
 
+// MultiSetWhitelistedLimit sets the whitelisted limits of multiple accounts.
+func (ms MsgServer) MultiSetWhitelistedLimit(
+	goCtx context.Context,
+	req *types.MsgMultiSetWhitelistedLimit,
+) (*types.EmptyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	for _, entry := range req.Entries {
+		account, err := sdk.AccAddressFromBech32(entry.Account)
+		if err != nil {
+			return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid account address")
+		}
+
+		if err := ms.keeper.SetWhitelistedBalance(ctx, sender, account, entry.Coin); err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// Clawback returns coins from an account to the issuer.
+func (ms MsgServer) Clawback(goCtx context.Context, req *types.MsgClawback) (*types.EmptyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	account, err := sdk.AccAddressFromBech32(req.Account)
+	if err != nil {
+		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid account address")
+	}
+
+	err = ms.keeper.Clawback(ctx, sender, account, req.Coin)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// TransferAdmin changes the admin of the token.
+func (ms MsgServer) TransferAdmin(goCtx context.Context, req *types.MsgTransferAdmin) (*types.EmptyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	account, err := sdk.AccAddressFromBech32(req.Account)
+	if err != nil {
+		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid account address")
+	}
+
+	err = ms.keeper.TransferAdmin(ctx, sender, account, req.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// ClearAdmin removes the admin of the token.
+func (ms MsgServer) ClearAdmin(goCtx context.Context, req *types.MsgClearAdmin) (*types.EmptyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	err = ms.keeper.ClearAdmin(ctx, sender, req.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// UpdateMetadata updates the metadata of the token.
+func (ms MsgServer) UpdateMetadata(goCtx context.Context, req *types.MsgUpdateMetadata) (*types.EmptyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	err = ms.keeper.UpdateMetadata(ctx, sender, req.Denom, req.Description, req.URI, req.URIHash, req.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// SetRateExemption exempts the account from the rates of the token.
+func (ms MsgServer) SetRateExemption(goCtx context.Context, req *types.MsgSetRateExemption) (*types.EmptyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	account, err := sdk.AccAddressFromBech32(req.Account)
+	if err != nil {
+		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid account address")
+	}
+
+	err = ms.keeper.SetRateExemption(ctx, sender, account, req.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// RemoveRateExemption removes the account from the rate exemptions of the token.
+func (ms MsgServer) RemoveRateExemption(goCtx context.Context, req *types.MsgRemoveRateExemption) (*types.EmptyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	account, err := sdk.AccAddressFromBech32(req.Account)
+	if err != nil {
+		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid account address")
+	}
+
+	err = ms.keeper.RemoveRateExemption(ctx, sender, account, req.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// UpdateRates changes the burn rate and send commission rate of the token.
+func (ms MsgServer) UpdateRates(goCtx context.Context, req *types.MsgUpdateRates) (*types.EmptyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	err = ms.keeper.UpdateRates(ctx, sender, req.Denom, req.BurnRate, req.SendCommissionRate)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// SetTransferLimit sets the amount the account might send within the rolling period.
+func (ms MsgServer) SetTransferLimit(goCtx context.Context, req *types.MsgSetTransferLimit) (*types.EmptyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	// the default limit is set if the account is empty
+	var account sdk.AccAddress
+	if req.Account != "" {
+		account, err = sdk.AccAddressFromBech32(req.Account)
+		if err != nil {
+			return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid account address")
+		}
+	}
+
+	err = ms.keeper.SetTransferLimit(ctx, sender, account, req.Denom, req.Amount, req.Period)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// RemoveTransferLimit removes the transfer limit of the account.
+func (ms MsgServer) RemoveTransferLimit(
+	goCtx context.Context,
+	req *types.MsgRemoveTransferLimit,
+) (*types.EmptyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	// the default limit is removed if the account is empty
+	var account sdk.AccAddress
+	if req.Account != "" {
+		account, err = sdk.AccAddressFromBech32(req.Account)
+		if err != nil {
+			return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid account address")
+		}
+	}
+
+	err = ms.keeper.RemoveTransferLimit(ctx, sender, account, req.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// BlockAccounts adds the accounts to the blocklist of the token.
+func (ms MsgServer) BlockAccounts(goCtx context.Context, req *types.MsgBlockAccounts) (*types.EmptyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	accounts, err := accAddressesFromBech32(req.Accounts)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ms.keeper.BlockAccounts(ctx, sender, req.Denom, accounts); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// UnblockAccounts removes the accounts from the blocklist of the token.
+func (ms MsgServer) UnblockAccounts(goCtx context.Context, req *types.MsgUnblockAccounts) (*types.EmptyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	accounts, err := accAddressesFromBech32(req.Accounts)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ms.keeper.UnblockAccounts(ctx, sender, req.Denom, accounts); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// CreateVestingSchedule sends the coin to the account and locks it until it is vested.
+func (ms MsgServer) CreateVestingSchedule(
+	goCtx context.Context,
+	req *types.MsgCreateVestingSchedule,
+) (*types.EmptyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if err := ms.keeper.CreateVestingSchedule(ctx, sender, types.VestingSchedule{
+		Denom:       req.Coin.Denom,
+		Account:     req.Account,
+		VestingType: req.VestingType,
+		Amount:      req.Coin.Amount,
+		StartTime:   req.StartTime,
+		EndTime:     req.EndTime,
+		Periods:     req.Periods,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// Distribute starts the distribution of the pool to the holders of the token.
+func (ms MsgServer) Distribute(goCtx context.Context, req *types.MsgDistribute) (*types.EmptyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	err = ms.keeper.Distribute(ctx, sender, req.Denom, req.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
 // UpgradeTokenV1 stores a request to upgrade token to V1.
 func (ms MsgServer) UpgradeTokenV1(goCtx context.Context, req *types.MsgUpgradeTokenV1) (*types.EmptyResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -235,6 +615,22 @@ func (ms MsgServer) UpgradeTokenV1(goCtx context.Context, req *types.MsgUpgradeT
 	return &types.EmptyResponse{}, nil
 }
 
+// UpgradeToken upgrades the token to the version.
+func (ms MsgServer) UpgradeToken(goCtx context.Context, req *types.MsgUpgradeToken) (*types.EmptyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	err = ms.keeper.UpgradeToken(ctx, sender, req.Denom, req.Version, req.Options)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
 // UpdateParams is a governance operation that sets parameters of the module.
 func (ms MsgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams) (*types.EmptyResponse, error) {
 	if err := ms.keeper.UpdateParams(sdk.UnwrapSDKContext(goCtx), req.Authority, req.Params); err != nil {
@@ -243,3 +639,16 @@ func (ms MsgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdatePara
 
 	return &types.EmptyResponse{}, nil
 }
+
+func accAddressesFromBech32(accounts []string) ([]sdk.AccAddress, error) {
+	addrs := make([]sdk.AccAddress, 0, len(accounts))
+	for _, account := range accounts {
+		addr, err := sdk.AccAddressFromBech32(account)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(cosmoserrors.ErrInvalidAddress, "invalid account address %s", account)
+		}
+		addrs = append(addrs, addr)
+	}
+
+	return addrs, nil
+}
@@ -0,0 +1,116 @@
+package keeper
+
+import (
+	"bytes"
+
+	sdkerrors "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/CoreumFoundation/coreum/v3/x/asset/ft/types"
+)
+
+// SetRateExemption exempts the account from the burn rate and send commission rate of the token.
+func (k Keeper) SetRateExemption(ctx sdk.Context, sender, addr sdk.AccAddress, denom string) error {
+	if _, err := k.adminChecks(ctx, sender, denom); err != nil {
+		return err
+	}
+
+	k.setRateExemption(ctx, denom, addr)
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventRateExemptionSet{
+		Denom:   denom,
+		Account: addr.String(),
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit EventRateExemptionSet event: %s", err)
+	}
+
+	return nil
+}
+
+// RemoveRateExemption removes the account from the rate exemptions of the token.
+func (k Keeper) RemoveRateExemption(ctx sdk.Context, sender, addr sdk.AccAddress, denom string) error {
+	if _, err := k.adminChecks(ctx, sender, denom); err != nil {
+		return err
+	}
+
+	if !k.IsRateExempt(ctx, denom, addr) {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "account %s is not exempted from the rates of %s", addr, denom)
+	}
+
+	ctx.KVStore(k.storeKey).Delete(types.CreateRateExemptionKey(denom, addr))
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventRateExemptionRemoved{
+		Denom:   denom,
+		Account: addr.String(),
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit EventRateExemptionRemoved event: %s", err)
+	}
+
+	return nil
+}
+
+// IsRateExempt returns true if the account is exempted from the rates of the token.
+func (k Keeper) IsRateExempt(ctx sdk.Context, denom string, addr sdk.AccAddress) bool {
+	return bytes.Equal(ctx.KVStore(k.storeKey).Get(types.CreateRateExemptionKey(denom, addr)), types.StoreTrue)
+}
+
+// GetRateExemptions returns the accounts exempted from the rates of the token.
+func (k Keeper) GetRateExemptions(
+	ctx sdk.Context,
+	denom string,
+	pagination *query.PageRequest,
+) ([]string, *query.PageResponse, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.CreateRateExemptionsPrefix(denom))
+	accounts := make([]string, 0)
+	pageRes, err := query.Paginate(store, pagination, func(key, _ []byte) error {
+		accounts = append(accounts, sdk.AccAddress(key).String())
+		return nil
+	})
+	if err != nil {
+		return nil, nil, sdkerrors.Wrapf(types.ErrInvalidInput, "failed to paginate: %s", err)
+	}
+
+	return accounts, pageRes, nil
+}
+
+// ImportRateExemptions imports the rate exemptions from genesis state.
+func (k Keeper) ImportRateExemptions(ctx sdk.Context, rateExemptions []types.RateExemption) error {
+	for _, rateExemption := range rateExemptions {
+		addr, err := sdk.AccAddressFromBech32(rateExemption.Account)
+		if err != nil {
+			return sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid account address")
+		}
+		k.setRateExemption(ctx, rateExemption.Denom, addr)
+	}
+	return nil
+}
+
+// ExportRateExemptions exports the rate exemptions.
+func (k Keeper) ExportRateExemptions(ctx sdk.Context) ([]types.RateExemption, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RateExemptionsKeyPrefix)
+	rateExemptions := make([]types.RateExemption, 0)
+	_, err := query.Paginate(store, &query.PageRequest{Limit: query.MaxLimit}, func(key, _ []byte) error {
+		denom, addr, err := types.DenomAndAddressFromRateExemptionKey(key)
+		if err != nil {
+			return err
+		}
+		rateExemptions = append(rateExemptions, types.RateExemption{
+			Denom:   denom,
+			Account: addr.String(),
+		})
+
+		return nil
+	})
+	if err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidInput, "failed to paginate: %s", err)
+	}
+
+	return rateExemptions, nil
+}
+
+func (k Keeper) setRateExemption(ctx sdk.Context, denom string, addr sdk.AccAddress) {
+	ctx.KVStore(k.storeKey).Set(types.CreateRateExemptionKey(denom, addr), types.StoreTrue)
+}
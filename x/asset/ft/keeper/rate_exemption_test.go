@@ -0,0 +1,96 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreum/v3/testutil/simapp"
+	"github.com/CoreumFoundation/coreum/v3/x/asset/ft/types"
+)
+
+func TestKeeper_RateExemption(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+
+	ftKeeper := testApp.AssetFTKeeper
+	bankKeeper := testApp.BankKeeper
+	ba := newBankAsserter(ctx, t, bankKeeper)
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	denom, err := ftKeeper.Issue(ctx, types.IssueSettings{
+		Issuer:             issuer,
+		Symbol:             "DEF",
+		Subunit:            "def",
+		Precision:          1,
+		InitialAmount:      sdkmath.NewInt(1000),
+		BurnRate:           sdk.MustNewDecFromStr("0.1"),
+		SendCommissionRate: sdk.MustNewDecFromStr("0.1"),
+	})
+	requireT.NoError(err)
+
+	exchange := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	recipient := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	requireT.NoError(bankKeeper.SendCoins(ctx, issuer, exchange, sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(500)))))
+
+	// try to set the exemption from non admin account
+	err = ftKeeper.SetRateExemption(ctx, exchange, exchange, denom)
+	requireT.ErrorIs(err, cosmoserrors.ErrUnauthorized)
+
+	// set the exemption
+	requireT.NoError(ftKeeper.SetRateExemption(ctx, issuer, exchange, denom))
+	requireT.True(ftKeeper.IsRateExempt(ctx, denom, exchange))
+	requireT.False(ftKeeper.IsRateExempt(ctx, denom, recipient))
+
+	exemptions, _, err := ftKeeper.GetRateExemptions(ctx, denom, nil)
+	requireT.NoError(err)
+	requireT.Equal([]string{exchange.String()}, exemptions)
+
+	// send from the exempted account (rates must not apply)
+	requireT.NoError(bankKeeper.SendCoins(ctx, exchange, recipient, sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(100)))))
+	ba.assertCoinDistribution(denom, map[*sdk.AccAddress]int64{
+		&issuer:    500,
+		&exchange:  400,
+		&recipient: 100,
+	})
+
+	// send to the exempted account (rates must not apply)
+	requireT.NoError(bankKeeper.SendCoins(ctx, recipient, exchange, sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(50)))))
+	ba.assertCoinDistribution(denom, map[*sdk.AccAddress]int64{
+		&issuer:    500,
+		&exchange:  450,
+		&recipient: 50,
+	})
+
+	// try to remove the exemption from non admin account
+	err = ftKeeper.RemoveRateExemption(ctx, exchange, exchange, denom)
+	requireT.ErrorIs(err, cosmoserrors.ErrUnauthorized)
+
+	// remove the exemption
+	requireT.NoError(ftKeeper.RemoveRateExemption(ctx, issuer, exchange, denom))
+	requireT.False(ftKeeper.IsRateExempt(ctx, denom, exchange))
+
+	// try to remove the exemption which doesn't exist
+	err = ftKeeper.RemoveRateExemption(ctx, issuer, exchange, denom)
+	requireT.ErrorIs(err, types.ErrInvalidInput)
+
+	// send to the account which is not exempted anymore (rates must apply)
+	requireT.NoError(bankKeeper.SendCoins(ctx, recipient, exchange, sdk.NewCoins(sdk.NewCoin(denom, sdkmath.NewInt(10)))))
+	ba.assertCoinDistribution(denom, map[*sdk.AccAddress]int64{
+		&issuer:    501,
+		&exchange:  460,
+		&recipient: 38,
+	})
+
+	exemptions, _, err = ftKeeper.GetRateExemptions(ctx, denom, nil)
+	requireT.NoError(err)
+	requireT.Empty(exemptions)
+}
@@ -0,0 +1,234 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdkerrors "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/CoreumFoundation/coreum/v3/x/asset/ft/types"
+	delaytypes "github.com/CoreumFoundation/coreum/v3/x/delay/types"
+)
+
+// UpdateRates changes the burn rate and send commission rate of the token. The rates which are not increased are
+// applied immediately, while the increased ones are applied when the notice period ends, so the holders have time
+// to react. The notice period is equal to the token upgrade grace period.
+func (k Keeper) UpdateRates(ctx sdk.Context, sender sdk.AccAddress, denom string, burnRate, sendCommissionRate *sdk.Dec) error {
+	if err := types.ValidateRatesUpdate(burnRate, sendCommissionRate); err != nil {
+		return err
+	}
+
+	def, err := k.adminChecks(ctx, sender, denom)
+	if err != nil {
+		return err
+	}
+
+	pendingUpdate, pendingFound, err := k.getPendingRatesUpdate(ctx, denom)
+	if err != nil {
+		return err
+	}
+	if !pendingFound {
+		pendingUpdate = types.PendingRatesUpdate{Denom: denom}
+	}
+
+	var updated, increased bool
+	// the latest request of the admin replaces the pending increase of the same rate
+	if burnRate != nil {
+		if burnRate.GT(def.BurnRate) {
+			pendingUpdate.BurnRate = burnRate
+			increased = true
+		} else {
+			def.BurnRate = *burnRate
+			pendingUpdate.BurnRate = nil
+			updated = true
+		}
+	}
+	if sendCommissionRate != nil {
+		if sendCommissionRate.GT(def.SendCommissionRate) {
+			pendingUpdate.SendCommissionRate = sendCommissionRate
+			increased = true
+		} else {
+			def.SendCommissionRate = *sendCommissionRate
+			pendingUpdate.SendCommissionRate = nil
+			updated = true
+		}
+	}
+
+	if updated {
+		if err := k.applyRates(ctx, def); err != nil {
+			return err
+		}
+	}
+
+	switch {
+	case increased:
+		// the notice period is restarted whenever the increase is requested
+		if pendingFound {
+			if err := k.removeDelayedRatesUpdate(ctx, denom); err != nil {
+				return err
+			}
+		}
+		gracePeriod := k.GetParams(ctx).TokenUpgradeGracePeriod
+		pendingUpdate.EffectiveTime = ctx.BlockTime().Add(gracePeriod)
+		if err := k.setPendingRatesUpdate(ctx, pendingUpdate); err != nil {
+			return err
+		}
+		if err := k.delayKeeper.DelayExecution(
+			ctx,
+			ratesUpdateID(denom),
+			&types.DelayedRatesUpdate{Denom: denom},
+			gracePeriod,
+		); err != nil {
+			return err
+		}
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventRatesUpdateScheduled{
+			Denom:              denom,
+			BurnRate:           pendingUpdate.BurnRate,
+			SendCommissionRate: pendingUpdate.SendCommissionRate,
+			EffectiveTime:      pendingUpdate.EffectiveTime,
+		}); err != nil {
+			return sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit EventRatesUpdateScheduled event: %s", err)
+		}
+	case pendingFound && pendingUpdate.BurnRate == nil && pendingUpdate.SendCommissionRate == nil:
+		// all the pending increases are replaced by the immediate changes
+		ctx.KVStore(k.storeKey).Delete(types.CreatePendingRatesUpdateKey(denom))
+		if err := k.removeDelayedRatesUpdate(ctx, denom); err != nil {
+			return err
+		}
+	case pendingFound:
+		if err := k.setPendingRatesUpdate(ctx, pendingUpdate); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// UpdateRatesDelayed applies the pending rates update when its notice period ends.
+func (k Keeper) UpdateRatesDelayed(ctx sdk.Context, data *types.DelayedRatesUpdate) error {
+	pendingUpdate, found, err := k.getPendingRatesUpdate(ctx, data.Denom)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "pending rates update for denom %s not found", data.Denom)
+	}
+
+	def, err := k.GetDefinition(ctx, data.Denom)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "not able to get token info for denom:%s", data.Denom)
+	}
+
+	if pendingUpdate.BurnRate != nil {
+		def.BurnRate = *pendingUpdate.BurnRate
+	}
+	if pendingUpdate.SendCommissionRate != nil {
+		def.SendCommissionRate = *pendingUpdate.SendCommissionRate
+	}
+
+	ctx.KVStore(k.storeKey).Delete(types.CreatePendingRatesUpdateKey(data.Denom))
+
+	return k.applyRates(ctx, def)
+}
+
+// GetPendingRatesUpdate returns the rates increase waiting for the end of the notice period.
+func (k Keeper) GetPendingRatesUpdate(ctx sdk.Context, denom string) (*types.PendingRatesUpdate, error) {
+	pendingUpdate, found, err := k.getPendingRatesUpdate(ctx, denom)
+	if err != nil || !found {
+		return nil, err
+	}
+
+	return &pendingUpdate, nil
+}
+
+// ImportPendingRatesUpdates imports the pending rates updates from genesis state.
+func (k Keeper) ImportPendingRatesUpdates(ctx sdk.Context, pendingUpdates []types.PendingRatesUpdate) error {
+	for _, pendingUpdate := range pendingUpdates {
+		if err := k.setPendingRatesUpdate(ctx, pendingUpdate); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ExportPendingRatesUpdates exports the pending rates updates.
+func (k Keeper) ExportPendingRatesUpdates(ctx sdk.Context) ([]types.PendingRatesUpdate, error) {
+	pendingUpdates := make([]types.PendingRatesUpdate, 0)
+	_, err := query.Paginate(
+		prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingRatesUpdateKeyPrefix),
+		&query.PageRequest{Limit: query.MaxLimit},
+		func(_, value []byte) error {
+			var pendingUpdate types.PendingRatesUpdate
+			if err := k.cdc.Unmarshal(value, &pendingUpdate); err != nil {
+				return sdkerrors.Wrapf(types.ErrInvalidState, "failed to unmarshal pending rates update: %s", err)
+			}
+			pendingUpdates = append(pendingUpdates, pendingUpdate)
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidInput, "failed to paginate: %s", err)
+	}
+
+	return pendingUpdates, nil
+}
+
+func (k Keeper) applyRates(ctx sdk.Context, def types.Definition) error {
+	if err := k.updateDefinition(ctx, def); err != nil {
+		return err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventRatesUpdated{
+		Denom:              def.Denom,
+		BurnRate:           def.BurnRate,
+		SendCommissionRate: def.SendCommissionRate,
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit EventRatesUpdated event: %s", err)
+	}
+
+	return nil
+}
+
+func (k Keeper) getPendingRatesUpdate(ctx sdk.Context, denom string) (types.PendingRatesUpdate, bool, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.CreatePendingRatesUpdateKey(denom))
+	if bz == nil {
+		return types.PendingRatesUpdate{}, false, nil
+	}
+
+	var pendingUpdate types.PendingRatesUpdate
+	if err := k.cdc.Unmarshal(bz, &pendingUpdate); err != nil {
+		return types.PendingRatesUpdate{}, false, sdkerrors.Wrapf(
+			types.ErrInvalidState, "failed to unmarshal pending rates update: %s", err,
+		)
+	}
+
+	return pendingUpdate, true, nil
+}
+
+func (k Keeper) setPendingRatesUpdate(ctx sdk.Context, pendingUpdate types.PendingRatesUpdate) error {
+	bz, err := k.cdc.Marshal(&pendingUpdate)
+	if err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "failed to marshal pending rates update: %s", err)
+	}
+
+	ctx.KVStore(k.storeKey).Set(types.CreatePendingRatesUpdateKey(pendingUpdate.Denom), bz)
+
+	return nil
+}
+
+// removeDelayedRatesUpdate removes the scheduled execution of the pending rates update.
+// The item is not found if its execution failed, then it is kept in the failed items of the delay module and the
+// pending update is replaced anyway, so the admin is not blocked.
+func (k Keeper) removeDelayedRatesUpdate(ctx sdk.Context, denom string) error {
+	if err := k.delayKeeper.RemoveDelayedExecution(ctx, ratesUpdateID(denom)); err != nil &&
+		!delaytypes.ErrNotFound.Is(err) {
+		return err
+	}
+	return nil
+}
+
+func ratesUpdateID(denom string) string {
+	return fmt.Sprintf("%s-rates-%s", types.ModuleName, denom)
+}
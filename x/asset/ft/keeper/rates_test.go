@@ -0,0 +1,236 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreum/v3/app"
+	"github.com/CoreumFoundation/coreum/v3/pkg/config"
+	"github.com/CoreumFoundation/coreum/v3/testutil/event"
+	"github.com/CoreumFoundation/coreum/v3/testutil/simapp"
+	"github.com/CoreumFoundation/coreum/v3/x/asset/ft/types"
+	delaytypes "github.com/CoreumFoundation/coreum/v3/x/delay/types"
+)
+
+func TestKeeper_UpdateRates(t *testing.T) {
+	requireT := require.New(t)
+
+	cdc := config.NewEncodingConfig(app.ModuleBasics).Codec
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{}).
+		WithBlockTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	ftKeeper := testApp.AssetFTKeeper
+	delayKeeper := testApp.DelayKeeper
+	noticePeriod := ftKeeper.GetParams(ctx).TokenUpgradeGracePeriod
+
+	issuer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	denom, err := ftKeeper.Issue(ctx, types.IssueSettings{
+		Issuer:             issuer,
+		Symbol:             "ABC",
+		Subunit:            "abc",
+		Precision:          8,
+		InitialAmount:      sdkmath.NewInt(777),
+		BurnRate:           sdk.MustNewDecFromStr("0.5"),
+		SendCommissionRate: sdk.MustNewDecFromStr("0.4"),
+	})
+	requireT.NoError(err)
+
+	// call from non-admin account fails
+	randomAddr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	err = ftKeeper.UpdateRates(ctx, randomAddr, denom, lo.ToPtr(sdk.MustNewDecFromStr("0.1")), nil)
+	requireT.ErrorIs(err, cosmoserrors.ErrUnauthorized)
+
+	// call without the rates fails
+	err = ftKeeper.UpdateRates(ctx, issuer, denom, nil, nil)
+	requireT.ErrorIs(err, types.ErrInvalidInput)
+
+	// invalid rate fails
+	err = ftKeeper.UpdateRates(ctx, issuer, denom, lo.ToPtr(sdk.MustNewDecFromStr("1.1")), nil)
+	requireT.ErrorIs(err, types.ErrInvalidInput)
+
+	// decreasing the rate is applied immediately
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	requireT.NoError(ftKeeper.UpdateRates(ctx, issuer, denom, lo.ToPtr(sdk.MustNewDecFromStr("0.1")), nil))
+
+	updatedEvents, err := event.FindTypedEvents[*types.EventRatesUpdated](ctx.EventManager().Events().ToABCIEvents())
+	requireT.NoError(err)
+	requireT.Equal([]*types.EventRatesUpdated{{
+		Denom:              denom,
+		BurnRate:           sdk.MustNewDecFromStr("0.1"),
+		SendCommissionRate: sdk.MustNewDecFromStr("0.4"),
+	}}, updatedEvents)
+
+	token, err := ftKeeper.GetToken(ctx, denom)
+	requireT.NoError(err)
+	requireT.Equal(sdk.MustNewDecFromStr("0.1").String(), token.BurnRate.String())
+	requireT.Equal(sdk.MustNewDecFromStr("0.4").String(), token.SendCommissionRate.String())
+	requireT.Nil(token.PendingRatesUpdate)
+
+	delayedItems, err := delayKeeper.ExportDelayedItems(ctx)
+	requireT.NoError(err)
+	requireT.Empty(delayedItems)
+
+	// increasing the rate is applied when the notice period ends, while the decrease is applied immediately
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	requireT.NoError(ftKeeper.UpdateRates(
+		ctx, issuer, denom, lo.ToPtr(sdk.MustNewDecFromStr("0.3")), lo.ToPtr(sdk.MustNewDecFromStr("0.2")),
+	))
+
+	effectiveTime := ctx.BlockTime().Add(noticePeriod)
+	scheduledEvents, err := event.FindTypedEvents[*types.EventRatesUpdateScheduled](
+		ctx.EventManager().Events().ToABCIEvents(),
+	)
+	requireT.NoError(err)
+	requireT.Equal([]*types.EventRatesUpdateScheduled{{
+		Denom:         denom,
+		BurnRate:      lo.ToPtr(sdk.MustNewDecFromStr("0.3")),
+		EffectiveTime: effectiveTime,
+	}}, scheduledEvents)
+
+	token, err = ftKeeper.GetToken(ctx, denom)
+	requireT.NoError(err)
+	requireT.Equal(sdk.MustNewDecFromStr("0.1").String(), token.BurnRate.String())
+	requireT.Equal(sdk.MustNewDecFromStr("0.2").String(), token.SendCommissionRate.String())
+	requireT.Equal(&types.PendingRatesUpdate{
+		Denom:         denom,
+		BurnRate:      lo.ToPtr(sdk.MustNewDecFromStr("0.3")),
+		EffectiveTime: effectiveTime,
+	}, token.PendingRatesUpdate)
+
+	delayedItems, err = delayKeeper.ExportDelayedItems(ctx)
+	requireT.NoError(err)
+	requireT.Len(delayedItems, 1)
+	requireT.Equal("assetft-rates-"+denom, delayedItems[0].Id)
+	requireT.Equal(effectiveTime, delayedItems[0].ExecutionTime)
+
+	// the next increase replaces the pending one and restarts the notice period
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	requireT.NoError(ftKeeper.UpdateRates(ctx, issuer, denom, nil, lo.ToPtr(sdk.MustNewDecFromStr("0.6"))))
+
+	effectiveTime = ctx.BlockTime().Add(noticePeriod)
+	pendingUpdate, err := ftKeeper.GetPendingRatesUpdate(ctx, denom)
+	requireT.NoError(err)
+	requireT.Equal(&types.PendingRatesUpdate{
+		Denom:              denom,
+		BurnRate:           lo.ToPtr(sdk.MustNewDecFromStr("0.3")),
+		SendCommissionRate: lo.ToPtr(sdk.MustNewDecFromStr("0.6")),
+		EffectiveTime:      effectiveTime,
+	}, pendingUpdate)
+
+	delayedItems, err = delayKeeper.ExportDelayedItems(ctx)
+	requireT.NoError(err)
+	requireT.Len(delayedItems, 1)
+	requireT.Equal(effectiveTime, delayedItems[0].ExecutionTime)
+
+	// the pending rates are exported
+	exported, err := ftKeeper.ExportPendingRatesUpdates(ctx)
+	requireT.NoError(err)
+	requireT.Equal([]types.PendingRatesUpdate{*pendingUpdate}, exported)
+
+	// decreasing the rate cancels its pending increase
+	requireT.NoError(ftKeeper.UpdateRates(ctx, issuer, denom, lo.ToPtr(sdk.MustNewDecFromStr("0.05")), nil))
+	pendingUpdate, err = ftKeeper.GetPendingRatesUpdate(ctx, denom)
+	requireT.NoError(err)
+	requireT.Nil(pendingUpdate.BurnRate)
+	requireT.Equal(sdk.MustNewDecFromStr("0.6").String(), pendingUpdate.SendCommissionRate.String())
+
+	// the update is executed when the notice period ends
+	delayedItems, err = delayKeeper.ExportDelayedItems(ctx)
+	requireT.NoError(err)
+	requireT.Len(delayedItems, 1)
+	var delayedItem codec.ProtoMarshaler
+	requireT.NoError(cdc.UnpackAny(delayedItems[0].Data, &delayedItem))
+	requireT.Equal(&types.DelayedRatesUpdate{Denom: denom}, delayedItem)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager()).WithBlockTime(effectiveTime)
+	requireT.NoError(delayKeeper.ExecuteDelayedItems(ctx))
+
+	updatedEvents, err = event.FindTypedEvents[*types.EventRatesUpdated](ctx.EventManager().Events().ToABCIEvents())
+	requireT.NoError(err)
+	requireT.Equal([]*types.EventRatesUpdated{{
+		Denom:              denom,
+		BurnRate:           sdk.MustNewDecFromStr("0.05"),
+		SendCommissionRate: sdk.MustNewDecFromStr("0.6"),
+	}}, updatedEvents)
+
+	token, err = ftKeeper.GetToken(ctx, denom)
+	requireT.NoError(err)
+	requireT.Equal(sdk.MustNewDecFromStr("0.05").String(), token.BurnRate.String())
+	requireT.Equal(sdk.MustNewDecFromStr("0.6").String(), token.SendCommissionRate.String())
+	requireT.Nil(token.PendingRatesUpdate)
+
+	// decreasing all the pending rates cancels the delayed update
+	requireT.NoError(ftKeeper.UpdateRates(ctx, issuer, denom, lo.ToPtr(sdk.MustNewDecFromStr("0.1")), nil))
+	requireT.NoError(ftKeeper.UpdateRates(ctx, issuer, denom, lo.ToPtr(sdk.MustNewDecFromStr("0.05")), nil))
+
+	pendingUpdate, err = ftKeeper.GetPendingRatesUpdate(ctx, denom)
+	requireT.NoError(err)
+	requireT.Nil(pendingUpdate)
+	delayedItems, err = delayKeeper.ExportDelayedItems(ctx)
+	requireT.NoError(err)
+	requireT.Len(delayedItems, 0)
+}
+
+func TestKeeper_UpdateRates_FailedDelayedUpdate(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{}).
+		WithBlockTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	ftKeeper := testApp.AssetFTKeeper
+	delayKeeper := testApp.DelayKeeper
+
+	issuer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	denom, err := ftKeeper.Issue(ctx, types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "ABC",
+		Subunit:       "abc",
+		Precision:     8,
+		InitialAmount: sdkmath.NewInt(777),
+		BurnRate:      sdk.MustNewDecFromStr("0.1"),
+	})
+	requireT.NoError(err)
+
+	requireT.NoError(ftKeeper.UpdateRates(ctx, issuer, denom, lo.ToPtr(sdk.MustNewDecFromStr("0.3")), nil))
+
+	// the delayed update is moved to the failed items, as it happens when its execution fails
+	delayedItems, err := delayKeeper.ExportDelayedItems(ctx)
+	requireT.NoError(err)
+	requireT.Len(delayedItems, 1)
+	requireT.NoError(delayKeeper.RemoveDelayedExecution(ctx, delayedItems[0].Id))
+	requireT.NoError(delayKeeper.ImportFailedItems(ctx, []delaytypes.FailedItem{{
+		Item:  delayedItems[0],
+		Error: "test failure",
+	}}))
+
+	// the pending increase can still be replaced
+	requireT.NoError(ftKeeper.UpdateRates(ctx, issuer, denom, lo.ToPtr(sdk.MustNewDecFromStr("0.4")), nil))
+	pendingUpdate, err := ftKeeper.GetPendingRatesUpdate(ctx, denom)
+	requireT.NoError(err)
+	requireT.Equal(sdk.MustNewDecFromStr("0.4").String(), pendingUpdate.BurnRate.String())
+	delayedItems, err = delayKeeper.ExportDelayedItems(ctx)
+	requireT.NoError(err)
+	requireT.Len(delayedItems, 1)
+
+	// the pending increase can be cancelled when the rescheduled update is not found as well
+	requireT.NoError(delayKeeper.RemoveDelayedExecution(ctx, delayedItems[0].Id))
+	requireT.NoError(ftKeeper.UpdateRates(ctx, issuer, denom, lo.ToPtr(sdk.MustNewDecFromStr("0.05")), nil))
+	pendingUpdate, err = ftKeeper.GetPendingRatesUpdate(ctx, denom)
+	requireT.NoError(err)
+	requireT.Nil(pendingUpdate)
+
+	token, err := ftKeeper.GetToken(ctx, denom)
+	requireT.NoError(err)
+	requireT.Equal(sdk.MustNewDecFromStr("0.05").String(), token.BurnRate.String())
+}
@@ -0,0 +1,238 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/CoreumFoundation/coreum/v3/x/asset/ft/types"
+	delaytypes "github.com/CoreumFoundation/coreum/v3/x/delay/types"
+)
+
+// FreezeUntil freezes specified token from the specified account and schedules the automatic unfreeze
+// of the same amount at the unfreeze time.
+func (k Keeper) FreezeUntil(
+	ctx sdk.Context,
+	sender, addr sdk.AccAddress,
+	coin sdk.Coin,
+	unfreezeTime time.Time,
+) error {
+	// the delay module executes items with the precision of seconds
+	unfreezeTime = time.Unix(unfreezeTime.Unix(), 0).UTC()
+	if !unfreezeTime.After(ctx.BlockTime()) {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidInput,
+			"unfreeze time %s must be after the block time %s",
+			unfreezeTime,
+			ctx.BlockTime(),
+		)
+	}
+
+	if err := k.Freeze(ctx, sender, addr, coin); err != nil {
+		return err
+	}
+
+	scheduledUnfreeze, found, err := k.getScheduledUnfreeze(ctx, addr, coin.Denom, unfreezeTime)
+	if err != nil {
+		return err
+	}
+	if found {
+		// the unfreeze is already scheduled for the same time, so the amount is increased only
+		scheduledUnfreeze.Coin = scheduledUnfreeze.Coin.Add(coin)
+		return k.setScheduledUnfreeze(ctx, scheduledUnfreeze)
+	}
+
+	if err := k.setScheduledUnfreeze(ctx, types.ScheduledUnfreeze{
+		Account:      addr.String(),
+		Coin:         coin,
+		UnfreezeTime: unfreezeTime,
+	}); err != nil {
+		return err
+	}
+
+	data := &types.DelayedUnfreeze{
+		Account:      addr.String(),
+		Denom:        coin.Denom,
+		UnfreezeTime: unfreezeTime,
+	}
+
+	return k.delayKeeper.StoreDelayedExecution(
+		ctx, scheduledUnfreezeID(data.Account, data.Denom, unfreezeTime), data, unfreezeTime,
+	)
+}
+
+// UnfreezeScheduled unfreezes the amount scheduled to be unfrozen at the unfreeze time.
+// The scheduled amount is reduced whenever the frozen balance is decreased by the admin, so only the amount
+// which is still frozen by the time-locked freeze is unfrozen.
+func (k Keeper) UnfreezeScheduled(ctx sdk.Context, data *types.DelayedUnfreeze) error {
+	addr, err := sdk.AccAddressFromBech32(data.Account)
+	if err != nil {
+		return sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid account address")
+	}
+
+	scheduledUnfreeze, found, err := k.getScheduledUnfreeze(ctx, addr, data.Denom, data.UnfreezeTime)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidState,
+			"scheduled unfreeze not found, account: %s, denom: %s, unfreeze time: %s",
+			data.Account,
+			data.Denom,
+			data.UnfreezeTime,
+		)
+	}
+
+	ctx.KVStore(k.storeKey).Delete(types.CreateScheduledUnfreezeKey(addr, data.Denom, data.UnfreezeTime))
+
+	frozenStore := k.frozenAccountBalanceStore(ctx, addr)
+	frozenBalance := frozenStore.Balance(data.Denom)
+	amount := sdkmath.MinInt(frozenBalance.Amount, scheduledUnfreeze.Coin.Amount)
+	if amount.IsZero() {
+		return nil
+	}
+
+	newFrozenBalance := frozenBalance.SubAmount(amount)
+	frozenStore.SetBalance(newFrozenBalance)
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventFrozenAmountChanged{
+		Account:        data.Account,
+		Denom:          data.Denom,
+		PreviousAmount: frozenBalance.Amount,
+		CurrentAmount:  newFrozenBalance.Amount,
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit EventFrozenAmountChanged event: %s", err)
+	}
+
+	return nil
+}
+
+// GetScheduledUnfreezes returns the unfreezes scheduled for the account and denom ordered by the unfreeze time.
+func (k Keeper) GetScheduledUnfreezes(
+	ctx sdk.Context,
+	addr sdk.AccAddress,
+	denom string,
+) ([]types.ScheduledUnfreeze, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.CreateScheduledUnfreezesPrefix(addr, denom))
+	return k.collectScheduledUnfreezes(store)
+}
+
+// ImportScheduledUnfreezes imports the scheduled unfreezes from genesis state.
+func (k Keeper) ImportScheduledUnfreezes(ctx sdk.Context, scheduledUnfreezes []types.ScheduledUnfreeze) error {
+	for _, scheduledUnfreeze := range scheduledUnfreezes {
+		if err := k.setScheduledUnfreeze(ctx, scheduledUnfreeze); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ExportScheduledUnfreezes exports the scheduled unfreezes.
+func (k Keeper) ExportScheduledUnfreezes(ctx sdk.Context) ([]types.ScheduledUnfreeze, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ScheduledUnfreezeKeyPrefix)
+	return k.collectScheduledUnfreezes(store)
+}
+
+// reduceScheduledUnfreezes reduces the amounts scheduled to be unfrozen after the frozen balance is decreased by
+// the admin, so the scheduled unfreeze never releases the amount frozen later. The amount is taken from the earliest
+// scheduled unfreezes first, and the ones reduced to zero are removed together with their delayed executions.
+func (k Keeper) reduceScheduledUnfreezes(ctx sdk.Context, addr sdk.AccAddress, denom string, amount sdkmath.Int) error {
+	scheduledUnfreezes, err := k.GetScheduledUnfreezes(ctx, addr, denom)
+	if err != nil {
+		return err
+	}
+
+	for _, scheduledUnfreeze := range scheduledUnfreezes {
+		if !amount.IsPositive() {
+			return nil
+		}
+
+		reduction := sdkmath.MinInt(amount, scheduledUnfreeze.Coin.Amount)
+		amount = amount.Sub(reduction)
+		scheduledUnfreeze.Coin = scheduledUnfreeze.Coin.SubAmount(reduction)
+		if scheduledUnfreeze.Coin.IsPositive() {
+			if err := k.setScheduledUnfreeze(ctx, scheduledUnfreeze); err != nil {
+				return err
+			}
+			continue
+		}
+
+		ctx.KVStore(k.storeKey).Delete(types.CreateScheduledUnfreezeKey(addr, denom, scheduledUnfreeze.UnfreezeTime))
+		// the item is not found if its execution failed, then it is kept in the failed items of the delay module
+		if err := k.delayKeeper.RemoveDelayedExecution(
+			ctx, scheduledUnfreezeID(scheduledUnfreeze.Account, denom, scheduledUnfreeze.UnfreezeTime),
+		); err != nil && !delaytypes.ErrNotFound.Is(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (k Keeper) collectScheduledUnfreezes(store prefix.Store) ([]types.ScheduledUnfreeze, error) {
+	scheduledUnfreezes := make([]types.ScheduledUnfreeze, 0)
+	_, err := query.Paginate(store, &query.PageRequest{Limit: query.MaxLimit}, func(key, value []byte) error {
+		var scheduledUnfreeze types.ScheduledUnfreeze
+		if err := k.cdc.Unmarshal(value, &scheduledUnfreeze); err != nil {
+			return sdkerrors.Wrapf(types.ErrInvalidState, "failed to unmarshal scheduled unfreeze: %s", err)
+		}
+		scheduledUnfreezes = append(scheduledUnfreezes, scheduledUnfreeze)
+
+		return nil
+	})
+	if err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidInput, "failed to paginate: %s", err)
+	}
+
+	return scheduledUnfreezes, nil
+}
+
+func (k Keeper) getScheduledUnfreeze(
+	ctx sdk.Context,
+	addr sdk.AccAddress,
+	denom string,
+	unfreezeTime time.Time,
+) (types.ScheduledUnfreeze, bool, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.CreateScheduledUnfreezeKey(addr, denom, unfreezeTime))
+	if bz == nil {
+		return types.ScheduledUnfreeze{}, false, nil
+	}
+
+	var scheduledUnfreeze types.ScheduledUnfreeze
+	if err := k.cdc.Unmarshal(bz, &scheduledUnfreeze); err != nil {
+		return types.ScheduledUnfreeze{}, false, sdkerrors.Wrapf(
+			types.ErrInvalidState, "failed to unmarshal scheduled unfreeze: %s", err,
+		)
+	}
+
+	return scheduledUnfreeze, true, nil
+}
+
+func (k Keeper) setScheduledUnfreeze(ctx sdk.Context, scheduledUnfreeze types.ScheduledUnfreeze) error {
+	addr, err := sdk.AccAddressFromBech32(scheduledUnfreeze.Account)
+	if err != nil {
+		return sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid account address")
+	}
+
+	bz, err := k.cdc.Marshal(&scheduledUnfreeze)
+	if err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "failed to marshal scheduled unfreeze: %s", err)
+	}
+
+	ctx.KVStore(k.storeKey).Set(
+		types.CreateScheduledUnfreezeKey(addr, scheduledUnfreeze.Coin.Denom, scheduledUnfreeze.UnfreezeTime), bz,
+	)
+
+	return nil
+}
+
+func scheduledUnfreezeID(account, denom string, unfreezeTime time.Time) string {
+	return fmt.Sprintf("%s-unfreeze-%s-%s-%d", types.ModuleName, account, denom, unfreezeTime.Unix())
+}
@@ -0,0 +1,168 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreum/v3/testutil/simapp"
+	"github.com/CoreumFoundation/coreum/v3/x/asset/ft/types"
+)
+
+func TestKeeper_FreezeUntil(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	blockTime := time.Date(2023, 2, 13, 1, 2, 3, 0, time.UTC)
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{}).WithBlockTime(blockTime)
+
+	ftKeeper := testApp.AssetFTKeeper
+	delayKeeper := testApp.DelayKeeper
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	denom, err := ftKeeper.Issue(ctx, types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "DEF",
+		Subunit:       "def",
+		Precision:     1,
+		InitialAmount: sdkmath.NewInt(666),
+		Features:      []types.Feature{types.Feature_freezing},
+	})
+	requireT.NoError(err)
+
+	recipient := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	randomAddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	unfreezeTime1 := blockTime.Add(time.Hour)
+	unfreezeTime2 := blockTime.Add(2 * time.Hour)
+
+	// try to freeze with the unfreeze time in the past
+	err = ftKeeper.FreezeUntil(ctx, issuer, recipient, sdk.NewCoin(denom, sdkmath.NewInt(10)), blockTime)
+	requireT.ErrorIs(err, types.ErrInvalidInput)
+
+	// try to freeze from non issuer address
+	err = ftKeeper.FreezeUntil(ctx, randomAddr, recipient, sdk.NewCoin(denom, sdkmath.NewInt(10)), unfreezeTime1)
+	requireT.ErrorIs(err, cosmoserrors.ErrUnauthorized)
+
+	// freeze with two different unfreeze times, the second freeze for the same time increases the amount
+	requireT.NoError(ftKeeper.FreezeUntil(ctx, issuer, recipient, sdk.NewCoin(denom, sdkmath.NewInt(10)), unfreezeTime1))
+	requireT.NoError(ftKeeper.FreezeUntil(ctx, issuer, recipient, sdk.NewCoin(denom, sdkmath.NewInt(20)), unfreezeTime2))
+	requireT.NoError(ftKeeper.FreezeUntil(ctx, issuer, recipient, sdk.NewCoin(denom, sdkmath.NewInt(5)), unfreezeTime1))
+	// freeze without the unfreeze time
+	requireT.NoError(ftKeeper.Freeze(ctx, issuer, recipient, sdk.NewCoin(denom, sdkmath.NewInt(7))))
+
+	requireT.Equal(sdk.NewCoin(denom, sdkmath.NewInt(42)).String(), ftKeeper.GetFrozenBalance(ctx, recipient, denom).String())
+
+	scheduledUnfreezes, err := ftKeeper.GetScheduledUnfreezes(ctx, recipient, denom)
+	requireT.NoError(err)
+	requireT.Equal([]types.ScheduledUnfreeze{
+		{
+			Account:      recipient.String(),
+			Coin:         sdk.NewCoin(denom, sdkmath.NewInt(15)),
+			UnfreezeTime: unfreezeTime1,
+		},
+		{
+			Account:      recipient.String(),
+			Coin:         sdk.NewCoin(denom, sdkmath.NewInt(20)),
+			UnfreezeTime: unfreezeTime2,
+		},
+	}, scheduledUnfreezes)
+
+	// single delayed item is stored per unfreeze time
+	delayedItems, err := delayKeeper.ExportDelayedItems(ctx)
+	requireT.NoError(err)
+	requireT.Len(delayedItems, 2)
+
+	// first unfreeze is executed
+	ctx = ctx.WithBlockTime(unfreezeTime1)
+	requireT.NoError(delayKeeper.ExecuteDelayedItems(ctx))
+	requireT.Equal(sdk.NewCoin(denom, sdkmath.NewInt(27)).String(), ftKeeper.GetFrozenBalance(ctx, recipient, denom).String())
+	scheduledUnfreezes, err = ftKeeper.GetScheduledUnfreezes(ctx, recipient, denom)
+	requireT.NoError(err)
+	requireT.Len(scheduledUnfreezes, 1)
+
+	// issuer decreases the frozen balance manually, so the scheduled amount is reduced by the decrease
+	requireT.NoError(ftKeeper.SetFrozen(ctx, issuer, recipient, sdk.NewCoin(denom, sdkmath.NewInt(12))))
+	scheduledUnfreezes, err = ftKeeper.GetScheduledUnfreezes(ctx, recipient, denom)
+	requireT.NoError(err)
+	requireT.Len(scheduledUnfreezes, 1)
+	requireT.Equal(sdk.NewCoin(denom, sdkmath.NewInt(5)).String(), scheduledUnfreezes[0].Coin.String())
+
+	// the amount frozen without the unfreeze time stays frozen
+	ctx = ctx.WithBlockTime(unfreezeTime2)
+	requireT.NoError(delayKeeper.ExecuteDelayedItems(ctx))
+	requireT.Equal(sdk.NewCoin(denom, sdkmath.NewInt(7)).String(), ftKeeper.GetFrozenBalance(ctx, recipient, denom).String())
+	scheduledUnfreezes, err = ftKeeper.GetScheduledUnfreezes(ctx, recipient, denom)
+	requireT.NoError(err)
+	requireT.Empty(scheduledUnfreezes)
+
+	failedItems, err := delayKeeper.ExportFailedItems(ctx)
+	requireT.NoError(err)
+	requireT.Empty(failedItems)
+}
+
+func TestKeeper_FreezeUntil_ManualUnfreeze(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	blockTime := time.Date(2023, 2, 13, 1, 2, 3, 0, time.UTC)
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{}).WithBlockTime(blockTime)
+
+	ftKeeper := testApp.AssetFTKeeper
+	delayKeeper := testApp.DelayKeeper
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	denom, err := ftKeeper.Issue(ctx, types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "DEF",
+		Subunit:       "def",
+		Precision:     1,
+		InitialAmount: sdkmath.NewInt(666),
+		Features:      []types.Feature{types.Feature_freezing},
+	})
+	requireT.NoError(err)
+
+	recipient := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	unfreezeTime1 := blockTime.Add(time.Hour)
+	unfreezeTime2 := blockTime.Add(2 * time.Hour)
+
+	requireT.NoError(ftKeeper.FreezeUntil(ctx, issuer, recipient, sdk.NewCoin(denom, sdkmath.NewInt(10)), unfreezeTime1))
+	requireT.NoError(ftKeeper.FreezeUntil(ctx, issuer, recipient, sdk.NewCoin(denom, sdkmath.NewInt(20)), unfreezeTime2))
+
+	// the manual unfreeze is taken from the earliest scheduled unfreeze first, and the scheduled unfreeze reduced
+	// to zero is removed together with its delayed item
+	requireT.NoError(ftKeeper.Unfreeze(ctx, issuer, recipient, sdk.NewCoin(denom, sdkmath.NewInt(15))))
+	scheduledUnfreezes, err := ftKeeper.GetScheduledUnfreezes(ctx, recipient, denom)
+	requireT.NoError(err)
+	requireT.Equal([]types.ScheduledUnfreeze{
+		{
+			Account:      recipient.String(),
+			Coin:         sdk.NewCoin(denom, sdkmath.NewInt(15)),
+			UnfreezeTime: unfreezeTime2,
+		},
+	}, scheduledUnfreezes)
+	delayedItems, err := delayKeeper.ExportDelayedItems(ctx)
+	requireT.NoError(err)
+	requireT.Len(delayedItems, 1)
+
+	// the rest of the time-locked freeze is unfrozen manually and the admin freezes the account indefinitely
+	requireT.NoError(ftKeeper.Unfreeze(ctx, issuer, recipient, sdk.NewCoin(denom, sdkmath.NewInt(15))))
+	requireT.NoError(ftKeeper.Freeze(ctx, issuer, recipient, sdk.NewCoin(denom, sdkmath.NewInt(50))))
+	scheduledUnfreezes, err = ftKeeper.GetScheduledUnfreezes(ctx, recipient, denom)
+	requireT.NoError(err)
+	requireT.Empty(scheduledUnfreezes)
+
+	// the indefinite freeze is not released once the unfreeze time passes
+	ctx = ctx.WithBlockTime(unfreezeTime2)
+	requireT.NoError(delayKeeper.ExecuteDelayedItems(ctx))
+	requireT.Equal(sdk.NewCoin(denom, sdkmath.NewInt(50)).String(), ftKeeper.GetFrozenBalance(ctx, recipient, denom).String())
+
+	failedItems, err := delayKeeper.ExportFailedItems(ctx)
+	requireT.NoError(err)
+	requireT.Empty(failedItems)
+}
@@ -2,8 +2,11 @@ package keeper
 
 import (
 	"encoding/binary"
+	"fmt"
+	"time"
 
 	sdkerrors "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -12,6 +15,90 @@ import (
 	"github.com/CoreumFoundation/coreum/v3/x/asset/ft/types"
 )
 
+// tokenUpgradeHandler defines the version specific logic of the token upgrade.
+type tokenUpgradeHandler interface {
+	// validate checks that the token might be upgraded using the options.
+	validate(ctx sdk.Context, params types.Params, def types.Definition, options types.TokenUpgradeOptions) error
+	// isDelayed tells if the upgrade is applied when the grace period ends instead of being applied immediately.
+	isDelayed(options types.TokenUpgradeOptions) bool
+	// setStatus sets the status of the upgrade in the token upgrade statuses.
+	setStatus(statuses *types.TokenUpgradeStatuses, options types.TokenUpgradeOptions, startTime, endTime time.Time)
+	// delayedData returns the data executed by the delay module when the grace period ends.
+	delayedData(denom string, options types.TokenUpgradeOptions) codec.ProtoMarshaler
+	// apply applies the upgrade to the token definition.
+	apply(def *types.Definition, options types.TokenUpgradeOptions)
+}
+
+// UpgradeToken upgrades the token to the version using the version specific options. If the upgrade changes the
+// behaviour of the token for the holders it is applied when the grace period ends, otherwise it is applied immediately.
+func (k Keeper) UpgradeToken(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	denom string,
+	version uint32,
+	options types.TokenUpgradeOptions,
+) error {
+	handler, err := k.getTokenUpgradeHandler(version)
+	if err != nil {
+		return err
+	}
+
+	def, err := k.GetDefinition(ctx, denom)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "not able to get token info for denom:%s", denom)
+	}
+
+	if !def.IsAdmin(sender) {
+		return sdkerrors.Wrap(cosmoserrors.ErrUnauthorized, "only admin may upgrade the token")
+	}
+
+	if def.Version >= version {
+		return sdkerrors.Wrapf(cosmoserrors.ErrUnauthorized, "denom %s has been already upgraded to v%d", denom, version)
+	}
+
+	// each upgrade relies on the decisions made by the previous ones (e.g. v1 is the only way to enable IBC),
+	// so the token can't skip any version
+	if version != def.Version+1 {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "denom %s must be upgraded to v%d first", denom, def.Version+1)
+	}
+
+	params := k.GetParams(ctx)
+	if err := handler.validate(ctx, params, def, options); err != nil {
+		return err
+	}
+
+	if err := k.SetPendingVersion(ctx, denom, version); err != nil {
+		return err
+	}
+
+	delayed := handler.isDelayed(options)
+	startTime := ctx.BlockTime()
+	endTime := startTime
+	if delayed {
+		endTime = startTime.Add(params.TokenUpgradeGracePeriod)
+	}
+
+	tokenUpgradeStatuses := k.GetTokenUpgradeStatuses(ctx, denom)
+	handler.setStatus(&tokenUpgradeStatuses, options, startTime, endTime)
+	k.SetTokenUpgradeStatuses(ctx, denom, tokenUpgradeStatuses)
+
+	if !delayed {
+		return k.applyTokenUpgrade(ctx, denom, version, options)
+	}
+
+	return k.delayKeeper.DelayExecution(
+		ctx,
+		tokenUpgradeID(version, denom),
+		handler.delayedData(denom, options),
+		params.TokenUpgradeGracePeriod,
+	)
+}
+
+// UpgradeTokenDelayed applies the token upgrade when its grace period ends.
+func (k Keeper) UpgradeTokenDelayed(ctx sdk.Context, data *types.DelayedTokenUpgrade) error {
+	return k.applyTokenUpgrade(ctx, data.Denom, data.Version, data.Options)
+}
+
 // ImportPendingTokenUpgrades imports pending version upgrades from genesis state.
 func (k Keeper) ImportPendingTokenUpgrades(ctx sdk.Context, versions []types.PendingTokenUpgrade) error {
 	for _, v := range versions {
@@ -81,3 +168,56 @@ func (k Keeper) GetTokenUpgradeStatuses(ctx sdk.Context, denom string) types.Tok
 func (k Keeper) SetTokenUpgradeStatuses(ctx sdk.Context, denom string, tokenUpgradeStatuses types.TokenUpgradeStatuses) {
 	ctx.KVStore(k.storeKey).Set(types.CreateTokenUpgradeStatusesKey(denom), k.cdc.MustMarshal(&tokenUpgradeStatuses))
 }
+
+func (k Keeper) getTokenUpgradeHandler(version uint32) (tokenUpgradeHandler, error) {
+	handler, ok := k.tokenUpgradeHandlers[version]
+	if !ok {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidInput, "token upgrade to version %d is not supported", version)
+	}
+
+	return handler, nil
+}
+
+func (k Keeper) applyTokenUpgrade(
+	ctx sdk.Context,
+	denom string,
+	version uint32,
+	options types.TokenUpgradeOptions,
+) error {
+	handler, err := k.getTokenUpgradeHandler(version)
+	if err != nil {
+		return err
+	}
+
+	def, err := k.GetDefinition(ctx, denom)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "not able to get token info for denom:%s", denom)
+	}
+
+	subunit, issuer, err := types.DeconstructDenom(denom)
+	if err != nil {
+		return err
+	}
+
+	handler.apply(&def, options)
+	def.Version = version
+	k.SetDefinition(ctx, issuer, subunit, def)
+	k.ClearPendingVersion(ctx, denom)
+
+	return emitTokenUpgradedEvent(ctx, denom, version)
+}
+
+func tokenUpgradeID(version uint32, denom string) string {
+	return fmt.Sprintf("%s-upgrade-%d-%s", types.ModuleName, version, denom)
+}
+
+func emitTokenUpgradedEvent(ctx sdk.Context, denom string, version uint32) error {
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventTokenUpgraded{
+		Denom:   denom,
+		Version: version,
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit EventTokenUpgraded event: %s", err)
+	}
+
+	return nil
+}
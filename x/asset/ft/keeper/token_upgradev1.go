@@ -1,9 +1,10 @@
 package keeper
 
 import (
-	"fmt"
+	"time"
 
 	sdkerrors "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
 
@@ -14,81 +15,69 @@ const tokenUpgradeV1Version = 1
 
 // AddDelayedTokenUpgradeV1 stores request for upgrading token to V1.
 func (k Keeper) AddDelayedTokenUpgradeV1(ctx sdk.Context, sender sdk.AccAddress, denom string, ibcEnabled bool) error {
-	params := k.GetParams(ctx)
-	if ctx.BlockTime().After(params.TokenUpgradeDecisionTimeout) {
-		return sdkerrors.Wrapf(cosmoserrors.ErrUnauthorized, "it is no longer possible to upgrade the token")
-	}
-
-	def, err := k.GetDefinition(ctx, denom)
-	if err != nil {
-		return sdkerrors.Wrapf(err, "not able to get token info for denom:%s", denom)
-	}
-
-	if !def.IsIssuer(sender) {
-		return sdkerrors.Wrap(cosmoserrors.ErrUnauthorized, "only issuer may upgrade the token")
-	}
+	return k.UpgradeToken(ctx, sender, denom, tokenUpgradeV1Version, types.TokenUpgradeOptions{
+		V1: &types.TokenUpgradeV1Options{
+			IbcEnabled: ibcEnabled,
+		},
+	})
+}
 
-	if def.Version >= tokenUpgradeV1Version {
-		return sdkerrors.Wrapf(cosmoserrors.ErrUnauthorized, "denom %s has been already upgraded to v1", denom)
-	}
+// UpgradeTokenToV1 upgrades token to version V1.
+func (k Keeper) UpgradeTokenToV1(ctx sdk.Context, data *types.DelayedTokenUpgradeV1) error {
+	// the upgrade is delayed only if IBC is enabled
+	return k.applyTokenUpgrade(ctx, data.Denom, tokenUpgradeV1Version, types.TokenUpgradeOptions{
+		V1: &types.TokenUpgradeV1Options{
+			IbcEnabled: true,
+		},
+	})
+}
 
-	if err := k.SetPendingVersion(ctx, denom, tokenUpgradeV1Version); err != nil {
-		return err
-	}
+// tokenUpgradeV1Handler enables IBC on the tokens issued before it was introduced.
+type tokenUpgradeV1Handler struct{}
 
-	// we don't read the current TokenUpgradeStatuses because we know that this is the initial state
-	tokenUpgradeStatuses := types.TokenUpgradeStatuses{
-		V1: &types.TokenUpgradeV1Status{
-			IbcEnabled: ibcEnabled,
-			StartTime:  ctx.BlockTime(),
-			EndTime:    ctx.BlockTime().Add(params.TokenUpgradeGracePeriod),
-		},
+func (h tokenUpgradeV1Handler) validate(
+	ctx sdk.Context,
+	params types.Params,
+	_ types.Definition,
+	options types.TokenUpgradeOptions,
+) error {
+	if ctx.BlockTime().After(params.TokenUpgradeDecisionTimeout) {
+		return sdkerrors.Wrapf(cosmoserrors.ErrUnauthorized, "it is no longer possible to upgrade the token")
 	}
 
-	if !ibcEnabled {
-		// if issuer does not want to enable IBC we may upgrade the token immediately
-		// because it's behaviour is not changed
-		def.Version = tokenUpgradeV1Version
-		subunit, issuer, err := types.DeconstructDenom(denom)
-		if err != nil {
-			return err
-		}
-		k.SetDefinition(ctx, issuer, subunit, def)
-		k.ClearPendingVersion(ctx, denom)
-		tokenUpgradeStatuses.V1.EndTime = tokenUpgradeStatuses.V1.StartTime
-		k.SetTokenUpgradeStatuses(ctx, denom, tokenUpgradeStatuses)
-		return nil
+	if options.V1 == nil {
+		return sdkerrors.Wrap(types.ErrInvalidInput, "v1 options must be provided")
 	}
 
-	k.SetTokenUpgradeStatuses(ctx, denom, tokenUpgradeStatuses)
-
-	data := &types.DelayedTokenUpgradeV1{
-		Denom: denom,
-	}
+	return nil
+}
 
-	return k.delayKeeper.DelayExecution(ctx, tokenUpgradeID(tokenUpgradeV1Version, data.Denom), data, params.TokenUpgradeGracePeriod)
+func (h tokenUpgradeV1Handler) isDelayed(options types.TokenUpgradeOptions) bool {
+	// if issuer does not want to enable IBC we may upgrade the token immediately
+	// because it's behaviour is not changed
+	return options.V1.IbcEnabled
 }
 
-// UpgradeTokenToV1 upgrades token to version V1.
-func (k Keeper) UpgradeTokenToV1(ctx sdk.Context, data *types.DelayedTokenUpgradeV1) error {
-	def, err := k.GetDefinition(ctx, data.Denom)
-	if err != nil {
-		return sdkerrors.Wrapf(err, "not able to get token info for denom:%s", data.Denom)
+func (h tokenUpgradeV1Handler) setStatus(
+	statuses *types.TokenUpgradeStatuses,
+	options types.TokenUpgradeOptions,
+	startTime, endTime time.Time,
+) {
+	statuses.V1 = &types.TokenUpgradeV1Status{
+		IbcEnabled: options.V1.IbcEnabled,
+		StartTime:  startTime,
+		EndTime:    endTime,
 	}
+}
 
-	subunit, issuer, err := types.DeconstructDenom(data.Denom)
-	if err != nil {
-		return err
+func (h tokenUpgradeV1Handler) delayedData(denom string, _ types.TokenUpgradeOptions) codec.ProtoMarshaler {
+	return &types.DelayedTokenUpgradeV1{
+		Denom: denom,
 	}
-
-	def.Features = append(def.Features, types.Feature_ibc)
-	def.Version = tokenUpgradeV1Version
-	k.SetDefinition(ctx, issuer, subunit, def)
-	k.ClearPendingVersion(ctx, data.Denom)
-
-	return nil
 }
 
-func tokenUpgradeID(version int, denom string) string {
-	return fmt.Sprintf("%s-upgrade-%d-%s", types.ModuleName, version, denom)
+func (h tokenUpgradeV1Handler) apply(def *types.Definition, options types.TokenUpgradeOptions) {
+	if options.V1.IbcEnabled && !def.IsFeatureEnabled(types.Feature_ibc) {
+		def.Features = append(def.Features, types.Feature_ibc)
+	}
 }
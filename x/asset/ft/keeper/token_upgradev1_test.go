@@ -13,6 +13,7 @@ import (
 
 	"github.com/CoreumFoundation/coreum/v3/app"
 	"github.com/CoreumFoundation/coreum/v3/pkg/config"
+	"github.com/CoreumFoundation/coreum/v3/testutil/event"
 	"github.com/CoreumFoundation/coreum/v3/testutil/simapp"
 	"github.com/CoreumFoundation/coreum/v3/x/asset/ft/types"
 )
@@ -68,8 +69,13 @@ func TestTokenUpgradeV1(t *testing.T) {
 	requireT.Error(ftKeeper.AddDelayedTokenUpgradeV1(ctxSDK, issuer1, denom2, false))
 
 	// first call succeeds
+	ctxSDK = ctxSDK.WithEventManager(sdk.NewEventManager())
 	requireT.NoError(ftKeeper.AddDelayedTokenUpgradeV1(ctxSDK, issuer1, denom1, false))
 
+	upgradedEvents, err := event.FindTypedEvents[*types.EventTokenUpgraded](ctxSDK.EventManager().Events().ToABCIEvents())
+	requireT.NoError(err)
+	requireT.Equal([]*types.EventTokenUpgraded{{Denom: denom1, Version: 1}}, upgradedEvents)
+
 	// ibc is set to false so the change should be applied immediately
 	token1, err := ftKeeper.GetToken(ctxSDK, denom1)
 	requireT.NoError(err)
@@ -130,10 +136,15 @@ func TestTokenUpgradeV1(t *testing.T) {
 	requireT.Error(ftKeeper.SetPendingVersion(ctxSDK, denom2, 1))
 
 	// now let's execute the upgrade
+	ctxSDK = ctxSDK.WithEventManager(sdk.NewEventManager())
 	requireT.NoError(ftKeeper.UpgradeTokenToV1(ctxSDK, &types.DelayedTokenUpgradeV1{
 		Denom: denom2,
 	}))
 
+	upgradedEvents, err = event.FindTypedEvents[*types.EventTokenUpgraded](ctxSDK.EventManager().Events().ToABCIEvents())
+	requireT.NoError(err)
+	requireT.Equal([]*types.EventTokenUpgraded{{Denom: denom2, Version: 1}}, upgradedEvents)
+
 	// token should be upgraded
 	token2, err = ftKeeper.GetToken(ctxSDK, denom2)
 	requireT.NoError(err)
@@ -0,0 +1,95 @@
+package keeper
+
+import (
+	"time"
+
+	sdkerrors "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CoreumFoundation/coreum/v3/x/asset/ft/types"
+)
+
+const tokenUpgradeV2Version = 2
+
+// tokenUpgradeV2Features are the features which might be enabled by the v2 upgrade. The features giving the admin
+// power over the funds of the holders (e.g. clawback) can't be enabled after the token is issued.
+var tokenUpgradeV2Features = map[types.Feature]struct{}{
+	types.Feature_minting: {},
+	types.Feature_burning: {},
+}
+
+// tokenUpgradeV2Handler enables features on the token and decreases its rates.
+type tokenUpgradeV2Handler struct{}
+
+func (h tokenUpgradeV2Handler) validate(
+	_ sdk.Context,
+	_ types.Params,
+	def types.Definition,
+	options types.TokenUpgradeOptions,
+) error {
+	if options.V2 == nil {
+		return sdkerrors.Wrap(types.ErrInvalidInput, "v2 options must be provided")
+	}
+
+	for _, feature := range options.V2.Features {
+		if _, ok := tokenUpgradeV2Features[feature]; !ok {
+			return sdkerrors.Wrapf(types.ErrInvalidInput, "feature %s can't be enabled by the upgrade", feature)
+		}
+		if def.IsFeatureEnabled(feature) {
+			return sdkerrors.Wrapf(types.ErrInvalidInput, "feature %s is already enabled", feature)
+		}
+	}
+
+	if options.V2.BurnRate != nil && options.V2.BurnRate.GT(def.BurnRate) {
+		return sdkerrors.Wrap(types.ErrInvalidInput, "burn rate can't be increased")
+	}
+
+	if options.V2.SendCommissionRate != nil && options.V2.SendCommissionRate.GT(def.SendCommissionRate) {
+		return sdkerrors.Wrap(types.ErrInvalidInput, "send commission rate can't be increased")
+	}
+
+	return nil
+}
+
+func (h tokenUpgradeV2Handler) isDelayed(options types.TokenUpgradeOptions) bool {
+	// decreasing the rates is beneficial for the holders, so it may be applied immediately,
+	// while the new features restrict them, so the holders must have time to react
+	return len(options.V2.Features) > 0
+}
+
+func (h tokenUpgradeV2Handler) setStatus(
+	statuses *types.TokenUpgradeStatuses,
+	options types.TokenUpgradeOptions,
+	startTime, endTime time.Time,
+) {
+	statuses.V2 = &types.TokenUpgradeV2Status{
+		Options:   *options.V2,
+		StartTime: startTime,
+		EndTime:   endTime,
+	}
+}
+
+func (h tokenUpgradeV2Handler) delayedData(denom string, options types.TokenUpgradeOptions) codec.ProtoMarshaler {
+	return &types.DelayedTokenUpgrade{
+		Denom:   denom,
+		Version: tokenUpgradeV2Version,
+		Options: options,
+	}
+}
+
+func (h tokenUpgradeV2Handler) apply(def *types.Definition, options types.TokenUpgradeOptions) {
+	for _, feature := range options.V2.Features {
+		if !def.IsFeatureEnabled(feature) {
+			def.Features = append(def.Features, feature)
+		}
+	}
+
+	// the rates might have been changed during the grace period, so they are never increased here
+	if options.V2.BurnRate != nil && options.V2.BurnRate.LT(def.BurnRate) {
+		def.BurnRate = *options.V2.BurnRate
+	}
+	if options.V2.SendCommissionRate != nil && options.V2.SendCommissionRate.LT(def.SendCommissionRate) {
+		def.SendCommissionRate = *options.V2.SendCommissionRate
+	}
+}
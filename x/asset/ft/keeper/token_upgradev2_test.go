@@ -0,0 +1,268 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreum/v3/app"
+	"github.com/CoreumFoundation/coreum/v3/pkg/config"
+	"github.com/CoreumFoundation/coreum/v3/testutil/event"
+	"github.com/CoreumFoundation/coreum/v3/testutil/simapp"
+	"github.com/CoreumFoundation/coreum/v3/x/asset/ft/types"
+)
+
+func TestTokenUpgradeV2(t *testing.T) {
+	requireT := require.New(t)
+
+	cdc := config.NewEncodingConfig(app.ModuleBasics).Codec
+	testApp := simapp.New()
+	ctxSDK := testApp.BaseApp.NewContext(false, tmproto.Header{}).
+		WithBlockTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	ftKeeper := testApp.AssetFTKeeper
+	delayKeeper := testApp.DelayKeeper
+	params := ftKeeper.GetParams(ctxSDK)
+
+	issuer1 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	issuer2 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	denom1, err := ftKeeper.Issue(ctxSDK, types.IssueSettings{
+		Issuer:             issuer1,
+		Symbol:             "ABC",
+		Subunit:            "abc",
+		Precision:          8,
+		InitialAmount:      sdkmath.NewInt(777),
+		Features:           []types.Feature{types.Feature_minting},
+		BurnRate:           sdk.MustNewDecFromStr("0.5"),
+		SendCommissionRate: sdk.MustNewDecFromStr("0.4"),
+	})
+	requireT.NoError(err)
+
+	denom2, err := ftKeeper.Issue(ctxSDK, types.IssueSettings{
+		Issuer:             issuer2,
+		Symbol:             "XYZ",
+		Subunit:            "xyz",
+		Precision:          8,
+		InitialAmount:      sdkmath.NewInt(888),
+		BurnRate:           sdk.MustNewDecFromStr("0.5"),
+		SendCommissionRate: sdk.MustNewDecFromStr("0.4"),
+	})
+	requireT.NoError(err)
+
+	ratesOptions := types.TokenUpgradeOptions{
+		V2: &types.TokenUpgradeV2Options{
+			BurnRate:           lo.ToPtr(sdk.MustNewDecFromStr("0.1")),
+			SendCommissionRate: lo.ToPtr(sdk.ZeroDec()),
+		},
+	}
+
+	// upgrade to the unsupported version fails
+	err = ftKeeper.UpgradeToken(ctxSDK, issuer1, denom1, 3, ratesOptions)
+	requireT.ErrorIs(err, types.ErrInvalidInput)
+
+	// upgrade to the current version fails
+	err = ftKeeper.UpgradeToken(ctxSDK, issuer1, denom1, 1, types.TokenUpgradeOptions{
+		V1: &types.TokenUpgradeV1Options{},
+	})
+	requireT.ErrorIs(err, cosmoserrors.ErrUnauthorized)
+
+	// call from non-admin account fails
+	err = ftKeeper.UpgradeToken(ctxSDK, issuer2, denom1, 2, ratesOptions)
+	requireT.ErrorIs(err, cosmoserrors.ErrUnauthorized)
+
+	// call without v2 options fails
+	err = ftKeeper.UpgradeToken(ctxSDK, issuer1, denom1, 2, types.TokenUpgradeOptions{
+		V1: &types.TokenUpgradeV1Options{},
+	})
+	requireT.ErrorIs(err, types.ErrInvalidInput)
+
+	// the rates can't be increased
+	err = ftKeeper.UpgradeToken(ctxSDK, issuer1, denom1, 2, types.TokenUpgradeOptions{
+		V2: &types.TokenUpgradeV2Options{
+			BurnRate: lo.ToPtr(sdk.MustNewDecFromStr("0.6")),
+		},
+	})
+	requireT.ErrorIs(err, types.ErrInvalidInput)
+	err = ftKeeper.UpgradeToken(ctxSDK, issuer1, denom1, 2, types.TokenUpgradeOptions{
+		V2: &types.TokenUpgradeV2Options{
+			SendCommissionRate: lo.ToPtr(sdk.MustNewDecFromStr("0.6")),
+		},
+	})
+	requireT.ErrorIs(err, types.ErrInvalidInput)
+
+	// the enabled feature can't be enabled again
+	err = ftKeeper.UpgradeToken(ctxSDK, issuer1, denom1, 2, types.TokenUpgradeOptions{
+		V2: &types.TokenUpgradeV2Options{
+			Features: []types.Feature{types.Feature_minting},
+		},
+	})
+	requireT.ErrorIs(err, types.ErrInvalidInput)
+
+	// the features giving the admin power over the funds of the holders can't be enabled
+	for _, feature := range []types.Feature{
+		types.Feature_freezing,
+		types.Feature_whitelisting,
+		types.Feature_ibc,
+		types.Feature_clawback,
+		types.Feature_transfer_limits,
+		types.Feature_blocklisting,
+	} {
+		err = ftKeeper.UpgradeToken(ctxSDK, issuer1, denom1, 2, types.TokenUpgradeOptions{
+			V2: &types.TokenUpgradeV2Options{
+				Features: []types.Feature{feature},
+			},
+		})
+		requireT.ErrorIs(err, types.ErrInvalidInput)
+	}
+
+	// decreasing the rates is applied immediately
+	ctxSDK = ctxSDK.WithEventManager(sdk.NewEventManager())
+	requireT.NoError(ftKeeper.UpgradeToken(ctxSDK, issuer1, denom1, 2, ratesOptions))
+
+	upgradedEvents, err := event.FindTypedEvents[*types.EventTokenUpgraded](ctxSDK.EventManager().Events().ToABCIEvents())
+	requireT.NoError(err)
+	requireT.Equal([]*types.EventTokenUpgraded{{Denom: denom1, Version: 2}}, upgradedEvents)
+
+	token1, err := ftKeeper.GetToken(ctxSDK, denom1)
+	requireT.NoError(err)
+	requireT.EqualValues(2, token1.Version)
+	requireT.Equal([]types.Feature{types.Feature_minting}, token1.Features)
+	requireT.Equal(sdk.MustNewDecFromStr("0.1").String(), token1.BurnRate.String())
+	requireT.True(token1.SendCommissionRate.IsZero())
+
+	tokenUpgradeStatuses := ftKeeper.GetTokenUpgradeStatuses(ctxSDK, denom1)
+	requireT.Nil(tokenUpgradeStatuses.V1)
+	requireT.Equal(&types.TokenUpgradeV2Status{
+		Options:   *ratesOptions.V2,
+		StartTime: ctxSDK.BlockTime(),
+		EndTime:   ctxSDK.BlockTime(),
+	}, tokenUpgradeStatuses.V2)
+
+	delayedItems, err := delayKeeper.ExportDelayedItems(ctxSDK)
+	requireT.NoError(err)
+	requireT.Empty(delayedItems)
+
+	// second call fails
+	err = ftKeeper.UpgradeToken(ctxSDK, issuer1, denom1, 2, ratesOptions)
+	requireT.ErrorIs(err, cosmoserrors.ErrUnauthorized)
+
+	// enabling the features is applied when the grace period ends
+	featuresOptions := types.TokenUpgradeOptions{
+		V2: &types.TokenUpgradeV2Options{
+			Features: []types.Feature{types.Feature_minting, types.Feature_burning},
+			BurnRate: lo.ToPtr(sdk.MustNewDecFromStr("0.2")),
+		},
+	}
+	requireT.NoError(ftKeeper.UpgradeToken(ctxSDK, issuer2, denom2, 2, featuresOptions))
+
+	token2, err := ftKeeper.GetToken(ctxSDK, denom2)
+	requireT.NoError(err)
+	requireT.EqualValues(1, token2.Version)
+	requireT.Empty(token2.Features)
+	requireT.Equal(sdk.MustNewDecFromStr("0.5").String(), token2.BurnRate.String())
+
+	tokenUpgradeStatuses = ftKeeper.GetTokenUpgradeStatuses(ctxSDK, denom2)
+	requireT.Equal(&types.TokenUpgradeV2Status{
+		Options:   *featuresOptions.V2,
+		StartTime: ctxSDK.BlockTime(),
+		EndTime:   ctxSDK.BlockTime().Add(params.TokenUpgradeGracePeriod),
+	}, tokenUpgradeStatuses.V2)
+
+	delayedItems, err = delayKeeper.ExportDelayedItems(ctxSDK)
+	requireT.NoError(err)
+	requireT.Len(delayedItems, 1)
+	requireT.Equal("assetft-upgrade-2-"+denom2, delayedItems[0].Id)
+	requireT.Equal(ctxSDK.BlockTime().Add(params.TokenUpgradeGracePeriod), delayedItems[0].ExecutionTime)
+
+	var delayedItem codec.ProtoMarshaler
+	requireT.NoError(cdc.UnpackAny(delayedItems[0].Data, &delayedItem))
+	requireT.Equal(&types.DelayedTokenUpgrade{
+		Denom:   denom2,
+		Version: 2,
+		Options: featuresOptions,
+	}, delayedItem)
+
+	// next call fails
+	err = ftKeeper.UpgradeToken(ctxSDK, issuer2, denom2, 2, ratesOptions)
+	requireT.Error(err)
+
+	// now let's execute the upgrade
+	ctxSDK = ctxSDK.WithEventManager(sdk.NewEventManager())
+	requireT.NoError(ftKeeper.UpgradeTokenDelayed(ctxSDK, delayedItem.(*types.DelayedTokenUpgrade)))
+
+	upgradedEvents, err = event.FindTypedEvents[*types.EventTokenUpgraded](ctxSDK.EventManager().Events().ToABCIEvents())
+	requireT.NoError(err)
+	requireT.Equal([]*types.EventTokenUpgraded{{Denom: denom2, Version: 2}}, upgradedEvents)
+
+	token2, err = ftKeeper.GetToken(ctxSDK, denom2)
+	requireT.NoError(err)
+	requireT.EqualValues(2, token2.Version)
+	requireT.Equal([]types.Feature{types.Feature_minting, types.Feature_burning}, token2.Features)
+	requireT.Equal(sdk.MustNewDecFromStr("0.2").String(), token2.BurnRate.String())
+	requireT.Equal(sdk.MustNewDecFromStr("0.4").String(), token2.SendCommissionRate.String())
+
+	// the enabled features work
+	recipient := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	coins := sdk.NewCoins(sdk.NewInt64Coin(denom2, 10))
+	requireT.NoError(ftKeeper.Mint(ctxSDK, issuer2, recipient, sdk.NewInt64Coin(denom2, 10)))
+	requireT.Equal(coins, testApp.BankKeeper.GetAllBalances(ctxSDK, recipient))
+
+	// the pending version is cleared
+	requireT.NoError(ftKeeper.SetPendingVersion(ctxSDK, denom2, 3))
+}
+
+func TestTokenUpgradeV2_VersionCantBeSkipped(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctxSDK := testApp.BaseApp.NewContext(false, tmproto.Header{}).
+		WithBlockTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	ftKeeper := testApp.AssetFTKeeper
+
+	params := ftKeeper.GetParams(ctxSDK)
+	params.TokenUpgradeDecisionTimeout = ctxSDK.BlockTime().Add(time.Hour)
+	requireT.NoError(ftKeeper.SetParams(ctxSDK, params))
+
+	issuer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	denom, err := ftKeeper.IssueVersioned(ctxSDK, types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "ABC",
+		Subunit:       "abc",
+		Precision:     8,
+		InitialAmount: sdkmath.NewInt(777),
+		BurnRate:      sdk.MustNewDecFromStr("0.5"),
+	}, 0)
+	requireT.NoError(err)
+
+	ratesOptions := types.TokenUpgradeOptions{
+		V2: &types.TokenUpgradeV2Options{
+			BurnRate: lo.ToPtr(sdk.MustNewDecFromStr("0.1")),
+		},
+	}
+
+	// the token of version 0 can't be upgraded to v2 directly
+	err = ftKeeper.UpgradeToken(ctxSDK, issuer, denom, 2, ratesOptions)
+	requireT.ErrorIs(err, types.ErrInvalidInput)
+
+	// once it is upgraded to v1 it might be upgraded to v2
+	requireT.NoError(ftKeeper.UpgradeToken(ctxSDK, issuer, denom, 1, types.TokenUpgradeOptions{
+		V1: &types.TokenUpgradeV1Options{},
+	}))
+	requireT.NoError(ftKeeper.UpgradeToken(ctxSDK, issuer, denom, 2, ratesOptions))
+
+	token, err := ftKeeper.GetToken(ctxSDK, denom)
+	requireT.NoError(err)
+	requireT.EqualValues(2, token.Version)
+	requireT.Equal(sdk.MustNewDecFromStr("0.1").String(), token.BurnRate.String())
+}
@@ -0,0 +1,366 @@
+package keeper
+
+import (
+	"time"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/CoreumFoundation/coreum/v3/x/asset/ft/types"
+	wibctransfertypes "github.com/CoreumFoundation/coreum/v3/x/wibctransfer/types"
+)
+
+// SetTransferLimit sets the amount the account might send within the rolling period. The default limit applied to
+// all the accounts without their own limits is set if the account is empty.
+func (k Keeper) SetTransferLimit(
+	ctx sdk.Context,
+	sender, addr sdk.AccAddress,
+	denom string,
+	amount sdkmath.Int,
+	period time.Duration,
+) error {
+	if err := types.ValidateTransferLimit(amount, period); err != nil {
+		return err
+	}
+
+	def, err := k.GetDefinition(ctx, denom)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "not able to get token info for denom:%s", denom)
+	}
+
+	if len(addr) != 0 && def.IsAdmin(addr) {
+		return sdkerrors.Wrap(cosmoserrors.ErrUnauthorized, "admin's transfers can't be limited")
+	}
+
+	if err := def.CheckFeatureAllowed(sender, types.Feature_transfer_limits); err != nil {
+		return err
+	}
+
+	transferLimit := types.TransferLimit{
+		Denom:  denom,
+		Amount: amount,
+		Period: period,
+	}
+	if len(addr) != 0 {
+		transferLimit.Account = addr.String()
+	}
+	if err := k.setTransferLimit(ctx, transferLimit); err != nil {
+		return err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventTransferLimitSet{
+		Denom:   denom,
+		Account: transferLimit.Account,
+		Amount:  amount,
+		Period:  period,
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit EventTransferLimitSet event: %s", err)
+	}
+
+	return nil
+}
+
+// RemoveTransferLimit removes the transfer limit of the account. The default limit is removed if the account is empty.
+func (k Keeper) RemoveTransferLimit(ctx sdk.Context, sender, addr sdk.AccAddress, denom string) error {
+	def, err := k.GetDefinition(ctx, denom)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "not able to get token info for denom:%s", denom)
+	}
+
+	if err := def.CheckFeatureAllowed(sender, types.Feature_transfer_limits); err != nil {
+		return err
+	}
+
+	key := types.CreateTransferLimitKey(denom, addr)
+	if !ctx.KVStore(k.storeKey).Has(key) {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "transfer limit of %s is not set for account %s", denom, addr)
+	}
+	ctx.KVStore(k.storeKey).Delete(key)
+
+	account := ""
+	if len(addr) != 0 {
+		account = addr.String()
+	}
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventTransferLimitRemoved{
+		Denom:   denom,
+		Account: account,
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit EventTransferLimitRemoved event: %s", err)
+	}
+
+	return nil
+}
+
+// GetTransferLimits returns the transfer limits of the token.
+func (k Keeper) GetTransferLimits(
+	ctx sdk.Context,
+	denom string,
+	pagination *query.PageRequest,
+) ([]types.TransferLimit, *query.PageResponse, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.CreateTransferLimitsPrefix(denom))
+	transferLimits := make([]types.TransferLimit, 0)
+	pageRes, err := query.Paginate(store, pagination, func(_, value []byte) error {
+		var transferLimit types.TransferLimit
+		if err := k.cdc.Unmarshal(value, &transferLimit); err != nil {
+			return sdkerrors.Wrapf(types.ErrInvalidState, "failed to unmarshal transfer limit: %s", err)
+		}
+		transferLimits = append(transferLimits, transferLimit)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, sdkerrors.Wrapf(types.ErrInvalidInput, "failed to paginate: %s", err)
+	}
+
+	return transferLimits, pageRes, nil
+}
+
+// GetTransferLimit returns the transfer limit applied to the account and the amount sent by the account within the
+// current period. Nil limit is returned if the account is not limited.
+func (k Keeper) GetTransferLimit(
+	ctx sdk.Context,
+	denom string,
+	addr sdk.AccAddress,
+) (*types.TransferLimit, sdkmath.Int, error) {
+	def, err := k.GetDefinition(ctx, denom)
+	if err != nil {
+		return nil, sdkmath.Int{}, sdkerrors.Wrapf(err, "not able to get token info for denom:%s", denom)
+	}
+
+	transferLimit, err := k.getAppliedTransferLimit(ctx, def, addr)
+	if err != nil || transferLimit == nil {
+		return nil, sdkmath.ZeroInt(), err
+	}
+
+	sent, _, err := k.getSentWithinTransferLimitPeriod(ctx, *transferLimit, addr)
+	if err != nil {
+		return nil, sdkmath.Int{}, err
+	}
+
+	return transferLimit, sent, nil
+}
+
+// ImportTransferLimits imports the transfer limits and the amounts sent within the transfer limit windows from
+// genesis state.
+func (k Keeper) ImportTransferLimits(
+	ctx sdk.Context,
+	transferLimits []types.TransferLimit,
+	transferLimitUsages []types.TransferLimitUsage,
+) error {
+	for _, transferLimit := range transferLimits {
+		if err := k.setTransferLimit(ctx, transferLimit); err != nil {
+			return err
+		}
+	}
+	for _, usage := range transferLimitUsages {
+		addr, err := sdk.AccAddressFromBech32(usage.Account)
+		if err != nil {
+			return sdkerrors.Wrapf(cosmoserrors.ErrInvalidAddress, "invalid account address %s", usage.Account)
+		}
+		if err := k.setTransferLimitUsage(ctx, addr, usage); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ExportTransferLimits exports the transfer limits and the amounts sent within the transfer limit windows.
+func (k Keeper) ExportTransferLimits(ctx sdk.Context) ([]types.TransferLimit, []types.TransferLimitUsage, error) {
+	transferLimits := make([]types.TransferLimit, 0)
+	_, err := query.Paginate(
+		prefix.NewStore(ctx.KVStore(k.storeKey), types.TransferLimitKeyPrefix),
+		&query.PageRequest{Limit: query.MaxLimit},
+		func(_, value []byte) error {
+			var transferLimit types.TransferLimit
+			if err := k.cdc.Unmarshal(value, &transferLimit); err != nil {
+				return sdkerrors.Wrapf(types.ErrInvalidState, "failed to unmarshal transfer limit: %s", err)
+			}
+			transferLimits = append(transferLimits, transferLimit)
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, nil, sdkerrors.Wrapf(types.ErrInvalidInput, "failed to paginate: %s", err)
+	}
+
+	usages := make([]types.TransferLimitUsage, 0)
+	_, err = query.Paginate(
+		prefix.NewStore(ctx.KVStore(k.storeKey), types.TransferLimitUsageKeyPrefix),
+		&query.PageRequest{Limit: query.MaxLimit},
+		func(_, value []byte) error {
+			var usage types.TransferLimitUsage
+			if err := k.cdc.Unmarshal(value, &usage); err != nil {
+				return sdkerrors.Wrapf(types.ErrInvalidState, "failed to unmarshal transfer limit usage: %s", err)
+			}
+			usages = append(usages, usage)
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, nil, sdkerrors.Wrapf(types.ErrInvalidInput, "failed to paginate: %s", err)
+	}
+
+	return transferLimits, usages, nil
+}
+
+// useTransferLimit increases the amount sent by the account within the current transfer limit window, if the account
+// is limited, and returns an error if the limit is exceeded.
+func (k Keeper) useTransferLimit(ctx sdk.Context, def types.Definition, addr sdk.AccAddress, amount sdkmath.Int) error {
+	// The funds sent by the escrow address when IBC transfer is received or refunded are not limited, because they
+	// were limited when they were sent out of the chain.
+	if wibctransfertypes.IsPurposeIn(ctx) || wibctransfertypes.IsPurposeAck(ctx) ||
+		wibctransfertypes.IsPurposeTimeout(ctx) {
+		return nil
+	}
+
+	transferLimit, err := k.getAppliedTransferLimit(ctx, def, addr)
+	if err != nil || transferLimit == nil {
+		return err
+	}
+
+	sent, expiredKeys, err := k.getSentWithinTransferLimitPeriod(ctx, *transferLimit, addr)
+	if err != nil {
+		return err
+	}
+
+	if sent.Add(amount).GT(transferLimit.Amount) {
+		return sdkerrors.Wrapf(
+			types.ErrTransferLimitExceeded,
+			"sending %s%s would exceed the transfer limit %s for the period %s, already sent: %s",
+			amount, def.Denom, transferLimit.Amount, transferLimit.Period, sent,
+		)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	for _, key := range expiredKeys {
+		store.Delete(key)
+	}
+
+	windowStart := ctx.BlockTime().Truncate(types.TransferLimitWindow(transferLimit.Period)).UTC()
+	usage, err := k.getTransferLimitUsage(ctx, def.Denom, addr, windowStart)
+	if err != nil {
+		return err
+	}
+	usage.Sent = usage.Sent.Add(amount)
+
+	return k.setTransferLimitUsage(ctx, addr, usage)
+}
+
+// getAppliedTransferLimit returns the transfer limit of the account, or the default one if the account doesn't have
+// its own limit. Nil is returned if the account is not limited.
+func (k Keeper) getAppliedTransferLimit(
+	ctx sdk.Context,
+	def types.Definition,
+	addr sdk.AccAddress,
+) (*types.TransferLimit, error) {
+	if !def.IsFeatureEnabled(types.Feature_transfer_limits) || def.IsAdmin(addr) {
+		return nil, nil //nolint:nilnil // nil means the account is not limited
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.CreateTransferLimitKey(def.Denom, addr))
+	if bz == nil {
+		bz = store.Get(types.CreateTransferLimitKey(def.Denom, nil))
+	}
+	if bz == nil {
+		return nil, nil //nolint:nilnil // nil means the account is not limited
+	}
+
+	var transferLimit types.TransferLimit
+	if err := k.cdc.Unmarshal(bz, &transferLimit); err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidState, "failed to unmarshal transfer limit: %s", err)
+	}
+
+	return &transferLimit, nil
+}
+
+// getSentWithinTransferLimitPeriod returns the amount sent by the account within the windows started during the
+// current period, and the keys of the windows which are expired.
+func (k Keeper) getSentWithinTransferLimitPeriod(
+	ctx sdk.Context,
+	transferLimit types.TransferLimit,
+	addr sdk.AccAddress,
+) (sdkmath.Int, [][]byte, error) {
+	periodStart := ctx.BlockTime().Add(-transferLimit.Period)
+	usagesPrefix := types.CreateTransferLimitUsagesPrefix(transferLimit.Denom, addr)
+	iterator := prefix.NewStore(ctx.KVStore(k.storeKey), usagesPrefix).Iterator(nil, nil)
+	defer iterator.Close()
+
+	sent := sdkmath.ZeroInt()
+	var expiredKeys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		var usage types.TransferLimitUsage
+		if err := k.cdc.Unmarshal(iterator.Value(), &usage); err != nil {
+			return sdkmath.Int{}, nil, sdkerrors.Wrapf(
+				types.ErrInvalidState, "failed to unmarshal transfer limit usage: %s", err,
+			)
+		}
+		if !usage.WindowStart.After(periodStart) {
+			expiredKeys = append(expiredKeys, append(append([]byte{}, usagesPrefix...), iterator.Key()...))
+			continue
+		}
+		sent = sent.Add(usage.Sent)
+	}
+
+	return sent, expiredKeys, nil
+}
+
+func (k Keeper) getTransferLimitUsage(
+	ctx sdk.Context,
+	denom string,
+	addr sdk.AccAddress,
+	windowStart time.Time,
+) (types.TransferLimitUsage, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.CreateTransferLimitUsageKey(denom, addr, windowStart))
+	if bz == nil {
+		return types.TransferLimitUsage{
+			Denom:       denom,
+			Account:     addr.String(),
+			WindowStart: windowStart,
+			Sent:        sdkmath.ZeroInt(),
+		}, nil
+	}
+
+	var usage types.TransferLimitUsage
+	if err := k.cdc.Unmarshal(bz, &usage); err != nil {
+		return types.TransferLimitUsage{}, sdkerrors.Wrapf(
+			types.ErrInvalidState, "failed to unmarshal transfer limit usage: %s", err,
+		)
+	}
+
+	return usage, nil
+}
+
+func (k Keeper) setTransferLimitUsage(ctx sdk.Context, addr sdk.AccAddress, usage types.TransferLimitUsage) error {
+	bz, err := k.cdc.Marshal(&usage)
+	if err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "failed to marshal transfer limit usage: %s", err)
+	}
+
+	ctx.KVStore(k.storeKey).Set(types.CreateTransferLimitUsageKey(usage.Denom, addr, usage.WindowStart), bz)
+
+	return nil
+}
+
+func (k Keeper) setTransferLimit(ctx sdk.Context, transferLimit types.TransferLimit) error {
+	var addr sdk.AccAddress
+	if transferLimit.Account != "" {
+		var err error
+		addr, err = sdk.AccAddressFromBech32(transferLimit.Account)
+		if err != nil {
+			return sdkerrors.Wrapf(cosmoserrors.ErrInvalidAddress, "invalid account address %s", transferLimit.Account)
+		}
+	}
+
+	bz, err := k.cdc.Marshal(&transferLimit)
+	if err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "failed to marshal transfer limit: %s", err)
+	}
+
+	ctx.KVStore(k.storeKey).Set(types.CreateTransferLimitKey(transferLimit.Denom, addr), bz)
+
+	return nil
+}
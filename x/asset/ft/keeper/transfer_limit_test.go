@@ -0,0 +1,131 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreum/v3/testutil/simapp"
+	"github.com/CoreumFoundation/coreum/v3/x/asset/ft/types"
+)
+
+func TestKeeper_TransferLimit(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	blockTime := time.Date(2023, 2, 13, 1, 0, 0, 0, time.UTC)
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{}).WithBlockTime(blockTime)
+
+	ftKeeper := testApp.AssetFTKeeper
+	bankKeeper := testApp.BankKeeper
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	denom, err := ftKeeper.Issue(ctx, types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "DEF",
+		Subunit:       "def",
+		Precision:     1,
+		InitialAmount: sdkmath.NewInt(10000),
+		Features:      []types.Feature{types.Feature_transfer_limits},
+	})
+	requireT.NoError(err)
+
+	unlimitedDenom, err := ftKeeper.Issue(ctx, types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "ABC",
+		Subunit:       "abc",
+		Precision:     1,
+		InitialAmount: sdkmath.NewInt(10000),
+	})
+	requireT.NoError(err)
+
+	sender := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	recipient := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	requireT.NoError(bankKeeper.SendCoins(ctx, issuer, sender, sdk.NewCoins(sdk.NewInt64Coin(denom, 1000))))
+
+	// the feature is disabled
+	err = ftKeeper.SetTransferLimit(ctx, issuer, sender, unlimitedDenom, sdkmath.NewInt(100), time.Hour)
+	requireT.ErrorIs(err, types.ErrFeatureDisabled)
+
+	// try to set the limit from non admin account
+	err = ftKeeper.SetTransferLimit(ctx, sender, nil, denom, sdkmath.NewInt(100), time.Hour)
+	requireT.ErrorIs(err, cosmoserrors.ErrUnauthorized)
+
+	// the admin can't be limited
+	err = ftKeeper.SetTransferLimit(ctx, issuer, issuer, denom, sdkmath.NewInt(100), time.Hour)
+	requireT.ErrorIs(err, cosmoserrors.ErrUnauthorized)
+
+	// the period is too short
+	err = ftKeeper.SetTransferLimit(ctx, issuer, nil, denom, sdkmath.NewInt(100), time.Second)
+	requireT.ErrorIs(err, types.ErrInvalidInput)
+
+	// set the default limit
+	requireT.NoError(ftKeeper.SetTransferLimit(ctx, issuer, nil, denom, sdkmath.NewInt(100), time.Hour))
+
+	requireT.NoError(bankKeeper.SendCoins(ctx, sender, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 60))))
+	err = bankKeeper.SendCoins(ctx, sender, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 50)))
+	requireT.ErrorIs(err, types.ErrTransferLimitExceeded)
+	requireT.NoError(bankKeeper.SendCoins(ctx, sender, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 40))))
+
+	transferLimit, sent, err := ftKeeper.GetTransferLimit(ctx, denom, sender)
+	requireT.NoError(err)
+	requireT.NotNil(transferLimit)
+	requireT.Equal("", transferLimit.Account)
+	requireT.Equal(sdkmath.NewInt(100).String(), sent.String())
+
+	// the admin is not limited
+	requireT.NoError(bankKeeper.SendCoins(ctx, issuer, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 1000))))
+	transferLimit, _, err = ftKeeper.GetTransferLimit(ctx, denom, issuer)
+	requireT.NoError(err)
+	requireT.Nil(transferLimit)
+
+	// set the limit of the account overriding the default one
+	requireT.NoError(ftKeeper.SetTransferLimit(ctx, issuer, sender, denom, sdkmath.NewInt(200), 2*time.Hour))
+	requireT.NoError(bankKeeper.SendCoins(ctx, sender, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 100))))
+	err = bankKeeper.SendCoins(ctx, sender, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 1)))
+	requireT.ErrorIs(err, types.ErrTransferLimitExceeded)
+
+	transferLimits, _, err := ftKeeper.GetTransferLimits(ctx, denom, nil)
+	requireT.NoError(err)
+	requireT.Len(transferLimits, 2)
+
+	// the sent amounts are counted within the whole rolling period
+	ctx = ctx.WithBlockTime(blockTime.Add(time.Hour))
+	err = bankKeeper.SendCoins(ctx, sender, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 1)))
+	requireT.ErrorIs(err, types.ErrTransferLimitExceeded)
+
+	// the sent amounts expire when the period passes
+	ctx = ctx.WithBlockTime(blockTime.Add(2 * time.Hour))
+	_, sent, err = ftKeeper.GetTransferLimit(ctx, denom, sender)
+	requireT.NoError(err)
+	requireT.Equal(sdkmath.ZeroInt().String(), sent.String())
+	requireT.NoError(bankKeeper.SendCoins(ctx, sender, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 150))))
+	_, sent, err = ftKeeper.GetTransferLimit(ctx, denom, sender)
+	requireT.NoError(err)
+	requireT.Equal(sdkmath.NewInt(150).String(), sent.String())
+
+	// the expired windows are pruned
+	_, usages, err := ftKeeper.ExportTransferLimits(ctx)
+	requireT.NoError(err)
+	requireT.Len(usages, 1)
+
+	// remove the limit of the account, the default one is applied again
+	requireT.NoError(ftKeeper.RemoveTransferLimit(ctx, issuer, sender, denom))
+	err = ftKeeper.RemoveTransferLimit(ctx, issuer, sender, denom)
+	requireT.ErrorIs(err, types.ErrInvalidInput)
+	transferLimit, _, err = ftKeeper.GetTransferLimit(ctx, denom, sender)
+	requireT.NoError(err)
+	requireT.Equal("", transferLimit.Account)
+	err = bankKeeper.SendCoins(ctx, sender, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 1)))
+	requireT.ErrorIs(err, types.ErrTransferLimitExceeded)
+
+	// remove the default limit
+	requireT.NoError(ftKeeper.RemoveTransferLimit(ctx, issuer, nil, denom))
+	requireT.NoError(bankKeeper.SendCoins(ctx, sender, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 500))))
+}
@@ -0,0 +1,243 @@
+package keeper
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/CoreumFoundation/coreum/v3/x/asset/ft/types"
+)
+
+// CreateVestingSchedule sends the amount of the schedule from the admin to the account and locks it until it is
+// vested according to the schedule.
+func (k Keeper) CreateVestingSchedule(ctx sdk.Context, sender sdk.AccAddress, schedule types.VestingSchedule) error {
+	if err := schedule.Validate(); err != nil {
+		return err
+	}
+
+	def, err := k.adminChecks(ctx, sender, schedule.Denom)
+	if err != nil {
+		return err
+	}
+
+	addr := sdk.MustAccAddressFromBech32(schedule.Account)
+	if def.IsAdmin(addr) {
+		return sdkerrors.Wrap(cosmoserrors.ErrUnauthorized, "vesting schedule can't be created for the admin")
+	}
+
+	if k.bankKeeper.BlockedAddr(addr) {
+		return sdkerrors.Wrap(cosmoserrors.ErrUnauthorized, "vesting schedule can't be created for module accounts")
+	}
+
+	if err := k.isCoinReceivable(ctx, addr, def, schedule.Amount); err != nil {
+		return sdkerrors.Wrapf(err, "coins are not receivable")
+	}
+
+	// the bank keeper used by the module doesn't call the asset hooks, so the amount locked by the dex orders of
+	// the admin is checked here, while the rates and limits are not applied
+	coin := sdk.NewCoin(schedule.Denom, schedule.Amount)
+	if err := k.availableBalanceChecks(ctx, sender, coin); err != nil {
+		return err
+	}
+
+	if err := k.recordDistributionHolders(ctx, schedule.Denom, addr, sender); err != nil {
+		return err
+	}
+
+	if err := k.bankKeeper.SendCoins(ctx, sender, addr, sdk.NewCoins(coin)); err != nil {
+		return sdkerrors.Wrapf(err, "can't send coins from admin %s to account %s", sender.String(), addr.String())
+	}
+
+	// the vested schedules are pruned here and not when the funds are sent, so the spendability checks don't
+	// write to the store
+	if err := k.pruneVestingSchedules(ctx, schedule.Denom, addr); err != nil {
+		return err
+	}
+
+	if err := k.appendVestingSchedule(ctx, addr, schedule); err != nil {
+		return err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventVestingScheduleCreated{
+		Denom:       schedule.Denom,
+		Account:     schedule.Account,
+		VestingType: schedule.VestingType,
+		Amount:      schedule.Amount,
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit EventVestingScheduleCreated event: %s", err)
+	}
+
+	return nil
+}
+
+// GetVestingSchedules returns the vesting schedules of the account and denom ordered by the creation.
+func (k Keeper) GetVestingSchedules(
+	ctx sdk.Context,
+	denom string,
+	addr sdk.AccAddress,
+) ([]types.VestingSchedule, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.CreateVestingSchedulesPrefix(denom, addr))
+	return k.collectVestingSchedules(store)
+}
+
+// GetVestingLockedAmount returns the amount of the denom locked on the account by the vesting schedules.
+func (k Keeper) GetVestingLockedAmount(ctx sdk.Context, denom string, addr sdk.AccAddress) (sdkmath.Int, error) {
+	schedules, err := k.GetVestingSchedules(ctx, denom, addr)
+	if err != nil {
+		return sdkmath.Int{}, err
+	}
+
+	locked := sdkmath.ZeroInt()
+	for _, schedule := range schedules {
+		locked = locked.Add(schedule.UnvestedAmount(ctx.BlockTime()))
+	}
+
+	return locked, nil
+}
+
+// ImportVestingSchedules imports the vesting schedules from genesis state.
+func (k Keeper) ImportVestingSchedules(ctx sdk.Context, schedules []types.VestingSchedule) error {
+	for _, schedule := range schedules {
+		addr, err := sdk.AccAddressFromBech32(schedule.Account)
+		if err != nil {
+			return sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid account address")
+		}
+		if err := k.appendVestingSchedule(ctx, addr, schedule); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ExportVestingSchedules exports the vesting schedules.
+func (k Keeper) ExportVestingSchedules(ctx sdk.Context) ([]types.VestingSchedule, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.VestingScheduleKeyPrefix)
+	return k.collectVestingSchedules(store)
+}
+
+func (k Keeper) collectVestingSchedules(store prefix.Store) ([]types.VestingSchedule, error) {
+	schedules := make([]types.VestingSchedule, 0)
+	_, err := query.Paginate(store, &query.PageRequest{Limit: query.MaxLimit}, func(key, value []byte) error {
+		var schedule types.VestingSchedule
+		if err := k.cdc.Unmarshal(value, &schedule); err != nil {
+			return sdkerrors.Wrapf(types.ErrInvalidState, "failed to unmarshal vesting schedule: %s", err)
+		}
+		schedules = append(schedules, schedule)
+
+		return nil
+	})
+	if err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidInput, "failed to paginate: %s", err)
+	}
+
+	return schedules, nil
+}
+
+func (k Keeper) appendVestingSchedule(ctx sdk.Context, addr sdk.AccAddress, schedule types.VestingSchedule) error {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.CreateVestingSchedulesPrefix(schedule.Denom, addr))
+
+	var index uint64
+	iterator := store.ReverseIterator(nil, nil)
+	if iterator.Valid() {
+		index = sdk.BigEndianToUint64(iterator.Key()) + 1
+	}
+	iterator.Close()
+
+	bz, err := k.cdc.Marshal(&schedule)
+	if err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "failed to marshal vesting schedule: %s", err)
+	}
+	store.Set(sdk.Uint64ToBigEndian(index), bz)
+
+	return nil
+}
+
+// reduceVestingSchedules reduces the amount locked by the vesting schedules of the account by the provided amount,
+// e.g. after the clawback. The amount is taken from the latest schedules first, and the schedules which don't lock
+// any amount anymore are removed.
+func (k Keeper) reduceVestingSchedules(ctx sdk.Context, denom string, addr sdk.AccAddress, amount sdkmath.Int) error {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.CreateVestingSchedulesPrefix(denom, addr))
+	keys, schedules, err := k.collectReducedVestingSchedules(ctx, store, amount)
+	if err != nil {
+		return err
+	}
+
+	for i, schedule := range schedules {
+		if schedule.UnvestedAmount(ctx.BlockTime()).IsZero() {
+			store.Delete(keys[i])
+			continue
+		}
+		bz, err := k.cdc.Marshal(&schedule)
+		if err != nil {
+			return sdkerrors.Wrapf(types.ErrInvalidState, "failed to marshal vesting schedule: %s", err)
+		}
+		store.Set(keys[i], bz)
+	}
+
+	return nil
+}
+
+func (k Keeper) collectReducedVestingSchedules(
+	ctx sdk.Context,
+	store prefix.Store,
+	amount sdkmath.Int,
+) ([][]byte, []types.VestingSchedule, error) {
+	iterator := store.ReverseIterator(nil, nil)
+	defer iterator.Close()
+
+	var keys [][]byte
+	var schedules []types.VestingSchedule
+	for ; iterator.Valid() && amount.IsPositive(); iterator.Next() {
+		var schedule types.VestingSchedule
+		if err := k.cdc.Unmarshal(iterator.Value(), &schedule); err != nil {
+			return nil, nil, sdkerrors.Wrapf(types.ErrInvalidState, "failed to unmarshal vesting schedule: %s", err)
+		}
+		unvested := schedule.UnvestedAmount(ctx.BlockTime())
+		if unvested.IsZero() {
+			continue
+		}
+		reduction := sdkmath.MinInt(amount, unvested)
+		amount = amount.Sub(reduction)
+
+		keys = append(keys, iterator.Key())
+		schedules = append(schedules, schedule.ReduceUnvested(ctx.BlockTime(), reduction))
+	}
+
+	return keys, schedules, nil
+}
+
+// pruneVestingSchedules removes the schedules of the account which don't lock any amount anymore.
+func (k Keeper) pruneVestingSchedules(ctx sdk.Context, denom string, addr sdk.AccAddress) error {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.CreateVestingSchedulesPrefix(denom, addr))
+	vestedKeys, err := k.collectVestedScheduleKeys(ctx, store)
+	if err != nil {
+		return err
+	}
+
+	for _, key := range vestedKeys {
+		store.Delete(key)
+	}
+
+	return nil
+}
+
+func (k Keeper) collectVestedScheduleKeys(ctx sdk.Context, store prefix.Store) ([][]byte, error) {
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		var schedule types.VestingSchedule
+		if err := k.cdc.Unmarshal(iterator.Value(), &schedule); err != nil {
+			return nil, sdkerrors.Wrapf(types.ErrInvalidState, "failed to unmarshal vesting schedule: %s", err)
+		}
+		if schedule.UnvestedAmount(ctx.BlockTime()).IsZero() {
+			keys = append(keys, iterator.Key())
+		}
+	}
+
+	return keys, nil
+}
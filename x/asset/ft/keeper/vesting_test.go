@@ -0,0 +1,331 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreum/v3/testutil/event"
+	"github.com/CoreumFoundation/coreum/v3/testutil/simapp"
+	"github.com/CoreumFoundation/coreum/v3/x/asset/ft/types"
+)
+
+func TestKeeper_VestingSchedule(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	startTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{}).WithBlockTime(startTime)
+
+	ftKeeper := testApp.AssetFTKeeper
+	bankKeeper := testApp.BankKeeper
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	denom, err := ftKeeper.Issue(ctx, types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "DEF",
+		Subunit:       "def",
+		Precision:     1,
+		InitialAmount: sdkmath.NewInt(10000),
+		Features: []types.Feature{
+			types.Feature_freezing,
+			types.Feature_burning,
+		},
+	})
+	requireT.NoError(err)
+
+	account := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	recipient := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	endTime := startTime.Add(100 * time.Hour)
+	linearSchedule := types.VestingSchedule{
+		Denom:       denom,
+		Account:     account.String(),
+		VestingType: types.VestingType_linear,
+		Amount:      sdkmath.NewInt(1000),
+		StartTime:   startTime,
+		EndTime:     &endTime,
+	}
+
+	// try to create the schedule from non admin account
+	err = ftKeeper.CreateVestingSchedule(ctx, recipient, linearSchedule)
+	requireT.ErrorIs(err, cosmoserrors.ErrUnauthorized)
+
+	// try to create the schedule for the admin
+	adminSchedule := linearSchedule
+	adminSchedule.Account = issuer.String()
+	err = ftKeeper.CreateVestingSchedule(ctx, issuer, adminSchedule)
+	requireT.ErrorIs(err, cosmoserrors.ErrUnauthorized)
+
+	// create the linear schedule
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	requireT.NoError(ftKeeper.CreateVestingSchedule(ctx, issuer, linearSchedule))
+	requireT.Equal(sdkmath.NewInt(1000).String(), bankKeeper.GetBalance(ctx, account, denom).Amount.String())
+
+	createdEvents, err := event.FindTypedEvents[*types.EventVestingScheduleCreated](
+		ctx.EventManager().Events().ToABCIEvents(),
+	)
+	requireT.NoError(err)
+	requireT.Equal([]*types.EventVestingScheduleCreated{{
+		Denom:       denom,
+		Account:     account.String(),
+		VestingType: types.VestingType_linear,
+		Amount:      sdkmath.NewInt(1000),
+	}}, createdEvents)
+
+	locked, err := ftKeeper.GetVestingLockedAmount(ctx, denom, account)
+	requireT.NoError(err)
+	requireT.Equal(sdkmath.NewInt(1000).String(), locked.String())
+
+	// the locked amount can't be sent
+	err = bankKeeper.SendCoins(ctx, account, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 1)))
+	requireT.ErrorIs(err, cosmoserrors.ErrInsufficientFunds)
+
+	// a quarter is vested
+	ctx = ctx.WithBlockTime(startTime.Add(25 * time.Hour))
+	locked, err = ftKeeper.GetVestingLockedAmount(ctx, denom, account)
+	requireT.NoError(err)
+	requireT.Equal(sdkmath.NewInt(750).String(), locked.String())
+
+	err = bankKeeper.SendCoins(ctx, account, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 251)))
+	requireT.ErrorIs(err, cosmoserrors.ErrInsufficientFunds)
+	requireT.NoError(bankKeeper.SendCoins(ctx, account, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 200))))
+
+	// the frozen amount is not available on top of the locked one
+	requireT.NoError(ftKeeper.Freeze(ctx, issuer, account, sdk.NewInt64Coin(denom, 30)))
+	err = bankKeeper.SendCoins(ctx, account, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 21)))
+	requireT.ErrorIs(err, cosmoserrors.ErrInsufficientFunds)
+	requireT.NoError(bankKeeper.SendCoins(ctx, account, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 20))))
+	requireT.NoError(ftKeeper.Unfreeze(ctx, issuer, account, sdk.NewInt64Coin(denom, 30)))
+
+	// the locked amount can't be burnt
+	err = ftKeeper.Burn(ctx, account, sdk.NewInt64Coin(denom, 31))
+	requireT.ErrorIs(err, cosmoserrors.ErrInsufficientFunds)
+
+	// create the cliff and periodic schedules
+	cliffEndTime := startTime.Add(50 * time.Hour)
+	requireT.NoError(ftKeeper.CreateVestingSchedule(ctx, issuer, types.VestingSchedule{
+		Denom:       denom,
+		Account:     account.String(),
+		VestingType: types.VestingType_cliff,
+		Amount:      sdkmath.NewInt(500),
+		StartTime:   startTime,
+		EndTime:     &cliffEndTime,
+	}))
+	requireT.NoError(ftKeeper.CreateVestingSchedule(ctx, issuer, types.VestingSchedule{
+		Denom:       denom,
+		Account:     account.String(),
+		VestingType: types.VestingType_periodic,
+		Amount:      sdkmath.NewInt(300),
+		StartTime:   startTime.Add(25 * time.Hour),
+		Periods: []types.VestingPeriod{
+			{Length: 10 * time.Hour, Amount: sdkmath.NewInt(100)},
+			{Length: 10 * time.Hour, Amount: sdkmath.NewInt(200)},
+		},
+	}))
+
+	schedules, err := ftKeeper.GetVestingSchedules(ctx, denom, account)
+	requireT.NoError(err)
+	requireT.Len(schedules, 3)
+
+	locked, err = ftKeeper.GetVestingLockedAmount(ctx, denom, account)
+	requireT.NoError(err)
+	requireT.Equal(sdkmath.NewInt(750+500+300).String(), locked.String())
+
+	// the first period is vested
+	ctx = ctx.WithBlockTime(startTime.Add(40 * time.Hour))
+	locked, err = ftKeeper.GetVestingLockedAmount(ctx, denom, account)
+	requireT.NoError(err)
+	requireT.Equal(sdkmath.NewInt(600+500+200).String(), locked.String())
+
+	// the cliff and the periodic schedules are vested
+	ctx = ctx.WithBlockTime(startTime.Add(50 * time.Hour))
+	locked, err = ftKeeper.GetVestingLockedAmount(ctx, denom, account)
+	requireT.NoError(err)
+	requireT.Equal(sdkmath.NewInt(500).String(), locked.String())
+
+	// the vested schedules are not removed when the funds are sent
+	requireT.NoError(bankKeeper.SendCoins(ctx, account, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 1))))
+	schedules, err = ftKeeper.GetVestingSchedules(ctx, denom, account)
+	requireT.NoError(err)
+	requireT.Len(schedules, 3)
+
+	// everything is vested
+	ctx = ctx.WithBlockTime(endTime)
+	locked, err = ftKeeper.GetVestingLockedAmount(ctx, denom, account)
+	requireT.NoError(err)
+	requireT.True(locked.IsZero())
+	balance := bankKeeper.GetBalance(ctx, account, denom)
+	requireT.NoError(bankKeeper.SendCoins(ctx, account, recipient, sdk.NewCoins(balance)))
+
+	// the vested schedules are removed when the new one is created
+	newEndTime := endTime.Add(time.Hour)
+	requireT.NoError(ftKeeper.CreateVestingSchedule(ctx, issuer, types.VestingSchedule{
+		Denom:       denom,
+		Account:     account.String(),
+		VestingType: types.VestingType_cliff,
+		Amount:      sdkmath.NewInt(100),
+		StartTime:   endTime,
+		EndTime:     &newEndTime,
+	}))
+	schedules, err = ftKeeper.GetVestingSchedules(ctx, denom, account)
+	requireT.NoError(err)
+	requireT.Len(schedules, 1)
+	requireT.Equal(types.VestingType_cliff, schedules[0].VestingType)
+
+	// the schedules are exported
+	exported, err := ftKeeper.ExportVestingSchedules(ctx)
+	requireT.NoError(err)
+	requireT.Len(exported, 1)
+	requireT.Equal(account.String(), exported[0].Account)
+}
+
+func TestKeeper_VestingScheduleWithDEXLock(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	startTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{}).WithBlockTime(startTime)
+
+	ftKeeper := testApp.AssetFTKeeper
+	bankKeeper := testApp.BankKeeper
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	denom, err := ftKeeper.Issue(ctx, types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "DEF",
+		Subunit:       "def",
+		Precision:     1,
+		InitialAmount: sdkmath.NewInt(1000),
+	})
+	requireT.NoError(err)
+
+	account := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	recipient := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	requireT.NoError(bankKeeper.SendCoins(ctx, issuer, account, sdk.NewCoins(sdk.NewInt64Coin(denom, 50))))
+
+	endTime := startTime.Add(time.Hour)
+	schedule := types.VestingSchedule{
+		Denom:       denom,
+		Account:     account.String(),
+		VestingType: types.VestingType_cliff,
+		Amount:      sdkmath.NewInt(50),
+		StartTime:   startTime,
+		EndTime:     &endTime,
+	}
+
+	// the admin can't use the coins locked by the dex orders to create the schedule
+	requireT.NoError(ftKeeper.DEXLock(ctx, issuer, sdk.NewInt64Coin(denom, 910)))
+	requireT.ErrorIs(ftKeeper.CreateVestingSchedule(ctx, issuer, schedule), cosmoserrors.ErrInsufficientFunds)
+	requireT.NoError(ftKeeper.DEXUnlock(ctx, issuer, sdk.NewInt64Coin(denom, 910)))
+	requireT.NoError(ftKeeper.CreateVestingSchedule(ctx, issuer, schedule))
+
+	// the balance is 100, 50 is locked by the vesting schedule, so only 50 might be locked by the dex orders
+	requireT.ErrorIs(ftKeeper.DEXLock(ctx, account, sdk.NewInt64Coin(denom, 51)), cosmoserrors.ErrInsufficientFunds)
+	requireT.NoError(ftKeeper.DEXLock(ctx, account, sdk.NewInt64Coin(denom, 50)))
+
+	// both locks are subtracted from the balance, so nothing can be sent
+	requireT.ErrorIs(
+		bankKeeper.SendCoins(ctx, account, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 50))),
+		cosmoserrors.ErrInsufficientFunds,
+	)
+	requireT.ErrorIs(
+		bankKeeper.SendCoins(ctx, account, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 1))),
+		cosmoserrors.ErrInsufficientFunds,
+	)
+
+	// the vested amount might be sent
+	ctx = ctx.WithBlockTime(endTime)
+	requireT.NoError(bankKeeper.SendCoins(ctx, account, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 50))))
+	requireT.ErrorIs(
+		bankKeeper.SendCoins(ctx, account, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 1))),
+		cosmoserrors.ErrInsufficientFunds,
+	)
+}
+
+func TestKeeper_VestingScheduleClawback(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	startTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{}).WithBlockTime(startTime)
+
+	ftKeeper := testApp.AssetFTKeeper
+	bankKeeper := testApp.BankKeeper
+
+	issuer := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	denom, err := ftKeeper.Issue(ctx, types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "DEF",
+		Subunit:       "def",
+		Precision:     1,
+		InitialAmount: sdkmath.NewInt(10000),
+		Features:      []types.Feature{types.Feature_clawback},
+	})
+	requireT.NoError(err)
+
+	account := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	recipient := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	requireT.NoError(bankKeeper.SendCoins(ctx, issuer, account, sdk.NewCoins(sdk.NewInt64Coin(denom, 100))))
+
+	endTime := startTime.Add(100 * time.Hour)
+	requireT.NoError(ftKeeper.CreateVestingSchedule(ctx, issuer, types.VestingSchedule{
+		Denom:       denom,
+		Account:     account.String(),
+		VestingType: types.VestingType_linear,
+		Amount:      sdkmath.NewInt(1000),
+		StartTime:   startTime,
+		EndTime:     &endTime,
+	}))
+	requireT.NoError(ftKeeper.CreateVestingSchedule(ctx, issuer, types.VestingSchedule{
+		Denom:       denom,
+		Account:     account.String(),
+		VestingType: types.VestingType_cliff,
+		Amount:      sdkmath.NewInt(200),
+		StartTime:   startTime,
+		EndTime:     &endTime,
+	}))
+
+	// a quarter of the linear schedule is vested, so 750 + 200 is locked
+	ctx = ctx.WithBlockTime(startTime.Add(25 * time.Hour))
+	locked, err := ftKeeper.GetVestingLockedAmount(ctx, denom, account)
+	requireT.NoError(err)
+	requireT.Equal(sdkmath.NewInt(950).String(), locked.String())
+
+	// the clawed back amount is taken from the latest schedule first, so the cliff schedule is removed
+	// and the linear one is reduced by 300
+	requireT.NoError(ftKeeper.Clawback(ctx, issuer, account, sdk.NewInt64Coin(denom, 500)))
+	schedules, err := ftKeeper.GetVestingSchedules(ctx, denom, account)
+	requireT.NoError(err)
+	requireT.Len(schedules, 1)
+	requireT.Equal(types.VestingType_linear, schedules[0].VestingType)
+	locked, err = ftKeeper.GetVestingLockedAmount(ctx, denom, account)
+	requireT.NoError(err)
+	requireT.Equal(sdkmath.NewInt(450).String(), locked.String())
+
+	// the balance is 800, so the amount which is not locked might be sent
+	requireT.ErrorIs(
+		bankKeeper.SendCoins(ctx, account, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 351))),
+		cosmoserrors.ErrInsufficientFunds,
+	)
+	requireT.NoError(bankKeeper.SendCoins(ctx, account, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 350))))
+
+	// the rest of the linear schedule is vested until the original end time
+	ctx = ctx.WithBlockTime(startTime.Add(62*time.Hour + 30*time.Minute))
+	locked, err = ftKeeper.GetVestingLockedAmount(ctx, denom, account)
+	requireT.NoError(err)
+	requireT.Equal(sdkmath.NewInt(225).String(), locked.String())
+
+	// the clawback of more than the locked amount removes all the schedules
+	requireT.NoError(ftKeeper.Clawback(ctx, issuer, account, sdk.NewInt64Coin(denom, 300)))
+	schedules, err = ftKeeper.GetVestingSchedules(ctx, denom, account)
+	requireT.NoError(err)
+	requireT.Empty(schedules)
+	requireT.NoError(bankKeeper.SendCoins(ctx, account, recipient, sdk.NewCoins(sdk.NewInt64Coin(denom, 150))))
+}
@@ -0,0 +1,28 @@
+package v3
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CoreumFoundation/coreum/v3/x/asset/ft/types"
+)
+
+// FTKeeper represents ft keeper.
+type FTKeeper interface {
+	IterateAllDefinitions(ctx sdk.Context, cb func(types.Definition) (bool, error)) error
+	SetDefinition(ctx sdk.Context, issuer sdk.AccAddress, subunit string, definition types.Definition)
+}
+
+// MigrateAdmin migrates asset ft definitions from v3 to v4.
+// It sets the issuer of each token as its admin.
+func MigrateAdmin(ctx sdk.Context, keeper FTKeeper) error {
+	return keeper.IterateAllDefinitions(ctx, func(def types.Definition) (bool, error) {
+		subunit, issuer, err := types.DeconstructDenom(def.Denom)
+		if err != nil {
+			return false, err
+		}
+
+		def.Admin = def.Issuer
+		keeper.SetDefinition(ctx, issuer, subunit, def)
+		return false, nil
+	})
+}
@@ -0,0 +1,63 @@
+package v3_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreum/v3/testutil/simapp"
+	v3 "github.com/CoreumFoundation/coreum/v3/x/asset/ft/migrations/v3"
+	"github.com/CoreumFoundation/coreum/v3/x/asset/ft/types"
+)
+
+func TestMigrateAdmin(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	blockTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := testApp.NewContext(false, tmproto.Header{}).WithBlockTime(blockTime)
+
+	keeper := testApp.AssetFTKeeper
+	issuer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	settings := types.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "DEF",
+		Subunit:       "def",
+		Precision:     1,
+		Description:   "DEF Desc",
+		InitialAmount: sdkmath.NewInt(1000),
+		Features:      []types.Feature{types.Feature_minting},
+	}
+	denom, err := keeper.Issue(ctx, settings)
+	requireT.NoError(err)
+
+	// simulate the definition stored before the admin was introduced
+	def, err := keeper.GetDefinition(ctx, denom)
+	requireT.NoError(err)
+	def.Admin = ""
+	keeper.SetDefinition(ctx, issuer, settings.Subunit, def)
+
+	tokens, _, err := keeper.GetIssuerTokens(ctx, issuer, &query.PageRequest{})
+	requireT.NoError(err)
+	requireT.Empty(tokens)
+	requireT.Error(keeper.Mint(ctx, issuer, issuer, sdk.NewCoin(denom, sdkmath.NewInt(1))))
+
+	requireT.NoError(v3.MigrateAdmin(ctx, keeper))
+
+	def, err = keeper.GetDefinition(ctx, denom)
+	requireT.NoError(err)
+	requireT.Equal(issuer.String(), def.Admin)
+
+	tokens, _, err = keeper.GetIssuerTokens(ctx, issuer, &query.PageRequest{})
+	requireT.NoError(err)
+	requireT.Len(tokens, 1)
+	requireT.Equal(denom, tokens[0].Denom)
+	requireT.NoError(keeper.Mint(ctx, issuer, issuer, sdk.NewCoin(denom, sdkmath.NewInt(1))))
+}
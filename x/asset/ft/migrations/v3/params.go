@@ -0,0 +1,20 @@
+package v3
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CoreumFoundation/coreum/v3/x/asset/ft/types"
+)
+
+// ParamsKeeper specifies methods of the ft keeper required by the params migration.
+type ParamsKeeper interface {
+	GetParams(ctx sdk.Context) types.Params
+	SetParams(ctx sdk.Context, params types.Params) error
+}
+
+// MigrateParams sets the default values of the params introduced in v4.
+func MigrateParams(ctx sdk.Context, keeper ParamsKeeper) error {
+	params := keeper.GetParams(ctx)
+	params.MaxDistributionOperationsPerBlock = types.DefaultMaxDistributionOperationsPerBlock
+	return keeper.SetParams(ctx, params)
+}
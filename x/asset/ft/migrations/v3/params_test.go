@@ -0,0 +1,29 @@
+package v3_test
+
+import (
+	"testing"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreum/v3/testutil/simapp"
+	v3 "github.com/CoreumFoundation/coreum/v3/x/asset/ft/migrations/v3"
+	"github.com/CoreumFoundation/coreum/v3/x/asset/ft/types"
+)
+
+func TestMigrateParams(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false, tmproto.Header{})
+	keeper := testApp.AssetFTKeeper
+
+	// the param is not set before the migration
+	params := keeper.GetParams(ctx)
+	params.MaxDistributionOperationsPerBlock = 0
+	requireT.NoError(keeper.SetParams(ctx, params))
+
+	requireT.NoError(v3.MigrateParams(ctx, keeper))
+	params.MaxDistributionOperationsPerBlock = types.DefaultMaxDistributionOperationsPerBlock
+	requireT.Equal(params, keeper.GetParams(ctx))
+}
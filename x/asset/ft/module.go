@@ -23,9 +23,10 @@ import (
 )
 
 var (
-	_ module.AppModule      = AppModule{}
-	_ module.HasGenesis     = AppModule{}
-	_ module.AppModuleBasic = AppModuleBasic{}
+	_ module.AppModule         = AppModule{}
+	_ module.HasGenesis        = AppModule{}
+	_ module.EndBlockAppModule = AppModule{}
+	_ module.AppModuleBasic    = AppModuleBasic{}
 )
 
 // ----------------------------------------------------------------------------
@@ -140,6 +141,9 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	if err := cfg.RegisterMigration(types.ModuleName, 2, m.Migrate2to3); err != nil {
 		panic(err)
 	}
+	if err := cfg.RegisterMigration(types.ModuleName, 3, m.Migrate3to4); err != nil {
+		panic(err)
+	}
 }
 
 // RegisterInvariants registers the asset ft module's invariants.
@@ -166,7 +170,16 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 }
 
 // ConsensusVersion implements ConsensusVersion.
-func (AppModule) ConsensusVersion() uint64 { return 3 }
+func (AppModule) ConsensusVersion() uint64 { return 4 }
+
+// EndBlock pays the holders of the distributions in progress. It returns no validator updates.
+func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+	// the failure is not propagated to not halt the chain, the distributions are processed again in the next block
+	if err := am.keeper.ProcessDistributions(ctx); err != nil {
+		ctx.Logger().Error("distributions can't be processed", "module", types.ModuleName, "error", err)
+	}
+	return []abci.ValidatorUpdate{}
+}
 
 // AppModuleSimulation functions
 
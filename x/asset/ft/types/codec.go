@@ -13,17 +13,38 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgIssue{},
 		&MsgMint{},
+		&MsgMultiMint{},
 		&MsgBurn{},
 		&MsgFreeze{},
+		&MsgMultiFreeze{},
 		&MsgUnfreeze{},
 		&MsgSetFrozen{},
 		&MsgGloballyFreeze{},
 		&MsgGloballyUnfreeze{},
 		&MsgSetWhitelistedLimit{},
+		&MsgMultiSetWhitelistedLimit{},
+		&MsgClawback{},
+		&MsgTransferAdmin{},
+		&MsgClearAdmin{},
+		&MsgUpdateMetadata{},
+		&MsgSetRateExemption{},
+		&MsgRemoveRateExemption{},
+		&MsgUpdateRates{},
+		&MsgSetTransferLimit{},
+		&MsgRemoveTransferLimit{},
+		&MsgBlockAccounts{},
+		&MsgUnblockAccounts{},
+		&MsgCreateVestingSchedule{},
+		&MsgDistribute{},
 		&MsgUpgradeTokenV1{},
+		&MsgUpgradeToken{},
 	)
 	registry.RegisterImplementations((*codec.ProtoMarshaler)(nil),
 		&DelayedTokenUpgradeV1{},
+		&DelayedTokenUpgrade{},
+		&DelayedRatesUpdate{},
+		&DelayedUnfreeze{},
+		&DataBytes{},
 	)
 	registry.RegisterImplementations(
 		(*authz.Authorization)(nil),
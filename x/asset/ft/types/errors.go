@@ -21,4 +21,14 @@ var (
 	ErrWhitelistedLimitExceeded = sdkerrors.Register(ModuleName, 7, "whitelisted limit exceeded")
 	// ErrInvalidState is returned when state of the module is invalid.
 	ErrInvalidState = sdkerrors.Register(ModuleName, 8, "invalid state")
+	// ErrMaxSupplyExceeded is returned when minting would make the supply of the token exceed its max supply.
+	ErrMaxSupplyExceeded = sdkerrors.Register(ModuleName, 9, "max supply exceeded")
+	// ErrMintAllowanceExceeded is returned when the amount minted within the period exceeds the mint allowance.
+	ErrMintAllowanceExceeded = sdkerrors.Register(ModuleName, 10, "mint allowance exceeded")
+	// ErrDistributionNotFound is returned when there is no distribution in progress for the token.
+	ErrDistributionNotFound = sdkerrors.Register(ModuleName, 11, "distribution not found")
+	// ErrTransferLimitExceeded is returned when the amount sent within the period exceeds the transfer limit.
+	ErrTransferLimitExceeded = sdkerrors.Register(ModuleName, 12, "transfer limit exceeded")
+	// ErrAccountBlocked is returned when the account blocked by the admin tries to send or receive the token.
+	ErrAccountBlocked = sdkerrors.Register(ModuleName, 13, "account blocked")
 )
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"context"
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -18,12 +19,20 @@ type BankKeeper interface {
 	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
 	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
+	GetSupply(ctx sdk.Context, denom string) sdk.Coin
 	LockedCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+	BlockedAddr(addr sdk.AccAddress) bool
+	DenomOwners(
+		goCtx context.Context,
+		req *banktypes.QueryDenomOwnersRequest,
+	) (*banktypes.QueryDenomOwnersResponse, error)
 }
 
 // DelayKeeper defines methods required from the delay keeper.
 type DelayKeeper interface {
 	DelayExecution(ctx sdk.Context, id string, data codec.ProtoMarshaler, delay time.Duration) error
+	StoreDelayedExecution(ctx sdk.Context, id string, data codec.ProtoMarshaler, t time.Time) error
+	RemoveDelayedExecution(ctx sdk.Context, id string) error
 }
 
 // WASMKeeper defines methods required from the WASM keeper.
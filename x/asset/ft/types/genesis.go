@@ -1,5 +1,12 @@
 package types
 
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
 // DefaultGenesis returns the default Token genesis state.
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
@@ -27,9 +34,238 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
+	for _, scheduledUnfreeze := range gs.ScheduledUnfreezes {
+		if err := scheduledUnfreeze.Validate(); err != nil {
+			return err
+		}
+	}
+
+	for _, rateExemption := range gs.RateExemptions {
+		if err := rateExemption.Validate(); err != nil {
+			return err
+		}
+	}
+
+	for _, mintAllowanceUsage := range gs.MintAllowanceUsages {
+		if err := mintAllowanceUsage.Validate(); err != nil {
+			return err
+		}
+	}
+
+	for _, distribution := range gs.Distributions {
+		if err := distribution.Validate(); err != nil {
+			return err
+		}
+	}
+
+	for _, distributionHolder := range gs.DistributionHolders {
+		if err := distributionHolder.Validate(); err != nil {
+			return err
+		}
+	}
+
+	for _, transferLimit := range gs.TransferLimits {
+		if err := transferLimit.Validate(); err != nil {
+			return err
+		}
+	}
+
+	for _, transferLimitUsage := range gs.TransferLimitUsages {
+		if err := transferLimitUsage.Validate(); err != nil {
+			return err
+		}
+	}
+
+	for _, blockedAccount := range gs.BlockedAccounts {
+		if err := blockedAccount.Validate(); err != nil {
+			return err
+		}
+	}
+
+	for _, vestingSchedule := range gs.VestingSchedules {
+		if err := vestingSchedule.Validate(); err != nil {
+			return err
+		}
+	}
+
+	for _, pendingRatesUpdate := range gs.PendingRatesUpdates {
+		if err := pendingRatesUpdate.Validate(); err != nil {
+			return err
+		}
+	}
+
 	return gs.Params.ValidateBasic()
 }
 
+// Validate checks all the fields are valid.
+func (re RateExemption) Validate() error {
+	if _, _, err := DeconstructDenom(re.Denom); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(re.Account); err != nil {
+		return sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid account address")
+	}
+
+	return nil
+}
+
+// Validate checks all the fields are valid.
+func (ba BlockedAccount) Validate() error {
+	if _, _, err := DeconstructDenom(ba.Denom); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(ba.Account); err != nil {
+		return sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid account address")
+	}
+
+	return nil
+}
+
+// Validate checks all the fields are valid.
+func (vs VestingSchedule) Validate() error {
+	if _, _, err := DeconstructDenom(vs.Denom); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(vs.Account); err != nil {
+		return sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid account address")
+	}
+
+	return ValidateVestingSchedule(vs.VestingType, vs.Amount, vs.StartTime, vs.EndTime, vs.Periods)
+}
+
+// Validate checks all the fields are valid.
+func (tl TransferLimit) Validate() error {
+	if _, _, err := DeconstructDenom(tl.Denom); err != nil {
+		return err
+	}
+
+	// the limit is the default one if the account is empty
+	if tl.Account != "" {
+		if _, err := sdk.AccAddressFromBech32(tl.Account); err != nil {
+			return sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid account address")
+		}
+	}
+
+	return ValidateTransferLimit(tl.Amount, tl.Period)
+}
+
+// Validate checks all the fields are valid.
+func (tlu TransferLimitUsage) Validate() error {
+	if _, _, err := DeconstructDenom(tlu.Denom); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(tlu.Account); err != nil {
+		return sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid account address")
+	}
+
+	if tlu.Sent.IsNil() || tlu.Sent.IsNegative() {
+		return sdkerrors.Wrap(ErrInvalidInput, "sent amount can't be negative")
+	}
+
+	return nil
+}
+
+// Validate checks all the fields are valid.
+func (mau MintAllowanceUsage) Validate() error {
+	if _, _, err := DeconstructDenom(mau.Denom); err != nil {
+		return err
+	}
+
+	if mau.Minted.IsNil() || mau.Minted.IsNegative() {
+		return sdkerrors.Wrap(ErrInvalidInput, "minted amount can't be negative")
+	}
+
+	return nil
+}
+
+// Validate checks all the fields are valid.
+func (d Distribution) Validate() error {
+	if _, _, err := DeconstructDenom(d.Denom); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(d.Distributor); err != nil {
+		return sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid distributor address")
+	}
+
+	if err := d.Pool.Validate(); err != nil {
+		return err
+	}
+
+	if !d.Pool.IsPositive() {
+		return sdkerrors.Wrap(cosmoserrors.ErrInvalidCoins, "distributed pool must be positive")
+	}
+
+	if d.SnapshotSupply.IsNil() || !d.SnapshotSupply.IsPositive() {
+		return sdkerrors.Wrap(ErrInvalidInput, "snapshot supply must be positive")
+	}
+
+	if d.Distributed.IsNil() || d.Distributed.IsNegative() || d.Distributed.GT(d.Pool.Amount) {
+		return sdkerrors.Wrap(ErrInvalidInput, "distributed amount must be between zero and the pool amount")
+	}
+
+	return nil
+}
+
+// Validate checks all the fields are valid.
+func (dh DistributionHolder) Validate() error {
+	if _, _, err := DeconstructDenom(dh.Denom); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(dh.Account); err != nil {
+		return sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid account address")
+	}
+
+	if dh.Balance.IsNil() || dh.Balance.IsNegative() {
+		return sdkerrors.Wrap(ErrInvalidInput, "balance can't be negative")
+	}
+
+	return nil
+}
+
+// Validate checks all the fields are valid.
+func (pru PendingRatesUpdate) Validate() error {
+	if _, _, err := DeconstructDenom(pru.Denom); err != nil {
+		return err
+	}
+
+	if err := ValidateRatesUpdate(pru.BurnRate, pru.SendCommissionRate); err != nil {
+		return err
+	}
+
+	if pru.EffectiveTime.Unix() <= 0 {
+		return sdkerrors.Wrap(ErrInvalidInput, "effective time must be positive")
+	}
+
+	return nil
+}
+
+// Validate checks all the fields are valid.
+func (su ScheduledUnfreeze) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(su.Account); err != nil {
+		return sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid account address")
+	}
+
+	if err := ValidateAssetCoin(su.Coin); err != nil {
+		return err
+	}
+
+	if !su.Coin.IsPositive() {
+		return sdkerrors.Wrap(cosmoserrors.ErrInvalidCoins, "scheduled unfreeze amount must be positive")
+	}
+
+	if su.UnfreezeTime.Unix() <= 0 {
+		return sdkerrors.Wrap(ErrInvalidInput, "unfreeze time must be positive")
+	}
+
+	return nil
+}
+
 // Validate checks all the fields are valid.
 func (token Token) Validate() error {
 	_, _, err := DeconstructDenom(token.Denom)
@@ -37,6 +273,12 @@ func (token Token) Validate() error {
 		return err
 	}
 
+	if token.Admin != "" {
+		if _, err := sdk.AccAddressFromBech32(token.Admin); err != nil {
+			return sdkerrors.Wrapf(cosmoserrors.ErrInvalidAddress, "invalid admin %s", token.Admin)
+		}
+	}
+
 	if err := ValidateSymbol(token.Symbol); err != nil {
 		return err
 	}
@@ -53,5 +295,17 @@ func (token Token) Validate() error {
 		return err
 	}
 
+	if err := ValidateMetadata(token.Description, token.URI, token.URIHash, token.Data); err != nil {
+		return err
+	}
+
+	if err := ValidateMaxSupply(token.MaxSupply, sdkmath.ZeroInt()); err != nil {
+		return err
+	}
+
+	if err := ValidateMintAllowance(token.MintAllowance); err != nil {
+		return err
+	}
+
 	return ValidateBurnRate(token.BurnRate)
 }
@@ -37,6 +37,26 @@ type GenesisState struct {
 	WhitelistedBalances []Balance `protobuf:"bytes,4,rep,name=whitelisted_balances,json=whitelistedBalances,proto3" json:"whitelisted_balances"`
 	// pending_token_upgrades contains pending token upgrades.
 	PendingTokenUpgrades []PendingTokenUpgrade `protobuf:"bytes,5,rep,name=pending_token_upgrades,json=pendingTokenUpgrades,proto3" json:"pending_token_upgrades"`
+	// scheduled_unfreezes contains the frozen amounts to be unfrozen automatically.
+	ScheduledUnfreezes []ScheduledUnfreeze `protobuf:"bytes,6,rep,name=scheduled_unfreezes,json=scheduledUnfreezes,proto3" json:"scheduled_unfreezes"`
+	// rate_exemptions contains the accounts exempted from the burn rate and send commission rate.
+	RateExemptions []RateExemption `protobuf:"bytes,7,rep,name=rate_exemptions,json=rateExemptions,proto3" json:"rate_exemptions"`
+	// mint_allowance_usages contains the amounts minted within the current mint allowance periods.
+	MintAllowanceUsages []MintAllowanceUsage `protobuf:"bytes,8,rep,name=mint_allowance_usages,json=mintAllowanceUsages,proto3" json:"mint_allowance_usages"`
+	// distributions contains the distributions in progress.
+	Distributions []Distribution `protobuf:"bytes,9,rep,name=distributions,proto3" json:"distributions"`
+	// distribution_holders contains the recorded balances of the holders of the distributions in progress.
+	DistributionHolders []DistributionHolder `protobuf:"bytes,10,rep,name=distribution_holders,json=distributionHolders,proto3" json:"distribution_holders"`
+	// transfer_limits contains the amounts the accounts might send within the rolling periods.
+	TransferLimits []TransferLimit `protobuf:"bytes,11,rep,name=transfer_limits,json=transferLimits,proto3" json:"transfer_limits"`
+	// transfer_limit_usages contains the amounts sent by the accounts within the windows of the transfer limit periods.
+	TransferLimitUsages []TransferLimitUsage `protobuf:"bytes,12,rep,name=transfer_limit_usages,json=transferLimitUsages,proto3" json:"transfer_limit_usages"`
+	// blocked_accounts contains the accounts blocked from sending and receiving the tokens.
+	BlockedAccounts []BlockedAccount `protobuf:"bytes,13,rep,name=blocked_accounts,json=blockedAccounts,proto3" json:"blocked_accounts"`
+	// vesting_schedules contains the amounts locked on the accounts and released over time.
+	VestingSchedules []VestingSchedule `protobuf:"bytes,14,rep,name=vesting_schedules,json=vestingSchedules,proto3" json:"vesting_schedules"`
+	// pending_rates_updates contains the rates increases waiting for the end of the notice period.
+	PendingRatesUpdates []PendingRatesUpdate `protobuf:"bytes,15,rep,name=pending_rates_updates,json=pendingRatesUpdates,proto3" json:"pending_rates_updates"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -107,6 +127,76 @@ func (m *GenesisState) GetPendingTokenUpgrades() []PendingTokenUpgrade {
 	return nil
 }
 
+func (m *GenesisState) GetScheduledUnfreezes() []ScheduledUnfreeze {
+	if m != nil {
+		return m.ScheduledUnfreezes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRateExemptions() []RateExemption {
+	if m != nil {
+		return m.RateExemptions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMintAllowanceUsages() []MintAllowanceUsage {
+	if m != nil {
+		return m.MintAllowanceUsages
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDistributions() []Distribution {
+	if m != nil {
+		return m.Distributions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDistributionHolders() []DistributionHolder {
+	if m != nil {
+		return m.DistributionHolders
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTransferLimits() []TransferLimit {
+	if m != nil {
+		return m.TransferLimits
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTransferLimitUsages() []TransferLimitUsage {
+	if m != nil {
+		return m.TransferLimitUsages
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBlockedAccounts() []BlockedAccount {
+	if m != nil {
+		return m.BlockedAccounts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetVestingSchedules() []VestingSchedule {
+	if m != nil {
+		return m.VestingSchedules
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingRatesUpdates() []PendingRatesUpdate {
+	if m != nil {
+		return m.PendingRatesUpdates
+	}
+	return nil
+}
+
 // Balance defines an account address and balance pair used module genesis genesis state.
 type Balance struct {
 	// address is the address of the balance holder.
@@ -162,6 +252,112 @@ func (m *Balance) GetCoins() github_com_cosmos_cosmos_sdk_types.Coins {
 	return nil
 }
 
+// RateExemption defines the account exempted from the burn rate and send commission rate of the denom.
+type RateExemption struct {
+	Denom   string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Account string `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+}
+
+func (m *RateExemption) Reset()         { *m = RateExemption{} }
+func (m *RateExemption) String() string { return proto.CompactTextString(m) }
+func (*RateExemption) ProtoMessage()    {}
+func (*RateExemption) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d281657d6c91cb92, []int{2}
+}
+func (m *RateExemption) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RateExemption) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RateExemption.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RateExemption) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateExemption.Merge(m, src)
+}
+func (m *RateExemption) XXX_Size() int {
+	return m.Size()
+}
+func (m *RateExemption) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateExemption.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RateExemption proto.InternalMessageInfo
+
+func (m *RateExemption) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *RateExemption) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+// BlockedAccount defines the account blocked from sending and receiving the denom.
+type BlockedAccount struct {
+	Denom   string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Account string `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+}
+
+func (m *BlockedAccount) Reset()         { *m = BlockedAccount{} }
+func (m *BlockedAccount) String() string { return proto.CompactTextString(m) }
+func (*BlockedAccount) ProtoMessage()    {}
+func (*BlockedAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d281657d6c91cb92, []int{3}
+}
+func (m *BlockedAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockedAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockedAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlockedAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockedAccount.Merge(m, src)
+}
+func (m *BlockedAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockedAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockedAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockedAccount proto.InternalMessageInfo
+
+func (m *BlockedAccount) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *BlockedAccount) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
 // PendingTokenUpgrade stores the version of pending token upgrade.
 type PendingTokenUpgrade struct {
 	Denom   string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
@@ -172,7 +368,7 @@ func (m *PendingTokenUpgrade) Reset()         { *m = PendingTokenUpgrade{} }
 func (m *PendingTokenUpgrade) String() string { return proto.CompactTextString(m) }
 func (*PendingTokenUpgrade) ProtoMessage()    {}
 func (*PendingTokenUpgrade) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d281657d6c91cb92, []int{2}
+	return fileDescriptor_d281657d6c91cb92, []int{4}
 }
 func (m *PendingTokenUpgrade) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -218,43 +414,64 @@ func (m *PendingTokenUpgrade) GetVersion() uint32 {
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "coreum.asset.ft.v1.GenesisState")
 	proto.RegisterType((*Balance)(nil), "coreum.asset.ft.v1.Balance")
+	proto.RegisterType((*RateExemption)(nil), "coreum.asset.ft.v1.RateExemption")
+	proto.RegisterType((*BlockedAccount)(nil), "coreum.asset.ft.v1.BlockedAccount")
 	proto.RegisterType((*PendingTokenUpgrade)(nil), "coreum.asset.ft.v1.PendingTokenUpgrade")
 }
 
 func init() { proto.RegisterFile("coreum/asset/ft/v1/genesis.proto", fileDescriptor_d281657d6c91cb92) }
 
 var fileDescriptor_d281657d6c91cb92 = []byte{
-	// 465 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0x4d, 0x6f, 0xd3, 0x30,
-	0x18, 0x6e, 0xb6, 0xb5, 0x13, 0x1e, 0x1f, 0x92, 0x57, 0xa1, 0x50, 0xa4, 0xb4, 0xea, 0x85, 0x5e,
-	0xb0, 0xe9, 0x26, 0x01, 0xe7, 0x4e, 0x80, 0xc4, 0x69, 0x2a, 0xe3, 0xc2, 0xa5, 0x72, 0x92, 0xb7,
-	0x99, 0xb5, 0xc5, 0x8e, 0xf2, 0xba, 0xe1, 0xe3, 0x07, 0x20, 0x8e, 0xfc, 0x0e, 0x7e, 0xc9, 0x8e,
-	0x3b, 0x72, 0x02, 0xd4, 0xfe, 0x11, 0x14, 0xdb, 0xa5, 0x95, 0x9a, 0x03, 0xa7, 0xc4, 0x79, 0x9f,
-	0xaf, 0xbc, 0x7e, 0xc8, 0x20, 0xd1, 0x25, 0x2c, 0x72, 0x2e, 0x10, 0xc1, 0xf0, 0xb9, 0xe1, 0xd5,
-	0x98, 0x67, 0xa0, 0x00, 0x25, 0xb2, 0xa2, 0xd4, 0x46, 0x53, 0xea, 0x10, 0xcc, 0x22, 0xd8, 0xdc,
-	0xb0, 0x6a, 0xdc, 0xeb, 0x66, 0x3a, 0xd3, 0x76, 0xcc, 0xeb, 0x37, 0x87, 0xec, 0x45, 0x89, 0xc6,
-	0x5c, 0x23, 0x8f, 0x05, 0x02, 0xaf, 0xc6, 0x31, 0x18, 0x31, 0xe6, 0x89, 0x96, 0x6a, 0x33, 0xdf,
-	0xf1, 0x32, 0xfa, 0x0a, 0xd6, 0xf3, 0x7e, 0xc3, 0xbc, 0x10, 0xa5, 0xc8, 0x7d, 0x94, 0xe1, 0xb7,
-	0x7d, 0x72, 0xf7, 0x8d, 0x0b, 0xf7, 0xce, 0x08, 0x03, 0xf4, 0x25, 0xe9, 0x38, 0x40, 0x18, 0x0c,
-	0x82, 0xd1, 0xd1, 0x49, 0x8f, 0xed, 0x86, 0x65, 0xe7, 0x16, 0x31, 0x39, 0xb8, 0xf9, 0xd5, 0x6f,
-	0x4d, 0x3d, 0x9e, 0xbe, 0x20, 0x1d, 0x6b, 0x8d, 0xe1, 0xde, 0x60, 0x7f, 0x74, 0x74, 0xf2, 0xa8,
-	0x89, 0x79, 0x51, 0x23, 0xd6, 0x44, 0x07, 0xa7, 0x6f, 0xc9, 0x83, 0x79, 0xa9, 0xbf, 0x80, 0x9a,
-	0xc5, 0xe2, 0x5a, 0xa8, 0x04, 0x30, 0xdc, 0xb7, 0x0a, 0x8f, 0x9b, 0x14, 0x26, 0x0e, 0xe3, 0x35,
-	0xee, 0x3b, 0xa6, 0xff, 0x88, 0xf4, 0x82, 0x74, 0x3f, 0x5e, 0x4a, 0x03, 0xd7, 0x12, 0x0d, 0xa4,
-	0x1b, 0xc1, 0x83, 0xff, 0x15, 0x3c, 0xde, 0xa2, 0xff, 0x53, 0x4d, 0xc8, 0xc3, 0x02, 0x54, 0x2a,
-	0x55, 0x36, 0xb3, 0x99, 0x67, 0x8b, 0x22, 0x2b, 0x45, 0x0a, 0x18, 0xb6, 0xad, 0xee, 0x93, 0xc6,
-	0x25, 0x39, 0x86, 0xfd, 0xe3, 0xf7, 0x0e, 0xef, 0x3d, 0xba, 0xc5, 0xee, 0x08, 0x87, 0x5f, 0x03,
-	0x72, 0xe8, 0x1d, 0x69, 0x48, 0x0e, 0x45, 0x9a, 0x96, 0x80, 0xee, 0x1a, 0xee, 0x4c, 0xd7, 0x47,
-	0x2a, 0x48, 0xbb, 0xbe, 0xff, 0xed, 0x25, 0xd7, 0x0d, 0x61, 0x75, 0x43, 0x98, 0x6f, 0x08, 0x3b,
-	0xd3, 0x52, 0x4d, 0x9e, 0xd5, 0x5e, 0x3f, 0x7e, 0xf7, 0x47, 0x99, 0x34, 0x97, 0x8b, 0x98, 0x25,
-	0x3a, 0xe7, 0xbe, 0x4e, 0xee, 0xf1, 0x14, 0xd3, 0x2b, 0x6e, 0x3e, 0x17, 0x80, 0x96, 0x80, 0x53,
-	0xa7, 0x3c, 0x7c, 0x45, 0x8e, 0x1b, 0xb2, 0xd3, 0x2e, 0x69, 0xa7, 0xa0, 0x74, 0xee, 0x13, 0xb9,
-	0x43, 0x9d, 0xb4, 0x82, 0x12, 0xa5, 0x56, 0xe1, 0xde, 0x20, 0x18, 0xdd, 0x9b, 0xae, 0x8f, 0x93,
-	0xf3, 0x9b, 0x65, 0x14, 0xdc, 0x2e, 0xa3, 0xe0, 0xcf, 0x32, 0x0a, 0xbe, 0xaf, 0xa2, 0xd6, 0xed,
-	0x2a, 0x6a, 0xfd, 0x5c, 0x45, 0xad, 0x0f, 0xcf, 0xb7, 0x12, 0x9d, 0xd9, 0xc5, 0xbd, 0xd6, 0x0b,
-	0x95, 0x0a, 0x23, 0xb5, 0xe2, 0xbe, 0xb1, 0xd5, 0x29, 0xff, 0xb4, 0xa9, 0xad, 0x4d, 0x19, 0x77,
-	0x6c, 0x67, 0x4f, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0x88, 0x4d, 0x5c, 0x7f, 0x62, 0x03, 0x00,
-	0x00,
+	// 781 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x95, 0xdf, 0x6e, 0xe3, 0x44,
+	0x14, 0xc6, 0x93, 0xdd, 0x6d, 0xca, 0x4e, 0x37, 0xcd, 0x32, 0x0d, 0xc8, 0x14, 0x29, 0x0d, 0x41,
+	0x40, 0x6f, 0xb0, 0xc9, 0xae, 0x04, 0xdc, 0xc1, 0x66, 0x59, 0x40, 0xa8, 0x48, 0x55, 0xda, 0xf4,
+	0x02, 0x21, 0x99, 0xb1, 0x7d, 0x92, 0x58, 0xb5, 0x67, 0x2c, 0x9f, 0x71, 0x5a, 0xfa, 0x00, 0x5c,
+	0x71, 0xc1, 0x73, 0xf0, 0x24, 0xbd, 0xec, 0x25, 0x57, 0x80, 0xda, 0x17, 0x41, 0x9e, 0x3f, 0xd4,
+	0x26, 0x8e, 0xc4, 0x5e, 0x25, 0x33, 0xe7, 0x3b, 0xbf, 0x39, 0xfe, 0x3c, 0xe7, 0x98, 0x0c, 0x43,
+	0x91, 0x43, 0x91, 0x7a, 0x0c, 0x11, 0xa4, 0x37, 0x97, 0xde, 0x6a, 0xec, 0x2d, 0x80, 0x03, 0xc6,
+	0xe8, 0x66, 0xb9, 0x90, 0x82, 0x52, 0xad, 0x70, 0x95, 0xc2, 0x9d, 0x4b, 0x77, 0x35, 0xde, 0xef,
+	0x2f, 0xc4, 0x42, 0xa8, 0xb0, 0x57, 0xfe, 0xd3, 0xca, 0xfd, 0x41, 0x28, 0x30, 0x15, 0xe8, 0x05,
+	0x0c, 0xc1, 0x5b, 0x8d, 0x03, 0x90, 0x6c, 0xec, 0x85, 0x22, 0xe6, 0xf7, 0xf1, 0xb5, 0xb3, 0xa4,
+	0x38, 0x07, 0x1b, 0x3f, 0x68, 0x88, 0x67, 0x2c, 0x67, 0xa9, 0x29, 0x65, 0xf4, 0x2b, 0x21, 0x4f,
+	0xbe, 0xd1, 0xc5, 0x9d, 0x48, 0x26, 0x81, 0x7e, 0x4e, 0x3a, 0x5a, 0xe0, 0xb4, 0x87, 0xed, 0xc3,
+	0x9d, 0x67, 0xfb, 0xee, 0x7a, 0xb1, 0xee, 0xb1, 0x52, 0x4c, 0x1e, 0x5d, 0xff, 0x79, 0xd0, 0x9a,
+	0x1a, 0x3d, 0xfd, 0x8c, 0x74, 0xd4, 0xd1, 0xe8, 0x3c, 0x18, 0x3e, 0x3c, 0xdc, 0x79, 0xf6, 0x4e,
+	0x53, 0xe6, 0x69, 0xa9, 0xb0, 0x89, 0x5a, 0x4e, 0xbf, 0x23, 0xbd, 0x79, 0x2e, 0xae, 0x80, 0xfb,
+	0x01, 0x4b, 0x18, 0x0f, 0x01, 0x9d, 0x87, 0x8a, 0xf0, 0x6e, 0x13, 0x61, 0xa2, 0x35, 0x86, 0xb1,
+	0xab, 0x33, 0xcd, 0x26, 0xd2, 0x53, 0xd2, 0xbf, 0x58, 0xc6, 0x12, 0x92, 0x18, 0x25, 0x44, 0xf7,
+	0xc0, 0x47, 0xff, 0x17, 0xb8, 0x57, 0x49, 0xff, 0x97, 0x1a, 0x92, 0xb7, 0x33, 0xe0, 0x51, 0xcc,
+	0x17, 0xbe, 0xaa, 0xd9, 0x2f, 0xb2, 0x45, 0xce, 0x22, 0x40, 0x67, 0x4b, 0x71, 0x3f, 0x6a, 0x34,
+	0x49, 0x67, 0xa8, 0x27, 0x9e, 0x69, 0xbd, 0x39, 0xa3, 0x9f, 0xad, 0x87, 0x90, 0xfe, 0x48, 0xf6,
+	0x30, 0x5c, 0x42, 0x54, 0x24, 0x10, 0xf9, 0x05, 0x9f, 0xe7, 0x00, 0x57, 0x80, 0x4e, 0x47, 0x9d,
+	0xf0, 0x41, 0xd3, 0x09, 0x27, 0x56, 0x3e, 0x33, 0x6a, 0xc3, 0xa7, 0xf8, 0xdf, 0x00, 0xd2, 0x63,
+	0xd2, 0xcb, 0x99, 0x04, 0x1f, 0x2e, 0x21, 0xcd, 0x64, 0x2c, 0x38, 0x3a, 0xdb, 0x8a, 0xfc, 0x5e,
+	0x13, 0x79, 0xca, 0x24, 0xbc, 0xb2, 0x4a, 0x6b, 0x75, 0x5e, 0xdd, 0x44, 0xfa, 0x13, 0x79, 0x2b,
+	0x8d, 0xb9, 0xf4, 0x59, 0x92, 0x88, 0x8b, 0xd2, 0x27, 0xbf, 0x40, 0xb6, 0x00, 0x74, 0xde, 0x50,
+	0xdc, 0x0f, 0x9b, 0xb8, 0xdf, 0xc7, 0x5c, 0xbe, 0xb0, 0xfa, 0x59, 0x29, 0xb7, 0xb6, 0xa7, 0x6b,
+	0x11, 0xa4, 0x47, 0xa4, 0x1b, 0xc5, 0x28, 0xf3, 0x38, 0x28, 0x74, 0xc5, 0x8f, 0x15, 0x79, 0xd8,
+	0x44, 0xfe, 0xaa, 0x22, 0x34, 0xcc, 0x7a, 0x32, 0xf5, 0x49, 0xbf, 0xba, 0xe1, 0x2f, 0x45, 0x12,
+	0x41, 0x8e, 0x0e, 0xd9, 0x5c, 0x6e, 0x15, 0xfa, 0xad, 0x92, 0xdb, 0x72, 0xa3, 0xb5, 0x88, 0xb2,
+	0x58, 0xe6, 0x8c, 0xe3, 0x1c, 0x72, 0x3f, 0x89, 0xd3, 0x58, 0xa2, 0xb3, 0xb3, 0xd9, 0xe2, 0x53,
+	0x23, 0x3d, 0x2a, 0x95, 0xd6, 0x62, 0x59, 0xdd, 0x54, 0x16, 0xd7, 0x89, 0xd6, 0xe2, 0x27, 0x9b,
+	0x6b, 0xae, 0x71, 0x6b, 0x16, 0xcb, 0xb5, 0x08, 0xd2, 0x13, 0xf2, 0x34, 0x48, 0x44, 0x78, 0x0e,
+	0x91, 0xcf, 0xc2, 0x50, 0x14, 0x5c, 0xa2, 0xd3, 0x55, 0xf0, 0x51, 0x63, 0xaf, 0x68, 0xed, 0x0b,
+	0x2d, 0x35, 0xe0, 0x5e, 0x50, 0xdb, 0x45, 0x7a, 0x46, 0xde, 0x5c, 0x01, 0xca, 0xb2, 0x5d, 0xec,
+	0x4d, 0x44, 0x67, 0x57, 0x51, 0xdf, 0x6f, 0xa2, 0x9e, 0x69, 0xb1, 0xbd, 0xce, 0x06, 0xfb, 0x74,
+	0x55, 0xdf, 0x56, 0x76, 0xd8, 0x36, 0x2c, 0xef, 0x22, 0xfa, 0x45, 0x16, 0x95, 0xbf, 0x4e, 0x6f,
+	0xb3, 0x1d, 0xa6, 0x0b, 0xcb, 0x0b, 0x8d, 0x33, 0x25, 0xb7, 0x76, 0x64, 0x6b, 0x11, 0x1c, 0xfd,
+	0xd2, 0x26, 0xdb, 0xa6, 0xeb, 0xa9, 0x43, 0xb6, 0x59, 0x14, 0xe5, 0x80, 0x7a, 0x14, 0x3e, 0x9e,
+	0xda, 0x25, 0x65, 0x64, 0xab, 0x9c, 0xc1, 0xd5, 0x41, 0x57, 0x4e, 0x69, 0xb7, 0x9c, 0xd2, 0xae,
+	0x99, 0xd2, 0xee, 0x4b, 0x11, 0xf3, 0xc9, 0x27, 0xe5, 0x51, 0xbf, 0xff, 0x75, 0x70, 0xb8, 0x88,
+	0xe5, 0xb2, 0x08, 0xdc, 0x50, 0xa4, 0x9e, 0x19, 0xe9, 0xfa, 0xe7, 0x63, 0x8c, 0xce, 0x3d, 0xf9,
+	0x73, 0x06, 0xa8, 0x12, 0x70, 0xaa, 0xc9, 0xa3, 0x2f, 0x48, 0xb7, 0xd6, 0x83, 0xb4, 0x4f, 0xb6,
+	0x22, 0xe0, 0x22, 0x35, 0xb5, 0xe8, 0x85, 0xaa, 0x51, 0xbb, 0xee, 0x3c, 0x30, 0x35, 0xea, 0xe5,
+	0xe8, 0x4b, 0xb2, 0x5b, 0x7f, 0x59, 0xaf, 0x4d, 0x78, 0x45, 0xf6, 0x1a, 0x46, 0xd8, 0x66, 0xcc,
+	0x0a, 0x72, 0x8c, 0x05, 0x57, 0x98, 0xee, 0xd4, 0x2e, 0x27, 0xc7, 0xd7, 0xb7, 0x83, 0xf6, 0xcd,
+	0xed, 0xa0, 0xfd, 0xf7, 0xed, 0xa0, 0xfd, 0xdb, 0xdd, 0xa0, 0x75, 0x73, 0x37, 0x68, 0xfd, 0x71,
+	0x37, 0x68, 0xfd, 0xf0, 0x69, 0xc5, 0x94, 0x97, 0xea, 0xcd, 0x7d, 0x2d, 0x0a, 0x1e, 0xb1, 0xf2,
+	0x79, 0x3d, 0xf3, 0xe1, 0x5a, 0x3d, 0xf7, 0x2e, 0xef, 0xbf, 0x5e, 0xca, 0xa8, 0xa0, 0xa3, 0x3e,
+	0x5d, 0xcf, 0xff, 0x19, 0x00, 0x01, 0x37, 0xbb, 0xa4, 0x69, 0x07, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -277,6 +494,146 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PendingRatesUpdates) > 0 {
+		for iNdEx := len(m.PendingRatesUpdates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingRatesUpdates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.VestingSchedules) > 0 {
+		for iNdEx := len(m.VestingSchedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VestingSchedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.BlockedAccounts) > 0 {
+		for iNdEx := len(m.BlockedAccounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BlockedAccounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.TransferLimitUsages) > 0 {
+		for iNdEx := len(m.TransferLimitUsages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TransferLimitUsages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.TransferLimits) > 0 {
+		for iNdEx := len(m.TransferLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TransferLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.DistributionHolders) > 0 {
+		for iNdEx := len(m.DistributionHolders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DistributionHolders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.Distributions) > 0 {
+		for iNdEx := len(m.Distributions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Distributions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.MintAllowanceUsages) > 0 {
+		for iNdEx := len(m.MintAllowanceUsages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MintAllowanceUsages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.RateExemptions) > 0 {
+		for iNdEx := len(m.RateExemptions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RateExemptions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.ScheduledUnfreezes) > 0 {
+		for iNdEx := len(m.ScheduledUnfreezes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScheduledUnfreezes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.PendingTokenUpgrades) > 0 {
 		for iNdEx := len(m.PendingTokenUpgrades) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -390,7 +747,7 @@ func (m *Balance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *PendingTokenUpgrade) Marshal() (dAtA []byte, err error) {
+func (m *RateExemption) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -400,20 +757,22 @@ func (m *PendingTokenUpgrade) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PendingTokenUpgrade) MarshalTo(dAtA []byte) (int, error) {
+func (m *RateExemption) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PendingTokenUpgrade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RateExemption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Version != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.Version))
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Account)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
 	if len(m.Denom) > 0 {
 		i -= len(m.Denom)
@@ -425,29 +784,101 @@ func (m *PendingTokenUpgrade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *BlockedAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *BlockedAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockedAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	if len(m.Tokens) > 0 {
-		for _, e := range m.Tokens {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingTokenUpgrade) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingTokenUpgrade) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingTokenUpgrade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Version != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Tokens) > 0 {
+		for _, e := range m.Tokens {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
 	if len(m.FrozenBalances) > 0 {
@@ -468,6 +899,66 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.ScheduledUnfreezes) > 0 {
+		for _, e := range m.ScheduledUnfreezes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RateExemptions) > 0 {
+		for _, e := range m.RateExemptions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MintAllowanceUsages) > 0 {
+		for _, e := range m.MintAllowanceUsages {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Distributions) > 0 {
+		for _, e := range m.Distributions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DistributionHolders) > 0 {
+		for _, e := range m.DistributionHolders {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TransferLimits) > 0 {
+		for _, e := range m.TransferLimits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TransferLimitUsages) > 0 {
+		for _, e := range m.TransferLimitUsages {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BlockedAccounts) > 0 {
+		for _, e := range m.BlockedAccounts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.VestingSchedules) > 0 {
+		for _, e := range m.VestingSchedules {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingRatesUpdates) > 0 {
+		for _, e := range m.PendingRatesUpdates {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -490,6 +981,40 @@ func (m *Balance) Size() (n int) {
 	return n
 }
 
+func (m *RateExemption) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *BlockedAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
 func (m *PendingTokenUpgrade) Size() (n int) {
 	if m == nil {
 		return 0
@@ -710,61 +1235,79 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledUnfreezes", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Balance) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
+			m.ScheduledUnfreezes = append(m.ScheduledUnfreezes, ScheduledUnfreeze{})
+			if err := m.ScheduledUnfreezes[len(m.ScheduledUnfreezes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateExemptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.RateExemptions = append(m.RateExemptions, RateExemption{})
+			if err := m.RateExemptions[len(m.RateExemptions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Balance: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Balance: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MintAllowanceUsages", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -774,27 +1317,29 @@ func (m *Balance) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.MintAllowanceUsages = append(m.MintAllowanceUsages, MintAllowanceUsage{})
+			if err := m.MintAllowanceUsages[len(m.MintAllowanceUsages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Distributions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -821,11 +1366,559 @@ func (m *Balance) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Coins = append(m.Coins, types.Coin{})
-			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Distributions = append(m.Distributions, Distribution{})
+			if err := m.Distributions[len(m.Distributions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionHolders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DistributionHolders = append(m.DistributionHolders, DistributionHolder{})
+			if err := m.DistributionHolders[len(m.DistributionHolders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransferLimits = append(m.TransferLimits, TransferLimit{})
+			if err := m.TransferLimits[len(m.TransferLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferLimitUsages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransferLimitUsages = append(m.TransferLimitUsages, TransferLimitUsage{})
+			if err := m.TransferLimitUsages[len(m.TransferLimitUsages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockedAccounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockedAccounts = append(m.BlockedAccounts, BlockedAccount{})
+			if err := m.BlockedAccounts[len(m.BlockedAccounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingSchedules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VestingSchedules = append(m.VestingSchedules, VestingSchedule{})
+			if err := m.VestingSchedules[len(m.VestingSchedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingRatesUpdates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingRatesUpdates = append(m.PendingRatesUpdates, PendingRatesUpdate{})
+			if err := m.PendingRatesUpdates[len(m.PendingRatesUpdates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Balance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Balance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Balance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RateExemption) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateExemption: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateExemption: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockedAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockedAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockedAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -2,6 +2,7 @@ package types
 
 import (
 	"strings"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
@@ -41,6 +42,32 @@ var (
 	TokenUpgradeStatusesKeyPrefix = []byte{0x07}
 	// ParamsKey defines the key to store parameters of the module, set via governance.
 	ParamsKey = []byte{0x08}
+	// ScheduledUnfreezeKeyPrefix defines the key prefix to track the frozen amounts unfrozen automatically.
+	ScheduledUnfreezeKeyPrefix = []byte{0x09}
+	// AdminTokensKeyPrefix defines the key prefix to index the fungible tokens by admin.
+	AdminTokensKeyPrefix = []byte{0x0a}
+	// RateExemptionsKeyPrefix defines the key prefix to track the accounts exempted from the rates.
+	RateExemptionsKeyPrefix = []byte{0x0b}
+	// MintAllowanceUsageKeyPrefix defines the key prefix to track the amounts minted within the mint allowance periods.
+	MintAllowanceUsageKeyPrefix = []byte{0x0c}
+	// DistributionKeyPrefix defines the key prefix for the distributions in progress.
+	DistributionKeyPrefix = []byte{0x0d}
+	// DistributionHolderKeyPrefix defines the key prefix to track the balances of the holders of the distributions.
+	DistributionHolderKeyPrefix = []byte{0x0e}
+	// TransferLimitKeyPrefix defines the key prefix for the transfer limits.
+	TransferLimitKeyPrefix = []byte{0x0f}
+	// TransferLimitUsageKeyPrefix defines the key prefix to track the amounts sent within the transfer limit windows.
+	TransferLimitUsageKeyPrefix = []byte{0x10}
+	// BlockedAccountsKeyPrefix defines the key prefix to track the accounts blocked from sending and receiving tokens.
+	BlockedAccountsKeyPrefix = []byte{0x11}
+	// VestingScheduleKeyPrefix defines the key prefix for the vesting schedules.
+	VestingScheduleKeyPrefix = []byte{0x12}
+	// PendingRatesUpdateKeyPrefix defines the key prefix for the rates increases waiting for the end of the notice period.
+	PendingRatesUpdateKeyPrefix = []byte{0x13}
+	// DEXLockedBalancesKeyPrefix defines the key prefix to track the balances locked by the dex orders.
+	DEXLockedBalancesKeyPrefix = []byte{0x14}
+	// NextDistributionKey defines the key to store the denom of the distribution processed first in the next block.
+	NextDistributionKey = []byte{0x15}
 )
 
 // StoreTrue keeps a value used by stores to indicate that key is present.
@@ -61,6 +88,16 @@ func CreateIssuerTokensPrefix(issuer sdk.AccAddress) []byte {
 	return store.JoinKeys(TokenKeyPrefix, address.MustLengthPrefix(issuer))
 }
 
+// CreateAdminTokensPrefix creates the key prefix for the fungible tokens administered by account.
+func CreateAdminTokensPrefix(admin sdk.AccAddress) []byte {
+	return store.JoinKeys(AdminTokensKeyPrefix, address.MustLengthPrefix(admin))
+}
+
+// CreateAdminTokenKey creates the key indexing the fungible token by admin.
+func CreateAdminTokenKey(admin sdk.AccAddress, denom string) []byte {
+	return store.JoinKeys(CreateAdminTokensPrefix(admin), []byte(denom))
+}
+
 // CreateSymbolKey creates the key for a ft symbol.
 func CreateSymbolKey(addr []byte, symbol string) []byte {
 	return store.JoinKeys(store.JoinKeys(SymbolKeyPrefix, addr), []byte(symbol))
@@ -71,6 +108,11 @@ func CreateFrozenBalancesKey(addr []byte) []byte {
 	return store.JoinKeys(FrozenBalancesKeyPrefix, address.MustLengthPrefix(addr))
 }
 
+// CreateDEXLockedBalancesKey creates the key for an account's balances locked by the dex orders.
+func CreateDEXLockedBalancesKey(addr []byte) []byte {
+	return store.JoinKeys(DEXLockedBalancesKeyPrefix, address.MustLengthPrefix(addr))
+}
+
 // CreateGlobalFreezeKey creates the key for fungible token global freeze key.
 func CreateGlobalFreezeKey(denom string) []byte {
 	return store.JoinKeys(GlobalFreezeKeyPrefix, []byte(denom))
@@ -86,6 +128,99 @@ func CreateTokenUpgradeStatusesKey(denom string) []byte {
 	return store.JoinKeys(TokenUpgradeStatusesKeyPrefix, []byte(denom))
 }
 
+// CreateScheduledUnfreezesPrefix creates the key prefix for the scheduled unfreezes of an account and denom.
+func CreateScheduledUnfreezesPrefix(addr []byte, denom string) []byte {
+	return store.JoinKeys(ScheduledUnfreezeKeyPrefix, address.MustLengthPrefix(addr), address.MustLengthPrefix([]byte(denom)))
+}
+
+// CreateScheduledUnfreezeKey creates the key for the scheduled unfreeze of an account and denom.
+func CreateScheduledUnfreezeKey(addr []byte, denom string, unfreezeTime time.Time) []byte {
+	return store.JoinKeys(CreateScheduledUnfreezesPrefix(addr, denom), sdk.Uint64ToBigEndian(uint64(unfreezeTime.Unix())))
+}
+
+// CreateRateExemptionsPrefix creates the key prefix for the accounts exempted from the rates of the denom.
+func CreateRateExemptionsPrefix(denom string) []byte {
+	return store.JoinKeys(RateExemptionsKeyPrefix, address.MustLengthPrefix([]byte(denom)))
+}
+
+// CreateRateExemptionKey creates the key for the account exempted from the rates of the denom.
+func CreateRateExemptionKey(denom string, addr sdk.AccAddress) []byte {
+	return store.JoinKeys(CreateRateExemptionsPrefix(denom), addr)
+}
+
+// CreateMintAllowanceUsageKey creates the key for the mint allowance usage of the denom.
+func CreateMintAllowanceUsageKey(denom string) []byte {
+	return store.JoinKeys(MintAllowanceUsageKeyPrefix, []byte(denom))
+}
+
+// CreateDistributionKey creates the key for the distribution of the denom.
+func CreateDistributionKey(denom string) []byte {
+	return store.JoinKeys(DistributionKeyPrefix, []byte(denom))
+}
+
+// CreateDistributionHoldersPrefix creates the key prefix for the holders of the distribution of the denom.
+func CreateDistributionHoldersPrefix(denom string) []byte {
+	return store.JoinKeys(DistributionHolderKeyPrefix, address.MustLengthPrefix([]byte(denom)))
+}
+
+// CreateDistributionHolderKey creates the key for the holder of the distribution of the denom.
+func CreateDistributionHolderKey(denom string, addr sdk.AccAddress) []byte {
+	return store.JoinKeys(CreateDistributionHoldersPrefix(denom), addr)
+}
+
+// CreateTransferLimitsPrefix creates the key prefix for the transfer limits of the denom.
+func CreateTransferLimitsPrefix(denom string) []byte {
+	return store.JoinKeys(TransferLimitKeyPrefix, address.MustLengthPrefix([]byte(denom)))
+}
+
+// CreateTransferLimitKey creates the key for the transfer limit of the account and denom. The key of the default
+// limit is created if the account is empty.
+func CreateTransferLimitKey(denom string, addr sdk.AccAddress) []byte {
+	return store.JoinKeys(CreateTransferLimitsPrefix(denom), addr)
+}
+
+// CreateTransferLimitUsagesPrefix creates the key prefix for the amounts sent by the account within the transfer
+// limit windows.
+func CreateTransferLimitUsagesPrefix(denom string, addr sdk.AccAddress) []byte {
+	return store.JoinKeys(
+		TransferLimitUsageKeyPrefix, address.MustLengthPrefix([]byte(denom)), address.MustLengthPrefix(addr),
+	)
+}
+
+// CreateTransferLimitUsageKey creates the key for the amount sent by the account within the transfer limit window.
+func CreateTransferLimitUsageKey(denom string, addr sdk.AccAddress, windowStart time.Time) []byte {
+	return store.JoinKeys(
+		CreateTransferLimitUsagesPrefix(denom, addr), sdk.Uint64ToBigEndian(uint64(windowStart.UnixNano())),
+	)
+}
+
+// CreateBlockedAccountsPrefix creates the key prefix for the accounts blocked from sending and receiving the denom.
+func CreateBlockedAccountsPrefix(denom string) []byte {
+	return store.JoinKeys(BlockedAccountsKeyPrefix, address.MustLengthPrefix([]byte(denom)))
+}
+
+// CreateBlockedAccountKey creates the key for the account blocked from sending and receiving the denom.
+func CreateBlockedAccountKey(denom string, addr sdk.AccAddress) []byte {
+	return store.JoinKeys(CreateBlockedAccountsPrefix(denom), addr)
+}
+
+// CreateVestingSchedulesPrefix creates the key prefix for the vesting schedules of the account and denom.
+func CreateVestingSchedulesPrefix(denom string, addr sdk.AccAddress) []byte {
+	return store.JoinKeys(
+		VestingScheduleKeyPrefix, address.MustLengthPrefix([]byte(denom)), address.MustLengthPrefix(addr),
+	)
+}
+
+// CreateVestingScheduleKey creates the key for the vesting schedule of the account and denom.
+func CreateVestingScheduleKey(denom string, addr sdk.AccAddress, index uint64) []byte {
+	return store.JoinKeys(CreateVestingSchedulesPrefix(denom, addr), sdk.Uint64ToBigEndian(index))
+}
+
+// CreatePendingRatesUpdateKey creates the key for the pending rates update of the denom.
+func CreatePendingRatesUpdateKey(denom string) []byte {
+	return store.JoinKeys(PendingRatesUpdateKeyPrefix, []byte(denom))
+}
+
 // AddressFromBalancesStore returns an account address from a balances prefix
 // store. The key must not contain the prefix BalancesPrefix as the prefix store
 // iterator discards the actual prefix.
@@ -102,3 +237,30 @@ func AddressFromBalancesStore(key []byte) (sdk.AccAddress, error) {
 	}
 	return key[1 : bound+1], nil
 }
+
+// DenomAndAddressFromRateExemptionKey returns the denom and account address from a rate exemption key.
+// The key must not contain the prefix RateExemptionsKeyPrefix as the prefix store iterator discards the actual prefix.
+//
+// If invalid key is passed, DenomAndAddressFromRateExemptionKey returns ErrInvalidKey.
+func DenomAndAddressFromRateExemptionKey(key []byte) (string, sdk.AccAddress, error) {
+	return denomAndAddressFromKey(key)
+}
+
+// DenomAndAddressFromBlockedAccountKey returns the denom and account address from a blocked account key.
+// The key must not contain the prefix BlockedAccountsKeyPrefix as the prefix store iterator discards the actual prefix.
+//
+// If invalid key is passed, DenomAndAddressFromBlockedAccountKey returns ErrInvalidKey.
+func DenomAndAddressFromBlockedAccountKey(key []byte) (string, sdk.AccAddress, error) {
+	return denomAndAddressFromKey(key)
+}
+
+func denomAndAddressFromKey(key []byte) (string, sdk.AccAddress, error) {
+	if len(key) == 0 {
+		return "", nil, ErrInvalidKey
+	}
+	denomLen := int(key[0])
+	if len(key)-1 <= denomLen {
+		return "", nil, ErrInvalidKey
+	}
+	return string(key[1 : denomLen+1]), key[denomLen+1:], nil
+}
@@ -14,16 +14,33 @@ import (
 
 // Type of messages for amino.
 const (
-	TypeMsgIssue               = "issue"
-	TypeMsgMint                = "mint"
-	TypeMsgBurn                = "burn"
-	TypeMsgFreeze              = "freeze"
-	TypeMsgUnfreeze            = "unfreeze"
-	TypeMsgGloballyFreeze      = "globally-freeze"
-	TypeMsgGloballyUnfreeze    = "globally-unfreeze"
-	TypeMsgSetWhitelistedLimit = "set-whitelisted-limit"
-	TypeMsgUpgradeTokenV1      = "upgrade-token-v1"
-	TypeMsgUpdateParams        = "update-params"
+	TypeMsgIssue                    = "issue"
+	TypeMsgMint                     = "mint"
+	TypeMsgMultiMint                = "multi-mint"
+	TypeMsgBurn                     = "burn"
+	TypeMsgFreeze                   = "freeze"
+	TypeMsgMultiFreeze              = "multi-freeze"
+	TypeMsgUnfreeze                 = "unfreeze"
+	TypeMsgGloballyFreeze           = "globally-freeze"
+	TypeMsgGloballyUnfreeze         = "globally-unfreeze"
+	TypeMsgSetWhitelistedLimit      = "set-whitelisted-limit"
+	TypeMsgMultiSetWhitelistedLimit = "multi-set-whitelisted-limit"
+	TypeMsgClawback                 = "clawback"
+	TypeMsgTransferAdmin            = "transfer-admin"
+	TypeMsgClearAdmin               = "clear-admin"
+	TypeMsgUpdateMetadata           = "update-metadata"
+	TypeMsgSetRateExemption         = "set-rate-exemption"
+	TypeMsgRemoveRateExemption      = "remove-rate-exemption"
+	TypeMsgUpdateRates              = "update-rates"
+	TypeMsgSetTransferLimit         = "set-transfer-limit"
+	TypeMsgRemoveTransferLimit      = "remove-transfer-limit"
+	TypeMsgBlockAccounts            = "block-accounts"
+	TypeMsgUnblockAccounts          = "unblock-accounts"
+	TypeMsgCreateVestingSchedule    = "create-vesting-schedule"
+	TypeMsgDistribute               = "distribute"
+	TypeMsgUpgradeTokenV1           = "upgrade-token-v1"
+	TypeMsgUpgradeToken             = "upgrade-token"
+	TypeMsgUpdateParams             = "update-params"
 )
 
 var (
@@ -31,10 +48,14 @@ var (
 	_ legacytx.LegacyMsg = &MsgIssue{}
 	_ sdk.Msg            = &MsgMint{}
 	_ legacytx.LegacyMsg = &MsgMint{}
+	_ sdk.Msg            = &MsgMultiMint{}
+	_ legacytx.LegacyMsg = &MsgMultiMint{}
 	_ sdk.Msg            = &MsgBurn{}
 	_ legacytx.LegacyMsg = &MsgBurn{}
 	_ sdk.Msg            = &MsgFreeze{}
 	_ legacytx.LegacyMsg = &MsgFreeze{}
+	_ sdk.Msg            = &MsgMultiFreeze{}
+	_ legacytx.LegacyMsg = &MsgMultiFreeze{}
 	_ sdk.Msg            = &MsgUnfreeze{}
 	_ legacytx.LegacyMsg = &MsgUnfreeze{}
 	_ sdk.Msg            = &MsgSetFrozen{}
@@ -45,8 +66,38 @@ var (
 	_ legacytx.LegacyMsg = &MsgGloballyUnfreeze{}
 	_ sdk.Msg            = &MsgSetWhitelistedLimit{}
 	_ legacytx.LegacyMsg = &MsgSetWhitelistedLimit{}
+	_ sdk.Msg            = &MsgMultiSetWhitelistedLimit{}
+	_ legacytx.LegacyMsg = &MsgMultiSetWhitelistedLimit{}
+	_ sdk.Msg            = &MsgClawback{}
+	_ legacytx.LegacyMsg = &MsgClawback{}
+	_ sdk.Msg            = &MsgTransferAdmin{}
+	_ legacytx.LegacyMsg = &MsgTransferAdmin{}
+	_ sdk.Msg            = &MsgClearAdmin{}
+	_ legacytx.LegacyMsg = &MsgClearAdmin{}
+	_ sdk.Msg            = &MsgUpdateMetadata{}
+	_ legacytx.LegacyMsg = &MsgUpdateMetadata{}
+	_ sdk.Msg            = &MsgSetRateExemption{}
+	_ legacytx.LegacyMsg = &MsgSetRateExemption{}
+	_ sdk.Msg            = &MsgRemoveRateExemption{}
+	_ legacytx.LegacyMsg = &MsgRemoveRateExemption{}
+	_ sdk.Msg            = &MsgUpdateRates{}
+	_ legacytx.LegacyMsg = &MsgUpdateRates{}
+	_ sdk.Msg            = &MsgSetTransferLimit{}
+	_ legacytx.LegacyMsg = &MsgSetTransferLimit{}
+	_ sdk.Msg            = &MsgRemoveTransferLimit{}
+	_ legacytx.LegacyMsg = &MsgRemoveTransferLimit{}
+	_ sdk.Msg            = &MsgBlockAccounts{}
+	_ legacytx.LegacyMsg = &MsgBlockAccounts{}
+	_ sdk.Msg            = &MsgUnblockAccounts{}
+	_ legacytx.LegacyMsg = &MsgUnblockAccounts{}
+	_ sdk.Msg            = &MsgCreateVestingSchedule{}
+	_ legacytx.LegacyMsg = &MsgCreateVestingSchedule{}
+	_ sdk.Msg            = &MsgDistribute{}
+	_ legacytx.LegacyMsg = &MsgDistribute{}
 	_ sdk.Msg            = &MsgUpgradeTokenV1{}
 	_ legacytx.LegacyMsg = &MsgUpgradeTokenV1{}
+	_ sdk.Msg            = &MsgUpgradeToken{}
+	_ legacytx.LegacyMsg = &MsgUpgradeToken{}
 	_ sdk.Msg            = &MsgUpdateParams{}
 	_ legacytx.LegacyMsg = &MsgUpdateParams{}
 )
@@ -55,21 +106,38 @@ var (
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgIssue{}, fmt.Sprintf("%s/MsgIssue", ModuleName), nil)
 	cdc.RegisterConcrete(&MsgMint{}, fmt.Sprintf("%s/MsgMint", ModuleName), nil)
+	cdc.RegisterConcrete(&MsgMultiMint{}, fmt.Sprintf("%s/MsgMultiMint", ModuleName), nil)
 	cdc.RegisterConcrete(&MsgBurn{}, fmt.Sprintf("%s/MsgBurn", ModuleName), nil)
 	cdc.RegisterConcrete(&MsgFreeze{}, fmt.Sprintf("%s/MsgFreeze", ModuleName), nil)
+	cdc.RegisterConcrete(&MsgMultiFreeze{}, fmt.Sprintf("%s/MsgMultiFreeze", ModuleName), nil)
 	cdc.RegisterConcrete(&MsgUnfreeze{}, fmt.Sprintf("%s/MsgUnfreeze", ModuleName), nil)
 	cdc.RegisterConcrete(&MsgSetFrozen{}, fmt.Sprintf("%s/MsgSetFrozen", ModuleName), nil)
 	cdc.RegisterConcrete(&MsgGloballyFreeze{}, fmt.Sprintf("%s/MsgGloballyFreeze", ModuleName), nil)
 	cdc.RegisterConcrete(&MsgGloballyUnfreeze{}, fmt.Sprintf("%s/MsgGloballyUnfreeze", ModuleName), nil)
 	cdc.RegisterConcrete(&MsgSetWhitelistedLimit{}, fmt.Sprintf("%s/MsgSetWhitelistedLimit", ModuleName), nil)
+	cdc.RegisterConcrete(
+		&MsgMultiSetWhitelistedLimit{}, fmt.Sprintf("%s/MsgMultiSetWhitelistedLimit", ModuleName), nil,
+	)
+	cdc.RegisterConcrete(&MsgClawback{}, fmt.Sprintf("%s/MsgClawback", ModuleName), nil)
+	cdc.RegisterConcrete(&MsgTransferAdmin{}, fmt.Sprintf("%s/MsgTransferAdmin", ModuleName), nil)
+	cdc.RegisterConcrete(&MsgClearAdmin{}, fmt.Sprintf("%s/MsgClearAdmin", ModuleName), nil)
+	cdc.RegisterConcrete(&MsgUpdateMetadata{}, fmt.Sprintf("%s/MsgUpdateMetadata", ModuleName), nil)
+	cdc.RegisterConcrete(&MsgSetRateExemption{}, fmt.Sprintf("%s/MsgSetRateExemption", ModuleName), nil)
+	cdc.RegisterConcrete(&MsgRemoveRateExemption{}, fmt.Sprintf("%s/MsgRemoveRateExemption", ModuleName), nil)
+	cdc.RegisterConcrete(&MsgUpdateRates{}, fmt.Sprintf("%s/MsgUpdateRates", ModuleName), nil)
+	cdc.RegisterConcrete(&MsgSetTransferLimit{}, fmt.Sprintf("%s/MsgSetTransferLimit", ModuleName), nil)
+	cdc.RegisterConcrete(&MsgRemoveTransferLimit{}, fmt.Sprintf("%s/MsgRemoveTransferLimit", ModuleName), nil)
+	cdc.RegisterConcrete(&MsgBlockAccounts{}, fmt.Sprintf("%s/MsgBlockAccounts", ModuleName), nil)
+	cdc.RegisterConcrete(&MsgUnblockAccounts{}, fmt.Sprintf("%s/MsgUnblockAccounts", ModuleName), nil)
+	cdc.RegisterConcrete(&MsgCreateVestingSchedule{}, fmt.Sprintf("%s/MsgCreateVestingSchedule", ModuleName), nil)
+	cdc.RegisterConcrete(&MsgDistribute{}, fmt.Sprintf("%s/MsgDistribute", ModuleName), nil)
 	cdc.RegisterConcrete(&MsgUpgradeTokenV1{}, fmt.Sprintf("%s/MsgUpgradeTokenV1", ModuleName), nil)
+	cdc.RegisterConcrete(&MsgUpgradeToken{}, fmt.Sprintf("%s/MsgUpgradeToken", ModuleName), nil)
 	cdc.RegisterConcrete(&MsgUpdateParams{}, fmt.Sprintf("%s/MsgUpdateParams", ModuleName), nil)
 }
 
 // ValidateBasic validates the message.
 func (m MsgIssue) ValidateBasic() error {
-	const maxDescriptionLength = 200
-
 	if _, err := sdk.AccAddressFromBech32(m.Issuer); err != nil {
 		return sdkerrors.Wrapf(cosmoserrors.ErrInvalidAddress, "invalid issuer %s", m.Issuer)
 	}
@@ -99,8 +167,16 @@ func (m MsgIssue) ValidateBasic() error {
 		return sdkerrors.Wrapf(ErrInvalidInput, "invalid initial amount %s, can't be negative", m.InitialAmount.String())
 	}
 
-	if len(m.Description) > maxDescriptionLength {
-		return sdkerrors.Wrapf(ErrInvalidInput, "invalid description %q, the length must be less than %d", m.Description, maxDescriptionLength)
+	if err := ValidateMetadata(m.Description, m.URI, m.URIHash, m.Data); err != nil {
+		return err
+	}
+
+	if err := ValidateMaxSupply(m.MaxSupply, m.InitialAmount); err != nil {
+		return err
+	}
+
+	if err := ValidateMintAllowance(m.MintAllowance); err != nil {
+		return err
 	}
 
 	duplicates := lo.FindDuplicates(m.Features)
@@ -168,6 +244,37 @@ func (m MsgMint) Type() string {
 	return TypeMsgMint
 }
 
+// ValidateBasic checks that message fields are valid.
+func (m MsgMultiMint) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	return validateAccountCoins(m.Entries)
+}
+
+// GetSigners returns the required signers of this message type.
+func (m MsgMultiMint) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(m.Sender),
+	}
+}
+
+// GetSignBytes returns sign bytes for LegacyMsg.
+func (m MsgMultiMint) GetSignBytes() []byte {
+	return sdk.MustSortJSON(moduleAminoCdc.MustMarshalJSON(&m))
+}
+
+// Route returns message route for LegacyMsg.
+func (m MsgMultiMint) Route() string {
+	return RouterKey
+}
+
+// Type returns message type for LegacyMsg.
+func (m MsgMultiMint) Type() string {
+	return TypeMsgMultiMint
+}
+
 // ValidateBasic checks that message fields are valid.
 func (m MsgBurn) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
@@ -213,13 +320,12 @@ func (m MsgFreeze) ValidateBasic() error {
 		return sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid account address")
 	}
 
-	_, issuer, err := DeconstructDenom(m.Coin.Denom)
-	if err != nil {
+	if _, _, err := DeconstructDenom(m.Coin.Denom); err != nil {
 		return err
 	}
 
-	if issuer.String() == m.Account {
-		return sdkerrors.Wrap(cosmoserrors.ErrUnauthorized, "issuer's balance can't be frozen")
+	if m.UnfreezeTime != nil && m.UnfreezeTime.Unix() <= 0 {
+		return sdkerrors.Wrap(ErrInvalidInput, "unfreeze time must be positive")
 	}
 
 	return m.Coin.Validate()
@@ -247,6 +353,37 @@ func (m MsgFreeze) Type() string {
 	return TypeMsgFreeze
 }
 
+// ValidateBasic checks that message fields are valid.
+func (m MsgMultiFreeze) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	return validateAccountCoins(m.Entries)
+}
+
+// GetSigners returns the required signers of this message type.
+func (m MsgMultiFreeze) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(m.Sender),
+	}
+}
+
+// GetSignBytes returns sign bytes for LegacyMsg.
+func (m MsgMultiFreeze) GetSignBytes() []byte {
+	return sdk.MustSortJSON(moduleAminoCdc.MustMarshalJSON(&m))
+}
+
+// Route returns message route for LegacyMsg.
+func (m MsgMultiFreeze) Route() string {
+	return RouterKey
+}
+
+// Type returns message type for LegacyMsg.
+func (m MsgMultiFreeze) Type() string {
+	return TypeMsgMultiFreeze
+}
+
 // ValidateBasic checks that message fields are valid.
 func (m MsgUnfreeze) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
@@ -296,15 +433,10 @@ func (m MsgSetFrozen) ValidateBasic() error {
 		return sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid account address")
 	}
 
-	_, issuer, err := DeconstructDenom(m.Coin.Denom)
-	if err != nil {
+	if _, _, err := DeconstructDenom(m.Coin.Denom); err != nil {
 		return err
 	}
 
-	if issuer.String() == m.Account {
-		return sdkerrors.Wrap(cosmoserrors.ErrUnauthorized, "issuer's balance can't be frozen")
-	}
-
 	return m.Coin.Validate()
 }
 
@@ -410,15 +542,10 @@ func (m MsgSetWhitelistedLimit) ValidateBasic() error {
 		return sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid account address")
 	}
 
-	_, issuer, err := DeconstructDenom(m.Coin.Denom)
-	if err != nil {
+	if _, _, err := DeconstructDenom(m.Coin.Denom); err != nil {
 		return err
 	}
 
-	if issuer.String() == m.Account {
-		return sdkerrors.Wrap(cosmoserrors.ErrUnauthorized, "issuer's balance can't be whitelisted")
-	}
-
 	return m.Coin.Validate()
 }
 
@@ -445,23 +572,536 @@ func (m MsgSetWhitelistedLimit) Type() string {
 }
 
 // ValidateBasic checks that message fields are valid.
-func (m MsgUpgradeTokenV1) ValidateBasic() error {
+func (m MsgMultiSetWhitelistedLimit) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
 		return sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid sender address")
 	}
 
-	_, issuer, err := DeconstructDenom(m.Denom)
-	if err != nil {
+	return validateAccountCoins(m.Entries)
+}
+
+// GetSigners returns the required signers of this message type.
+func (m MsgMultiSetWhitelistedLimit) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(m.Sender),
+	}
+}
+
+// GetSignBytes returns sign bytes for LegacyMsg.
+func (m MsgMultiSetWhitelistedLimit) GetSignBytes() []byte {
+	return sdk.MustSortJSON(moduleAminoCdc.MustMarshalJSON(&m))
+}
+
+// Route returns message route for LegacyMsg.
+func (m MsgMultiSetWhitelistedLimit) Route() string {
+	return RouterKey
+}
+
+// Type returns message type for LegacyMsg.
+func (m MsgMultiSetWhitelistedLimit) Type() string {
+	return TypeMsgMultiSetWhitelistedLimit
+}
+
+// ValidateBasic checks that message fields are valid.
+func (m MsgClawback) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Account); err != nil {
+		return sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid account address")
+	}
+
+	if _, _, err := DeconstructDenom(m.Coin.Denom); err != nil {
 		return err
 	}
 
-	if issuer.String() != m.Sender {
-		return sdkerrors.Wrap(cosmoserrors.ErrUnauthorized, "only issuer can upgrade the denom")
+	return m.Coin.Validate()
+}
+
+// GetSigners returns the required signers of this message type.
+func (m MsgClawback) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(m.Sender),
+	}
+}
+
+// GetSignBytes returns sign bytes for LegacyMsg.
+func (m MsgClawback) GetSignBytes() []byte {
+	return sdk.MustSortJSON(moduleAminoCdc.MustMarshalJSON(&m))
+}
+
+// Route returns message route for LegacyMsg.
+func (m MsgClawback) Route() string {
+	return RouterKey
+}
+
+// Type returns message type for LegacyMsg.
+func (m MsgClawback) Type() string {
+	return TypeMsgClawback
+}
+
+// ValidateBasic checks that message fields are valid.
+func (m MsgTransferAdmin) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Account); err != nil {
+		return sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid account address")
+	}
+
+	if m.Sender == m.Account {
+		return sdkerrors.Wrap(ErrInvalidInput, "sender is already the admin")
+	}
+
+	_, _, err := DeconstructDenom(m.Denom)
+	return err
+}
+
+// GetSigners returns the required signers of this message type.
+func (m MsgTransferAdmin) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(m.Sender),
+	}
+}
+
+// GetSignBytes returns sign bytes for LegacyMsg.
+func (m MsgTransferAdmin) GetSignBytes() []byte {
+	return sdk.MustSortJSON(moduleAminoCdc.MustMarshalJSON(&m))
+}
+
+// Route returns message route for LegacyMsg.
+func (m MsgTransferAdmin) Route() string {
+	return RouterKey
+}
+
+// Type returns message type for LegacyMsg.
+func (m MsgTransferAdmin) Type() string {
+	return TypeMsgTransferAdmin
+}
+
+// ValidateBasic checks that message fields are valid.
+func (m MsgClearAdmin) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	_, _, err := DeconstructDenom(m.Denom)
+	return err
+}
+
+// GetSigners returns the required signers of this message type.
+func (m MsgClearAdmin) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(m.Sender),
+	}
+}
+
+// GetSignBytes returns sign bytes for LegacyMsg.
+func (m MsgClearAdmin) GetSignBytes() []byte {
+	return sdk.MustSortJSON(moduleAminoCdc.MustMarshalJSON(&m))
+}
+
+// Route returns message route for LegacyMsg.
+func (m MsgClearAdmin) Route() string {
+	return RouterKey
+}
+
+// Type returns message type for LegacyMsg.
+func (m MsgClearAdmin) Type() string {
+	return TypeMsgClearAdmin
+}
+
+// ValidateBasic checks that message fields are valid.
+func (m MsgUpdateMetadata) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if _, _, err := DeconstructDenom(m.Denom); err != nil {
+		return err
+	}
+
+	return ValidateMetadata(m.Description, m.URI, m.URIHash, m.Data)
+}
+
+// GetSigners returns the required signers of this message type.
+func (m MsgUpdateMetadata) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(m.Sender),
+	}
+}
+
+// GetSignBytes returns sign bytes for LegacyMsg.
+func (m MsgUpdateMetadata) GetSignBytes() []byte {
+	return sdk.MustSortJSON(moduleAminoCdc.MustMarshalJSON(&m))
+}
+
+// Route returns message route for LegacyMsg.
+func (m MsgUpdateMetadata) Route() string {
+	return RouterKey
+}
+
+// Type returns message type for LegacyMsg.
+func (m MsgUpdateMetadata) Type() string {
+	return TypeMsgUpdateMetadata
+}
+
+// ValidateBasic checks that message fields are valid.
+func (m MsgSetRateExemption) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Account); err != nil {
+		return sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid account address")
+	}
+
+	_, _, err := DeconstructDenom(m.Denom)
+	return err
+}
+
+// GetSigners returns the required signers of this message type.
+func (m MsgSetRateExemption) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(m.Sender),
+	}
+}
+
+// GetSignBytes returns sign bytes for LegacyMsg.
+func (m MsgSetRateExemption) GetSignBytes() []byte {
+	return sdk.MustSortJSON(moduleAminoCdc.MustMarshalJSON(&m))
+}
+
+// Route returns message route for LegacyMsg.
+func (m MsgSetRateExemption) Route() string {
+	return RouterKey
+}
+
+// Type returns message type for LegacyMsg.
+func (m MsgSetRateExemption) Type() string {
+	return TypeMsgSetRateExemption
+}
+
+// ValidateBasic checks that message fields are valid.
+func (m MsgRemoveRateExemption) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Account); err != nil {
+		return sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid account address")
+	}
+
+	_, _, err := DeconstructDenom(m.Denom)
+	return err
+}
+
+// GetSigners returns the required signers of this message type.
+func (m MsgRemoveRateExemption) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(m.Sender),
+	}
+}
+
+// GetSignBytes returns sign bytes for LegacyMsg.
+func (m MsgRemoveRateExemption) GetSignBytes() []byte {
+	return sdk.MustSortJSON(moduleAminoCdc.MustMarshalJSON(&m))
+}
+
+// Route returns message route for LegacyMsg.
+func (m MsgRemoveRateExemption) Route() string {
+	return RouterKey
+}
+
+// Type returns message type for LegacyMsg.
+func (m MsgRemoveRateExemption) Type() string {
+	return TypeMsgRemoveRateExemption
+}
+
+// ValidateBasic checks that message fields are valid.
+func (m MsgUpdateRates) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if _, _, err := DeconstructDenom(m.Denom); err != nil {
+		return err
+	}
+
+	return ValidateRatesUpdate(m.BurnRate, m.SendCommissionRate)
+}
+
+// GetSigners returns the required signers of this message type.
+func (m MsgUpdateRates) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(m.Sender),
+	}
+}
+
+// GetSignBytes returns sign bytes for LegacyMsg.
+func (m MsgUpdateRates) GetSignBytes() []byte {
+	return sdk.MustSortJSON(moduleAminoCdc.MustMarshalJSON(&m))
+}
+
+// Route returns message route for LegacyMsg.
+func (m MsgUpdateRates) Route() string {
+	return RouterKey
+}
+
+// Type returns message type for LegacyMsg.
+func (m MsgUpdateRates) Type() string {
+	return TypeMsgUpdateRates
+}
+
+// ValidateBasic checks that message fields are valid.
+func (m MsgSetTransferLimit) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	// the default limit is set if the account is empty
+	if m.Account != "" {
+		if _, err := sdk.AccAddressFromBech32(m.Account); err != nil {
+			return sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid account address")
+		}
+	}
+
+	if _, _, err := DeconstructDenom(m.Denom); err != nil {
+		return err
+	}
+
+	return ValidateTransferLimit(m.Amount, m.Period)
+}
+
+// GetSigners returns the required signers of this message type.
+func (m MsgSetTransferLimit) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(m.Sender),
+	}
+}
+
+// GetSignBytes returns sign bytes for LegacyMsg.
+func (m MsgSetTransferLimit) GetSignBytes() []byte {
+	return sdk.MustSortJSON(moduleAminoCdc.MustMarshalJSON(&m))
+}
+
+// Route returns message route for LegacyMsg.
+func (m MsgSetTransferLimit) Route() string {
+	return RouterKey
+}
+
+// Type returns message type for LegacyMsg.
+func (m MsgSetTransferLimit) Type() string {
+	return TypeMsgSetTransferLimit
+}
+
+// ValidateBasic checks that message fields are valid.
+func (m MsgRemoveTransferLimit) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	// the default limit is removed if the account is empty
+	if m.Account != "" {
+		if _, err := sdk.AccAddressFromBech32(m.Account); err != nil {
+			return sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid account address")
+		}
+	}
+
+	_, _, err := DeconstructDenom(m.Denom)
+	return err
+}
+
+// GetSigners returns the required signers of this message type.
+func (m MsgRemoveTransferLimit) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(m.Sender),
+	}
+}
+
+// GetSignBytes returns sign bytes for LegacyMsg.
+func (m MsgRemoveTransferLimit) GetSignBytes() []byte {
+	return sdk.MustSortJSON(moduleAminoCdc.MustMarshalJSON(&m))
+}
+
+// Route returns message route for LegacyMsg.
+func (m MsgRemoveTransferLimit) Route() string {
+	return RouterKey
+}
+
+// Type returns message type for LegacyMsg.
+func (m MsgRemoveTransferLimit) Type() string {
+	return TypeMsgRemoveTransferLimit
+}
+
+// ValidateBasic checks that message fields are valid.
+func (m MsgBlockAccounts) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if _, _, err := DeconstructDenom(m.Denom); err != nil {
+		return err
+	}
+
+	return validateAccounts(m.Accounts)
+}
+
+// GetSigners returns the required signers of this message type.
+func (m MsgBlockAccounts) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(m.Sender),
+	}
+}
+
+// GetSignBytes returns sign bytes for LegacyMsg.
+func (m MsgBlockAccounts) GetSignBytes() []byte {
+	return sdk.MustSortJSON(moduleAminoCdc.MustMarshalJSON(&m))
+}
+
+// Route returns message route for LegacyMsg.
+func (m MsgBlockAccounts) Route() string {
+	return RouterKey
+}
+
+// Type returns message type for LegacyMsg.
+func (m MsgBlockAccounts) Type() string {
+	return TypeMsgBlockAccounts
+}
+
+// ValidateBasic checks that message fields are valid.
+func (m MsgUnblockAccounts) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if _, _, err := DeconstructDenom(m.Denom); err != nil {
+		return err
+	}
+
+	return validateAccounts(m.Accounts)
+}
+
+// GetSigners returns the required signers of this message type.
+func (m MsgUnblockAccounts) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(m.Sender),
+	}
+}
+
+// GetSignBytes returns sign bytes for LegacyMsg.
+func (m MsgUnblockAccounts) GetSignBytes() []byte {
+	return sdk.MustSortJSON(moduleAminoCdc.MustMarshalJSON(&m))
+}
+
+// Route returns message route for LegacyMsg.
+func (m MsgUnblockAccounts) Route() string {
+	return RouterKey
+}
+
+// Type returns message type for LegacyMsg.
+func (m MsgUnblockAccounts) Type() string {
+	return TypeMsgUnblockAccounts
+}
+
+// ValidateBasic checks that message fields are valid.
+func (m MsgCreateVestingSchedule) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Account); err != nil {
+		return sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid account address")
+	}
+
+	if _, _, err := DeconstructDenom(m.Coin.Denom); err != nil {
+		return err
+	}
+
+	if err := m.Coin.Validate(); err != nil {
+		return err
+	}
+
+	return ValidateVestingSchedule(m.VestingType, m.Coin.Amount, m.StartTime, m.EndTime, m.Periods)
+}
+
+// GetSigners returns the required signers of this message type.
+func (m MsgCreateVestingSchedule) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(m.Sender),
+	}
+}
+
+// GetSignBytes returns sign bytes for LegacyMsg.
+func (m MsgCreateVestingSchedule) GetSignBytes() []byte {
+	return sdk.MustSortJSON(moduleAminoCdc.MustMarshalJSON(&m))
+}
+
+// Route returns message route for LegacyMsg.
+func (m MsgCreateVestingSchedule) Route() string {
+	return RouterKey
+}
+
+// Type returns message type for LegacyMsg.
+func (m MsgCreateVestingSchedule) Type() string {
+	return TypeMsgCreateVestingSchedule
+}
+
+// ValidateBasic checks that message fields are valid.
+func (m MsgDistribute) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if _, _, err := DeconstructDenom(m.Denom); err != nil {
+		return err
+	}
+
+	if err := m.Amount.Validate(); err != nil {
+		return err
+	}
+
+	if !m.Amount.IsPositive() {
+		return sdkerrors.Wrap(cosmoserrors.ErrInvalidCoins, "distributed amount should be positive")
 	}
 
 	return nil
 }
 
+// GetSigners returns the required signers of this message type.
+func (m MsgDistribute) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(m.Sender),
+	}
+}
+
+// GetSignBytes returns sign bytes for LegacyMsg.
+func (m MsgDistribute) GetSignBytes() []byte {
+	return sdk.MustSortJSON(moduleAminoCdc.MustMarshalJSON(&m))
+}
+
+// Route returns message route for LegacyMsg.
+func (m MsgDistribute) Route() string {
+	return RouterKey
+}
+
+// Type returns message type for LegacyMsg.
+func (m MsgDistribute) Type() string {
+	return TypeMsgDistribute
+}
+
+// ValidateBasic checks that message fields are valid.
+func (m MsgUpgradeTokenV1) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	_, _, err := DeconstructDenom(m.Denom)
+	return err
+}
+
 // GetSigners returns the required signers of this message type.
 func (m MsgUpgradeTokenV1) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{
@@ -484,6 +1124,45 @@ func (m MsgUpgradeTokenV1) Type() string {
 	return TypeMsgUpgradeTokenV1
 }
 
+// ValidateBasic checks that message fields are valid.
+func (m MsgUpgradeToken) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if _, _, err := DeconstructDenom(m.Denom); err != nil {
+		return err
+	}
+
+	if m.Version == 0 {
+		return sdkerrors.Wrap(ErrInvalidInput, "version must be positive")
+	}
+
+	return m.Options.Validate()
+}
+
+// GetSigners returns the required signers of this message type.
+func (m MsgUpgradeToken) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(m.Sender),
+	}
+}
+
+// GetSignBytes returns sign bytes for LegacyMsg.
+func (m MsgUpgradeToken) GetSignBytes() []byte {
+	return sdk.MustSortJSON(moduleAminoCdc.MustMarshalJSON(&m))
+}
+
+// Route returns message route for LegacyMsg.
+func (m MsgUpgradeToken) Route() string {
+	return RouterKey
+}
+
+// Type returns message type for LegacyMsg.
+func (m MsgUpgradeToken) Type() string {
+	return TypeMsgUpgradeToken
+}
+
 // ValidateBasic checks that message fields are valid.
 func (m MsgUpdateParams) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
@@ -514,6 +1193,61 @@ func (m MsgUpdateParams) Type() string {
 	return TypeMsgUpdateParams
 }
 
+func validateAccounts(accounts []string) error {
+	if len(accounts) == 0 {
+		return sdkerrors.Wrap(ErrInvalidInput, "accounts must not be empty")
+	}
+
+	seen := make(map[string]struct{}, len(accounts))
+	for _, account := range accounts {
+		if _, err := sdk.AccAddressFromBech32(account); err != nil {
+			return sdkerrors.Wrapf(cosmoserrors.ErrInvalidAddress, "invalid account address %s", account)
+		}
+
+		if _, ok := seen[account]; ok {
+			return sdkerrors.Wrapf(ErrInvalidInput, "duplicated account %s", account)
+		}
+		seen[account] = struct{}{}
+	}
+
+	return nil
+}
+
+func validateAccountCoins(entries []AccountCoin) error {
+	if len(entries) == 0 {
+		return sdkerrors.Wrap(ErrInvalidInput, "entries must not be empty")
+	}
+
+	type accountDenom struct {
+		account string
+		denom   string
+	}
+	seen := make(map[accountDenom]struct{}, len(entries))
+	for _, entry := range entries {
+		if _, err := sdk.AccAddressFromBech32(entry.Account); err != nil {
+			return sdkerrors.Wrapf(cosmoserrors.ErrInvalidAddress, "invalid account address %s", entry.Account)
+		}
+
+		if _, _, err := DeconstructDenom(entry.Coin.Denom); err != nil {
+			return err
+		}
+
+		if err := entry.Coin.Validate(); err != nil {
+			return err
+		}
+
+		key := accountDenom{account: entry.Account, denom: entry.Coin.Denom}
+		if _, ok := seen[key]; ok {
+			return sdkerrors.Wrapf(
+				ErrInvalidInput, "duplicated entry for account %s and denom %s", entry.Account, entry.Coin.Denom,
+			)
+		}
+		seen[key] = struct{}{}
+	}
+
+	return nil
+}
+
 var (
 	amino          = codec.NewLegacyAmino()
 	moduleAminoCdc = codec.NewAminoCodec(amino)
@@ -1,14 +1,19 @@
 package types_test
 
 import (
+	"bytes"
+	"strings"
 	"testing"
+	"time"
 
 	sdkerrors "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+	"github.com/cosmos/gogoproto/proto"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -28,6 +33,8 @@ func TestMain(m *testing.M) {
 
 func TestMsgIssue_ValidateBasic(t *testing.T) {
 	acc := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	dataValue, err := codectypes.NewAnyWithValue(&types.DataBytes{Data: []byte("metadata")})
+	require.NoError(t, err)
 	validMessage := types.MsgIssue{
 		Issuer:        acc.String(),
 		Symbol:        "BTC",
@@ -35,6 +42,9 @@ func TestMsgIssue_ValidateBasic(t *testing.T) {
 		Precision:     1,
 		Description:   "BTC Description",
 		InitialAmount: sdkmath.NewInt(777),
+		URI:           "https://my.invalid",
+		URIHash:       "sha-hash",
+		Data:          dataValue,
 	}
 
 	testCases := []struct {
@@ -105,9 +115,49 @@ func TestMsgIssue_ValidateBasic(t *testing.T) {
 			expectedError: types.ErrInvalidInput,
 		},
 		{
-			name: "invalid empty subunit",
+			name: "invalid long URI",
 			messageFunc: func(msg types.MsgIssue) types.MsgIssue {
-				msg.Subunit = ""
+				msg.URI = strings.Repeat("x", types.MaxURILength+1)
+				return msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid long URI hash",
+			messageFunc: func(msg types.MsgIssue) types.MsgIssue {
+				msg.URIHash = strings.Repeat("x", types.MaxURIHashLength+1)
+				return msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "valid data with max size",
+			messageFunc: func(msg types.MsgIssue) types.MsgIssue {
+				msg.Data = &codectypes.Any{
+					TypeUrl: "/" + proto.MessageName((*types.DataBytes)(nil)),
+					Value:   bytes.Repeat([]byte{0x01}, types.MaxDataSize),
+				}
+				return msg
+			},
+		},
+		{
+			name: "invalid data size",
+			messageFunc: func(msg types.MsgIssue) types.MsgIssue {
+				msg.Data = &codectypes.Any{
+					TypeUrl: "/" + proto.MessageName((*types.DataBytes)(nil)),
+					Value:   bytes.Repeat([]byte{0x01}, types.MaxDataSize+1),
+				}
+				return msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid data type",
+			messageFunc: func(msg types.MsgIssue) types.MsgIssue {
+				msg.Data = &codectypes.Any{
+					TypeUrl: "/" + proto.MessageName((*types.DelayedTokenUpgradeV1)(nil)),
+					Value:   []byte{0x01},
+				}
 				return msg
 			},
 			expectedError: types.ErrInvalidInput,
@@ -148,6 +198,58 @@ func TestMsgIssue_ValidateBasic(t *testing.T) {
 			},
 			expectedError: types.ErrInvalidInput,
 		},
+		{
+			name: "valid max supply and mint allowance",
+			messageFunc: func(msg types.MsgIssue) types.MsgIssue {
+				maxSupply := sdkmath.NewInt(777)
+				msg.MaxSupply = &maxSupply
+				msg.MintAllowance = &types.MintAllowance{
+					Amount: sdkmath.NewInt(10),
+					Period: time.Hour,
+				}
+				return msg
+			},
+		},
+		{
+			name: "invalid zero max supply",
+			messageFunc: func(msg types.MsgIssue) types.MsgIssue {
+				maxSupply := sdkmath.ZeroInt()
+				msg.MaxSupply = &maxSupply
+				msg.InitialAmount = sdkmath.ZeroInt()
+				return msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid initial amount exceeding max supply",
+			messageFunc: func(msg types.MsgIssue) types.MsgIssue {
+				maxSupply := sdkmath.NewInt(776)
+				msg.MaxSupply = &maxSupply
+				return msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid zero mint allowance amount",
+			messageFunc: func(msg types.MsgIssue) types.MsgIssue {
+				msg.MintAllowance = &types.MintAllowance{
+					Amount: sdkmath.ZeroInt(),
+					Period: time.Hour,
+				}
+				return msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid zero mint allowance period",
+			messageFunc: func(msg types.MsgIssue) types.MsgIssue {
+				msg.MintAllowance = &types.MintAllowance{
+					Amount: sdkmath.NewInt(10),
+				}
+				return msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
 	}
 	for _, testCase := range testCases {
 		tc := testCase
@@ -205,16 +307,74 @@ func TestMsgFreeze_ValidateBasic(t *testing.T) {
 			expectedError: cosmoserrors.ErrInvalidAddress,
 		},
 		{
-			name: "issuer freezing",
+			name: "invalid unfreeze time",
 			message: types.MsgFreeze{
 				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
-				Account: "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Account: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq",
+				Coin: sdk.Coin{
+					Denom:  "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+					Amount: sdkmath.NewInt(100),
+				},
+				UnfreezeTime: &time.Time{},
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			assertT := assert.New(t)
+			err := tc.message.ValidateBasic()
+			if tc.expectedError == nil {
+				assertT.NoError(err)
+			} else {
+				assertT.True(sdkerrors.IsOf(err, tc.expectedError))
+			}
+		})
+	}
+}
+
+func TestMsgClawback_ValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name          string
+		message       types.MsgClawback
+		expectedError error
+	}{
+		{
+			name: "valid msg",
+			message: types.MsgClawback{
+				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Account: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq",
+				Coin: sdk.Coin{
+					Denom:  "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+					Amount: sdkmath.NewInt(100),
+				},
+			},
+		},
+		{
+			name: "invalid sender address",
+			message: types.MsgClawback{
+				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5+",
+				Account: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq",
+				Coin: sdk.Coin{
+					Denom:  "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+					Amount: sdkmath.NewInt(100),
+				},
+			},
+			expectedError: cosmoserrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid account",
+			message: types.MsgClawback{
+				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Account: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq+",
 				Coin: sdk.Coin{
 					Denom:  "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
 					Amount: sdkmath.NewInt(100),
 				},
 			},
-			expectedError: cosmoserrors.ErrUnauthorized,
+			expectedError: cosmoserrors.ErrInvalidAddress,
 		},
 	}
 
@@ -453,18 +613,6 @@ func TestMsgSetWhitelistedLimit_ValidateBasic(t *testing.T) {
 			},
 			expectedErrorString: "invalid denom",
 		},
-		{
-			name: "issuer whitelisting",
-			message: types.MsgSetWhitelistedLimit{
-				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
-				Account: "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
-				Coin: sdk.Coin{
-					Denom:  "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
-					Amount: sdkmath.NewInt(100),
-				},
-			},
-			expectedError: cosmoserrors.ErrUnauthorized,
-		},
 	}
 
 	for _, testCase := range testCases {
@@ -484,105 +632,1296 @@ func TestMsgSetWhitelistedLimit_ValidateBasic(t *testing.T) {
 	}
 }
 
-func TestAmino(t *testing.T) {
-	const address = "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"
-	coin := sdk.NewInt64Coin("my-denom", 1)
-
-	tests := []struct {
+func TestMsgTransferAdmin_ValidateBasic(t *testing.T) {
+	testCases := []struct {
 		name          string
-		msg           legacytx.LegacyMsg
-		wantAminoJSON string
+		message       types.MsgTransferAdmin
+		expectedError error
 	}{
 		{
-			name: types.TypeMsgIssue,
-			msg: &types.MsgIssue{
-				Issuer: address,
-				Symbol: "ABC",
+			name: "valid msg",
+			message: types.MsgTransferAdmin{
+				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Account: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq",
+				Denom:   "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
 			},
-			wantAminoJSON: `{"type":"assetft/MsgIssue","value":{"burn_rate":"0","initial_amount":"0","issuer":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5","send_commission_rate":"0","symbol":"ABC"}}`,
 		},
 		{
-			name: types.TypeMsgMint,
-			msg: &types.MsgMint{
-				Sender: address,
-				Coin:   coin,
+			name: "invalid sender address",
+			message: types.MsgTransferAdmin{
+				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5+",
+				Account: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq",
+				Denom:   "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
 			},
-			wantAminoJSON: `{"type":"assetft/MsgMint","value":{"coin":{"amount":"1","denom":"my-denom"},"sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"}}`,
+			expectedError: cosmoserrors.ErrInvalidAddress,
 		},
 		{
-			name: types.TypeMsgBurn,
-			msg: &types.MsgBurn{
-				Sender: address,
-				Coin:   coin,
+			name: "invalid account",
+			message: types.MsgTransferAdmin{
+				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Account: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq+",
+				Denom:   "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
 			},
-			wantAminoJSON: `{"type":"assetft/MsgBurn","value":{"coin":{"amount":"1","denom":"my-denom"},"sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"}}`,
+			expectedError: cosmoserrors.ErrInvalidAddress,
 		},
 		{
-			name: types.TypeMsgFreeze,
-			msg: &types.MsgFreeze{
-				Sender: address,
-				Coin:   coin,
+			name: "transfer to sender",
+			message: types.MsgTransferAdmin{
+				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Account: "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Denom:   "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
 			},
-			wantAminoJSON: `{"type":"assetft/MsgFreeze","value":{"coin":{"amount":"1","denom":"my-denom"},"sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"}}`,
+			expectedError: types.ErrInvalidInput,
 		},
 		{
-			name: types.TypeMsgUnfreeze,
-			msg: &types.MsgUnfreeze{
-				Sender: address,
-				Coin:   coin,
+			name: "invalid denom",
+			message: types.MsgTransferAdmin{
+				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Account: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq",
+				Denom:   "abc",
 			},
-			wantAminoJSON: `{"type":"assetft/MsgUnfreeze","value":{"coin":{"amount":"1","denom":"my-denom"},"sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"}}`,
+			expectedError: types.ErrInvalidDenom,
 		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			assertT := assert.New(t)
+			err := tc.message.ValidateBasic()
+			if tc.expectedError == nil {
+				assertT.NoError(err)
+			} else {
+				assertT.True(sdkerrors.IsOf(err, tc.expectedError))
+			}
+		})
+	}
+}
+
+func TestMsgClearAdmin_ValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name          string
+		message       types.MsgClearAdmin
+		expectedError error
+	}{
 		{
-			name: types.TypeMsgUnfreeze,
-			msg: &types.MsgUnfreeze{
-				Sender: address,
-				Coin:   coin,
+			name: "valid msg",
+			message: types.MsgClearAdmin{
+				Sender: "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Denom:  "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
 			},
-			wantAminoJSON: `{"type":"assetft/MsgUnfreeze","value":{"coin":{"amount":"1","denom":"my-denom"},"sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"}}`,
 		},
 		{
-			name: types.TypeMsgGloballyFreeze,
-			msg: &types.MsgGloballyFreeze{
-				Sender: address,
-				Denom:  coin.Denom,
+			name: "invalid sender address",
+			message: types.MsgClearAdmin{
+				Sender: "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5+",
+				Denom:  "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
 			},
-			wantAminoJSON: `{"type":"assetft/MsgGloballyFreeze","value":{"denom":"my-denom","sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"}}`,
+			expectedError: cosmoserrors.ErrInvalidAddress,
 		},
 		{
-			name: types.TypeMsgGloballyUnfreeze,
-			msg: &types.MsgGloballyUnfreeze{
-				Sender: address,
-				Denom:  coin.Denom,
+			name: "invalid denom",
+			message: types.MsgClearAdmin{
+				Sender: "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Denom:  "abc",
 			},
-			wantAminoJSON: `{"type":"assetft/MsgGloballyUnfreeze","value":{"denom":"my-denom","sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"}}`,
+			expectedError: types.ErrInvalidDenom,
 		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			assertT := assert.New(t)
+			err := tc.message.ValidateBasic()
+			if tc.expectedError == nil {
+				assertT.NoError(err)
+			} else {
+				assertT.True(sdkerrors.IsOf(err, tc.expectedError))
+			}
+		})
+	}
+}
+
+func TestMsgUpdateMetadata_ValidateBasic(t *testing.T) {
+	validMessage := types.MsgUpdateMetadata{
+		Sender:      "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+		Denom:       "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+		Description: "ABC Description",
+		URI:         "https://my.invalid",
+		URIHash:     "sha-hash",
+	}
+
+	testCases := []struct {
+		name          string
+		messageFunc   func(types.MsgUpdateMetadata) types.MsgUpdateMetadata
+		expectedError error
+	}{
 		{
-			name: types.TypeMsgUnfreeze,
-			msg: &types.MsgUnfreeze{
-				Sender: address,
-				Coin:   coin,
+			name: "valid",
+			messageFunc: func(msg types.MsgUpdateMetadata) types.MsgUpdateMetadata {
+				return msg
 			},
-			wantAminoJSON: `{"type":"assetft/MsgUnfreeze","value":{"coin":{"amount":"1","denom":"my-denom"},"sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"}}`,
 		},
 		{
-			name: types.TypeMsgSetWhitelistedLimit,
-			msg: &types.MsgSetWhitelistedLimit{
-				Sender:  address,
-				Account: address,
-				Coin:    coin,
+			name: "valid empty metadata",
+			messageFunc: func(msg types.MsgUpdateMetadata) types.MsgUpdateMetadata {
+				msg.Description = ""
+				msg.URI = ""
+				msg.URIHash = ""
+				return msg
 			},
-			wantAminoJSON: `{"type":"assetft/MsgSetWhitelistedLimit","value":{"account":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5","coin":{"amount":"1","denom":"my-denom"},"sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"}}`,
 		},
 		{
-			name: types.TypeMsgUpgradeTokenV1,
-			msg: &types.MsgUpgradeTokenV1{
-				Sender:     address,
-				Denom:      coin.Denom,
-				IbcEnabled: false,
+			name: "invalid sender address",
+			messageFunc: func(msg types.MsgUpdateMetadata) types.MsgUpdateMetadata {
+				msg.Sender = "invalid"
+				return msg
+			},
+			expectedError: cosmoserrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid denom",
+			messageFunc: func(msg types.MsgUpdateMetadata) types.MsgUpdateMetadata {
+				msg.Denom = "abc"
+				return msg
+			},
+			expectedError: types.ErrInvalidDenom,
+		},
+		{
+			name: "invalid long description",
+			messageFunc: func(msg types.MsgUpdateMetadata) types.MsgUpdateMetadata {
+				msg.Description = string(make([]byte, 10000))
+				return msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid long URI",
+			messageFunc: func(msg types.MsgUpdateMetadata) types.MsgUpdateMetadata {
+				msg.URI = strings.Repeat("x", types.MaxURILength+1)
+				return msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid data size",
+			messageFunc: func(msg types.MsgUpdateMetadata) types.MsgUpdateMetadata {
+				msg.Data = &codectypes.Any{
+					TypeUrl: "/" + proto.MessageName((*types.DataBytes)(nil)),
+					Value:   bytes.Repeat([]byte{0x01}, types.MaxDataSize+1),
+				}
+				return msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+	}
+	for _, testCase := range testCases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			assertT := assert.New(t)
+			err := tc.messageFunc(validMessage).ValidateBasic()
+			if tc.expectedError == nil {
+				assertT.NoError(err)
+			} else {
+				assertT.True(sdkerrors.IsOf(err, tc.expectedError))
+			}
+		})
+	}
+}
+
+func TestMsgSetRateExemption_ValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name          string
+		message       types.MsgSetRateExemption
+		expectedError error
+	}{
+		{
+			name: "valid msg",
+			message: types.MsgSetRateExemption{
+				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Account: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq",
+				Denom:   "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+			},
+		},
+		{
+			name: "invalid sender address",
+			message: types.MsgSetRateExemption{
+				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5+",
+				Account: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq",
+				Denom:   "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+			},
+			expectedError: cosmoserrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid account",
+			message: types.MsgSetRateExemption{
+				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Account: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq+",
+				Denom:   "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+			},
+			expectedError: cosmoserrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid denom",
+			message: types.MsgSetRateExemption{
+				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Account: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq",
+				Denom:   "abc",
+			},
+			expectedError: types.ErrInvalidDenom,
+		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			assertT := assert.New(t)
+			err := tc.message.ValidateBasic()
+			if tc.expectedError == nil {
+				assertT.NoError(err)
+			} else {
+				assertT.True(sdkerrors.IsOf(err, tc.expectedError))
+			}
+		})
+	}
+}
+
+func TestMsgRemoveRateExemption_ValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name          string
+		message       types.MsgRemoveRateExemption
+		expectedError error
+	}{
+		{
+			name: "valid msg",
+			message: types.MsgRemoveRateExemption{
+				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Account: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq",
+				Denom:   "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+			},
+		},
+		{
+			name: "invalid sender address",
+			message: types.MsgRemoveRateExemption{
+				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5+",
+				Account: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq",
+				Denom:   "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+			},
+			expectedError: cosmoserrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid account",
+			message: types.MsgRemoveRateExemption{
+				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Account: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq+",
+				Denom:   "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+			},
+			expectedError: cosmoserrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid denom",
+			message: types.MsgRemoveRateExemption{
+				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Account: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq",
+				Denom:   "abc",
+			},
+			expectedError: types.ErrInvalidDenom,
+		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			assertT := assert.New(t)
+			err := tc.message.ValidateBasic()
+			if tc.expectedError == nil {
+				assertT.NoError(err)
+			} else {
+				assertT.True(sdkerrors.IsOf(err, tc.expectedError))
+			}
+		})
+	}
+}
+
+func TestMsgUpdateRates_ValidateBasic(t *testing.T) {
+	rate := sdk.MustNewDecFromStr("0.1")
+	invalidRate := sdk.MustNewDecFromStr("1.1")
+	testCases := []struct {
+		name          string
+		message       types.MsgUpdateRates
+		expectedError error
+	}{
+		{
+			name: "valid msg",
+			message: types.MsgUpdateRates{
+				Sender:             "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Denom:              "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				BurnRate:           &rate,
+				SendCommissionRate: &rate,
+			},
+		},
+		{
+			name: "valid msg with one rate",
+			message: types.MsgUpdateRates{
+				Sender:             "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Denom:              "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				SendCommissionRate: &rate,
+			},
+		},
+		{
+			name: "invalid sender address",
+			message: types.MsgUpdateRates{
+				Sender:   "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5+",
+				Denom:    "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				BurnRate: &rate,
+			},
+			expectedError: cosmoserrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid denom",
+			message: types.MsgUpdateRates{
+				Sender:   "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Denom:    "abc",
+				BurnRate: &rate,
+			},
+			expectedError: types.ErrInvalidDenom,
+		},
+		{
+			name: "no rates",
+			message: types.MsgUpdateRates{
+				Sender: "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Denom:  "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid burn rate",
+			message: types.MsgUpdateRates{
+				Sender:   "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Denom:    "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				BurnRate: &invalidRate,
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid send commission rate",
+			message: types.MsgUpdateRates{
+				Sender:             "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Denom:              "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				SendCommissionRate: &invalidRate,
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			assertT := assert.New(t)
+			err := tc.message.ValidateBasic()
+			if tc.expectedError == nil {
+				assertT.NoError(err)
+			} else {
+				assertT.True(sdkerrors.IsOf(err, tc.expectedError))
+			}
+		})
+	}
+}
+
+func TestMsgSetTransferLimit_ValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name          string
+		message       types.MsgSetTransferLimit
+		expectedError error
+	}{
+		{
+			name: "valid msg",
+			message: types.MsgSetTransferLimit{
+				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Account: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq",
+				Denom:   "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Amount:  sdkmath.NewInt(100),
+				Period:  time.Hour,
+			},
+		},
+		{
+			name: "valid default limit",
+			message: types.MsgSetTransferLimit{
+				Sender: "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Denom:  "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Amount: sdkmath.ZeroInt(),
+				Period: time.Hour,
+			},
+		},
+		{
+			name: "invalid sender address",
+			message: types.MsgSetTransferLimit{
+				Sender: "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5+",
+				Denom:  "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Amount: sdkmath.NewInt(100),
+				Period: time.Hour,
+			},
+			expectedError: cosmoserrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid account",
+			message: types.MsgSetTransferLimit{
+				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Account: "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq+",
+				Denom:   "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Amount:  sdkmath.NewInt(100),
+				Period:  time.Hour,
+			},
+			expectedError: cosmoserrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid denom",
+			message: types.MsgSetTransferLimit{
+				Sender: "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Denom:  "abc",
+				Amount: sdkmath.NewInt(100),
+				Period: time.Hour,
+			},
+			expectedError: types.ErrInvalidDenom,
+		},
+		{
+			name: "negative amount",
+			message: types.MsgSetTransferLimit{
+				Sender: "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Denom:  "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Amount: sdkmath.NewInt(-1),
+				Period: time.Hour,
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "too short period",
+			message: types.MsgSetTransferLimit{
+				Sender: "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Denom:  "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Amount: sdkmath.NewInt(100),
+				Period: time.Second,
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			assertT := assert.New(t)
+			err := tc.message.ValidateBasic()
+			if tc.expectedError == nil {
+				assertT.NoError(err)
+			} else {
+				assertT.True(sdkerrors.IsOf(err, tc.expectedError))
+			}
+		})
+	}
+}
+
+func TestMsgDistribute_ValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name          string
+		message       types.MsgDistribute
+		expectedError error
+	}{
+		{
+			name: "valid msg",
+			message: types.MsgDistribute{
+				Sender: "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Denom:  "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Amount: sdk.NewInt64Coin("ucore", 100),
+			},
+		},
+		{
+			name: "invalid sender address",
+			message: types.MsgDistribute{
+				Sender: "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5+",
+				Denom:  "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Amount: sdk.NewInt64Coin("ucore", 100),
+			},
+			expectedError: cosmoserrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid denom",
+			message: types.MsgDistribute{
+				Sender: "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Denom:  "abc",
+				Amount: sdk.NewInt64Coin("ucore", 100),
+			},
+			expectedError: types.ErrInvalidDenom,
+		},
+		{
+			name: "zero amount",
+			message: types.MsgDistribute{
+				Sender: "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Denom:  "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Amount: sdk.NewInt64Coin("ucore", 0),
+			},
+			expectedError: cosmoserrors.ErrInvalidCoins,
+		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			assertT := assert.New(t)
+			err := tc.message.ValidateBasic()
+			if tc.expectedError == nil {
+				assertT.NoError(err)
+			} else {
+				assertT.True(sdkerrors.IsOf(err, tc.expectedError))
+			}
+		})
+	}
+}
+
+func TestMsgMultiFreeze_ValidateBasic(t *testing.T) {
+	type M = types.MsgMultiFreeze
+
+	acc := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	account1 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	account2 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	denom := "abc" + "-" + acc.String()
+	defaultMsg := func() M {
+		return M{
+			Sender: acc.String(),
+			Entries: []types.AccountCoin{
+				{Account: account1.String(), Coin: sdk.NewCoin(denom, sdkmath.NewInt(100))},
+				{Account: account2.String(), Coin: sdk.NewCoin(denom, sdkmath.NewInt(200))},
+			},
+		}
+	}
+
+	testCases := []struct {
+		name        string
+		modifyMsg   func(M) M
+		expectError bool
+	}{
+		{
+			name:      "all is good",
+			modifyMsg: func(m M) M { return m },
+		},
+		{
+			name:        "invalid sender address",
+			modifyMsg:   func(m M) M { m.Sender = "invalid sender"; return m },
+			expectError: true,
+		},
+		{
+			name:        "no entries",
+			modifyMsg:   func(m M) M { m.Entries = nil; return m },
+			expectError: true,
+		},
+		{
+			name:        "invalid account address",
+			modifyMsg:   func(m M) M { m.Entries[1].Account = "invalid account"; return m },
+			expectError: true,
+		},
+		{
+			name:        "invalid denom",
+			modifyMsg:   func(m M) M { m.Entries[1].Coin = sdk.NewCoin("abc", sdkmath.NewInt(200)); return m },
+			expectError: true,
+		},
+		{
+			name:        "invalid coin",
+			modifyMsg:   func(m M) M { m.Entries[1].Coin = sdk.Coin{Denom: denom, Amount: sdkmath.NewInt(-1)}; return m },
+			expectError: true,
+		},
+		{
+			name:        "duplicated entry",
+			modifyMsg:   func(m M) M { m.Entries[1].Account = account1.String(); return m },
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			requireT := require.New(t)
+			msg := tc.modifyMsg(defaultMsg())
+			if tc.expectError {
+				requireT.Error(msg.ValidateBasic())
+			} else {
+				requireT.NoError(msg.ValidateBasic())
+			}
+		})
+	}
+}
+
+func TestMsgBlockAccounts_ValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name          string
+		message       types.MsgBlockAccounts
+		expectedError error
+	}{
+		{
+			name: "valid msg",
+			message: types.MsgBlockAccounts{
+				Sender:   "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Denom:    "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Accounts: []string{"devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq"},
+			},
+		},
+		{
+			name: "invalid sender address",
+			message: types.MsgBlockAccounts{
+				Sender:   "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5+",
+				Denom:    "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Accounts: []string{"devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq"},
+			},
+			expectedError: cosmoserrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid denom",
+			message: types.MsgBlockAccounts{
+				Sender:   "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Denom:    "abc",
+				Accounts: []string{"devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq"},
+			},
+			expectedError: types.ErrInvalidDenom,
+		},
+		{
+			name: "invalid account",
+			message: types.MsgBlockAccounts{
+				Sender:   "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Denom:    "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Accounts: []string{"devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq+"},
+			},
+			expectedError: cosmoserrors.ErrInvalidAddress,
+		},
+		{
+			name: "no accounts",
+			message: types.MsgBlockAccounts{
+				Sender: "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Denom:  "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "duplicated accounts",
+			message: types.MsgBlockAccounts{
+				Sender: "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Denom:  "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Accounts: []string{
+					"devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq",
+					"devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq",
+				},
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			assertT := assert.New(t)
+			err := tc.message.ValidateBasic()
+			if tc.expectedError == nil {
+				assertT.NoError(err)
+			} else {
+				assertT.True(sdkerrors.IsOf(err, tc.expectedError))
+			}
+		})
+	}
+}
+
+func TestMsgUpgradeToken_ValidateBasic(t *testing.T) {
+	burnRate := sdk.MustNewDecFromStr("0.1")
+	invalidRate := sdk.MustNewDecFromStr("1.1")
+	testCases := []struct {
+		name          string
+		message       types.MsgUpgradeToken
+		expectedError error
+	}{
+		{
+			name: "valid v1 msg",
+			message: types.MsgUpgradeToken{
+				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Denom:   "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Version: 1,
+				Options: types.TokenUpgradeOptions{
+					V1: &types.TokenUpgradeV1Options{IbcEnabled: true},
+				},
+			},
+		},
+		{
+			name: "valid v2 msg",
+			message: types.MsgUpgradeToken{
+				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Denom:   "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Version: 2,
+				Options: types.TokenUpgradeOptions{
+					V2: &types.TokenUpgradeV2Options{
+						Features: []types.Feature{types.Feature_freezing},
+						BurnRate: &burnRate,
+					},
+				},
+			},
+		},
+		{
+			name: "invalid sender address",
+			message: types.MsgUpgradeToken{
+				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5+",
+				Denom:   "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Version: 1,
+				Options: types.TokenUpgradeOptions{
+					V1: &types.TokenUpgradeV1Options{},
+				},
+			},
+			expectedError: cosmoserrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid denom",
+			message: types.MsgUpgradeToken{
+				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Denom:   "abc",
+				Version: 1,
+				Options: types.TokenUpgradeOptions{
+					V1: &types.TokenUpgradeV1Options{},
+				},
+			},
+			expectedError: types.ErrInvalidDenom,
+		},
+		{
+			name: "zero version",
+			message: types.MsgUpgradeToken{
+				Sender: "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Denom:  "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Options: types.TokenUpgradeOptions{
+					V1: &types.TokenUpgradeV1Options{},
+				},
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "no options",
+			message: types.MsgUpgradeToken{
+				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Denom:   "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Version: 2,
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "options of multiple versions",
+			message: types.MsgUpgradeToken{
+				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Denom:   "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Version: 2,
+				Options: types.TokenUpgradeOptions{
+					V1: &types.TokenUpgradeV1Options{},
+					V2: &types.TokenUpgradeV2Options{BurnRate: &burnRate},
+				},
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "empty v2 options",
+			message: types.MsgUpgradeToken{
+				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Denom:   "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Version: 2,
+				Options: types.TokenUpgradeOptions{
+					V2: &types.TokenUpgradeV2Options{},
+				},
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "duplicated features",
+			message: types.MsgUpgradeToken{
+				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Denom:   "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Version: 2,
+				Options: types.TokenUpgradeOptions{
+					V2: &types.TokenUpgradeV2Options{
+						Features: []types.Feature{types.Feature_freezing, types.Feature_freezing},
+					},
+				},
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid burn rate",
+			message: types.MsgUpgradeToken{
+				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Denom:   "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Version: 2,
+				Options: types.TokenUpgradeOptions{
+					V2: &types.TokenUpgradeV2Options{BurnRate: &invalidRate},
+				},
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid send commission rate",
+			message: types.MsgUpgradeToken{
+				Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Denom:   "abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Version: 2,
+				Options: types.TokenUpgradeOptions{
+					V2: &types.TokenUpgradeV2Options{SendCommissionRate: &invalidRate},
+				},
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			assertT := assert.New(t)
+			err := tc.message.ValidateBasic()
+			if tc.expectedError == nil {
+				assertT.NoError(err)
+			} else {
+				assertT.True(sdkerrors.IsOf(err, tc.expectedError))
+			}
+		})
+	}
+}
+
+func TestMsgCreateVestingSchedule_ValidateBasic(t *testing.T) {
+	startTime := time.Unix(1735689600, 0)
+	endTime := startTime.Add(time.Hour)
+
+	testCases := []struct {
+		name          string
+		message       types.MsgCreateVestingSchedule
+		expectedError error
+	}{
+		{
+			name: "valid linear msg",
+			message: types.MsgCreateVestingSchedule{
+				Sender:      "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Account:     "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq",
+				Coin:        sdk.NewInt64Coin("abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5", 100),
+				VestingType: types.VestingType_linear,
+				StartTime:   startTime,
+				EndTime:     &endTime,
+			},
+		},
+		{
+			name: "valid periodic msg",
+			message: types.MsgCreateVestingSchedule{
+				Sender:      "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Account:     "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq",
+				Coin:        sdk.NewInt64Coin("abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5", 100),
+				VestingType: types.VestingType_periodic,
+				StartTime:   startTime,
+				Periods: []types.VestingPeriod{
+					{Length: time.Hour, Amount: sdkmath.NewInt(40)},
+					{Length: time.Hour, Amount: sdkmath.NewInt(60)},
+				},
+			},
+		},
+		{
+			name: "invalid sender address",
+			message: types.MsgCreateVestingSchedule{
+				Sender:      "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5+",
+				Account:     "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq",
+				Coin:        sdk.NewInt64Coin("abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5", 100),
+				VestingType: types.VestingType_linear,
+				StartTime:   startTime,
+				EndTime:     &endTime,
+			},
+			expectedError: cosmoserrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid account address",
+			message: types.MsgCreateVestingSchedule{
+				Sender:      "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Account:     "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq+",
+				Coin:        sdk.NewInt64Coin("abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5", 100),
+				VestingType: types.VestingType_linear,
+				StartTime:   startTime,
+				EndTime:     &endTime,
+			},
+			expectedError: cosmoserrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid denom",
+			message: types.MsgCreateVestingSchedule{
+				Sender:      "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Account:     "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq",
+				Coin:        sdk.NewInt64Coin("abc", 100),
+				VestingType: types.VestingType_linear,
+				StartTime:   startTime,
+				EndTime:     &endTime,
+			},
+			expectedError: types.ErrInvalidDenom,
+		},
+		{
+			name: "zero amount",
+			message: types.MsgCreateVestingSchedule{
+				Sender:      "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Account:     "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq",
+				Coin:        sdk.NewInt64Coin("abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5", 0),
+				VestingType: types.VestingType_linear,
+				StartTime:   startTime,
+				EndTime:     &endTime,
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "missing end time",
+			message: types.MsgCreateVestingSchedule{
+				Sender:      "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Account:     "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq",
+				Coin:        sdk.NewInt64Coin("abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5", 100),
+				VestingType: types.VestingType_cliff,
+				StartTime:   startTime,
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "end time before start time",
+			message: types.MsgCreateVestingSchedule{
+				Sender:      "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Account:     "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq",
+				Coin:        sdk.NewInt64Coin("abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5", 100),
+				VestingType: types.VestingType_linear,
+				StartTime:   endTime,
+				EndTime:     &startTime,
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "periods set for linear schedule",
+			message: types.MsgCreateVestingSchedule{
+				Sender:      "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Account:     "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq",
+				Coin:        sdk.NewInt64Coin("abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5", 100),
+				VestingType: types.VestingType_linear,
+				StartTime:   startTime,
+				EndTime:     &endTime,
+				Periods: []types.VestingPeriod{
+					{Length: time.Hour, Amount: sdkmath.NewInt(100)},
+				},
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "end time set for periodic schedule",
+			message: types.MsgCreateVestingSchedule{
+				Sender:      "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Account:     "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq",
+				Coin:        sdk.NewInt64Coin("abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5", 100),
+				VestingType: types.VestingType_periodic,
+				StartTime:   startTime,
+				EndTime:     &endTime,
+				Periods: []types.VestingPeriod{
+					{Length: time.Hour, Amount: sdkmath.NewInt(100)},
+				},
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "periods amount mismatch",
+			message: types.MsgCreateVestingSchedule{
+				Sender:      "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Account:     "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq",
+				Coin:        sdk.NewInt64Coin("abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5", 100),
+				VestingType: types.VestingType_periodic,
+				StartTime:   startTime,
+				Periods: []types.VestingPeriod{
+					{Length: time.Hour, Amount: sdkmath.NewInt(40)},
+					{Length: time.Hour, Amount: sdkmath.NewInt(50)},
+				},
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "zero period length",
+			message: types.MsgCreateVestingSchedule{
+				Sender:      "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				Account:     "devcore1k3mke3gyf9apyd8vxveutgp9h4j2e80e05yfuq",
+				Coin:        sdk.NewInt64Coin("abc-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5", 100),
+				VestingType: types.VestingType_periodic,
+				StartTime:   startTime,
+				Periods: []types.VestingPeriod{
+					{Length: 0, Amount: sdkmath.NewInt(100)},
+				},
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			assertT := assert.New(t)
+			err := tc.message.ValidateBasic()
+			if tc.expectedError == nil {
+				assertT.NoError(err)
+			} else {
+				assertT.True(sdkerrors.IsOf(err, tc.expectedError))
+			}
+		})
+	}
+}
+
+func TestAmino(t *testing.T) {
+	const address = "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"
+	coin := sdk.NewInt64Coin("my-denom", 1)
+	rate := sdk.MustNewDecFromStr("0.1")
+
+	tests := []struct {
+		name          string
+		msg           legacytx.LegacyMsg
+		wantAminoJSON string
+	}{
+		{
+			name: types.TypeMsgIssue,
+			msg: &types.MsgIssue{
+				Issuer: address,
+				Symbol: "ABC",
+			},
+			wantAminoJSON: `{"type":"assetft/MsgIssue","value":{"burn_rate":"0","initial_amount":"0","issuer":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5","send_commission_rate":"0","symbol":"ABC"}}`,
+		},
+		{
+			name: types.TypeMsgMint,
+			msg: &types.MsgMint{
+				Sender: address,
+				Coin:   coin,
+			},
+			wantAminoJSON: `{"type":"assetft/MsgMint","value":{"coin":{"amount":"1","denom":"my-denom"},"sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"}}`,
+		},
+		{
+			name: types.TypeMsgBurn,
+			msg: &types.MsgBurn{
+				Sender: address,
+				Coin:   coin,
+			},
+			wantAminoJSON: `{"type":"assetft/MsgBurn","value":{"coin":{"amount":"1","denom":"my-denom"},"sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"}}`,
+		},
+		{
+			name: types.TypeMsgFreeze,
+			msg: &types.MsgFreeze{
+				Sender: address,
+				Coin:   coin,
+			},
+			wantAminoJSON: `{"type":"assetft/MsgFreeze","value":{"coin":{"amount":"1","denom":"my-denom"},"sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"}}`,
+		},
+		{
+			name: types.TypeMsgUnfreeze,
+			msg: &types.MsgUnfreeze{
+				Sender: address,
+				Coin:   coin,
+			},
+			wantAminoJSON: `{"type":"assetft/MsgUnfreeze","value":{"coin":{"amount":"1","denom":"my-denom"},"sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"}}`,
+		},
+		{
+			name: types.TypeMsgUnfreeze,
+			msg: &types.MsgUnfreeze{
+				Sender: address,
+				Coin:   coin,
+			},
+			wantAminoJSON: `{"type":"assetft/MsgUnfreeze","value":{"coin":{"amount":"1","denom":"my-denom"},"sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"}}`,
+		},
+		{
+			name: types.TypeMsgGloballyFreeze,
+			msg: &types.MsgGloballyFreeze{
+				Sender: address,
+				Denom:  coin.Denom,
+			},
+			wantAminoJSON: `{"type":"assetft/MsgGloballyFreeze","value":{"denom":"my-denom","sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"}}`,
+		},
+		{
+			name: types.TypeMsgGloballyUnfreeze,
+			msg: &types.MsgGloballyUnfreeze{
+				Sender: address,
+				Denom:  coin.Denom,
+			},
+			wantAminoJSON: `{"type":"assetft/MsgGloballyUnfreeze","value":{"denom":"my-denom","sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"}}`,
+		},
+		{
+			name: types.TypeMsgUnfreeze,
+			msg: &types.MsgUnfreeze{
+				Sender: address,
+				Coin:   coin,
+			},
+			wantAminoJSON: `{"type":"assetft/MsgUnfreeze","value":{"coin":{"amount":"1","denom":"my-denom"},"sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"}}`,
+		},
+		{
+			name: types.TypeMsgSetWhitelistedLimit,
+			msg: &types.MsgSetWhitelistedLimit{
+				Sender:  address,
+				Account: address,
+				Coin:    coin,
+			},
+			wantAminoJSON: `{"type":"assetft/MsgSetWhitelistedLimit","value":{"account":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5","coin":{"amount":"1","denom":"my-denom"},"sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"}}`,
+		},
+		{
+			name: types.TypeMsgMultiMint,
+			msg: &types.MsgMultiMint{
+				Sender: address,
+				Entries: []types.AccountCoin{
+					{Account: address, Coin: coin},
+				},
+			},
+			wantAminoJSON: `{"type":"assetft/MsgMultiMint","value":{"entries":[{"account":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5","coin":{"amount":"1","denom":"my-denom"}}],"sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"}}`,
+		},
+		{
+			name: types.TypeMsgMultiFreeze,
+			msg: &types.MsgMultiFreeze{
+				Sender: address,
+				Entries: []types.AccountCoin{
+					{Account: address, Coin: coin},
+				},
+			},
+			wantAminoJSON: `{"type":"assetft/MsgMultiFreeze","value":{"entries":[{"account":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5","coin":{"amount":"1","denom":"my-denom"}}],"sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"}}`,
+		},
+		{
+			name: types.TypeMsgMultiSetWhitelistedLimit,
+			msg: &types.MsgMultiSetWhitelistedLimit{
+				Sender: address,
+				Entries: []types.AccountCoin{
+					{Account: address, Coin: coin},
+				},
+			},
+			wantAminoJSON: `{"type":"assetft/MsgMultiSetWhitelistedLimit","value":{"entries":[{"account":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5","coin":{"amount":"1","denom":"my-denom"}}],"sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"}}`,
+		},
+		{
+			name: types.TypeMsgUpgradeTokenV1,
+			msg: &types.MsgUpgradeTokenV1{
+				Sender:     address,
+				Denom:      coin.Denom,
+				IbcEnabled: false,
 			},
 			wantAminoJSON: `{"type":"assetft/MsgUpgradeTokenV1","value":{"denom":"my-denom","sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"}}`,
 		},
+		{
+			name: types.TypeMsgUpgradeToken,
+			msg: &types.MsgUpgradeToken{
+				Sender:  address,
+				Denom:   coin.Denom,
+				Version: 2,
+				Options: types.TokenUpgradeOptions{
+					V2: &types.TokenUpgradeV2Options{
+						Features: []types.Feature{types.Feature_freezing},
+						BurnRate: &rate,
+					},
+				},
+			},
+			wantAminoJSON: `{"type":"assetft/MsgUpgradeToken","value":{"denom":"my-denom","options":{"v2":{"burn_rate":"0.100000000000000000","features":[2]}},"sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5","version":2}}`,
+		},
+		{
+			name: types.TypeMsgTransferAdmin,
+			msg: &types.MsgTransferAdmin{
+				Sender:  address,
+				Account: address,
+				Denom:   coin.Denom,
+			},
+			wantAminoJSON: `{"type":"assetft/MsgTransferAdmin","value":{"account":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5","denom":"my-denom","sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"}}`,
+		},
+		{
+			name: types.TypeMsgClearAdmin,
+			msg: &types.MsgClearAdmin{
+				Sender: address,
+				Denom:  coin.Denom,
+			},
+			wantAminoJSON: `{"type":"assetft/MsgClearAdmin","value":{"denom":"my-denom","sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"}}`,
+		},
+		{
+			name: types.TypeMsgUpdateMetadata,
+			msg: &types.MsgUpdateMetadata{
+				Sender: address,
+				Denom:  coin.Denom,
+				URI:    "https://my.invalid",
+			},
+			wantAminoJSON: `{"type":"assetft/MsgUpdateMetadata","value":{"denom":"my-denom","sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5","uri":"https://my.invalid"}}`,
+		},
+		{
+			name: types.TypeMsgSetRateExemption,
+			msg: &types.MsgSetRateExemption{
+				Sender:  address,
+				Account: address,
+				Denom:   coin.Denom,
+			},
+			wantAminoJSON: `{"type":"assetft/MsgSetRateExemption","value":{"account":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5","denom":"my-denom","sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"}}`,
+		},
+		{
+			name: types.TypeMsgRemoveRateExemption,
+			msg: &types.MsgRemoveRateExemption{
+				Sender:  address,
+				Account: address,
+				Denom:   coin.Denom,
+			},
+			wantAminoJSON: `{"type":"assetft/MsgRemoveRateExemption","value":{"account":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5","denom":"my-denom","sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"}}`,
+		},
+		{
+			name: types.TypeMsgUpdateRates,
+			msg: &types.MsgUpdateRates{
+				Sender:   address,
+				Denom:    coin.Denom,
+				BurnRate: &rate,
+			},
+			wantAminoJSON: `{"type":"assetft/MsgUpdateRates","value":{"burn_rate":"0.100000000000000000","denom":"my-denom","sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"}}`,
+		},
+		{
+			name: types.TypeMsgSetTransferLimit,
+			msg: &types.MsgSetTransferLimit{
+				Sender:  address,
+				Account: address,
+				Denom:   coin.Denom,
+				Amount:  sdkmath.NewInt(100),
+				Period:  time.Hour,
+			},
+			wantAminoJSON: `{"type":"assetft/MsgSetTransferLimit","value":{"account":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5","amount":"100","denom":"my-denom","period":"3600000000000","sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"}}`,
+		},
+		{
+			name: types.TypeMsgRemoveTransferLimit,
+			msg: &types.MsgRemoveTransferLimit{
+				Sender:  address,
+				Account: address,
+				Denom:   coin.Denom,
+			},
+			wantAminoJSON: `{"type":"assetft/MsgRemoveTransferLimit","value":{"account":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5","denom":"my-denom","sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"}}`,
+		},
+		{
+			name: types.TypeMsgDistribute,
+			msg: &types.MsgDistribute{
+				Sender: address,
+				Denom:  coin.Denom,
+				Amount: coin,
+			},
+			wantAminoJSON: `{"type":"assetft/MsgDistribute","value":{"amount":{"amount":"1","denom":"my-denom"},"denom":"my-denom","sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"}}`,
+		},
+		{
+			name: types.TypeMsgBlockAccounts,
+			msg: &types.MsgBlockAccounts{
+				Sender:   address,
+				Denom:    coin.Denom,
+				Accounts: []string{address},
+			},
+			wantAminoJSON: `{"type":"assetft/MsgBlockAccounts","value":{"accounts":["devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"],"denom":"my-denom","sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"}}`,
+		},
+		{
+			name: types.TypeMsgUnblockAccounts,
+			msg: &types.MsgUnblockAccounts{
+				Sender:   address,
+				Denom:    coin.Denom,
+				Accounts: []string{address},
+			},
+			wantAminoJSON: `{"type":"assetft/MsgUnblockAccounts","value":{"accounts":["devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"],"denom":"my-denom","sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"}}`,
+		},
+		{
+			name: types.TypeMsgCreateVestingSchedule,
+			msg: &types.MsgCreateVestingSchedule{
+				Sender:      address,
+				Account:     address,
+				Coin:        coin,
+				VestingType: types.VestingType_periodic,
+				StartTime:   time.Unix(1735689600, 0).UTC(),
+				Periods: []types.VestingPeriod{
+					{Length: time.Hour, Amount: sdkmath.NewInt(1)},
+				},
+			},
+			wantAminoJSON: `{"type":"assetft/MsgCreateVestingSchedule","value":{"account":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5","coin":{"amount":"1","denom":"my-denom"},"periods":[{"amount":"1","length":"3600000000000"}],"sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5","start_time":"2025-01-01T00:00:00Z","vesting_type":2}}`,
+		},
 	}
 	for _, tt := range tests {
 		tt := tt
@@ -11,6 +11,10 @@ import (
 // DefaultTokenUpgradeGracePeriod is the period after which upgrade is effectively executed.
 const DefaultTokenUpgradeGracePeriod = time.Hour * 24 * 7
 
+// DefaultMaxDistributionOperationsPerBlock is the default maximum number of holders paid by the distributions
+// in a single block.
+const DefaultMaxDistributionOperationsPerBlock = 100
+
 // DefaultTokenUpgradeDecisionTimeout is the timeout for a decision to upgrade the token.
 var DefaultTokenUpgradeDecisionTimeout = time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC)
 
@@ -28,9 +32,10 @@ var (
 // DefaultParams returns params with default values.
 func DefaultParams() Params {
 	return Params{
-		IssueFee:                    sdk.NewInt64Coin(sdk.DefaultBondDenom, 0),
-		TokenUpgradeDecisionTimeout: DefaultTokenUpgradeDecisionTimeout,
-		TokenUpgradeGracePeriod:     DefaultTokenUpgradeGracePeriod,
+		IssueFee:                          sdk.NewInt64Coin(sdk.DefaultBondDenom, 0),
+		TokenUpgradeDecisionTimeout:       DefaultTokenUpgradeDecisionTimeout,
+		TokenUpgradeGracePeriod:           DefaultTokenUpgradeGracePeriod,
+		MaxDistributionOperationsPerBlock: DefaultMaxDistributionOperationsPerBlock,
 	}
 }
 
@@ -52,7 +57,13 @@ func (m Params) ValidateBasic() error {
 	if err := validateTokenUpgradeDecisionTimeout(m.TokenUpgradeDecisionTimeout); err != nil {
 		return err
 	}
-	return validateTokenUpgradeGracePeriod(m.TokenUpgradeGracePeriod)
+	if err := validateTokenUpgradeGracePeriod(m.TokenUpgradeGracePeriod); err != nil {
+		return err
+	}
+	if m.MaxDistributionOperationsPerBlock == 0 {
+		return sdkerrors.Wrap(ErrInvalidInput, "max distribution operations per block must be positive")
+	}
+	return nil
 }
 
 func validateIssueFee(i interface{}) error {
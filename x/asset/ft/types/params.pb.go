@@ -37,6 +37,8 @@ type Params struct {
 	TokenUpgradeDecisionTimeout time.Time `protobuf:"bytes,2,opt,name=token_upgrade_decision_timeout,json=tokenUpgradeDecisionTimeout,proto3,stdtime" json:"token_upgrade_decision_timeout" yaml:"token_upgrade_decision_timeout"`
 	// token_upgrade_grace_period the period after which the token upgrade is executed effectively.
 	TokenUpgradeGracePeriod time.Duration `protobuf:"bytes,3,opt,name=token_upgrade_grace_period,json=tokenUpgradeGracePeriod,proto3,stdduration" json:"token_upgrade_grace_period" yaml:"token_upgrade_grace_period"`
+	// max_distribution_operations_per_block is the maximum number of holders paid by the distributions in a single block.
+	MaxDistributionOperationsPerBlock uint32 `protobuf:"varint,4,opt,name=max_distribution_operations_per_block,json=maxDistributionOperationsPerBlock,proto3" json:"max_distribution_operations_per_block,omitempty" yaml:"max_distribution_operations_per_block"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -93,6 +95,13 @@ func (m *Params) GetTokenUpgradeGracePeriod() time.Duration {
 	return 0
 }
 
+func (m *Params) GetMaxDistributionOperationsPerBlock() uint32 {
+	if m != nil {
+		return m.MaxDistributionOperationsPerBlock
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "coreum.asset.ft.v1.Params")
 }
@@ -100,32 +109,36 @@ func init() {
 func init() { proto.RegisterFile("coreum/asset/ft/v1/params.proto", fileDescriptor_b08ee2013666b045) }
 
 var fileDescriptor_b08ee2013666b045 = []byte{
-	// 397 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0x31, 0x8b, 0xd5, 0x40,
-	0x14, 0x85, 0x33, 0x2b, 0x2c, 0x1a, 0x1b, 0x09, 0x82, 0x31, 0xc2, 0x44, 0x03, 0x82, 0x8d, 0x33,
-	0xc4, 0x05, 0x0b, 0xcb, 0xec, 0xb2, 0xb6, 0x61, 0x59, 0x1b, 0x9b, 0x30, 0x49, 0x6e, 0xe2, 0xe0,
-	0x26, 0x37, 0x64, 0x66, 0x82, 0xfb, 0x03, 0xec, 0x17, 0x2b, 0x7f, 0xd2, 0x96, 0x5b, 0x5a, 0x3d,
-	0xe5, 0xbd, 0x7f, 0x60, 0x63, 0x2b, 0x99, 0xcc, 0xd3, 0xe7, 0x43, 0xb6, 0x9b, 0x70, 0xbe, 0x7b,
-	0xcf, 0x39, 0xe1, 0xfa, 0x71, 0x85, 0x23, 0x98, 0x8e, 0x0b, 0xa5, 0x40, 0xf3, 0x46, 0xf3, 0x29,
-	0xe5, 0x83, 0x18, 0x45, 0xa7, 0xd8, 0x30, 0xa2, 0xc6, 0x20, 0x58, 0x00, 0x66, 0x01, 0xd6, 0x68,
-	0x36, 0xa5, 0xd1, 0xc3, 0x16, 0x5b, 0xb4, 0x32, 0x9f, 0x5f, 0x0b, 0x19, 0xc5, 0x2d, 0x62, 0x7b,
-	0x01, 0xdc, 0x7e, 0x95, 0xa6, 0xe1, 0x5a, 0x76, 0xa0, 0xb4, 0xe8, 0x06, 0x07, 0xd0, 0x7d, 0xa0,
-	0x36, 0xa3, 0xd0, 0x12, 0xfb, 0xad, 0x5e, 0xa1, 0xea, 0x50, 0xf1, 0x52, 0x28, 0xe0, 0x53, 0x5a,
-	0x82, 0x16, 0x29, 0xaf, 0x50, 0x3a, 0x3d, 0xf9, 0x75, 0xe0, 0x1f, 0xe6, 0x36, 0x5b, 0x90, 0xfb,
-	0xf7, 0xa4, 0x52, 0x06, 0x8a, 0x06, 0x20, 0x24, 0x4f, 0xc9, 0x8b, 0xfb, 0xaf, 0x1e, 0xb3, 0x65,
-	0x9c, 0xcd, 0xe3, 0xcc, 0x8d, 0xb3, 0x63, 0x94, 0x7d, 0x16, 0x5e, 0xaf, 0x62, 0xef, 0xe7, 0x2a,
-	0x7e, 0x70, 0x29, 0xba, 0x8b, 0x37, 0xc9, 0x9f, 0xc9, 0xe4, 0xec, 0xae, 0x7d, 0x9f, 0x02, 0x04,
-	0x5f, 0x88, 0x4f, 0x35, 0x7e, 0x84, 0xbe, 0x30, 0x43, 0x3b, 0x8a, 0x1a, 0x8a, 0x1a, 0x2a, 0xa9,
-	0x24, 0xf6, 0xc5, 0xdc, 0x03, 0x8d, 0x0e, 0x0f, 0xac, 0x4f, 0xc4, 0x96, 0x1a, 0x6c, 0x5b, 0x83,
-	0x9d, 0x6f, 0x7b, 0x66, 0xa9, 0x33, 0x7a, 0xbe, 0x18, 0xdd, 0xbe, 0x2f, 0xb9, 0xfa, 0x1e, 0x93,
-	0xb3, 0x27, 0x16, 0x7a, 0xb7, 0x30, 0x27, 0x0e, 0x39, 0x5f, 0x88, 0xe0, 0x33, 0xf1, 0xa3, 0x7f,
-	0x97, 0xb4, 0xa3, 0xa8, 0xa0, 0x18, 0x60, 0x94, 0x58, 0x87, 0x77, 0x5c, 0xf1, 0xfd, 0x40, 0x27,
-	0xee, 0xbf, 0x66, 0x2f, 0x5d, 0x9e, 0x67, 0xff, 0xcb, 0xb3, 0xbb, 0x2a, 0xf9, 0x3a, 0x67, 0x79,
-	0xb4, 0x9b, 0xe5, 0xed, 0x2c, 0xe7, 0x56, 0xcd, 0xf2, 0xeb, 0x35, 0x25, 0x37, 0x6b, 0x4a, 0x7e,
-	0xac, 0x29, 0xb9, 0xda, 0x50, 0xef, 0x66, 0x43, 0xbd, 0x6f, 0x1b, 0xea, 0xbd, 0x7f, 0xdd, 0x4a,
-	0xfd, 0xc1, 0x94, 0xac, 0xc2, 0x8e, 0x1f, 0xdb, 0x4b, 0x39, 0x45, 0xd3, 0xd7, 0xd6, 0x9e, 0xbb,
-	0xdb, 0x9a, 0x8e, 0xf8, 0xa7, 0xbf, 0x07, 0xa6, 0x2f, 0x07, 0x50, 0xe5, 0xa1, 0x0d, 0x7b, 0xf4,
-	0x3b, 0x00, 0x00, 0xff, 0xff, 0xfc, 0x0a, 0xdd, 0x81, 0x80, 0x02, 0x00, 0x00,
+	// 455 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xc1, 0x6b, 0xd4, 0x40,
+	0x14, 0xc6, 0x77, 0xac, 0x14, 0x8d, 0x08, 0x12, 0x04, 0xd7, 0x15, 0x92, 0x36, 0x50, 0xe8, 0x41,
+	0x67, 0x8c, 0x05, 0x0f, 0x1e, 0xd3, 0xa5, 0x1e, 0x0d, 0x4b, 0xbd, 0x78, 0x09, 0x93, 0xe4, 0x6d,
+	0x1c, 0xba, 0xc9, 0x0b, 0x99, 0x99, 0x65, 0x7b, 0x15, 0xbc, 0x17, 0x4f, 0xfe, 0x49, 0x3d, 0xf6,
+	0xe8, 0x69, 0x95, 0xdd, 0xff, 0xa0, 0x67, 0x0f, 0x92, 0x99, 0xd9, 0xba, 0x16, 0x11, 0x6f, 0x09,
+	0xef, 0xf7, 0xbd, 0xef, 0xfb, 0x86, 0xe7, 0x85, 0x05, 0x76, 0xa0, 0x6b, 0xc6, 0xa5, 0x04, 0xc5,
+	0xa6, 0x8a, 0xcd, 0x63, 0xd6, 0xf2, 0x8e, 0xd7, 0x92, 0xb6, 0x1d, 0x2a, 0xf4, 0x7d, 0x0b, 0x50,
+	0x03, 0xd0, 0xa9, 0xa2, 0xf3, 0x78, 0xf4, 0xb8, 0xc2, 0x0a, 0xcd, 0x98, 0xf5, 0x5f, 0x96, 0x1c,
+	0x85, 0x15, 0x62, 0x35, 0x03, 0x66, 0xfe, 0x72, 0x3d, 0x65, 0x4a, 0xd4, 0x20, 0x15, 0xaf, 0x5b,
+	0x07, 0x04, 0xb7, 0x81, 0x52, 0x77, 0x5c, 0x09, 0x6c, 0x36, 0xf3, 0x02, 0x65, 0x8d, 0x92, 0xe5,
+	0x5c, 0x02, 0x9b, 0xc7, 0x39, 0x28, 0x1e, 0xb3, 0x02, 0x85, 0x9b, 0x47, 0x3f, 0x77, 0xbc, 0xdd,
+	0xd4, 0x64, 0xf3, 0x53, 0xef, 0xbe, 0x90, 0x52, 0x43, 0x36, 0x05, 0x18, 0x92, 0x3d, 0x72, 0xf8,
+	0xe0, 0xd5, 0x53, 0x6a, 0xe5, 0xb4, 0x97, 0x53, 0x27, 0xa7, 0xc7, 0x28, 0x9a, 0x64, 0x78, 0xb9,
+	0x0c, 0x07, 0xd7, 0xcb, 0xf0, 0xd1, 0x39, 0xaf, 0x67, 0x6f, 0xa2, 0x1b, 0x65, 0x34, 0xb9, 0x67,
+	0xbe, 0x4f, 0x00, 0xfc, 0x2f, 0xc4, 0x0b, 0x14, 0x9e, 0x41, 0x93, 0xe9, 0xb6, 0xea, 0x78, 0x09,
+	0x59, 0x09, 0x85, 0x90, 0x02, 0x9b, 0xac, 0xef, 0x81, 0x5a, 0x0d, 0xef, 0x18, 0x9f, 0x11, 0xb5,
+	0x35, 0xe8, 0xa6, 0x06, 0x3d, 0xdd, 0xf4, 0x4c, 0x62, 0x67, 0x74, 0x60, 0x8d, 0xfe, 0xbd, 0x2f,
+	0xba, 0xf8, 0x1e, 0x92, 0xc9, 0x33, 0x03, 0xbd, 0xb7, 0xcc, 0xd8, 0x21, 0xa7, 0x96, 0xf0, 0x3f,
+	0x13, 0x6f, 0xf4, 0xe7, 0x92, 0xaa, 0xe3, 0x05, 0x64, 0x2d, 0x74, 0x02, 0xcb, 0xe1, 0x8e, 0x2b,
+	0x7e, 0x3b, 0xd0, 0xd8, 0xbd, 0x6b, 0xf2, 0xc2, 0xe5, 0xd9, 0xff, 0x5b, 0x9e, 0xed, 0x55, 0xd1,
+	0xd7, 0x3e, 0xcb, 0x93, 0xed, 0x2c, 0x6f, 0xfb, 0x71, 0x6a, 0xa6, 0xfe, 0x27, 0xe2, 0x1d, 0xd4,
+	0x7c, 0x91, 0x95, 0x42, 0xaa, 0x4e, 0xe4, 0xba, 0x5f, 0x9e, 0x61, 0x0b, 0xd6, 0x46, 0xf6, 0x4b,
+	0xb2, 0x7c, 0x86, 0xc5, 0xd9, 0xf0, 0xee, 0x1e, 0x39, 0x7c, 0x98, 0xbc, 0xbc, 0x5e, 0x86, 0xcf,
+	0xad, 0xe7, 0x7f, 0xc9, 0xa2, 0xc9, 0x7e, 0xcd, 0x17, 0xe3, 0x2d, 0xec, 0xdd, 0x0d, 0x95, 0x42,
+	0x97, 0xf4, 0x4c, 0x92, 0x5e, 0xae, 0x02, 0x72, 0xb5, 0x0a, 0xc8, 0x8f, 0x55, 0x40, 0x2e, 0xd6,
+	0xc1, 0xe0, 0x6a, 0x1d, 0x0c, 0xbe, 0xad, 0x83, 0xc1, 0x87, 0xd7, 0x95, 0x50, 0x1f, 0x75, 0x4e,
+	0x0b, 0xac, 0xd9, 0xb1, 0x39, 0xd7, 0x13, 0xd4, 0x4d, 0x69, 0xf4, 0xcc, 0x1d, 0xf8, 0xfc, 0x88,
+	0x2d, 0x7e, 0x5f, 0xb9, 0x3a, 0x6f, 0x41, 0xe6, 0xbb, 0xe6, 0xc5, 0x8e, 0x7e, 0x0d, 0x00, 0xb3,
+	0x43, 0x47, 0xc7, 0x05, 0x03, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -148,6 +161,11 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxDistributionOperationsPerBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxDistributionOperationsPerBlock))
+		i--
+		dAtA[i] = 0x20
+	}
 	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.TokenUpgradeGracePeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TokenUpgradeGracePeriod):])
 	if err1 != nil {
 		return 0, err1
@@ -200,6 +218,9 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovParams(uint64(l))
 	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TokenUpgradeGracePeriod)
 	n += 1 + l + sovParams(uint64(l))
+	if m.MaxDistributionOperationsPerBlock != 0 {
+		n += 1 + sovParams(uint64(m.MaxDistributionOperationsPerBlock))
+	}
 	return n
 }
 
@@ -337,6 +358,25 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDistributionOperationsPerBlock", wireType)
+			}
+			m.MaxDistributionOperationsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDistributionOperationsPerBlock |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -10,9 +10,10 @@ import (
 )
 
 var params = Params{
-	IssueFee:                    sdk.NewInt64Coin(sdk.DefaultBondDenom, 10_000_000),
-	TokenUpgradeGracePeriod:     time.Second,
-	TokenUpgradeDecisionTimeout: time.Date(2023, 3, 2, 1, 11, 12, 13, time.UTC),
+	IssueFee:                          sdk.NewInt64Coin(sdk.DefaultBondDenom, 10_000_000),
+	TokenUpgradeGracePeriod:           time.Second,
+	TokenUpgradeDecisionTimeout:       time.Date(2023, 3, 2, 1, 11, 12, 13, time.UTC),
+	MaxDistributionOperationsPerBlock: DefaultMaxDistributionOperationsPerBlock,
 }
 
 func TestParamsValidation(t *testing.T) {
@@ -45,4 +46,8 @@ func TestParamsValidation(t *testing.T) {
 	testParams = params
 	testParams.TokenUpgradeGracePeriod = -1
 	assert.Error(t, testParams.ValidateBasic())
+
+	testParams = params
+	testParams.MaxDistributionOperationsPerBlock = 0
+	assert.Error(t, testParams.ValidateBasic())
 }
@@ -290,24 +290,25 @@ func (m *QueryTokenUpgradeStatusesResponse) GetStatuses() TokenUpgradeStatuses {
 	return TokenUpgradeStatuses{}
 }
 
-type QueryTokensRequest struct {
+type QueryRateExemptionsRequest struct {
 	// pagination defines an optional pagination for the request.
 	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
-	Issuer     string             `protobuf:"bytes,2,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	// denom specifies the token to query the rate exemptions of
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-func (m *QueryTokensRequest) Reset()         { *m = QueryTokensRequest{} }
-func (m *QueryTokensRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryTokensRequest) ProtoMessage()    {}
-func (*QueryTokensRequest) Descriptor() ([]byte, []int) {
+func (m *QueryRateExemptionsRequest) Reset()         { *m = QueryRateExemptionsRequest{} }
+func (m *QueryRateExemptionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRateExemptionsRequest) ProtoMessage()    {}
+func (*QueryRateExemptionsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e9fe336d9bdb8f05, []int{6}
 }
-func (m *QueryTokensRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryRateExemptionsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryTokensRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryRateExemptionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryTokensRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryRateExemptionsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -317,50 +318,51 @@ func (m *QueryTokensRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryTokensRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryTokensRequest.Merge(m, src)
+func (m *QueryRateExemptionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRateExemptionsRequest.Merge(m, src)
 }
-func (m *QueryTokensRequest) XXX_Size() int {
+func (m *QueryRateExemptionsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryTokensRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryTokensRequest.DiscardUnknown(m)
+func (m *QueryRateExemptionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRateExemptionsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryTokensRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryRateExemptionsRequest proto.InternalMessageInfo
 
-func (m *QueryTokensRequest) GetPagination() *query.PageRequest {
+func (m *QueryRateExemptionsRequest) GetPagination() *query.PageRequest {
 	if m != nil {
 		return m.Pagination
 	}
 	return nil
 }
 
-func (m *QueryTokensRequest) GetIssuer() string {
+func (m *QueryRateExemptionsRequest) GetDenom() string {
 	if m != nil {
-		return m.Issuer
+		return m.Denom
 	}
 	return ""
 }
 
-type QueryTokensResponse struct {
+type QueryRateExemptionsResponse struct {
 	// pagination defines the pagination in the response.
 	Pagination *query.PageResponse `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
-	Tokens     []Token             `protobuf:"bytes,2,rep,name=tokens,proto3" json:"tokens"`
+	// accounts contains the accounts exempted from the rates of the queried token
+	Accounts []string `protobuf:"bytes,2,rep,name=accounts,proto3" json:"accounts,omitempty"`
 }
 
-func (m *QueryTokensResponse) Reset()         { *m = QueryTokensResponse{} }
-func (m *QueryTokensResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryTokensResponse) ProtoMessage()    {}
-func (*QueryTokensResponse) Descriptor() ([]byte, []int) {
+func (m *QueryRateExemptionsResponse) Reset()         { *m = QueryRateExemptionsResponse{} }
+func (m *QueryRateExemptionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRateExemptionsResponse) ProtoMessage()    {}
+func (*QueryRateExemptionsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e9fe336d9bdb8f05, []int{7}
 }
-func (m *QueryTokensResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryRateExemptionsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryTokensResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryRateExemptionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryTokensResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryRateExemptionsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -370,51 +372,51 @@ func (m *QueryTokensResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryTokensResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryTokensResponse.Merge(m, src)
+func (m *QueryRateExemptionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRateExemptionsResponse.Merge(m, src)
 }
-func (m *QueryTokensResponse) XXX_Size() int {
+func (m *QueryRateExemptionsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryTokensResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryTokensResponse.DiscardUnknown(m)
+func (m *QueryRateExemptionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRateExemptionsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryTokensResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryRateExemptionsResponse proto.InternalMessageInfo
 
-func (m *QueryTokensResponse) GetPagination() *query.PageResponse {
+func (m *QueryRateExemptionsResponse) GetPagination() *query.PageResponse {
 	if m != nil {
 		return m.Pagination
 	}
 	return nil
 }
 
-func (m *QueryTokensResponse) GetTokens() []Token {
+func (m *QueryRateExemptionsResponse) GetAccounts() []string {
 	if m != nil {
-		return m.Tokens
+		return m.Accounts
 	}
 	return nil
 }
 
-type QueryBalanceRequest struct {
-	// account specifies the account onto which we query balances
-	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
-	// denom specifies balances on a specific denom
+type QueryBlockedAccountsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// denom specifies the token to query the blocked accounts of
 	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-func (m *QueryBalanceRequest) Reset()         { *m = QueryBalanceRequest{} }
-func (m *QueryBalanceRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryBalanceRequest) ProtoMessage()    {}
-func (*QueryBalanceRequest) Descriptor() ([]byte, []int) {
+func (m *QueryBlockedAccountsRequest) Reset()         { *m = QueryBlockedAccountsRequest{} }
+func (m *QueryBlockedAccountsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBlockedAccountsRequest) ProtoMessage()    {}
+func (*QueryBlockedAccountsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e9fe336d9bdb8f05, []int{8}
 }
-func (m *QueryBalanceRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryBlockedAccountsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryBalanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryBlockedAccountsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryBalanceRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryBlockedAccountsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -424,55 +426,51 @@ func (m *QueryBalanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryBalanceRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryBalanceRequest.Merge(m, src)
+func (m *QueryBlockedAccountsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlockedAccountsRequest.Merge(m, src)
 }
-func (m *QueryBalanceRequest) XXX_Size() int {
+func (m *QueryBlockedAccountsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryBalanceRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryBalanceRequest.DiscardUnknown(m)
+func (m *QueryBlockedAccountsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlockedAccountsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryBalanceRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryBlockedAccountsRequest proto.InternalMessageInfo
 
-func (m *QueryBalanceRequest) GetAccount() string {
+func (m *QueryBlockedAccountsRequest) GetPagination() *query.PageRequest {
 	if m != nil {
-		return m.Account
+		return m.Pagination
 	}
-	return ""
+	return nil
 }
 
-func (m *QueryBalanceRequest) GetDenom() string {
+func (m *QueryBlockedAccountsRequest) GetDenom() string {
 	if m != nil {
 		return m.Denom
 	}
 	return ""
 }
 
-type QueryBalanceResponse struct {
-	// balance contains the balance with the queried account and denom
-	Balance github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=balance,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"balance"`
-	// whitelisted is the whitelisted amount of the denom on the account.
-	Whitelisted github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=whitelisted,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"whitelisted"`
-	// frozen is the frozen amount of the denom on the account.
-	Frozen github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=frozen,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"frozen"`
-	// locked is the balance locked by vesting.
-	Locked github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=locked,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"locked"`
+type QueryBlockedAccountsResponse struct {
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// accounts contains the accounts blocked from sending and receiving the queried token
+	Accounts []string `protobuf:"bytes,2,rep,name=accounts,proto3" json:"accounts,omitempty"`
 }
 
-func (m *QueryBalanceResponse) Reset()         { *m = QueryBalanceResponse{} }
-func (m *QueryBalanceResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryBalanceResponse) ProtoMessage()    {}
-func (*QueryBalanceResponse) Descriptor() ([]byte, []int) {
+func (m *QueryBlockedAccountsResponse) Reset()         { *m = QueryBlockedAccountsResponse{} }
+func (m *QueryBlockedAccountsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBlockedAccountsResponse) ProtoMessage()    {}
+func (*QueryBlockedAccountsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e9fe336d9bdb8f05, []int{9}
 }
-func (m *QueryBalanceResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryBlockedAccountsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryBalanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryBlockedAccountsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryBalanceResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryBlockedAccountsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -482,37 +480,51 @@ func (m *QueryBalanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *QueryBalanceResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryBalanceResponse.Merge(m, src)
+func (m *QueryBlockedAccountsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlockedAccountsResponse.Merge(m, src)
 }
-func (m *QueryBalanceResponse) XXX_Size() int {
+func (m *QueryBlockedAccountsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryBalanceResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryBalanceResponse.DiscardUnknown(m)
+func (m *QueryBlockedAccountsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlockedAccountsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryBalanceResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryBlockedAccountsResponse proto.InternalMessageInfo
 
-type QueryFrozenBalancesRequest struct {
-	// pagination defines an optional pagination for the request.
-	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
-	// account specifies the account onto which we query frozen balances
+func (m *QueryBlockedAccountsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func (m *QueryBlockedAccountsResponse) GetAccounts() []string {
+	if m != nil {
+		return m.Accounts
+	}
+	return nil
+}
+
+type QueryBlockedAccountRequest struct {
+	// denom specifies the token to query the blocklist of
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// account specifies the account to check
 	Account string `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
 }
 
-func (m *QueryFrozenBalancesRequest) Reset()         { *m = QueryFrozenBalancesRequest{} }
-func (m *QueryFrozenBalancesRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryFrozenBalancesRequest) ProtoMessage()    {}
-func (*QueryFrozenBalancesRequest) Descriptor() ([]byte, []int) {
+func (m *QueryBlockedAccountRequest) Reset()         { *m = QueryBlockedAccountRequest{} }
+func (m *QueryBlockedAccountRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBlockedAccountRequest) ProtoMessage()    {}
+func (*QueryBlockedAccountRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e9fe336d9bdb8f05, []int{10}
 }
-func (m *QueryFrozenBalancesRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryBlockedAccountRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryFrozenBalancesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryBlockedAccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryFrozenBalancesRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryBlockedAccountRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -522,51 +534,49 @@ func (m *QueryFrozenBalancesRequest) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *QueryFrozenBalancesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryFrozenBalancesRequest.Merge(m, src)
+func (m *QueryBlockedAccountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlockedAccountRequest.Merge(m, src)
 }
-func (m *QueryFrozenBalancesRequest) XXX_Size() int {
+func (m *QueryBlockedAccountRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryFrozenBalancesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryFrozenBalancesRequest.DiscardUnknown(m)
+func (m *QueryBlockedAccountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlockedAccountRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryFrozenBalancesRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryBlockedAccountRequest proto.InternalMessageInfo
 
-func (m *QueryFrozenBalancesRequest) GetPagination() *query.PageRequest {
+func (m *QueryBlockedAccountRequest) GetDenom() string {
 	if m != nil {
-		return m.Pagination
+		return m.Denom
 	}
-	return nil
+	return ""
 }
 
-func (m *QueryFrozenBalancesRequest) GetAccount() string {
+func (m *QueryBlockedAccountRequest) GetAccount() string {
 	if m != nil {
 		return m.Account
 	}
 	return ""
 }
 
-type QueryFrozenBalancesResponse struct {
-	// pagination defines the pagination in the response.
-	Pagination *query.PageResponse `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
-	// balances contains the frozen balances on the queried account
-	Balances github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=balances,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"balances"`
+type QueryBlockedAccountResponse struct {
+	// blocked is true if the account is blocked from sending and receiving the token
+	Blocked bool `protobuf:"varint,1,opt,name=blocked,proto3" json:"blocked,omitempty"`
 }
 
-func (m *QueryFrozenBalancesResponse) Reset()         { *m = QueryFrozenBalancesResponse{} }
-func (m *QueryFrozenBalancesResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryFrozenBalancesResponse) ProtoMessage()    {}
-func (*QueryFrozenBalancesResponse) Descriptor() ([]byte, []int) {
+func (m *QueryBlockedAccountResponse) Reset()         { *m = QueryBlockedAccountResponse{} }
+func (m *QueryBlockedAccountResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBlockedAccountResponse) ProtoMessage()    {}
+func (*QueryBlockedAccountResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e9fe336d9bdb8f05, []int{11}
 }
-func (m *QueryFrozenBalancesResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryBlockedAccountResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryFrozenBalancesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryBlockedAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryFrozenBalancesResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryBlockedAccountResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -576,51 +586,42 @@ func (m *QueryFrozenBalancesResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryFrozenBalancesResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryFrozenBalancesResponse.Merge(m, src)
+func (m *QueryBlockedAccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlockedAccountResponse.Merge(m, src)
 }
-func (m *QueryFrozenBalancesResponse) XXX_Size() int {
+func (m *QueryBlockedAccountResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryFrozenBalancesResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryFrozenBalancesResponse.DiscardUnknown(m)
+func (m *QueryBlockedAccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlockedAccountResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryFrozenBalancesResponse proto.InternalMessageInfo
-
-func (m *QueryFrozenBalancesResponse) GetPagination() *query.PageResponse {
-	if m != nil {
-		return m.Pagination
-	}
-	return nil
-}
+var xxx_messageInfo_QueryBlockedAccountResponse proto.InternalMessageInfo
 
-func (m *QueryFrozenBalancesResponse) GetBalances() github_com_cosmos_cosmos_sdk_types.Coins {
+func (m *QueryBlockedAccountResponse) GetBlocked() bool {
 	if m != nil {
-		return m.Balances
+		return m.Blocked
 	}
-	return nil
+	return false
 }
 
-type QueryFrozenBalanceRequest struct {
-	// account specifies the account onto which we query frozen balances
-	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
-	// denom specifies frozen balances on a specific denom
-	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+type QueryDistributionRequest struct {
+	// denom specifies the token to query the distribution of
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-func (m *QueryFrozenBalanceRequest) Reset()         { *m = QueryFrozenBalanceRequest{} }
-func (m *QueryFrozenBalanceRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryFrozenBalanceRequest) ProtoMessage()    {}
-func (*QueryFrozenBalanceRequest) Descriptor() ([]byte, []int) {
+func (m *QueryDistributionRequest) Reset()         { *m = QueryDistributionRequest{} }
+func (m *QueryDistributionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDistributionRequest) ProtoMessage()    {}
+func (*QueryDistributionRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e9fe336d9bdb8f05, []int{12}
 }
-func (m *QueryFrozenBalanceRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryDistributionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryFrozenBalanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryDistributionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryFrozenBalanceRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryDistributionRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -630,49 +631,41 @@ func (m *QueryFrozenBalanceRequest) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *QueryFrozenBalanceRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryFrozenBalanceRequest.Merge(m, src)
+func (m *QueryDistributionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDistributionRequest.Merge(m, src)
 }
-func (m *QueryFrozenBalanceRequest) XXX_Size() int {
+func (m *QueryDistributionRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryFrozenBalanceRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryFrozenBalanceRequest.DiscardUnknown(m)
+func (m *QueryDistributionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDistributionRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryFrozenBalanceRequest proto.InternalMessageInfo
-
-func (m *QueryFrozenBalanceRequest) GetAccount() string {
-	if m != nil {
-		return m.Account
-	}
-	return ""
-}
+var xxx_messageInfo_QueryDistributionRequest proto.InternalMessageInfo
 
-func (m *QueryFrozenBalanceRequest) GetDenom() string {
+func (m *QueryDistributionRequest) GetDenom() string {
 	if m != nil {
 		return m.Denom
 	}
 	return ""
 }
 
-type QueryFrozenBalanceResponse struct {
-	// balance contains the frozen balance with the queried account and denom
-	Balance types.Coin `protobuf:"bytes,1,opt,name=balance,proto3" json:"balance"`
+type QueryDistributionResponse struct {
+	Distribution Distribution `protobuf:"bytes,1,opt,name=distribution,proto3" json:"distribution"`
 }
 
-func (m *QueryFrozenBalanceResponse) Reset()         { *m = QueryFrozenBalanceResponse{} }
-func (m *QueryFrozenBalanceResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryFrozenBalanceResponse) ProtoMessage()    {}
-func (*QueryFrozenBalanceResponse) Descriptor() ([]byte, []int) {
+func (m *QueryDistributionResponse) Reset()         { *m = QueryDistributionResponse{} }
+func (m *QueryDistributionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDistributionResponse) ProtoMessage()    {}
+func (*QueryDistributionResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e9fe336d9bdb8f05, []int{13}
 }
-func (m *QueryFrozenBalanceResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryDistributionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryFrozenBalanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryDistributionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryFrozenBalanceResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryDistributionResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -682,44 +675,44 @@ func (m *QueryFrozenBalanceResponse) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *QueryFrozenBalanceResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryFrozenBalanceResponse.Merge(m, src)
+func (m *QueryDistributionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDistributionResponse.Merge(m, src)
 }
-func (m *QueryFrozenBalanceResponse) XXX_Size() int {
+func (m *QueryDistributionResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryFrozenBalanceResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryFrozenBalanceResponse.DiscardUnknown(m)
+func (m *QueryDistributionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDistributionResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryFrozenBalanceResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryDistributionResponse proto.InternalMessageInfo
 
-func (m *QueryFrozenBalanceResponse) GetBalance() types.Coin {
+func (m *QueryDistributionResponse) GetDistribution() Distribution {
 	if m != nil {
-		return m.Balance
+		return m.Distribution
 	}
-	return types.Coin{}
+	return Distribution{}
 }
 
-type QueryWhitelistedBalancesRequest struct {
-	// pagination defines an optional pagination for the request.
-	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
-	// account specifies the account onto which we query whitelisted balances
+type QueryDistributionClaimableRequest struct {
+	// denom specifies the token to query the distribution of
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// account specifies the holder to query the claimable amount of
 	Account string `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
 }
 
-func (m *QueryWhitelistedBalancesRequest) Reset()         { *m = QueryWhitelistedBalancesRequest{} }
-func (m *QueryWhitelistedBalancesRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryWhitelistedBalancesRequest) ProtoMessage()    {}
-func (*QueryWhitelistedBalancesRequest) Descriptor() ([]byte, []int) {
+func (m *QueryDistributionClaimableRequest) Reset()         { *m = QueryDistributionClaimableRequest{} }
+func (m *QueryDistributionClaimableRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDistributionClaimableRequest) ProtoMessage()    {}
+func (*QueryDistributionClaimableRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e9fe336d9bdb8f05, []int{14}
 }
-func (m *QueryWhitelistedBalancesRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryDistributionClaimableRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryWhitelistedBalancesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryDistributionClaimableRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryWhitelistedBalancesRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryDistributionClaimableRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -729,51 +722,49 @@ func (m *QueryWhitelistedBalancesRequest) XXX_Marshal(b []byte, deterministic bo
 		return b[:n], nil
 	}
 }
-func (m *QueryWhitelistedBalancesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryWhitelistedBalancesRequest.Merge(m, src)
+func (m *QueryDistributionClaimableRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDistributionClaimableRequest.Merge(m, src)
 }
-func (m *QueryWhitelistedBalancesRequest) XXX_Size() int {
+func (m *QueryDistributionClaimableRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryWhitelistedBalancesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryWhitelistedBalancesRequest.DiscardUnknown(m)
+func (m *QueryDistributionClaimableRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDistributionClaimableRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryWhitelistedBalancesRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryDistributionClaimableRequest proto.InternalMessageInfo
 
-func (m *QueryWhitelistedBalancesRequest) GetPagination() *query.PageRequest {
+func (m *QueryDistributionClaimableRequest) GetDenom() string {
 	if m != nil {
-		return m.Pagination
+		return m.Denom
 	}
-	return nil
+	return ""
 }
 
-func (m *QueryWhitelistedBalancesRequest) GetAccount() string {
+func (m *QueryDistributionClaimableRequest) GetAccount() string {
 	if m != nil {
 		return m.Account
 	}
 	return ""
 }
 
-type QueryWhitelistedBalancesResponse struct {
-	// pagination defines the pagination in the response.
-	Pagination *query.PageResponse `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
-	// balances contains the whitelisted balances on the queried account
-	Balances github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=balances,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"balances"`
+type QueryDistributionClaimableResponse struct {
+	// amount is the amount the account is going to receive from the distribution
+	Amount types.Coin `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount"`
 }
 
-func (m *QueryWhitelistedBalancesResponse) Reset()         { *m = QueryWhitelistedBalancesResponse{} }
-func (m *QueryWhitelistedBalancesResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryWhitelistedBalancesResponse) ProtoMessage()    {}
-func (*QueryWhitelistedBalancesResponse) Descriptor() ([]byte, []int) {
+func (m *QueryDistributionClaimableResponse) Reset()         { *m = QueryDistributionClaimableResponse{} }
+func (m *QueryDistributionClaimableResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDistributionClaimableResponse) ProtoMessage()    {}
+func (*QueryDistributionClaimableResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e9fe336d9bdb8f05, []int{15}
 }
-func (m *QueryWhitelistedBalancesResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryDistributionClaimableResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryWhitelistedBalancesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryDistributionClaimableResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryWhitelistedBalancesResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryDistributionClaimableResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -783,51 +774,44 @@ func (m *QueryWhitelistedBalancesResponse) XXX_Marshal(b []byte, deterministic b
 		return b[:n], nil
 	}
 }
-func (m *QueryWhitelistedBalancesResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryWhitelistedBalancesResponse.Merge(m, src)
+func (m *QueryDistributionClaimableResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDistributionClaimableResponse.Merge(m, src)
 }
-func (m *QueryWhitelistedBalancesResponse) XXX_Size() int {
+func (m *QueryDistributionClaimableResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryWhitelistedBalancesResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryWhitelistedBalancesResponse.DiscardUnknown(m)
+func (m *QueryDistributionClaimableResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDistributionClaimableResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryWhitelistedBalancesResponse proto.InternalMessageInfo
-
-func (m *QueryWhitelistedBalancesResponse) GetPagination() *query.PageResponse {
-	if m != nil {
-		return m.Pagination
-	}
-	return nil
-}
+var xxx_messageInfo_QueryDistributionClaimableResponse proto.InternalMessageInfo
 
-func (m *QueryWhitelistedBalancesResponse) GetBalances() github_com_cosmos_cosmos_sdk_types.Coins {
+func (m *QueryDistributionClaimableResponse) GetAmount() types.Coin {
 	if m != nil {
-		return m.Balances
+		return m.Amount
 	}
-	return nil
+	return types.Coin{}
 }
 
-type QueryWhitelistedBalanceRequest struct {
-	// account specifies the account onto which we query whitelisted balances
-	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
-	// denom specifies whitelisted balances on a specific denom
+type QueryTransferLimitsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// denom specifies the token to query the transfer limits of
 	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-func (m *QueryWhitelistedBalanceRequest) Reset()         { *m = QueryWhitelistedBalanceRequest{} }
-func (m *QueryWhitelistedBalanceRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryWhitelistedBalanceRequest) ProtoMessage()    {}
-func (*QueryWhitelistedBalanceRequest) Descriptor() ([]byte, []int) {
+func (m *QueryTransferLimitsRequest) Reset()         { *m = QueryTransferLimitsRequest{} }
+func (m *QueryTransferLimitsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTransferLimitsRequest) ProtoMessage()    {}
+func (*QueryTransferLimitsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e9fe336d9bdb8f05, []int{16}
 }
-func (m *QueryWhitelistedBalanceRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryTransferLimitsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryWhitelistedBalanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryTransferLimitsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryWhitelistedBalanceRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryTransferLimitsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -837,49 +821,51 @@ func (m *QueryWhitelistedBalanceRequest) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *QueryWhitelistedBalanceRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryWhitelistedBalanceRequest.Merge(m, src)
+func (m *QueryTransferLimitsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTransferLimitsRequest.Merge(m, src)
 }
-func (m *QueryWhitelistedBalanceRequest) XXX_Size() int {
+func (m *QueryTransferLimitsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryWhitelistedBalanceRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryWhitelistedBalanceRequest.DiscardUnknown(m)
+func (m *QueryTransferLimitsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTransferLimitsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryWhitelistedBalanceRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryTransferLimitsRequest proto.InternalMessageInfo
 
-func (m *QueryWhitelistedBalanceRequest) GetAccount() string {
+func (m *QueryTransferLimitsRequest) GetPagination() *query.PageRequest {
 	if m != nil {
-		return m.Account
+		return m.Pagination
 	}
-	return ""
+	return nil
 }
 
-func (m *QueryWhitelistedBalanceRequest) GetDenom() string {
+func (m *QueryTransferLimitsRequest) GetDenom() string {
 	if m != nil {
 		return m.Denom
 	}
 	return ""
 }
 
-type QueryWhitelistedBalanceResponse struct {
-	// balance contains the whitelisted balance with the queried account and denom
-	Balance types.Coin `protobuf:"bytes,1,opt,name=balance,proto3" json:"balance"`
+type QueryTransferLimitsResponse struct {
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// transfer_limits contains the transfer limits of the queried token
+	TransferLimits []TransferLimit `protobuf:"bytes,2,rep,name=transfer_limits,json=transferLimits,proto3" json:"transfer_limits"`
 }
 
-func (m *QueryWhitelistedBalanceResponse) Reset()         { *m = QueryWhitelistedBalanceResponse{} }
-func (m *QueryWhitelistedBalanceResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryWhitelistedBalanceResponse) ProtoMessage()    {}
-func (*QueryWhitelistedBalanceResponse) Descriptor() ([]byte, []int) {
+func (m *QueryTransferLimitsResponse) Reset()         { *m = QueryTransferLimitsResponse{} }
+func (m *QueryTransferLimitsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTransferLimitsResponse) ProtoMessage()    {}
+func (*QueryTransferLimitsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e9fe336d9bdb8f05, []int{17}
 }
-func (m *QueryWhitelistedBalanceResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryTransferLimitsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryWhitelistedBalanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryTransferLimitsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryWhitelistedBalanceResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryTransferLimitsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -889,1480 +875,6052 @@ func (m *QueryWhitelistedBalanceResponse) XXX_Marshal(b []byte, deterministic bo
 		return b[:n], nil
 	}
 }
-func (m *QueryWhitelistedBalanceResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryWhitelistedBalanceResponse.Merge(m, src)
+func (m *QueryTransferLimitsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTransferLimitsResponse.Merge(m, src)
 }
-func (m *QueryWhitelistedBalanceResponse) XXX_Size() int {
+func (m *QueryTransferLimitsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryWhitelistedBalanceResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryWhitelistedBalanceResponse.DiscardUnknown(m)
+func (m *QueryTransferLimitsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTransferLimitsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryWhitelistedBalanceResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryTransferLimitsResponse proto.InternalMessageInfo
 
-func (m *QueryWhitelistedBalanceResponse) GetBalance() types.Coin {
+func (m *QueryTransferLimitsResponse) GetPagination() *query.PageResponse {
 	if m != nil {
-		return m.Balance
+		return m.Pagination
 	}
-	return types.Coin{}
-}
-
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "coreum.asset.ft.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "coreum.asset.ft.v1.QueryParamsResponse")
-	proto.RegisterType((*QueryTokenRequest)(nil), "coreum.asset.ft.v1.QueryTokenRequest")
-	proto.RegisterType((*QueryTokenResponse)(nil), "coreum.asset.ft.v1.QueryTokenResponse")
-	proto.RegisterType((*QueryTokenUpgradeStatusesRequest)(nil), "coreum.asset.ft.v1.QueryTokenUpgradeStatusesRequest")
-	proto.RegisterType((*QueryTokenUpgradeStatusesResponse)(nil), "coreum.asset.ft.v1.QueryTokenUpgradeStatusesResponse")
-	proto.RegisterType((*QueryTokensRequest)(nil), "coreum.asset.ft.v1.QueryTokensRequest")
-	proto.RegisterType((*QueryTokensResponse)(nil), "coreum.asset.ft.v1.QueryTokensResponse")
-	proto.RegisterType((*QueryBalanceRequest)(nil), "coreum.asset.ft.v1.QueryBalanceRequest")
-	proto.RegisterType((*QueryBalanceResponse)(nil), "coreum.asset.ft.v1.QueryBalanceResponse")
-	proto.RegisterType((*QueryFrozenBalancesRequest)(nil), "coreum.asset.ft.v1.QueryFrozenBalancesRequest")
-	proto.RegisterType((*QueryFrozenBalancesResponse)(nil), "coreum.asset.ft.v1.QueryFrozenBalancesResponse")
-	proto.RegisterType((*QueryFrozenBalanceRequest)(nil), "coreum.asset.ft.v1.QueryFrozenBalanceRequest")
-	proto.RegisterType((*QueryFrozenBalanceResponse)(nil), "coreum.asset.ft.v1.QueryFrozenBalanceResponse")
-	proto.RegisterType((*QueryWhitelistedBalancesRequest)(nil), "coreum.asset.ft.v1.QueryWhitelistedBalancesRequest")
-	proto.RegisterType((*QueryWhitelistedBalancesResponse)(nil), "coreum.asset.ft.v1.QueryWhitelistedBalancesResponse")
-	proto.RegisterType((*QueryWhitelistedBalanceRequest)(nil), "coreum.asset.ft.v1.QueryWhitelistedBalanceRequest")
-	proto.RegisterType((*QueryWhitelistedBalanceResponse)(nil), "coreum.asset.ft.v1.QueryWhitelistedBalanceResponse")
+	return nil
 }
 
-func init() { proto.RegisterFile("coreum/asset/ft/v1/query.proto", fileDescriptor_e9fe336d9bdb8f05) }
-
-var fileDescriptor_e9fe336d9bdb8f05 = []byte{
-	// 1008 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x57, 0xcf, 0x6f, 0x1b, 0xc5,
-	0x17, 0xcf, 0xba, 0x8d, 0xd3, 0xef, 0x8b, 0xbe, 0x48, 0x4c, 0x2d, 0xe4, 0x2e, 0xd5, 0x3a, 0xac,
-	0x20, 0x0d, 0x95, 0xb2, 0x43, 0xe2, 0xa6, 0x04, 0x55, 0x14, 0x70, 0x54, 0x17, 0xc8, 0x01, 0x63,
-	0x40, 0x95, 0x10, 0x97, 0xb5, 0x3d, 0xd9, 0xae, 0x12, 0xef, 0xb8, 0x9e, 0xd9, 0x40, 0xa8, 0xc2,
-	0xa1, 0xfc, 0x03, 0x48, 0x1c, 0xf8, 0x0b, 0xb8, 0x70, 0xe3, 0xc2, 0x19, 0x21, 0x21, 0x55, 0x5c,
-	0xa8, 0x04, 0x07, 0xc4, 0xa1, 0xa0, 0x84, 0xff, 0x81, 0x2b, 0xda, 0x99, 0xb7, 0xf6, 0x2e, 0xde,
-	0xf5, 0x2f, 0x22, 0x24, 0x4e, 0xf1, 0xee, 0xbc, 0xf7, 0xf9, 0x7c, 0xde, 0x7c, 0xde, 0xec, 0x9b,
-	0x80, 0xd5, 0xe6, 0x7d, 0x16, 0x76, 0xa9, 0x2b, 0x04, 0x93, 0x74, 0x4f, 0xd2, 0xc3, 0x0d, 0x7a,
-	0x2f, 0x64, 0xfd, 0x23, 0xa7, 0xd7, 0xe7, 0x92, 0x13, 0xa2, 0xd7, 0x1d, 0xb5, 0xee, 0xec, 0x49,
-	0xe7, 0x70, 0xc3, 0x2c, 0x79, 0xdc, 0xe3, 0x6a, 0x99, 0x46, 0xbf, 0x74, 0xa4, 0x79, 0xd9, 0xe3,
-	0xdc, 0x3b, 0x60, 0xd4, 0xed, 0xf9, 0xd4, 0x0d, 0x02, 0x2e, 0x5d, 0xe9, 0xf3, 0x40, 0xe0, 0xaa,
-	0xd5, 0xe6, 0xa2, 0xcb, 0x05, 0x6d, 0xb9, 0x82, 0xd1, 0xc3, 0x8d, 0x16, 0x93, 0xee, 0x06, 0x6d,
-	0x73, 0x3f, 0xc0, 0xf5, 0xab, 0xc9, 0x75, 0x25, 0x60, 0x10, 0xd5, 0x73, 0x3d, 0x3f, 0x50, 0x60,
-	0x43, 0xac, 0x11, 0xcd, 0x92, 0xef, 0xb3, 0x78, 0xbd, 0x92, 0xb1, 0xde, 0x73, 0xfb, 0x6e, 0x17,
-	0xc5, 0xd8, 0x25, 0x20, 0x6f, 0x47, 0x14, 0x0d, 0xf5, 0xb2, 0xc9, 0xee, 0x85, 0x4c, 0x48, 0xfb,
-	0x2d, 0xb8, 0x98, 0x7a, 0x2b, 0x7a, 0x3c, 0x10, 0x8c, 0x6c, 0x43, 0x51, 0x27, 0x97, 0x8d, 0x15,
-	0x63, 0x6d, 0x79, 0xd3, 0x74, 0x46, 0xb7, 0xc4, 0xd1, 0x39, 0xb5, 0xf3, 0x0f, 0x1f, 0x57, 0x16,
-	0x9a, 0x18, 0x6f, 0x3f, 0x0f, 0x4f, 0x2a, 0xc0, 0x77, 0x23, 0x6d, 0xc8, 0x42, 0x4a, 0xb0, 0xd8,
-	0x61, 0x01, 0xef, 0x2a, 0xb4, 0xff, 0x35, 0xf5, 0x83, 0xbd, 0x8b, 0x8a, 0x30, 0x14, 0xa9, 0xb7,
-	0x60, 0x51, 0xd5, 0x85, 0xcc, 0x97, 0xb2, 0x98, 0x55, 0x06, 0x12, 0xeb, 0x68, 0x7b, 0x1b, 0x56,
-	0x86, 0x60, 0xef, 0xf5, 0xbc, 0xbe, 0xdb, 0x61, 0xef, 0x48, 0x57, 0x86, 0x82, 0x89, 0xf1, 0x32,
-	0x38, 0x3c, 0x33, 0x26, 0x13, 0x55, 0xbd, 0x09, 0x17, 0x04, 0xbe, 0x43, 0x61, 0x6b, 0xb9, 0xc2,
-	0xfe, 0x86, 0x81, 0x3a, 0x07, 0xf9, 0xb6, 0x4c, 0xd6, 0x3d, 0x10, 0x57, 0x07, 0x18, 0x9a, 0x8e,
-	0x1c, 0xab, 0x8e, 0xee, 0x10, 0x27, 0xea, 0x10, 0x47, 0xb7, 0x28, 0x76, 0x88, 0xd3, 0x70, 0x3d,
-	0x86, 0xb9, 0xcd, 0x44, 0x26, 0x79, 0x0a, 0x8a, 0xbe, 0x10, 0x21, 0xeb, 0x97, 0x0b, 0xaa, 0x4a,
-	0x7c, 0xb2, 0xbf, 0x30, 0xd0, 0xea, 0x98, 0x16, 0x2b, 0xbb, 0x9d, 0xc1, 0x7b, 0x65, 0x22, 0xaf,
-	0x4e, 0x4e, 0x11, 0xbf, 0x08, 0x45, 0x65, 0x85, 0x28, 0x17, 0x56, 0xce, 0x4d, 0xe3, 0x1c, 0x86,
-	0xdb, 0xb7, 0x50, 0x58, 0xcd, 0x3d, 0x70, 0x83, 0x76, 0x5c, 0x14, 0x29, 0xc3, 0x92, 0xdb, 0x6e,
-	0xf3, 0x30, 0x90, 0xe8, 0x57, 0xfc, 0x38, 0xf4, 0xb1, 0x90, 0xf4, 0xf1, 0xbb, 0x02, 0x94, 0xd2,
-	0x38, 0x58, 0xe1, 0xeb, 0xb0, 0xd4, 0xd2, 0xaf, 0x34, 0x50, 0xcd, 0x89, 0xe8, 0x7f, 0x7d, 0x5c,
-	0x59, 0xf5, 0x7c, 0x79, 0x37, 0x6c, 0x39, 0x6d, 0xde, 0xa5, 0x78, 0x14, 0xf5, 0x9f, 0x75, 0xd1,
-	0xd9, 0xa7, 0xf2, 0xa8, 0xc7, 0x84, 0xf3, 0x46, 0x20, 0x9b, 0x71, 0x3a, 0x69, 0xc0, 0xf2, 0x87,
-	0x77, 0x7d, 0xc9, 0x0e, 0x7c, 0x21, 0x59, 0x47, 0xd3, 0xcf, 0x8c, 0x96, 0x84, 0x20, 0x75, 0x28,
-	0xee, 0xf5, 0xf9, 0xc7, 0x2c, 0x28, 0x9f, 0x9b, 0x0b, 0x0c, 0xb3, 0x23, 0x9c, 0x03, 0xde, 0xde,
-	0x67, 0x9d, 0xf2, 0xf9, 0xf9, 0x70, 0x74, 0xb6, 0xfd, 0x09, 0x98, 0x6a, 0x0f, 0xeb, 0x0a, 0x16,
-	0x77, 0xf2, 0xcc, 0x7b, 0x34, 0x61, 0x6d, 0x21, 0x65, 0xad, 0xfd, 0xa3, 0x01, 0x4f, 0x67, 0x0a,
-	0x38, 0xeb, 0x6e, 0xf5, 0xe0, 0x02, 0xba, 0x9a, 0xec, 0xd7, 0x21, 0x4c, 0x0c, 0xb0, 0xc3, 0xfd,
-	0xa0, 0xf6, 0x42, 0xb4, 0x9b, 0x5f, 0xfd, 0x56, 0x59, 0x9b, 0x62, 0x37, 0xa3, 0x04, 0xd1, 0x1c,
-	0x80, 0xdb, 0xbb, 0x70, 0x69, 0xb4, 0xa0, 0x79, 0x7b, 0xfc, 0x4e, 0x96, 0x3d, 0x83, 0xcd, 0x79,
-	0x29, 0xdd, 0xe8, 0x63, 0x4b, 0xd2, 0x47, 0x30, 0x8e, 0xb7, 0x3f, 0x35, 0xa0, 0xa2, 0x90, 0xef,
-	0x0c, 0x9b, 0xf3, 0xdf, 0x77, 0xff, 0x67, 0x03, 0xbf, 0xe2, 0x99, 0x2a, 0xfe, 0xb3, 0x2d, 0xd0,
-	0x00, 0x2b, 0xa7, 0xaa, 0x79, 0xfb, 0xe0, 0x83, 0x5c, 0xb7, 0xce, 0xa0, 0x19, 0x36, 0xff, 0x5c,
-	0x86, 0x45, 0x05, 0x4f, 0x8e, 0xa1, 0xa8, 0xa7, 0x3c, 0x59, 0xcd, 0xfa, 0x9a, 0x8f, 0x5e, 0x28,
-	0xcc, 0x2b, 0x13, 0xe3, 0xb4, 0x3e, 0xdb, 0x7e, 0xf0, 0xd3, 0x1f, 0x9f, 0x17, 0x2e, 0x13, 0x93,
-	0xe6, 0xde, 0x5c, 0x22, 0x7a, 0x3d, 0xad, 0xc6, 0xd0, 0xa7, 0xa6, 0xe8, 0x18, 0xfa, 0xf4, 0xd8,
-	0x1b, 0x4f, 0xaf, 0x07, 0x13, 0x79, 0x60, 0xc0, 0xa2, 0x4a, 0x23, 0xcf, 0x8d, 0x87, 0x8d, 0xd9,
-	0x57, 0x27, 0x85, 0x21, 0xf9, 0x55, 0x45, 0xfe, 0x2c, 0xb1, 0xf3, 0xc9, 0xe9, 0x7d, 0xe5, 0xf4,
-	0x31, 0xf9, 0xd6, 0x80, 0x52, 0xd6, 0xb5, 0x82, 0x5c, 0x1b, 0x4f, 0x96, 0x7d, 0x07, 0x32, 0xb7,
-	0x66, 0xcc, 0x42, 0xc5, 0x37, 0x94, 0xe2, 0x2d, 0x52, 0x9d, 0xac, 0x98, 0x86, 0x1a, 0x63, 0x3d,
-	0xbe, 0xf0, 0x90, 0x2f, 0x0d, 0x58, 0xc2, 0xf6, 0x24, 0xf9, 0x06, 0xa5, 0x8f, 0x84, 0xb9, 0x36,
-	0x39, 0x10, 0xb5, 0xdd, 0x56, 0xda, 0x5e, 0x23, 0xaf, 0x64, 0x69, 0xc3, 0x73, 0x24, 0xe8, 0x7d,
-	0xfc, 0x75, 0x4c, 0xe3, 0x73, 0x49, 0x45, 0xd8, 0xed, 0xba, 0xfd, 0xa3, 0xc1, 0x56, 0x7f, 0x6d,
-	0xc0, 0x13, 0xe9, 0xb9, 0x43, 0x9c, 0x5c, 0x15, 0x99, 0x13, 0xd2, 0xa4, 0x53, 0xc7, 0xa3, 0xf8,
-	0x9b, 0x4a, 0xfc, 0x36, 0xb9, 0x3e, 0xab, 0x78, 0x1c, 0xfc, 0xdf, 0x18, 0xf0, 0xff, 0x14, 0x34,
-	0x59, 0x9f, 0x4e, 0x42, 0xac, 0xd8, 0x99, 0x36, 0x1c, 0x05, 0xd7, 0x95, 0xe0, 0x57, 0xc9, 0xcd,
-	0xf9, 0x04, 0x0f, 0x36, 0xfb, 0x7b, 0x03, 0x2e, 0x66, 0x7c, 0xe6, 0x49, 0x35, 0x57, 0x4f, 0xfe,
-	0x68, 0x32, 0xaf, 0xcd, 0x96, 0x84, 0xa5, 0xec, 0xa8, 0x52, 0x5e, 0x26, 0x37, 0x66, 0x2d, 0x25,
-	0x79, 0x83, 0xfb, 0xc1, 0x00, 0x32, 0x4a, 0x42, 0x36, 0x67, 0x50, 0x14, 0x57, 0x51, 0x9d, 0x29,
-	0x07, 0x8b, 0xd8, 0x55, 0x45, 0xdc, 0x22, 0x3b, 0xff, 0xa0, 0x88, 0xd8, 0x94, 0x5a, 0xe3, 0xe1,
-	0x89, 0x65, 0x3c, 0x3a, 0xb1, 0x8c, 0xdf, 0x4f, 0x2c, 0xe3, 0xb3, 0x53, 0x6b, 0xe1, 0xd1, 0xa9,
-	0xb5, 0xf0, 0xcb, 0xa9, 0xb5, 0xf0, 0xfe, 0xf5, 0xc4, 0xdc, 0xdb, 0x51, 0x44, 0x75, 0x1e, 0x06,
-	0x1d, 0x35, 0x49, 0x63, 0xe6, 0xc3, 0x2a, 0xfd, 0x68, 0x48, 0xaf, 0x66, 0x61, 0xab, 0xa8, 0xfe,
-	0xfb, 0xac, 0xfe, 0x15, 0x00, 0x00, 0xff, 0xff, 0xcd, 0xb9, 0xea, 0xf2, 0x74, 0x0f, 0x00, 0x00,
+func (m *QueryTransferLimitsResponse) GetTransferLimits() []TransferLimit {
+	if m != nil {
+		return m.TransferLimits
+	}
+	return nil
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Params queries the parameters of x/asset/ft module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Tokens queries the fungible tokens of the module.
-	Tokens(ctx context.Context, in *QueryTokensRequest, opts ...grpc.CallOption) (*QueryTokensResponse, error)
-	// Token queries the fungible token of the module.
-	Token(ctx context.Context, in *QueryTokenRequest, opts ...grpc.CallOption) (*QueryTokenResponse, error)
-	// TokenUpgradeStatuses returns token upgrades info.
-	TokenUpgradeStatuses(ctx context.Context, in *QueryTokenUpgradeStatusesRequest, opts ...grpc.CallOption) (*QueryTokenUpgradeStatusesResponse, error)
-	// Balance returns balance of the denom for the account.
-	Balance(ctx context.Context, in *QueryBalanceRequest, opts ...grpc.CallOption) (*QueryBalanceResponse, error)
-	// FrozenBalances returns all the frozen balances for the account.
-	FrozenBalances(ctx context.Context, in *QueryFrozenBalancesRequest, opts ...grpc.CallOption) (*QueryFrozenBalancesResponse, error)
-	// FrozenBalance returns frozen balance of the denom for the account.
-	FrozenBalance(ctx context.Context, in *QueryFrozenBalanceRequest, opts ...grpc.CallOption) (*QueryFrozenBalanceResponse, error)
-	// WhitelistedBalances returns all the whitelisted balances for the account.
-	WhitelistedBalances(ctx context.Context, in *QueryWhitelistedBalancesRequest, opts ...grpc.CallOption) (*QueryWhitelistedBalancesResponse, error)
-	// WhitelistedBalance returns whitelisted balance of the denom for the account.
-	WhitelistedBalance(ctx context.Context, in *QueryWhitelistedBalanceRequest, opts ...grpc.CallOption) (*QueryWhitelistedBalanceResponse, error)
+type QueryTransferLimitRequest struct {
+	// denom specifies the token to query the transfer limit of
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// account specifies the account to query the transfer limit of
+	Account string `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryTransferLimitRequest) Reset()         { *m = QueryTransferLimitRequest{} }
+func (m *QueryTransferLimitRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTransferLimitRequest) ProtoMessage()    {}
+func (*QueryTransferLimitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9fe336d9bdb8f05, []int{18}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryTransferLimitRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/coreum.asset.ft.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryTransferLimitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTransferLimitRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryTransferLimitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTransferLimitRequest.Merge(m, src)
+}
+func (m *QueryTransferLimitRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTransferLimitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTransferLimitRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) Tokens(ctx context.Context, in *QueryTokensRequest, opts ...grpc.CallOption) (*QueryTokensResponse, error) {
-	out := new(QueryTokensResponse)
-	err := c.cc.Invoke(ctx, "/coreum.asset.ft.v1.Query/Tokens", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryTransferLimitRequest proto.InternalMessageInfo
+
+func (m *QueryTransferLimitRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) Token(ctx context.Context, in *QueryTokenRequest, opts ...grpc.CallOption) (*QueryTokenResponse, error) {
-	out := new(QueryTokenResponse)
-	err := c.cc.Invoke(ctx, "/coreum.asset.ft.v1.Query/Token", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryTransferLimitRequest) GetAccount() string {
+	if m != nil {
+		return m.Account
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) TokenUpgradeStatuses(ctx context.Context, in *QueryTokenUpgradeStatusesRequest, opts ...grpc.CallOption) (*QueryTokenUpgradeStatusesResponse, error) {
-	out := new(QueryTokenUpgradeStatusesResponse)
-	err := c.cc.Invoke(ctx, "/coreum.asset.ft.v1.Query/TokenUpgradeStatuses", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryTransferLimitResponse struct {
+	// transfer_limit is the limit applied to the account, it is empty if the account is not limited.
+	TransferLimit *TransferLimit `protobuf:"bytes,1,opt,name=transfer_limit,json=transferLimit,proto3" json:"transfer_limit,omitempty"`
+	// sent is the amount sent by the account within the current period.
+	Sent github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=sent,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"sent"`
+	// remaining is the amount the account might still send within the current period, it is empty if the account
+	// is not limited.
+	Remaining *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=remaining,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"remaining,omitempty"`
 }
 
-func (c *queryClient) Balance(ctx context.Context, in *QueryBalanceRequest, opts ...grpc.CallOption) (*QueryBalanceResponse, error) {
-	out := new(QueryBalanceResponse)
-	err := c.cc.Invoke(ctx, "/coreum.asset.ft.v1.Query/Balance", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryTransferLimitResponse) Reset()         { *m = QueryTransferLimitResponse{} }
+func (m *QueryTransferLimitResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTransferLimitResponse) ProtoMessage()    {}
+func (*QueryTransferLimitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9fe336d9bdb8f05, []int{19}
+}
+func (m *QueryTransferLimitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTransferLimitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTransferLimitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryTransferLimitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTransferLimitResponse.Merge(m, src)
+}
+func (m *QueryTransferLimitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTransferLimitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTransferLimitResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) FrozenBalances(ctx context.Context, in *QueryFrozenBalancesRequest, opts ...grpc.CallOption) (*QueryFrozenBalancesResponse, error) {
-	out := new(QueryFrozenBalancesResponse)
-	err := c.cc.Invoke(ctx, "/coreum.asset.ft.v1.Query/FrozenBalances", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryTransferLimitResponse proto.InternalMessageInfo
+
+func (m *QueryTransferLimitResponse) GetTransferLimit() *TransferLimit {
+	if m != nil {
+		return m.TransferLimit
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) FrozenBalance(ctx context.Context, in *QueryFrozenBalanceRequest, opts ...grpc.CallOption) (*QueryFrozenBalanceResponse, error) {
-	out := new(QueryFrozenBalanceResponse)
-	err := c.cc.Invoke(ctx, "/coreum.asset.ft.v1.Query/FrozenBalance", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryVestingSchedulesRequest struct {
+	// denom specifies the token to query the vesting schedules of
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// account specifies the account to query the vesting schedules of
+	Account string `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
 }
 
-func (c *queryClient) WhitelistedBalances(ctx context.Context, in *QueryWhitelistedBalancesRequest, opts ...grpc.CallOption) (*QueryWhitelistedBalancesResponse, error) {
-	out := new(QueryWhitelistedBalancesResponse)
-	err := c.cc.Invoke(ctx, "/coreum.asset.ft.v1.Query/WhitelistedBalances", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryVestingSchedulesRequest) Reset()         { *m = QueryVestingSchedulesRequest{} }
+func (m *QueryVestingSchedulesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVestingSchedulesRequest) ProtoMessage()    {}
+func (*QueryVestingSchedulesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9fe336d9bdb8f05, []int{20}
+}
+func (m *QueryVestingSchedulesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVestingSchedulesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVestingSchedulesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryVestingSchedulesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVestingSchedulesRequest.Merge(m, src)
+}
+func (m *QueryVestingSchedulesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVestingSchedulesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVestingSchedulesRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) WhitelistedBalance(ctx context.Context, in *QueryWhitelistedBalanceRequest, opts ...grpc.CallOption) (*QueryWhitelistedBalanceResponse, error) {
-	out := new(QueryWhitelistedBalanceResponse)
-	err := c.cc.Invoke(ctx, "/coreum.asset.ft.v1.Query/WhitelistedBalance", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryVestingSchedulesRequest proto.InternalMessageInfo
+
+func (m *QueryVestingSchedulesRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return out, nil
+	return ""
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Params queries the parameters of x/asset/ft module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Tokens queries the fungible tokens of the module.
-	Tokens(context.Context, *QueryTokensRequest) (*QueryTokensResponse, error)
-	// Token queries the fungible token of the module.
-	Token(context.Context, *QueryTokenRequest) (*QueryTokenResponse, error)
-	// TokenUpgradeStatuses returns token upgrades info.
-	TokenUpgradeStatuses(context.Context, *QueryTokenUpgradeStatusesRequest) (*QueryTokenUpgradeStatusesResponse, error)
-	// Balance returns balance of the denom for the account.
-	Balance(context.Context, *QueryBalanceRequest) (*QueryBalanceResponse, error)
-	// FrozenBalances returns all the frozen balances for the account.
-	FrozenBalances(context.Context, *QueryFrozenBalancesRequest) (*QueryFrozenBalancesResponse, error)
-	// FrozenBalance returns frozen balance of the denom for the account.
-	FrozenBalance(context.Context, *QueryFrozenBalanceRequest) (*QueryFrozenBalanceResponse, error)
-	// WhitelistedBalances returns all the whitelisted balances for the account.
-	WhitelistedBalances(context.Context, *QueryWhitelistedBalancesRequest) (*QueryWhitelistedBalancesResponse, error)
-	// WhitelistedBalance returns whitelisted balance of the denom for the account.
-	WhitelistedBalance(context.Context, *QueryWhitelistedBalanceRequest) (*QueryWhitelistedBalanceResponse, error)
+func (m *QueryVestingSchedulesRequest) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+type QueryVestingSchedulesResponse struct {
+	VestingSchedules []VestingSchedule `protobuf:"bytes,1,rep,name=vesting_schedules,json=vestingSchedules,proto3" json:"vesting_schedules"`
+	// vested is the amount of the schedules already vested.
+	Vested github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=vested,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"vested"`
+	// unvested is the amount of the schedules still locked on the account.
+	Unvested github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=unvested,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"unvested"`
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
-}
-func (*UnimplementedQueryServer) Tokens(ctx context.Context, req *QueryTokensRequest) (*QueryTokensResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Tokens not implemented")
-}
-func (*UnimplementedQueryServer) Token(ctx context.Context, req *QueryTokenRequest) (*QueryTokenResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Token not implemented")
+func (m *QueryVestingSchedulesResponse) Reset()         { *m = QueryVestingSchedulesResponse{} }
+func (m *QueryVestingSchedulesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVestingSchedulesResponse) ProtoMessage()    {}
+func (*QueryVestingSchedulesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9fe336d9bdb8f05, []int{21}
 }
-func (*UnimplementedQueryServer) TokenUpgradeStatuses(ctx context.Context, req *QueryTokenUpgradeStatusesRequest) (*QueryTokenUpgradeStatusesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TokenUpgradeStatuses not implemented")
+func (m *QueryVestingSchedulesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) Balance(ctx context.Context, req *QueryBalanceRequest) (*QueryBalanceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Balance not implemented")
+func (m *QueryVestingSchedulesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVestingSchedulesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) FrozenBalances(ctx context.Context, req *QueryFrozenBalancesRequest) (*QueryFrozenBalancesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FrozenBalances not implemented")
+func (m *QueryVestingSchedulesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVestingSchedulesResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) FrozenBalance(ctx context.Context, req *QueryFrozenBalanceRequest) (*QueryFrozenBalanceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FrozenBalance not implemented")
+func (m *QueryVestingSchedulesResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) WhitelistedBalances(ctx context.Context, req *QueryWhitelistedBalancesRequest) (*QueryWhitelistedBalancesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WhitelistedBalances not implemented")
+func (m *QueryVestingSchedulesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVestingSchedulesResponse.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) WhitelistedBalance(ctx context.Context, req *QueryWhitelistedBalanceRequest) (*QueryWhitelistedBalanceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WhitelistedBalance not implemented")
+
+var xxx_messageInfo_QueryVestingSchedulesResponse proto.InternalMessageInfo
+
+func (m *QueryVestingSchedulesResponse) GetVestingSchedules() []VestingSchedule {
+	if m != nil {
+		return m.VestingSchedules
+	}
+	return nil
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+type QueryHoldersRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// denom specifies the token to query the holders of
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/coreum.asset.ft.v1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+func (m *QueryHoldersRequest) Reset()         { *m = QueryHoldersRequest{} }
+func (m *QueryHoldersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryHoldersRequest) ProtoMessage()    {}
+func (*QueryHoldersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9fe336d9bdb8f05, []int{22}
+}
+func (m *QueryHoldersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHoldersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHoldersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryHoldersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHoldersRequest.Merge(m, src)
+}
+func (m *QueryHoldersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHoldersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHoldersRequest.DiscardUnknown(m)
 }
 
-func _Query_Tokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryTokensRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Tokens(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/coreum.asset.ft.v1.Query/Tokens",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Tokens(ctx, req.(*QueryTokensRequest))
+var xxx_messageInfo_QueryHoldersRequest proto.InternalMessageInfo
+
+func (m *QueryHoldersRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_Token_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryTokenRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Token(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/coreum.asset.ft.v1.Query/Token",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Token(ctx, req.(*QueryTokenRequest))
+func (m *QueryHoldersRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_TokenUpgradeStatuses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryTokenUpgradeStatusesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).TokenUpgradeStatuses(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/coreum.asset.ft.v1.Query/TokenUpgradeStatuses",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TokenUpgradeStatuses(ctx, req.(*QueryTokenUpgradeStatusesRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryHoldersResponse struct {
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// holders contains the holders of the queried token
+	Holders []Holder `protobuf:"bytes,2,rep,name=holders,proto3" json:"holders"`
 }
 
-func _Query_Balance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryBalanceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Balance(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/coreum.asset.ft.v1.Query/Balance",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Balance(ctx, req.(*QueryBalanceRequest))
+func (m *QueryHoldersResponse) Reset()         { *m = QueryHoldersResponse{} }
+func (m *QueryHoldersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryHoldersResponse) ProtoMessage()    {}
+func (*QueryHoldersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9fe336d9bdb8f05, []int{23}
+}
+func (m *QueryHoldersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHoldersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHoldersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryHoldersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHoldersResponse.Merge(m, src)
+}
+func (m *QueryHoldersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHoldersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHoldersResponse.DiscardUnknown(m)
 }
 
-func _Query_FrozenBalances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryFrozenBalancesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).FrozenBalances(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/coreum.asset.ft.v1.Query/FrozenBalances",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).FrozenBalances(ctx, req.(*QueryFrozenBalancesRequest))
+var xxx_messageInfo_QueryHoldersResponse proto.InternalMessageInfo
+
+func (m *QueryHoldersResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_FrozenBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryFrozenBalanceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).FrozenBalance(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/coreum.asset.ft.v1.Query/FrozenBalance",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).FrozenBalance(ctx, req.(*QueryFrozenBalanceRequest))
+func (m *QueryHoldersResponse) GetHolders() []Holder {
+	if m != nil {
+		return m.Holders
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_WhitelistedBalances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryWhitelistedBalancesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).WhitelistedBalances(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/coreum.asset.ft.v1.Query/WhitelistedBalances",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).WhitelistedBalances(ctx, req.(*QueryWhitelistedBalancesRequest))
+// Holder is the holder of the token with its balances.
+type Holder struct {
+	// account is the address of the holder
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// balance is the balance of the token on the account
+	Balance github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=balance,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"balance"`
+	// whitelisted is the whitelisted amount of the token on the account.
+	Whitelisted github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=whitelisted,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"whitelisted"`
+	// frozen is the frozen amount of the token on the account.
+	Frozen github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=frozen,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"frozen"`
+	// locked is the balance locked by vesting and the dex orders.
+	Locked github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=locked,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"locked"`
+	// blocked is true if the account is blocked from sending and receiving the token.
+	Blocked bool `protobuf:"varint,6,opt,name=blocked,proto3" json:"blocked,omitempty"`
+}
+
+func (m *Holder) Reset()         { *m = Holder{} }
+func (m *Holder) String() string { return proto.CompactTextString(m) }
+func (*Holder) ProtoMessage()    {}
+func (*Holder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9fe336d9bdb8f05, []int{24}
+}
+func (m *Holder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Holder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Holder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *Holder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Holder.Merge(m, src)
+}
+func (m *Holder) XXX_Size() int {
+	return m.Size()
+}
+func (m *Holder) XXX_DiscardUnknown() {
+	xxx_messageInfo_Holder.DiscardUnknown(m)
 }
 
-func _Query_WhitelistedBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryWhitelistedBalanceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).WhitelistedBalance(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/coreum.asset.ft.v1.Query/WhitelistedBalance",
+var xxx_messageInfo_Holder proto.InternalMessageInfo
+
+func (m *Holder) GetAccount() string {
+	if m != nil {
+		return m.Account
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).WhitelistedBalance(ctx, req.(*QueryWhitelistedBalanceRequest))
+	return ""
+}
+
+func (m *Holder) GetBlocked() bool {
+	if m != nil {
+		return m.Blocked
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "coreum.asset.ft.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "Tokens",
-			Handler:    _Query_Tokens_Handler,
-		},
-		{
-			MethodName: "Token",
-			Handler:    _Query_Token_Handler,
-		},
-		{
-			MethodName: "TokenUpgradeStatuses",
-			Handler:    _Query_TokenUpgradeStatuses_Handler,
-		},
-		{
-			MethodName: "Balance",
-			Handler:    _Query_Balance_Handler,
-		},
-		{
-			MethodName: "FrozenBalances",
-			Handler:    _Query_FrozenBalances_Handler,
-		},
-		{
-			MethodName: "FrozenBalance",
-			Handler:    _Query_FrozenBalance_Handler,
-		},
-		{
-			MethodName: "WhitelistedBalances",
-			Handler:    _Query_WhitelistedBalances_Handler,
-		},
-		{
-			MethodName: "WhitelistedBalance",
-			Handler:    _Query_WhitelistedBalance_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "coreum/asset/ft/v1/query.proto",
+type QueryTokensRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// issuer is the admin of the tokens to return.
+	Issuer string `protobuf:"bytes,2,opt,name=issuer,proto3" json:"issuer,omitempty"`
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryTokensRequest) Reset()         { *m = QueryTokensRequest{} }
+func (m *QueryTokensRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTokensRequest) ProtoMessage()    {}
+func (*QueryTokensRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9fe336d9bdb8f05, []int{25}
+}
+func (m *QueryTokensRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTokensRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTokensRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryTokensRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTokensRequest.Merge(m, src)
+}
+func (m *QueryTokensRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTokensRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTokensRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_QueryTokensRequest proto.InternalMessageInfo
+
+func (m *QueryTokensRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryTokensRequest) GetIssuer() string {
+	if m != nil {
+		return m.Issuer
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryTokensResponse struct {
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Tokens     []Token             `protobuf:"bytes,2,rep,name=tokens,proto3" json:"tokens"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryTokensResponse) Reset()         { *m = QueryTokensResponse{} }
+func (m *QueryTokensResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTokensResponse) ProtoMessage()    {}
+func (*QueryTokensResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9fe336d9bdb8f05, []int{26}
+}
+func (m *QueryTokensResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTokensResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTokensResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryTokenRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryTokensResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTokensResponse.Merge(m, src)
 }
-
-func (m *QueryTokenRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryTokensResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTokensResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTokensResponse.DiscardUnknown(m)
 }
 
-func (m *QueryTokenRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryTokensResponse proto.InternalMessageInfo
+
+func (m *QueryTokensResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryTokenResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryTokensResponse) GetTokens() []Token {
+	if m != nil {
+		return m.Tokens
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryTokenResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryBalanceRequest struct {
+	// account specifies the account onto which we query balances
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// denom specifies balances on a specific denom
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-func (m *QueryTokenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Token.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryBalanceRequest) Reset()         { *m = QueryBalanceRequest{} }
+func (m *QueryBalanceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBalanceRequest) ProtoMessage()    {}
+func (*QueryBalanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9fe336d9bdb8f05, []int{27}
+}
+func (m *QueryBalanceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBalanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBalanceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryTokenUpgradeStatusesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryBalanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBalanceRequest.Merge(m, src)
 }
-
-func (m *QueryTokenUpgradeStatusesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryBalanceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBalanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBalanceRequest.DiscardUnknown(m)
 }
 
-func (m *QueryTokenUpgradeStatusesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryBalanceRequest proto.InternalMessageInfo
+
+func (m *QueryBalanceRequest) GetAccount() string {
+	if m != nil {
+		return m.Account
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryTokenUpgradeStatusesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryBalanceRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryTokenUpgradeStatusesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryBalanceResponse struct {
+	// balance contains the balance with the queried account and denom
+	Balance github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=balance,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"balance"`
+	// whitelisted is the whitelisted amount of the denom on the account.
+	Whitelisted github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=whitelisted,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"whitelisted"`
+	// frozen is the frozen amount of the denom on the account.
+	Frozen github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=frozen,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"frozen"`
+	// locked is the balance locked by vesting and the dex orders.
+	Locked github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=locked,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"locked"`
 }
 
-func (m *QueryTokenUpgradeStatusesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Statuses.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryBalanceResponse) Reset()         { *m = QueryBalanceResponse{} }
+func (m *QueryBalanceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBalanceResponse) ProtoMessage()    {}
+func (*QueryBalanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9fe336d9bdb8f05, []int{28}
+}
+func (m *QueryBalanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBalanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBalanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryTokensRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryBalanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBalanceResponse.Merge(m, src)
+}
+func (m *QueryBalanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBalanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBalanceResponse.DiscardUnknown(m)
 }
 
-func (m *QueryTokensRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryBalanceResponse proto.InternalMessageInfo
+
+type QueryFrozenBalancesRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// account specifies the account onto which we query frozen balances
+	Account string `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
 }
 
-func (m *QueryTokensRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Issuer) > 0 {
-		i -= len(m.Issuer)
-		copy(dAtA[i:], m.Issuer)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Issuer)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryFrozenBalancesRequest) Reset()         { *m = QueryFrozenBalancesRequest{} }
+func (m *QueryFrozenBalancesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFrozenBalancesRequest) ProtoMessage()    {}
+func (*QueryFrozenBalancesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9fe336d9bdb8f05, []int{29}
+}
+func (m *QueryFrozenBalancesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFrozenBalancesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFrozenBalancesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryTokensResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryFrozenBalancesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFrozenBalancesRequest.Merge(m, src)
 }
-
-func (m *QueryTokensResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryFrozenBalancesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFrozenBalancesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFrozenBalancesRequest.DiscardUnknown(m)
 }
 
-func (m *QueryTokensResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Tokens) > 0 {
-		for iNdEx := len(m.Tokens) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Tokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryFrozenBalancesRequest proto.InternalMessageInfo
+
+func (m *QueryFrozenBalancesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryBalanceRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryFrozenBalancesRequest) GetAccount() string {
+	if m != nil {
+		return m.Account
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryBalanceRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryFrozenBalancesResponse struct {
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// balances contains the frozen balances on the queried account
+	Balances github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=balances,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"balances"`
 }
 
-func (m *QueryBalanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x12
+func (m *QueryFrozenBalancesResponse) Reset()         { *m = QueryFrozenBalancesResponse{} }
+func (m *QueryFrozenBalancesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFrozenBalancesResponse) ProtoMessage()    {}
+func (*QueryFrozenBalancesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9fe336d9bdb8f05, []int{30}
+}
+func (m *QueryFrozenBalancesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFrozenBalancesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFrozenBalancesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Account) > 0 {
-		i -= len(m.Account)
-		copy(dAtA[i:], m.Account)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *QueryFrozenBalancesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFrozenBalancesResponse.Merge(m, src)
+}
+func (m *QueryFrozenBalancesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFrozenBalancesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFrozenBalancesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFrozenBalancesResponse proto.InternalMessageInfo
+
+func (m *QueryFrozenBalancesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryBalanceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryFrozenBalancesResponse) GetBalances() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Balances
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryBalanceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryFrozenBalanceRequest struct {
+	// account specifies the account onto which we query frozen balances
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// denom specifies frozen balances on a specific denom
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-func (m *QueryBalanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Locked.Size()
-		i -= size
-		if _, err := m.Locked.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.Frozen.Size()
-		i -= size
-		if _, err := m.Frozen.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.Whitelisted.Size()
-		i -= size
-		if _, err := m.Whitelisted.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.Balance.Size()
-		i -= size
-		if _, err := m.Balance.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *QueryFrozenBalanceRequest) Reset()         { *m = QueryFrozenBalanceRequest{} }
+func (m *QueryFrozenBalanceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFrozenBalanceRequest) ProtoMessage()    {}
+func (*QueryFrozenBalanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9fe336d9bdb8f05, []int{31}
+}
+func (m *QueryFrozenBalanceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFrozenBalanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFrozenBalanceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryFrozenBalancesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryFrozenBalanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFrozenBalanceRequest.Merge(m, src)
 }
-
-func (m *QueryFrozenBalancesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryFrozenBalanceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFrozenBalanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFrozenBalanceRequest.DiscardUnknown(m)
 }
 
-func (m *QueryFrozenBalancesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Account) > 0 {
-		i -= len(m.Account)
-		copy(dAtA[i:], m.Account)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryFrozenBalanceRequest proto.InternalMessageInfo
+
+func (m *QueryFrozenBalanceRequest) GetAccount() string {
+	if m != nil {
+		return m.Account
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryFrozenBalancesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryFrozenBalanceRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryFrozenBalancesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryFrozenBalanceResponse struct {
+	// balance contains the frozen balance with the queried account and denom
+	Balance types.Coin `protobuf:"bytes,1,opt,name=balance,proto3" json:"balance"`
+	// scheduled_unfreezes contains the portions of the frozen balance to be unfrozen automatically
+	ScheduledUnfreezes []ScheduledUnfreeze `protobuf:"bytes,2,rep,name=scheduled_unfreezes,json=scheduledUnfreezes,proto3" json:"scheduled_unfreezes"`
 }
 
-func (m *QueryFrozenBalancesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Balances) > 0 {
-		for iNdEx := len(m.Balances) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Balances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryFrozenBalanceResponse) Reset()         { *m = QueryFrozenBalanceResponse{} }
+func (m *QueryFrozenBalanceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFrozenBalanceResponse) ProtoMessage()    {}
+func (*QueryFrozenBalanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9fe336d9bdb8f05, []int{32}
+}
+func (m *QueryFrozenBalanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFrozenBalanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFrozenBalanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryFrozenBalanceRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryFrozenBalanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFrozenBalanceResponse.Merge(m, src)
 }
-
-func (m *QueryFrozenBalanceRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryFrozenBalanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFrozenBalanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFrozenBalanceResponse.DiscardUnknown(m)
 }
 
-func (m *QueryFrozenBalanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Account) > 0 {
-		i -= len(m.Account)
-		copy(dAtA[i:], m.Account)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryFrozenBalanceResponse proto.InternalMessageInfo
+
+func (m *QueryFrozenBalanceResponse) GetBalance() types.Coin {
+	if m != nil {
+		return m.Balance
 	}
-	return len(dAtA) - i, nil
+	return types.Coin{}
 }
 
-func (m *QueryFrozenBalanceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryFrozenBalanceResponse) GetScheduledUnfreezes() []ScheduledUnfreeze {
+	if m != nil {
+		return m.ScheduledUnfreezes
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryFrozenBalanceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryWhitelistedBalancesRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// account specifies the account onto which we query whitelisted balances
+	Account string `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
 }
 
-func (m *QueryFrozenBalanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Balance.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+func (m *QueryWhitelistedBalancesRequest) Reset()         { *m = QueryWhitelistedBalancesRequest{} }
+func (m *QueryWhitelistedBalancesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryWhitelistedBalancesRequest) ProtoMessage()    {}
+func (*QueryWhitelistedBalancesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9fe336d9bdb8f05, []int{33}
 }
-
-func (m *QueryWhitelistedBalancesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryWhitelistedBalancesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryWhitelistedBalancesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWhitelistedBalancesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryWhitelistedBalancesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryWhitelistedBalancesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWhitelistedBalancesRequest.Merge(m, src)
+}
+func (m *QueryWhitelistedBalancesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWhitelistedBalancesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWhitelistedBalancesRequest.DiscardUnknown(m)
 }
 
-func (m *QueryWhitelistedBalancesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Account) > 0 {
-		i -= len(m.Account)
-		copy(dAtA[i:], m.Account)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryWhitelistedBalancesRequest proto.InternalMessageInfo
+
+func (m *QueryWhitelistedBalancesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryWhitelistedBalancesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryWhitelistedBalancesRequest) GetAccount() string {
+	if m != nil {
+		return m.Account
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryWhitelistedBalancesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryWhitelistedBalancesResponse struct {
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// balances contains the whitelisted balances on the queried account
+	Balances github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=balances,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"balances"`
 }
 
-func (m *QueryWhitelistedBalancesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Balances) > 0 {
-		for iNdEx := len(m.Balances) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Balances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryWhitelistedBalancesResponse) Reset()         { *m = QueryWhitelistedBalancesResponse{} }
+func (m *QueryWhitelistedBalancesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryWhitelistedBalancesResponse) ProtoMessage()    {}
+func (*QueryWhitelistedBalancesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9fe336d9bdb8f05, []int{34}
+}
+func (m *QueryWhitelistedBalancesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryWhitelistedBalancesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWhitelistedBalancesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryWhitelistedBalanceRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryWhitelistedBalancesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWhitelistedBalancesResponse.Merge(m, src)
 }
-
-func (m *QueryWhitelistedBalanceRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryWhitelistedBalancesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWhitelistedBalancesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWhitelistedBalancesResponse.DiscardUnknown(m)
 }
 
-func (m *QueryWhitelistedBalanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Account) > 0 {
-		i -= len(m.Account)
-		copy(dAtA[i:], m.Account)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryWhitelistedBalancesResponse proto.InternalMessageInfo
+
+func (m *QueryWhitelistedBalancesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryWhitelistedBalanceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryWhitelistedBalancesResponse) GetBalances() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Balances
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryWhitelistedBalanceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryWhitelistedBalanceRequest struct {
+	// account specifies the account onto which we query whitelisted balances
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// denom specifies whitelisted balances on a specific denom
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-func (m *QueryWhitelistedBalanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Balance.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryWhitelistedBalanceRequest) Reset()         { *m = QueryWhitelistedBalanceRequest{} }
+func (m *QueryWhitelistedBalanceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryWhitelistedBalanceRequest) ProtoMessage()    {}
+func (*QueryWhitelistedBalanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9fe336d9bdb8f05, []int{35}
+}
+func (m *QueryWhitelistedBalanceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryWhitelistedBalanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWhitelistedBalanceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryWhitelistedBalanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWhitelistedBalanceRequest.Merge(m, src)
+}
+func (m *QueryWhitelistedBalanceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWhitelistedBalanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWhitelistedBalanceRequest.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_QueryWhitelistedBalanceRequest proto.InternalMessageInfo
+
+func (m *QueryWhitelistedBalanceRequest) GetAccount() string {
+	if m != nil {
+		return m.Account
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *QueryWhitelistedBalanceRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	var l int
-	_ = l
-	return n
+	return ""
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+type QueryWhitelistedBalanceResponse struct {
+	// balance contains the whitelisted balance with the queried account and denom
+	Balance types.Coin `protobuf:"bytes,1,opt,name=balance,proto3" json:"balance"`
+}
+
+func (m *QueryWhitelistedBalanceResponse) Reset()         { *m = QueryWhitelistedBalanceResponse{} }
+func (m *QueryWhitelistedBalanceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryWhitelistedBalanceResponse) ProtoMessage()    {}
+func (*QueryWhitelistedBalanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9fe336d9bdb8f05, []int{36}
+}
+func (m *QueryWhitelistedBalanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryWhitelistedBalanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWhitelistedBalanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+}
+func (m *QueryWhitelistedBalanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWhitelistedBalanceResponse.Merge(m, src)
+}
+func (m *QueryWhitelistedBalanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWhitelistedBalanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWhitelistedBalanceResponse.DiscardUnknown(m)
 }
 
-func (m *QueryTokenRequest) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryWhitelistedBalanceResponse proto.InternalMessageInfo
+
+func (m *QueryWhitelistedBalanceResponse) GetBalance() types.Coin {
+	if m != nil {
+		return m.Balance
 	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return types.Coin{}
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "coreum.asset.ft.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "coreum.asset.ft.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryTokenRequest)(nil), "coreum.asset.ft.v1.QueryTokenRequest")
+	proto.RegisterType((*QueryTokenResponse)(nil), "coreum.asset.ft.v1.QueryTokenResponse")
+	proto.RegisterType((*QueryTokenUpgradeStatusesRequest)(nil), "coreum.asset.ft.v1.QueryTokenUpgradeStatusesRequest")
+	proto.RegisterType((*QueryTokenUpgradeStatusesResponse)(nil), "coreum.asset.ft.v1.QueryTokenUpgradeStatusesResponse")
+	proto.RegisterType((*QueryRateExemptionsRequest)(nil), "coreum.asset.ft.v1.QueryRateExemptionsRequest")
+	proto.RegisterType((*QueryRateExemptionsResponse)(nil), "coreum.asset.ft.v1.QueryRateExemptionsResponse")
+	proto.RegisterType((*QueryBlockedAccountsRequest)(nil), "coreum.asset.ft.v1.QueryBlockedAccountsRequest")
+	proto.RegisterType((*QueryBlockedAccountsResponse)(nil), "coreum.asset.ft.v1.QueryBlockedAccountsResponse")
+	proto.RegisterType((*QueryBlockedAccountRequest)(nil), "coreum.asset.ft.v1.QueryBlockedAccountRequest")
+	proto.RegisterType((*QueryBlockedAccountResponse)(nil), "coreum.asset.ft.v1.QueryBlockedAccountResponse")
+	proto.RegisterType((*QueryDistributionRequest)(nil), "coreum.asset.ft.v1.QueryDistributionRequest")
+	proto.RegisterType((*QueryDistributionResponse)(nil), "coreum.asset.ft.v1.QueryDistributionResponse")
+	proto.RegisterType((*QueryDistributionClaimableRequest)(nil), "coreum.asset.ft.v1.QueryDistributionClaimableRequest")
+	proto.RegisterType((*QueryDistributionClaimableResponse)(nil), "coreum.asset.ft.v1.QueryDistributionClaimableResponse")
+	proto.RegisterType((*QueryTransferLimitsRequest)(nil), "coreum.asset.ft.v1.QueryTransferLimitsRequest")
+	proto.RegisterType((*QueryTransferLimitsResponse)(nil), "coreum.asset.ft.v1.QueryTransferLimitsResponse")
+	proto.RegisterType((*QueryTransferLimitRequest)(nil), "coreum.asset.ft.v1.QueryTransferLimitRequest")
+	proto.RegisterType((*QueryTransferLimitResponse)(nil), "coreum.asset.ft.v1.QueryTransferLimitResponse")
+	proto.RegisterType((*QueryVestingSchedulesRequest)(nil), "coreum.asset.ft.v1.QueryVestingSchedulesRequest")
+	proto.RegisterType((*QueryVestingSchedulesResponse)(nil), "coreum.asset.ft.v1.QueryVestingSchedulesResponse")
+	proto.RegisterType((*QueryHoldersRequest)(nil), "coreum.asset.ft.v1.QueryHoldersRequest")
+	proto.RegisterType((*QueryHoldersResponse)(nil), "coreum.asset.ft.v1.QueryHoldersResponse")
+	proto.RegisterType((*Holder)(nil), "coreum.asset.ft.v1.Holder")
+	proto.RegisterType((*QueryTokensRequest)(nil), "coreum.asset.ft.v1.QueryTokensRequest")
+	proto.RegisterType((*QueryTokensResponse)(nil), "coreum.asset.ft.v1.QueryTokensResponse")
+	proto.RegisterType((*QueryBalanceRequest)(nil), "coreum.asset.ft.v1.QueryBalanceRequest")
+	proto.RegisterType((*QueryBalanceResponse)(nil), "coreum.asset.ft.v1.QueryBalanceResponse")
+	proto.RegisterType((*QueryFrozenBalancesRequest)(nil), "coreum.asset.ft.v1.QueryFrozenBalancesRequest")
+	proto.RegisterType((*QueryFrozenBalancesResponse)(nil), "coreum.asset.ft.v1.QueryFrozenBalancesResponse")
+	proto.RegisterType((*QueryFrozenBalanceRequest)(nil), "coreum.asset.ft.v1.QueryFrozenBalanceRequest")
+	proto.RegisterType((*QueryFrozenBalanceResponse)(nil), "coreum.asset.ft.v1.QueryFrozenBalanceResponse")
+	proto.RegisterType((*QueryWhitelistedBalancesRequest)(nil), "coreum.asset.ft.v1.QueryWhitelistedBalancesRequest")
+	proto.RegisterType((*QueryWhitelistedBalancesResponse)(nil), "coreum.asset.ft.v1.QueryWhitelistedBalancesResponse")
+	proto.RegisterType((*QueryWhitelistedBalanceRequest)(nil), "coreum.asset.ft.v1.QueryWhitelistedBalanceRequest")
+	proto.RegisterType((*QueryWhitelistedBalanceResponse)(nil), "coreum.asset.ft.v1.QueryWhitelistedBalanceResponse")
+}
+
+func init() { proto.RegisterFile("coreum/asset/ft/v1/query.proto", fileDescriptor_e9fe336d9bdb8f05) }
+
+var fileDescriptor_e9fe336d9bdb8f05 = []byte{
+	// 1704 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x59, 0xcd, 0x6f, 0xd4, 0xd6,
+	0x16, 0xcf, 0xcd, 0xc7, 0x24, 0x1c, 0x20, 0xc0, 0x4d, 0xde, 0xd3, 0xe0, 0xc7, 0x9b, 0x04, 0xbf,
+	0x47, 0xc8, 0x8b, 0x18, 0x9b, 0x24, 0x84, 0xf0, 0xf1, 0x80, 0x92, 0x90, 0x90, 0x16, 0xd4, 0xa6,
+	0xc3, 0x47, 0xa5, 0x8a, 0x0a, 0x79, 0x66, 0x6e, 0x26, 0x16, 0x33, 0xf6, 0x60, 0x7b, 0x52, 0x3e,
+	0x44, 0x17, 0x74, 0xd9, 0x0d, 0x52, 0x17, 0x5d, 0x74, 0xdb, 0x6e, 0x2a, 0x75, 0x81, 0xd4, 0x56,
+	0xec, 0x5a, 0x55, 0x42, 0xa2, 0xdd, 0x14, 0xa9, 0x5d, 0x54, 0x5d, 0xd0, 0x0a, 0xba, 0xe8, 0x1f,
+	0xd0, 0x3f, 0xa0, 0xf2, 0xf5, 0xb1, 0xc7, 0x9e, 0x5c, 0xcf, 0x78, 0x86, 0x51, 0xa4, 0xae, 0x32,
+	0xf6, 0x3d, 0xe7, 0xfc, 0x7e, 0xe7, 0xdc, 0xdf, 0xf5, 0xbd, 0xf7, 0x04, 0x32, 0x05, 0xd3, 0x62,
+	0xb5, 0x8a, 0xaa, 0xd9, 0x36, 0x73, 0xd4, 0x35, 0x47, 0xdd, 0x98, 0x56, 0x6f, 0xd6, 0x98, 0x75,
+	0x5b, 0xa9, 0x5a, 0xa6, 0x63, 0x52, 0xea, 0x8d, 0x2b, 0x7c, 0x5c, 0x59, 0x73, 0x94, 0x8d, 0x69,
+	0x69, 0xb4, 0x64, 0x96, 0x4c, 0x3e, 0xac, 0xba, 0xbf, 0x3c, 0x4b, 0x69, 0x5f, 0xc9, 0x34, 0x4b,
+	0x65, 0xa6, 0x6a, 0x55, 0x5d, 0xd5, 0x0c, 0xc3, 0x74, 0x34, 0x47, 0x37, 0x0d, 0x1b, 0x47, 0x33,
+	0x05, 0xd3, 0xae, 0x98, 0xb6, 0x9a, 0xd7, 0x6c, 0xa6, 0x6e, 0x4c, 0xe7, 0x99, 0xa3, 0x4d, 0xab,
+	0x05, 0x53, 0x37, 0x70, 0x7c, 0x2a, 0x3c, 0xce, 0x09, 0x04, 0x56, 0x55, 0xad, 0xa4, 0x1b, 0x3c,
+	0x58, 0x3d, 0xd6, 0x26, 0xce, 0x8e, 0x79, 0x83, 0xf9, 0xe3, 0x63, 0x82, 0xf1, 0xaa, 0x66, 0x69,
+	0x15, 0x24, 0x23, 0x8f, 0x02, 0x7d, 0xd3, 0x85, 0x58, 0xe5, 0x2f, 0x73, 0xec, 0x66, 0x8d, 0xd9,
+	0x8e, 0xfc, 0x06, 0x8c, 0x44, 0xde, 0xda, 0x55, 0xd3, 0xb0, 0x19, 0x3d, 0x06, 0x29, 0xcf, 0x39,
+	0x4d, 0xc6, 0xc9, 0xe4, 0xf6, 0x19, 0x49, 0xd9, 0x5c, 0x12, 0xc5, 0xf3, 0x59, 0xe8, 0x7f, 0xf2,
+	0x6c, 0xac, 0x27, 0x87, 0xf6, 0xf2, 0xff, 0x60, 0x0f, 0x0f, 0x78, 0xd9, 0xe5, 0x86, 0x28, 0x74,
+	0x14, 0x06, 0x8a, 0xcc, 0x30, 0x2b, 0x3c, 0xda, 0xb6, 0x9c, 0xf7, 0x20, 0x5f, 0x00, 0x1a, 0x36,
+	0x45, 0xe8, 0x39, 0x18, 0xe0, 0x79, 0x21, 0xf2, 0x5e, 0x11, 0x32, 0xf7, 0x40, 0x60, 0xcf, 0x5a,
+	0x3e, 0x06, 0xe3, 0xf5, 0x60, 0x57, 0xaa, 0x25, 0x4b, 0x2b, 0xb2, 0x4b, 0x8e, 0xe6, 0xd4, 0x6c,
+	0x66, 0x37, 0xa7, 0x61, 0xc2, 0xfe, 0x26, 0x9e, 0xc8, 0xea, 0x35, 0x18, 0xb2, 0xf1, 0x1d, 0x12,
+	0x9b, 0x8c, 0x25, 0xd6, 0x10, 0x03, 0x79, 0x06, 0xfe, 0xf2, 0x1d, 0x90, 0x38, 0x60, 0x4e, 0x73,
+	0xd8, 0xd2, 0x2d, 0x56, 0xa9, 0x72, 0xcd, 0xf8, 0x24, 0x97, 0x01, 0xea, 0x93, 0x8f, 0x58, 0x13,
+	0x8a, 0xa7, 0x14, 0xc5, 0x55, 0x8a, 0xe2, 0x49, 0x15, 0x95, 0xa2, 0xac, 0x6a, 0x25, 0x86, 0xbe,
+	0xb9, 0x90, 0x67, 0x3d, 0xd9, 0xde, 0x70, 0xb2, 0xf7, 0x09, 0xfc, 0x4b, 0x08, 0x8e, 0x79, 0x9e,
+	0x17, 0xa0, 0x1f, 0x6c, 0x89, 0xee, 0x39, 0x47, 0xe0, 0x25, 0x18, 0xd2, 0x0a, 0x05, 0xb3, 0x66,
+	0x38, 0x76, 0xba, 0x77, 0xbc, 0x6f, 0x72, 0x5b, 0x2e, 0x78, 0x96, 0xef, 0x22, 0x87, 0x85, 0xb2,
+	0x59, 0xb8, 0xc1, 0x8a, 0x67, 0xf1, 0xfd, 0xd6, 0x54, 0xe0, 0x7d, 0x02, 0xfb, 0xc4, 0xe8, 0x5b,
+	0x59, 0x82, 0x8b, 0x20, 0x09, 0x48, 0x34, 0x15, 0x2a, 0x4d, 0xc3, 0x20, 0xfa, 0x63, 0x46, 0xfe,
+	0xa3, 0x3c, 0x2f, 0x2c, 0x68, 0x90, 0x51, 0x1a, 0x06, 0xf3, 0xde, 0x08, 0x0f, 0x38, 0x94, 0xf3,
+	0x1f, 0xe5, 0xc3, 0x90, 0xe6, 0x8e, 0xe7, 0x74, 0xdb, 0xb1, 0xf4, 0x7c, 0xcd, 0xe5, 0xdd, 0x7c,
+	0xb5, 0x94, 0x60, 0xaf, 0xc0, 0x23, 0x58, 0x25, 0x3b, 0x8a, 0xa1, 0xf7, 0x58, 0xbc, 0x71, 0xd1,
+	0x4a, 0x09, 0xfb, 0xe3, 0x0a, 0x89, 0xf8, 0xca, 0x97, 0x70, 0x59, 0x86, 0x0d, 0x17, 0xcb, 0x9a,
+	0x5e, 0xd1, 0xf2, 0x65, 0xd6, 0x69, 0xa1, 0xde, 0x01, 0xb9, 0x59, 0x50, 0x4c, 0x63, 0x1e, 0x52,
+	0x5a, 0x85, 0xbb, 0xd7, 0xbf, 0x41, 0xf5, 0xd9, 0xf7, 0xe7, 0x7d, 0xd1, 0xd4, 0x7d, 0xe6, 0x68,
+	0x1e, 0xac, 0xec, 0xcb, 0x96, 0x66, 0xd8, 0x6b, 0xcc, 0xba, 0xa8, 0x57, 0xf4, 0xad, 0xd2, 0xf5,
+	0x23, 0x7f, 0x65, 0x37, 0x82, 0x77, 0x5b, 0xd6, 0xab, 0xb0, 0xcb, 0x41, 0x88, 0xeb, 0x65, 0x8e,
+	0xc1, 0xd5, 0xbd, 0x7d, 0x66, 0xbf, 0xf0, 0x8b, 0x18, 0x66, 0x83, 0xe5, 0x1a, 0x76, 0x22, 0x14,
+	0xe5, 0x0b, 0xa8, 0xa9, 0x88, 0x6d, 0xa7, 0x53, 0xfc, 0x27, 0x11, 0x4d, 0x42, 0x50, 0x86, 0x15,
+	0x18, 0x8e, 0xb2, 0xc7, 0x52, 0xb4, 0x26, 0x9f, 0xdb, 0x19, 0xa1, 0x4d, 0x17, 0xa0, 0xdf, 0x66,
+	0x3e, 0xfe, 0x82, 0xe2, 0x66, 0xf6, 0xcb, 0xb3, 0xb1, 0x89, 0x92, 0xee, 0xac, 0xd7, 0xf2, 0x4a,
+	0xc1, 0xac, 0xa8, 0xb8, 0xbd, 0x7b, 0x7f, 0xb2, 0x76, 0xf1, 0x86, 0xea, 0xdc, 0xae, 0x32, 0x5b,
+	0x79, 0xd5, 0x70, 0x72, 0xdc, 0x97, 0xae, 0xc0, 0x36, 0x8b, 0x55, 0x34, 0xdd, 0xd0, 0x8d, 0x52,
+	0xba, 0x8f, 0x07, 0x9a, 0x6a, 0x23, 0x48, 0xdd, 0x59, 0x7e, 0x1d, 0xbf, 0x6a, 0x57, 0x99, 0xed,
+	0xe8, 0x46, 0xe9, 0x52, 0x61, 0x9d, 0x15, 0x6b, 0x65, 0x66, 0x77, 0x5a, 0xc6, 0x0f, 0x7a, 0xe1,
+	0xdf, 0x31, 0x01, 0xb1, 0x92, 0x57, 0x61, 0xcf, 0x86, 0x37, 0x76, 0xdd, 0xf6, 0x07, 0xd3, 0x84,
+	0x2b, 0xe1, 0x3f, 0xa2, 0x62, 0x36, 0x04, 0x42, 0x2d, 0xec, 0xde, 0x68, 0x88, 0x4f, 0x97, 0x21,
+	0xe5, 0xbe, 0x63, 0xc5, 0x0e, 0x2b, 0x8b, 0xde, 0xee, 0x96, 0x5d, 0x33, 0x30, 0x52, 0x5f, 0x47,
+	0x91, 0x02, 0x7f, 0xd9, 0xc6, 0x63, 0xd2, 0x8a, 0x59, 0x2e, 0x32, 0x6b, 0x8b, 0x56, 0xf4, 0xc7,
+	0x04, 0x46, 0xa3, 0xa8, 0xdd, 0x5e, 0xca, 0x27, 0x60, 0x70, 0xdd, 0x8b, 0x8d, 0x4b, 0x58, 0x78,
+	0xce, 0xf3, 0xe0, 0x71, 0xbe, 0x7c, 0x07, 0xf9, 0x8f, 0x5e, 0x48, 0x79, 0x23, 0x61, 0x15, 0x91,
+	0x88, 0x8a, 0xe8, 0x0a, 0x0c, 0xe6, 0xb5, 0xb2, 0x66, 0x14, 0x58, 0x87, 0x93, 0xe9, 0xbb, 0xd3,
+	0x55, 0xd8, 0xfe, 0xee, 0xba, 0xee, 0xb0, 0xb2, 0xfe, 0x12, 0x13, 0x1a, 0x0e, 0xe1, 0xea, 0x6c,
+	0xcd, 0x32, 0xef, 0x30, 0x23, 0xdd, 0xdf, 0x99, 0xce, 0x3c, 0x6f, 0x37, 0x0e, 0x6e, 0xae, 0x03,
+	0x9d, 0xc5, 0xf1, 0xbc, 0xc3, 0xbb, 0x74, 0x2a, 0xba, 0x4b, 0x3b, 0xe1, 0x83, 0x72, 0xd7, 0xc5,
+	0xf7, 0x4f, 0x48, 0xe9, 0xb6, 0x5d, 0x63, 0x16, 0xaa, 0x0f, 0x9f, 0xe4, 0x8f, 0x08, 0x8c, 0x44,
+	0x60, 0xbb, 0xad, 0xbe, 0x79, 0x48, 0xf1, 0xb3, 0xbb, 0x2f, 0xbe, 0x96, 0x47, 0x7d, 0x34, 0x97,
+	0x97, 0x90, 0xd8, 0x82, 0xa7, 0x0d, 0xbf, 0x20, 0xf1, 0x32, 0x14, 0xaf, 0xaf, 0x6f, 0x7b, 0x61,
+	0x34, 0x1a, 0x27, 0xd8, 0x23, 0x02, 0xd5, 0x92, 0xae, 0xaa, 0xb6, 0xb7, 0x9b, 0xaa, 0xed, 0xeb,
+	0x92, 0x6a, 0xfb, 0x5f, 0x46, 0xb5, 0xf2, 0x7b, 0xb8, 0xdb, 0x2e, 0xf3, 0xb0, 0x58, 0xc9, 0xae,
+	0x6b, 0x34, 0x7e, 0x9f, 0xfa, 0xc1, 0x3f, 0xf6, 0x34, 0x12, 0xe8, 0xb6, 0x5a, 0x4b, 0x30, 0x84,
+	0xb3, 0x1a, 0xd6, 0x6b, 0xcc, 0xb1, 0xf0, 0xb0, 0x5b, 0xcd, 0xcf, 0x7e, 0x1d, 0x9b, 0x4c, 0x50,
+	0x4d, 0xd7, 0xc1, 0xce, 0x05, 0xc1, 0x83, 0xd3, 0x50, 0x24, 0xa1, 0x4e, 0x35, 0xfe, 0x25, 0x11,
+	0xcd, 0x4f, 0x50, 0x9d, 0xe3, 0x51, 0xa5, 0x27, 0x38, 0xea, 0x06, 0xd2, 0xbe, 0x06, 0x23, 0xfe,
+	0xb6, 0x5f, 0xbc, 0x5e, 0x33, 0xd6, 0x2c, 0xc6, 0xee, 0x04, 0xa5, 0x39, 0x20, 0x5a, 0xca, 0xfe,
+	0x16, 0x5f, 0xbc, 0x82, 0xd6, 0x18, 0x92, 0xda, 0x8d, 0x03, 0xb6, 0x7b, 0x4b, 0x1b, 0xe3, 0xbc,
+	0xdf, 0xaa, 0x6b, 0x7f, 0xeb, 0xc5, 0xf5, 0x13, 0x81, 0xf1, 0x78, 0x16, 0x7f, 0x5b, 0x85, 0xad,
+	0x42, 0x26, 0x26, 0xab, 0x4e, 0x65, 0x76, 0x2d, 0x76, 0xb6, 0xba, 0x20, 0xb5, 0x99, 0xc7, 0x69,
+	0x18, 0xe0, 0xe1, 0xe9, 0x3d, 0x48, 0x79, 0x5d, 0x27, 0x3a, 0x21, 0x52, 0xd8, 0xe6, 0x06, 0x97,
+	0x74, 0xb0, 0xa5, 0x9d, 0xc7, 0x4f, 0x96, 0xef, 0xff, 0xf8, 0xfb, 0x87, 0xbd, 0xfb, 0xa8, 0xa4,
+	0xc6, 0x76, 0xd2, 0x5c, 0x78, 0x6f, 0x33, 0x6c, 0x02, 0x1f, 0xd9, 0xa4, 0xa5, 0x83, 0x2d, 0xed,
+	0x92, 0xc0, 0x7b, 0xfb, 0x1e, 0xbd, 0x4f, 0x60, 0x80, 0xbb, 0xd1, 0x03, 0xcd, 0xc3, 0xfa, 0xe8,
+	0x13, 0xad, 0xcc, 0x10, 0x7c, 0x8a, 0x83, 0xff, 0x97, 0xca, 0xf1, 0xe0, 0xea, 0x5d, 0x3e, 0xd3,
+	0xf7, 0xe8, 0x37, 0x04, 0x46, 0x45, 0x6d, 0x2e, 0x7a, 0xa4, 0x39, 0x98, 0xb8, 0x27, 0x27, 0xcd,
+	0xb5, 0xe9, 0x85, 0x8c, 0x4f, 0x72, 0xc6, 0x73, 0x74, 0xb6, 0x35, 0x63, 0xb5, 0xe6, 0xc5, 0xc8,
+	0xfa, 0x0d, 0x38, 0xfa, 0x39, 0x81, 0xe1, 0x68, 0xff, 0x8b, 0x2a, 0xb1, 0x34, 0x84, 0x5d, 0x3a,
+	0x49, 0x4d, 0x6c, 0x8f, 0x84, 0x4f, 0x70, 0xc2, 0x47, 0xe8, 0x4c, 0x02, 0xc2, 0x96, 0xe6, 0xb0,
+	0x2c, 0xab, 0x93, 0x7b, 0x48, 0x60, 0x57, 0x43, 0xb7, 0x8a, 0xc6, 0x13, 0x10, 0x77, 0xd5, 0xa4,
+	0xc3, 0xc9, 0x1d, 0x3a, 0xa8, 0x31, 0x1e, 0x55, 0xb3, 0x7e, 0x83, 0x8b, 0x3e, 0x22, 0x30, 0x1c,
+	0x0d, 0xdc, 0xa4, 0xc6, 0xc2, 0x2e, 0x98, 0xa4, 0x26, 0xb6, 0x47, 0xc2, 0x4b, 0x9c, 0xf0, 0x19,
+	0x7a, 0xaa, 0x03, 0xc2, 0xea, 0x5d, 0xfc, 0x75, 0x8f, 0x7e, 0x42, 0x60, 0x47, 0xb8, 0x41, 0x44,
+	0x0f, 0xc5, 0x12, 0x11, 0xf4, 0xcd, 0xa4, 0x6c, 0x42, 0x6b, 0x24, 0x3d, 0xcf, 0x49, 0x4f, 0x53,
+	0x35, 0x01, 0xe9, 0x70, 0x83, 0x8c, 0x7e, 0x47, 0xe0, 0x1f, 0xc2, 0x3e, 0x16, 0x9d, 0x4b, 0xc4,
+	0xa0, 0xb1, 0x99, 0x26, 0x1d, 0x6d, 0xd7, 0x0d, 0x33, 0x38, 0xcb, 0x33, 0x38, 0x49, 0x8f, 0xb7,
+	0x99, 0x41, 0xa8, 0xe4, 0xee, 0x8a, 0x8c, 0xf6, 0xad, 0x9a, 0xa8, 0x45, 0xd8, 0x5d, 0x93, 0xd4,
+	0xc4, 0xf6, 0x1d, 0xac, 0x48, 0xbf, 0xf3, 0x93, 0xf5, 0x1a, 0x5e, 0xf4, 0x0b, 0x02, 0x3b, 0x23,
+	0x61, 0x69, 0x36, 0x19, 0xbc, 0xcf, 0x56, 0x49, 0x6a, 0x8e, 0x64, 0xcf, 0x71, 0xb2, 0xa7, 0xe9,
+	0xff, 0xdb, 0x27, 0x1b, 0x2a, 0xf3, 0xd7, 0x04, 0x76, 0x37, 0xf6, 0x73, 0x68, 0xfc, 0x87, 0x21,
+	0xa6, 0x97, 0x24, 0x4d, 0xb7, 0xe1, 0x81, 0xfc, 0x97, 0x39, 0xff, 0x57, 0xe8, 0xe9, 0x04, 0xfc,
+	0xb1, 0x23, 0x94, 0x0d, 0xba, 0x4a, 0xa1, 0x0c, 0x1e, 0x10, 0x18, 0xc4, 0x76, 0x08, 0x8d, 0xdf,
+	0x5b, 0xa3, 0x6d, 0x1a, 0x69, 0xb2, 0xb5, 0x21, 0xd2, 0x9c, 0xe1, 0x34, 0x0f, 0xd1, 0xa9, 0x04,
+	0x34, 0xb1, 0x11, 0x42, 0x3f, 0x25, 0x30, 0x88, 0x87, 0x9d, 0x26, 0x94, 0xa2, 0x07, 0x2c, 0x69,
+	0xb2, 0xb5, 0x21, 0x52, 0x3a, 0xcf, 0x29, 0x9d, 0xa5, 0x67, 0x44, 0x94, 0x36, 0x7f, 0xbd, 0x54,
+	0xff, 0x94, 0xa7, 0xda, 0xb5, 0x4a, 0x45, 0xb3, 0x6e, 0x07, 0x1b, 0xf7, 0x43, 0x02, 0xc3, 0xd1,
+	0x4b, 0x52, 0x93, 0x35, 0x26, 0xbc, 0xce, 0x49, 0x6a, 0x62, 0x7b, 0x24, 0x7f, 0x9a, 0x93, 0x3f,
+	0x46, 0x8f, 0xb6, 0x4b, 0x1e, 0x6f, 0xa9, 0x5f, 0x11, 0xd8, 0x19, 0x09, 0xdd, 0x64, 0x9d, 0x89,
+	0xee, 0x4b, 0x92, 0x92, 0xd4, 0x3c, 0x89, 0x4e, 0x5b, 0x13, 0x0e, 0x8a, 0xfd, 0x98, 0xc0, 0x88,
+	0xe0, 0xd2, 0x40, 0x67, 0x63, 0xf9, 0xc4, 0x5f, 0x74, 0xa4, 0x23, 0xed, 0x39, 0x61, 0x2a, 0x8b,
+	0x3c, 0x95, 0x53, 0xf4, 0x64, 0xbb, 0xa9, 0x84, 0xdb, 0x0d, 0xdf, 0x13, 0xa0, 0x9b, 0x41, 0xe8,
+	0x4c, 0x1b, 0x8c, 0xfc, 0x2c, 0x66, 0xdb, 0xf2, 0xc1, 0x24, 0x2e, 0xf0, 0x24, 0x96, 0xe8, 0xe2,
+	0x4b, 0x24, 0xe1, 0x4f, 0xca, 0xc2, 0xea, 0x93, 0xe7, 0x19, 0xf2, 0xf4, 0x79, 0x86, 0xfc, 0xf6,
+	0x3c, 0x43, 0x1e, 0xbc, 0xc8, 0xf4, 0x3c, 0x7d, 0x91, 0xe9, 0xf9, 0xf9, 0x45, 0xa6, 0xe7, 0xed,
+	0xa3, 0xa1, 0x5b, 0xd4, 0x22, 0x07, 0x5a, 0x36, 0x6b, 0x46, 0x91, 0xdf, 0xcb, 0x7c, 0xe4, 0x8d,
+	0x59, 0xf5, 0x56, 0x1d, 0x9e, 0xdf, 0xac, 0xf2, 0x29, 0xfe, 0xbf, 0xf5, 0xd9, 0xbf, 0x06, 0x00,
+	0xef, 0x1e, 0x4f, 0x8d, 0x52, 0x20, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params queries the parameters of x/asset/ft module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Tokens queries the fungible tokens of the module.
+	Tokens(ctx context.Context, in *QueryTokensRequest, opts ...grpc.CallOption) (*QueryTokensResponse, error)
+	// Token queries the fungible token of the module.
+	Token(ctx context.Context, in *QueryTokenRequest, opts ...grpc.CallOption) (*QueryTokenResponse, error)
+	// TokenUpgradeStatuses returns token upgrades info.
+	TokenUpgradeStatuses(ctx context.Context, in *QueryTokenUpgradeStatusesRequest, opts ...grpc.CallOption) (*QueryTokenUpgradeStatusesResponse, error)
+	// RateExemptions returns the accounts exempted from the burn rate and send commission rate of the token.
+	RateExemptions(ctx context.Context, in *QueryRateExemptionsRequest, opts ...grpc.CallOption) (*QueryRateExemptionsResponse, error)
+	// BlockedAccounts returns the accounts blocked from sending and receiving the token.
+	BlockedAccounts(ctx context.Context, in *QueryBlockedAccountsRequest, opts ...grpc.CallOption) (*QueryBlockedAccountsResponse, error)
+	// BlockedAccount returns whether the account is blocked from sending and receiving the token.
+	BlockedAccount(ctx context.Context, in *QueryBlockedAccountRequest, opts ...grpc.CallOption) (*QueryBlockedAccountResponse, error)
+	// Distribution returns the progress of the distribution in progress for the token.
+	Distribution(ctx context.Context, in *QueryDistributionRequest, opts ...grpc.CallOption) (*QueryDistributionResponse, error)
+	// DistributionClaimable returns the amount the account is going to receive from the distribution in progress.
+	DistributionClaimable(ctx context.Context, in *QueryDistributionClaimableRequest, opts ...grpc.CallOption) (*QueryDistributionClaimableResponse, error)
+	// TransferLimits returns the transfer limits of the token.
+	TransferLimits(ctx context.Context, in *QueryTransferLimitsRequest, opts ...grpc.CallOption) (*QueryTransferLimitsResponse, error)
+	// TransferLimit returns the transfer limit applied to the account and the amount it might still send.
+	TransferLimit(ctx context.Context, in *QueryTransferLimitRequest, opts ...grpc.CallOption) (*QueryTransferLimitResponse, error)
+	// VestingSchedules returns the vesting schedules of the account together with the vested and unvested amounts.
+	VestingSchedules(ctx context.Context, in *QueryVestingSchedulesRequest, opts ...grpc.CallOption) (*QueryVestingSchedulesResponse, error)
+	// Holders returns the holders of the token with their balances.
+	Holders(ctx context.Context, in *QueryHoldersRequest, opts ...grpc.CallOption) (*QueryHoldersResponse, error)
+	// Balance returns balance of the denom for the account.
+	Balance(ctx context.Context, in *QueryBalanceRequest, opts ...grpc.CallOption) (*QueryBalanceResponse, error)
+	// FrozenBalances returns all the frozen balances for the account.
+	FrozenBalances(ctx context.Context, in *QueryFrozenBalancesRequest, opts ...grpc.CallOption) (*QueryFrozenBalancesResponse, error)
+	// FrozenBalance returns frozen balance of the denom for the account.
+	FrozenBalance(ctx context.Context, in *QueryFrozenBalanceRequest, opts ...grpc.CallOption) (*QueryFrozenBalanceResponse, error)
+	// WhitelistedBalances returns all the whitelisted balances for the account.
+	WhitelistedBalances(ctx context.Context, in *QueryWhitelistedBalancesRequest, opts ...grpc.CallOption) (*QueryWhitelistedBalancesResponse, error)
+	// WhitelistedBalance returns whitelisted balance of the denom for the account.
+	WhitelistedBalance(ctx context.Context, in *QueryWhitelistedBalanceRequest, opts ...grpc.CallOption) (*QueryWhitelistedBalanceResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/coreum.asset.ft.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryTokenResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) Tokens(ctx context.Context, in *QueryTokensRequest, opts ...grpc.CallOption) (*QueryTokensResponse, error) {
+	out := new(QueryTokensResponse)
+	err := c.cc.Invoke(ctx, "/coreum.asset.ft.v1.Query/Tokens", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Token.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *QueryTokenUpgradeStatusesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) Token(ctx context.Context, in *QueryTokenRequest, opts ...grpc.CallOption) (*QueryTokenResponse, error) {
+	out := new(QueryTokenResponse)
+	err := c.cc.Invoke(ctx, "/coreum.asset.ft.v1.Query/Token", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) TokenUpgradeStatuses(ctx context.Context, in *QueryTokenUpgradeStatusesRequest, opts ...grpc.CallOption) (*QueryTokenUpgradeStatusesResponse, error) {
+	out := new(QueryTokenUpgradeStatusesResponse)
+	err := c.cc.Invoke(ctx, "/coreum.asset.ft.v1.Query/TokenUpgradeStatuses", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryTokenUpgradeStatusesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) RateExemptions(ctx context.Context, in *QueryRateExemptionsRequest, opts ...grpc.CallOption) (*QueryRateExemptionsResponse, error) {
+	out := new(QueryRateExemptionsResponse)
+	err := c.cc.Invoke(ctx, "/coreum.asset.ft.v1.Query/RateExemptions", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Statuses.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *QueryTokensRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) BlockedAccounts(ctx context.Context, in *QueryBlockedAccountsRequest, opts ...grpc.CallOption) (*QueryBlockedAccountsResponse, error) {
+	out := new(QueryBlockedAccountsResponse)
+	err := c.cc.Invoke(ctx, "/coreum.asset.ft.v1.Query/BlockedAccounts", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) BlockedAccount(ctx context.Context, in *QueryBlockedAccountRequest, opts ...grpc.CallOption) (*QueryBlockedAccountResponse, error) {
+	out := new(QueryBlockedAccountResponse)
+	err := c.cc.Invoke(ctx, "/coreum.asset.ft.v1.Query/BlockedAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Issuer)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) Distribution(ctx context.Context, in *QueryDistributionRequest, opts ...grpc.CallOption) (*QueryDistributionResponse, error) {
+	out := new(QueryDistributionResponse)
+	err := c.cc.Invoke(ctx, "/coreum.asset.ft.v1.Query/Distribution", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryTokensResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) DistributionClaimable(ctx context.Context, in *QueryDistributionClaimableRequest, opts ...grpc.CallOption) (*QueryDistributionClaimableResponse, error) {
+	out := new(QueryDistributionClaimableResponse)
+	err := c.cc.Invoke(ctx, "/coreum.asset.ft.v1.Query/DistributionClaimable", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) TransferLimits(ctx context.Context, in *QueryTransferLimitsRequest, opts ...grpc.CallOption) (*QueryTransferLimitsResponse, error) {
+	out := new(QueryTransferLimitsResponse)
+	err := c.cc.Invoke(ctx, "/coreum.asset.ft.v1.Query/TransferLimits", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Tokens) > 0 {
-		for _, e := range m.Tokens {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	return out, nil
+}
+
+func (c *queryClient) TransferLimit(ctx context.Context, in *QueryTransferLimitRequest, opts ...grpc.CallOption) (*QueryTransferLimitResponse, error) {
+	out := new(QueryTransferLimitResponse)
+	err := c.cc.Invoke(ctx, "/coreum.asset.ft.v1.Query/TransferLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryBalanceRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) VestingSchedules(ctx context.Context, in *QueryVestingSchedulesRequest, opts ...grpc.CallOption) (*QueryVestingSchedulesResponse, error) {
+	out := new(QueryVestingSchedulesResponse)
+	err := c.cc.Invoke(ctx, "/coreum.asset.ft.v1.Query/VestingSchedules", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Account)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) Holders(ctx context.Context, in *QueryHoldersRequest, opts ...grpc.CallOption) (*QueryHoldersResponse, error) {
+	out := new(QueryHoldersResponse)
+	err := c.cc.Invoke(ctx, "/coreum.asset.ft.v1.Query/Holders", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) Balance(ctx context.Context, in *QueryBalanceRequest, opts ...grpc.CallOption) (*QueryBalanceResponse, error) {
+	out := new(QueryBalanceResponse)
+	err := c.cc.Invoke(ctx, "/coreum.asset.ft.v1.Query/Balance", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryBalanceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) FrozenBalances(ctx context.Context, in *QueryFrozenBalancesRequest, opts ...grpc.CallOption) (*QueryFrozenBalancesResponse, error) {
+	out := new(QueryFrozenBalancesResponse)
+	err := c.cc.Invoke(ctx, "/coreum.asset.ft.v1.Query/FrozenBalances", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Balance.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.Whitelisted.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.Frozen.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.Locked.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *QueryFrozenBalancesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) FrozenBalance(ctx context.Context, in *QueryFrozenBalanceRequest, opts ...grpc.CallOption) (*QueryFrozenBalanceResponse, error) {
+	out := new(QueryFrozenBalanceResponse)
+	err := c.cc.Invoke(ctx, "/coreum.asset.ft.v1.Query/FrozenBalance", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) WhitelistedBalances(ctx context.Context, in *QueryWhitelistedBalancesRequest, opts ...grpc.CallOption) (*QueryWhitelistedBalancesResponse, error) {
+	out := new(QueryWhitelistedBalancesResponse)
+	err := c.cc.Invoke(ctx, "/coreum.asset.ft.v1.Query/WhitelistedBalances", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Account)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) WhitelistedBalance(ctx context.Context, in *QueryWhitelistedBalanceRequest, opts ...grpc.CallOption) (*QueryWhitelistedBalanceResponse, error) {
+	out := new(QueryWhitelistedBalanceResponse)
+	err := c.cc.Invoke(ctx, "/coreum.asset.ft.v1.Query/WhitelistedBalance", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryFrozenBalancesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params queries the parameters of x/asset/ft module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Tokens queries the fungible tokens of the module.
+	Tokens(context.Context, *QueryTokensRequest) (*QueryTokensResponse, error)
+	// Token queries the fungible token of the module.
+	Token(context.Context, *QueryTokenRequest) (*QueryTokenResponse, error)
+	// TokenUpgradeStatuses returns token upgrades info.
+	TokenUpgradeStatuses(context.Context, *QueryTokenUpgradeStatusesRequest) (*QueryTokenUpgradeStatusesResponse, error)
+	// RateExemptions returns the accounts exempted from the burn rate and send commission rate of the token.
+	RateExemptions(context.Context, *QueryRateExemptionsRequest) (*QueryRateExemptionsResponse, error)
+	// BlockedAccounts returns the accounts blocked from sending and receiving the token.
+	BlockedAccounts(context.Context, *QueryBlockedAccountsRequest) (*QueryBlockedAccountsResponse, error)
+	// BlockedAccount returns whether the account is blocked from sending and receiving the token.
+	BlockedAccount(context.Context, *QueryBlockedAccountRequest) (*QueryBlockedAccountResponse, error)
+	// Distribution returns the progress of the distribution in progress for the token.
+	Distribution(context.Context, *QueryDistributionRequest) (*QueryDistributionResponse, error)
+	// DistributionClaimable returns the amount the account is going to receive from the distribution in progress.
+	DistributionClaimable(context.Context, *QueryDistributionClaimableRequest) (*QueryDistributionClaimableResponse, error)
+	// TransferLimits returns the transfer limits of the token.
+	TransferLimits(context.Context, *QueryTransferLimitsRequest) (*QueryTransferLimitsResponse, error)
+	// TransferLimit returns the transfer limit applied to the account and the amount it might still send.
+	TransferLimit(context.Context, *QueryTransferLimitRequest) (*QueryTransferLimitResponse, error)
+	// VestingSchedules returns the vesting schedules of the account together with the vested and unvested amounts.
+	VestingSchedules(context.Context, *QueryVestingSchedulesRequest) (*QueryVestingSchedulesResponse, error)
+	// Holders returns the holders of the token with their balances.
+	Holders(context.Context, *QueryHoldersRequest) (*QueryHoldersResponse, error)
+	// Balance returns balance of the denom for the account.
+	Balance(context.Context, *QueryBalanceRequest) (*QueryBalanceResponse, error)
+	// FrozenBalances returns all the frozen balances for the account.
+	FrozenBalances(context.Context, *QueryFrozenBalancesRequest) (*QueryFrozenBalancesResponse, error)
+	// FrozenBalance returns frozen balance of the denom for the account.
+	FrozenBalance(context.Context, *QueryFrozenBalanceRequest) (*QueryFrozenBalanceResponse, error)
+	// WhitelistedBalances returns all the whitelisted balances for the account.
+	WhitelistedBalances(context.Context, *QueryWhitelistedBalancesRequest) (*QueryWhitelistedBalancesResponse, error)
+	// WhitelistedBalance returns whitelisted balance of the denom for the account.
+	WhitelistedBalance(context.Context, *QueryWhitelistedBalanceRequest) (*QueryWhitelistedBalanceResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) Tokens(ctx context.Context, req *QueryTokensRequest) (*QueryTokensResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Tokens not implemented")
+}
+func (*UnimplementedQueryServer) Token(ctx context.Context, req *QueryTokenRequest) (*QueryTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Token not implemented")
+}
+func (*UnimplementedQueryServer) TokenUpgradeStatuses(ctx context.Context, req *QueryTokenUpgradeStatusesRequest) (*QueryTokenUpgradeStatusesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TokenUpgradeStatuses not implemented")
+}
+func (*UnimplementedQueryServer) RateExemptions(ctx context.Context, req *QueryRateExemptionsRequest) (*QueryRateExemptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RateExemptions not implemented")
+}
+func (*UnimplementedQueryServer) BlockedAccounts(ctx context.Context, req *QueryBlockedAccountsRequest) (*QueryBlockedAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockedAccounts not implemented")
+}
+func (*UnimplementedQueryServer) BlockedAccount(ctx context.Context, req *QueryBlockedAccountRequest) (*QueryBlockedAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockedAccount not implemented")
+}
+func (*UnimplementedQueryServer) Distribution(ctx context.Context, req *QueryDistributionRequest) (*QueryDistributionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Distribution not implemented")
+}
+func (*UnimplementedQueryServer) DistributionClaimable(ctx context.Context, req *QueryDistributionClaimableRequest) (*QueryDistributionClaimableResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DistributionClaimable not implemented")
+}
+func (*UnimplementedQueryServer) TransferLimits(ctx context.Context, req *QueryTransferLimitsRequest) (*QueryTransferLimitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferLimits not implemented")
+}
+func (*UnimplementedQueryServer) TransferLimit(ctx context.Context, req *QueryTransferLimitRequest) (*QueryTransferLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferLimit not implemented")
+}
+func (*UnimplementedQueryServer) VestingSchedules(ctx context.Context, req *QueryVestingSchedulesRequest) (*QueryVestingSchedulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VestingSchedules not implemented")
+}
+func (*UnimplementedQueryServer) Holders(ctx context.Context, req *QueryHoldersRequest) (*QueryHoldersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Holders not implemented")
+}
+func (*UnimplementedQueryServer) Balance(ctx context.Context, req *QueryBalanceRequest) (*QueryBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Balance not implemented")
+}
+func (*UnimplementedQueryServer) FrozenBalances(ctx context.Context, req *QueryFrozenBalancesRequest) (*QueryFrozenBalancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FrozenBalances not implemented")
+}
+func (*UnimplementedQueryServer) FrozenBalance(ctx context.Context, req *QueryFrozenBalanceRequest) (*QueryFrozenBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FrozenBalance not implemented")
+}
+func (*UnimplementedQueryServer) WhitelistedBalances(ctx context.Context, req *QueryWhitelistedBalancesRequest) (*QueryWhitelistedBalancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WhitelistedBalances not implemented")
+}
+func (*UnimplementedQueryServer) WhitelistedBalance(ctx context.Context, req *QueryWhitelistedBalanceRequest) (*QueryWhitelistedBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WhitelistedBalance not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
 	}
-	if len(m.Balances) > 0 {
-		for _, e := range m.Balances {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.asset.ft.v1.Query/Params",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryFrozenBalanceRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_Tokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTokensRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Account)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).Tokens(ctx, in)
 	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.asset.ft.v1.Query/Tokens",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Tokens(ctx, req.(*QueryTokensRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryFrozenBalanceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_Token_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Balance.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if interceptor == nil {
+		return srv.(QueryServer).Token(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.asset.ft.v1.Query/Token",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Token(ctx, req.(*QueryTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryWhitelistedBalancesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_TokenUpgradeStatuses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTokenUpgradeStatusesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).TokenUpgradeStatuses(ctx, in)
 	}
-	l = len(m.Account)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.asset.ft.v1.Query/TokenUpgradeStatuses",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TokenUpgradeStatuses(ctx, req.(*QueryTokenUpgradeStatusesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryWhitelistedBalancesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_RateExemptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRateExemptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).RateExemptions(ctx, in)
 	}
-	if len(m.Balances) > 0 {
-		for _, e := range m.Balances {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.asset.ft.v1.Query/RateExemptions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RateExemptions(ctx, req.(*QueryRateExemptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BlockedAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlockedAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BlockedAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.asset.ft.v1.Query/BlockedAccounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BlockedAccounts(ctx, req.(*QueryBlockedAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BlockedAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlockedAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BlockedAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.asset.ft.v1.Query/BlockedAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BlockedAccount(ctx, req.(*QueryBlockedAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Distribution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDistributionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Distribution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.asset.ft.v1.Query/Distribution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Distribution(ctx, req.(*QueryDistributionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DistributionClaimable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDistributionClaimableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DistributionClaimable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.asset.ft.v1.Query/DistributionClaimable",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DistributionClaimable(ctx, req.(*QueryDistributionClaimableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TransferLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTransferLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TransferLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.asset.ft.v1.Query/TransferLimits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TransferLimits(ctx, req.(*QueryTransferLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TransferLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTransferLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TransferLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.asset.ft.v1.Query/TransferLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TransferLimit(ctx, req.(*QueryTransferLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VestingSchedules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVestingSchedulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VestingSchedules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.asset.ft.v1.Query/VestingSchedules",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VestingSchedules(ctx, req.(*QueryVestingSchedulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Holders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHoldersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Holders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.asset.ft.v1.Query/Holders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Holders(ctx, req.(*QueryHoldersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Balance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Balance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.asset.ft.v1.Query/Balance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Balance(ctx, req.(*QueryBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FrozenBalances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFrozenBalancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FrozenBalances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.asset.ft.v1.Query/FrozenBalances",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FrozenBalances(ctx, req.(*QueryFrozenBalancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FrozenBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFrozenBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FrozenBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.asset.ft.v1.Query/FrozenBalance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FrozenBalance(ctx, req.(*QueryFrozenBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_WhitelistedBalances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWhitelistedBalancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).WhitelistedBalances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.asset.ft.v1.Query/WhitelistedBalances",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).WhitelistedBalances(ctx, req.(*QueryWhitelistedBalancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_WhitelistedBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWhitelistedBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).WhitelistedBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.asset.ft.v1.Query/WhitelistedBalance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).WhitelistedBalance(ctx, req.(*QueryWhitelistedBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "coreum.asset.ft.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "Tokens",
+			Handler:    _Query_Tokens_Handler,
+		},
+		{
+			MethodName: "Token",
+			Handler:    _Query_Token_Handler,
+		},
+		{
+			MethodName: "TokenUpgradeStatuses",
+			Handler:    _Query_TokenUpgradeStatuses_Handler,
+		},
+		{
+			MethodName: "RateExemptions",
+			Handler:    _Query_RateExemptions_Handler,
+		},
+		{
+			MethodName: "BlockedAccounts",
+			Handler:    _Query_BlockedAccounts_Handler,
+		},
+		{
+			MethodName: "BlockedAccount",
+			Handler:    _Query_BlockedAccount_Handler,
+		},
+		{
+			MethodName: "Distribution",
+			Handler:    _Query_Distribution_Handler,
+		},
+		{
+			MethodName: "DistributionClaimable",
+			Handler:    _Query_DistributionClaimable_Handler,
+		},
+		{
+			MethodName: "TransferLimits",
+			Handler:    _Query_TransferLimits_Handler,
+		},
+		{
+			MethodName: "TransferLimit",
+			Handler:    _Query_TransferLimit_Handler,
+		},
+		{
+			MethodName: "VestingSchedules",
+			Handler:    _Query_VestingSchedules_Handler,
+		},
+		{
+			MethodName: "Holders",
+			Handler:    _Query_Holders_Handler,
+		},
+		{
+			MethodName: "Balance",
+			Handler:    _Query_Balance_Handler,
+		},
+		{
+			MethodName: "FrozenBalances",
+			Handler:    _Query_FrozenBalances_Handler,
+		},
+		{
+			MethodName: "FrozenBalance",
+			Handler:    _Query_FrozenBalance_Handler,
+		},
+		{
+			MethodName: "WhitelistedBalances",
+			Handler:    _Query_WhitelistedBalances_Handler,
+		},
+		{
+			MethodName: "WhitelistedBalance",
+			Handler:    _Query_WhitelistedBalance_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "coreum/asset/ft/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTokenRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTokenRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTokenRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTokenResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTokenResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTokenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Token.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTokenUpgradeStatusesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTokenUpgradeStatusesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTokenUpgradeStatusesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTokenUpgradeStatusesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTokenUpgradeStatusesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTokenUpgradeStatusesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Statuses.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRateExemptionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRateExemptionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRateExemptionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRateExemptionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRateExemptionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRateExemptionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Accounts) > 0 {
+		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Accounts[iNdEx])
+			copy(dAtA[i:], m.Accounts[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Accounts[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlockedAccountsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlockedAccountsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlockedAccountsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlockedAccountsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlockedAccountsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlockedAccountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Accounts) > 0 {
+		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Accounts[iNdEx])
+			copy(dAtA[i:], m.Accounts[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Accounts[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlockedAccountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlockedAccountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlockedAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlockedAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlockedAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlockedAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Blocked {
+		i--
+		if m.Blocked {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDistributionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDistributionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDistributionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDistributionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDistributionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDistributionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Distribution.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDistributionClaimableRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDistributionClaimableRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDistributionClaimableRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDistributionClaimableResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDistributionClaimableResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDistributionClaimableResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTransferLimitsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTransferLimitsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTransferLimitsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTransferLimitsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTransferLimitsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTransferLimitsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TransferLimits) > 0 {
+		for iNdEx := len(m.TransferLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TransferLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTransferLimitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTransferLimitRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTransferLimitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTransferLimitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTransferLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTransferLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Remaining != nil {
+		{
+			size := m.Remaining.Size()
+			i -= size
+			if _, err := m.Remaining.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.Sent.Size()
+		i -= size
+		if _, err := m.Sent.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.TransferLimit != nil {
+		{
+			size, err := m.TransferLimit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVestingSchedulesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVestingSchedulesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVestingSchedulesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVestingSchedulesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVestingSchedulesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVestingSchedulesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Unvested.Size()
+		i -= size
+		if _, err := m.Unvested.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Vested.Size()
+		i -= size
+		if _, err := m.Vested.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.VestingSchedules) > 0 {
+		for iNdEx := len(m.VestingSchedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VestingSchedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHoldersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHoldersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHoldersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHoldersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHoldersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHoldersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Holders) > 0 {
+		for iNdEx := len(m.Holders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Holders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Holder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Holder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Holder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Blocked {
+		i--
+		if m.Blocked {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.Locked.Size()
+		i -= size
+		if _, err := m.Locked.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Frozen.Size()
+		i -= size
+		if _, err := m.Frozen.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Whitelisted.Size()
+		i -= size
+		if _, err := m.Whitelisted.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Balance.Size()
+		i -= size
+		if _, err := m.Balance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTokensRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTokensRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTokensRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Issuer) > 0 {
+		i -= len(m.Issuer)
+		copy(dAtA[i:], m.Issuer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Issuer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTokensResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTokensResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTokensResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tokens) > 0 {
+		for iNdEx := len(m.Tokens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBalanceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBalanceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBalanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBalanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBalanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBalanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Locked.Size()
+		i -= size
+		if _, err := m.Locked.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Frozen.Size()
+		i -= size
+		if _, err := m.Frozen.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Whitelisted.Size()
+		i -= size
+		if _, err := m.Whitelisted.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Balance.Size()
+		i -= size
+		if _, err := m.Balance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFrozenBalancesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFrozenBalancesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFrozenBalancesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFrozenBalancesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFrozenBalancesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFrozenBalancesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Balances) > 0 {
+		for iNdEx := len(m.Balances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFrozenBalanceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFrozenBalanceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFrozenBalanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFrozenBalanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFrozenBalanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFrozenBalanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ScheduledUnfreezes) > 0 {
+		for iNdEx := len(m.ScheduledUnfreezes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScheduledUnfreezes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Balance.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWhitelistedBalancesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWhitelistedBalancesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWhitelistedBalancesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWhitelistedBalancesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWhitelistedBalancesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWhitelistedBalancesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Balances) > 0 {
+		for iNdEx := len(m.Balances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWhitelistedBalanceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWhitelistedBalanceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWhitelistedBalanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWhitelistedBalanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWhitelistedBalanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWhitelistedBalanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Balance.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTokenRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTokenResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Token.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTokenUpgradeStatusesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTokenUpgradeStatusesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Statuses.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryRateExemptionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRateExemptionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Accounts) > 0 {
+		for _, s := range m.Accounts {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryBlockedAccountsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBlockedAccountsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Accounts) > 0 {
+		for _, s := range m.Accounts {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryBlockedAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBlockedAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Blocked {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryDistributionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDistributionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Distribution.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDistributionClaimableRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDistributionClaimableResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTransferLimitsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTransferLimitsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.TransferLimits) > 0 {
+		for _, e := range m.TransferLimits {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryTransferLimitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTransferLimitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TransferLimit != nil {
+		l = m.TransferLimit.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Sent.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Remaining != nil {
+		l = m.Remaining.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVestingSchedulesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVestingSchedulesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.VestingSchedules) > 0 {
+		for _, e := range m.VestingSchedules {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.Vested.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Unvested.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryHoldersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHoldersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Holders) > 0 {
+		for _, e := range m.Holders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Holder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Balance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Whitelisted.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Frozen.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Locked.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Blocked {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryTokensRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Issuer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTokensResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Tokens) > 0 {
+		for _, e := range m.Tokens {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryBalanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBalanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Balance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Whitelisted.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Frozen.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Locked.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryFrozenBalancesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFrozenBalancesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Balances) > 0 {
+		for _, e := range m.Balances {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryFrozenBalanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFrozenBalanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Balance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.ScheduledUnfreezes) > 0 {
+		for _, e := range m.ScheduledUnfreezes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryWhitelistedBalancesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryWhitelistedBalancesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Balances) > 0 {
+		for _, e := range m.Balances {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryWhitelistedBalanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryWhitelistedBalanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Balance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTokenRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTokenRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTokenRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTokenResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTokenResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTokenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Token.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTokenUpgradeStatusesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTokenUpgradeStatusesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTokenUpgradeStatusesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTokenUpgradeStatusesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTokenUpgradeStatusesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTokenUpgradeStatusesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Statuses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Statuses.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRateExemptionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRateExemptionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRateExemptionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRateExemptionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRateExemptionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRateExemptionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Accounts = append(m.Accounts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlockedAccountsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlockedAccountsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlockedAccountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlockedAccountsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlockedAccountsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlockedAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Accounts = append(m.Accounts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlockedAccountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlockedAccountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlockedAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlockedAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlockedAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlockedAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocked", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Blocked = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDistributionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDistributionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDistributionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDistributionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDistributionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDistributionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Distribution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Distribution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDistributionClaimableRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDistributionClaimableRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDistributionClaimableRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDistributionClaimableResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDistributionClaimableResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDistributionClaimableResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTransferLimitsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTransferLimitsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTransferLimitsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTransferLimitsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTransferLimitsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTransferLimitsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransferLimits = append(m.TransferLimits, TransferLimit{})
+			if err := m.TransferLimits[len(m.TransferLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTransferLimitRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTransferLimitRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTransferLimitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTransferLimitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTransferLimitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTransferLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TransferLimit == nil {
+				m.TransferLimit = &TransferLimit{}
+			}
+			if err := m.TransferLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sent", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Sent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remaining", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.Remaining = &v
+			if err := m.Remaining.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
-
-func (m *QueryWhitelistedBalanceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Account)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
 
-func (m *QueryWhitelistedBalanceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Balance.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryVestingSchedulesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2385,12 +6943,76 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVestingSchedulesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVestingSchedulesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2412,7 +7034,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryVestingSchedulesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2435,15 +7057,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryVestingSchedulesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryVestingSchedulesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingSchedules", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2470,7 +7092,76 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.VestingSchedules = append(m.VestingSchedules, VestingSchedule{})
+			if err := m.VestingSchedules[len(m.VestingSchedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vested", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Vested.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unvested", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Unvested.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2495,7 +7186,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTokenRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryHoldersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2514,17 +7205,53 @@ func (m *QueryTokenRequest) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTokenRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTokenRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHoldersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHoldersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
@@ -2577,7 +7304,7 @@ func (m *QueryTokenRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTokenResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryHoldersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2600,15 +7327,15 @@ func (m *QueryTokenResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTokenResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHoldersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTokenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHoldersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2635,7 +7362,44 @@ func (m *QueryTokenResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Token.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Holders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Holders = append(m.Holders, Holder{})
+			if err := m.Holders[len(m.Holders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2660,7 +7424,7 @@ func (m *QueryTokenResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTokenUpgradeStatusesRequest) Unmarshal(dAtA []byte) error {
+func (m *Holder) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2683,15 +7447,15 @@ func (m *QueryTokenUpgradeStatusesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTokenUpgradeStatusesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: Holder: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTokenUpgradeStatusesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Holder: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2719,63 +7483,115 @@ func (m *QueryTokenUpgradeStatusesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Account = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Whitelisted", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryTokenUpgradeStatusesResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			if err := m.Whitelisted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Frozen", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Frozen.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTokenUpgradeStatusesResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTokenUpgradeStatusesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Statuses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Locked", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2785,25 +7601,46 @@ func (m *QueryTokenUpgradeStatusesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Statuses.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Locked.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocked", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Blocked = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3777,6 +8614,40 @@ func (m *QueryFrozenBalanceResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledUnfreezes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduledUnfreezes = append(m.ScheduledUnfreezes, ScheduledUnfreeze{})
+			if err := m.ScheduledUnfreezes[len(m.ScheduledUnfreezes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
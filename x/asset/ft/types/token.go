@@ -2,11 +2,14 @@ package types
 
 import (
 	"math"
+	"math/big"
 	"regexp"
 	"strings"
+	"time"
 
 	sdkerrors "cosmossdk.io/errors"
 	sdkmath "cosmossdk.io/math"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/gogoproto/proto"
@@ -36,6 +39,21 @@ const (
 	denomSeparator = "-"
 	// MaxPrecision used when issuing a token.
 	MaxPrecision = 20
+	// MaxDescriptionLength is the max length of the token description.
+	MaxDescriptionLength = 200
+	// MaxURILength is the max length of the token URI.
+	MaxURILength = 256
+	// MaxURIHashLength is the max length of the token URI hash.
+	MaxURIHashLength = 128
+	// MaxDataSize is the max size of the token data.
+	MaxDataSize = 5 * 1024 // 5KB
+	// TransferLimitWindowsPerPeriod is the number of windows the sent amounts are tracked in within the transfer
+	// limit period.
+	TransferLimitWindowsPerPeriod = 24
+	// MinTransferLimitPeriod is the min transfer limit period.
+	MinTransferLimitPeriod = TransferLimitWindowsPerPeriod * time.Second
+	// MaxVestingPeriods is the max number of the periods of the periodic vesting schedule.
+	MaxVestingPeriods = 100
 )
 
 func init() {
@@ -54,6 +72,11 @@ type IssueSettings struct {
 	Features           []Feature
 	BurnRate           sdk.Dec
 	SendCommissionRate sdk.Dec
+	URI                string
+	URIHash            string
+	Data               *codectypes.Any
+	MaxSupply          *sdkmath.Int
+	MintAllowance      *MintAllowance
 }
 
 // BuildDenom builds the denom string from the symbol and issuer address.
@@ -165,12 +188,12 @@ func (def Definition) CheckFeatureAllowed(addr sdk.AccAddress, feature Feature)
 // IsFeatureAllowed returns true if feature is allowed for the address.
 func (def Definition) IsFeatureAllowed(addr sdk.Address, feature Feature) bool {
 	featureEnabled := def.IsFeatureEnabled(feature)
-	// issuer can use any enabled feature and burning even if it is disabled
-	if def.IsIssuer(addr) {
+	// admin can use any enabled feature and burning even if it is disabled
+	if def.IsAdmin(addr) {
 		return featureEnabled || feature == Feature_burning
 	}
 
-	// non-issuer can use only burning and only if it is enabled
+	// non-admin can use only burning and only if it is enabled
 	return featureEnabled && feature == Feature_burning
 }
 
@@ -184,6 +207,11 @@ func (def Definition) IsIssuer(addr sdk.Address) bool {
 	return def.Issuer == addr.String()
 }
 
+// IsAdmin returns true if the addr is the admin.
+func (def Definition) IsAdmin(addr sdk.Address) bool {
+	return def.Admin != "" && def.Admin == addr.String()
+}
+
 // ValidateFeatures verifies that provided features belong to the defined set.
 func ValidateFeatures(features []Feature) error {
 	present := map[Feature]struct{}{}
@@ -216,6 +244,241 @@ func ValidateSendCommissionRate(sendCommissionRate sdk.Dec) error {
 	return nil
 }
 
+// ValidateRatesUpdate checks that at least one rate is provided and the provided rates are valid.
+func ValidateRatesUpdate(burnRate, sendCommissionRate *sdk.Dec) error {
+	if burnRate == nil && sendCommissionRate == nil {
+		return sdkerrors.Wrap(ErrInvalidInput, "at least one rate must be provided")
+	}
+
+	if burnRate != nil {
+		if err := ValidateBurnRate(*burnRate); err != nil {
+			return err
+		}
+	}
+
+	if sendCommissionRate != nil {
+		if err := ValidateSendCommissionRate(*sendCommissionRate); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ValidateMetadata checks that the provided description, URI, URI hash and data are valid.
+func ValidateMetadata(description, uri, uriHash string, data *codectypes.Any) error {
+	if len(description) > MaxDescriptionLength {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid description %q, the length must be less than %d", description, MaxDescriptionLength)
+	}
+
+	if len(uri) > MaxURILength {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid URI %q, the length must be less than or equal %d", uri, MaxURILength)
+	}
+
+	if len(uriHash) > MaxURIHashLength {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid URI hash %q, the length must be less than or equal %d", uriHash, MaxURIHashLength)
+	}
+
+	if data != nil {
+		if len(data.Value) > MaxDataSize {
+			return sdkerrors.Wrapf(ErrInvalidInput, "invalid data, it's allowed to use %d bytes", MaxDataSize)
+		}
+		if data.TypeUrl != "/"+proto.MessageName((*DataBytes)(nil)) {
+			return sdkerrors.Wrapf(ErrInvalidInput, "data field must contain %s type", proto.MessageName((*DataBytes)(nil)))
+		}
+	}
+
+	return nil
+}
+
+// ValidateMaxSupply checks that the provided max supply is valid and the initial amount doesn't exceed it.
+func ValidateMaxSupply(maxSupply *sdkmath.Int, initialAmount sdkmath.Int) error {
+	if maxSupply == nil {
+		return nil
+	}
+
+	if maxSupply.IsNil() || !maxSupply.IsPositive() {
+		return sdkerrors.Wrap(ErrInvalidInput, "max supply must be positive")
+	}
+
+	if !initialAmount.IsNil() && initialAmount.GT(*maxSupply) {
+		return sdkerrors.Wrapf(
+			ErrInvalidInput, "initial amount %s exceeds the max supply %s", initialAmount, maxSupply,
+		)
+	}
+
+	return nil
+}
+
+// ValidateTransferLimit checks that the provided transfer limit amount and period are valid.
+func ValidateTransferLimit(amount sdkmath.Int, period time.Duration) error {
+	if amount.IsNil() || amount.IsNegative() {
+		return sdkerrors.Wrap(ErrInvalidInput, "transfer limit amount must be greater than or equal to 0")
+	}
+
+	if period < MinTransferLimitPeriod {
+		return sdkerrors.Wrapf(ErrInvalidInput, "transfer limit period must be at least %s", MinTransferLimitPeriod)
+	}
+
+	return nil
+}
+
+// TransferLimitWindow returns the duration of the window the sent amounts are tracked in.
+func TransferLimitWindow(period time.Duration) time.Duration {
+	return period / TransferLimitWindowsPerPeriod
+}
+
+// ValidateVestingSchedule checks that the provided vesting schedule parameters are valid.
+func ValidateVestingSchedule(
+	vestingType VestingType,
+	amount sdkmath.Int,
+	startTime time.Time,
+	endTime *time.Time,
+	periods []VestingPeriod,
+) error {
+	if amount.IsNil() || !amount.IsPositive() {
+		return sdkerrors.Wrap(ErrInvalidInput, "vesting amount must be positive")
+	}
+
+	switch vestingType {
+	case VestingType_linear, VestingType_cliff:
+		if endTime == nil || !endTime.After(startTime) {
+			return sdkerrors.Wrap(ErrInvalidInput, "vesting end time must be after the start time")
+		}
+		if len(periods) > 0 {
+			return sdkerrors.Wrapf(ErrInvalidInput, "vesting periods can't be set for the %s schedule", vestingType)
+		}
+	case VestingType_periodic:
+		if endTime != nil {
+			return sdkerrors.Wrap(ErrInvalidInput, "vesting end time can't be set for the periodic schedule")
+		}
+		if len(periods) == 0 || len(periods) > MaxVestingPeriods {
+			return sdkerrors.Wrapf(
+				ErrInvalidInput, "number of the vesting periods must be between 1 and %d", MaxVestingPeriods,
+			)
+		}
+		total := sdkmath.ZeroInt()
+		for _, period := range periods {
+			if period.Length <= 0 {
+				return sdkerrors.Wrap(ErrInvalidInput, "vesting period length must be positive")
+			}
+			if period.Amount.IsNil() || !period.Amount.IsPositive() {
+				return sdkerrors.Wrap(ErrInvalidInput, "vesting period amount must be positive")
+			}
+			total = total.Add(period.Amount)
+		}
+		if !total.Equal(amount) {
+			return sdkerrors.Wrapf(
+				ErrInvalidInput, "vesting periods amount %s doesn't match the vesting amount %s", total, amount,
+			)
+		}
+	default:
+		return sdkerrors.Wrapf(ErrInvalidInput, "unknown vesting type %d", vestingType)
+	}
+
+	return nil
+}
+
+// VestedAmount returns the amount of the schedule vested at the provided time.
+func (vs VestingSchedule) VestedAmount(t time.Time) sdkmath.Int {
+	if !t.After(vs.StartTime) {
+		return sdkmath.ZeroInt()
+	}
+
+	switch vs.VestingType {
+	case VestingType_linear:
+		if !t.Before(*vs.EndTime) {
+			return vs.Amount
+		}
+		elapsed := big.NewInt(int64(t.Sub(vs.StartTime)))
+		duration := big.NewInt(int64(vs.EndTime.Sub(vs.StartTime)))
+		return sdkmath.NewIntFromBigInt(new(big.Int).Quo(new(big.Int).Mul(vs.Amount.BigInt(), elapsed), duration))
+	case VestingType_cliff:
+		if !t.Before(*vs.EndTime) {
+			return vs.Amount
+		}
+		return sdkmath.ZeroInt()
+	default:
+		vested := sdkmath.ZeroInt()
+		periodEnd := vs.StartTime
+		for _, period := range vs.Periods {
+			periodEnd = periodEnd.Add(period.Length)
+			if t.Before(periodEnd) {
+				break
+			}
+			vested = vested.Add(period.Amount)
+		}
+		return vested
+	}
+}
+
+// UnvestedAmount returns the amount of the schedule still locked at the provided time.
+func (vs VestingSchedule) UnvestedAmount(t time.Time) sdkmath.Int {
+	return vs.Amount.Sub(vs.VestedAmount(t))
+}
+
+// ReduceUnvested returns the schedule with the amount unvested at the provided time reduced by the provided amount,
+// which must not be greater than the unvested one. The part of the schedule vested before the provided time is
+// dropped, while the rest is vested at the same times as before.
+func (vs VestingSchedule) ReduceUnvested(t time.Time, amount sdkmath.Int) VestingSchedule {
+	switch vs.VestingType {
+	case VestingType_linear:
+		vs.Amount = vs.UnvestedAmount(t).Sub(amount)
+		if t.After(vs.StartTime) {
+			vs.StartTime = t
+		}
+	case VestingType_cliff:
+		vs.Amount = vs.UnvestedAmount(t).Sub(amount)
+	default:
+		var periods []VestingPeriod
+		periodEnd := vs.StartTime
+		for _, period := range vs.Periods {
+			periodEnd = periodEnd.Add(period.Length)
+			if t.Before(periodEnd) {
+				periods = append(periods, period)
+				continue
+			}
+			vs.StartTime = periodEnd
+		}
+
+		// the amount is taken from the last periods, so the remaining ones are vested at the same times
+		remaining := amount
+		for i := len(periods) - 1; i >= 0 && remaining.IsPositive(); i-- {
+			reduction := sdkmath.MinInt(remaining, periods[i].Amount)
+			periods[i].Amount = periods[i].Amount.Sub(reduction)
+			remaining = remaining.Sub(reduction)
+			if periods[i].Amount.IsZero() {
+				periods = periods[:i]
+			}
+		}
+
+		vs.Periods = periods
+		vs.Amount = sdkmath.ZeroInt()
+		for _, period := range periods {
+			vs.Amount = vs.Amount.Add(period.Amount)
+		}
+	}
+
+	return vs
+}
+
+// ValidateMintAllowance checks that the provided mint allowance is valid.
+func ValidateMintAllowance(mintAllowance *MintAllowance) error {
+	if mintAllowance == nil {
+		return nil
+	}
+
+	if mintAllowance.Amount.IsNil() || !mintAllowance.Amount.IsPositive() {
+		return sdkerrors.Wrap(ErrInvalidInput, "mint allowance amount must be positive")
+	}
+
+	if mintAllowance.Period <= 0 {
+		return sdkerrors.Wrap(ErrInvalidInput, "mint allowance period must be positive")
+	}
+
+	return nil
+}
+
 func validateRate(rate sdk.Dec) error {
 	const maxRatePrecisionAllowed = 4
 
@@ -239,6 +502,43 @@ func isDecPrecisionValid(dec sdk.Dec, prec uint) bool {
 	return dec.Mul(sdk.NewDecFromInt(sdkmath.NewInt(int64(math.Pow10(int(prec)))))).IsInteger()
 }
 
+// Validate checks that exactly one version specific options are set and they are valid.
+func (o TokenUpgradeOptions) Validate() error {
+	switch {
+	case o.V1 != nil && o.V2 == nil:
+		return nil
+	case o.V1 == nil && o.V2 != nil:
+		return o.V2.Validate()
+	default:
+		return sdkerrors.Wrap(ErrInvalidInput, "exactly one version specific options must be set")
+	}
+}
+
+// Validate checks that the v2 upgrade options are valid.
+func (o TokenUpgradeV2Options) Validate() error {
+	if len(o.Features) == 0 && o.BurnRate == nil && o.SendCommissionRate == nil {
+		return sdkerrors.Wrap(ErrInvalidInput, "at least one feature or rate must be provided")
+	}
+
+	if err := ValidateFeatures(o.Features); err != nil {
+		return err
+	}
+
+	if o.BurnRate != nil {
+		if err := ValidateBurnRate(*o.BurnRate); err != nil {
+			return err
+		}
+	}
+
+	if o.SendCommissionRate != nil {
+		if err := ValidateSendCommissionRate(*o.SendCommissionRate); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // TokenUpgradeV1Keeper defines methods required to update tokens to V1.
 type TokenUpgradeV1Keeper interface {
 	UpgradeTokenToV1(ctx sdk.Context, data *DelayedTokenUpgradeV1) error
@@ -250,3 +550,39 @@ func NewTokenUpgradeV1Handler(keeper TokenUpgradeV1Keeper) delaytypes.Handler {
 		return keeper.UpgradeTokenToV1(ctx, data.(*DelayedTokenUpgradeV1))
 	}
 }
+
+// TokenUpgradeKeeper defines methods required to execute the delayed token upgrades.
+type TokenUpgradeKeeper interface {
+	UpgradeTokenDelayed(ctx sdk.Context, data *DelayedTokenUpgrade) error
+}
+
+// NewTokenUpgradeHandler handles the delayed token upgrade.
+func NewTokenUpgradeHandler(keeper TokenUpgradeKeeper) delaytypes.Handler {
+	return func(ctx sdk.Context, data proto.Message) error {
+		return keeper.UpgradeTokenDelayed(ctx, data.(*DelayedTokenUpgrade))
+	}
+}
+
+// RatesUpdateKeeper defines methods required to apply the pending rates updates.
+type RatesUpdateKeeper interface {
+	UpdateRatesDelayed(ctx sdk.Context, data *DelayedRatesUpdate) error
+}
+
+// NewRatesUpdateHandler handles the pending rates update when the notice period ends.
+func NewRatesUpdateHandler(keeper RatesUpdateKeeper) delaytypes.Handler {
+	return func(ctx sdk.Context, data proto.Message) error {
+		return keeper.UpdateRatesDelayed(ctx, data.(*DelayedRatesUpdate))
+	}
+}
+
+// UnfreezeKeeper defines methods required to unfreeze the time-locked frozen amounts.
+type UnfreezeKeeper interface {
+	UnfreezeScheduled(ctx sdk.Context, data *DelayedUnfreeze) error
+}
+
+// NewUnfreezeHandler handles the scheduled unfreeze.
+func NewUnfreezeHandler(keeper UnfreezeKeeper) delaytypes.Handler {
+	return func(ctx sdk.Context, data proto.Message) error {
+		return keeper.UnfreezeScheduled(ctx, data.(*DelayedUnfreeze))
+	}
+}
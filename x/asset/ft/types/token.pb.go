@@ -5,10 +5,13 @@ package types
 
 import (
 	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/codec/types"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
@@ -32,11 +35,14 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type Feature int32
 
 const (
-	Feature_minting      Feature = 0
-	Feature_burning      Feature = 1
-	Feature_freezing     Feature = 2
-	Feature_whitelisting Feature = 3
-	Feature_ibc          Feature = 4
+	Feature_minting         Feature = 0
+	Feature_burning         Feature = 1
+	Feature_freezing        Feature = 2
+	Feature_whitelisting    Feature = 3
+	Feature_ibc             Feature = 4
+	Feature_clawback        Feature = 5
+	Feature_transfer_limits Feature = 6
+	Feature_blocklisting    Feature = 7
 )
 
 var Feature_name = map[int32]string{
@@ -45,14 +51,20 @@ var Feature_name = map[int32]string{
 	2: "freezing",
 	3: "whitelisting",
 	4: "ibc",
+	5: "clawback",
+	6: "transfer_limits",
+	7: "blocklisting",
 }
 
 var Feature_value = map[string]int32{
-	"minting":      0,
-	"burning":      1,
-	"freezing":     2,
-	"whitelisting": 3,
-	"ibc":          4,
+	"minting":         0,
+	"burning":         1,
+	"freezing":        2,
+	"whitelisting":    3,
+	"ibc":             4,
+	"clawback":        5,
+	"transfer_limits": 6,
+	"blocklisting":    7,
 }
 
 func (x Feature) String() string {
@@ -63,6 +75,70 @@ func (Feature) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_fe80c7a2c55589e7, []int{0}
 }
 
+// DistributionPhase defines the phase of the distribution processing.
+type DistributionPhase int32
+
+const (
+	// paying_holders is the phase when the current holders of the token are paid.
+	DistributionPhase_paying_holders DistributionPhase = 0
+	// paying_recorded_holders is the phase when the holders whose balances were recorded on the change are paid.
+	DistributionPhase_paying_recorded_holders DistributionPhase = 1
+	// cleaning_up is the phase when the recorded balances are removed.
+	DistributionPhase_cleaning_up DistributionPhase = 2
+)
+
+var DistributionPhase_name = map[int32]string{
+	0: "paying_holders",
+	1: "paying_recorded_holders",
+	2: "cleaning_up",
+}
+
+var DistributionPhase_value = map[string]int32{
+	"paying_holders":          0,
+	"paying_recorded_holders": 1,
+	"cleaning_up":             2,
+}
+
+func (x DistributionPhase) String() string {
+	return proto.EnumName(DistributionPhase_name, int32(x))
+}
+
+func (DistributionPhase) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_fe80c7a2c55589e7, []int{1}
+}
+
+// VestingType defines the way the amount of the vesting schedule is vested.
+type VestingType int32
+
+const (
+	// linear vests the amount continuously between the start time and the end time.
+	VestingType_linear VestingType = 0
+	// cliff vests the whole amount at the end time.
+	VestingType_cliff VestingType = 1
+	// periodic vests the amount of each period at the end of the period.
+	VestingType_periodic VestingType = 2
+)
+
+var VestingType_name = map[int32]string{
+	0: "linear",
+	1: "cliff",
+	2: "periodic",
+}
+
+var VestingType_value = map[string]int32{
+	"linear":   0,
+	"cliff":    1,
+	"periodic": 2,
+}
+
+func (x VestingType) String() string {
+	return proto.EnumName(VestingType_name, int32(x))
+}
+
+func (VestingType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_fe80c7a2c55589e7, []int{2}
+}
+
 // Definition defines the fungible token settings to store.
 type Definition struct {
 	Denom    string    `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
@@ -75,6 +151,14 @@ type Definition struct {
 	// amount sent to the token issuer account.
 	SendCommissionRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=send_commission_rate,json=sendCommissionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"send_commission_rate"`
 	Version            uint32                                 `protobuf:"varint,6,opt,name=version,proto3" json:"version,omitempty"`
+	// admin is the account allowed to manage the token, the token can't be managed if it is empty.
+	Admin string `protobuf:"bytes,7,opt,name=admin,proto3" json:"admin,omitempty"`
+	// data is the arbitrary data attached to the token.
+	Data *types.Any `protobuf:"bytes,8,opt,name=data,proto3" json:"data,omitempty"`
+	// max_supply is the maximum total supply of the token, the supply is not capped if it is empty.
+	MaxSupply *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,9,opt,name=max_supply,json=maxSupply,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_supply,omitempty"`
+	// mint_allowance limits the amount which might be minted within the period, minting is not limited if it is empty.
+	MintAllowance *MintAllowance `protobuf:"bytes,10,opt,name=mint_allowance,json=mintAllowance,proto3" json:"mint_allowance,omitempty"`
 }
 
 func (m *Definition) Reset()         { *m = Definition{} }
@@ -127,6 +211,20 @@ type Token struct {
 	// amount sent to the token issuer account.
 	SendCommissionRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=send_commission_rate,json=sendCommissionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"send_commission_rate"`
 	Version            uint32                                 `protobuf:"varint,11,opt,name=version,proto3" json:"version,omitempty"`
+	// admin is the account allowed to manage the token, the token can't be managed if it is empty.
+	Admin   string     `protobuf:"bytes,12,opt,name=admin,proto3" json:"admin,omitempty"`
+	URI     string     `protobuf:"bytes,13,opt,name=uri,proto3" json:"uri,omitempty"`
+	URIHash string     `protobuf:"bytes,14,opt,name=uri_hash,json=uriHash,proto3" json:"uri_hash,omitempty"`
+	Data    *types.Any `protobuf:"bytes,15,opt,name=data,proto3" json:"data,omitempty"`
+	// max_supply is the maximum total supply of the token, the supply is not capped if it is empty.
+	MaxSupply *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,16,opt,name=max_supply,json=maxSupply,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_supply,omitempty"`
+	// mint_allowance limits the amount which might be minted within the period, minting is not limited if it is empty.
+	MintAllowance *MintAllowance `protobuf:"bytes,17,opt,name=mint_allowance,json=mintAllowance,proto3" json:"mint_allowance,omitempty"`
+	// mintable_amount is the amount which might be minted currently without exceeding the max supply and the
+	// mint allowance, it is empty if neither of them is set.
+	MintableAmount *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,18,opt,name=mintable_amount,json=mintableAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"mintable_amount,omitempty"`
+	// pending_rates_update contains the rates increase which takes effect after the notice period, if any.
+	PendingRatesUpdate *PendingRatesUpdate `protobuf:"bytes,19,opt,name=pending_rates_update,json=pendingRatesUpdate,proto3" json:"pending_rates_update,omitempty"`
 }
 
 func (m *Token) Reset()         { *m = Token{} }
@@ -162,6 +260,151 @@ func (m *Token) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Token proto.InternalMessageInfo
 
+// MintAllowance defines the amount which might be minted within the period.
+type MintAllowance struct {
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	Period time.Duration                          `protobuf:"bytes,2,opt,name=period,proto3,stdduration" json:"period"`
+}
+
+func (m *MintAllowance) Reset()         { *m = MintAllowance{} }
+func (m *MintAllowance) String() string { return proto.CompactTextString(m) }
+func (*MintAllowance) ProtoMessage()    {}
+func (*MintAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe80c7a2c55589e7, []int{2}
+}
+func (m *MintAllowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MintAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MintAllowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MintAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MintAllowance.Merge(m, src)
+}
+func (m *MintAllowance) XXX_Size() int {
+	return m.Size()
+}
+func (m *MintAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_MintAllowance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MintAllowance proto.InternalMessageInfo
+
+func (m *MintAllowance) GetPeriod() time.Duration {
+	if m != nil {
+		return m.Period
+	}
+	return 0
+}
+
+// MintAllowanceUsage defines the amount minted within the current mint allowance period.
+type MintAllowanceUsage struct {
+	Denom       string                                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	PeriodStart time.Time                              `protobuf:"bytes,2,opt,name=period_start,json=periodStart,proto3,stdtime" json:"period_start"`
+	Minted      github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=minted,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"minted"`
+}
+
+func (m *MintAllowanceUsage) Reset()         { *m = MintAllowanceUsage{} }
+func (m *MintAllowanceUsage) String() string { return proto.CompactTextString(m) }
+func (*MintAllowanceUsage) ProtoMessage()    {}
+func (*MintAllowanceUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe80c7a2c55589e7, []int{3}
+}
+func (m *MintAllowanceUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MintAllowanceUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MintAllowanceUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MintAllowanceUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MintAllowanceUsage.Merge(m, src)
+}
+func (m *MintAllowanceUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *MintAllowanceUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_MintAllowanceUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MintAllowanceUsage proto.InternalMessageInfo
+
+func (m *MintAllowanceUsage) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *MintAllowanceUsage) GetPeriodStart() time.Time {
+	if m != nil {
+		return m.PeriodStart
+	}
+	return time.Time{}
+}
+
+// DataBytes represents the immutable data attached to the token.
+type DataBytes struct {
+	Data []byte `protobuf:"bytes,1,opt,name=Data,proto3" json:"Data,omitempty"`
+}
+
+func (m *DataBytes) Reset()         { *m = DataBytes{} }
+func (m *DataBytes) String() string { return proto.CompactTextString(m) }
+func (*DataBytes) ProtoMessage()    {}
+func (*DataBytes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe80c7a2c55589e7, []int{4}
+}
+func (m *DataBytes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DataBytes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DataBytes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DataBytes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DataBytes.Merge(m, src)
+}
+func (m *DataBytes) XXX_Size() int {
+	return m.Size()
+}
+func (m *DataBytes) XXX_DiscardUnknown() {
+	xxx_messageInfo_DataBytes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DataBytes proto.InternalMessageInfo
+
+func (m *DataBytes) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
 // DelayedTokenUpgradeV1 is executed by the delay module when it's time to enable IBC.
 type DelayedTokenUpgradeV1 struct {
 	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
@@ -171,7 +414,7 @@ func (m *DelayedTokenUpgradeV1) Reset()         { *m = DelayedTokenUpgradeV1{} }
 func (m *DelayedTokenUpgradeV1) String() string { return proto.CompactTextString(m) }
 func (*DelayedTokenUpgradeV1) ProtoMessage()    {}
 func (*DelayedTokenUpgradeV1) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fe80c7a2c55589e7, []int{2}
+	return fileDescriptor_fe80c7a2c55589e7, []int{5}
 }
 func (m *DelayedTokenUpgradeV1) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -207,25 +450,25 @@ func (m *DelayedTokenUpgradeV1) GetDenom() string {
 	return ""
 }
 
-// TokenUpgradeV1Status defines the current status of the v1 token migration.
-type TokenUpgradeV1Status struct {
-	IbcEnabled bool      `protobuf:"varint,1,opt,name=ibc_enabled,json=ibcEnabled,proto3" json:"ibc_enabled,omitempty"`
-	StartTime  time.Time `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
-	EndTime    time.Time `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+// DelayedTokenUpgrade is executed by the delay module when the grace period of the token upgrade ends.
+type DelayedTokenUpgrade struct {
+	Denom   string              `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Version uint32              `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	Options TokenUpgradeOptions `protobuf:"bytes,3,opt,name=options,proto3" json:"options"`
 }
 
-func (m *TokenUpgradeV1Status) Reset()         { *m = TokenUpgradeV1Status{} }
-func (m *TokenUpgradeV1Status) String() string { return proto.CompactTextString(m) }
-func (*TokenUpgradeV1Status) ProtoMessage()    {}
-func (*TokenUpgradeV1Status) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fe80c7a2c55589e7, []int{3}
+func (m *DelayedTokenUpgrade) Reset()         { *m = DelayedTokenUpgrade{} }
+func (m *DelayedTokenUpgrade) String() string { return proto.CompactTextString(m) }
+func (*DelayedTokenUpgrade) ProtoMessage()    {}
+func (*DelayedTokenUpgrade) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe80c7a2c55589e7, []int{6}
 }
-func (m *TokenUpgradeV1Status) XXX_Unmarshal(b []byte) error {
+func (m *DelayedTokenUpgrade) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *TokenUpgradeV1Status) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DelayedTokenUpgrade) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_TokenUpgradeV1Status.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DelayedTokenUpgrade.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -235,56 +478,57 @@ func (m *TokenUpgradeV1Status) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *TokenUpgradeV1Status) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TokenUpgradeV1Status.Merge(m, src)
+func (m *DelayedTokenUpgrade) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelayedTokenUpgrade.Merge(m, src)
 }
-func (m *TokenUpgradeV1Status) XXX_Size() int {
+func (m *DelayedTokenUpgrade) XXX_Size() int {
 	return m.Size()
 }
-func (m *TokenUpgradeV1Status) XXX_DiscardUnknown() {
-	xxx_messageInfo_TokenUpgradeV1Status.DiscardUnknown(m)
+func (m *DelayedTokenUpgrade) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelayedTokenUpgrade.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TokenUpgradeV1Status proto.InternalMessageInfo
+var xxx_messageInfo_DelayedTokenUpgrade proto.InternalMessageInfo
 
-func (m *TokenUpgradeV1Status) GetIbcEnabled() bool {
+func (m *DelayedTokenUpgrade) GetDenom() string {
 	if m != nil {
-		return m.IbcEnabled
+		return m.Denom
 	}
-	return false
+	return ""
 }
 
-func (m *TokenUpgradeV1Status) GetStartTime() time.Time {
+func (m *DelayedTokenUpgrade) GetVersion() uint32 {
 	if m != nil {
-		return m.StartTime
+		return m.Version
 	}
-	return time.Time{}
+	return 0
 }
 
-func (m *TokenUpgradeV1Status) GetEndTime() time.Time {
+func (m *DelayedTokenUpgrade) GetOptions() TokenUpgradeOptions {
 	if m != nil {
-		return m.EndTime
+		return m.Options
 	}
-	return time.Time{}
+	return TokenUpgradeOptions{}
 }
 
-// TokenUpgradeStatuses defines all statuses of the token migrations.
-type TokenUpgradeStatuses struct {
-	V1 *TokenUpgradeV1Status `protobuf:"bytes,1,opt,name=v1,proto3" json:"v1,omitempty"`
+// TokenUpgradeOptions defines the options of the token upgrade, only the options of the requested version are set.
+type TokenUpgradeOptions struct {
+	V1 *TokenUpgradeV1Options `protobuf:"bytes,1,opt,name=v1,proto3" json:"v1,omitempty"`
+	V2 *TokenUpgradeV2Options `protobuf:"bytes,2,opt,name=v2,proto3" json:"v2,omitempty"`
 }
 
-func (m *TokenUpgradeStatuses) Reset()         { *m = TokenUpgradeStatuses{} }
-func (m *TokenUpgradeStatuses) String() string { return proto.CompactTextString(m) }
-func (*TokenUpgradeStatuses) ProtoMessage()    {}
-func (*TokenUpgradeStatuses) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fe80c7a2c55589e7, []int{4}
+func (m *TokenUpgradeOptions) Reset()         { *m = TokenUpgradeOptions{} }
+func (m *TokenUpgradeOptions) String() string { return proto.CompactTextString(m) }
+func (*TokenUpgradeOptions) ProtoMessage()    {}
+func (*TokenUpgradeOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe80c7a2c55589e7, []int{7}
 }
-func (m *TokenUpgradeStatuses) XXX_Unmarshal(b []byte) error {
+func (m *TokenUpgradeOptions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *TokenUpgradeStatuses) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TokenUpgradeOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_TokenUpgradeStatuses.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TokenUpgradeOptions.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -294,532 +538,6031 @@ func (m *TokenUpgradeStatuses) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *TokenUpgradeStatuses) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TokenUpgradeStatuses.Merge(m, src)
+func (m *TokenUpgradeOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenUpgradeOptions.Merge(m, src)
 }
-func (m *TokenUpgradeStatuses) XXX_Size() int {
+func (m *TokenUpgradeOptions) XXX_Size() int {
 	return m.Size()
 }
-func (m *TokenUpgradeStatuses) XXX_DiscardUnknown() {
-	xxx_messageInfo_TokenUpgradeStatuses.DiscardUnknown(m)
+func (m *TokenUpgradeOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenUpgradeOptions.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TokenUpgradeStatuses proto.InternalMessageInfo
+var xxx_messageInfo_TokenUpgradeOptions proto.InternalMessageInfo
 
-func (m *TokenUpgradeStatuses) GetV1() *TokenUpgradeV1Status {
+func (m *TokenUpgradeOptions) GetV1() *TokenUpgradeV1Options {
 	if m != nil {
 		return m.V1
 	}
 	return nil
 }
 
-func init() {
-	proto.RegisterEnum("coreum.asset.ft.v1.Feature", Feature_name, Feature_value)
-	proto.RegisterType((*Definition)(nil), "coreum.asset.ft.v1.Definition")
-	proto.RegisterType((*Token)(nil), "coreum.asset.ft.v1.Token")
-	proto.RegisterType((*DelayedTokenUpgradeV1)(nil), "coreum.asset.ft.v1.DelayedTokenUpgradeV1")
-	proto.RegisterType((*TokenUpgradeV1Status)(nil), "coreum.asset.ft.v1.TokenUpgradeV1Status")
-	proto.RegisterType((*TokenUpgradeStatuses)(nil), "coreum.asset.ft.v1.TokenUpgradeStatuses")
+func (m *TokenUpgradeOptions) GetV2() *TokenUpgradeV2Options {
+	if m != nil {
+		return m.V2
+	}
+	return nil
 }
 
-func init() { proto.RegisterFile("coreum/asset/ft/v1/token.proto", fileDescriptor_fe80c7a2c55589e7) }
+// TokenUpgradeV1Options defines the options of the v1 token upgrade.
+type TokenUpgradeV1Options struct {
+	IbcEnabled bool `protobuf:"varint,1,opt,name=ibc_enabled,json=ibcEnabled,proto3" json:"ibc_enabled,omitempty"`
+}
 
-var fileDescriptor_fe80c7a2c55589e7 = []byte{
-	// 659 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xcd, 0x6e, 0xd3, 0x4c,
-	0x14, 0x8d, 0x93, 0x26, 0x71, 0x6e, 0xfa, 0xf5, 0xab, 0x46, 0xa1, 0xb2, 0x0a, 0xb2, 0xa3, 0x2c,
-	0x20, 0x42, 0xaa, 0xad, 0xb4, 0x12, 0x20, 0x36, 0x48, 0x6d, 0xe9, 0x06, 0x16, 0x95, 0x29, 0x2c,
-	0xd8, 0x04, 0xff, 0x5c, 0xbb, 0xa3, 0xda, 0x9e, 0xc8, 0x33, 0x0e, 0xa4, 0x4f, 0xc0, 0xb2, 0x8f,
-	0xd0, 0x17, 0x61, 0x8b, 0xba, 0xec, 0x12, 0x21, 0x54, 0x50, 0xbb, 0xe1, 0x31, 0xd0, 0x8c, 0x93,
-	0xfe, 0x88, 0xb2, 0x68, 0xa5, 0xae, 0x92, 0x73, 0xe6, 0xfa, 0xf8, 0xf8, 0x9e, 0x7b, 0x07, 0xcc,
-	0x80, 0xe5, 0x58, 0xa4, 0x8e, 0xc7, 0x39, 0x0a, 0x27, 0x12, 0xce, 0x78, 0xe0, 0x08, 0xb6, 0x87,
-	0x99, 0x3d, 0xca, 0x99, 0x60, 0x84, 0x94, 0xe7, 0xb6, 0x3a, 0xb7, 0x23, 0x61, 0x8f, 0x07, 0xcb,
-	0x9d, 0x98, 0xc5, 0x4c, 0x1d, 0x3b, 0xf2, 0x5f, 0x59, 0xb9, 0x6c, 0xc5, 0x8c, 0xc5, 0x09, 0x3a,
-	0x0a, 0xf9, 0x45, 0xe4, 0x08, 0x9a, 0x22, 0x17, 0x5e, 0x3a, 0x2a, 0x0b, 0x7a, 0x5f, 0xab, 0x00,
-	0x9b, 0x18, 0xd1, 0x8c, 0x0a, 0xca, 0x32, 0xd2, 0x81, 0x7a, 0x88, 0x19, 0x4b, 0x0d, 0xad, 0xab,
-	0xf5, 0x5b, 0x6e, 0x09, 0xc8, 0x12, 0x34, 0x28, 0xe7, 0x05, 0xe6, 0x46, 0x55, 0xd1, 0x53, 0x44,
-	0x9e, 0x82, 0x1e, 0xa1, 0x27, 0x8a, 0x1c, 0xb9, 0x51, 0xeb, 0xd6, 0xfa, 0x0b, 0xab, 0xf7, 0xed,
-	0xbf, 0xad, 0xd9, 0x5b, 0x65, 0x8d, 0x7b, 0x5e, 0x4c, 0x5e, 0x41, 0xcb, 0x2f, 0xf2, 0x6c, 0x98,
-	0x7b, 0x02, 0x8d, 0x39, 0xa9, 0xb9, 0x6e, 0x1f, 0x9d, 0x58, 0x95, 0xef, 0x27, 0xd6, 0xc3, 0x98,
-	0x8a, 0xdd, 0xc2, 0xb7, 0x03, 0x96, 0x3a, 0x01, 0xe3, 0x29, 0xe3, 0xd3, 0x9f, 0x15, 0x1e, 0xee,
-	0x39, 0x62, 0x32, 0x42, 0x6e, 0x6f, 0x62, 0xe0, 0xea, 0x52, 0xc0, 0xf5, 0x04, 0x92, 0x0f, 0xd0,
-	0xe1, 0x98, 0x85, 0xc3, 0x80, 0xa5, 0x29, 0xe5, 0x9c, 0xb2, 0xa9, 0x6e, 0xfd, 0x56, 0xba, 0x44,
-	0x6a, 0x6d, 0x9c, 0x4b, 0xa9, 0x37, 0x18, 0xd0, 0x1c, 0x63, 0x2e, 0xa1, 0xd1, 0xe8, 0x6a, 0xfd,
-	0xff, 0xdc, 0x19, 0x7c, 0xae, 0x7f, 0x3e, 0xb4, 0x2a, 0xbf, 0x0f, 0xad, 0x4a, 0xef, 0x47, 0x0d,
-	0xea, 0x3b, 0x32, 0xa3, 0x1b, 0xf6, 0x70, 0x09, 0x1a, 0x7c, 0x92, 0xfa, 0x2c, 0x31, 0x6a, 0x25,
-	0x5f, 0x22, 0xf9, 0x4e, 0x5e, 0xf8, 0x45, 0x46, 0x45, 0xd9, 0x20, 0x77, 0x06, 0xc9, 0x03, 0x68,
-	0x8d, 0x72, 0x0c, 0xa8, 0xf2, 0x53, 0x57, 0x7e, 0x2e, 0x08, 0xd2, 0x85, 0x76, 0x88, 0x3c, 0xc8,
-	0xe9, 0x48, 0xcc, 0xfc, 0xb6, 0xdc, 0xcb, 0x14, 0x79, 0x04, 0xff, 0xc7, 0x09, 0xf3, 0xbd, 0x24,
-	0x99, 0x0c, 0xa3, 0x9c, 0xed, 0x63, 0x66, 0x34, 0xbb, 0x5a, 0x5f, 0x77, 0x17, 0x66, 0xf4, 0x96,
-	0x62, 0xaf, 0xc4, 0xab, 0xdf, 0x3a, 0xde, 0xd6, 0x1d, 0xc5, 0x0b, 0x77, 0x11, 0x6f, 0xfb, 0x5f,
-	0xf1, 0xae, 0xc0, 0xbd, 0x4d, 0x4c, 0xbc, 0x09, 0x86, 0x2a, 0xe4, 0xb7, 0xa3, 0x38, 0xf7, 0x42,
-	0x7c, 0x37, 0xb8, 0x3e, 0xed, 0xde, 0x17, 0x0d, 0x3a, 0x57, 0x0b, 0xdf, 0x08, 0x4f, 0x14, 0x9c,
-	0x58, 0xd0, 0xa6, 0x7e, 0x30, 0xc4, 0xcc, 0xf3, 0x13, 0x0c, 0xd5, 0x43, 0xba, 0x0b, 0xd4, 0x0f,
-	0x5e, 0x96, 0x0c, 0xd9, 0x00, 0xe0, 0xc2, 0xcb, 0xc5, 0x50, 0x6e, 0xaa, 0x9a, 0x95, 0xf6, 0xea,
-	0xb2, 0x5d, 0xae, 0xb1, 0x3d, 0x5b, 0x63, 0x7b, 0x67, 0xb6, 0xc6, 0xeb, 0xba, 0x6c, 0xc0, 0xc1,
-	0x4f, 0x4b, 0x73, 0x5b, 0xea, 0x39, 0x79, 0x42, 0x5e, 0x80, 0x2e, 0x5b, 0xa6, 0x24, 0x6a, 0x37,
-	0x90, 0x68, 0x62, 0x16, 0x4a, 0xbe, 0xb7, 0x7d, 0xd5, 0x7e, 0x69, 0x1e, 0x39, 0x79, 0x06, 0xd5,
-	0xf1, 0x40, 0xb9, 0x6e, 0xaf, 0xf6, 0xaf, 0x1b, 0x86, 0xeb, 0x3e, 0xda, 0xad, 0x8e, 0x07, 0x8f,
-	0x5f, 0x43, 0x73, 0x3a, 0x28, 0xa4, 0x0d, 0xcd, 0x94, 0x66, 0x82, 0x66, 0xf1, 0x62, 0x45, 0x02,
-	0x19, 0xb5, 0x04, 0x1a, 0x99, 0x07, 0x3d, 0xca, 0x11, 0xf7, 0x25, 0xaa, 0x92, 0x45, 0x98, 0xff,
-	0xb8, 0x4b, 0x05, 0x26, 0x94, 0xab, 0xe2, 0x1a, 0x69, 0x42, 0x8d, 0xfa, 0xc1, 0xe2, 0xdc, 0xfa,
-	0xf6, 0xd1, 0xa9, 0xa9, 0x1d, 0x9f, 0x9a, 0xda, 0xaf, 0x53, 0x53, 0x3b, 0x38, 0x33, 0x2b, 0xc7,
-	0x67, 0x66, 0xe5, 0xdb, 0x99, 0x59, 0x79, 0xff, 0xe4, 0xd2, 0x20, 0x6c, 0x28, 0x7f, 0x5b, 0xac,
-	0xc8, 0x42, 0x4f, 0x8e, 0xbe, 0x33, 0xbd, 0x57, 0xc7, 0x6b, 0xce, 0xa7, 0x8b, 0xcb, 0x55, 0x0d,
-	0x87, 0xdf, 0x50, 0x8d, 0x59, 0xfb, 0x13, 0x00, 0x00, 0xff, 0xff, 0x12, 0xaf, 0xc1, 0x5a, 0x7c,
-	0x05, 0x00, 0x00,
+func (m *TokenUpgradeV1Options) Reset()         { *m = TokenUpgradeV1Options{} }
+func (m *TokenUpgradeV1Options) String() string { return proto.CompactTextString(m) }
+func (*TokenUpgradeV1Options) ProtoMessage()    {}
+func (*TokenUpgradeV1Options) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe80c7a2c55589e7, []int{8}
+}
+func (m *TokenUpgradeV1Options) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenUpgradeV1Options) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenUpgradeV1Options.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TokenUpgradeV1Options) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenUpgradeV1Options.Merge(m, src)
+}
+func (m *TokenUpgradeV1Options) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenUpgradeV1Options) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenUpgradeV1Options.DiscardUnknown(m)
 }
 
-func (m *Definition) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_TokenUpgradeV1Options proto.InternalMessageInfo
+
+func (m *TokenUpgradeV1Options) GetIbcEnabled() bool {
+	if m != nil {
+		return m.IbcEnabled
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *Definition) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// TokenUpgradeV2Options defines the options of the v2 token upgrade.
+type TokenUpgradeV2Options struct {
+	// features are the features enabled on the token.
+	Features []Feature `protobuf:"varint,1,rep,packed,name=features,proto3,enum=coreum.asset.ft.v1.Feature" json:"features,omitempty"`
+	// burn_rate is the new burn rate of the token, it can't be higher than the current one.
+	// The rate is not changed if it is empty.
+	BurnRate *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=burn_rate,json=burnRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"burn_rate,omitempty"`
+	// send_commission_rate is the new send commission rate of the token, it can't be higher than the current one.
+	// The rate is not changed if it is empty.
+	SendCommissionRate *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=send_commission_rate,json=sendCommissionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"send_commission_rate,omitempty"`
 }
 
-func (m *Definition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Version != 0 {
-		i = encodeVarintToken(dAtA, i, uint64(m.Version))
-		i--
-		dAtA[i] = 0x30
-	}
-	{
-		size := m.SendCommissionRate.Size()
-		i -= size
-		if _, err := m.SendCommissionRate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *TokenUpgradeV2Options) Reset()         { *m = TokenUpgradeV2Options{} }
+func (m *TokenUpgradeV2Options) String() string { return proto.CompactTextString(m) }
+func (*TokenUpgradeV2Options) ProtoMessage()    {}
+func (*TokenUpgradeV2Options) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe80c7a2c55589e7, []int{9}
+}
+func (m *TokenUpgradeV2Options) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenUpgradeV2Options) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenUpgradeV2Options.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintToken(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size := m.BurnRate.Size()
-		i -= size
-		if _, err := m.BurnRate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintToken(dAtA, i, uint64(size))
+}
+func (m *TokenUpgradeV2Options) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenUpgradeV2Options.Merge(m, src)
+}
+func (m *TokenUpgradeV2Options) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenUpgradeV2Options) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenUpgradeV2Options.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenUpgradeV2Options proto.InternalMessageInfo
+
+func (m *TokenUpgradeV2Options) GetFeatures() []Feature {
+	if m != nil {
+		return m.Features
 	}
-	i--
-	dAtA[i] = 0x22
-	if len(m.Features) > 0 {
-		dAtA2 := make([]byte, len(m.Features)*10)
-		var j1 int
-		for _, num := range m.Features {
-			for num >= 1<<7 {
-				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j1++
-			}
-			dAtA2[j1] = uint8(num)
-			j1++
-		}
-		i -= j1
-		copy(dAtA[i:], dAtA2[:j1])
-		i = encodeVarintToken(dAtA, i, uint64(j1))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Issuer) > 0 {
-		i -= len(m.Issuer)
-		copy(dAtA[i:], m.Issuer)
-		i = encodeVarintToken(dAtA, i, uint64(len(m.Issuer)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintToken(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *Token) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// PendingRatesUpdate defines the rates increase which takes effect after the notice period.
+type PendingRatesUpdate struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// burn_rate is the new burn rate of the token, the rate is not changed if it is empty.
+	BurnRate *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=burn_rate,json=burnRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"burn_rate,omitempty"`
+	// send_commission_rate is the new send commission rate of the token, the rate is not changed if it is empty.
+	SendCommissionRate *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=send_commission_rate,json=sendCommissionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"send_commission_rate,omitempty"`
+	EffectiveTime      time.Time                               `protobuf:"bytes,4,opt,name=effective_time,json=effectiveTime,proto3,stdtime" json:"effective_time"`
 }
 
-func (m *Token) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PendingRatesUpdate) Reset()         { *m = PendingRatesUpdate{} }
+func (m *PendingRatesUpdate) String() string { return proto.CompactTextString(m) }
+func (*PendingRatesUpdate) ProtoMessage()    {}
+func (*PendingRatesUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe80c7a2c55589e7, []int{10}
 }
-
-func (m *Token) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Version != 0 {
-		i = encodeVarintToken(dAtA, i, uint64(m.Version))
-		i--
-		dAtA[i] = 0x58
-	}
-	{
-		size := m.SendCommissionRate.Size()
-		i -= size
-		if _, err := m.SendCommissionRate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintToken(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x52
-	{
-		size := m.BurnRate.Size()
-		i -= size
-		if _, err := m.BurnRate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintToken(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x4a
-	if len(m.Features) > 0 {
-		dAtA4 := make([]byte, len(m.Features)*10)
-		var j3 int
-		for _, num := range m.Features {
-			for num >= 1<<7 {
-				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j3++
-			}
-			dAtA4[j3] = uint8(num)
-			j3++
-		}
-		i -= j3
-		copy(dAtA[i:], dAtA4[:j3])
-		i = encodeVarintToken(dAtA, i, uint64(j3))
-		i--
-		dAtA[i] = 0x42
-	}
-	if m.GloballyFrozen {
-		i--
-		if m.GloballyFrozen {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *PendingRatesUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingRatesUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingRatesUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x38
-	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintToken(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.Precision != 0 {
-		i = encodeVarintToken(dAtA, i, uint64(m.Precision))
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.Subunit) > 0 {
-		i -= len(m.Subunit)
-		copy(dAtA[i:], m.Subunit)
-		i = encodeVarintToken(dAtA, i, uint64(len(m.Subunit)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Symbol) > 0 {
-		i -= len(m.Symbol)
-		copy(dAtA[i:], m.Symbol)
-		i = encodeVarintToken(dAtA, i, uint64(len(m.Symbol)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Issuer) > 0 {
-		i -= len(m.Issuer)
-		copy(dAtA[i:], m.Issuer)
-		i = encodeVarintToken(dAtA, i, uint64(len(m.Issuer)))
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintToken(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *PendingRatesUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingRatesUpdate.Merge(m, src)
+}
+func (m *PendingRatesUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingRatesUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingRatesUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingRatesUpdate proto.InternalMessageInfo
+
+func (m *PendingRatesUpdate) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *DelayedTokenUpgradeV1) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PendingRatesUpdate) GetEffectiveTime() time.Time {
+	if m != nil {
+		return m.EffectiveTime
 	}
-	return dAtA[:n], nil
+	return time.Time{}
 }
 
-func (m *DelayedTokenUpgradeV1) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// DelayedRatesUpdate is executed by the delay module when the notice period of the rates increase ends.
+type DelayedRatesUpdate struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-func (m *DelayedTokenUpgradeV1) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintToken(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+func (m *DelayedRatesUpdate) Reset()         { *m = DelayedRatesUpdate{} }
+func (m *DelayedRatesUpdate) String() string { return proto.CompactTextString(m) }
+func (*DelayedRatesUpdate) ProtoMessage()    {}
+func (*DelayedRatesUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe80c7a2c55589e7, []int{11}
+}
+func (m *DelayedRatesUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelayedRatesUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelayedRatesUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *DelayedRatesUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelayedRatesUpdate.Merge(m, src)
+}
+func (m *DelayedRatesUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelayedRatesUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelayedRatesUpdate.DiscardUnknown(m)
 }
 
-func (m *TokenUpgradeV1Status) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_DelayedRatesUpdate proto.InternalMessageInfo
+
+func (m *DelayedRatesUpdate) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *TokenUpgradeV1Status) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// DelayedUnfreeze is executed by the delay module when it's time to unfreeze the time-locked frozen amount.
+type DelayedUnfreeze struct {
+	Account      string    `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Denom        string    `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	UnfreezeTime time.Time `protobuf:"bytes,3,opt,name=unfreeze_time,json=unfreezeTime,proto3,stdtime" json:"unfreeze_time"`
 }
 
-func (m *TokenUpgradeV1Status) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
-	if err5 != nil {
-		return 0, err5
+func (m *DelayedUnfreeze) Reset()         { *m = DelayedUnfreeze{} }
+func (m *DelayedUnfreeze) String() string { return proto.CompactTextString(m) }
+func (*DelayedUnfreeze) ProtoMessage()    {}
+func (*DelayedUnfreeze) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe80c7a2c55589e7, []int{12}
+}
+func (m *DelayedUnfreeze) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelayedUnfreeze) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelayedUnfreeze.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	i -= n5
-	i = encodeVarintToken(dAtA, i, uint64(n5))
-	i--
-	dAtA[i] = 0x1a
-	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
-	if err6 != nil {
-		return 0, err6
+}
+func (m *DelayedUnfreeze) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelayedUnfreeze.Merge(m, src)
+}
+func (m *DelayedUnfreeze) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelayedUnfreeze) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelayedUnfreeze.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelayedUnfreeze proto.InternalMessageInfo
+
+func (m *DelayedUnfreeze) GetAccount() string {
+	if m != nil {
+		return m.Account
 	}
-	i -= n6
-	i = encodeVarintToken(dAtA, i, uint64(n6))
-	i--
-	dAtA[i] = 0x12
-	if m.IbcEnabled {
-		i--
-		if m.IbcEnabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
+	return ""
+}
+
+func (m *DelayedUnfreeze) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *TokenUpgradeStatuses) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *DelayedUnfreeze) GetUnfreezeTime() time.Time {
+	if m != nil {
+		return m.UnfreezeTime
 	}
-	return dAtA[:n], nil
+	return time.Time{}
 }
 
-func (m *TokenUpgradeStatuses) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ScheduledUnfreeze defines the frozen amount which is unfrozen automatically at the unfreeze time.
+type ScheduledUnfreeze struct {
+	Account      string      `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Coin         types1.Coin `protobuf:"bytes,2,opt,name=coin,proto3" json:"coin"`
+	UnfreezeTime time.Time   `protobuf:"bytes,3,opt,name=unfreeze_time,json=unfreezeTime,proto3,stdtime" json:"unfreeze_time"`
 }
 
-func (m *TokenUpgradeStatuses) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.V1 != nil {
-		{
-			size, err := m.V1.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintToken(dAtA, i, uint64(size))
+func (m *ScheduledUnfreeze) Reset()         { *m = ScheduledUnfreeze{} }
+func (m *ScheduledUnfreeze) String() string { return proto.CompactTextString(m) }
+func (*ScheduledUnfreeze) ProtoMessage()    {}
+func (*ScheduledUnfreeze) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe80c7a2c55589e7, []int{13}
+}
+func (m *ScheduledUnfreeze) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduledUnfreeze) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduledUnfreeze.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ScheduledUnfreeze) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledUnfreeze.Merge(m, src)
+}
+func (m *ScheduledUnfreeze) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduledUnfreeze) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledUnfreeze.DiscardUnknown(m)
 }
 
-func encodeVarintToken(dAtA []byte, offset int, v uint64) int {
-	offset -= sovToken(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_ScheduledUnfreeze proto.InternalMessageInfo
+
+func (m *ScheduledUnfreeze) GetAccount() string {
+	if m != nil {
+		return m.Account
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *Definition) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovToken(uint64(l))
+
+func (m *ScheduledUnfreeze) GetCoin() types1.Coin {
+	if m != nil {
+		return m.Coin
 	}
-	l = len(m.Issuer)
-	if l > 0 {
-		n += 1 + l + sovToken(uint64(l))
+	return types1.Coin{}
+}
+
+func (m *ScheduledUnfreeze) GetUnfreezeTime() time.Time {
+	if m != nil {
+		return m.UnfreezeTime
 	}
-	if len(m.Features) > 0 {
-		l = 0
-		for _, e := range m.Features {
-			l += sovToken(uint64(e))
+	return time.Time{}
+}
+
+// TokenUpgradeV1Status defines the current status of the v1 token migration.
+type TokenUpgradeV1Status struct {
+	IbcEnabled bool      `protobuf:"varint,1,opt,name=ibc_enabled,json=ibcEnabled,proto3" json:"ibc_enabled,omitempty"`
+	StartTime  time.Time `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	EndTime    time.Time `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+}
+
+func (m *TokenUpgradeV1Status) Reset()         { *m = TokenUpgradeV1Status{} }
+func (m *TokenUpgradeV1Status) String() string { return proto.CompactTextString(m) }
+func (*TokenUpgradeV1Status) ProtoMessage()    {}
+func (*TokenUpgradeV1Status) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe80c7a2c55589e7, []int{14}
+}
+func (m *TokenUpgradeV1Status) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenUpgradeV1Status) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenUpgradeV1Status.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		n += 1 + sovToken(uint64(l)) + l
+		return b[:n], nil
 	}
-	l = m.BurnRate.Size()
-	n += 1 + l + sovToken(uint64(l))
-	l = m.SendCommissionRate.Size()
-	n += 1 + l + sovToken(uint64(l))
-	if m.Version != 0 {
-		n += 1 + sovToken(uint64(m.Version))
+}
+func (m *TokenUpgradeV1Status) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenUpgradeV1Status.Merge(m, src)
+}
+func (m *TokenUpgradeV1Status) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenUpgradeV1Status) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenUpgradeV1Status.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenUpgradeV1Status proto.InternalMessageInfo
+
+func (m *TokenUpgradeV1Status) GetIbcEnabled() bool {
+	if m != nil {
+		return m.IbcEnabled
 	}
-	return n
+	return false
 }
 
-func (m *Token) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TokenUpgradeV1Status) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
 	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovToken(uint64(l))
+	return time.Time{}
+}
+
+func (m *TokenUpgradeV1Status) GetEndTime() time.Time {
+	if m != nil {
+		return m.EndTime
 	}
-	l = len(m.Issuer)
-	if l > 0 {
-		n += 1 + l + sovToken(uint64(l))
+	return time.Time{}
+}
+
+// TokenUpgradeV2Status defines the current status of the v2 token migration.
+type TokenUpgradeV2Status struct {
+	Options   TokenUpgradeV2Options `protobuf:"bytes,1,opt,name=options,proto3" json:"options"`
+	StartTime time.Time             `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	EndTime   time.Time             `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+}
+
+func (m *TokenUpgradeV2Status) Reset()         { *m = TokenUpgradeV2Status{} }
+func (m *TokenUpgradeV2Status) String() string { return proto.CompactTextString(m) }
+func (*TokenUpgradeV2Status) ProtoMessage()    {}
+func (*TokenUpgradeV2Status) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe80c7a2c55589e7, []int{15}
+}
+func (m *TokenUpgradeV2Status) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenUpgradeV2Status) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenUpgradeV2Status.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.Symbol)
-	if l > 0 {
-		n += 1 + l + sovToken(uint64(l))
+}
+func (m *TokenUpgradeV2Status) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenUpgradeV2Status.Merge(m, src)
+}
+func (m *TokenUpgradeV2Status) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenUpgradeV2Status) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenUpgradeV2Status.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenUpgradeV2Status proto.InternalMessageInfo
+
+func (m *TokenUpgradeV2Status) GetOptions() TokenUpgradeV2Options {
+	if m != nil {
+		return m.Options
 	}
-	l = len(m.Subunit)
-	if l > 0 {
-		n += 1 + l + sovToken(uint64(l))
+	return TokenUpgradeV2Options{}
+}
+
+func (m *TokenUpgradeV2Status) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
 	}
-	if m.Precision != 0 {
-		n += 1 + sovToken(uint64(m.Precision))
+	return time.Time{}
+}
+
+func (m *TokenUpgradeV2Status) GetEndTime() time.Time {
+	if m != nil {
+		return m.EndTime
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovToken(uint64(l))
+	return time.Time{}
+}
+
+// TokenUpgradeStatuses defines all statuses of the token migrations.
+type TokenUpgradeStatuses struct {
+	V1 *TokenUpgradeV1Status `protobuf:"bytes,1,opt,name=v1,proto3" json:"v1,omitempty"`
+	V2 *TokenUpgradeV2Status `protobuf:"bytes,2,opt,name=v2,proto3" json:"v2,omitempty"`
+}
+
+func (m *TokenUpgradeStatuses) Reset()         { *m = TokenUpgradeStatuses{} }
+func (m *TokenUpgradeStatuses) String() string { return proto.CompactTextString(m) }
+func (*TokenUpgradeStatuses) ProtoMessage()    {}
+func (*TokenUpgradeStatuses) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe80c7a2c55589e7, []int{16}
+}
+func (m *TokenUpgradeStatuses) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenUpgradeStatuses) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenUpgradeStatuses.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.GloballyFrozen {
-		n += 2
+}
+func (m *TokenUpgradeStatuses) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenUpgradeStatuses.Merge(m, src)
+}
+func (m *TokenUpgradeStatuses) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenUpgradeStatuses) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenUpgradeStatuses.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenUpgradeStatuses proto.InternalMessageInfo
+
+func (m *TokenUpgradeStatuses) GetV1() *TokenUpgradeV1Status {
+	if m != nil {
+		return m.V1
 	}
-	if len(m.Features) > 0 {
-		l = 0
-		for _, e := range m.Features {
-			l += sovToken(uint64(e))
+	return nil
+}
+
+func (m *TokenUpgradeStatuses) GetV2() *TokenUpgradeV2Status {
+	if m != nil {
+		return m.V2
+	}
+	return nil
+}
+
+// TransferLimit defines the amount the account might send within the rolling period.
+type TransferLimit struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// account is the account the limit is applied to, the limit is the default one applied to all the accounts
+	// without their own limits if it is empty.
+	Account string                                 `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	Amount  github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	Period  time.Duration                          `protobuf:"bytes,4,opt,name=period,proto3,stdduration" json:"period"`
+}
+
+func (m *TransferLimit) Reset()         { *m = TransferLimit{} }
+func (m *TransferLimit) String() string { return proto.CompactTextString(m) }
+func (*TransferLimit) ProtoMessage()    {}
+func (*TransferLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe80c7a2c55589e7, []int{17}
+}
+func (m *TransferLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransferLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransferLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TransferLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferLimit.Merge(m, src)
+}
+func (m *TransferLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransferLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferLimit proto.InternalMessageInfo
+
+func (m *TransferLimit) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *TransferLimit) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *TransferLimit) GetPeriod() time.Duration {
+	if m != nil {
+		return m.Period
+	}
+	return 0
+}
+
+// TransferLimitUsage defines the amount sent by the account within the window of the transfer limit period.
+type TransferLimitUsage struct {
+	Denom       string                                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Account     string                                 `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	WindowStart time.Time                              `protobuf:"bytes,3,opt,name=window_start,json=windowStart,proto3,stdtime" json:"window_start"`
+	Sent        github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=sent,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"sent"`
+}
+
+func (m *TransferLimitUsage) Reset()         { *m = TransferLimitUsage{} }
+func (m *TransferLimitUsage) String() string { return proto.CompactTextString(m) }
+func (*TransferLimitUsage) ProtoMessage()    {}
+func (*TransferLimitUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe80c7a2c55589e7, []int{18}
+}
+func (m *TransferLimitUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransferLimitUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransferLimitUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TransferLimitUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferLimitUsage.Merge(m, src)
+}
+func (m *TransferLimitUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransferLimitUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferLimitUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferLimitUsage proto.InternalMessageInfo
+
+func (m *TransferLimitUsage) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *TransferLimitUsage) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *TransferLimitUsage) GetWindowStart() time.Time {
+	if m != nil {
+		return m.WindowStart
+	}
+	return time.Time{}
+}
+
+// Distribution defines the pool distributed to the holders of the token proportionally to their balances at the
+// snapshot height.
+type Distribution struct {
+	// denom is the token whose holders receive the pool.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// distributor is the account which funded the pool.
+	Distributor string `protobuf:"bytes,2,opt,name=distributor,proto3" json:"distributor,omitempty"`
+	// pool is the amount distributed to the holders.
+	Pool types1.Coin `protobuf:"bytes,3,opt,name=pool,proto3" json:"pool"`
+	// snapshot_height is the height at which the balances of the holders are taken.
+	SnapshotHeight int64 `protobuf:"varint,4,opt,name=snapshot_height,json=snapshotHeight,proto3" json:"snapshot_height,omitempty"`
+	// snapshot_supply is the supply of the token at the snapshot height.
+	SnapshotSupply github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=snapshot_supply,json=snapshotSupply,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"snapshot_supply"`
+	// distributed is the amount already paid to the holders.
+	Distributed github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=distributed,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"distributed"`
+	// paid_holders is the number of the holders already paid.
+	PaidHolders uint64 `protobuf:"varint,7,opt,name=paid_holders,json=paidHolders,proto3" json:"paid_holders,omitempty"`
+	// phase is the current phase of the distribution processing.
+	Phase DistributionPhase `protobuf:"varint,8,opt,name=phase,proto3,enum=coreum.asset.ft.v1.DistributionPhase" json:"phase,omitempty"`
+	// next_key is the key the processing continues from in the next block.
+	NextKey []byte `protobuf:"bytes,9,opt,name=next_key,json=nextKey,proto3" json:"next_key,omitempty"`
+}
+
+func (m *Distribution) Reset()         { *m = Distribution{} }
+func (m *Distribution) String() string { return proto.CompactTextString(m) }
+func (*Distribution) ProtoMessage()    {}
+func (*Distribution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe80c7a2c55589e7, []int{19}
+}
+func (m *Distribution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Distribution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Distribution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Distribution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Distribution.Merge(m, src)
+}
+func (m *Distribution) XXX_Size() int {
+	return m.Size()
+}
+func (m *Distribution) XXX_DiscardUnknown() {
+	xxx_messageInfo_Distribution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Distribution proto.InternalMessageInfo
+
+func (m *Distribution) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *Distribution) GetDistributor() string {
+	if m != nil {
+		return m.Distributor
+	}
+	return ""
+}
+
+func (m *Distribution) GetPool() types1.Coin {
+	if m != nil {
+		return m.Pool
+	}
+	return types1.Coin{}
+}
+
+func (m *Distribution) GetSnapshotHeight() int64 {
+	if m != nil {
+		return m.SnapshotHeight
+	}
+	return 0
+}
+
+func (m *Distribution) GetPaidHolders() uint64 {
+	if m != nil {
+		return m.PaidHolders
+	}
+	return 0
+}
+
+func (m *Distribution) GetPhase() DistributionPhase {
+	if m != nil {
+		return m.Phase
+	}
+	return DistributionPhase_paying_holders
+}
+
+func (m *Distribution) GetNextKey() []byte {
+	if m != nil {
+		return m.NextKey
+	}
+	return nil
+}
+
+// DistributionHolder defines the balance of the holder at the snapshot height, recorded when the balance is changed
+// or the holder is paid.
+type DistributionHolder struct {
+	Denom   string                                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Account string                                 `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	Balance github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=balance,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"balance"`
+	Paid    bool                                   `protobuf:"varint,4,opt,name=paid,proto3" json:"paid,omitempty"`
+}
+
+func (m *DistributionHolder) Reset()         { *m = DistributionHolder{} }
+func (m *DistributionHolder) String() string { return proto.CompactTextString(m) }
+func (*DistributionHolder) ProtoMessage()    {}
+func (*DistributionHolder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe80c7a2c55589e7, []int{20}
+}
+func (m *DistributionHolder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DistributionHolder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DistributionHolder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DistributionHolder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DistributionHolder.Merge(m, src)
+}
+func (m *DistributionHolder) XXX_Size() int {
+	return m.Size()
+}
+func (m *DistributionHolder) XXX_DiscardUnknown() {
+	xxx_messageInfo_DistributionHolder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DistributionHolder proto.InternalMessageInfo
+
+func (m *DistributionHolder) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *DistributionHolder) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *DistributionHolder) GetPaid() bool {
+	if m != nil {
+		return m.Paid
+	}
+	return false
+}
+
+// VestingPeriod defines the amount vested at the end of the period of the periodic vesting schedule.
+type VestingPeriod struct {
+	Length time.Duration                          `protobuf:"bytes,1,opt,name=length,proto3,stdduration" json:"length"`
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+}
+
+func (m *VestingPeriod) Reset()         { *m = VestingPeriod{} }
+func (m *VestingPeriod) String() string { return proto.CompactTextString(m) }
+func (*VestingPeriod) ProtoMessage()    {}
+func (*VestingPeriod) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe80c7a2c55589e7, []int{21}
+}
+func (m *VestingPeriod) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VestingPeriod) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VestingPeriod.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VestingPeriod) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VestingPeriod.Merge(m, src)
+}
+func (m *VestingPeriod) XXX_Size() int {
+	return m.Size()
+}
+func (m *VestingPeriod) XXX_DiscardUnknown() {
+	xxx_messageInfo_VestingPeriod.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VestingPeriod proto.InternalMessageInfo
+
+func (m *VestingPeriod) GetLength() time.Duration {
+	if m != nil {
+		return m.Length
+	}
+	return 0
+}
+
+// VestingSchedule defines the amount of the token locked on the account and released over time.
+type VestingSchedule struct {
+	Denom       string      `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Account     string      `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	VestingType VestingType `protobuf:"varint,3,opt,name=vesting_type,json=vestingType,proto3,enum=coreum.asset.ft.v1.VestingType" json:"vesting_type,omitempty"`
+	// amount is the total amount vested by the schedule.
+	Amount    github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	StartTime time.Time                              `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	// end_time is the time the whole amount is vested at, it is set for the linear and cliff schedules only.
+	EndTime *time.Time `protobuf:"bytes,6,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time,omitempty"`
+	// periods are the consecutive periods of the periodic schedule starting at the start time.
+	Periods []VestingPeriod `protobuf:"bytes,7,rep,name=periods,proto3" json:"periods"`
+}
+
+func (m *VestingSchedule) Reset()         { *m = VestingSchedule{} }
+func (m *VestingSchedule) String() string { return proto.CompactTextString(m) }
+func (*VestingSchedule) ProtoMessage()    {}
+func (*VestingSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe80c7a2c55589e7, []int{22}
+}
+func (m *VestingSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VestingSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VestingSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VestingSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VestingSchedule.Merge(m, src)
+}
+func (m *VestingSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *VestingSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_VestingSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VestingSchedule proto.InternalMessageInfo
+
+func (m *VestingSchedule) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *VestingSchedule) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *VestingSchedule) GetVestingType() VestingType {
+	if m != nil {
+		return m.VestingType
+	}
+	return VestingType_linear
+}
+
+func (m *VestingSchedule) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *VestingSchedule) GetEndTime() *time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return nil
+}
+
+func (m *VestingSchedule) GetPeriods() []VestingPeriod {
+	if m != nil {
+		return m.Periods
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("coreum.asset.ft.v1.Feature", Feature_name, Feature_value)
+	proto.RegisterEnum("coreum.asset.ft.v1.DistributionPhase", DistributionPhase_name, DistributionPhase_value)
+	proto.RegisterEnum("coreum.asset.ft.v1.VestingType", VestingType_name, VestingType_value)
+	proto.RegisterType((*Definition)(nil), "coreum.asset.ft.v1.Definition")
+	proto.RegisterType((*Token)(nil), "coreum.asset.ft.v1.Token")
+	proto.RegisterType((*MintAllowance)(nil), "coreum.asset.ft.v1.MintAllowance")
+	proto.RegisterType((*MintAllowanceUsage)(nil), "coreum.asset.ft.v1.MintAllowanceUsage")
+	proto.RegisterType((*DataBytes)(nil), "coreum.asset.ft.v1.DataBytes")
+	proto.RegisterType((*DelayedTokenUpgradeV1)(nil), "coreum.asset.ft.v1.DelayedTokenUpgradeV1")
+	proto.RegisterType((*DelayedTokenUpgrade)(nil), "coreum.asset.ft.v1.DelayedTokenUpgrade")
+	proto.RegisterType((*TokenUpgradeOptions)(nil), "coreum.asset.ft.v1.TokenUpgradeOptions")
+	proto.RegisterType((*TokenUpgradeV1Options)(nil), "coreum.asset.ft.v1.TokenUpgradeV1Options")
+	proto.RegisterType((*TokenUpgradeV2Options)(nil), "coreum.asset.ft.v1.TokenUpgradeV2Options")
+	proto.RegisterType((*PendingRatesUpdate)(nil), "coreum.asset.ft.v1.PendingRatesUpdate")
+	proto.RegisterType((*DelayedRatesUpdate)(nil), "coreum.asset.ft.v1.DelayedRatesUpdate")
+	proto.RegisterType((*DelayedUnfreeze)(nil), "coreum.asset.ft.v1.DelayedUnfreeze")
+	proto.RegisterType((*ScheduledUnfreeze)(nil), "coreum.asset.ft.v1.ScheduledUnfreeze")
+	proto.RegisterType((*TokenUpgradeV1Status)(nil), "coreum.asset.ft.v1.TokenUpgradeV1Status")
+	proto.RegisterType((*TokenUpgradeV2Status)(nil), "coreum.asset.ft.v1.TokenUpgradeV2Status")
+	proto.RegisterType((*TokenUpgradeStatuses)(nil), "coreum.asset.ft.v1.TokenUpgradeStatuses")
+	proto.RegisterType((*TransferLimit)(nil), "coreum.asset.ft.v1.TransferLimit")
+	proto.RegisterType((*TransferLimitUsage)(nil), "coreum.asset.ft.v1.TransferLimitUsage")
+	proto.RegisterType((*Distribution)(nil), "coreum.asset.ft.v1.Distribution")
+	proto.RegisterType((*DistributionHolder)(nil), "coreum.asset.ft.v1.DistributionHolder")
+	proto.RegisterType((*VestingPeriod)(nil), "coreum.asset.ft.v1.VestingPeriod")
+	proto.RegisterType((*VestingSchedule)(nil), "coreum.asset.ft.v1.VestingSchedule")
+}
+
+func init() { proto.RegisterFile("coreum/asset/ft/v1/token.proto", fileDescriptor_fe80c7a2c55589e7) }
+
+var fileDescriptor_fe80c7a2c55589e7 = []byte{
+	// 1755 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0x4f, 0x8f, 0x1b, 0x49,
+	0x15, 0x77, 0xfb, 0xbf, 0x9f, 0x3d, 0x1e, 0xa7, 0x32, 0x59, 0x3a, 0x59, 0x64, 0xcf, 0x5a, 0x22,
+	0x3b, 0x8c, 0xb4, 0xb6, 0xc6, 0x91, 0x20, 0x90, 0x03, 0xca, 0x64, 0x48, 0x66, 0x14, 0x10, 0xa3,
+	0x9e, 0x4c, 0x40, 0x08, 0xa9, 0xa9, 0xee, 0x2e, 0xdb, 0xa5, 0x69, 0x77, 0xb7, 0xba, 0xaa, 0x3d,
+	0xf1, 0xde, 0xb8, 0x21, 0x40, 0x68, 0x85, 0x38, 0x20, 0x21, 0xa1, 0x15, 0x9c, 0xf9, 0x04, 0x88,
+	0x1b, 0x87, 0x15, 0xa7, 0xe5, 0x86, 0x38, 0x04, 0x34, 0x39, 0xc0, 0x37, 0xe0, 0x8a, 0xaa, 0xaa,
+	0x7b, 0xa6, 0x3d, 0x63, 0xef, 0xa4, 0xbd, 0x89, 0xc4, 0x9e, 0xec, 0xf7, 0xea, 0xbd, 0x57, 0xef,
+	0xef, 0xaf, 0xaa, 0x1a, 0xda, 0xb6, 0x1f, 0x92, 0x68, 0xd2, 0xc7, 0x8c, 0x11, 0xde, 0x1f, 0xf2,
+	0xfe, 0x74, 0xa7, 0xcf, 0xfd, 0x13, 0xe2, 0xf5, 0x82, 0xd0, 0xe7, 0x3e, 0x42, 0x6a, 0xbd, 0x27,
+	0xd7, 0x7b, 0x43, 0xde, 0x9b, 0xee, 0xdc, 0x69, 0xdb, 0x3e, 0x9b, 0xf8, 0xac, 0x6f, 0x61, 0x46,
+	0xfa, 0xd3, 0x1d, 0x8b, 0x70, 0xbc, 0xd3, 0xb7, 0x7d, 0x1a, 0xeb, 0xdc, 0xd9, 0x18, 0xf9, 0x23,
+	0x5f, 0xfe, 0xed, 0x8b, 0x7f, 0x31, 0xf7, 0xf6, 0xc8, 0xf7, 0x47, 0x2e, 0xe9, 0x4b, 0xca, 0x8a,
+	0x86, 0x7d, 0xec, 0xcd, 0xe2, 0xa5, 0xf6, 0xe5, 0x25, 0x27, 0x0a, 0x31, 0xa7, 0x7e, 0x62, 0xb0,
+	0x73, 0x79, 0x9d, 0xd3, 0x09, 0x61, 0x1c, 0x4f, 0x02, 0x25, 0xd0, 0xfd, 0x6d, 0x11, 0x60, 0x8f,
+	0x0c, 0xa9, 0x47, 0x85, 0x16, 0xda, 0x80, 0x92, 0x43, 0x3c, 0x7f, 0xa2, 0x6b, 0x9b, 0xda, 0x56,
+	0xcd, 0x50, 0x04, 0x7a, 0x07, 0xca, 0x94, 0xb1, 0x88, 0x84, 0x7a, 0x5e, 0xb2, 0x63, 0x0a, 0x7d,
+	0x1d, 0xaa, 0x43, 0x82, 0x79, 0x14, 0x12, 0xa6, 0x17, 0x36, 0x0b, 0x5b, 0xcd, 0xc1, 0xbb, 0xbd,
+	0xab, 0x51, 0xf7, 0x1e, 0x2b, 0x19, 0xe3, 0x5c, 0x18, 0x3d, 0x85, 0x9a, 0x15, 0x85, 0x9e, 0x19,
+	0x62, 0x4e, 0xf4, 0xa2, 0xb0, 0xb9, 0xdb, 0xfb, 0xe4, 0x65, 0x27, 0xf7, 0x8f, 0x97, 0x9d, 0xbb,
+	0x23, 0xca, 0xc7, 0x91, 0xd5, 0xb3, 0xfd, 0x49, 0x3f, 0xce, 0x96, 0xfa, 0xf9, 0x80, 0x39, 0x27,
+	0x7d, 0x3e, 0x0b, 0x08, 0xeb, 0xed, 0x11, 0xdb, 0xa8, 0x0a, 0x03, 0x06, 0xe6, 0x04, 0xfd, 0x18,
+	0x36, 0x18, 0xf1, 0x1c, 0xd3, 0xf6, 0x27, 0x13, 0xca, 0x18, 0xf5, 0x63, 0xbb, 0xa5, 0x95, 0xec,
+	0x22, 0x61, 0xeb, 0xd1, 0xb9, 0x29, 0xb9, 0x83, 0x0e, 0x95, 0x29, 0x09, 0x05, 0xa9, 0x97, 0x37,
+	0xb5, 0xad, 0x35, 0x23, 0x21, 0x45, 0xbe, 0xb0, 0x33, 0xa1, 0x9e, 0x5e, 0x51, 0xf9, 0x92, 0x04,
+	0xda, 0x82, 0xa2, 0x83, 0x39, 0xd6, 0xab, 0x9b, 0xda, 0x56, 0x7d, 0xb0, 0xd1, 0x53, 0x45, 0xe8,
+	0x25, 0x45, 0xe8, 0x3d, 0xf4, 0x66, 0x86, 0x94, 0x40, 0x07, 0x00, 0x13, 0xfc, 0xc2, 0x64, 0x51,
+	0x10, 0xb8, 0x33, 0xbd, 0x26, 0x3d, 0xde, 0x7e, 0x4d, 0x6f, 0x0f, 0x3c, 0x6e, 0xd4, 0x26, 0xf8,
+	0xc5, 0x91, 0x54, 0x46, 0xfb, 0xd0, 0x9c, 0x50, 0x8f, 0x9b, 0xd8, 0x75, 0xfd, 0x53, 0xec, 0xd9,
+	0x44, 0x07, 0xb9, 0xfd, 0x7b, 0x8b, 0x4a, 0xf2, 0x5d, 0xea, 0xf1, 0x87, 0x89, 0xa0, 0xb1, 0x36,
+	0x49, 0x93, 0xdf, 0xac, 0xfe, 0xf4, 0xe3, 0x4e, 0xee, 0x3f, 0x1f, 0x77, 0x72, 0xdd, 0x3f, 0x54,
+	0xa0, 0xf4, 0x4c, 0xf4, 0x74, 0xc6, 0xc6, 0x78, 0x07, 0xca, 0x6c, 0x36, 0xb1, 0x7c, 0x57, 0x2f,
+	0x28, 0xbe, 0xa2, 0x44, 0x22, 0x59, 0x64, 0x45, 0x1e, 0xe5, 0xaa, 0xea, 0x46, 0x42, 0xa2, 0x2f,
+	0x43, 0x2d, 0x08, 0x89, 0x4d, 0x65, 0x92, 0x4b, 0x32, 0xc9, 0x17, 0x0c, 0xb4, 0x09, 0x75, 0x87,
+	0x30, 0x3b, 0xa4, 0x01, 0x4f, 0x8a, 0x50, 0x33, 0xd2, 0x2c, 0xf4, 0x3e, 0xac, 0x8f, 0x5c, 0xdf,
+	0xc2, 0xae, 0x3b, 0x33, 0x87, 0xa1, 0xff, 0x21, 0x51, 0x25, 0xa9, 0x1a, 0xcd, 0x84, 0xfd, 0x58,
+	0x72, 0xe7, 0x7a, 0xb6, 0xba, 0x72, 0xcf, 0xd6, 0xde, 0x52, 0xcf, 0xc2, 0xdb, 0xe8, 0xd9, 0xfa,
+	0x92, 0x9e, 0x6d, 0xa4, 0x7b, 0xf6, 0x36, 0x14, 0xa2, 0x90, 0xea, 0x6b, 0xd2, 0x81, 0xca, 0xd9,
+	0xcb, 0x4e, 0xe1, 0xd8, 0x38, 0x30, 0x04, 0x0f, 0xdd, 0x85, 0x6a, 0x14, 0x52, 0x73, 0x8c, 0xd9,
+	0x58, 0x6f, 0xca, 0xf5, 0xfa, 0xd9, 0xcb, 0x4e, 0xe5, 0xd8, 0x38, 0xd8, 0xc7, 0x6c, 0x6c, 0x54,
+	0xa2, 0x90, 0x8a, 0x3f, 0xe7, 0x6d, 0xbf, 0x9e, 0xb1, 0xed, 0x5b, 0x6f, 0xb6, 0xed, 0x6f, 0xac,
+	0xd6, 0xf6, 0xe8, 0x08, 0xd6, 0x05, 0x03, 0x5b, 0x2e, 0x31, 0xf1, 0xc4, 0x8f, 0x3c, 0xae, 0xa3,
+	0xcc, 0x9e, 0x35, 0x13, 0x13, 0x0f, 0xa5, 0x05, 0xf4, 0x03, 0xd8, 0x08, 0x88, 0xe7, 0x50, 0x6f,
+	0x24, 0x0b, 0xcc, 0xcc, 0x28, 0x70, 0x44, 0xa1, 0x6f, 0x4a, 0x27, 0xef, 0x2e, 0x72, 0xf2, 0x50,
+	0xc9, 0x8b, 0x2a, 0xb2, 0x63, 0x29, 0x6d, 0xa0, 0xe0, 0x0a, 0x2f, 0x35, 0xa5, 0xbf, 0xd6, 0x60,
+	0x6d, 0x2e, 0x32, 0xf4, 0x18, 0xca, 0x71, 0x04, 0x5a, 0xe6, 0x86, 0x12, 0x51, 0xc4, 0xda, 0xe8,
+	0x01, 0x94, 0x03, 0x12, 0x52, 0xdf, 0x91, 0xf3, 0x5d, 0x1f, 0xdc, 0xbe, 0x52, 0xd3, 0xbd, 0xf8,
+	0xbc, 0xd9, 0xad, 0x8a, 0x2d, 0x7e, 0xf3, 0xcf, 0x8e, 0x66, 0xc4, 0x2a, 0xdd, 0x3f, 0x69, 0x80,
+	0xe6, 0xdc, 0x3a, 0x66, 0x78, 0x44, 0x96, 0x20, 0xc9, 0x13, 0x68, 0x28, 0x35, 0x93, 0x71, 0x1c,
+	0xf2, 0x78, 0xbf, 0x3b, 0x57, 0xf6, 0x7b, 0x96, 0x9c, 0x5f, 0x6a, 0xc3, 0x8f, 0xc4, 0x86, 0x75,
+	0xa5, 0x79, 0x24, 0x14, 0x45, 0xe8, 0xa2, 0x04, 0xc4, 0xd1, 0x0b, 0xab, 0x85, 0xae, 0xb4, 0xbb,
+	0x1d, 0xa8, 0xed, 0x61, 0x8e, 0x77, 0x67, 0x9c, 0x30, 0x84, 0xa0, 0x28, 0x08, 0xe9, 0x72, 0xc3,
+	0x90, 0xff, 0xbb, 0x1f, 0xc0, 0xad, 0x3d, 0xe2, 0xe2, 0x19, 0x71, 0x24, 0x42, 0x1e, 0x07, 0xa3,
+	0x10, 0x3b, 0xe4, 0xf9, 0xce, 0xe2, 0x00, 0xbb, 0xbf, 0xd4, 0xe0, 0xe6, 0x02, 0xf9, 0x25, 0xe9,
+	0x48, 0x4d, 0x6f, 0x7e, 0x7e, 0x7a, 0x9f, 0x40, 0xc5, 0x97, 0x90, 0xc7, 0x64, 0x80, 0xf5, 0xc1,
+	0xfb, 0x8b, 0x7a, 0x28, 0xbd, 0xc5, 0xf7, 0x94, 0xf8, 0x6e, 0x51, 0x64, 0xc2, 0x48, 0xb4, 0xbb,
+	0x3f, 0xd7, 0xe0, 0xe6, 0x02, 0x31, 0xf4, 0x0d, 0xc8, 0x4f, 0x77, 0xa4, 0x37, 0xf5, 0xc1, 0x57,
+	0xaf, 0xb3, 0xfd, 0x7c, 0x27, 0x56, 0x33, 0xf2, 0xd3, 0x1d, 0xa9, 0x3a, 0xd0, 0xf3, 0xaf, 0xa9,
+	0x3a, 0xb8, 0x50, 0x1d, 0x74, 0xef, 0xc3, 0xad, 0x85, 0x76, 0x51, 0x07, 0xea, 0xd4, 0xb2, 0x4d,
+	0xe2, 0x89, 0xa1, 0x72, 0xa4, 0x5f, 0x55, 0x03, 0xa8, 0x65, 0x7f, 0x5b, 0x71, 0xba, 0xff, 0xd5,
+	0xe0, 0xd6, 0x42, 0xbb, 0x73, 0x50, 0xaf, 0x65, 0x81, 0xfa, 0x27, 0x69, 0xa8, 0xcf, 0x67, 0xc2,
+	0x80, 0x79, 0x98, 0xff, 0xd1, 0x12, 0x98, 0x2f, 0x64, 0xb6, 0xb9, 0x00, 0xe2, 0xbb, 0xbf, 0xcb,
+	0x03, 0xba, 0x0a, 0x16, 0x4b, 0x07, 0xec, 0x8b, 0x10, 0x13, 0x7a, 0x0a, 0x4d, 0x32, 0x1c, 0x12,
+	0x9b, 0xd3, 0x29, 0x31, 0xc5, 0x65, 0x55, 0x2f, 0x66, 0x40, 0x82, 0xb5, 0x73, 0x5d, 0xb1, 0xda,
+	0xdd, 0x06, 0x14, 0x8f, 0xdc, 0xb5, 0xf9, 0xe9, 0xfe, 0x42, 0x83, 0xf5, 0x58, 0xf8, 0xd8, 0x1b,
+	0x86, 0x84, 0x7c, 0x28, 0xcf, 0x50, 0x6c, 0xdb, 0x17, 0x38, 0x6a, 0x24, 0xe4, 0x85, 0x8d, 0x7c,
+	0x3a, 0xc7, 0x07, 0xb0, 0x16, 0xc5, 0xba, 0xca, 0xf7, 0x42, 0x06, 0xdf, 0x1b, 0x89, 0xaa, 0x74,
+	0xfd, 0x8f, 0x1a, 0xdc, 0x38, 0xb2, 0xc7, 0xc4, 0x89, 0xdc, 0xd7, 0x72, 0xe8, 0x1e, 0x14, 0xc5,
+	0x3b, 0xe2, 0x1c, 0xa7, 0x55, 0xc2, 0x7b, 0xe2, 0xa1, 0xd1, 0x8b, 0x1f, 0x1a, 0xbd, 0x47, 0x3e,
+	0xf5, 0x62, 0x14, 0x90, 0xc2, 0x6f, 0xd2, 0xdf, 0x3f, 0x6b, 0xb0, 0x31, 0x3f, 0xc0, 0x47, 0x1c,
+	0xf3, 0xe8, 0xfa, 0xf9, 0x45, 0x8f, 0x00, 0x24, 0xe4, 0x2b, 0x0f, 0xb2, 0xe0, 0x7e, 0x4d, 0xea,
+	0x89, 0x15, 0xf4, 0x2d, 0xa8, 0x8a, 0x9e, 0xcc, 0x1c, 0x44, 0x85, 0x78, 0x8e, 0xf4, 0xff, 0xdf,
+	0x97, 0xfd, 0x1f, 0xc4, 0xfe, 0x1f, 0x5c, 0xe0, 0xad, 0x96, 0x11, 0xd8, 0x2e, 0x21, 0xee, 0xff,
+	0x49, 0xa4, 0x3f, 0xbb, 0x14, 0xa9, 0x8a, 0x93, 0x30, 0x74, 0x3f, 0x05, 0xfc, 0x5b, 0xd7, 0x03,
+	0xbf, 0xd2, 0x93, 0xb8, 0x7f, 0x3f, 0x85, 0xfb, 0xd7, 0x6b, 0x0e, 0xce, 0x35, 0x07, 0xdd, 0xbf,
+	0x68, 0xb0, 0xf6, 0x2c, 0xc4, 0x1e, 0x1b, 0x92, 0xf0, 0x3b, 0x74, 0x42, 0xf9, 0xf2, 0xf3, 0x30,
+	0x69, 0xfc, 0xfc, 0x7c, 0xe3, 0x5f, 0x5c, 0x75, 0x0a, 0x6f, 0xe8, 0xaa, 0x53, 0xcc, 0x7e, 0xd5,
+	0xf9, 0x9b, 0x06, 0x68, 0x2e, 0x8c, 0xcf, 0xba, 0xea, 0x2c, 0x8f, 0xe5, 0x09, 0x34, 0x4e, 0xa9,
+	0xe7, 0xf8, 0xa7, 0xf1, 0x25, 0x28, 0x4b, 0x7d, 0xeb, 0x4a, 0x53, 0x5d, 0x82, 0x76, 0xa1, 0xc8,
+	0x88, 0xc7, 0xf5, 0xe2, 0x4a, 0x29, 0x91, 0xba, 0xdd, 0xbf, 0x16, 0xa0, 0xb1, 0x47, 0x19, 0x0f,
+	0xa9, 0x15, 0x7d, 0xc6, 0xb7, 0x01, 0xf1, 0x34, 0x4b, 0xa4, 0xfc, 0xe4, 0x1d, 0x98, 0x66, 0x09,
+	0x68, 0x0a, 0xfc, 0xf8, 0x29, 0xf8, 0x3a, 0xd0, 0x24, 0x84, 0xc5, 0x7b, 0x8e, 0x79, 0x38, 0x60,
+	0x63, 0x9f, 0x9b, 0x63, 0x42, 0x47, 0x63, 0x15, 0x4c, 0xc1, 0x68, 0x26, 0xec, 0x7d, 0xc9, 0x45,
+	0xdf, 0x4f, 0x09, 0xc6, 0xef, 0x89, 0xd2, 0x4a, 0x51, 0x9f, 0x1b, 0x8e, 0x1f, 0x16, 0x87, 0xa9,
+	0xc0, 0x88, 0xa3, 0x97, 0x57, 0x32, 0x9a, 0x36, 0x81, 0xde, 0x83, 0x46, 0x80, 0xa9, 0x63, 0x8e,
+	0x7d, 0xd7, 0x21, 0x21, 0x93, 0x0f, 0xd4, 0xa2, 0x51, 0x17, 0xbc, 0x7d, 0xc5, 0x42, 0x0f, 0xa0,
+	0x14, 0x8c, 0x31, 0x23, 0xf2, 0xd3, 0x41, 0x73, 0xf0, 0x95, 0x45, 0xc3, 0x94, 0x2e, 0xca, 0xa1,
+	0x10, 0x36, 0x94, 0x0e, 0xba, 0x0d, 0x55, 0x8f, 0xbc, 0xe0, 0xe6, 0x09, 0x51, 0x9f, 0x12, 0x1a,
+	0x46, 0x45, 0xd0, 0x4f, 0xc9, 0xac, 0xfb, 0x7b, 0x0d, 0x50, 0x5a, 0x4f, 0xed, 0x97, 0xb9, 0x41,
+	0xf7, 0xa1, 0x62, 0x61, 0x57, 0xbe, 0xb2, 0x56, 0x9b, 0xb6, 0x44, 0x5d, 0xdc, 0xa8, 0x45, 0xdc,
+	0xb2, 0xa8, 0x55, 0x43, 0xfe, 0x97, 0xef, 0x98, 0xe7, 0x84, 0x71, 0xea, 0x8d, 0x0e, 0xe5, 0x5c,
+	0x89, 0xa1, 0x74, 0x89, 0x37, 0xe2, 0x63, 0x5d, 0xcb, 0x30, 0x94, 0x4a, 0x25, 0x85, 0x0c, 0xf9,
+	0xcf, 0x83, 0x0c, 0xdd, 0x5f, 0x15, 0x60, 0x3d, 0x76, 0x2b, 0x39, 0x91, 0x33, 0x27, 0x6e, 0x17,
+	0x1a, 0x53, 0x65, 0xc2, 0x14, 0x1b, 0xc8, 0xec, 0x35, 0x07, 0x9d, 0x45, 0xe5, 0x8d, 0xb7, 0x7a,
+	0x36, 0x0b, 0x88, 0x51, 0x9f, 0x5e, 0x10, 0xa9, 0x78, 0x8a, 0x9f, 0x0b, 0xe9, 0xe6, 0x8f, 0xa1,
+	0xd2, 0x6a, 0xc7, 0xd0, 0x83, 0xd4, 0x31, 0x54, 0xbe, 0xd6, 0x44, 0x71, 0xee, 0x08, 0x42, 0x0f,
+	0xa1, 0xa2, 0x80, 0x53, 0xcc, 0x40, 0x61, 0xd9, 0x63, 0x7d, 0xae, 0x15, 0x92, 0xb3, 0x34, 0xd6,
+	0xdb, 0xfe, 0x89, 0x06, 0x95, 0xf8, 0xe2, 0x8e, 0xea, 0x50, 0x11, 0x8f, 0x36, 0xea, 0x8d, 0x5a,
+	0x39, 0x41, 0x88, 0xab, 0xaa, 0x20, 0x34, 0xd4, 0x80, 0xaa, 0xbc, 0xa3, 0x08, 0x2a, 0x8f, 0x5a,
+	0xd0, 0x38, 0x1d, 0x53, 0x4e, 0x5c, 0x2a, 0x0d, 0xb7, 0x0a, 0xa8, 0x02, 0x05, 0x6a, 0xd9, 0xad,
+	0xa2, 0x10, 0xb4, 0x5d, 0x7c, 0x6a, 0x61, 0xfb, 0xa4, 0x55, 0x42, 0x37, 0x61, 0x9d, 0xc7, 0x68,
+	0x6e, 0xba, 0x02, 0xce, 0x59, 0xab, 0x2c, 0xb4, 0x2d, 0xd7, 0xb7, 0x4f, 0x12, 0xed, 0xca, 0xf6,
+	0x31, 0xdc, 0xb8, 0x32, 0x8b, 0x08, 0x41, 0x33, 0xc0, 0x33, 0x51, 0xe8, 0x78, 0xcc, 0x5b, 0x39,
+	0xf4, 0x2e, 0x7c, 0x29, 0xe6, 0x85, 0xc4, 0xf6, 0x43, 0x87, 0x9c, 0x63, 0x40, 0x4b, 0x43, 0xeb,
+	0x50, 0xb7, 0x5d, 0x82, 0x85, 0xc7, 0x66, 0x14, 0xb4, 0xf2, 0xdb, 0x03, 0xa8, 0xa7, 0x7a, 0x00,
+	0x01, 0x94, 0x5d, 0xea, 0x11, 0x1c, 0xb6, 0x72, 0xa8, 0x06, 0x25, 0xdb, 0xa5, 0xc3, 0xa1, 0x0a,
+	0x4d, 0xe5, 0x82, 0xda, 0xad, 0xfc, 0xee, 0xe1, 0x27, 0x67, 0x6d, 0xed, 0xd3, 0xb3, 0xb6, 0xf6,
+	0xaf, 0xb3, 0xb6, 0xf6, 0xd1, 0xab, 0x76, 0xee, 0xd3, 0x57, 0xed, 0xdc, 0xdf, 0x5f, 0xb5, 0x73,
+	0x3f, 0xfc, 0x5a, 0xaa, 0x3b, 0x1e, 0xc9, 0x24, 0x3f, 0xf6, 0x23, 0xcf, 0x91, 0x43, 0xd3, 0x8f,
+	0x3f, 0x61, 0x4f, 0xef, 0xf5, 0x5f, 0x5c, 0x7c, 0xc7, 0x96, 0x1d, 0x63, 0x95, 0x65, 0x19, 0xef,
+	0xfd, 0x6f, 0x00, 0x61, 0x17, 0x27, 0x13, 0xe7, 0x16, 0x00, 0x00,
+}
+
+func (m *Definition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Definition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Definition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MintAllowance != nil {
+		{
+			size, err := m.MintAllowance.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintToken(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.MaxSupply != nil {
+		{
+			size := m.MaxSupply.Size()
+			i -= size
+			if _, err := m.MaxSupply.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintToken(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Data != nil {
+		{
+			size, err := m.Data.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintToken(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Version != 0 {
+		i = encodeVarintToken(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.SendCommissionRate.Size()
+		i -= size
+		if _, err := m.SendCommissionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintToken(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.BurnRate.Size()
+		i -= size
+		if _, err := m.BurnRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintToken(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Features) > 0 {
+		dAtA4 := make([]byte, len(m.Features)*10)
+		var j3 int
+		for _, num := range m.Features {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintToken(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Issuer) > 0 {
+		i -= len(m.Issuer)
+		copy(dAtA[i:], m.Issuer)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.Issuer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Token) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Token) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Token) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PendingRatesUpdate != nil {
+		{
+			size, err := m.PendingRatesUpdate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintToken(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.MintableAmount != nil {
+		{
+			size := m.MintableAmount.Size()
+			i -= size
+			if _, err := m.MintableAmount.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintToken(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if m.MintAllowance != nil {
+		{
+			size, err := m.MintAllowance.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintToken(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.MaxSupply != nil {
+		{
+			size := m.MaxSupply.Size()
+			i -= size
+			if _, err := m.MaxSupply.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintToken(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.Data != nil {
+		{
+			size, err := m.Data.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintToken(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
+	if len(m.URIHash) > 0 {
+		i -= len(m.URIHash)
+		copy(dAtA[i:], m.URIHash)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.URIHash)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.URI) > 0 {
+		i -= len(m.URI)
+		copy(dAtA[i:], m.URI)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.URI)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.Version != 0 {
+		i = encodeVarintToken(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x58
+	}
+	{
+		size := m.SendCommissionRate.Size()
+		i -= size
+		if _, err := m.SendCommissionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintToken(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.BurnRate.Size()
+		i -= size
+		if _, err := m.BurnRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintToken(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if len(m.Features) > 0 {
+		dAtA9 := make([]byte, len(m.Features)*10)
+		var j8 int
+		for _, num := range m.Features {
+			for num >= 1<<7 {
+				dAtA9[j8] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j8++
+			}
+			dAtA9[j8] = uint8(num)
+			j8++
+		}
+		i -= j8
+		copy(dAtA[i:], dAtA9[:j8])
+		i = encodeVarintToken(dAtA, i, uint64(j8))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.GloballyFrozen {
+		i--
+		if m.GloballyFrozen {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Precision != 0 {
+		i = encodeVarintToken(dAtA, i, uint64(m.Precision))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Subunit) > 0 {
+		i -= len(m.Subunit)
+		copy(dAtA[i:], m.Subunit)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.Subunit)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Issuer) > 0 {
+		i -= len(m.Issuer)
+		copy(dAtA[i:], m.Issuer)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.Issuer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MintAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MintAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MintAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n10, err10 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Period, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Period):])
+	if err10 != nil {
+		return 0, err10
+	}
+	i -= n10
+	i = encodeVarintToken(dAtA, i, uint64(n10))
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintToken(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MintAllowanceUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MintAllowanceUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MintAllowanceUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Minted.Size()
+		i -= size
+		if _, err := m.Minted.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintToken(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	n11, err11 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.PeriodStart, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PeriodStart):])
+	if err11 != nil {
+		return 0, err11
+	}
+	i -= n11
+	i = encodeVarintToken(dAtA, i, uint64(n11))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DataBytes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DataBytes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DataBytes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelayedTokenUpgradeV1) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelayedTokenUpgradeV1) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelayedTokenUpgradeV1) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelayedTokenUpgrade) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelayedTokenUpgrade) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelayedTokenUpgrade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Options.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintToken(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Version != 0 {
+		i = encodeVarintToken(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TokenUpgradeOptions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenUpgradeOptions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenUpgradeOptions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.V2 != nil {
+		{
+			size, err := m.V2.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintToken(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.V1 != nil {
+		{
+			size, err := m.V1.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintToken(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TokenUpgradeV1Options) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenUpgradeV1Options) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenUpgradeV1Options) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IbcEnabled {
+		i--
+		if m.IbcEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TokenUpgradeV2Options) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenUpgradeV2Options) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenUpgradeV2Options) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SendCommissionRate != nil {
+		{
+			size := m.SendCommissionRate.Size()
+			i -= size
+			if _, err := m.SendCommissionRate.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintToken(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.BurnRate != nil {
+		{
+			size := m.BurnRate.Size()
+			i -= size
+			if _, err := m.BurnRate.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintToken(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Features) > 0 {
+		dAtA16 := make([]byte, len(m.Features)*10)
+		var j15 int
+		for _, num := range m.Features {
+			for num >= 1<<7 {
+				dAtA16[j15] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j15++
+			}
+			dAtA16[j15] = uint8(num)
+			j15++
+		}
+		i -= j15
+		copy(dAtA[i:], dAtA16[:j15])
+		i = encodeVarintToken(dAtA, i, uint64(j15))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingRatesUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingRatesUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingRatesUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n17, err17 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EffectiveTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EffectiveTime):])
+	if err17 != nil {
+		return 0, err17
+	}
+	i -= n17
+	i = encodeVarintToken(dAtA, i, uint64(n17))
+	i--
+	dAtA[i] = 0x22
+	if m.SendCommissionRate != nil {
+		{
+			size := m.SendCommissionRate.Size()
+			i -= size
+			if _, err := m.SendCommissionRate.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintToken(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.BurnRate != nil {
+		{
+			size := m.BurnRate.Size()
+			i -= size
+			if _, err := m.BurnRate.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintToken(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelayedRatesUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelayedRatesUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelayedRatesUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelayedUnfreeze) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelayedUnfreeze) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelayedUnfreeze) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n18, err18 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.UnfreezeTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UnfreezeTime):])
+	if err18 != nil {
+		return 0, err18
+	}
+	i -= n18
+	i = encodeVarintToken(dAtA, i, uint64(n18))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduledUnfreeze) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduledUnfreeze) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduledUnfreeze) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n19, err19 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.UnfreezeTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UnfreezeTime):])
+	if err19 != nil {
+		return 0, err19
+	}
+	i -= n19
+	i = encodeVarintToken(dAtA, i, uint64(n19))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Coin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintToken(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TokenUpgradeV1Status) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenUpgradeV1Status) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenUpgradeV1Status) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n21, err21 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
+	if err21 != nil {
+		return 0, err21
+	}
+	i -= n21
+	i = encodeVarintToken(dAtA, i, uint64(n21))
+	i--
+	dAtA[i] = 0x1a
+	n22, err22 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err22 != nil {
+		return 0, err22
+	}
+	i -= n22
+	i = encodeVarintToken(dAtA, i, uint64(n22))
+	i--
+	dAtA[i] = 0x12
+	if m.IbcEnabled {
+		i--
+		if m.IbcEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TokenUpgradeV2Status) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenUpgradeV2Status) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenUpgradeV2Status) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n23, err23 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
+	if err23 != nil {
+		return 0, err23
+	}
+	i -= n23
+	i = encodeVarintToken(dAtA, i, uint64(n23))
+	i--
+	dAtA[i] = 0x1a
+	n24, err24 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err24 != nil {
+		return 0, err24
+	}
+	i -= n24
+	i = encodeVarintToken(dAtA, i, uint64(n24))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Options.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintToken(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *TokenUpgradeStatuses) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenUpgradeStatuses) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenUpgradeStatuses) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.V2 != nil {
+		{
+			size, err := m.V2.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintToken(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.V1 != nil {
+		{
+			size, err := m.V1.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintToken(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TransferLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransferLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransferLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n28, err28 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Period, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Period):])
+	if err28 != nil {
+		return 0, err28
+	}
+	i -= n28
+	i = encodeVarintToken(dAtA, i, uint64(n28))
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintToken(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TransferLimitUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransferLimitUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransferLimitUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Sent.Size()
+		i -= size
+		if _, err := m.Sent.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintToken(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	n29, err29 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.WindowStart, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.WindowStart):])
+	if err29 != nil {
+		return 0, err29
+	}
+	i -= n29
+	i = encodeVarintToken(dAtA, i, uint64(n29))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Distribution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Distribution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Distribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NextKey) > 0 {
+		i -= len(m.NextKey)
+		copy(dAtA[i:], m.NextKey)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.NextKey)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Phase != 0 {
+		i = encodeVarintToken(dAtA, i, uint64(m.Phase))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.PaidHolders != 0 {
+		i = encodeVarintToken(dAtA, i, uint64(m.PaidHolders))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.Distributed.Size()
+		i -= size
+		if _, err := m.Distributed.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintToken(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.SnapshotSupply.Size()
+		i -= size
+		if _, err := m.SnapshotSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintToken(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.SnapshotHeight != 0 {
+		i = encodeVarintToken(dAtA, i, uint64(m.SnapshotHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.Pool.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintToken(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Distributor) > 0 {
+		i -= len(m.Distributor)
+		copy(dAtA[i:], m.Distributor)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.Distributor)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DistributionHolder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DistributionHolder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DistributionHolder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Paid {
+		i--
+		if m.Paid {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.Balance.Size()
+		i -= size
+		if _, err := m.Balance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintToken(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VestingPeriod) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VestingPeriod) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VestingPeriod) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintToken(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	n31, err31 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Length, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Length):])
+	if err31 != nil {
+		return 0, err31
+	}
+	i -= n31
+	i = encodeVarintToken(dAtA, i, uint64(n31))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *VestingSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VestingSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VestingSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Periods) > 0 {
+		for iNdEx := len(m.Periods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Periods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintToken(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.EndTime != nil {
+		n32, err32 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EndTime):])
+		if err32 != nil {
+			return 0, err32
+		}
+		i -= n32
+		i = encodeVarintToken(dAtA, i, uint64(n32))
+		i--
+		dAtA[i] = 0x32
+	}
+	n33, err33 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err33 != nil {
+		return 0, err33
+	}
+	i -= n33
+	i = encodeVarintToken(dAtA, i, uint64(n33))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintToken(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.VestingType != 0 {
+		i = encodeVarintToken(dAtA, i, uint64(m.VestingType))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintToken(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintToken(dAtA []byte, offset int, v uint64) int {
+	offset -= sovToken(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Definition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	l = len(m.Issuer)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	if len(m.Features) > 0 {
+		l = 0
+		for _, e := range m.Features {
+			l += sovToken(uint64(e))
+		}
+		n += 1 + sovToken(uint64(l)) + l
+	}
+	l = m.BurnRate.Size()
+	n += 1 + l + sovToken(uint64(l))
+	l = m.SendCommissionRate.Size()
+	n += 1 + l + sovToken(uint64(l))
+	if m.Version != 0 {
+		n += 1 + sovToken(uint64(m.Version))
+	}
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	if m.Data != nil {
+		l = m.Data.Size()
+		n += 1 + l + sovToken(uint64(l))
+	}
+	if m.MaxSupply != nil {
+		l = m.MaxSupply.Size()
+		n += 1 + l + sovToken(uint64(l))
+	}
+	if m.MintAllowance != nil {
+		l = m.MintAllowance.Size()
+		n += 1 + l + sovToken(uint64(l))
+	}
+	return n
+}
+
+func (m *Token) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	l = len(m.Issuer)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	l = len(m.Subunit)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	if m.Precision != 0 {
+		n += 1 + sovToken(uint64(m.Precision))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	if m.GloballyFrozen {
+		n += 2
+	}
+	if len(m.Features) > 0 {
+		l = 0
+		for _, e := range m.Features {
+			l += sovToken(uint64(e))
+		}
+		n += 1 + sovToken(uint64(l)) + l
+	}
+	l = m.BurnRate.Size()
+	n += 1 + l + sovToken(uint64(l))
+	l = m.SendCommissionRate.Size()
+	n += 1 + l + sovToken(uint64(l))
+	if m.Version != 0 {
+		n += 1 + sovToken(uint64(m.Version))
+	}
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	l = len(m.URI)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	l = len(m.URIHash)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	if m.Data != nil {
+		l = m.Data.Size()
+		n += 1 + l + sovToken(uint64(l))
+	}
+	if m.MaxSupply != nil {
+		l = m.MaxSupply.Size()
+		n += 2 + l + sovToken(uint64(l))
+	}
+	if m.MintAllowance != nil {
+		l = m.MintAllowance.Size()
+		n += 2 + l + sovToken(uint64(l))
+	}
+	if m.MintableAmount != nil {
+		l = m.MintableAmount.Size()
+		n += 2 + l + sovToken(uint64(l))
+	}
+	if m.PendingRatesUpdate != nil {
+		l = m.PendingRatesUpdate.Size()
+		n += 2 + l + sovToken(uint64(l))
+	}
+	return n
+}
+
+func (m *MintAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovToken(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Period)
+	n += 1 + l + sovToken(uint64(l))
+	return n
+}
+
+func (m *MintAllowanceUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PeriodStart)
+	n += 1 + l + sovToken(uint64(l))
+	l = m.Minted.Size()
+	n += 1 + l + sovToken(uint64(l))
+	return n
+}
+
+func (m *DataBytes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	return n
+}
+
+func (m *DelayedTokenUpgradeV1) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	return n
+}
+
+func (m *DelayedTokenUpgrade) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovToken(uint64(m.Version))
+	}
+	l = m.Options.Size()
+	n += 1 + l + sovToken(uint64(l))
+	return n
+}
+
+func (m *TokenUpgradeOptions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.V1 != nil {
+		l = m.V1.Size()
+		n += 1 + l + sovToken(uint64(l))
+	}
+	if m.V2 != nil {
+		l = m.V2.Size()
+		n += 1 + l + sovToken(uint64(l))
+	}
+	return n
+}
+
+func (m *TokenUpgradeV1Options) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IbcEnabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *TokenUpgradeV2Options) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Features) > 0 {
+		l = 0
+		for _, e := range m.Features {
+			l += sovToken(uint64(e))
+		}
+		n += 1 + sovToken(uint64(l)) + l
+	}
+	if m.BurnRate != nil {
+		l = m.BurnRate.Size()
+		n += 1 + l + sovToken(uint64(l))
+	}
+	if m.SendCommissionRate != nil {
+		l = m.SendCommissionRate.Size()
+		n += 1 + l + sovToken(uint64(l))
+	}
+	return n
+}
+
+func (m *PendingRatesUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	if m.BurnRate != nil {
+		l = m.BurnRate.Size()
+		n += 1 + l + sovToken(uint64(l))
+	}
+	if m.SendCommissionRate != nil {
+		l = m.SendCommissionRate.Size()
+		n += 1 + l + sovToken(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EffectiveTime)
+	n += 1 + l + sovToken(uint64(l))
+	return n
+}
+
+func (m *DelayedRatesUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	return n
+}
+
+func (m *DelayedUnfreeze) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UnfreezeTime)
+	n += 1 + l + sovToken(uint64(l))
+	return n
+}
+
+func (m *ScheduledUnfreeze) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	l = m.Coin.Size()
+	n += 1 + l + sovToken(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UnfreezeTime)
+	n += 1 + l + sovToken(uint64(l))
+	return n
+}
+
+func (m *TokenUpgradeV1Status) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IbcEnabled {
+		n += 2
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovToken(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovToken(uint64(l))
+	return n
+}
+
+func (m *TokenUpgradeV2Status) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Options.Size()
+	n += 1 + l + sovToken(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovToken(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovToken(uint64(l))
+	return n
+}
+
+func (m *TokenUpgradeStatuses) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.V1 != nil {
+		l = m.V1.Size()
+		n += 1 + l + sovToken(uint64(l))
+	}
+	if m.V2 != nil {
+		l = m.V2.Size()
+		n += 1 + l + sovToken(uint64(l))
+	}
+	return n
+}
+
+func (m *TransferLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovToken(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Period)
+	n += 1 + l + sovToken(uint64(l))
+	return n
+}
+
+func (m *TransferLimitUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.WindowStart)
+	n += 1 + l + sovToken(uint64(l))
+	l = m.Sent.Size()
+	n += 1 + l + sovToken(uint64(l))
+	return n
+}
+
+func (m *Distribution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	l = len(m.Distributor)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	l = m.Pool.Size()
+	n += 1 + l + sovToken(uint64(l))
+	if m.SnapshotHeight != 0 {
+		n += 1 + sovToken(uint64(m.SnapshotHeight))
+	}
+	l = m.SnapshotSupply.Size()
+	n += 1 + l + sovToken(uint64(l))
+	l = m.Distributed.Size()
+	n += 1 + l + sovToken(uint64(l))
+	if m.PaidHolders != 0 {
+		n += 1 + sovToken(uint64(m.PaidHolders))
+	}
+	if m.Phase != 0 {
+		n += 1 + sovToken(uint64(m.Phase))
+	}
+	l = len(m.NextKey)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	return n
+}
+
+func (m *DistributionHolder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	l = m.Balance.Size()
+	n += 1 + l + sovToken(uint64(l))
+	if m.Paid {
+		n += 2
+	}
+	return n
+}
+
+func (m *VestingPeriod) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Length)
+	n += 1 + l + sovToken(uint64(l))
+	l = m.Amount.Size()
+	n += 1 + l + sovToken(uint64(l))
+	return n
+}
+
+func (m *VestingSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovToken(uint64(l))
+	}
+	if m.VestingType != 0 {
+		n += 1 + sovToken(uint64(m.VestingType))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovToken(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovToken(uint64(l))
+	if m.EndTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EndTime)
+		n += 1 + l + sovToken(uint64(l))
+	}
+	if len(m.Periods) > 0 {
+		for _, e := range m.Periods {
+			l = e.Size()
+			n += 1 + l + sovToken(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovToken(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozToken(x uint64) (n int) {
+	return sovToken(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Definition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowToken
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Definition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Definition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Issuer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v Feature
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowToken
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= Feature(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Features = append(m.Features, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowToken
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthToken
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthToken
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Features) == 0 {
+					m.Features = make([]Feature, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v Feature
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowToken
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= Feature(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Features = append(m.Features, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Features", wireType)
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BurnRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendCommissionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SendCommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Data == nil {
+				m.Data = &types.Any{}
+			}
+			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.MaxSupply = &v
+			if err := m.MaxSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintAllowance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MintAllowance == nil {
+				m.MintAllowance = &MintAllowance{}
+			}
+			if err := m.MintAllowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipToken(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthToken
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Token) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowToken
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Token: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Token: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Issuer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subunit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subunit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Precision", wireType)
+			}
+			m.Precision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Precision |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GloballyFrozen", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.GloballyFrozen = bool(v != 0)
+		case 8:
+			if wireType == 0 {
+				var v Feature
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowToken
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= Feature(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Features = append(m.Features, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowToken
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthToken
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthToken
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Features) == 0 {
+					m.Features = make([]Feature, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v Feature
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowToken
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= Feature(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Features = append(m.Features, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Features", wireType)
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BurnRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendCommissionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SendCommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URI", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URI = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URIHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URIHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Data == nil {
+				m.Data = &types.Any{}
+			}
+			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.MaxSupply = &v
+			if err := m.MaxSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintAllowance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MintAllowance == nil {
+				m.MintAllowance = &MintAllowance{}
+			}
+			if err := m.MintAllowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintableAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.MintableAmount = &v
+			if err := m.MintableAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingRatesUpdate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PendingRatesUpdate == nil {
+				m.PendingRatesUpdate = &PendingRatesUpdate{}
+			}
+			if err := m.PendingRatesUpdate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipToken(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthToken
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MintAllowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowToken
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MintAllowance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MintAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Period, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipToken(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthToken
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MintAllowanceUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowToken
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MintAllowanceUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MintAllowanceUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodStart", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.PeriodStart, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minted", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Minted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipToken(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthToken
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DataBytes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowToken
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DataBytes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DataBytes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipToken(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthToken
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelayedTokenUpgradeV1) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowToken
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelayedTokenUpgradeV1: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelayedTokenUpgradeV1: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipToken(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthToken
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelayedTokenUpgrade) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowToken
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelayedTokenUpgrade: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelayedTokenUpgrade: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Options.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipToken(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthToken
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TokenUpgradeOptions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowToken
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenUpgradeOptions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenUpgradeOptions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field V1", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.V1 == nil {
+				m.V1 = &TokenUpgradeV1Options{}
+			}
+			if err := m.V1.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field V2", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.V2 == nil {
+				m.V2 = &TokenUpgradeV2Options{}
+			}
+			if err := m.V2.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipToken(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthToken
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TokenUpgradeV1Options) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowToken
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenUpgradeV1Options: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenUpgradeV1Options: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IbcEnabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipToken(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthToken
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TokenUpgradeV2Options) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowToken
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenUpgradeV2Options: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenUpgradeV2Options: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v Feature
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowToken
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= Feature(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Features = append(m.Features, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowToken
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthToken
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthToken
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Features) == 0 {
+					m.Features = make([]Feature, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v Feature
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowToken
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= Feature(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Features = append(m.Features, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Features", wireType)
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.BurnRate = &v
+			if err := m.BurnRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendCommissionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.SendCommissionRate = &v
+			if err := m.SendCommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipToken(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthToken
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingRatesUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowToken
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingRatesUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingRatesUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.BurnRate = &v
+			if err := m.BurnRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendCommissionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.SendCommissionRate = &v
+			if err := m.SendCommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EffectiveTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipToken(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthToken
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelayedRatesUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowToken
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelayedRatesUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelayedRatesUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipToken(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthToken
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelayedUnfreeze) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowToken
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelayedUnfreeze: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelayedUnfreeze: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnfreezeTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.UnfreezeTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipToken(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthToken
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduledUnfreeze) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowToken
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduledUnfreeze: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduledUnfreeze: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Coin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnfreezeTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.UnfreezeTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipToken(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthToken
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TokenUpgradeV1Status) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowToken
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenUpgradeV1Status: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenUpgradeV1Status: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IbcEnabled = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipToken(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthToken
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TokenUpgradeV2Status) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowToken
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenUpgradeV2Status: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenUpgradeV2Status: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Options.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipToken(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthToken
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TokenUpgradeStatuses) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowToken
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenUpgradeStatuses: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenUpgradeStatuses: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field V1", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.V1 == nil {
+				m.V1 = &TokenUpgradeV1Status{}
+			}
+			if err := m.V1.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field V2", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.V2 == nil {
+				m.V2 = &TokenUpgradeV2Status{}
+			}
+			if err := m.V2.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipToken(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthToken
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
-		n += 1 + sovToken(uint64(l)) + l
-	}
-	l = m.BurnRate.Size()
-	n += 1 + l + sovToken(uint64(l))
-	l = m.SendCommissionRate.Size()
-	n += 1 + l + sovToken(uint64(l))
-	if m.Version != 0 {
-		n += 1 + sovToken(uint64(m.Version))
-	}
-	return n
-}
-
-func (m *DelayedTokenUpgradeV1) Size() (n int) {
-	if m == nil {
-		return 0
 	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovToken(uint64(l))
-	}
-	return n
-}
-
-func (m *TokenUpgradeV1Status) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.IbcEnabled {
-		n += 2
-	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
-	n += 1 + l + sovToken(uint64(l))
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
-	n += 1 + l + sovToken(uint64(l))
-	return n
-}
 
-func (m *TokenUpgradeStatuses) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.V1 != nil {
-		l = m.V1.Size()
-		n += 1 + l + sovToken(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovToken(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozToken(x uint64) (n int) {
-	return sovToken(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Definition) Unmarshal(dAtA []byte) error {
+func (m *TransferLimit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -842,10 +6585,10 @@ func (m *Definition) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Definition: wiretype end group for non-group")
+			return fmt.Errorf("proto: TransferLimit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Definition: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TransferLimit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -882,7 +6625,7 @@ func (m *Definition) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -910,80 +6653,160 @@ func (m *Definition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Issuer = string(dAtA[iNdEx:postIndex])
+			m.Account = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType == 0 {
-				var v Feature
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowToken
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= Feature(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
 				}
-				m.Features = append(m.Features, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowToken
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthToken
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthToken
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				if elementCount != 0 && len(m.Features) == 0 {
-					m.Features = make([]Feature, 0, elementCount)
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				for iNdEx < postIndex {
-					var v Feature
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowToken
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= Feature(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Features = append(m.Features, v)
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Period, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipToken(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthToken
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransferLimitUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowToken
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransferLimitUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransferLimitUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Features", wireType)
 			}
-		case 4:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BurnRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1011,15 +6834,13 @@ func (m *Definition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BurnRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Account = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SendCommissionRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowStart", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowToken
@@ -1029,31 +6850,30 @@ func (m *Definition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthToken
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthToken
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SendCommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.WindowStart, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sent", wireType)
 			}
-			m.Version = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowToken
@@ -1063,11 +6883,26 @@ func (m *Definition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Version |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Sent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipToken(dAtA[iNdEx:])
@@ -1089,7 +6924,7 @@ func (m *Definition) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Token) Unmarshal(dAtA []byte) error {
+func (m *Distribution) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1112,10 +6947,10 @@ func (m *Token) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Token: wiretype end group for non-group")
+			return fmt.Errorf("proto: Distribution: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Token: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Distribution: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1152,7 +6987,7 @@ func (m *Token) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Distributor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1180,13 +7015,13 @@ func (m *Token) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Issuer = string(dAtA[iNdEx:postIndex])
+			m.Distributor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowToken
@@ -1196,29 +7031,30 @@ func (m *Token) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthToken
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthToken
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Symbol = string(dAtA[iNdEx:postIndex])
+			if err := m.Pool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Subunit", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotHeight", wireType)
 			}
-			var stringLen uint64
+			m.SnapshotHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowToken
@@ -1228,29 +7064,16 @@ func (m *Token) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.SnapshotHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthToken
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthToken
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Subunit = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Precision", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SnapshotSupply", wireType)
 			}
-			m.Precision = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowToken
@@ -1260,14 +7083,29 @@ func (m *Token) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Precision |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SnapshotSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Distributed", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1295,13 +7133,15 @@ func (m *Token) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			if err := m.Distributed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GloballyFrozen", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PaidHolders", wireType)
 			}
-			var v int
+			m.PaidHolders = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowToken
@@ -1311,84 +7151,149 @@ func (m *Token) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.PaidHolders |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.GloballyFrozen = bool(v != 0)
 		case 8:
-			if wireType == 0 {
-				var v Feature
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowToken
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= Feature(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			m.Phase = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
 				}
-				m.Features = append(m.Features, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowToken
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthToken
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Phase |= DistributionPhase(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthToken
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				if elementCount != 0 && len(m.Features) == 0 {
-					m.Features = make([]Feature, 0, elementCount)
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextKey = append(m.NextKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextKey == nil {
+				m.NextKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipToken(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthToken
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DistributionHolder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowToken
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DistributionHolder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DistributionHolder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				for iNdEx < postIndex {
-					var v Feature
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowToken
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= Feature(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Features = append(m.Features, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Features", wireType)
 			}
-		case 9:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BurnRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1416,13 +7321,11 @@ func (m *Token) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BurnRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Account = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SendCommissionRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1450,15 +7353,15 @@ func (m *Token) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SendCommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Paid", wireType)
 			}
-			m.Version = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowToken
@@ -1468,11 +7371,12 @@ func (m *Token) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Version |= uint32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Paid = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipToken(dAtA[iNdEx:])
@@ -1494,7 +7398,7 @@ func (m *Token) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DelayedTokenUpgradeV1) Unmarshal(dAtA []byte) error {
+func (m *VestingPeriod) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1517,15 +7421,48 @@ func (m *DelayedTokenUpgradeV1) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DelayedTokenUpgradeV1: wiretype end group for non-group")
+			return fmt.Errorf("proto: VestingPeriod: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelayedTokenUpgradeV1: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VestingPeriod: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Length", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Length, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1553,7 +7490,9 @@ func (m *DelayedTokenUpgradeV1) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1576,7 +7515,7 @@ func (m *DelayedTokenUpgradeV1) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TokenUpgradeV1Status) Unmarshal(dAtA []byte) error {
+func (m *VestingSchedule) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1599,17 +7538,17 @@ func (m *TokenUpgradeV1Status) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TokenUpgradeV1Status: wiretype end group for non-group")
+			return fmt.Errorf("proto: VestingSchedule: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TokenUpgradeV1Status: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VestingSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IbcEnabled", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowToken
@@ -1619,17 +7558,29 @@ func (m *TokenUpgradeV1Status) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IbcEnabled = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowToken
@@ -1639,28 +7590,80 @@ func (m *TokenUpgradeV1Status) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthToken
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthToken
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingType", wireType)
+			}
+			m.VestingType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VestingType |= VestingType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1687,63 +7690,49 @@ func (m *TokenUpgradeV1Status) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipToken(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthToken
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthToken
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TokenUpgradeStatuses) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowToken
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.EndTime == nil {
+				m.EndTime = new(time.Time)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TokenUpgradeStatuses: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TokenUpgradeStatuses: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field V1", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Periods", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1770,10 +7759,8 @@ func (m *TokenUpgradeStatuses) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.V1 == nil {
-				m.V1 = &TokenUpgradeV1Status{}
-			}
-			if err := m.V1.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Periods = append(m.Periods, VestingPeriod{})
+			if err := m.Periods[len(m.Periods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
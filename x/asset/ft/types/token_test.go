@@ -3,7 +3,9 @@ package types_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
+	sdkmath "cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -453,6 +455,7 @@ func TestDefinition_CheckFeatureAllowed(t *testing.T) {
 	type fields struct {
 		Denom              string
 		Issuer             string
+		Admin              string
 		Features           []types.Feature
 		BurnRate           sdk.Dec
 		SendCommissionRate sdk.Dec
@@ -471,6 +474,7 @@ func TestDefinition_CheckFeatureAllowed(t *testing.T) {
 			name: "minting_feature_enabled_for_issuer",
 			fields: fields{
 				Issuer: issuer.String(),
+				Admin:  issuer.String(),
 				Features: []types.Feature{
 					types.Feature_minting,
 				},
@@ -485,6 +489,7 @@ func TestDefinition_CheckFeatureAllowed(t *testing.T) {
 			name: "burning_feature_always_enabled_for_issuer",
 			fields: fields{
 				Issuer: issuer.String(),
+				Admin:  issuer.String(),
 				Features: []types.Feature{
 					types.Feature_burning,
 				},
@@ -499,6 +504,7 @@ func TestDefinition_CheckFeatureAllowed(t *testing.T) {
 			name: "burning_feature_enabled_for_non_issuer",
 			fields: fields{
 				Issuer: issuer.String(),
+				Admin:  issuer.String(),
 			},
 			args: args{
 				addr:    issuer,
@@ -510,6 +516,7 @@ func TestDefinition_CheckFeatureAllowed(t *testing.T) {
 			name: "minting_feature_disabled_for_non_issuer",
 			fields: fields{
 				Issuer: issuer.String(),
+				Admin:  issuer.String(),
 				Features: []types.Feature{
 					types.Feature_minting,
 				},
@@ -529,6 +536,7 @@ func TestDefinition_CheckFeatureAllowed(t *testing.T) {
 			name: "minting_feature_disabled_for_issuer",
 			fields: fields{
 				Issuer: issuer.String(),
+				Admin:  issuer.String(),
 			},
 			args: args{
 				addr:    issuer,
@@ -541,6 +549,25 @@ func TestDefinition_CheckFeatureAllowed(t *testing.T) {
 				t.FailNow()
 			},
 		},
+		{
+			name: "minting_feature_disabled_for_issuer_if_admin_cleared",
+			fields: fields{
+				Issuer: issuer.String(),
+				Features: []types.Feature{
+					types.Feature_minting,
+				},
+			},
+			args: args{
+				addr:    issuer,
+				feature: types.Feature_minting,
+			},
+			wantErr: func(t require.TestingT, err error, i ...interface{}) {
+				if assert.ErrorIs(t, err, cosmoserrors.ErrUnauthorized) {
+					return
+				}
+				t.FailNow()
+			},
+		},
 	}
 	for _, tt := range tests {
 		tt := tt
@@ -548,6 +575,7 @@ func TestDefinition_CheckFeatureAllowed(t *testing.T) {
 			def := types.Definition{
 				Denom:              tt.fields.Denom,
 				Issuer:             tt.fields.Issuer,
+				Admin:              tt.fields.Admin,
 				Features:           tt.fields.Features,
 				BurnRate:           tt.fields.BurnRate,
 				SendCommissionRate: tt.fields.SendCommissionRate,
@@ -556,3 +584,211 @@ func TestDefinition_CheckFeatureAllowed(t *testing.T) {
 		})
 	}
 }
+
+func TestVestingSchedule_VestedAmount(t *testing.T) {
+	startTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	endTime := startTime.Add(100 * time.Hour)
+
+	testCases := []struct {
+		name     string
+		schedule types.VestingSchedule
+		time     time.Time
+		vested   sdkmath.Int
+	}{
+		{
+			name: "linear before start",
+			schedule: types.VestingSchedule{
+				VestingType: types.VestingType_linear,
+				Amount:      sdkmath.NewInt(1000),
+				StartTime:   startTime,
+				EndTime:     &endTime,
+			},
+			time:   startTime.Add(-time.Hour),
+			vested: sdkmath.ZeroInt(),
+		},
+		{
+			name: "linear in the middle",
+			schedule: types.VestingSchedule{
+				VestingType: types.VestingType_linear,
+				Amount:      sdkmath.NewInt(1000),
+				StartTime:   startTime,
+				EndTime:     &endTime,
+			},
+			time:   startTime.Add(25 * time.Hour),
+			vested: sdkmath.NewInt(250),
+		},
+		{
+			name: "linear after end",
+			schedule: types.VestingSchedule{
+				VestingType: types.VestingType_linear,
+				Amount:      sdkmath.NewInt(1000),
+				StartTime:   startTime,
+				EndTime:     &endTime,
+			},
+			time:   endTime.Add(time.Hour),
+			vested: sdkmath.NewInt(1000),
+		},
+		{
+			name: "cliff before end",
+			schedule: types.VestingSchedule{
+				VestingType: types.VestingType_cliff,
+				Amount:      sdkmath.NewInt(1000),
+				StartTime:   startTime,
+				EndTime:     &endTime,
+			},
+			time:   endTime.Add(-time.Second),
+			vested: sdkmath.ZeroInt(),
+		},
+		{
+			name: "cliff at end",
+			schedule: types.VestingSchedule{
+				VestingType: types.VestingType_cliff,
+				Amount:      sdkmath.NewInt(1000),
+				StartTime:   startTime,
+				EndTime:     &endTime,
+			},
+			time:   endTime,
+			vested: sdkmath.NewInt(1000),
+		},
+		{
+			name: "periodic within the second period",
+			schedule: types.VestingSchedule{
+				VestingType: types.VestingType_periodic,
+				Amount:      sdkmath.NewInt(1000),
+				StartTime:   startTime,
+				Periods: []types.VestingPeriod{
+					{Length: time.Hour, Amount: sdkmath.NewInt(100)},
+					{Length: time.Hour, Amount: sdkmath.NewInt(300)},
+					{Length: time.Hour, Amount: sdkmath.NewInt(600)},
+				},
+			},
+			time:   startTime.Add(90 * time.Minute),
+			vested: sdkmath.NewInt(100),
+		},
+		{
+			name: "periodic at the end of the second period",
+			schedule: types.VestingSchedule{
+				VestingType: types.VestingType_periodic,
+				Amount:      sdkmath.NewInt(1000),
+				StartTime:   startTime,
+				Periods: []types.VestingPeriod{
+					{Length: time.Hour, Amount: sdkmath.NewInt(100)},
+					{Length: time.Hour, Amount: sdkmath.NewInt(300)},
+					{Length: time.Hour, Amount: sdkmath.NewInt(600)},
+				},
+			},
+			time:   startTime.Add(2 * time.Hour),
+			vested: sdkmath.NewInt(400),
+		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			assertT := assert.New(t)
+			assertT.Equal(tc.vested.String(), tc.schedule.VestedAmount(tc.time).String())
+			assertT.Equal(tc.schedule.Amount.Sub(tc.vested).String(), tc.schedule.UnvestedAmount(tc.time).String())
+		})
+	}
+}
+
+func TestVestingSchedule_ReduceUnvested(t *testing.T) {
+	startTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	endTime := startTime.Add(100 * time.Hour)
+
+	testCases := []struct {
+		name      string
+		schedule  types.VestingSchedule
+		time      time.Time
+		reduction sdkmath.Int
+		expected  types.VestingSchedule
+	}{
+		{
+			name: "linear before start",
+			schedule: types.VestingSchedule{
+				VestingType: types.VestingType_linear,
+				Amount:      sdkmath.NewInt(1000),
+				StartTime:   startTime,
+				EndTime:     &endTime,
+			},
+			time:      startTime.Add(-time.Hour),
+			reduction: sdkmath.NewInt(400),
+			expected: types.VestingSchedule{
+				VestingType: types.VestingType_linear,
+				Amount:      sdkmath.NewInt(600),
+				StartTime:   startTime,
+				EndTime:     &endTime,
+			},
+		},
+		{
+			name: "linear in the middle",
+			schedule: types.VestingSchedule{
+				VestingType: types.VestingType_linear,
+				Amount:      sdkmath.NewInt(1000),
+				StartTime:   startTime,
+				EndTime:     &endTime,
+			},
+			time:      startTime.Add(25 * time.Hour),
+			reduction: sdkmath.NewInt(150),
+			expected: types.VestingSchedule{
+				VestingType: types.VestingType_linear,
+				Amount:      sdkmath.NewInt(600),
+				StartTime:   startTime.Add(25 * time.Hour),
+				EndTime:     &endTime,
+			},
+		},
+		{
+			name: "cliff",
+			schedule: types.VestingSchedule{
+				VestingType: types.VestingType_cliff,
+				Amount:      sdkmath.NewInt(1000),
+				StartTime:   startTime,
+				EndTime:     &endTime,
+			},
+			time:      startTime.Add(25 * time.Hour),
+			reduction: sdkmath.NewInt(1000),
+			expected: types.VestingSchedule{
+				VestingType: types.VestingType_cliff,
+				Amount:      sdkmath.ZeroInt(),
+				StartTime:   startTime,
+				EndTime:     &endTime,
+			},
+		},
+		{
+			name: "periodic within the second period",
+			schedule: types.VestingSchedule{
+				VestingType: types.VestingType_periodic,
+				Amount:      sdkmath.NewInt(1000),
+				StartTime:   startTime,
+				Periods: []types.VestingPeriod{
+					{Length: time.Hour, Amount: sdkmath.NewInt(100)},
+					{Length: time.Hour, Amount: sdkmath.NewInt(300)},
+					{Length: time.Hour, Amount: sdkmath.NewInt(600)},
+				},
+			},
+			time:      startTime.Add(90 * time.Minute),
+			reduction: sdkmath.NewInt(700),
+			expected: types.VestingSchedule{
+				VestingType: types.VestingType_periodic,
+				Amount:      sdkmath.NewInt(200),
+				StartTime:   startTime.Add(time.Hour),
+				Periods: []types.VestingPeriod{
+					{Length: time.Hour, Amount: sdkmath.NewInt(200)},
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			assertT := assert.New(t)
+			reduced := tc.schedule.ReduceUnvested(tc.time, tc.reduction)
+			assertT.Equal(tc.expected.String(), reduced.String())
+			assertT.Equal(
+				tc.schedule.UnvestedAmount(tc.time).Sub(tc.reduction).String(),
+				reduced.UnvestedAmount(tc.time).String(),
+			)
+		})
+	}
+}
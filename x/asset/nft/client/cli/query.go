@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -16,7 +17,8 @@ import (
 
 // Flags defined on queries.
 const (
-	IssuerFlag = "issuer"
+	IssuerFlag  = "issuer"
+	ClassIDFlag = "class-id"
 )
 
 // GetQueryCmd returns the cli query commands for the module.
@@ -34,11 +36,16 @@ func GetQueryCmd() *cobra.Command {
 		CmdQueryClass(),
 		CmdQueryClasses(),
 		CmdQueryFrozen(),
+		CmdQueryClassFrozen(),
 		CmdQueryWhitelisted(),
 		CmdQueryWhitelistedAccounts(),
 		CmdQueryClassWhitelistedAccounts(),
 		CmdQueryBurnt(),
 		CmdQueryParams(),
+		CmdQueryListing(),
+		CmdQueryListingsByClass(),
+		CmdQueryListingsBySeller(),
+		CmdQueryNFTsByOwner(),
 	)
 
 	return cmd
@@ -165,6 +172,42 @@ $ %[1]s query %s frozen [class-id] [id]
 	return cmd
 }
 
+// CmdQueryClassFrozen return the CmdQueryClassFrozen cobra command.
+func CmdQueryClassFrozen() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "class-frozen [class-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query if non-fungible token class is frozen",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query if non-fungible token class is frozen.
+
+Example:
+$ %[1]s query %s class-frozen [class-id]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			classID := args[0]
+			res, err := queryClient.ClassFrozen(cmd.Context(), &types.QueryClassFrozenRequest{
+				ClassId: classID,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // CmdQueryWhitelisted return the CmdQueryWhitelisted cobra command.
 func CmdQueryWhitelisted() *cobra.Command {
 	cmd := &cobra.Command{
@@ -389,3 +432,173 @@ $ %s query %s burnt [class-id]
 
 	return cmd
 }
+
+// CmdQueryListing return the QueryListing cobra command.
+func CmdQueryListing() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "listing [id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query marketplace listing",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query marketplace listing details.
+
+Example:
+$ %[1]s query %s listing 1
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return errors.Wrap(err, "invalid listing id")
+			}
+
+			res, err := queryClient.Listing(cmd.Context(), &types.QueryListingRequest{
+				Id: id,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryListingsByClass return the QueryListingsByClass cobra command.
+func CmdQueryListingsByClass() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "listings-by-class [class_id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query marketplace listings of the non-fungible token class",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query marketplace listings of the non-fungible token class.
+
+Example:
+$ %[1]s query %s listings-by-class abc-%s
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ListingsByClass(cmd.Context(), &types.QueryListingsByClassRequest{
+				Pagination: pageReq,
+				ClassId:    args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "listings-by-class")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryListingsBySeller return the QueryListingsBySeller cobra command.
+func CmdQueryListingsBySeller() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "listings-by-seller [seller]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query marketplace listings of the seller",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query marketplace listings of the seller.
+
+Example:
+$ %[1]s query %s listings-by-seller %s
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ListingsBySeller(cmd.Context(), &types.QueryListingsBySellerRequest{
+				Pagination: pageReq,
+				Seller:     args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "listings-by-seller")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryNFTsByOwner return the QueryNFTsByOwner cobra command.
+func CmdQueryNFTsByOwner() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "nfts-by-owner [owner]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query non-fungible tokens owned by the account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query non-fungible tokens owned by the account together with their frozen and whitelisting state.
+
+Example:
+$ %[1]s query %s nfts-by-owner %s --class-id abc-%[3]s
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			classID, err := cmd.Flags().GetString(ClassIDFlag)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			res, err := queryClient.NFTsByOwner(cmd.Context(), &types.QueryNFTsByOwnerRequest{
+				Pagination: pageReq,
+				Owner:      args[0],
+				ClassId:    classID,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(ClassIDFlag, "", fmt.Sprintf("Class ID to filter the non-fungible tokens by. e.g abc-%s", constant.AddressSampleTest))
+	flags.AddPaginationFlagsToCmd(cmd, "nfts-by-owner")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
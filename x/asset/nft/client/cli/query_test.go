@@ -56,6 +56,7 @@ func TestQueryClassAndClasses(t *testing.T) {
 			types.ClassFeature_disable_sending,
 		},
 		RoyaltyRate: sdk.MustNewDecFromStr("0.1"),
+		DataEditors: []types.DataEditor{},
 	}
 
 	requireT.Equal(expectedClass, classRes.Class)
@@ -125,6 +126,54 @@ func TestCmdTxBurn(t *testing.T) {
 	requireT.Len(respList.NftIds, 1)
 }
 
+func TestCmdQueryNFTsByOwner(t *testing.T) {
+	requireT := require.New(t)
+	testNetwork := network.New(t)
+
+	symbol := "nft" + uuid.NewString()[:4]
+	validator := testNetwork.Validators[0]
+	ctx := validator.ClientCtx
+
+	classID := issueClass(
+		requireT,
+		ctx,
+		symbol,
+		"class name",
+		"class description",
+		"https://my-class-meta.invalid/1",
+		"",
+		testNetwork,
+		"0.0",
+		types.ClassFeature_freezing,
+	)
+	mint(
+		requireT,
+		ctx,
+		classID,
+		"nft-1",
+		"https://my-nft-meta.invalid/1",
+		"",
+		testNetwork,
+	)
+
+	args := []string{classID, "nft-1"}
+	args = append(args, txValidator1Args(testNetwork)...)
+	_, err := coreumclitestutil.ExecTxCmd(ctx, testNetwork, cli.CmdTxFreeze(), args)
+	requireT.NoError(err)
+
+	var resp types.QueryNFTsByOwnerResponse
+	args = []string{validator.Address.String(), fmt.Sprintf("--%s=%s", cli.ClassIDFlag, classID), "--output", "json"}
+	requireT.NoError(coreumclitestutil.ExecQueryCmd(ctx, cli.CmdQueryNFTsByOwner(), args, &resp))
+	requireT.Len(resp.Nfts, 1)
+	requireT.Equal(types.NFTRecord{
+		ClassId:       classID,
+		Id:            "nft-1",
+		URI:           "https://my-nft-meta.invalid/1",
+		Frozen:        true,
+		ClassFeatures: []types.ClassFeature{types.ClassFeature_freezing},
+	}, resp.Nfts[0])
+}
+
 func TestCmdQueryParams(t *testing.T) {
 	requireT := require.New(t)
 
@@ -1,8 +1,12 @@
 package cli
 
 import (
+	"encoding/json"
 	"fmt"
+	"os"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -21,6 +25,8 @@ const (
 	FeaturesFlag    = "features"
 	RoyaltyRateFlag = "royalty-rate"
 	RecipientFlag   = "recipient"
+	AuctionFlag     = "auction-duration"
+	DataEditorsFlag = "data-editors"
 )
 
 // GetTxCmd returns the transaction commands for this module.
@@ -36,13 +42,23 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(
 		CmdTxIssueClass(),
 		CmdTxMint(),
+		CmdTxMintBatch(),
+		CmdTxUpdateData(),
 		CmdTxBurn(),
+		CmdTxBurnBatch(),
+		CmdTxRevoke(),
 		CmdTxFreeze(),
 		CmdTxUnfreeze(),
+		CmdTxClassFreeze(),
+		CmdTxClassUnfreeze(),
 		CmdTxWhitelist(),
 		CmdTxUnwhitelist(),
 		CmdTxClassWhitelist(),
 		CmdTxClassUnwhitelist(),
+		CmdTxListNFT(),
+		CmdTxBuyNFT(),
+		CmdTxPlaceBid(),
+		CmdTxCancelListing(),
 	)
 
 	return cmd
@@ -56,6 +72,12 @@ func CmdTxIssueClass() *cobra.Command {
 	}
 	allowedFeaturesString := strings.Join(allowedFeatures, ",")
 
+	allowedDataEditors := make([]string, 0, len(types.DataEditor_name))
+	for _, n := range types.DataEditor_name {
+		allowedDataEditors = append(allowedDataEditors, n)
+	}
+	allowedDataEditorsString := strings.Join(allowedDataEditors, ",")
+
 	cmd := &cobra.Command{
 		Use:   fmt.Sprintf("issue-class [symbol] [name] [description] [uri] [uri_hash] --from [issuer] --%s=%s", FeaturesFlag, allowedFeaturesString),
 		Args:  cobra.ExactArgs(5),
@@ -104,6 +126,20 @@ $ %s tx %s issue-class abc "ABC Name" "ABC class description." https://my-class-
 				features = append(features, types.ClassFeature(feature))
 			}
 
+			dataEditorsString, err := cmd.Flags().GetStringSlice(DataEditorsFlag)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			var dataEditors []types.DataEditor
+			for _, str := range dataEditorsString {
+				dataEditor, ok := types.DataEditor_value[str]
+				if !ok {
+					return errors.Errorf("unknown data editor '%s', allowed data editors: %s", str, allowedDataEditorsString)
+				}
+				dataEditors = append(dataEditors, types.DataEditor(dataEditor))
+			}
+
 			msg := &types.MsgIssueClass{
 				Issuer:      issuer.String(),
 				Symbol:      symbol,
@@ -113,6 +149,7 @@ $ %s tx %s issue-class abc "ABC Name" "ABC class description." https://my-class-
 				URIHash:     uriHash,
 				Features:    features,
 				RoyaltyRate: royaltyRate,
+				DataEditors: dataEditors,
 			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
@@ -120,6 +157,7 @@ $ %s tx %s issue-class abc "ABC Name" "ABC class description." https://my-class-
 	}
 
 	cmd.Flags().StringSlice(FeaturesFlag, []string{}, fmt.Sprintf("Features to be enabled on non-fungible token. e.g --%s=%s", FeaturesFlag, allowedFeaturesString))
+	cmd.Flags().StringSlice(DataEditorsFlag, []string{}, fmt.Sprintf("Accounts allowed to update the data of the tokens if %s feature is enabled. e.g --%s=%s", types.ClassFeature_updatable_data.String(), DataEditorsFlag, allowedDataEditorsString))
 	cmd.Flags().String(RoyaltyRateFlag, "0", fmt.Sprintf("%s is a number between 0 and 1, and will be used to determine royalties sent to issuer, when an nft in this class is traded.", RoyaltyRateFlag))
 	flags.AddTxFlagsToCmd(cmd)
 
@@ -177,6 +215,98 @@ $ %s tx %s mint abc-%s id1 https://my-nft-meta.invalid/1 e000624 --from [sender]
 	return cmd
 }
 
+// CmdTxMintBatch returns MintBatch cobra command.
+func CmdTxMintBatch() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mint-batch [items_file] --from [sender]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Mint multiple non-fungible tokens",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Mint multiple non-fungible tokens atomically.
+
+The items file is the JSON file containing the list of non-fungible tokens to mint. If the recipient is not
+specified the token is sent to the class issuer.
+
+Example:
+$ %s tx %s mint-batch items.json --from [sender]
+
+items.json:
+[
+  {"class_id": "abc-%s", "id": "id1", "uri": "https://my-nft-meta.invalid/1", "uri_hash": "e000624", "recipient": "[recipient_address]"}
+]
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			items, err := readMintBatchItems(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgMintBatch{
+				Sender: clientCtx.GetFromAddress().String(),
+				Items:  items,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxUpdateData returns UpdateData cobra command.
+func CmdTxUpdateData() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-data [class-id] [id] [uri] [uri_hash] --from [sender]",
+		Args:  cobra.ExactArgs(4),
+		Short: "Update the uri and uri hash of the non-fungible token",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Update the uri and uri hash of the non-fungible token.
+
+Example:
+$ %s tx %s update-data abc-%s id1 https://my-nft-meta.invalid/2 e000625 --from [sender]
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			sender := clientCtx.GetFromAddress()
+			classID := args[0]
+			ID := args[1]
+			uri := args[2]
+			uriHash := args[3]
+
+			msg := &types.MsgUpdateData{
+				Sender:  sender.String(),
+				ClassID: classID,
+				ID:      ID,
+				URI:     uri,
+				URIHash: uriHash,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // CmdTxBurn returns Burn cobra command.
 func CmdTxBurn() *cobra.Command {
 	cmd := &cobra.Command{
@@ -217,6 +347,95 @@ $ %s tx %s burn abc-%s id1 --from [sender]
 	return cmd
 }
 
+// CmdTxBurnBatch returns BurnBatch cobra command.
+func CmdTxBurnBatch() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "burn-batch [items_file] --from [sender]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Burn multiple non-fungible tokens",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Burn multiple non-fungible tokens atomically.
+
+The items file is the JSON file containing the list of non-fungible tokens to burn.
+
+Example:
+$ %s tx %s burn-batch items.json --from [sender]
+
+items.json:
+[
+  {"class_id": "abc-%s", "id": "id1"}
+]
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			items, err := readBurnBatchItems(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgBurnBatch{
+				Sender: clientCtx.GetFromAddress().String(),
+				Items:  items,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxRevoke returns Revoke cobra command.
+func CmdTxRevoke() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke [class-id] [id] [reason] --from [issuer]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Revoke non-fungible token of the soulbound class",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Revoke non-fungible token of the soulbound class by burning it from the owner.
+
+Example:
+$ %s tx %s revoke abc-%s id1 "certificate expired" --from [issuer]
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			sender := clientCtx.GetFromAddress()
+			classID := args[0]
+			ID := args[1]
+			reason := args[2]
+
+			msg := &types.MsgRevoke{
+				Sender:  sender.String(),
+				ClassID: classID,
+				ID:      ID,
+				Reason:  reason,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // CmdTxFreeze returns Freeze cobra command.
 func CmdTxFreeze() *cobra.Command {
 	cmd := &cobra.Command{
@@ -297,6 +516,82 @@ $ %s tx %s unfreeze abc-%s id1 --from [sender]
 	return cmd
 }
 
+// CmdTxClassFreeze returns ClassFreeze cobra command.
+func CmdTxClassFreeze() *cobra.Command { //nolint:dupl // all CLI commands are similar.
+	cmd := &cobra.Command{
+		Use:   "class-freeze [class-id] --from [sender]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Freeze all the non-fungible tokens of the class",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Freeze all the non-fungible tokens of the class.
+
+Example:
+$ %s tx %s class-freeze abc-%s --from [sender]
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			sender := clientCtx.GetFromAddress()
+			classID := args[0]
+
+			msg := &types.MsgClassFreeze{
+				Sender:  sender.String(),
+				ClassID: classID,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxClassUnfreeze returns ClassUnfreeze cobra command.
+func CmdTxClassUnfreeze() *cobra.Command { //nolint:dupl // all CLI commands are similar.
+	cmd := &cobra.Command{
+		Use:   "class-unfreeze [class-id] --from [sender]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Unfreeze all the non-fungible tokens of the class",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Unfreeze all the non-fungible tokens of the class.
+
+Example:
+$ %s tx %s class-unfreeze abc-%s --from [sender]
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			sender := clientCtx.GetFromAddress()
+			classID := args[0]
+
+			msg := &types.MsgClassUnfreeze{
+				Sender:  sender.String(),
+				ClassID: classID,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // CmdTxWhitelist returns Whitelist cobra command.
 func CmdTxWhitelist() *cobra.Command { //nolint:dupl // all CLI commands are similar.
 	cmd := &cobra.Command{
@@ -460,3 +755,234 @@ $ %s tx %s class-unwhitelist abc-%[3]s %[3]s --from [sender]
 
 	return cmd
 }
+
+// CmdTxListNFT returns ListNFT cobra command.
+func CmdTxListNFT() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   fmt.Sprintf("list-nft [class-id] [id] [price] --from [sender] --%s=[duration]", AuctionFlag),
+		Args:  cobra.ExactArgs(3),
+		Short: "List non-fungible token on the marketplace",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`List non-fungible token on the marketplace for the fixed price, or on the auction with the minimal bid if the auction duration is set.
+
+Example:
+$ %s tx %s list-nft abc-%s id1 1000000%s --from [sender] --%s=24h
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest, constant.DenomTest, AuctionFlag,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			price, err := sdk.ParseCoinNormalized(args[2])
+			if err != nil {
+				return errors.Wrap(err, "invalid price")
+			}
+
+			auctionDuration, err := cmd.Flags().GetDuration(AuctionFlag)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			listingType := types.ListingType_fixed_price
+			if auctionDuration != 0 {
+				listingType = types.ListingType_auction
+			}
+
+			msg := &types.MsgListNFT{
+				Sender:          clientCtx.GetFromAddress().String(),
+				ClassID:         args[0],
+				ID:              args[1],
+				ListingType:     listingType,
+				Price:           price,
+				AuctionDuration: auctionDuration,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Duration(AuctionFlag, time.Duration(0), "Duration of the auction, the fixed-price listing is created if not set")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxBuyNFT returns BuyNFT cobra command.
+func CmdTxBuyNFT() *cobra.Command { //nolint:dupl // all CLI commands are similar.
+	cmd := &cobra.Command{
+		Use:   "buy-nft [listing-id] --from [sender]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Buy non-fungible token listed for the fixed price",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Buy non-fungible token listed for the fixed price.
+
+Example:
+$ %s tx %s buy-nft 1 --from [sender]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			listingID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return errors.Wrap(err, "invalid listing id")
+			}
+
+			msg := &types.MsgBuyNFT{
+				Sender:    clientCtx.GetFromAddress().String(),
+				ListingID: listingID,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxPlaceBid returns PlaceBid cobra command.
+func CmdTxPlaceBid() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "place-bid [listing-id] [amount] --from [sender]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Place a bid on the non-fungible token listed on the auction",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Place a bid on the non-fungible token listed on the auction.
+
+Example:
+$ %s tx %s place-bid 1 1000000%s --from [sender]
+`,
+				version.AppName, types.ModuleName, constant.DenomTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			listingID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return errors.Wrap(err, "invalid listing id")
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return errors.Wrap(err, "invalid amount")
+			}
+
+			msg := &types.MsgPlaceBid{
+				Sender:    clientCtx.GetFromAddress().String(),
+				ListingID: listingID,
+				Amount:    amount,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxCancelListing returns CancelListing cobra command.
+func CmdTxCancelListing() *cobra.Command { //nolint:dupl // all CLI commands are similar.
+	cmd := &cobra.Command{
+		Use:   "cancel-listing [listing-id] --from [sender]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Cancel the marketplace listing",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Cancel the marketplace listing and return the non-fungible token to the seller.
+
+Example:
+$ %s tx %s cancel-listing 1 --from [sender]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			listingID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return errors.Wrap(err, "invalid listing id")
+			}
+
+			msg := &types.MsgCancelListing{
+				Sender:    clientCtx.GetFromAddress().String(),
+				ListingID: listingID,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func readMintBatchItems(path string) ([]types.MintBatchItem, error) {
+	type item struct {
+		ClassID   string `json:"class_id"`
+		ID        string `json:"id"`
+		URI       string `json:"uri"`
+		URIHash   string `json:"uri_hash"`
+		Recipient string `json:"recipient"`
+	}
+
+	var rawItems []item
+	if err := readJSONFile(path, &rawItems); err != nil {
+		return nil, err
+	}
+
+	items := make([]types.MintBatchItem, 0, len(rawItems))
+	for _, rawItem := range rawItems {
+		items = append(items, types.MintBatchItem{
+			ClassID:   rawItem.ClassID,
+			ID:        rawItem.ID,
+			URI:       rawItem.URI,
+			URIHash:   rawItem.URIHash,
+			Recipient: rawItem.Recipient,
+		})
+	}
+
+	return items, nil
+}
+
+func readBurnBatchItems(path string) ([]types.BurnBatchItem, error) {
+	var items []types.BurnBatchItem
+	if err := readJSONFile(path, &items); err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
+
+func readJSONFile(path string, v any) error {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return errors.Wrapf(err, "failed to read items file %s", path)
+	}
+
+	if err := json.Unmarshal(bz, v); err != nil {
+		return errors.Wrapf(err, "failed to decode items file %s", path)
+	}
+
+	return nil
+}
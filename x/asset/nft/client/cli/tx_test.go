@@ -2,6 +2,8 @@ package cli_test
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
 
 	sdkmath "cosmossdk.io/math"
@@ -136,6 +138,203 @@ func TestCmdFreeze(t *testing.T) {
 	requireT.False(frozenResp.Frozen)
 }
 
+func TestCmdClassFreeze(t *testing.T) {
+	requireT := require.New(t)
+	testNetwork := network.New(t)
+
+	symbol := "nft" + uuid.NewString()[:4]
+	ctx := testNetwork.Validators[0].ClientCtx
+
+	// issue class
+	classID := issueClass(
+		requireT,
+		ctx,
+		symbol,
+		"class name",
+		"class description",
+		"https://my-class-meta.invalid/1",
+		"",
+		testNetwork,
+		"0.0",
+		types.ClassFeature_freezing,
+	)
+
+	// class freeze
+	args := []string{classID}
+	args = append(args, txValidator1Args(testNetwork)...)
+	_, err := coreumclitestutil.ExecTxCmd(ctx, testNetwork, cli.CmdTxClassFreeze(), args)
+	requireT.NoError(err)
+
+	// query class frozen
+	var frozenResp types.QueryClassFrozenResponse
+	args = []string{classID}
+	requireT.NoError(coreumclitestutil.ExecQueryCmd(ctx, cli.CmdQueryClassFrozen(), args, &frozenResp))
+	requireT.True(frozenResp.Frozen)
+
+	// class unfreeze
+	args = []string{classID}
+	args = append(args, txValidator1Args(testNetwork)...)
+	_, err = coreumclitestutil.ExecTxCmd(ctx, testNetwork, cli.CmdTxClassUnfreeze(), args)
+	requireT.NoError(err)
+
+	// query class frozen
+	args = []string{classID}
+	requireT.NoError(coreumclitestutil.ExecQueryCmd(ctx, cli.CmdQueryClassFrozen(), args, &frozenResp))
+	requireT.False(frozenResp.Frozen)
+}
+
+func TestCmdUpdateData(t *testing.T) {
+	requireT := require.New(t)
+	testNetwork := network.New(t)
+
+	symbol := "nft" + uuid.NewString()[:4]
+	validator := testNetwork.Validators[0]
+	ctx := validator.ClientCtx
+
+	// create class
+	args := []string{
+		symbol,
+		"class name",
+		"class description",
+		"https://my-class-meta.invalid/1",
+		"",
+		fmt.Sprintf("--%s=%s", cli.FeaturesFlag, types.ClassFeature_updatable_data.String()),
+		fmt.Sprintf("--%s=%s", cli.DataEditorsFlag, types.DataEditor_issuer.String()),
+	}
+	args = append(args, txValidator1Args(testNetwork)...)
+	_, err := coreumclitestutil.ExecTxCmd(ctx, testNetwork, cli.CmdTxIssueClass(), args)
+	requireT.NoError(err)
+
+	classID := types.BuildClassID(symbol, validator.Address)
+	mint(
+		requireT,
+		ctx,
+		classID,
+		nftID,
+		"https://my-nft-meta.invalid/1",
+		"",
+		testNetwork,
+	)
+
+	// update data
+	args = []string{classID, nftID, "https://my-nft-meta.invalid/2", "content-hash"}
+	args = append(args, txValidator1Args(testNetwork)...)
+	_, err = coreumclitestutil.ExecTxCmd(ctx, testNetwork, cli.CmdTxUpdateData(), args)
+	requireT.NoError(err)
+
+	// query nft
+	var resp nft.QueryNFTResponse
+	args = []string{classID, nftID}
+	requireT.NoError(coreumclitestutil.ExecQueryCmd(ctx, cosmoscli.GetCmdQueryNFT(), args, &resp))
+	requireT.Equal("https://my-nft-meta.invalid/2", resp.Nft.Uri)
+	requireT.Equal("content-hash", resp.Nft.UriHash)
+}
+
+func TestCmdRevoke(t *testing.T) {
+	requireT := require.New(t)
+	testNetwork := network.New(t)
+
+	symbol := "nft" + uuid.NewString()[:4]
+	validator := testNetwork.Validators[0]
+	ctx := validator.ClientCtx
+
+	// create class
+	classID := issueClass(
+		requireT,
+		ctx,
+		symbol,
+		"class name",
+		"class description",
+		"https://my-class-meta.invalid/1",
+		"",
+		testNetwork,
+		"0.0",
+		types.ClassFeature_soulbound,
+	)
+	// mint nft
+	mint(
+		requireT,
+		ctx,
+		classID,
+		nftID,
+		"https://my-nft-meta.invalid/1",
+		"",
+		testNetwork,
+	)
+
+	// revoke
+	args := []string{classID, nftID, "certificate expired"}
+	args = append(args, txValidator1Args(testNetwork)...)
+	_, err := coreumclitestutil.ExecTxCmd(ctx, testNetwork, cli.CmdTxRevoke(), args)
+	requireT.NoError(err)
+
+	// query burnt
+	var resp types.QueryBurntNFTResponse
+	args = []string{classID, nftID, "--output", "json"}
+	requireT.NoError(coreumclitestutil.ExecQueryCmd(ctx, cli.CmdQueryBurnt(), args, &resp))
+	requireT.True(resp.Burnt)
+	requireT.True(resp.Revoked)
+	requireT.Equal("certificate expired", resp.RevocationReason)
+}
+
+func TestCmdMintAndBurnBatch(t *testing.T) {
+	requireT := require.New(t)
+	testNetwork := network.New(t)
+
+	symbol := "nft" + uuid.NewString()[:4]
+	validator := testNetwork.Validators[0]
+	ctx := validator.ClientCtx
+	recipient := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	dir := t.TempDir()
+
+	// create class
+	classID := issueClass(
+		requireT,
+		ctx,
+		symbol,
+		"class name",
+		"class description",
+		"https://my-class-meta.invalid/1",
+		"",
+		testNetwork,
+		"0.0",
+	)
+
+	// mint two nfts, one to the issuer and one to the recipient
+	mintFile := filepath.Join(dir, "mint.json")
+	requireT.NoError(os.WriteFile(mintFile, []byte(fmt.Sprintf(
+		`[{"class_id":"%s","id":"nft-1","uri":"https://my-nft-meta.invalid/1"},{"class_id":"%s","id":"nft-2","recipient":"%s"}]`,
+		classID, classID, recipient,
+	)), 0o600))
+	args := append([]string{mintFile}, txValidator1Args(testNetwork)...)
+	_, err := coreumclitestutil.ExecTxCmd(ctx, testNetwork, cli.CmdTxMintBatch(), args)
+	requireT.NoError(err)
+
+	var ownerResp nft.QueryOwnerResponse
+	args = []string{classID, "nft-1"}
+	requireT.NoError(coreumclitestutil.ExecQueryCmd(ctx, cosmoscli.GetCmdQueryOwner(), args, &ownerResp))
+	requireT.Equal(validator.Address.String(), ownerResp.Owner)
+
+	args = []string{classID, "nft-2"}
+	requireT.NoError(coreumclitestutil.ExecQueryCmd(ctx, cosmoscli.GetCmdQueryOwner(), args, &ownerResp))
+	requireT.Equal(recipient.String(), ownerResp.Owner)
+
+	// burn the nft owned by the issuer
+	burnFile := filepath.Join(dir, "burn.json")
+	requireT.NoError(os.WriteFile(burnFile, []byte(fmt.Sprintf(
+		`[{"class_id":"%s","id":"nft-1"}]`,
+		classID,
+	)), 0o600))
+	args = append([]string{burnFile}, txValidator1Args(testNetwork)...)
+	_, err = coreumclitestutil.ExecTxCmd(ctx, testNetwork, cli.CmdTxBurnBatch(), args)
+	requireT.NoError(err)
+
+	var burntResp types.QueryBurntNFTResponse
+	args = []string{classID, "nft-1", "--output", "json"}
+	requireT.NoError(coreumclitestutil.ExecQueryCmd(ctx, cli.CmdQueryBurnt(), args, &burntResp))
+	requireT.True(burntResp.Burnt)
+}
+
 func TestCmdWhitelist(t *testing.T) {
 	requireT := require.New(t)
 	testNetwork := network.New(t)
@@ -263,6 +462,71 @@ func TestCmdClassWhitelist(t *testing.T) {
 	requireT.False(whitelistedResp.Whitelisted)
 }
 
+func TestCmdListNFT(t *testing.T) {
+	requireT := require.New(t)
+	testNetwork := network.New(t)
+
+	symbol := "nft" + uuid.NewString()[:4]
+	validator := testNetwork.Validators[0]
+	ctx := validator.ClientCtx
+
+	// create class
+	classID := issueClass(
+		requireT,
+		ctx,
+		symbol,
+		"class name",
+		"class description",
+		"https://my-class-meta.invalid/1",
+		"",
+		testNetwork,
+		"0.1",
+	)
+	// mint nft
+	mint(
+		requireT,
+		ctx,
+		classID,
+		nftID,
+		"https://my-nft-meta.invalid/1",
+		"9309e7e6e96150afbf181d308fe88343ab1cbec391b7717150a7fb217b4cf0a9",
+		testNetwork,
+	)
+
+	// list
+	price := sdk.NewInt64Coin(testNetwork.Config.BondDenom, 1000)
+	args := []string{classID, nftID, price.String()}
+	args = append(args, txValidator1Args(testNetwork)...)
+	_, err := coreumclitestutil.ExecTxCmd(ctx, testNetwork, cli.CmdTxListNFT(), args)
+	requireT.NoError(err)
+
+	// query listing
+	var listingResp types.QueryListingResponse
+	requireT.NoError(coreumclitestutil.ExecQueryCmd(ctx, cli.CmdQueryListing(), []string{"1"}, &listingResp))
+	requireT.Equal(classID, listingResp.Listing.ClassID)
+	requireT.Equal(nftID, listingResp.Listing.NftID)
+	requireT.Equal(types.ListingType_fixed_price, listingResp.Listing.Type)
+	requireT.Equal(price.String(), listingResp.Listing.Price.String())
+
+	var listingsResp types.QueryListingsByClassResponse
+	requireT.NoError(coreumclitestutil.ExecQueryCmd(ctx, cli.CmdQueryListingsByClass(), []string{classID}, &listingsResp))
+	requireT.Len(listingsResp.Listings, 1)
+
+	var sellerListingsResp types.QueryListingsBySellerResponse
+	args = []string{validator.Address.String()}
+	requireT.NoError(coreumclitestutil.ExecQueryCmd(ctx, cli.CmdQueryListingsBySeller(), args, &sellerListingsResp))
+	requireT.Len(sellerListingsResp.Listings, 1)
+
+	// cancel
+	args = []string{"1"}
+	args = append(args, txValidator1Args(testNetwork)...)
+	_, err = coreumclitestutil.ExecTxCmd(ctx, testNetwork, cli.CmdTxCancelListing(), args)
+	requireT.NoError(err)
+
+	requireT.NoError(coreumclitestutil.ExecQueryCmd(ctx, cli.CmdQueryListingsByClass(), []string{classID}, &listingsResp))
+	requireT.Empty(listingsResp.Listings)
+}
+
 func txValidator1Args(testNetwork *network.Network) []string {
 	return []string{
 		fmt.Sprintf("--%s=%s", flags.FlagFrom, testNetwork.Validators[0].Address.String()),
@@ -30,6 +30,12 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 		}
 	}
 
+	for _, classID := range genState.FrozenClassIDs {
+		if err := k.SetClassFrozen(ctx, classID, true); err != nil {
+			panic(err)
+		}
+	}
+
 	for _, whitelisted := range genState.WhitelistedNFTAccounts {
 		if err := whitelisted.Validate(); err != nil {
 			panic(err)
@@ -73,6 +79,25 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 			}
 		}
 	}
+
+	for _, revoked := range genState.RevokedNFTs {
+		if err := revoked.Validate(); err != nil {
+			panic(err)
+		}
+		if err := k.SetRevocation(ctx, revoked.ClassID, revoked.NftID, revoked.Reason); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, listing := range genState.Listings {
+		if err := listing.Validate(); err != nil {
+			panic(err)
+		}
+		if err := k.ImportListing(ctx, listing); err != nil {
+			panic(err)
+		}
+	}
+	k.SetListingSequence(ctx, genState.ListingSequence)
 }
 
 // ExportGenesis returns the module's exported genesis.
@@ -87,6 +112,11 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		panic(err)
 	}
 
+	frozenClassIDs, _, err := k.GetFrozenClasses(ctx, &query.PageRequest{Limit: query.MaxLimit})
+	if err != nil {
+		panic(err)
+	}
+
 	whitelisted, _, err := k.GetWhitelistedAccounts(ctx, &query.PageRequest{Limit: query.MaxLimit})
 	if err != nil {
 		panic(err)
@@ -102,12 +132,26 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		panic(err)
 	}
 
+	revoked, _, err := k.GetRevokedNFTs(ctx, &query.PageRequest{Limit: query.MaxLimit})
+	if err != nil {
+		panic(err)
+	}
+
+	listings, _, err := k.GetListings(ctx, &query.PageRequest{Limit: query.MaxLimit})
+	if err != nil {
+		panic(err)
+	}
+
 	return &types.GenesisState{
 		ClassDefinitions:         classDefinitions,
 		Params:                   k.GetParams(ctx),
 		FrozenNFTs:               frozen,
+		FrozenClassIDs:           frozenClassIDs,
 		WhitelistedNFTAccounts:   whitelisted,
 		ClassWhitelistedAccounts: classWhitelisted,
 		BurntNFTs:                burnt,
+		RevokedNFTs:              revoked,
+		Listings:                 listings,
+		ListingSequence:          k.GetListingSequence(ctx),
 	}
 }
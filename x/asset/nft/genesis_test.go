@@ -80,6 +80,12 @@ func TestInitAndExportGenesis(t *testing.T) {
 		})
 	}
 
+	// Frozen classes
+	frozenClassIDs := []string{
+		fmt.Sprintf("classid0-%s", issuer),
+		fmt.Sprintf("classid1-%s", issuer),
+	}
+
 	// Whitelisting
 	var whitelisted []types.WhitelistedNFTAccounts
 	for i := 0; i < 5; i++ {
@@ -131,13 +137,25 @@ func TestInitAndExportGenesis(t *testing.T) {
 		})
 	}
 
+	// Revoked NFTs
+	var revoked []types.RevokedNFT
+	for i := 0; i < 5; i++ {
+		revoked = append(revoked, types.RevokedNFT{
+			ClassID: fmt.Sprintf("classid%d-%s", i, issuer),
+			NftID:   fmt.Sprintf("burnt-nft-id-2-%d", i),
+			Reason:  "expired",
+		})
+	}
+
 	genState := types.GenesisState{
 		Params:                   types.DefaultParams(),
 		ClassDefinitions:         classDefinitions,
 		FrozenNFTs:               frozen,
+		FrozenClassIDs:           frozenClassIDs,
 		WhitelistedNFTAccounts:   whitelisted,
 		ClassWhitelistedAccounts: classWhitelisted,
 		BurntNFTs:                burnt,
+		RevokedNFTs:              revoked,
 	}
 
 	// init the keeper
@@ -161,6 +179,7 @@ func TestInitAndExportGenesis(t *testing.T) {
 	exportedGenState := nft.ExportGenesis(ctx, nftKeeper)
 	assertT.ElementsMatch(genState.ClassDefinitions, exportedGenState.ClassDefinitions)
 	assertT.ElementsMatch(genState.FrozenNFTs, exportedGenState.FrozenNFTs)
+	assertT.ElementsMatch(genState.FrozenClassIDs, exportedGenState.FrozenClassIDs)
 
 	for _, st := range genState.WhitelistedNFTAccounts {
 		sort.Strings(st.Accounts)
@@ -178,4 +197,5 @@ func TestInitAndExportGenesis(t *testing.T) {
 	assertT.ElementsMatch(genState.WhitelistedNFTAccounts, exportedGenState.WhitelistedNFTAccounts)
 	assertT.ElementsMatch(genState.ClassWhitelistedAccounts, exportedGenState.ClassWhitelistedAccounts)
 	assertT.ElementsMatch(genState.BurntNFTs, exportedGenState.BurntNFTs)
+	assertT.ElementsMatch(genState.RevokedNFTs, exportedGenState.RevokedNFTs)
 }
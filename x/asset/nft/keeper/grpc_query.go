@@ -18,11 +18,18 @@ type QueryKeeper interface {
 	GetClass(ctx sdk.Context, classID string) (types.Class, error)
 	GetClasses(ctx sdk.Context, issuer *sdk.AccAddress, pagination *query.PageRequest) ([]types.Class, *query.PageResponse, error)
 	IsFrozen(ctx sdk.Context, classID, nftID string) (bool, error)
+	IsClassFrozen(ctx sdk.Context, classID string) (bool, error)
 	IsWhitelisted(ctx sdk.Context, classID, nftID string, account sdk.AccAddress) (bool, error)
 	GetWhitelistedAccountsForNFT(ctx sdk.Context, classID, nftID string, q *query.PageRequest) ([]string, *query.PageResponse, error)
 	GetClassWhitelistedAccounts(ctx sdk.Context, classID string, q *query.PageRequest) ([]string, *query.PageResponse, error)
 	GetBurntByClass(ctx sdk.Context, classID string, q *query.PageRequest) (*query.PageResponse, []string, error)
 	IsBurnt(ctx sdk.Context, classID, nftID string) (bool, error)
+	IsRevoked(ctx sdk.Context, classID, nftID string) (bool, error)
+	GetRevocation(ctx sdk.Context, classID, nftID string) (*types.Revocation, error)
+	GetListing(ctx sdk.Context, listingID uint64) (types.Listing, error)
+	GetListingsByClass(ctx sdk.Context, classID string, pagination *query.PageRequest) ([]types.Listing, *query.PageResponse, error)
+	GetListingsBySeller(ctx sdk.Context, seller sdk.AccAddress, pagination *query.PageRequest) ([]types.Listing, *query.PageResponse, error)
+	GetNFTsByOwner(ctx sdk.Context, owner sdk.AccAddress, classID string, pagination *query.PageRequest) ([]types.NFTRecord, *query.PageResponse, error)
 }
 
 // QueryService serves grpc query requests for assetsnft module.
@@ -83,6 +90,14 @@ func (qs QueryService) Frozen(ctx context.Context, req *types.QueryFrozenRequest
 	}, err
 }
 
+// ClassFrozen returns whether NFT class is frozen or not.
+func (qs QueryService) ClassFrozen(ctx context.Context, req *types.QueryClassFrozenRequest) (*types.QueryClassFrozenResponse, error) {
+	frozen, err := qs.keeper.IsClassFrozen(sdk.UnwrapSDKContext(ctx), req.ClassId)
+	return &types.QueryClassFrozenResponse{
+		Frozen: frozen,
+	}, err
+}
+
 // Whitelisted checks to see if an account is whitelisted for an NFT.
 func (qs QueryService) Whitelisted(ctx context.Context, req *types.QueryWhitelistedRequest) (*types.QueryWhitelistedResponse, error) {
 	account, err := sdk.AccAddressFromBech32(req.Account)
@@ -117,16 +132,34 @@ func (qs QueryService) ClassWhitelistedAccounts(ctx context.Context, req *types.
 	}, err
 }
 
-// BurntNFT checks if an NFT is burnt or not.
+// BurntNFT checks if an NFT is burnt or not, and returns the reason if it is revoked.
 func (qs QueryService) BurntNFT(ctx context.Context, req *types.QueryBurntNFTRequest) (*types.QueryBurntNFTResponse, error) {
-	isBurnt, err := qs.keeper.IsBurnt(sdk.UnwrapSDKContext(ctx), req.ClassId, req.NftId)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	isBurnt, err := qs.keeper.IsBurnt(sdkCtx, req.ClassId, req.NftId)
 	if err != nil {
 		return nil, err
 	}
 
-	return &types.QueryBurntNFTResponse{
-		Burnt: isBurnt,
-	}, nil
+	// the revoked nft isn't marked as burnt, since it might be minted again
+	isRevoked, err := qs.keeper.IsRevoked(sdkCtx, req.ClassId, req.NftId)
+	if err != nil {
+		return nil, err
+	}
+
+	revocation, err := qs.keeper.GetRevocation(sdkCtx, req.ClassId, req.NftId)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &types.QueryBurntNFTResponse{
+		Burnt: isBurnt || isRevoked,
+	}
+	if revocation != nil {
+		res.Revoked = true
+		res.RevocationReason = revocation.Reason
+	}
+
+	return res, nil
 }
 
 // BurntNFTsInClass returns the list of burnt NFTs in a class.
@@ -141,3 +174,64 @@ func (qs QueryService) BurntNFTsInClass(ctx context.Context, req *types.QueryBur
 		NftIds:     list,
 	}, nil
 }
+
+// Listing returns the marketplace listing.
+func (qs QueryService) Listing(ctx context.Context, req *types.QueryListingRequest) (*types.QueryListingResponse, error) {
+	listing, err := qs.keeper.GetListing(sdk.UnwrapSDKContext(ctx), req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryListingResponse{
+		Listing: listing,
+	}, nil
+}
+
+// ListingsByClass returns the marketplace listings of the class.
+func (qs QueryService) ListingsByClass(ctx context.Context, req *types.QueryListingsByClassRequest) (*types.QueryListingsByClassResponse, error) {
+	listings, pageRes, err := qs.keeper.GetListingsByClass(sdk.UnwrapSDKContext(ctx), req.ClassId, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryListingsByClassResponse{
+		Pagination: pageRes,
+		Listings:   listings,
+	}, nil
+}
+
+// ListingsBySeller returns the marketplace listings of the seller.
+func (qs QueryService) ListingsBySeller(ctx context.Context, req *types.QueryListingsBySellerRequest) (*types.QueryListingsBySellerResponse, error) {
+	seller, err := sdk.AccAddressFromBech32(req.Seller)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidInput, "invalid seller account")
+	}
+
+	listings, pageRes, err := qs.keeper.GetListingsBySeller(sdk.UnwrapSDKContext(ctx), seller, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryListingsBySellerResponse{
+		Pagination: pageRes,
+		Listings:   listings,
+	}, nil
+}
+
+// NFTsByOwner returns the NFTs owned by the account together with their state.
+func (qs QueryService) NFTsByOwner(ctx context.Context, req *types.QueryNFTsByOwnerRequest) (*types.QueryNFTsByOwnerResponse, error) {
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidInput, "invalid owner account")
+	}
+
+	nfts, pageRes, err := qs.keeper.GetNFTsByOwner(sdk.UnwrapSDKContext(ctx), owner, req.ClassId, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryNFTsByOwnerResponse{
+		Pagination: pageRes,
+		Nfts:       nfts,
+	}, nil
+}
@@ -23,7 +23,8 @@ func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
 	ir.RegisterRoute(types.ModuleName, BurntNFTInvariantName, BurntNFTInvariant(k))
 }
 
-// FreezingInvariant checks that all frozen NFTs have counterpart on the original Cosmos SDK nft module.
+// FreezingInvariant checks that all frozen NFTs have counterpart on the original Cosmos SDK nft module
+// and that all frozen classes are registered with the freezing feature enabled.
 func FreezingInvariant(k Keeper) sdk.Invariant {
 	return func(ctx sdk.Context) (string, bool) {
 		var (
@@ -57,6 +58,25 @@ func FreezingInvariant(k Keeper) sdk.Invariant {
 			}
 		}
 
+		frozenClassIDs, _, err := k.GetFrozenClasses(ctx, &query.PageRequest{Limit: query.MaxLimit})
+		if err != nil {
+			panic(err)
+		}
+		for _, classID := range frozenClassIDs {
+			classDefinition, err := k.GetClassDefinition(ctx, classID)
+			if types.ErrClassNotFound.Is(err) {
+				violationsCount++
+				msg += fmt.Sprintf("\t class definition not found for frozen class(%s)", classID)
+			} else if err != nil {
+				panic(err)
+			}
+
+			if !classDefinition.IsFeatureEnabled(types.ClassFeature_freezing) {
+				violationsCount++
+				msg += fmt.Sprintf("\t freezing is disabled, but class (%s) is frozen \n", classID)
+			}
+		}
+
 		return sdk.FormatInvariant(
 			types.ModuleName, FreezingInvariantName,
 			fmt.Sprintf("number of invariant violation %d\n%s", violationsCount, msg),
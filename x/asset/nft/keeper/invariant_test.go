@@ -90,6 +90,38 @@ func TestFrozenNFTExistsInvariant(t *testing.T) {
 	requireT.True(isBroken)
 }
 
+func TestFrozenClassInvariant(t *testing.T) {
+	requireT := require.New(t)
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false, tmproto.Header{})
+	assetNFTKeeper := testApp.AssetNFTKeeper
+
+	issuer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	// Issue a class
+	settings := types.IssueClassSettings{
+		Issuer:      issuer,
+		Symbol:      "DEF",
+		Description: "DEF Desc",
+		Features:    []types.ClassFeature{types.ClassFeature_freezing},
+	}
+
+	classID, err := assetNFTKeeper.IssueClass(ctx, settings)
+	requireT.NoError(err)
+
+	err = assetNFTKeeper.ClassFreeze(ctx, issuer, classID)
+	requireT.NoError(err)
+
+	// invariant is valid
+	_, isBroken := keeper.FreezingInvariant(assetNFTKeeper)(ctx)
+	requireT.False(isBroken)
+
+	// non-existing class (invariant is broken)
+	requireT.NoError(assetNFTKeeper.SetClassFrozen(ctx, types.BuildClassID("GHI", issuer), true))
+	_, isBroken = keeper.FreezingInvariant(assetNFTKeeper)(ctx)
+	requireT.True(isBroken)
+}
+
 func TestBurntNFTNotExistsInvariant(t *testing.T) {
 	requireT := require.New(t)
 	testApp := simapp.New()
@@ -97,6 +97,7 @@ func (k Keeper) GetClass(ctx sdk.Context, classID string) (types.Class, error) {
 		Data:        class.Data,
 		Features:    definition.Features,
 		RoyaltyRate: definition.RoyaltyRate,
+		DataEditors: definition.DataEditors,
 	}, nil
 }
 
@@ -133,6 +134,10 @@ func (k Keeper) IssueClass(ctx sdk.Context, settings types.IssueClassSettings) (
 		return "", err
 	}
 
+	if err := types.ValidateDataEditors(settings.Features, settings.DataEditors); err != nil {
+		return "", err
+	}
+
 	id := types.BuildClassID(settings.Symbol, settings.Issuer)
 	if err := types.ValidateData(settings.Data); err != nil {
 		return "", sdkerrors.Wrap(types.ErrInvalidInput, err.Error())
@@ -165,6 +170,7 @@ func (k Keeper) IssueClass(ctx sdk.Context, settings types.IssueClassSettings) (
 		Issuer:      settings.Issuer.String(),
 		Features:    settings.Features,
 		RoyaltyRate: settings.RoyaltyRate,
+		DataEditors: settings.DataEditors,
 	}); err != nil {
 		return "", err
 	}
@@ -179,6 +185,7 @@ func (k Keeper) IssueClass(ctx sdk.Context, settings types.IssueClassSettings) (
 		URIHash:     settings.URIHash,
 		Features:    settings.Features,
 		RoyaltyRate: settings.RoyaltyRate,
+		DataEditors: settings.DataEditors,
 	}); err != nil {
 		return "", sdkerrors.Wrapf(types.ErrInvalidInput, "failed to emit event EventClassIssued: %s", err)
 	}
@@ -312,6 +319,7 @@ func (k Keeper) Mint(ctx sdk.Context, settings types.MintSettings) error {
 		return sdkerrors.Wrapf(types.ErrInvalidInput, "ID %q already defined for the class", settings.ID)
 	}
 
+	// the token revoked by the issuer isn't marked as burnt, so it might be issued again, while its revocation is kept
 	burnt, err := k.IsBurnt(ctx, settings.ClassID, settings.ID)
 	if err != nil {
 		return err
@@ -344,6 +352,60 @@ func (k Keeper) Mint(ctx sdk.Context, settings types.MintSettings) error {
 	return nil
 }
 
+// UpdateData updates the URI, URI hash and data of the non-fungible token. The data might be updated by the issuer
+// and the owner of the token if they are configured as the data editors of the class.
+func (k Keeper) UpdateData(ctx sdk.Context, settings types.UpdateDataSettings) error {
+	if err := types.ValidateData(settings.Data); err != nil {
+		return sdkerrors.Wrap(types.ErrInvalidInput, err.Error())
+	}
+
+	definition, err := k.GetClassDefinition(ctx, settings.ClassID)
+	if err != nil {
+		return err
+	}
+
+	if !definition.IsFeatureEnabled(types.ClassFeature_updatable_data) {
+		return sdkerrors.Wrapf(types.ErrFeatureDisabled, "feature %s is disabled", types.ClassFeature_updatable_data.String())
+	}
+
+	if !k.nftKeeper.HasNFT(ctx, settings.ClassID, settings.ID) {
+		return sdkerrors.Wrapf(types.ErrNFTNotFound, "nft with classID:%s and ID:%s not found", settings.ClassID, settings.ID)
+	}
+
+	isIssuerEditor := definition.IsIssuer(settings.Sender) && definition.IsDataEditor(types.DataEditor_issuer)
+	isOwnerEditor := k.nftKeeper.GetOwner(ctx, settings.ClassID, settings.ID).Equals(settings.Sender) &&
+		definition.IsDataEditor(types.DataEditor_owner)
+	if !isIssuerEditor && !isOwnerEditor {
+		return sdkerrors.Wrapf(cosmoserrors.ErrUnauthorized, "address %q is unauthorized to update the data", settings.Sender.String())
+	}
+
+	if err := k.isNFTDataUpdatable(ctx, settings.ClassID, settings.ID); err != nil {
+		return err
+	}
+
+	if err := k.nftKeeper.Update(ctx, nft.NFT{
+		ClassId: settings.ClassID,
+		Id:      settings.ID,
+		Uri:     settings.URI,
+		UriHash: settings.URIHash,
+		Data:    settings.Data,
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "can't update non-fungible token: %s", err)
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventDataUpdated{
+		ClassId: settings.ClassID,
+		Id:      settings.ID,
+		Editor:  settings.Sender.String(),
+		Uri:     settings.URI,
+		UriHash: settings.URIHash,
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit event EventDataUpdated: %s", err)
+	}
+
+	return nil
+}
+
 // Burn burns non-fungible token.
 func (k Keeper) Burn(ctx sdk.Context, owner sdk.AccAddress, classID, id string) error {
 	ndfd, err := k.GetClassDefinition(ctx, classID)
@@ -381,11 +443,80 @@ func (k Keeper) Burn(ctx sdk.Context, owner sdk.AccAddress, classID, id string)
 	return k.SetBurnt(ctx, classID, id)
 }
 
+// Revoke burns the non-fungible token of the soulbound class from its owner and records the reason of the revocation.
+// Unlike the regular burning, the revoked token isn't marked as burnt, so the issuer might mint it again. The whitelisting
+// of the token is cleaned, so the accounts whitelisted for the revoked token can't receive the token minted again.
+func (k Keeper) Revoke(ctx sdk.Context, sender sdk.AccAddress, classID, id, reason string) error {
+	if err := types.ValidateRevocationReason(reason); err != nil {
+		return err
+	}
+
+	definition, err := k.GetClassDefinition(ctx, classID)
+	if err != nil {
+		return err
+	}
+
+	if !definition.IsFeatureEnabled(types.ClassFeature_soulbound) {
+		return sdkerrors.Wrapf(types.ErrFeatureDisabled, "feature %s is disabled", types.ClassFeature_soulbound.String())
+	}
+
+	if !definition.IsIssuer(sender) {
+		return sdkerrors.Wrap(cosmoserrors.ErrUnauthorized, "only issuer can revoke the nft")
+	}
+
+	if !k.nftKeeper.HasNFT(ctx, classID, id) {
+		return sdkerrors.Wrapf(types.ErrNFTNotFound, "nft with classID:%s and ID:%s not found", classID, id)
+	}
+
+	owner := k.nftKeeper.GetOwner(ctx, classID, id)
+	if owner.Equals(escrowAddress()) {
+		return sdkerrors.Wrapf(cosmoserrors.ErrUnauthorized, "nft with classID:%s and ID:%s is listed", classID, id)
+	}
+
+	if err := k.SetFrozen(ctx, classID, id, false); err != nil {
+		return err
+	}
+
+	if err := k.nftKeeper.Burn(ctx, classID, id); err != nil {
+		return err
+	}
+
+	accounts, err := k.collectWhitelistedAccountsForNFT(ctx, classID, id)
+	if err != nil {
+		return err
+	}
+	for _, account := range accounts {
+		if err := k.SetWhitelisting(ctx, classID, id, account, false); err != nil {
+			return err
+		}
+	}
+
+	if err := k.SetRevocation(ctx, classID, id, reason); err != nil {
+		return err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventRevoked{
+		ClassId: classID,
+		Id:      id,
+		Owner:   owner.String(),
+		Reason:  reason,
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit event EventRevoked: %s", err)
+	}
+
+	return nil
+}
+
 func (k Keeper) checkBurnable(ctx sdk.Context, owner sdk.AccAddress, ndfd types.ClassDefinition, classID, nftID string) error {
 	frozen, err := k.IsFrozen(ctx, classID, nftID)
 	if err != nil && !errors.Is(err, types.ErrFeatureDisabled) {
 		return err
 	}
+	if !frozen {
+		if frozen, err = k.isClassFrozen(ctx, classID); err != nil {
+			return err
+		}
+	}
 
 	// non issuer is not allowed to burn frozen NFT, but the issuer can
 	if frozen && owner.String() != ndfd.Issuer {
@@ -453,6 +584,81 @@ func (k Keeper) SetBurnt(ctx sdk.Context, classID, nftID string) error {
 	return nil
 }
 
+// SetRevocation records the reason of the nft revocation, but does not make any checks
+// should not be used directly outside the module except for genesis.
+// The revocation is kept in its own store, so it isn't removed when the revoked nft is minted again.
+func (k Keeper) SetRevocation(ctx sdk.Context, classID, nftID, reason string) error {
+	bz, err := k.cdc.Marshal(&types.Revocation{Reason: reason})
+	if err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "failed to marshal revocation: %s", err)
+	}
+
+	key, err := types.CreateRevocationKey(classID, nftID)
+	if err != nil {
+		return err
+	}
+	ctx.KVStore(k.storeKey).Set(key, bz)
+	return nil
+}
+
+// GetRevocation returns the latest revocation of the nft, or nil if the nft has never been revoked.
+func (k Keeper) GetRevocation(ctx sdk.Context, classID, nftID string) (*types.Revocation, error) {
+	key, err := types.CreateRevocationKey(classID, nftID)
+	if err != nil {
+		return nil, err
+	}
+
+	bz := ctx.KVStore(k.storeKey).Get(key)
+	if bz == nil {
+		return nil, nil
+	}
+
+	var revocation types.Revocation
+	if err := k.cdc.Unmarshal(bz, &revocation); err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidState, "failed to unmarshal revocation: %s", err)
+	}
+
+	return &revocation, nil
+}
+
+// IsRevoked returns whether the nft has been revoked and hasn't been minted again.
+func (k Keeper) IsRevoked(ctx sdk.Context, classID, nftID string) (bool, error) {
+	revocation, err := k.GetRevocation(ctx, classID, nftID)
+	if err != nil {
+		return false, err
+	}
+
+	return revocation != nil && !k.nftKeeper.HasNFT(ctx, classID, nftID), nil
+}
+
+// GetRevokedNFTs return paginated revoked NFTs.
+func (k Keeper) GetRevokedNFTs(ctx sdk.Context, q *query.PageRequest) ([]types.RevokedNFT, *query.PageResponse, error) {
+	revoked := make([]types.RevokedNFT, 0)
+	pageRes, err := query.Paginate(prefix.NewStore(ctx.KVStore(k.storeKey), types.NFTRevocationKeyPrefix),
+		q, func(key, value []byte) error {
+			var revocation types.Revocation
+			if err := k.cdc.Unmarshal(value, &revocation); err != nil {
+				return sdkerrors.Wrapf(types.ErrInvalidState, "failed to unmarshal revocation: %s", err)
+			}
+			classID, nftID, err := types.ParseRevocationKey(key)
+			if err != nil {
+				return err
+			}
+
+			revoked = append(revoked, types.RevokedNFT{
+				ClassID: classID,
+				NftID:   nftID,
+				Reason:  revocation.Reason,
+			})
+			return nil
+		})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return revoked, pageRes, nil
+}
+
 // GetBurntNFTs return paginated burnt NFTs.
 //
 //nolint:dupl
@@ -568,6 +774,78 @@ func (k Keeper) GetFrozenNFTs(ctx sdk.Context, q *query.PageRequest) ([]types.Fr
 	return frozen, pageRes, nil
 }
 
+// ClassFreeze freezes all the non-fungible tokens of the class.
+func (k Keeper) ClassFreeze(ctx sdk.Context, sender sdk.AccAddress, classID string) error {
+	return k.classFreezeOrUnfreeze(ctx, sender, classID, true)
+}
+
+// ClassUnfreeze unfreezes all the non-fungible tokens of the class.
+func (k Keeper) ClassUnfreeze(ctx sdk.Context, sender sdk.AccAddress, classID string) error {
+	return k.classFreezeOrUnfreeze(ctx, sender, classID, false)
+}
+
+// SetClassFrozen marks the nft class frozen, but does not make any checks
+// should not be used directly outside the module except for genesis.
+func (k Keeper) SetClassFrozen(ctx sdk.Context, classID string, frozen bool) error {
+	key, err := types.CreateClassFreezingKey(classID)
+	if err != nil {
+		return err
+	}
+	s := ctx.KVStore(k.storeKey)
+	if frozen {
+		s.Set(key, types.StoreTrue)
+	} else {
+		s.Delete(key)
+	}
+	return nil
+}
+
+// IsClassFrozen return whether a non-fungible token class is frozen or not.
+func (k Keeper) IsClassFrozen(ctx sdk.Context, classID string) (bool, error) {
+	classDefinition, err := k.GetClassDefinition(ctx, classID)
+	if err != nil {
+		return false, err
+	}
+
+	if !classDefinition.IsFeatureEnabled(types.ClassFeature_freezing) {
+		return false, sdkerrors.Wrapf(types.ErrFeatureDisabled, `feature "freezing" is disabled`)
+	}
+
+	return k.isClassFrozen(ctx, classID)
+}
+
+// GetFrozenClasses return paginated frozen classes.
+func (k Keeper) GetFrozenClasses(ctx sdk.Context, q *query.PageRequest) ([]string, *query.PageResponse, error) {
+	classIDs := make([]string, 0)
+	pageRes, err := query.Paginate(prefix.NewStore(ctx.KVStore(k.storeKey), types.NFTClassFreezingKeyPrefix),
+		q, func(key, value []byte) error {
+			if !bytes.Equal(value, types.StoreTrue) {
+				return sdkerrors.Wrapf(types.ErrInvalidState, "value stored in class freezing store is not %x, value %x", types.StoreTrue, value)
+			}
+			classID, err := types.ParseClassFreezingKey(key)
+			if err != nil {
+				return err
+			}
+
+			classIDs = append(classIDs, classID)
+			return nil
+		})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return classIDs, pageRes, nil
+}
+
+func (k Keeper) isClassFrozen(ctx sdk.Context, classID string) (bool, error) {
+	key, err := types.CreateClassFreezingKey(classID)
+	if err != nil {
+		return false, err
+	}
+
+	return bytes.Equal(ctx.KVStore(k.storeKey).Get(key), types.StoreTrue), nil
+}
+
 // IsWhitelisted checks to see if an account is whitelisted for an NFT.
 func (k Keeper) IsWhitelisted(ctx sdk.Context, classID, nftID string, account sdk.AccAddress) (bool, error) {
 	classDefinition, err := k.GetClassDefinition(ctx, classID)
@@ -750,6 +1028,49 @@ func (k Keeper) GetClassWhitelistedAccounts(ctx sdk.Context, classID string, q *
 	return accounts, pageRes, nil
 }
 
+// GetNFTsByOwner returns the NFTs owned by the account together with their state. If the classID is provided
+// only the NFTs of that class are returned.
+func (k Keeper) GetNFTsByOwner(
+	ctx sdk.Context, owner sdk.AccAddress, classID string, q *query.PageRequest,
+) ([]types.NFTRecord, *query.PageResponse, error) {
+	if classID != "" {
+		if _, _, err := types.DeconstructClassID(classID); err != nil {
+			return nil, nil, sdkerrors.Wrap(types.ErrInvalidInput, err.Error())
+		}
+	}
+
+	// the nft module indexes the NFTs by owner and class, so we use its query to paginate over the index
+	nftsRes, err := k.nftKeeper.NFTs(sdk.WrapSDKContext(ctx), &nft.QueryNFTsRequest{
+		ClassId:    classID,
+		Owner:      owner.String(),
+		Pagination: q,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	classDefinitions := make(map[string]types.ClassDefinition)
+	records := make([]types.NFTRecord, 0, len(nftsRes.Nfts))
+	for _, n := range nftsRes.Nfts {
+		classDefinition, ok := classDefinitions[n.ClassId]
+		if !ok {
+			classDefinition, err = k.GetClassDefinition(ctx, n.ClassId)
+			if err != nil {
+				return nil, nil, err
+			}
+			classDefinitions[n.ClassId] = classDefinition
+		}
+
+		record, err := k.getNFTRecord(ctx, classDefinition, *n)
+		if err != nil {
+			return nil, nil, err
+		}
+		records = append(records, record)
+	}
+
+	return records, nftsRes.Pagination, nil
+}
+
 // AddToWhitelist adds an account to the whitelisted list of accounts for the NFT.
 func (k Keeper) AddToWhitelist(ctx sdk.Context, classID, nftID string, sender, account sdk.AccAddress) error {
 	return k.addToWhitelistOrRemoveFromWhitelist(ctx, classID, nftID, sender, account, true)
@@ -772,16 +1093,38 @@ func (k Keeper) RemoveFromClassWhitelist(ctx sdk.Context, classID string, sender
 
 // SetWhitelisting adds an account to the whitelisting of the NFT, if whitelisting is true
 // and removes it, if whitelisting is false.
+// The number of accounts whitelisted for the NFT is updated accordingly.
 func (k Keeper) SetWhitelisting(ctx sdk.Context, classID, nftID string, account sdk.AccAddress, whitelisting bool) error {
 	key, err := types.CreateWhitelistingKey(classID, nftID, account)
 	if err != nil {
 		return err
 	}
 	s := ctx.KVStore(k.storeKey)
+	// the count is changed only if the whitelisting of the account is changed
+	if s.Has(key) == whitelisting {
+		return nil
+	}
+
+	count, err := k.getWhitelistedAccountsCountForNFT(ctx, classID, nftID)
+	if err != nil {
+		return err
+	}
 	if whitelisting {
 		s.Set(key, types.StoreTrue)
+		count++
 	} else {
 		s.Delete(key)
+		count--
+	}
+
+	countKey, err := types.CreateWhitelistingCountKey(classID, nftID)
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		s.Delete(countKey)
+	} else {
+		s.Set(countKey, sdk.Uint64ToBigEndian(count))
 	}
 	return nil
 }
@@ -803,6 +1146,10 @@ func (k Keeper) SetClassWhitelisting(ctx sdk.Context, classID string, account sd
 }
 
 func (k Keeper) isNFTSendable(ctx sdk.Context, classID, nftID string) error {
+	return k.isNFTSendableBy(ctx, classID, nftID, k.nftKeeper.GetOwner(ctx, classID, nftID))
+}
+
+func (k Keeper) isNFTSendableBy(ctx sdk.Context, classID, nftID string, owner sdk.AccAddress) error {
 	classDefinition, err := k.GetClassDefinition(ctx, classID)
 	// we return nil here, since we want the original tests of the nft module to pass, but they
 	// fail if we return errors for unregistered NFTs on asset. Also the original nft module
@@ -815,12 +1162,12 @@ func (k Keeper) isNFTSendable(ctx sdk.Context, classID, nftID string) error {
 	}
 
 	// always allow issuer to send NFTs issued by them.
-	owner := k.nftKeeper.GetOwner(ctx, classID, nftID)
 	if classDefinition.Issuer == owner.String() {
 		return nil
 	}
 
-	if classDefinition.IsFeatureEnabled(types.ClassFeature_disable_sending) {
+	if classDefinition.IsFeatureEnabled(types.ClassFeature_disable_sending) ||
+		classDefinition.IsFeatureEnabled(types.ClassFeature_soulbound) {
 		return sdkerrors.Wrapf(cosmoserrors.ErrUnauthorized, "nft with classID:%s and ID:%s has sending disabled", classID, nftID)
 	}
 
@@ -834,6 +1181,43 @@ func (k Keeper) isNFTSendable(ctx sdk.Context, classID, nftID string) error {
 	if frozen {
 		return sdkerrors.Wrapf(cosmoserrors.ErrUnauthorized, "nft with classID:%s and ID:%s is frozen", classID, nftID)
 	}
+
+	classFrozen, err := k.isClassFrozen(ctx, classID)
+	if err != nil {
+		return err
+	}
+	if classFrozen {
+		return sdkerrors.Wrapf(cosmoserrors.ErrUnauthorized, "nft class with classID:%s is frozen", classID)
+	}
+	return nil
+}
+
+// isNFTDataUpdatable checks that the token is neither frozen nor escrowed by the listing, so the data of the token
+// is not changed after it is listed or frozen.
+func (k Keeper) isNFTDataUpdatable(ctx sdk.Context, classID, nftID string) error {
+	if k.nftKeeper.GetOwner(ctx, classID, nftID).Equals(escrowAddress()) {
+		return sdkerrors.Wrapf(cosmoserrors.ErrUnauthorized, "nft with classID:%s and ID:%s is listed", classID, nftID)
+	}
+
+	frozen, err := k.IsFrozen(ctx, classID, nftID)
+	if err != nil {
+		if errors.Is(err, types.ErrFeatureDisabled) {
+			return nil
+		}
+		return err
+	}
+	if frozen {
+		return sdkerrors.Wrapf(cosmoserrors.ErrUnauthorized, "nft with classID:%s and ID:%s is frozen", classID, nftID)
+	}
+
+	classFrozen, err := k.isClassFrozen(ctx, classID)
+	if err != nil {
+		return err
+	}
+	if classFrozen {
+		return sdkerrors.Wrapf(cosmoserrors.ErrUnauthorized, "nft class with classID:%s is frozen", classID)
+	}
+
 	return nil
 }
 
@@ -913,6 +1297,38 @@ func (k Keeper) freezeOrUnfreeze(ctx sdk.Context, sender sdk.AccAddress, classID
 	return nil
 }
 
+func (k Keeper) classFreezeOrUnfreeze(ctx sdk.Context, sender sdk.AccAddress, classID string, setFrozen bool) error {
+	classDefinition, err := k.GetClassDefinition(ctx, classID)
+	if err != nil {
+		return err
+	}
+
+	if err = classDefinition.CheckFeatureAllowed(sender, types.ClassFeature_freezing); err != nil {
+		return err
+	}
+
+	if err := k.SetClassFrozen(ctx, classID, setFrozen); err != nil {
+		return err
+	}
+
+	var event proto.Message
+	if setFrozen {
+		event = &types.EventClassFrozen{
+			ClassId: classID,
+		}
+	} else {
+		event = &types.EventClassUnfrozen{
+			ClassId: classID,
+		}
+	}
+
+	if err = ctx.EventManager().EmitTypedEvent(event); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit event: %v, err: %s", event, err)
+	}
+
+	return nil
+}
+
 func (k Keeper) addToWhitelistOrRemoveFromWhitelistClass(ctx sdk.Context, classID string, sender, account sdk.AccAddress, setWhitelisted bool) error {
 	classDefinition, err := k.GetClassDefinition(ctx, classID)
 	if err != nil {
@@ -994,3 +1410,71 @@ func (k Keeper) addToWhitelistOrRemoveFromWhitelist(ctx sdk.Context, classID, nf
 
 	return nil
 }
+
+func (k Keeper) getNFTRecord(ctx sdk.Context, classDefinition types.ClassDefinition, n nft.NFT) (types.NFTRecord, error) {
+	record := types.NFTRecord{
+		ClassId:       n.ClassId,
+		Id:            n.Id,
+		URI:           n.Uri,
+		URIHash:       n.UriHash,
+		Data:          n.Data,
+		ClassFeatures: classDefinition.Features,
+	}
+
+	if classDefinition.IsFeatureEnabled(types.ClassFeature_freezing) {
+		key, err := types.CreateFreezingKey(n.ClassId, n.Id)
+		if err != nil {
+			return types.NFTRecord{}, err
+		}
+		record.Frozen = bytes.Equal(ctx.KVStore(k.storeKey).Get(key), types.StoreTrue)
+
+		classFrozen, err := k.isClassFrozen(ctx, n.ClassId)
+		if err != nil {
+			return types.NFTRecord{}, err
+		}
+		record.ClassFrozen = classFrozen
+	}
+
+	if classDefinition.IsFeatureEnabled(types.ClassFeature_whitelisting) {
+		count, err := k.getWhitelistedAccountsCountForNFT(ctx, n.ClassId, n.Id)
+		if err != nil {
+			return types.NFTRecord{}, err
+		}
+		record.WhitelistedAccountsCount = count
+	}
+
+	return record, nil
+}
+
+func (k Keeper) collectWhitelistedAccountsForNFT(ctx sdk.Context, classID, nftID string) ([]sdk.AccAddress, error) {
+	compositeKey, err := store.JoinKeysWithLength([]byte(classID), []byte(nftID))
+	if err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidKey, "failed to create a composite key for nft, err: %s", err)
+	}
+
+	iterator := prefix.NewStore(
+		ctx.KVStore(k.storeKey), store.JoinKeys(types.NFTWhitelistingKeyPrefix, compositeKey),
+	).Iterator(nil, nil)
+	defer iterator.Close()
+
+	accounts := make([]sdk.AccAddress, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		accounts = append(accounts, sdk.AccAddress(iterator.Key()[1:])) // the first byte contains the length prefix
+	}
+
+	return accounts, nil
+}
+
+func (k Keeper) getWhitelistedAccountsCountForNFT(ctx sdk.Context, classID, nftID string) (uint64, error) {
+	key, err := types.CreateWhitelistingCountKey(classID, nftID)
+	if err != nil {
+		return 0, err
+	}
+
+	bz := ctx.KVStore(k.storeKey).Get(key)
+	if bz == nil {
+		return 0, nil
+	}
+
+	return sdk.BigEndianToUint64(bz), nil
+}
@@ -4,6 +4,7 @@ import (
 	"sort"
 	"strings"
 	"testing"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
@@ -330,6 +331,183 @@ func TestKeeper_MintWithRecipientAndWhitelisting(t *testing.T) {
 	requireT.Equal(randomAddr, testApp.NFTKeeper.GetOwner(ctx, classID, settings.ID))
 }
 
+func TestKeeper_UpdateData(t *testing.T) {
+	requireT := require.New(t)
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false, tmproto.Header{})
+	nftKeeper := testApp.AssetNFTKeeper
+
+	requireT.NoError(nftKeeper.SetParams(ctx, types.Params{
+		MintFee: sdk.NewCoin(constant.DenomDev, sdkmath.ZeroInt()),
+	}))
+
+	issuer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	owner := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	// issuing the class with the data editors but without the feature fails
+	_, err := nftKeeper.IssueClass(ctx, types.IssueClassSettings{
+		Issuer:      issuer,
+		Symbol:      "symbol",
+		DataEditors: []types.DataEditor{types.DataEditor_owner},
+	})
+	requireT.ErrorIs(err, types.ErrInvalidInput)
+
+	classID, err := nftKeeper.IssueClass(ctx, types.IssueClassSettings{
+		Issuer:      issuer,
+		Symbol:      "symbol",
+		Features:    []types.ClassFeature{types.ClassFeature_updatable_data},
+		DataEditors: []types.DataEditor{types.DataEditor_owner},
+	})
+	requireT.NoError(err)
+
+	class, err := nftKeeper.GetClass(ctx, classID)
+	requireT.NoError(err)
+	requireT.Equal([]types.DataEditor{types.DataEditor_owner}, class.DataEditors)
+
+	nonUpdatableClassID, err := nftKeeper.IssueClass(ctx, types.IssueClassSettings{
+		Issuer: issuer,
+		Symbol: "static",
+	})
+	requireT.NoError(err)
+
+	for _, id := range []string{classID, nonUpdatableClassID} {
+		requireT.NoError(nftKeeper.Mint(ctx, types.MintSettings{
+			Sender:    issuer,
+			Recipient: owner,
+			ClassID:   id,
+			ID:        "my-id",
+			URI:       "https://my-nft-meta.invalid/1",
+			URIHash:   "content-hash",
+			Data:      genNFTData(requireT),
+		}))
+	}
+
+	settings := types.UpdateDataSettings{
+		Sender:  owner,
+		ClassID: classID,
+		ID:      "my-id",
+		URI:     "https://my-nft-meta.invalid/2",
+		URIHash: "content-hash-2",
+		Data:    genNFTData(requireT),
+	}
+
+	// update of the class without the feature fails
+	nonUpdatableSettings := settings
+	nonUpdatableSettings.ClassID = nonUpdatableClassID
+	err = nftKeeper.UpdateData(ctx, nonUpdatableSettings)
+	requireT.ErrorIs(err, types.ErrFeatureDisabled)
+
+	// update of the non-existing nft fails
+	nonExistingSettings := settings
+	nonExistingSettings.ID = "non-existing"
+	err = nftKeeper.UpdateData(ctx, nonExistingSettings)
+	requireT.ErrorIs(err, types.ErrNFTNotFound)
+
+	// update by the issuer fails since only the owner is the data editor
+	issuerSettings := settings
+	issuerSettings.Sender = issuer
+	err = nftKeeper.UpdateData(ctx, issuerSettings)
+	requireT.ErrorIs(err, cosmoserrors.ErrUnauthorized)
+
+	// update by the owner succeeds
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	requireT.NoError(nftKeeper.UpdateData(ctx, settings))
+
+	nft, found := testApp.NFTKeeper.GetNFT(ctx, classID, settings.ID)
+	requireT.True(found)
+	requireT.Equal(settings.URI, nft.Uri)
+	requireT.Equal(settings.URIHash, nft.UriHash)
+	requireT.Equal(string(settings.Data.Value), string(nft.Data.Value))
+
+	updatedEvents, err := event.FindTypedEvents[*types.EventDataUpdated](ctx.EventManager().Events().ToABCIEvents())
+	requireT.NoError(err)
+	requireT.Equal([]*types.EventDataUpdated{{
+		ClassId: classID,
+		Id:      settings.ID,
+		Editor:  owner.String(),
+		Uri:     settings.URI,
+		UriHash: settings.URIHash,
+	}}, updatedEvents)
+
+	// once the nft is sent the previous owner can't update the data anymore
+	recipient := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	requireT.NoError(testApp.NFTKeeper.Transfer(ctx, classID, settings.ID, recipient))
+	err = nftKeeper.UpdateData(ctx, settings)
+	requireT.ErrorIs(err, cosmoserrors.ErrUnauthorized)
+
+	settings.Sender = recipient
+	requireT.NoError(nftKeeper.UpdateData(ctx, settings))
+}
+
+func TestKeeper_UpdateData_FrozenAndListed(t *testing.T) {
+	requireT := require.New(t)
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false, tmproto.Header{Time: time.Now()})
+	nftKeeper := testApp.AssetNFTKeeper
+
+	requireT.NoError(nftKeeper.SetParams(ctx, types.Params{
+		MintFee:                       sdk.NewCoin(constant.DenomDev, sdkmath.ZeroInt()),
+		MaxAuctionSettlementsPerBlock: types.DefaultMaxAuctionSettlementsPerBlock,
+	}))
+
+	issuer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	owner := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	classID, err := nftKeeper.IssueClass(ctx, types.IssueClassSettings{
+		Issuer: issuer,
+		Symbol: "symbol",
+		Features: []types.ClassFeature{
+			types.ClassFeature_updatable_data,
+			types.ClassFeature_freezing,
+		},
+		DataEditors: []types.DataEditor{types.DataEditor_issuer},
+	})
+	requireT.NoError(err)
+
+	requireT.NoError(nftKeeper.Mint(ctx, types.MintSettings{
+		Sender:    issuer,
+		Recipient: owner,
+		ClassID:   classID,
+		ID:        "my-id",
+		URI:       "https://my-nft-meta.invalid/1",
+		URIHash:   "content-hash",
+		Data:      genNFTData(requireT),
+	}))
+
+	settings := types.UpdateDataSettings{
+		Sender:  issuer,
+		ClassID: classID,
+		ID:      "my-id",
+		URI:     "https://my-nft-meta.invalid/2",
+		URIHash: "content-hash-2",
+		Data:    genNFTData(requireT),
+	}
+
+	// the data of the frozen nft can't be updated
+	requireT.NoError(nftKeeper.Freeze(ctx, issuer, classID, settings.ID))
+	requireT.ErrorIs(nftKeeper.UpdateData(ctx, settings), cosmoserrors.ErrUnauthorized)
+	requireT.NoError(nftKeeper.Unfreeze(ctx, issuer, classID, settings.ID))
+
+	// the data of the nft of the frozen class can't be updated
+	requireT.NoError(nftKeeper.ClassFreeze(ctx, issuer, classID))
+	requireT.ErrorIs(nftKeeper.UpdateData(ctx, settings), cosmoserrors.ErrUnauthorized)
+	requireT.NoError(nftKeeper.ClassUnfreeze(ctx, issuer, classID))
+
+	// the data of the listed nft can't be updated
+	listingID, err := nftKeeper.ListNFT(ctx, types.ListingSettings{
+		Seller:  owner,
+		ClassID: classID,
+		ID:      settings.ID,
+		Type:    types.ListingType_fixed_price,
+		Price:   sdk.NewInt64Coin(constant.DenomDev, 100),
+	})
+	requireT.NoError(err)
+	requireT.ErrorIs(nftKeeper.UpdateData(ctx, settings), cosmoserrors.ErrUnauthorized)
+	requireT.NoError(nftKeeper.CancelListing(ctx, owner, listingID))
+
+	requireT.NoError(nftKeeper.UpdateData(ctx, settings))
+}
+
 func TestKeeper_Burn(t *testing.T) {
 	requireT := require.New(t)
 	testApp := simapp.New()
@@ -601,6 +779,177 @@ func TestKeeper_DisableSending(t *testing.T) {
 	requireT.ErrorIs(err, cosmoserrors.ErrUnauthorized)
 }
 
+func TestKeeper_Soulbound(t *testing.T) {
+	requireT := require.New(t)
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false, tmproto.Header{})
+	assetNFTKeeper := testApp.AssetNFTKeeper
+	nftKeeper := testApp.NFTKeeper
+	queryService := keeper.NewQueryService(assetNFTKeeper)
+
+	requireT.NoError(assetNFTKeeper.SetParams(ctx, types.Params{
+		MintFee: sdk.NewInt64Coin(constant.DenomDev, 0),
+	}))
+
+	issuer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	owner := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	classID, err := assetNFTKeeper.IssueClass(ctx, types.IssueClassSettings{
+		Issuer:   issuer,
+		Symbol:   "symbol",
+		Features: []types.ClassFeature{types.ClassFeature_soulbound, types.ClassFeature_burning},
+	})
+	requireT.NoError(err)
+
+	regularClassID, err := assetNFTKeeper.IssueClass(ctx, types.IssueClassSettings{
+		Issuer: issuer,
+		Symbol: "regular",
+	})
+	requireT.NoError(err)
+
+	for _, id := range []string{"nft-1", "nft-2"} {
+		requireT.NoError(assetNFTKeeper.Mint(ctx, types.MintSettings{
+			Sender:    issuer,
+			Recipient: owner,
+			ClassID:   classID,
+			ID:        id,
+		}))
+	}
+	requireT.NoError(assetNFTKeeper.Mint(ctx, types.MintSettings{
+		Sender:    issuer,
+		Recipient: owner,
+		ClassID:   regularClassID,
+		ID:        "nft-1",
+	}))
+
+	// the owner can't send the nft
+	recipient := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	err = nftKeeper.Transfer(ctx, classID, "nft-1", recipient)
+	requireT.ErrorIs(err, cosmoserrors.ErrUnauthorized)
+
+	// revoking the nft of the class without the feature fails
+	err = assetNFTKeeper.Revoke(ctx, issuer, regularClassID, "nft-1", "expired")
+	requireT.ErrorIs(err, types.ErrFeatureDisabled)
+
+	// revoking by the non-issuer fails
+	err = assetNFTKeeper.Revoke(ctx, owner, classID, "nft-1", "expired")
+	requireT.ErrorIs(err, cosmoserrors.ErrUnauthorized)
+
+	// revoking the non-existing nft fails
+	err = assetNFTKeeper.Revoke(ctx, issuer, classID, "nft-3", "expired")
+	requireT.ErrorIs(err, types.ErrNFTNotFound)
+
+	// revoking without the reason fails
+	err = assetNFTKeeper.Revoke(ctx, issuer, classID, "nft-1", "")
+	requireT.ErrorIs(err, types.ErrInvalidInput)
+
+	// whitelist the accounts for the nft to check that the whitelisting is cleaned on revocation
+	for _, account := range []sdk.AccAddress{owner, recipient} {
+		requireT.NoError(assetNFTKeeper.SetWhitelisting(ctx, classID, "nft-1", account, true))
+	}
+
+	// revoke
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	requireT.NoError(assetNFTKeeper.Revoke(ctx, issuer, classID, "nft-1", "expired"))
+	requireT.False(nftKeeper.HasNFT(ctx, classID, "nft-1"))
+
+	revokedEvents, err := event.FindTypedEvents[*types.EventRevoked](ctx.EventManager().Events().ToABCIEvents())
+	requireT.NoError(err)
+	requireT.Equal([]*types.EventRevoked{{
+		ClassId: classID,
+		Id:      "nft-1",
+		Owner:   owner.String(),
+		Reason:  "expired",
+	}}, revokedEvents)
+
+	burntRes, err := queryService.BurntNFT(sdk.WrapSDKContext(ctx), &types.QueryBurntNFTRequest{
+		ClassId: classID,
+		NftId:   "nft-1",
+	})
+	requireT.NoError(err)
+	requireT.Equal(&types.QueryBurntNFTResponse{
+		Burnt:            true,
+		Revoked:          true,
+		RevocationReason: "expired",
+	}, burntRes)
+
+	// the nft burnt by the owner isn't revoked
+	requireT.NoError(assetNFTKeeper.Burn(ctx, owner, classID, "nft-2"))
+	burntRes, err = queryService.BurntNFT(sdk.WrapSDKContext(ctx), &types.QueryBurntNFTRequest{
+		ClassId: classID,
+		NftId:   "nft-2",
+	})
+	requireT.NoError(err)
+	requireT.Equal(&types.QueryBurntNFTResponse{Burnt: true}, burntRes)
+
+	// the revoked nft isn't marked as burnt
+	_, burntIDs, err := assetNFTKeeper.GetBurntByClass(ctx, classID, nil)
+	requireT.NoError(err)
+	requireT.ElementsMatch([]string{"nft-2"}, burntIDs)
+
+	// the nft burnt by the owner can't be minted again, but the revoked one can
+	err = assetNFTKeeper.Mint(ctx, types.MintSettings{
+		Sender:    issuer,
+		Recipient: owner,
+		ClassID:   classID,
+		ID:        "nft-2",
+	})
+	requireT.ErrorIs(err, types.ErrInvalidInput)
+
+	requireT.NoError(assetNFTKeeper.Mint(ctx, types.MintSettings{
+		Sender:    issuer,
+		Recipient: owner,
+		ClassID:   classID,
+		ID:        "nft-1",
+	}))
+	requireT.Equal(owner, nftKeeper.GetOwner(ctx, classID, "nft-1"))
+
+	// the whitelisting of the revoked nft is removed
+	whitelisted, _, err := assetNFTKeeper.GetWhitelistedAccountsForNFT(ctx, classID, "nft-1", nil)
+	requireT.NoError(err)
+	requireT.Empty(whitelisted)
+
+	// the revocation is kept after the nft is minted again
+	burntRes, err = queryService.BurntNFT(sdk.WrapSDKContext(ctx), &types.QueryBurntNFTRequest{
+		ClassId: classID,
+		NftId:   "nft-1",
+	})
+	requireT.NoError(err)
+	requireT.Equal(&types.QueryBurntNFTResponse{
+		Revoked:          true,
+		RevocationReason: "expired",
+	}, burntRes)
+
+	// the minted again nft burnt by the owner can't be minted anymore
+	requireT.NoError(assetNFTKeeper.Burn(ctx, owner, classID, "nft-1"))
+	err = assetNFTKeeper.Mint(ctx, types.MintSettings{
+		Sender:    issuer,
+		Recipient: owner,
+		ClassID:   classID,
+		ID:        "nft-1",
+	})
+	requireT.ErrorIs(err, types.ErrInvalidInput)
+
+	burntRes, err = queryService.BurntNFT(sdk.WrapSDKContext(ctx), &types.QueryBurntNFTRequest{
+		ClassId: classID,
+		NftId:   "nft-1",
+	})
+	requireT.NoError(err)
+	requireT.Equal(&types.QueryBurntNFTResponse{
+		Burnt:            true,
+		Revoked:          true,
+		RevocationReason: "expired",
+	}, burntRes)
+
+	revokedNFTs, _, err := assetNFTKeeper.GetRevokedNFTs(ctx, nil)
+	requireT.NoError(err)
+	requireT.Equal([]types.RevokedNFT{{
+		ClassID: classID,
+		NftID:   "nft-1",
+		Reason:  "expired",
+	}}, revokedNFTs)
+}
+
 func TestKeeper_Freeze(t *testing.T) {
 	requireT := require.New(t)
 	testApp := simapp.New()
@@ -743,6 +1092,128 @@ func TestKeeper_Freeze_Nonexistent(t *testing.T) {
 	requireT.True(types.ErrNFTNotFound.Is(err))
 }
 
+func TestKeeper_ClassFreeze(t *testing.T) {
+	requireT := require.New(t)
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false, tmproto.Header{})
+	assetNFTKeeper := testApp.AssetNFTKeeper
+	nftKeeper := testApp.NFTKeeper
+
+	nftParams := types.Params{
+		MintFee: sdk.NewInt64Coin(constant.DenomDev, 0),
+	}
+	requireT.NoError(assetNFTKeeper.SetParams(ctx, nftParams))
+
+	issuer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	classSettings := types.IssueClassSettings{
+		Issuer: issuer,
+		Symbol: "symbol",
+		Features: []types.ClassFeature{
+			types.ClassFeature_burning,
+			types.ClassFeature_freezing,
+		},
+	}
+
+	classID, err := assetNFTKeeper.IssueClass(ctx, classSettings)
+	requireT.NoError(err)
+
+	recipient := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	settings := types.MintSettings{
+		Sender:    issuer,
+		Recipient: recipient,
+		ClassID:   classID,
+		ID:        "my-id",
+		URI:       "https://my-nft-meta.invalid/1",
+		URIHash:   "content-hash",
+	}
+
+	// mint NFT
+	requireT.NoError(assetNFTKeeper.Mint(ctx, settings))
+
+	// try to freeze the class by non-issuer
+	err = assetNFTKeeper.ClassFreeze(ctx, recipient, classID)
+	requireT.Error(err)
+	requireT.True(cosmoserrors.ErrUnauthorized.Is(err))
+
+	// freeze the class
+	requireT.NoError(assetNFTKeeper.ClassFreeze(ctx, issuer, classID))
+	isClassFrozen, err := assetNFTKeeper.IsClassFrozen(ctx, classID)
+	requireT.NoError(err)
+	requireT.True(isClassFrozen)
+
+	// the NFT itself is not frozen
+	isFrozen, err := assetNFTKeeper.IsFrozen(ctx, classID, settings.ID)
+	requireT.NoError(err)
+	requireT.False(isFrozen)
+
+	// transfer from non-issuer (must fail)
+	recipient2 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	err = nftKeeper.Transfer(ctx, classID, settings.ID, recipient2)
+	requireT.Error(err)
+	requireT.True(cosmoserrors.ErrUnauthorized.Is(err))
+
+	// burn by non-issuer (must fail)
+	err = assetNFTKeeper.Burn(ctx, recipient, classID, settings.ID)
+	requireT.Error(err)
+	requireT.True(cosmoserrors.ErrUnauthorized.Is(err))
+
+	// mint NFT to the issuer after the class is frozen
+	settings2 := settings
+	settings2.ID = "my-id-2"
+	settings2.Recipient = issuer
+	requireT.NoError(assetNFTKeeper.Mint(ctx, settings2))
+
+	// transfer from issuer (although the class is frozen, the issuer can send)
+	requireT.NoError(nftKeeper.Transfer(ctx, classID, settings2.ID, recipient))
+
+	// transfer of the newly minted NFT from non-issuer (must fail)
+	err = nftKeeper.Transfer(ctx, classID, settings2.ID, recipient2)
+	requireT.Error(err)
+	requireT.True(cosmoserrors.ErrUnauthorized.Is(err))
+
+	// unfreeze the class
+	requireT.NoError(assetNFTKeeper.ClassUnfreeze(ctx, issuer, classID))
+	isClassFrozen, err = assetNFTKeeper.IsClassFrozen(ctx, classID)
+	requireT.NoError(err)
+	requireT.False(isClassFrozen)
+
+	// transfer from non-issuer (must succeed)
+	requireT.NoError(nftKeeper.Transfer(ctx, classID, settings.ID, recipient2))
+	requireT.NoError(nftKeeper.Transfer(ctx, classID, settings2.ID, recipient2))
+}
+
+func TestKeeper_ClassFreeze_Unfreezable(t *testing.T) {
+	requireT := require.New(t)
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false, tmproto.Header{})
+	assetNFTKeeper := testApp.AssetNFTKeeper
+
+	issuer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	// try to freeze the class when it does not exist
+	err := assetNFTKeeper.ClassFreeze(ctx, issuer, types.BuildClassID("symbol", issuer))
+	requireT.Error(err)
+	requireT.True(types.ErrClassNotFound.Is(err))
+
+	classSettings := types.IssueClassSettings{
+		Issuer:   issuer,
+		Symbol:   "symbol",
+		Features: []types.ClassFeature{},
+	}
+
+	classID, err := assetNFTKeeper.IssueClass(ctx, classSettings)
+	requireT.NoError(err)
+
+	// freeze the class
+	err = assetNFTKeeper.ClassFreeze(ctx, issuer, classID)
+	requireT.Error(err)
+	requireT.True(types.ErrFeatureDisabled.Is(err))
+
+	_, err = assetNFTKeeper.IsClassFrozen(ctx, classID)
+	requireT.Error(err)
+	requireT.True(types.ErrFeatureDisabled.Is(err))
+}
+
 func TestKeeper_Whitelist(t *testing.T) {
 	requireT := require.New(t)
 	testApp := simapp.New()
@@ -1124,3 +1595,108 @@ func assertWhitelisting(t *testing.T, ctx sdk.Context, k keeper.Keeper, classID,
 	require.NoError(t, err)
 	require.EqualValues(t, isWhitelisted, expectedWhitelisting)
 }
+
+func TestKeeper_GetNFTsByOwner(t *testing.T) {
+	requireT := require.New(t)
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false, tmproto.Header{})
+	assetNFTKeeper := testApp.AssetNFTKeeper
+
+	nftParams := types.Params{
+		MintFee: sdk.NewInt64Coin(constant.DenomDev, 0),
+	}
+	requireT.NoError(assetNFTKeeper.SetParams(ctx, nftParams))
+
+	issuer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	owner := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	classID1, err := assetNFTKeeper.IssueClass(ctx, types.IssueClassSettings{
+		Issuer: issuer,
+		Symbol: "symbol1",
+		Features: []types.ClassFeature{
+			types.ClassFeature_freezing,
+			types.ClassFeature_whitelisting,
+		},
+	})
+	requireT.NoError(err)
+	classID2, err := assetNFTKeeper.IssueClass(ctx, types.IssueClassSettings{
+		Issuer: issuer,
+		Symbol: "symbol2",
+	})
+	requireT.NoError(err)
+
+	for _, settings := range []types.MintSettings{
+		{Sender: issuer, Recipient: issuer, ClassID: classID1, ID: "id1", URI: "https://my-nft-meta.invalid/1"},
+		{Sender: issuer, Recipient: issuer, ClassID: classID1, ID: "id2"},
+		{Sender: issuer, Recipient: issuer, ClassID: classID2, ID: "id3"},
+		{Sender: issuer, Recipient: owner, ClassID: classID2, ID: "id4"},
+	} {
+		requireT.NoError(assetNFTKeeper.Mint(ctx, settings))
+	}
+
+	requireT.NoError(assetNFTKeeper.Freeze(ctx, issuer, classID1, "id1"))
+	accounts := make([]sdk.AccAddress, 0, 3)
+	for i := 0; i < 3; i++ {
+		account := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+		requireT.NoError(assetNFTKeeper.AddToWhitelist(ctx, classID1, "id2", issuer, account))
+		accounts = append(accounts, account)
+	}
+	// whitelisting the account again and removing the account, which isn't whitelisted, don't change the count
+	requireT.NoError(assetNFTKeeper.AddToWhitelist(ctx, classID1, "id2", issuer, accounts[0]))
+	requireT.NoError(assetNFTKeeper.RemoveFromWhitelist(ctx, classID1, "id2", issuer, owner))
+	requireT.NoError(assetNFTKeeper.RemoveFromWhitelist(ctx, classID1, "id2", issuer, accounts[1]))
+	// the class whitelisting is not counted
+	requireT.NoError(assetNFTKeeper.AddToClassWhitelist(ctx, classID1, issuer, owner))
+
+	// all NFTs of the issuer
+	records, _, err := assetNFTKeeper.GetNFTsByOwner(ctx, issuer, "", &query.PageRequest{Limit: query.MaxLimit})
+	requireT.NoError(err)
+	sort.Slice(records, func(i, j int) bool {
+		return records[i].Id < records[j].Id
+	})
+	requireT.Equal([]types.NFTRecord{
+		{
+			ClassId:       classID1,
+			Id:            "id1",
+			URI:           "https://my-nft-meta.invalid/1",
+			Frozen:        true,
+			ClassFeatures: []types.ClassFeature{types.ClassFeature_freezing, types.ClassFeature_whitelisting},
+		},
+		{
+			ClassId:                  classID1,
+			Id:                       "id2",
+			WhitelistedAccountsCount: 2,
+			ClassFeatures:            []types.ClassFeature{types.ClassFeature_freezing, types.ClassFeature_whitelisting},
+		},
+		{
+			ClassId: classID2,
+			Id:      "id3",
+		},
+	}, records)
+
+	// NFTs of the issuer filtered by class
+	records, _, err = assetNFTKeeper.GetNFTsByOwner(ctx, issuer, classID2, &query.PageRequest{Limit: query.MaxLimit})
+	requireT.NoError(err)
+	requireT.Len(records, 1)
+	requireT.Equal("id3", records[0].Id)
+
+	// pagination
+	records, pageRes, err := assetNFTKeeper.GetNFTsByOwner(ctx, issuer, "", &query.PageRequest{Limit: 2})
+	requireT.NoError(err)
+	requireT.Len(records, 2)
+	requireT.NotNil(pageRes.NextKey)
+	records, pageRes, err = assetNFTKeeper.GetNFTsByOwner(ctx, issuer, "", &query.PageRequest{Key: pageRes.NextKey})
+	requireT.NoError(err)
+	requireT.Len(records, 1)
+	requireT.Nil(pageRes.NextKey)
+
+	// NFTs of another owner
+	records, _, err = assetNFTKeeper.GetNFTsByOwner(ctx, owner, "", &query.PageRequest{Limit: query.MaxLimit})
+	requireT.NoError(err)
+	requireT.Len(records, 1)
+	requireT.Equal("id4", records[0].Id)
+
+	// invalid class ID
+	_, _, err = assetNFTKeeper.GetNFTsByOwner(ctx, issuer, "invalid", &query.PageRequest{Limit: query.MaxLimit})
+	requireT.ErrorIs(err, types.ErrInvalidInput)
+}
@@ -0,0 +1,576 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	sdkerrors "cosmossdk.io/errors"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/CoreumFoundation/coreum/v3/x/asset/nft/types"
+)
+
+// ListNFT puts the non-fungible token on sale on the marketplace.
+// The token is kept by the module until it is sold or the listing is cancelled.
+func (k Keeper) ListNFT(ctx sdk.Context, settings types.ListingSettings) (uint64, error) {
+	if err := types.ValidateListingTerms(settings.Type, settings.Price, settings.AuctionDuration); err != nil {
+		return 0, err
+	}
+
+	if _, err := k.GetClassDefinition(ctx, settings.ClassID); err != nil {
+		return 0, err
+	}
+
+	if !k.nftKeeper.HasNFT(ctx, settings.ClassID, settings.ID) {
+		return 0, sdkerrors.Wrapf(types.ErrNFTNotFound, "nft with classID:%s and ID:%s not found", settings.ClassID, settings.ID)
+	}
+
+	owner := k.nftKeeper.GetOwner(ctx, settings.ClassID, settings.ID)
+	if !owner.Equals(settings.Seller) {
+		return 0, sdkerrors.Wrapf(cosmoserrors.ErrUnauthorized, "%s is not the owner of nft %s", settings.Seller, settings.ID)
+	}
+
+	if err := k.isNFTSendableBy(ctx, settings.ClassID, settings.ID, settings.Seller); err != nil {
+		return 0, err
+	}
+
+	listingID := k.nextListingID(ctx)
+	listing := types.Listing{
+		ID:         listingID,
+		ClassID:    settings.ClassID,
+		NftID:      settings.ID,
+		Seller:     settings.Seller.String(),
+		Type:       settings.Type,
+		Price:      settings.Price,
+		HighestBid: sdk.NewCoin(settings.Price.Denom, sdk.ZeroInt()),
+	}
+	if settings.Type == types.ListingType_auction {
+		listing.EndTime = ctx.BlockTime().Add(settings.AuctionDuration)
+	}
+
+	// the escrow account is not whitelisted by the issuer, so the original transfer is used
+	if err := k.nftKeeper.Transfer(ctx, settings.ClassID, settings.ID, escrowAddress()); err != nil {
+		return 0, sdkerrors.Wrapf(types.ErrInvalidState, "failed to escrow the nft: %s", err)
+	}
+
+	if err := k.ImportListing(ctx, listing); err != nil {
+		return 0, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventNFTListed{
+		Listing: listing,
+	}); err != nil {
+		return 0, sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit EventNFTListed event: %s", err)
+	}
+
+	return listingID, nil
+}
+
+// BuyNFT buys the non-fungible token listed for the fixed price.
+func (k Keeper) BuyNFT(ctx sdk.Context, buyer sdk.AccAddress, listingID uint64) error {
+	listing, err := k.GetListing(ctx, listingID)
+	if err != nil {
+		return err
+	}
+
+	if listing.Type != types.ListingType_fixed_price {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "listing %d is not a fixed-price listing", listingID)
+	}
+
+	seller := sdk.MustAccAddressFromBech32(listing.Seller)
+	if buyer.Equals(seller) {
+		return sdkerrors.Wrap(types.ErrInvalidInput, "seller can't buy the listed nft")
+	}
+
+	if err := k.checkNFTTradable(ctx, listing, seller, buyer); err != nil {
+		return err
+	}
+
+	royalty, err := k.payForNFT(ctx, listing, seller, func(recipient sdk.AccAddress, amount sdk.Coins) error {
+		return k.bankKeeper.SendCoins(ctx, buyer, recipient, amount)
+	})
+	if err != nil {
+		return err
+	}
+
+	return k.completeSale(ctx, listing, buyer, listing.Price, royalty)
+}
+
+// PlaceBid places a bid on the non-fungible token listed on the auction.
+// The bid amount is kept by the module, and the previous highest bid is returned to its bidder.
+func (k Keeper) PlaceBid(ctx sdk.Context, bidder sdk.AccAddress, listingID uint64, amount sdk.Coin) error {
+	listing, err := k.GetListing(ctx, listingID)
+	if err != nil {
+		return err
+	}
+
+	if listing.Type != types.ListingType_auction {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "listing %d is not an auction", listingID)
+	}
+
+	if !ctx.BlockTime().Before(listing.EndTime) {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "auction %d has ended", listingID)
+	}
+
+	if bidder.String() == listing.Seller {
+		return sdkerrors.Wrap(types.ErrInvalidInput, "seller can't bid on the listed nft")
+	}
+
+	if amount.Denom != listing.Price.Denom {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "bid denom must be %s", listing.Price.Denom)
+	}
+
+	if listing.HasBid() {
+		if !listing.HighestBid.IsLT(amount) {
+			return sdkerrors.Wrapf(types.ErrInvalidInput, "bid must be greater than the highest bid %s", listing.HighestBid)
+		}
+	} else if amount.IsLT(listing.Price) {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "bid must not be less than the minimal bid %s", listing.Price)
+	}
+
+	if err := k.isNFTReceivable(ctx, listing.ClassID, listing.NftID, bidder); err != nil {
+		return err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, bidder, types.ModuleName, sdk.NewCoins(amount)); err != nil {
+		return err
+	}
+
+	if listing.HasBid() {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(
+			ctx, types.ModuleName, sdk.MustAccAddressFromBech32(listing.HighestBidder), sdk.NewCoins(listing.HighestBid),
+		); err != nil {
+			return sdkerrors.Wrapf(err, "failed to return the previous bid")
+		}
+	}
+
+	listing.HighestBidder = bidder.String()
+	listing.HighestBid = amount
+	if err := k.setListing(ctx, listing); err != nil {
+		return err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventBidPlaced{
+		ListingID: listing.ID,
+		ClassId:   listing.ClassID,
+		Id:        listing.NftID,
+		Bidder:    bidder.String(),
+		Amount:    amount,
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit EventBidPlaced event: %s", err)
+	}
+
+	return nil
+}
+
+// CancelListing removes the listing from the marketplace and returns the non-fungible token to the seller.
+// The auction can't be cancelled once a bid is placed, unless its settlement has failed in all the attempts, then
+// the highest bid is returned to the bidder.
+func (k Keeper) CancelListing(ctx sdk.Context, sender sdk.AccAddress, listingID uint64) error {
+	listing, err := k.GetListing(ctx, listingID)
+	if err != nil {
+		return err
+	}
+
+	if listing.Seller != sender.String() {
+		return sdkerrors.Wrap(cosmoserrors.ErrUnauthorized, "only seller can cancel the listing")
+	}
+
+	if listing.HasBid() && k.getAuctionSettlementAttempts(ctx, listingID) < types.MaxAuctionSettlementAttempts {
+		return sdkerrors.Wrapf(types.ErrInvalidInput, "auction %d with bids can't be cancelled", listingID)
+	}
+
+	return k.closeListing(ctx, listing)
+}
+
+// SettleAuctions settles the auctions ended before or at the current block time.
+// At most MaxAuctionSettlementsPerBlock auctions are settled, the remaining ones are settled in the next blocks.
+// If the token can't be sold to the highest bidder, the bid is returned and the token goes back to the seller.
+// If even that fails, the settlement is retried in the next blocks. Once MaxAuctionSettlementAttempts attempts fail,
+// the auction is removed from the settlement queue, so it doesn't block the settlement of other auctions, and stays
+// in the store until the seller cancels it.
+func (k Keeper) SettleAuctions(ctx sdk.Context) error {
+	store := ctx.KVStore(k.storeKey)
+	for _, auctionEndKey := range k.getEndedAuctionKeys(ctx, int(k.GetParams(ctx).MaxAuctionSettlementsPerBlock)) {
+		listingID, err := types.ParseListingID(auctionEndKey)
+		if err != nil {
+			k.logger(ctx).Error("invalid auction end key, removing it", "key", auctionEndKey, "error", err)
+			store.Delete(auctionEndKey)
+			continue
+		}
+
+		listing, err := k.GetListing(ctx, listingID)
+		if err != nil {
+			k.logger(ctx).Error("ended auction can't be loaded, removing it from the queue", "listingID", listingID, "error", err)
+			store.Delete(auctionEndKey)
+			continue
+		}
+
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err := k.settleAuction(cacheCtx, listing); err != nil {
+			k.logger(ctx).Info("auction can't be settled, closing it", "listingID", listingID, "error", err)
+
+			cacheCtx, writeCache = ctx.CacheContext()
+			if err := k.closeListing(cacheCtx, listing); err != nil {
+				k.recordFailedAuctionSettlement(ctx, listingID, auctionEndKey, err)
+				continue
+			}
+		}
+		writeCache()
+	}
+
+	return nil
+}
+
+// GetListing returns the marketplace listing.
+func (k Keeper) GetListing(ctx sdk.Context, listingID uint64) (types.Listing, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.CreateListingKey(listingID))
+	if bz == nil {
+		return types.Listing{}, sdkerrors.Wrapf(types.ErrListingNotFound, "listing with ID %d not found", listingID)
+	}
+
+	var listing types.Listing
+	if err := k.cdc.Unmarshal(bz, &listing); err != nil {
+		return types.Listing{}, sdkerrors.Wrapf(types.ErrInvalidState, "failed to unmarshal listing: %s", err)
+	}
+
+	return listing, nil
+}
+
+// GetListings returns all the marketplace listings.
+func (k Keeper) GetListings(ctx sdk.Context, pagination *query.PageRequest) ([]types.Listing, *query.PageResponse, error) {
+	listings, pageRes, err := query.GenericFilteredPaginate(
+		k.cdc,
+		prefix.NewStore(ctx.KVStore(k.storeKey), types.ListingKeyPrefix),
+		pagination,
+		// builder
+		func(key []byte, listing *types.Listing) (*types.Listing, error) {
+			return listing, nil
+		},
+		// constructor
+		func() *types.Listing {
+			return &types.Listing{}
+		},
+	)
+	if err != nil {
+		return nil, nil, sdkerrors.Wrapf(types.ErrInvalidInput, "failed to paginate, err: %s", err)
+	}
+
+	result := make([]types.Listing, 0, len(listings))
+	for _, listing := range listings {
+		result = append(result, *listing)
+	}
+
+	return result, pageRes, nil
+}
+
+// GetListingsByClass returns the marketplace listings of the class.
+func (k Keeper) GetListingsByClass(
+	ctx sdk.Context, classID string, pagination *query.PageRequest,
+) ([]types.Listing, *query.PageResponse, error) {
+	indexPrefix, err := types.CreateClassListingPrefix(classID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return k.getIndexedListings(ctx, indexPrefix, pagination)
+}
+
+// GetListingsBySeller returns the marketplace listings of the seller.
+func (k Keeper) GetListingsBySeller(
+	ctx sdk.Context, seller sdk.AccAddress, pagination *query.PageRequest,
+) ([]types.Listing, *query.PageResponse, error) {
+	indexPrefix, err := types.CreateSellerListingPrefix(seller)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return k.getIndexedListings(ctx, indexPrefix, pagination)
+}
+
+// ImportListing saves the listing together with its indexes, but does not make any checks
+// should not be used directly outside the module except for genesis.
+func (k Keeper) ImportListing(ctx sdk.Context, listing types.Listing) error {
+	if err := k.setListing(ctx, listing); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+
+	classKey, err := types.CreateClassListingKey(listing.ClassID, listing.ID)
+	if err != nil {
+		return err
+	}
+	store.Set(classKey, types.StoreTrue)
+
+	sellerKey, err := types.CreateSellerListingKey(sdk.MustAccAddressFromBech32(listing.Seller), listing.ID)
+	if err != nil {
+		return err
+	}
+	store.Set(sellerKey, types.StoreTrue)
+
+	if listing.Type == types.ListingType_auction {
+		store.Set(types.CreateAuctionEndKey(listing.EndTime, listing.ID), types.StoreTrue)
+	}
+
+	return nil
+}
+
+// GetListingSequence returns the ID of the last created listing.
+func (k Keeper) GetListingSequence(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.ListingSequenceKey)
+	if bz == nil {
+		return 0
+	}
+
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetListingSequence sets the ID of the last created listing.
+func (k Keeper) SetListingSequence(ctx sdk.Context, sequence uint64) {
+	ctx.KVStore(k.storeKey).Set(types.ListingSequenceKey, sdk.Uint64ToBigEndian(sequence))
+}
+
+func (k Keeper) settleAuction(ctx sdk.Context, listing types.Listing) error {
+	if !listing.HasBid() {
+		return k.closeListing(ctx, listing)
+	}
+
+	seller := sdk.MustAccAddressFromBech32(listing.Seller)
+	buyer := sdk.MustAccAddressFromBech32(listing.HighestBidder)
+	if err := k.checkNFTTradable(ctx, listing, seller, buyer); err != nil {
+		return err
+	}
+
+	price := listing.HighestBid
+	listing.Price = price
+	royalty, err := k.payForNFT(ctx, listing, seller, func(recipient sdk.AccAddress, amount sdk.Coins) error {
+		return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, amount)
+	})
+	if err != nil {
+		return err
+	}
+
+	return k.completeSale(ctx, listing, buyer, price, royalty)
+}
+
+// checkNFTTradable checks that the token can be transferred from the seller to the buyer as if the seller still
+// owned it.
+func (k Keeper) checkNFTTradable(ctx sdk.Context, listing types.Listing, seller, buyer sdk.AccAddress) error {
+	if err := k.isNFTSendableBy(ctx, listing.ClassID, listing.NftID, seller); err != nil {
+		return err
+	}
+
+	return k.isNFTReceivable(ctx, listing.ClassID, listing.NftID, buyer)
+}
+
+// payForNFT transfers the price of the listing to the seller, and the royalty part of it to the class issuer.
+func (k Keeper) payForNFT(
+	ctx sdk.Context,
+	listing types.Listing,
+	seller sdk.AccAddress,
+	send func(recipient sdk.AccAddress, amount sdk.Coins) error,
+) (sdk.Coin, error) {
+	classDefinition, err := k.GetClassDefinition(ctx, listing.ClassID)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	royalty := sdk.NewCoin(listing.Price.Denom, sdk.ZeroInt())
+	if classDefinition.Issuer != seller.String() {
+		royalty.Amount = classDefinition.RoyaltyRate.MulInt(listing.Price.Amount).TruncateInt()
+	}
+
+	if royalty.IsPositive() {
+		if err := send(sdk.MustAccAddressFromBech32(classDefinition.Issuer), sdk.NewCoins(royalty)); err != nil {
+			return sdk.Coin{}, sdkerrors.Wrap(err, "failed to pay the royalty")
+		}
+	}
+
+	if sellerAmount := listing.Price.Sub(royalty); sellerAmount.IsPositive() {
+		if err := send(seller, sdk.NewCoins(sellerAmount)); err != nil {
+			return sdk.Coin{}, sdkerrors.Wrap(err, "failed to pay the seller")
+		}
+	}
+
+	return royalty, nil
+}
+
+func (k Keeper) completeSale(
+	ctx sdk.Context, listing types.Listing, buyer sdk.AccAddress, price, royalty sdk.Coin,
+) error {
+	if err := k.nftKeeper.Transfer(ctx, listing.ClassID, listing.NftID, buyer); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "failed to transfer the nft to the buyer: %s", err)
+	}
+
+	if err := k.removeListing(ctx, listing); err != nil {
+		return err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventNFTSold{
+		ListingID: listing.ID,
+		ClassId:   listing.ClassID,
+		Id:        listing.NftID,
+		Seller:    listing.Seller,
+		Buyer:     buyer.String(),
+		Price:     price,
+		Royalty:   royalty,
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit EventNFTSold event: %s", err)
+	}
+
+	return nil
+}
+
+// closeListing removes the listing, returns the highest bid to its bidder and the token to the seller.
+func (k Keeper) closeListing(ctx sdk.Context, listing types.Listing) error {
+	if listing.HasBid() {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(
+			ctx, types.ModuleName, sdk.MustAccAddressFromBech32(listing.HighestBidder), sdk.NewCoins(listing.HighestBid),
+		); err != nil {
+			return sdkerrors.Wrapf(err, "failed to return the bid")
+		}
+	}
+
+	seller := sdk.MustAccAddressFromBech32(listing.Seller)
+	if err := k.nftKeeper.Transfer(ctx, listing.ClassID, listing.NftID, seller); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "failed to return the nft to the seller: %s", err)
+	}
+
+	if err := k.removeListing(ctx, listing); err != nil {
+		return err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventListingCancelled{
+		ListingID: listing.ID,
+		ClassId:   listing.ClassID,
+		Id:        listing.NftID,
+		Seller:    listing.Seller,
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit EventListingCancelled event: %s", err)
+	}
+
+	return nil
+}
+
+// recordFailedAuctionSettlement counts the failed settlement attempt of the auction, and removes the auction from
+// the settlement queue once the attempts are exhausted.
+func (k Keeper) recordFailedAuctionSettlement(ctx sdk.Context, listingID uint64, auctionEndKey []byte, err error) {
+	attempts := k.getAuctionSettlementAttempts(ctx, listingID) + 1
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.CreateAuctionSettlementAttemptsKey(listingID), sdk.Uint64ToBigEndian(attempts))
+	if attempts < types.MaxAuctionSettlementAttempts {
+		k.logger(ctx).Info("auction can't be closed, retrying in the next block",
+			"listingID", listingID, "attempts", attempts, "error", err)
+		return
+	}
+
+	k.logger(ctx).Error("auction can't be closed, removing it from the queue", "listingID", listingID, "error", err)
+	store.Delete(auctionEndKey)
+}
+
+func (k Keeper) getAuctionSettlementAttempts(ctx sdk.Context, listingID uint64) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.CreateAuctionSettlementAttemptsKey(listingID))
+	if bz == nil {
+		return 0
+	}
+
+	return binary.BigEndian.Uint64(bz)
+}
+
+func (k Keeper) getEndedAuctionKeys(ctx sdk.Context, maxItems int) [][]byte {
+	iterator := ctx.KVStore(k.storeKey).Iterator(
+		types.AuctionEndKeyPrefix,
+		storetypes.PrefixEndBytes(types.CreateAuctionEndPrefix(ctx.BlockTime())),
+	)
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid() && len(keys) < maxItems; iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+
+	return keys
+}
+
+func (k Keeper) getIndexedListings(
+	ctx sdk.Context, indexPrefix []byte, pagination *query.PageRequest,
+) ([]types.Listing, *query.PageResponse, error) {
+	listings := make([]types.Listing, 0)
+	pageRes, err := query.Paginate(prefix.NewStore(ctx.KVStore(k.storeKey), indexPrefix), pagination,
+		func(key, _ []byte) error {
+			listingID, err := types.ParseListingID(key)
+			if err != nil {
+				return err
+			}
+			listing, err := k.GetListing(ctx, listingID)
+			if err != nil {
+				return err
+			}
+			listings = append(listings, listing)
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return listings, pageRes, nil
+}
+
+func (k Keeper) setListing(ctx sdk.Context, listing types.Listing) error {
+	bz, err := k.cdc.Marshal(&listing)
+	if err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "failed to marshal listing: %s", err)
+	}
+	ctx.KVStore(k.storeKey).Set(types.CreateListingKey(listing.ID), bz)
+
+	return nil
+}
+
+func (k Keeper) removeListing(ctx sdk.Context, listing types.Listing) error {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.CreateListingKey(listing.ID))
+
+	classKey, err := types.CreateClassListingKey(listing.ClassID, listing.ID)
+	if err != nil {
+		return err
+	}
+	store.Delete(classKey)
+
+	sellerKey, err := types.CreateSellerListingKey(sdk.MustAccAddressFromBech32(listing.Seller), listing.ID)
+	if err != nil {
+		return err
+	}
+	store.Delete(sellerKey)
+
+	if listing.Type == types.ListingType_auction {
+		store.Delete(types.CreateAuctionEndKey(listing.EndTime, listing.ID))
+		store.Delete(types.CreateAuctionSettlementAttemptsKey(listing.ID))
+	}
+
+	return nil
+}
+
+func (k Keeper) nextListingID(ctx sdk.Context) uint64 {
+	listingID := k.GetListingSequence(ctx) + 1
+	k.SetListingSequence(ctx, listingID)
+
+	return listingID
+}
+
+// logger returns the Keeper logger.
+func (k Keeper) logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// escrowAddress returns the address of the module account keeping the listed tokens and auction bids.
+func escrowAddress() sdk.AccAddress {
+	return authtypes.NewModuleAddress(types.ModuleName)
+}
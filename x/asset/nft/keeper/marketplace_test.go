@@ -0,0 +1,571 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreum/v3/pkg/config/constant"
+	"github.com/CoreumFoundation/coreum/v3/testutil/simapp"
+	assetfttypes "github.com/CoreumFoundation/coreum/v3/x/asset/ft/types"
+	"github.com/CoreumFoundation/coreum/v3/x/asset/nft/types"
+)
+
+func TestKeeper_Marketplace_FixedPrice(t *testing.T) {
+	requireT := require.New(t)
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false, tmproto.Header{})
+	assetNFTKeeper := testApp.AssetNFTKeeper
+	nftKeeper := testApp.NFTKeeper
+	bankKeeper := testApp.BankKeeper
+
+	issuer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	seller := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	buyer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	classID, nftID := issueAndMintForSale(requireT, testApp, ctx, issuer, seller)
+
+	price := sdk.NewInt64Coin(constant.DenomDev, 1000)
+	listingSettings := types.ListingSettings{
+		Seller:  seller,
+		ClassID: classID,
+		ID:      nftID,
+		Type:    types.ListingType_fixed_price,
+		Price:   price,
+	}
+
+	// only the owner can list the nft
+	wrongSettings := listingSettings
+	wrongSettings.Seller = buyer
+	_, err := assetNFTKeeper.ListNFT(ctx, wrongSettings)
+	requireT.ErrorIs(err, cosmoserrors.ErrUnauthorized)
+
+	// auction duration is not allowed for the fixed-price listing
+	wrongSettings = listingSettings
+	wrongSettings.AuctionDuration = time.Hour
+	_, err = assetNFTKeeper.ListNFT(ctx, wrongSettings)
+	requireT.ErrorIs(err, types.ErrInvalidInput)
+
+	listingID, err := assetNFTKeeper.ListNFT(ctx, listingSettings)
+	requireT.NoError(err)
+	requireT.EqualValues(1, listingID)
+
+	// the nft is kept by the module
+	requireT.Equal(authtypes.NewModuleAddress(types.ModuleName).String(), nftKeeper.GetOwner(ctx, classID, nftID).String())
+
+	listings, _, err := assetNFTKeeper.GetListingsByClass(ctx, classID, &query.PageRequest{})
+	requireT.NoError(err)
+	requireT.Len(listings, 1)
+	requireT.Equal(seller.String(), listings[0].Seller)
+	listings, _, err = assetNFTKeeper.GetListingsBySeller(ctx, seller, &query.PageRequest{})
+	requireT.NoError(err)
+	requireT.Len(listings, 1)
+
+	// the nft can't be bid on
+	requireT.ErrorIs(assetNFTKeeper.PlaceBid(ctx, buyer, listingID, price), types.ErrInvalidInput)
+	// the seller can't buy it
+	requireT.ErrorIs(assetNFTKeeper.BuyNFT(ctx, seller, listingID), types.ErrInvalidInput)
+	// the buyer doesn't have funds
+	requireT.ErrorIs(assetNFTKeeper.BuyNFT(ctx, buyer, listingID), cosmoserrors.ErrInsufficientFunds)
+
+	requireT.NoError(testApp.FundAccount(ctx, buyer, sdk.NewCoins(price)))
+	requireT.NoError(assetNFTKeeper.BuyNFT(ctx, buyer, listingID))
+
+	// the royalty is paid to the issuer
+	requireT.Equal(buyer.String(), nftKeeper.GetOwner(ctx, classID, nftID).String())
+	requireT.Equal("100", bankKeeper.GetBalance(ctx, issuer, constant.DenomDev).Amount.String())
+	requireT.Equal("900", bankKeeper.GetBalance(ctx, seller, constant.DenomDev).Amount.String())
+	requireT.True(bankKeeper.GetBalance(ctx, buyer, constant.DenomDev).IsZero())
+
+	_, err = assetNFTKeeper.GetListing(ctx, listingID)
+	requireT.ErrorIs(err, types.ErrListingNotFound)
+	listings, _, err = assetNFTKeeper.GetListingsByClass(ctx, classID, &query.PageRequest{})
+	requireT.NoError(err)
+	requireT.Empty(listings)
+	listings, _, err = assetNFTKeeper.GetListingsBySeller(ctx, seller, &query.PageRequest{})
+	requireT.NoError(err)
+	requireT.Empty(listings)
+}
+
+func TestKeeper_Marketplace_Auction(t *testing.T) {
+	requireT := require.New(t)
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false, tmproto.Header{Time: time.Now()})
+	assetNFTKeeper := testApp.AssetNFTKeeper
+	nftKeeper := testApp.NFTKeeper
+	bankKeeper := testApp.BankKeeper
+
+	issuer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	seller := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	bidder1 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	bidder2 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	requireT.NoError(testApp.FundAccount(ctx, bidder1, sdk.NewCoins(sdk.NewInt64Coin(constant.DenomDev, 1000))))
+	requireT.NoError(testApp.FundAccount(ctx, bidder2, sdk.NewCoins(sdk.NewInt64Coin(constant.DenomDev, 1000))))
+	classID, nftID := issueAndMintForSale(requireT, testApp, ctx, issuer, seller)
+
+	listingID, err := assetNFTKeeper.ListNFT(ctx, types.ListingSettings{
+		Seller:          seller,
+		ClassID:         classID,
+		ID:              nftID,
+		Type:            types.ListingType_auction,
+		Price:           sdk.NewInt64Coin(constant.DenomDev, 500),
+		AuctionDuration: time.Hour,
+	})
+	requireT.NoError(err)
+
+	// the auction can't be bought for the fixed price
+	requireT.ErrorIs(assetNFTKeeper.BuyNFT(ctx, bidder1, listingID), types.ErrInvalidInput)
+
+	// the bid is less than the minimal one
+	requireT.ErrorIs(assetNFTKeeper.PlaceBid(ctx, bidder1, listingID, sdk.NewInt64Coin(constant.DenomDev, 499)), types.ErrInvalidInput)
+	// wrong denom
+	requireT.ErrorIs(assetNFTKeeper.PlaceBid(ctx, bidder1, listingID, sdk.NewInt64Coin("ucore", 500)), types.ErrInvalidInput)
+
+	requireT.NoError(assetNFTKeeper.PlaceBid(ctx, bidder1, listingID, sdk.NewInt64Coin(constant.DenomDev, 500)))
+	requireT.Equal("500", bankKeeper.GetBalance(ctx, bidder1, constant.DenomDev).Amount.String())
+
+	// the bid must be greater than the highest one
+	requireT.ErrorIs(assetNFTKeeper.PlaceBid(ctx, bidder2, listingID, sdk.NewInt64Coin(constant.DenomDev, 500)), types.ErrInvalidInput)
+	requireT.NoError(assetNFTKeeper.PlaceBid(ctx, bidder2, listingID, sdk.NewInt64Coin(constant.DenomDev, 600)))
+
+	// the previous bid is returned
+	requireT.Equal("1000", bankKeeper.GetBalance(ctx, bidder1, constant.DenomDev).Amount.String())
+	requireT.Equal("400", bankKeeper.GetBalance(ctx, bidder2, constant.DenomDev).Amount.String())
+
+	// the auction with bids can't be cancelled
+	requireT.ErrorIs(assetNFTKeeper.CancelListing(ctx, seller, listingID), types.ErrInvalidInput)
+
+	// the auction is not settled before the end time
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour - time.Second))
+	requireT.NoError(assetNFTKeeper.SettleAuctions(ctx))
+	_, err = assetNFTKeeper.GetListing(ctx, listingID)
+	requireT.NoError(err)
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Second))
+	requireT.ErrorIs(assetNFTKeeper.PlaceBid(ctx, bidder1, listingID, sdk.NewInt64Coin(constant.DenomDev, 700)), types.ErrInvalidInput)
+	requireT.NoError(assetNFTKeeper.SettleAuctions(ctx))
+
+	requireT.Equal(bidder2.String(), nftKeeper.GetOwner(ctx, classID, nftID).String())
+	requireT.Equal("60", bankKeeper.GetBalance(ctx, issuer, constant.DenomDev).Amount.String())
+	requireT.Equal("540", bankKeeper.GetBalance(ctx, seller, constant.DenomDev).Amount.String())
+	requireT.True(bankKeeper.GetBalance(ctx, authtypes.NewModuleAddress(types.ModuleName), constant.DenomDev).IsZero())
+	_, err = assetNFTKeeper.GetListing(ctx, listingID)
+	requireT.ErrorIs(err, types.ErrListingNotFound)
+}
+
+func TestKeeper_Marketplace_AuctionWithoutBids(t *testing.T) {
+	requireT := require.New(t)
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false, tmproto.Header{Time: time.Now()})
+	assetNFTKeeper := testApp.AssetNFTKeeper
+	nftKeeper := testApp.NFTKeeper
+
+	issuer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	seller := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	classID, nftID := issueAndMintForSale(requireT, testApp, ctx, issuer, seller)
+
+	listingID, err := assetNFTKeeper.ListNFT(ctx, types.ListingSettings{
+		Seller:          seller,
+		ClassID:         classID,
+		ID:              nftID,
+		Type:            types.ListingType_auction,
+		Price:           sdk.NewInt64Coin(constant.DenomDev, 500),
+		AuctionDuration: time.Hour,
+	})
+	requireT.NoError(err)
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(2 * time.Hour))
+	requireT.NoError(assetNFTKeeper.SettleAuctions(ctx))
+
+	requireT.Equal(seller.String(), nftKeeper.GetOwner(ctx, classID, nftID).String())
+	_, err = assetNFTKeeper.GetListing(ctx, listingID)
+	requireT.ErrorIs(err, types.ErrListingNotFound)
+}
+
+func TestKeeper_Marketplace_AuctionSettlementsPerBlock(t *testing.T) {
+	requireT := require.New(t)
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false, tmproto.Header{Time: time.Now()})
+	assetNFTKeeper := testApp.AssetNFTKeeper
+
+	params := assetNFTKeeper.GetParams(ctx)
+	params.MaxAuctionSettlementsPerBlock = 1
+	requireT.NoError(assetNFTKeeper.SetParams(ctx, params))
+
+	listingIDs := make([]uint64, 0, 2)
+	for i := 0; i < 2; i++ {
+		issuer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+		seller := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+		classID, nftID := issueAndMintForSale(requireT, testApp, ctx, issuer, seller)
+
+		listingID, err := assetNFTKeeper.ListNFT(ctx, types.ListingSettings{
+			Seller:          seller,
+			ClassID:         classID,
+			ID:              nftID,
+			Type:            types.ListingType_auction,
+			Price:           sdk.NewInt64Coin(constant.DenomDev, 500),
+			AuctionDuration: time.Hour,
+		})
+		requireT.NoError(err)
+		listingIDs = append(listingIDs, listingID)
+	}
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(2 * time.Hour))
+
+	// only one auction is settled in the block
+	requireT.NoError(assetNFTKeeper.SettleAuctions(ctx))
+	_, err := assetNFTKeeper.GetListing(ctx, listingIDs[0])
+	requireT.ErrorIs(err, types.ErrListingNotFound)
+	_, err = assetNFTKeeper.GetListing(ctx, listingIDs[1])
+	requireT.NoError(err)
+
+	// the remaining one is settled in the next block
+	requireT.NoError(assetNFTKeeper.SettleAuctions(ctx))
+	_, err = assetNFTKeeper.GetListing(ctx, listingIDs[1])
+	requireT.ErrorIs(err, types.ErrListingNotFound)
+}
+
+func TestKeeper_Marketplace_FailedAuctionSettlement(t *testing.T) {
+	requireT := require.New(t)
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false, tmproto.Header{Time: time.Now()})
+	assetNFTKeeper := testApp.AssetNFTKeeper
+	assetFTKeeper := testApp.AssetFTKeeper
+
+	params := assetNFTKeeper.GetParams(ctx)
+	params.MaxAuctionSettlementsPerBlock = 1
+	requireT.NoError(assetNFTKeeper.SetParams(ctx, params))
+
+	ftIssuer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	requireT.NoError(testApp.FundAccount(ctx, ftIssuer, sdk.NewCoins(assetFTKeeper.GetParams(ctx).IssueFee)))
+	denom, err := assetFTKeeper.Issue(ctx, assetfttypes.IssueSettings{
+		Issuer:        ftIssuer,
+		Symbol:        "ABC",
+		Subunit:       "abc",
+		Precision:     6,
+		InitialAmount: sdkmath.NewInt(1000),
+		Features:      []assetfttypes.Feature{assetfttypes.Feature_whitelisting},
+	})
+	requireT.NoError(err)
+
+	bidder := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	requireT.NoError(assetFTKeeper.SetWhitelistedBalance(ctx, ftIssuer, bidder, sdk.NewInt64Coin(denom, 500)))
+	requireT.NoError(testApp.BankKeeper.SendCoins(ctx, ftIssuer, bidder, sdk.NewCoins(sdk.NewInt64Coin(denom, 500))))
+
+	listingIDs := make([]uint64, 0, 2)
+	sellers := make([]sdk.AccAddress, 0, 2)
+	for _, price := range []sdk.Coin{sdk.NewInt64Coin(denom, 500), sdk.NewInt64Coin(constant.DenomDev, 500)} {
+		issuer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+		seller := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+		classID, nftID := issueAndMintForSale(requireT, testApp, ctx, issuer, seller)
+
+		listingID, err := assetNFTKeeper.ListNFT(ctx, types.ListingSettings{
+			Seller:          seller,
+			ClassID:         classID,
+			ID:              nftID,
+			Type:            types.ListingType_auction,
+			Price:           price,
+			AuctionDuration: time.Hour,
+		})
+		requireT.NoError(err)
+		listingIDs = append(listingIDs, listingID)
+		sellers = append(sellers, seller)
+	}
+	requireT.NoError(assetNFTKeeper.PlaceBid(ctx, bidder, listingIDs[0], sdk.NewInt64Coin(denom, 500)))
+
+	// the escrowed bid can be neither paid to the seller nor returned to the bidder, who aren't whitelisted
+	requireT.NoError(assetFTKeeper.SetWhitelistedBalance(ctx, ftIssuer, bidder, sdk.NewInt64Coin(denom, 0)))
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(2 * time.Hour))
+
+	// the failed auction is retried in the next blocks, so the next auction waits
+	for i := 1; i < types.MaxAuctionSettlementAttempts; i++ {
+		requireT.NoError(assetNFTKeeper.SettleAuctions(ctx))
+		_, err = assetNFTKeeper.GetListing(ctx, listingIDs[0])
+		requireT.NoError(err)
+		_, err = assetNFTKeeper.GetListing(ctx, listingIDs[1])
+		requireT.NoError(err)
+	}
+
+	// the auction with bids can't be cancelled while its settlement is retried
+	requireT.ErrorIs(assetNFTKeeper.CancelListing(ctx, sellers[0], listingIDs[0]), types.ErrInvalidInput)
+
+	// once the attempts are exhausted, the failed auction is removed from the queue, but kept in the store
+	requireT.NoError(assetNFTKeeper.SettleAuctions(ctx))
+	_, err = assetNFTKeeper.GetListing(ctx, listingIDs[0])
+	requireT.NoError(err)
+
+	// so the next auction is settled in the next block
+	requireT.NoError(assetNFTKeeper.SettleAuctions(ctx))
+	_, err = assetNFTKeeper.GetListing(ctx, listingIDs[0])
+	requireT.NoError(err)
+	_, err = assetNFTKeeper.GetListing(ctx, listingIDs[1])
+	requireT.ErrorIs(err, types.ErrListingNotFound)
+
+	// the seller cancels the failed auction once the bidder is whitelisted again, so the bid is returned
+	requireT.NoError(assetFTKeeper.SetWhitelistedBalance(ctx, ftIssuer, bidder, sdk.NewInt64Coin(denom, 500)))
+	requireT.NoError(assetNFTKeeper.CancelListing(ctx, sellers[0], listingIDs[0]))
+	_, err = assetNFTKeeper.GetListing(ctx, listingIDs[0])
+	requireT.ErrorIs(err, types.ErrListingNotFound)
+	requireT.Equal("500", testApp.BankKeeper.GetBalance(ctx, bidder, denom).Amount.String())
+}
+
+func TestKeeper_Marketplace_Cancel(t *testing.T) {
+	requireT := require.New(t)
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false, tmproto.Header{})
+	assetNFTKeeper := testApp.AssetNFTKeeper
+	nftKeeper := testApp.NFTKeeper
+
+	issuer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	seller := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	classID, nftID := issueAndMintForSale(requireT, testApp, ctx, issuer, seller)
+
+	listingID, err := assetNFTKeeper.ListNFT(ctx, types.ListingSettings{
+		Seller:  seller,
+		ClassID: classID,
+		ID:      nftID,
+		Type:    types.ListingType_fixed_price,
+		Price:   sdk.NewInt64Coin(constant.DenomDev, 500),
+	})
+	requireT.NoError(err)
+
+	requireT.ErrorIs(assetNFTKeeper.CancelListing(ctx, issuer, listingID), cosmoserrors.ErrUnauthorized)
+	requireT.NoError(assetNFTKeeper.CancelListing(ctx, seller, listingID))
+	requireT.Equal(seller.String(), nftKeeper.GetOwner(ctx, classID, nftID).String())
+	requireT.ErrorIs(assetNFTKeeper.CancelListing(ctx, seller, listingID), types.ErrListingNotFound)
+}
+
+func TestKeeper_Marketplace_ClassFeatures(t *testing.T) {
+	requireT := require.New(t)
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false, tmproto.Header{Time: time.Now()})
+	assetNFTKeeper := testApp.AssetNFTKeeper
+	nftKeeper := testApp.NFTKeeper
+	bankKeeper := testApp.BankKeeper
+
+	issuer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	seller := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	buyer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	requireT.NoError(testApp.FundAccount(ctx, buyer, sdk.NewCoins(sdk.NewInt64Coin(constant.DenomDev, 1000))))
+
+	classID, err := assetNFTKeeper.IssueClass(ctx, types.IssueClassSettings{
+		Issuer: issuer,
+		Symbol: "symbol",
+		Features: []types.ClassFeature{
+			types.ClassFeature_freezing,
+			types.ClassFeature_whitelisting,
+		},
+	})
+	requireT.NoError(err)
+	requireT.NoError(assetNFTKeeper.Mint(ctx, types.MintSettings{
+		Sender:    issuer,
+		Recipient: issuer,
+		ClassID:   classID,
+		ID:        "my-id",
+	}))
+	nftID := "my-id"
+	requireT.NoError(assetNFTKeeper.AddToWhitelist(ctx, classID, nftID, issuer, seller))
+	requireT.NoError(nftKeeper.Transfer(ctx, classID, nftID, seller))
+
+	// frozen nft can't be listed
+	requireT.NoError(assetNFTKeeper.Freeze(ctx, issuer, classID, nftID))
+	listingSettings := types.ListingSettings{
+		Seller:  seller,
+		ClassID: classID,
+		ID:      nftID,
+		Type:    types.ListingType_fixed_price,
+		Price:   sdk.NewInt64Coin(constant.DenomDev, 500),
+	}
+	_, err = assetNFTKeeper.ListNFT(ctx, listingSettings)
+	requireT.ErrorIs(err, cosmoserrors.ErrUnauthorized)
+	requireT.NoError(assetNFTKeeper.Unfreeze(ctx, issuer, classID, nftID))
+
+	// the escrow doesn't need to be whitelisted
+	listingID, err := assetNFTKeeper.ListNFT(ctx, listingSettings)
+	requireT.NoError(err)
+
+	// not whitelisted buyer can't buy the nft
+	requireT.ErrorIs(assetNFTKeeper.BuyNFT(ctx, buyer, listingID), cosmoserrors.ErrUnauthorized)
+	requireT.NoError(assetNFTKeeper.CancelListing(ctx, seller, listingID))
+
+	// the auction can't be settled when the nft is frozen, so it is closed
+	requireT.NoError(assetNFTKeeper.AddToWhitelist(ctx, classID, nftID, issuer, buyer))
+	listingSettings.Type = types.ListingType_auction
+	listingSettings.AuctionDuration = time.Hour
+	listingID, err = assetNFTKeeper.ListNFT(ctx, listingSettings)
+	requireT.NoError(err)
+	requireT.NoError(assetNFTKeeper.PlaceBid(ctx, buyer, listingID, sdk.NewInt64Coin(constant.DenomDev, 500)))
+	requireT.NoError(assetNFTKeeper.Freeze(ctx, issuer, classID, nftID))
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	requireT.NoError(assetNFTKeeper.SettleAuctions(ctx))
+
+	requireT.Equal(seller.String(), nftKeeper.GetOwner(ctx, classID, nftID).String())
+	requireT.Equal("1000", bankKeeper.GetBalance(ctx, buyer, constant.DenomDev).Amount.String())
+	_, err = assetNFTKeeper.GetListing(ctx, listingID)
+	requireT.ErrorIs(err, types.ErrListingNotFound)
+}
+
+func TestKeeper_Marketplace_FTPayment(t *testing.T) {
+	requireT := require.New(t)
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false, tmproto.Header{Time: time.Now()})
+	assetNFTKeeper := testApp.AssetNFTKeeper
+	assetFTKeeper := testApp.AssetFTKeeper
+	nftKeeper := testApp.NFTKeeper
+
+	issuer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	seller := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	buyer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	classID, nftID := issueAndMintForSale(requireT, testApp, ctx, issuer, seller)
+
+	ftIssuer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	requireT.NoError(testApp.FundAccount(ctx, ftIssuer, sdk.NewCoins(assetFTKeeper.GetParams(ctx).IssueFee)))
+	denom, err := assetFTKeeper.Issue(ctx, assetfttypes.IssueSettings{
+		Issuer:        ftIssuer,
+		Symbol:        "ABC",
+		Subunit:       "abc",
+		Precision:     6,
+		InitialAmount: sdkmath.NewInt(1000),
+		Features: []assetfttypes.Feature{
+			assetfttypes.Feature_freezing,
+			assetfttypes.Feature_whitelisting,
+		},
+	})
+	requireT.NoError(err)
+	price := sdk.NewInt64Coin(denom, 500)
+	requireT.NoError(assetFTKeeper.SetWhitelistedBalance(ctx, ftIssuer, buyer, price))
+	requireT.NoError(testApp.BankKeeper.SendCoins(ctx, ftIssuer, buyer, sdk.NewCoins(price)))
+
+	listingID, err := assetNFTKeeper.ListNFT(ctx, types.ListingSettings{
+		Seller:  seller,
+		ClassID: classID,
+		ID:      nftID,
+		Type:    types.ListingType_fixed_price,
+		Price:   price,
+	})
+	requireT.NoError(err)
+
+	// the frozen coins can't be used to pay
+	requireT.NoError(assetFTKeeper.Freeze(ctx, ftIssuer, buyer, price))
+	requireT.ErrorIs(assetNFTKeeper.BuyNFT(ctx, buyer, listingID), cosmoserrors.ErrInsufficientFunds)
+	requireT.NoError(assetFTKeeper.Unfreeze(ctx, ftIssuer, buyer, price))
+
+	// the seller and the class issuer are not whitelisted to receive the coins
+	requireT.ErrorIs(assetNFTKeeper.BuyNFT(ctx, buyer, listingID), assetfttypes.ErrWhitelistedLimitExceeded)
+	requireT.Equal(authtypes.NewModuleAddress(types.ModuleName).String(), nftKeeper.GetOwner(ctx, classID, nftID).String())
+
+	requireT.NoError(assetFTKeeper.SetWhitelistedBalance(ctx, ftIssuer, issuer, price))
+	requireT.NoError(assetFTKeeper.SetWhitelistedBalance(ctx, ftIssuer, seller, price))
+	requireT.NoError(assetNFTKeeper.BuyNFT(ctx, buyer, listingID))
+	requireT.Equal(buyer.String(), nftKeeper.GetOwner(ctx, classID, nftID).String())
+	requireT.Equal("50", testApp.BankKeeper.GetBalance(ctx, issuer, denom).Amount.String())
+	requireT.Equal("450", testApp.BankKeeper.GetBalance(ctx, seller, denom).Amount.String())
+
+	// the bid is escrowed by the module even though it is not whitelisted
+	listingID, err = assetNFTKeeper.ListNFT(ctx, types.ListingSettings{
+		Seller:          buyer,
+		ClassID:         classID,
+		ID:              nftID,
+		Type:            types.ListingType_auction,
+		Price:           sdk.NewInt64Coin(denom, 100),
+		AuctionDuration: time.Hour,
+	})
+	requireT.NoError(err)
+	requireT.NoError(assetNFTKeeper.PlaceBid(ctx, seller, listingID, sdk.NewInt64Coin(denom, 100)))
+	requireT.Equal("350", testApp.BankKeeper.GetBalance(ctx, seller, denom).Amount.String())
+}
+
+func TestKeeper_Marketplace_FTBidRates(t *testing.T) {
+	requireT := require.New(t)
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false, tmproto.Header{Time: time.Now()})
+	assetNFTKeeper := testApp.AssetNFTKeeper
+	assetFTKeeper := testApp.AssetFTKeeper
+	bankKeeper := testApp.BankKeeper
+
+	issuer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	seller := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	bidder1 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	bidder2 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	classID, nftID := issueAndMintForSale(requireT, testApp, ctx, issuer, seller)
+
+	ftIssuer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	requireT.NoError(testApp.FundAccount(ctx, ftIssuer, sdk.NewCoins(assetFTKeeper.GetParams(ctx).IssueFee)))
+	denom, err := assetFTKeeper.Issue(ctx, assetfttypes.IssueSettings{
+		Issuer:             ftIssuer,
+		Symbol:             "ABC",
+		Subunit:            "abc",
+		Precision:          6,
+		InitialAmount:      sdkmath.NewInt(1000),
+		Features:           []assetfttypes.Feature{assetfttypes.Feature_freezing},
+		BurnRate:           sdk.MustNewDecFromStr("0.1"),
+		SendCommissionRate: sdk.MustNewDecFromStr("0.1"),
+	})
+	requireT.NoError(err)
+	requireT.NoError(bankKeeper.SendCoins(ctx, ftIssuer, bidder1, sdk.NewCoins(sdk.NewInt64Coin(denom, 200))))
+	requireT.NoError(bankKeeper.SendCoins(ctx, ftIssuer, bidder2, sdk.NewCoins(sdk.NewInt64Coin(denom, 300))))
+
+	listingID, err := assetNFTKeeper.ListNFT(ctx, types.ListingSettings{
+		Seller:          seller,
+		ClassID:         classID,
+		ID:              nftID,
+		Type:            types.ListingType_auction,
+		Price:           sdk.NewInt64Coin(denom, 100),
+		AuctionDuration: time.Hour,
+	})
+	requireT.NoError(err)
+
+	// the rates are charged from the bidder on top of the bid
+	requireT.NoError(assetNFTKeeper.PlaceBid(ctx, bidder1, listingID, sdk.NewInt64Coin(denom, 100)))
+	requireT.Equal("80", bankKeeper.GetBalance(ctx, bidder1, denom).Amount.String())
+
+	// the escrow frozen by the admin doesn't block the return of the bid
+	escrow := authtypes.NewModuleAddress(types.ModuleName)
+	requireT.NoError(assetFTKeeper.Freeze(ctx, ftIssuer, escrow, sdk.NewInt64Coin(denom, 100)))
+
+	// the outbid bid is returned in full
+	requireT.NoError(assetNFTKeeper.PlaceBid(ctx, bidder2, listingID, sdk.NewInt64Coin(denom, 200)))
+	requireT.Equal("180", bankKeeper.GetBalance(ctx, bidder1, denom).Amount.String())
+	requireT.Equal("60", bankKeeper.GetBalance(ctx, bidder2, denom).Amount.String())
+	requireT.Equal("200", bankKeeper.GetBalance(ctx, escrow, denom).Amount.String())
+
+	// the highest bid is paid out in full
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	requireT.NoError(assetNFTKeeper.SettleAuctions(ctx))
+	requireT.Equal(bidder2.String(), testApp.NFTKeeper.GetOwner(ctx, classID, nftID).String())
+	requireT.Equal("20", bankKeeper.GetBalance(ctx, issuer, denom).Amount.String())
+	requireT.Equal("180", bankKeeper.GetBalance(ctx, seller, denom).Amount.String())
+	requireT.True(bankKeeper.GetBalance(ctx, escrow, denom).IsZero())
+}
+
+func issueAndMintForSale(
+	requireT *require.Assertions,
+	testApp *simapp.App,
+	ctx sdk.Context,
+	issuer, owner sdk.AccAddress,
+) (string, string) {
+	classID, err := testApp.AssetNFTKeeper.IssueClass(ctx, types.IssueClassSettings{
+		Issuer:      issuer,
+		Symbol:      "symbol",
+		RoyaltyRate: sdk.MustNewDecFromStr("0.1"),
+	})
+	requireT.NoError(err)
+
+	nftID := "my-id"
+	requireT.NoError(testApp.AssetNFTKeeper.Mint(ctx, types.MintSettings{
+		Sender:    issuer,
+		Recipient: owner,
+		ClassID:   classID,
+		ID:        nftID,
+	}))
+
+	return classID, nftID
+}
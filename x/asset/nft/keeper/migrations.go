@@ -5,6 +5,7 @@ import (
 
 	v1 "github.com/CoreumFoundation/coreum/v3/x/asset/nft/migrations/v1"
 	v2 "github.com/CoreumFoundation/coreum/v3/x/asset/nft/migrations/v2"
+	v3 "github.com/CoreumFoundation/coreum/v3/x/asset/nft/migrations/v3"
 	"github.com/CoreumFoundation/coreum/v3/x/asset/nft/types"
 )
 
@@ -43,3 +44,12 @@ func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 func (m Migrator) Migrate2to3(ctx sdk.Context) error {
 	return v2.MigrateParams(ctx, m.keeper, m.paramsKeeper)
 }
+
+// Migrate3to4 migrates from version 3 to 4.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	if err := v3.MigrateParams(ctx, m.keeper); err != nil {
+		return err
+	}
+
+	return v3.MigrateWhitelistingCounts(ctx, m.keeper.storeKey)
+}
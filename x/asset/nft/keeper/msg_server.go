@@ -15,14 +15,22 @@ var _ types.MsgServer = MsgServer{}
 type MsgKeeper interface {
 	IssueClass(ctx sdk.Context, settings types.IssueClassSettings) (string, error)
 	Mint(ctx sdk.Context, settings types.MintSettings) error
+	UpdateData(ctx sdk.Context, settings types.UpdateDataSettings) error
 	Burn(ctx sdk.Context, owner sdk.AccAddress, classID, ID string) error
+	Revoke(ctx sdk.Context, sender sdk.AccAddress, classID, ID, reason string) error
 	Freeze(ctx sdk.Context, sender sdk.AccAddress, classID, nftID string) error
 	Unfreeze(ctx sdk.Context, sender sdk.AccAddress, classID, nftID string) error
+	ClassFreeze(ctx sdk.Context, sender sdk.AccAddress, classID string) error
+	ClassUnfreeze(ctx sdk.Context, sender sdk.AccAddress, classID string) error
 	AddToWhitelist(ctx sdk.Context, classID, nftID string, sender, account sdk.AccAddress) error
 	RemoveFromWhitelist(ctx sdk.Context, classID, nftID string, sender, account sdk.AccAddress) error
 	AddToClassWhitelist(ctx sdk.Context, classID string, sender, account sdk.AccAddress) error
 	RemoveFromClassWhitelist(ctx sdk.Context, classID string, sender, account sdk.AccAddress) error
 	UpdateParams(ctx sdk.Context, authority string, params types.Params) error
+	ListNFT(ctx sdk.Context, settings types.ListingSettings) (uint64, error)
+	BuyNFT(ctx sdk.Context, buyer sdk.AccAddress, listingID uint64) error
+	PlaceBid(ctx sdk.Context, bidder sdk.AccAddress, listingID uint64, amount sdk.Coin) error
+	CancelListing(ctx sdk.Context, sender sdk.AccAddress, listingID uint64) error
 }
 
 // MsgServer serves grpc tx requests for assets module.
@@ -56,6 +64,7 @@ func (ms MsgServer) IssueClass(ctx context.Context, req *types.MsgIssueClass) (*
 			Data:        req.Data,
 			Features:    req.Features,
 			RoyaltyRate: req.RoyaltyRate,
+			DataEditors: req.DataEditors,
 		},
 	); err != nil {
 		return nil, err
@@ -97,6 +106,49 @@ func (ms MsgServer) Mint(ctx context.Context, req *types.MsgMint) (*types.EmptyR
 	return &types.EmptyResponse{}, nil
 }
 
+// MintBatch mints multiple non-fungible tokens.
+func (ms MsgServer) MintBatch(ctx context.Context, req *types.MsgMintBatch) (*types.EmptyResponse, error) {
+	for _, item := range req.Items {
+		if _, err := ms.Mint(ctx, &types.MsgMint{
+			Sender:    req.Sender,
+			ClassID:   item.ClassID,
+			ID:        item.ID,
+			URI:       item.URI,
+			URIHash:   item.URIHash,
+			Data:      item.Data,
+			Recipient: item.Recipient,
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// UpdateData updates the data of the non-fungible token.
+func (ms MsgServer) UpdateData(ctx context.Context, req *types.MsgUpdateData) (*types.EmptyResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidInput, "invalid sender")
+	}
+
+	if err := ms.keeper.UpdateData(
+		sdk.UnwrapSDKContext(ctx),
+		types.UpdateDataSettings{
+			Sender:  sender,
+			ClassID: req.ClassID,
+			ID:      req.ID,
+			URI:     req.URI,
+			URIHash: req.URIHash,
+			Data:    req.Data,
+		},
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
 // Burn burns the non-fungible token.
 func (ms MsgServer) Burn(ctx context.Context, req *types.MsgBurn) (*types.EmptyResponse, error) {
 	owner, err := sdk.AccAddressFromBech32(req.Sender)
@@ -116,6 +168,41 @@ func (ms MsgServer) Burn(ctx context.Context, req *types.MsgBurn) (*types.EmptyR
 	return &types.EmptyResponse{}, nil
 }
 
+// BurnBatch burns multiple non-fungible tokens.
+func (ms MsgServer) BurnBatch(ctx context.Context, req *types.MsgBurnBatch) (*types.EmptyResponse, error) {
+	for _, item := range req.Items {
+		if _, err := ms.Burn(ctx, &types.MsgBurn{
+			Sender:  req.Sender,
+			ClassID: item.ClassID,
+			ID:      item.ID,
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// Revoke revokes the non-fungible token of the soulbound class.
+func (ms MsgServer) Revoke(ctx context.Context, req *types.MsgRevoke) (*types.EmptyResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidInput, "invalid sender")
+	}
+
+	if err := ms.keeper.Revoke(
+		sdk.UnwrapSDKContext(ctx),
+		sender,
+		req.ClassID,
+		req.ID,
+		req.Reason,
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
 // Freeze freeze the non-fungible token.
 func (ms MsgServer) Freeze(ctx context.Context, req *types.MsgFreeze) (*types.EmptyResponse, error) {
 	sender, err := sdk.AccAddressFromBech32(req.Sender)
@@ -146,6 +233,36 @@ func (ms MsgServer) Unfreeze(ctx context.Context, req *types.MsgUnfreeze) (*type
 	return &types.EmptyResponse{}, nil
 }
 
+// ClassFreeze freezes all the non-fungible tokens of the class.
+func (ms MsgServer) ClassFreeze(ctx context.Context, req *types.MsgClassFreeze) (*types.EmptyResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidInput, "invalid sender")
+	}
+
+	err = ms.keeper.ClassFreeze(sdk.UnwrapSDKContext(ctx), sender, req.ClassID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// ClassUnfreeze unfreezes all the non-fungible tokens of the class.
+func (ms MsgServer) ClassUnfreeze(ctx context.Context, req *types.MsgClassUnfreeze) (*types.EmptyResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidInput, "invalid sender")
+	}
+
+	err = ms.keeper.ClassUnfreeze(sdk.UnwrapSDKContext(ctx), sender, req.ClassID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
 // AddToWhitelist adds an account to the whitelisted list of accounts for the NFT.
 func (ms MsgServer) AddToWhitelist(ctx context.Context, req *types.MsgAddToWhitelist) (*types.EmptyResponse, error) {
 	sender, err := sdk.AccAddressFromBech32(req.Sender)
@@ -230,3 +347,66 @@ func (ms MsgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdatePara
 
 	return &types.EmptyResponse{}, nil
 }
+
+// ListNFT puts the non-fungible token on sale on the marketplace.
+func (ms MsgServer) ListNFT(ctx context.Context, req *types.MsgListNFT) (*types.EmptyResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidInput, "invalid sender")
+	}
+
+	if _, err := ms.keeper.ListNFT(sdk.UnwrapSDKContext(ctx), types.ListingSettings{
+		Seller:          sender,
+		ClassID:         req.ClassID,
+		ID:              req.ID,
+		Type:            req.ListingType,
+		Price:           req.Price,
+		AuctionDuration: req.AuctionDuration,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// BuyNFT buys the non-fungible token listed for the fixed price.
+func (ms MsgServer) BuyNFT(ctx context.Context, req *types.MsgBuyNFT) (*types.EmptyResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidInput, "invalid sender")
+	}
+
+	if err := ms.keeper.BuyNFT(sdk.UnwrapSDKContext(ctx), sender, req.ListingID); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// PlaceBid places a bid on the non-fungible token listed on the auction.
+func (ms MsgServer) PlaceBid(ctx context.Context, req *types.MsgPlaceBid) (*types.EmptyResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidInput, "invalid sender")
+	}
+
+	if err := ms.keeper.PlaceBid(sdk.UnwrapSDKContext(ctx), sender, req.ListingID, req.Amount); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// CancelListing removes the listing from the marketplace.
+func (ms MsgServer) CancelListing(ctx context.Context, req *types.MsgCancelListing) (*types.EmptyResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidInput, "invalid sender")
+	}
+
+	if err := ms.keeper.CancelListing(sdk.UnwrapSDKContext(ctx), sender, req.ListingID); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
@@ -0,0 +1,59 @@
+package v3
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CoreumFoundation/coreum/v3/x/asset/nft/types"
+)
+
+type whitelistingCount struct {
+	classID string
+	nftID   string
+	count   uint64
+}
+
+// MigrateWhitelistingCounts stores the number of accounts whitelisted for every NFT introduced in v4.
+func MigrateWhitelistingCounts(ctx sdk.Context, storeKey storetypes.StoreKey) error {
+	counts, err := collectWhitelistingCounts(ctx, storeKey)
+	if err != nil {
+		return err
+	}
+
+	moduleStore := ctx.KVStore(storeKey)
+	for _, count := range counts {
+		key, err := types.CreateWhitelistingCountKey(count.classID, count.nftID)
+		if err != nil {
+			return err
+		}
+		moduleStore.Set(key, sdk.Uint64ToBigEndian(count.count))
+	}
+
+	return nil
+}
+
+func collectWhitelistingCounts(ctx sdk.Context, storeKey storetypes.StoreKey) ([]whitelistingCount, error) {
+	iterator := prefix.NewStore(ctx.KVStore(storeKey), types.NFTWhitelistingKeyPrefix).Iterator(nil, nil)
+	defer iterator.Close()
+
+	// the keys are ordered, so the accounts of the same NFT are iterated one after another
+	counts := make([]whitelistingCount, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		classID, nftID, _, err := types.ParseWhitelistingKey(iterator.Key())
+		if err != nil {
+			return nil, err
+		}
+		if last := len(counts) - 1; last >= 0 && counts[last].classID == classID && counts[last].nftID == nftID {
+			counts[last].count++
+			continue
+		}
+		counts = append(counts, whitelistingCount{
+			classID: classID,
+			nftID:   nftID,
+			count:   1,
+		})
+	}
+
+	return counts, nil
+}
@@ -0,0 +1,45 @@
+package v3_test
+
+import (
+	"testing"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreum/v3/testutil/simapp"
+	v3 "github.com/CoreumFoundation/coreum/v3/x/asset/nft/migrations/v3"
+	"github.com/CoreumFoundation/coreum/v3/x/asset/nft/types"
+)
+
+func TestMigrateWhitelistingCounts(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false, tmproto.Header{})
+	storeKey := testApp.GetKey(types.StoreKey)
+	moduleStore := ctx.KVStore(storeKey)
+
+	classID := types.BuildClassID("symbol", sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()))
+	counts := map[string]uint64{
+		"nft-1": 3,
+		"nft-2": 1,
+	}
+	// the whitelisting is stored without the counts before the migration
+	for nftID, count := range counts {
+		for i := uint64(0); i < count; i++ {
+			key, err := types.CreateWhitelistingKey(classID, nftID, sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()))
+			requireT.NoError(err)
+			moduleStore.Set(key, types.StoreTrue)
+		}
+	}
+
+	requireT.NoError(v3.MigrateWhitelistingCounts(ctx, storeKey))
+
+	for nftID, count := range counts {
+		key, err := types.CreateWhitelistingCountKey(classID, nftID)
+		requireT.NoError(err)
+		requireT.Equal(count, sdk.BigEndianToUint64(moduleStore.Get(key)))
+	}
+}
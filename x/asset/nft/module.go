@@ -143,6 +143,9 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	if err := cfg.RegisterMigration(types.ModuleName, 2, m.Migrate2to3); err != nil {
 		panic(errors.Errorf("can't register module %s migrations, err: %s", types.ModuleName, err))
 	}
+	if err := cfg.RegisterMigration(types.ModuleName, 3, m.Migrate3to4); err != nil {
+		panic(errors.Errorf("can't register module %s migrations, err: %s", types.ModuleName, err))
+	}
 }
 
 // RegisterInvariants registers the assetnft module's invariants.
@@ -168,7 +171,15 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 }
 
 // ConsensusVersion implements ConsensusVersion.
-func (AppModule) ConsensusVersion() uint64 { return 3 }
+func (AppModule) ConsensusVersion() uint64 { return 4 }
+
+// BeginBlock settles the ended marketplace auctions.
+func (am AppModule) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {
+	// the failure is not propagated to not halt the chain, the auctions are settled again in the next block
+	if err := am.keeper.SettleAuctions(ctx); err != nil {
+		ctx.Logger().Error("auctions can't be settled", "module", types.ModuleName, "error", err)
+	}
+}
 
 // AppModuleSimulation functions
 
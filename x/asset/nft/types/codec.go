@@ -13,11 +13,21 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgIssueClass{},
 		&MsgMint{},
+		&MsgMintBatch{},
+		&MsgUpdateData{},
 		&MsgBurn{},
+		&MsgBurnBatch{},
+		&MsgRevoke{},
 		&MsgFreeze{},
 		&MsgUnfreeze{},
+		&MsgClassFreeze{},
+		&MsgClassUnfreeze{},
 		&MsgAddToWhitelist{},
 		&MsgRemoveFromWhitelist{},
+		&MsgListNFT{},
+		&MsgBuyNFT{},
+		&MsgPlaceBid{},
+		&MsgCancelListing{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -19,4 +19,6 @@ var (
 	ErrInvalidKey = sdkerrors.Register(ModuleName, 6, "invalid key")
 	// ErrInvalidState is returned when state of the module is invalid.
 	ErrInvalidState = sdkerrors.Register(ModuleName, 7, "invalid state")
+	// ErrListingNotFound is returned if a marketplace listing not found in the store.
+	ErrListingNotFound = sdkerrors.Register(ModuleName, 8, "listing not found")
 )
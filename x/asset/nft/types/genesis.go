@@ -1,6 +1,7 @@
 package types
 
 import (
+	sdkerrors "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -25,6 +26,17 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
+	frozenClassIDs := make(map[string]struct{}, len(gs.FrozenClassIDs))
+	for _, classID := range gs.FrozenClassIDs {
+		if _, _, err := DeconstructClassID(classID); err != nil {
+			return err
+		}
+		if _, ok := frozenClassIDs[classID]; ok {
+			return sdkerrors.Wrapf(ErrInvalidState, "duplicate frozen class ID %s", classID)
+		}
+		frozenClassIDs[classID] = struct{}{}
+	}
+
 	for _, whitelisted := range gs.WhitelistedNFTAccounts {
 		if err := whitelisted.Validate(); err != nil {
 			return err
@@ -37,6 +49,28 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
+	for _, revoked := range gs.RevokedNFTs {
+		if err := revoked.Validate(); err != nil {
+			return err
+		}
+	}
+
+	listingIDs := make(map[uint64]struct{}, len(gs.Listings))
+	for _, listing := range gs.Listings {
+		if err := listing.Validate(); err != nil {
+			return err
+		}
+		if listing.ID > gs.ListingSequence {
+			return sdkerrors.Wrapf(
+				ErrInvalidState, "listing ID %d is greater than the listing sequence %d", listing.ID, gs.ListingSequence,
+			)
+		}
+		if _, ok := listingIDs[listing.ID]; ok {
+			return sdkerrors.Wrapf(ErrInvalidState, "duplicate listing ID %d", listing.ID)
+		}
+		listingIDs[listing.ID] = struct{}{}
+	}
+
 	return gs.Params.ValidateBasic()
 }
 
@@ -110,3 +144,16 @@ func (b BurntNFT) Validate() error {
 
 	return nil
 }
+
+// Validate performs basic validation on the fields of RevokedNFT.
+func (r RevokedNFT) Validate() error {
+	if _, _, err := DeconstructClassID(r.ClassID); err != nil {
+		return err
+	}
+
+	if err := ValidateTokenID(r.NftID); err != nil {
+		return err
+	}
+
+	return ValidateRevocationReason(r.Reason)
+}
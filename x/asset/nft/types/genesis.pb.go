@@ -33,6 +33,14 @@ type GenesisState struct {
 	WhitelistedNFTAccounts   []WhitelistedNFTAccounts   `protobuf:"bytes,4,rep,name=whitelisted_nft_accounts,json=whitelistedNftAccounts,proto3" json:"whitelisted_nft_accounts"`
 	BurntNFTs                []BurntNFT                 `protobuf:"bytes,5,rep,name=burnt_nfts,json=burntNfts,proto3" json:"burnt_nfts"`
 	ClassWhitelistedAccounts []ClassWhitelistedAccounts `protobuf:"bytes,6,rep,name=class_whitelisted_accounts,json=classWhitelistedAccounts,proto3" json:"class_whitelisted_accounts"`
+	// listings keep the marketplace listings state
+	Listings []Listing `protobuf:"bytes,7,rep,name=listings,proto3" json:"listings"`
+	// listing_sequence is the ID of the last created listing
+	ListingSequence uint64 `protobuf:"varint,8,opt,name=listing_sequence,json=listingSequence,proto3" json:"listing_sequence,omitempty"`
+	// revoked_nfts keep the reasons of the burnt non-fungible tokens revoked by the issuers
+	RevokedNFTs []RevokedNFT `protobuf:"bytes,9,rep,name=revoked_nfts,json=revokedNfts,proto3" json:"revoked_nfts"`
+	// frozen_class_ids keep the IDs of the classes frozen as a whole
+	FrozenClassIDs []string `protobuf:"bytes,10,rep,name=frozen_class_ids,json=frozenClassIds,proto3" json:"frozen_class_ids,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -110,6 +118,34 @@ func (m *GenesisState) GetClassWhitelistedAccounts() []ClassWhitelistedAccounts
 	return nil
 }
 
+func (m *GenesisState) GetListings() []Listing {
+	if m != nil {
+		return m.Listings
+	}
+	return nil
+}
+
+func (m *GenesisState) GetListingSequence() uint64 {
+	if m != nil {
+		return m.ListingSequence
+	}
+	return 0
+}
+
+func (m *GenesisState) GetRevokedNFTs() []RevokedNFT {
+	if m != nil {
+		return m.RevokedNFTs
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFrozenClassIDs() []string {
+	if m != nil {
+		return m.FrozenClassIDs
+	}
+	return nil
+}
+
 type FrozenNFT struct {
 	ClassID string   `protobuf:"bytes,1,opt,name=classID,proto3" json:"classID,omitempty"`
 	NftIDs  []string `protobuf:"bytes,2,rep,name=nftIDs,proto3" json:"nftIDs,omitempty"`
@@ -326,50 +362,120 @@ func (m *BurntNFT) GetNftIDs() []string {
 	return nil
 }
 
+type RevokedNFT struct {
+	ClassID string `protobuf:"bytes,1,opt,name=classID,proto3" json:"classID,omitempty"`
+	NftID   string `protobuf:"bytes,2,opt,name=nftID,proto3" json:"nftID,omitempty"`
+	Reason  string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *RevokedNFT) Reset()         { *m = RevokedNFT{} }
+func (m *RevokedNFT) String() string { return proto.CompactTextString(m) }
+func (*RevokedNFT) ProtoMessage()    {}
+func (*RevokedNFT) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3abcf08d60f6fbfd, []int{5}
+}
+func (m *RevokedNFT) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RevokedNFT) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RevokedNFT.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RevokedNFT) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokedNFT.Merge(m, src)
+}
+func (m *RevokedNFT) XXX_Size() int {
+	return m.Size()
+}
+func (m *RevokedNFT) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokedNFT.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevokedNFT proto.InternalMessageInfo
+
+func (m *RevokedNFT) GetClassID() string {
+	if m != nil {
+		return m.ClassID
+	}
+	return ""
+}
+
+func (m *RevokedNFT) GetNftID() string {
+	if m != nil {
+		return m.NftID
+	}
+	return ""
+}
+
+func (m *RevokedNFT) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "coreum.asset.nft.v1.GenesisState")
 	proto.RegisterType((*FrozenNFT)(nil), "coreum.asset.nft.v1.FrozenNFT")
 	proto.RegisterType((*WhitelistedNFTAccounts)(nil), "coreum.asset.nft.v1.WhitelistedNFTAccounts")
 	proto.RegisterType((*ClassWhitelistedAccounts)(nil), "coreum.asset.nft.v1.ClassWhitelistedAccounts")
 	proto.RegisterType((*BurntNFT)(nil), "coreum.asset.nft.v1.BurntNFT")
+	proto.RegisterType((*RevokedNFT)(nil), "coreum.asset.nft.v1.RevokedNFT")
 }
 
 func init() { proto.RegisterFile("coreum/asset/nft/v1/genesis.proto", fileDescriptor_3abcf08d60f6fbfd) }
 
 var fileDescriptor_3abcf08d60f6fbfd = []byte{
-	// 507 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x4d, 0x6f, 0xd3, 0x40,
-	0x10, 0x8d, 0xd3, 0x34, 0xd4, 0x13, 0x0e, 0x74, 0xa9, 0xa2, 0x95, 0x51, 0xdd, 0x10, 0x71, 0xa8,
-	0x84, 0xb0, 0xd5, 0xf6, 0x80, 0x90, 0xe0, 0x80, 0x1b, 0x05, 0xf5, 0x12, 0x8a, 0x5b, 0xa9, 0x12,
-	0x97, 0xc8, 0x71, 0xd6, 0xa9, 0xa5, 0x66, 0x37, 0x78, 0xd7, 0xe1, 0xe3, 0xce, 0x9d, 0xff, 0xc4,
-	0xa5, 0xc7, 0x1e, 0x39, 0x55, 0x28, 0xf9, 0x23, 0xc8, 0xb3, 0x8e, 0x71, 0x91, 0x53, 0x89, 0xdb,
-	0xce, 0xcc, 0x9b, 0xf7, 0xf6, 0xed, 0xec, 0xc0, 0xd3, 0x50, 0x24, 0x2c, 0x9d, 0xba, 0x81, 0x94,
-	0x4c, 0xb9, 0x3c, 0x52, 0xee, 0xfc, 0xc0, 0x9d, 0x30, 0xce, 0x64, 0x2c, 0x9d, 0x59, 0x22, 0x94,
-	0x20, 0x8f, 0x35, 0xc4, 0x41, 0x88, 0xc3, 0x23, 0xe5, 0xcc, 0x0f, 0xac, 0x9d, 0x89, 0x98, 0x08,
-	0xac, 0xbb, 0xd9, 0x49, 0x43, 0xad, 0x4e, 0x15, 0xdb, 0x2c, 0x48, 0x82, 0x69, 0x4e, 0x66, 0xed,
-	0x56, 0x21, 0x32, 0x4e, 0x2c, 0x77, 0x7f, 0x36, 0xe0, 0xe1, 0x3b, 0xad, 0x7e, 0xa6, 0x02, 0xc5,
-	0xc8, 0x2b, 0x68, 0xea, 0x7e, 0x6a, 0x74, 0x8c, 0xfd, 0xd6, 0xe1, 0x13, 0xa7, 0xe2, 0x36, 0xce,
-	0x29, 0x42, 0xbc, 0xc6, 0xf5, 0xed, 0x5e, 0xcd, 0xcf, 0x1b, 0xc8, 0x05, 0x6c, 0x87, 0x57, 0x81,
-	0x94, 0xc3, 0x31, 0x8b, 0x62, 0x1e, 0xab, 0x58, 0x70, 0x49, 0xeb, 0x9d, 0x8d, 0xfd, 0xd6, 0xe1,
-	0xb3, 0x4a, 0x96, 0xe3, 0x0c, 0xdd, 0x2b, 0xc0, 0x39, 0xdd, 0xa3, 0xf0, 0x6e, 0x5a, 0x92, 0x33,
-	0x68, 0x45, 0x89, 0xf8, 0xc6, 0xf8, 0x90, 0x47, 0x4a, 0xd2, 0x0d, 0xa4, 0xb4, 0x2b, 0x29, 0xfb,
-	0x88, 0x1b, 0xf4, 0xcf, 0x3d, 0x92, 0x91, 0x2d, 0x6e, 0xf7, 0xa0, 0x48, 0x49, 0x1f, 0x34, 0xcd,
-	0x20, 0x52, 0x92, 0x7c, 0x37, 0x80, 0x7e, 0xbe, 0x8c, 0x15, 0xbb, 0x8a, 0xa5, 0x62, 0xe3, 0x8c,
-	0x7a, 0x18, 0x84, 0xa1, 0x48, 0xb9, 0x92, 0xb4, 0x81, 0x12, 0xcf, 0x2b, 0x25, 0x2e, 0xfe, 0x36,
-	0x0d, 0xfa, 0xe7, 0x6f, 0xf3, 0x16, 0xcf, 0xce, 0xf5, 0xda, 0xd5, 0x75, 0xbf, 0x5d, 0x12, 0x1b,
-	0x44, 0x6a, 0x95, 0x27, 0xef, 0x01, 0x46, 0x69, 0xc2, 0x95, 0xf6, 0xb6, 0x89, 0xc2, 0xbb, 0x95,
-	0xc2, 0x5e, 0x06, 0xcb, 0xac, 0x6d, 0xe7, 0x52, 0xe6, 0x2a, 0x23, 0x7d, 0x13, 0x39, 0xd0, 0xd8,
-	0x27, 0xb0, 0xf4, 0x18, 0xca, 0xee, 0x0a, 0x67, 0x4d, 0x14, 0x78, 0xb1, 0x7e, 0x1e, 0xa5, 0xeb,
-	0x17, 0xde, 0xf4, 0x60, 0x68, 0xb8, 0xa6, 0xde, 0x7d, 0x03, 0x66, 0xf1, 0xca, 0x84, 0xc2, 0x03,
-	0x04, 0x9e, 0xf4, 0xf0, 0x0b, 0x99, 0xfe, 0x2a, 0x24, 0x6d, 0x68, 0xf2, 0x48, 0x9d, 0xf4, 0xf4,
-	0xaf, 0x30, 0xfd, 0x3c, 0xea, 0x8e, 0x61, 0xcd, 0xa3, 0xdd, 0xc3, 0xb5, 0x03, 0x9b, 0xd8, 0x4d,
-	0xeb, 0x98, 0xd7, 0x01, 0xb1, 0x60, 0xeb, 0xce, 0x0c, 0x4d, 0xbf, 0x88, 0xbb, 0xa7, 0x40, 0xd7,
-	0x19, 0xbc, 0x47, 0xa7, 0xcc, 0x58, 0xff, 0x87, 0xf1, 0x35, 0x6c, 0xad, 0x26, 0xf0, 0xff, 0xae,
-	0xbd, 0x0f, 0xd7, 0x0b, 0xdb, 0xb8, 0x59, 0xd8, 0xc6, 0xef, 0x85, 0x6d, 0xfc, 0x58, 0xda, 0xb5,
-	0x9b, 0xa5, 0x5d, 0xfb, 0xb5, 0xb4, 0x6b, 0x1f, 0x5f, 0x4e, 0x62, 0x75, 0x99, 0x8e, 0x9c, 0x50,
-	0x4c, 0xdd, 0x63, 0x9c, 0x53, 0x5f, 0xa4, 0x7c, 0x1c, 0x64, 0xdb, 0xe0, 0xe6, 0xfb, 0x3c, 0x3f,
-	0x72, 0xbf, 0x94, 0x96, 0x5a, 0x7d, 0x9d, 0x31, 0x39, 0x6a, 0xe2, 0x52, 0x1f, 0xfd, 0x09, 0x00,
-	0x00, 0xff, 0xff, 0x6a, 0xc5, 0x5d, 0xf6, 0x65, 0x04, 0x00, 0x00,
+	// 644 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xcf, 0x4b, 0x1b, 0x41,
+	0x14, 0xce, 0x1a, 0x8d, 0xd9, 0x17, 0xb1, 0x3a, 0x4a, 0x18, 0xd2, 0xba, 0x49, 0x43, 0x0b, 0x29,
+	0xa5, 0xbb, 0xa8, 0x87, 0x52, 0xb0, 0x85, 0x46, 0x49, 0x11, 0x4a, 0x6a, 0x57, 0x41, 0xe8, 0x25,
+	0xac, 0x9b, 0xd9, 0xb8, 0x68, 0x66, 0xe2, 0xce, 0x6c, 0xfa, 0xe3, 0xde, 0x7b, 0xff, 0xa9, 0x82,
+	0x47, 0x8f, 0x3d, 0x49, 0x89, 0xff, 0x48, 0xd9, 0x99, 0xc9, 0xba, 0x96, 0x8d, 0xd0, 0xde, 0xf6,
+	0x7d, 0xf3, 0xbd, 0xef, 0x9b, 0xf7, 0x63, 0x07, 0x1e, 0xfb, 0x2c, 0x22, 0xf1, 0xd0, 0xf1, 0x38,
+	0x27, 0xc2, 0xa1, 0x81, 0x70, 0xc6, 0x9b, 0xce, 0x80, 0x50, 0xc2, 0x43, 0x6e, 0x8f, 0x22, 0x26,
+	0x18, 0x5a, 0x53, 0x14, 0x5b, 0x52, 0x6c, 0x1a, 0x08, 0x7b, 0xbc, 0x59, 0x5b, 0x1f, 0xb0, 0x01,
+	0x93, 0xe7, 0x4e, 0xf2, 0xa5, 0xa8, 0xb5, 0xa7, 0x79, 0x6a, 0x43, 0x2f, 0x3a, 0x23, 0x62, 0x74,
+	0xee, 0xf9, 0x44, 0xd3, 0x1a, 0x79, 0xb4, 0x91, 0x17, 0x79, 0x43, 0xed, 0x59, 0xdb, 0xc8, 0x63,
+	0x24, 0xd6, 0xf2, 0xb8, 0xf9, 0xb3, 0x04, 0x4b, 0xef, 0xd4, 0x25, 0x0f, 0x85, 0x27, 0x08, 0x7a,
+	0x05, 0x25, 0x95, 0x8f, 0x8d, 0x86, 0xd1, 0xaa, 0x6c, 0x3d, 0xb4, 0x73, 0x2e, 0x6d, 0x1f, 0x48,
+	0x4a, 0x7b, 0xfe, 0xf2, 0xba, 0x5e, 0x70, 0x75, 0x02, 0x3a, 0x86, 0x55, 0xff, 0xdc, 0xe3, 0xbc,
+	0xd7, 0x27, 0x41, 0x48, 0x43, 0x11, 0x32, 0xca, 0xf1, 0x5c, 0xa3, 0xd8, 0xaa, 0x6c, 0x3d, 0xc9,
+	0x55, 0xd9, 0x4d, 0xd8, 0x7b, 0x29, 0x59, 0xcb, 0xad, 0xf8, 0x77, 0x61, 0x8e, 0x0e, 0xa1, 0x12,
+	0x44, 0xec, 0x1b, 0xa1, 0x3d, 0x1a, 0x08, 0x8e, 0x8b, 0x52, 0xd2, 0xca, 0x95, 0xec, 0x48, 0x5e,
+	0xb7, 0x73, 0xd4, 0x46, 0x89, 0xd8, 0xe4, 0xba, 0x0e, 0x29, 0xc4, 0x5d, 0x50, 0x32, 0xdd, 0x40,
+	0x70, 0xf4, 0xdd, 0x00, 0xfc, 0xf9, 0x34, 0x14, 0xe4, 0x3c, 0xe4, 0x82, 0xf4, 0x13, 0xe9, 0x9e,
+	0xe7, 0xfb, 0x2c, 0xa6, 0x82, 0xe3, 0x79, 0x69, 0xf1, 0x3c, 0xd7, 0xe2, 0xf8, 0x36, 0xa9, 0xdb,
+	0x39, 0x7a, 0xab, 0x53, 0xda, 0x96, 0xf6, 0xab, 0xe6, 0x9f, 0xbb, 0xd5, 0x8c, 0x59, 0x37, 0x10,
+	0x53, 0x1c, 0x7d, 0x00, 0x38, 0x89, 0x23, 0x2a, 0x54, 0x6d, 0x0b, 0xd2, 0x78, 0x23, 0xd7, 0xb8,
+	0x9d, 0xd0, 0x92, 0xd2, 0x56, 0xb5, 0x95, 0x39, 0x45, 0xb8, 0x6b, 0x4a, 0x0d, 0x59, 0xd8, 0x05,
+	0xd4, 0xd4, 0x18, 0xb2, 0xd5, 0xa5, 0x95, 0x95, 0xa4, 0xc1, 0x8b, 0xd9, 0xf3, 0xc8, 0x5c, 0x3f,
+	0xad, 0x4d, 0x0d, 0x06, 0xfb, 0x33, 0xce, 0xd1, 0x1b, 0x28, 0x27, 0x48, 0x48, 0x07, 0x1c, 0x2f,
+	0x4a, 0x83, 0x47, 0xb9, 0x06, 0xef, 0x15, 0x49, 0xeb, 0xa5, 0x39, 0xe8, 0x19, 0xac, 0xe8, 0xef,
+	0x1e, 0x27, 0x17, 0x31, 0xa1, 0x3e, 0xc1, 0xe5, 0x86, 0xd1, 0x9a, 0x77, 0x1f, 0x68, 0xfc, 0x50,
+	0xc3, 0xe8, 0x18, 0x96, 0x22, 0x32, 0x66, 0x67, 0x6a, 0x62, 0x1c, 0x9b, 0xd2, 0xae, 0x9e, 0x6b,
+	0xe7, 0x2a, 0x62, 0xd2, 0xb2, 0x35, 0xdd, 0xb2, 0xca, 0x2d, 0xc6, 0xdd, 0x8a, 0x56, 0x92, 0x6d,
+	0xdb, 0x81, 0x15, 0xbd, 0x64, 0xaa, 0x7b, 0x61, 0x9f, 0x63, 0x68, 0x14, 0x5b, 0x66, 0x1b, 0x4d,
+	0xae, 0xeb, 0xcb, 0x6a, 0x8b, 0x64, 0x87, 0xf6, 0xf7, 0xb8, 0xbb, 0x1c, 0x64, 0xe2, 0x3e, 0x6f,
+	0xbe, 0x06, 0x33, 0xdd, 0x33, 0x84, 0x61, 0xd1, 0x57, 0x44, 0xf9, 0x13, 0x99, 0xee, 0x34, 0x44,
+	0x55, 0x28, 0xd1, 0x40, 0xec, 0xef, 0xa9, 0xff, 0xc2, 0x74, 0x75, 0xd4, 0xec, 0xc3, 0x8c, 0xb5,
+	0xb9, 0x47, 0x6b, 0x1d, 0x16, 0x64, 0x36, 0x9e, 0x93, 0xb8, 0x0a, 0x50, 0x0d, 0xca, 0x77, 0xb6,
+	0xd8, 0x74, 0xd3, 0xb8, 0x79, 0x00, 0x78, 0xd6, 0x88, 0xef, 0xf1, 0xc9, 0x2a, 0xce, 0xfd, 0xa5,
+	0xb8, 0x03, 0xe5, 0xe9, 0x0e, 0xfe, 0x47, 0xd5, 0x47, 0x00, 0xb7, 0xe3, 0xf8, 0xe7, 0x4a, 0xab,
+	0x50, 0x8a, 0x88, 0xc7, 0x19, 0xc5, 0x45, 0x09, 0xeb, 0xa8, 0xfd, 0xf1, 0x72, 0x62, 0x19, 0x57,
+	0x13, 0xcb, 0xf8, 0x3d, 0xb1, 0x8c, 0x1f, 0x37, 0x56, 0xe1, 0xea, 0xc6, 0x2a, 0xfc, 0xba, 0xb1,
+	0x0a, 0x9f, 0x5e, 0x0e, 0x42, 0x71, 0x1a, 0x9f, 0xd8, 0x3e, 0x1b, 0x3a, 0xbb, 0x72, 0x5f, 0x3a,
+	0x2c, 0xa6, 0x7d, 0x2f, 0x79, 0x65, 0x1c, 0xfd, 0x4e, 0x8e, 0xb7, 0x9d, 0x2f, 0x99, 0xc7, 0x52,
+	0x7c, 0x1d, 0x11, 0x7e, 0x52, 0x92, 0x8f, 0xe5, 0xf6, 0x9f, 0x01, 0x00, 0x64, 0xfa, 0x74, 0x51,
+	0xe4, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -392,6 +498,48 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FrozenClassIDs) > 0 {
+		for iNdEx := len(m.FrozenClassIDs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FrozenClassIDs[iNdEx])
+			copy(dAtA[i:], m.FrozenClassIDs[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.FrozenClassIDs[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.RevokedNFTs) > 0 {
+		for iNdEx := len(m.RevokedNFTs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RevokedNFTs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.ListingSequence != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ListingSequence))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Listings) > 0 {
+		for iNdEx := len(m.Listings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Listings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if len(m.ClassWhitelistedAccounts) > 0 {
 		for iNdEx := len(m.ClassWhitelistedAccounts) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -638,6 +786,50 @@ func (m *BurntNFT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RevokedNFT) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RevokedNFT) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RevokedNFT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.NftID) > 0 {
+		i -= len(m.NftID)
+		copy(dAtA[i:], m.NftID)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.NftID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClassID) > 0 {
+		i -= len(m.ClassID)
+		copy(dAtA[i:], m.ClassID)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ClassID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -687,6 +879,27 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.Listings) > 0 {
+		for _, e := range m.Listings {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.ListingSequence != 0 {
+		n += 1 + sovGenesis(uint64(m.ListingSequence))
+	}
+	if len(m.RevokedNFTs) > 0 {
+		for _, e := range m.RevokedNFTs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FrozenClassIDs) > 0 {
+		for _, s := range m.FrozenClassIDs {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -770,6 +983,27 @@ func (m *BurntNFT) Size() (n int) {
 	return n
 }
 
+func (m *RevokedNFT) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassID)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.NftID)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
 func sovGenesis(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1008,6 +1242,125 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Listings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Listings = append(m.Listings, Listing{})
+			if err := m.Listings[len(m.Listings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListingSequence", wireType)
+			}
+			m.ListingSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ListingSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevokedNFTs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RevokedNFTs = append(m.RevokedNFTs, RevokedNFT{})
+			if err := m.RevokedNFTs[len(m.RevokedNFTs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrozenClassIDs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FrozenClassIDs = append(m.FrozenClassIDs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1517,6 +1870,152 @@ func (m *BurntNFT) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RevokedNFT) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RevokedNFT: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RevokedNFT: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NftID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NftID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGenesis(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
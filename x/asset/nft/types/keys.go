@@ -1,6 +1,9 @@
 package types
 
 import (
+	"encoding/binary"
+	"time"
+
 	sdkerrors "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -35,6 +38,24 @@ var (
 	ParamsKey = []byte{0x05}
 	// NFTClassWhitelistingKeyPrefix defines the key prefix to track whitelisted account for class.
 	NFTClassWhitelistingKeyPrefix = []byte{0x06}
+	// ListingKeyPrefix defines the key prefix for the marketplace listings.
+	ListingKeyPrefix = []byte{0x07}
+	// ListingClassKeyPrefix defines the key prefix to index the marketplace listings by class.
+	ListingClassKeyPrefix = []byte{0x08}
+	// ListingSellerKeyPrefix defines the key prefix to index the marketplace listings by seller.
+	ListingSellerKeyPrefix = []byte{0x09}
+	// AuctionEndKeyPrefix defines the key prefix to track the auctions by their end time.
+	AuctionEndKeyPrefix = []byte{0x0a}
+	// ListingSequenceKey defines the key to store the ID of the last created listing.
+	ListingSequenceKey = []byte{0x0b}
+	// NFTClassFreezingKeyPrefix defines the key prefix to track frozen classes.
+	NFTClassFreezingKeyPrefix = []byte{0x0c}
+	// NFTRevocationKeyPrefix defines the key prefix to track revoked NFTs.
+	NFTRevocationKeyPrefix = []byte{0x0d}
+	// NFTWhitelistingCountKeyPrefix defines the key prefix to track the number of accounts whitelisted for NFTs.
+	NFTWhitelistingCountKeyPrefix = []byte{0x0e}
+	// AuctionSettlementAttemptsKeyPrefix defines the key prefix to track the failed settlement attempts of auctions.
+	AuctionSettlementAttemptsKeyPrefix = []byte{0x0f}
 )
 
 // StoreTrue keeps a value used by stores to indicate that key is present.
@@ -87,6 +108,29 @@ func ParseFreezingKey(key []byte) (string, string, error) {
 	return string(parsedKeys[0]), string(parsedKeys[1]), nil
 }
 
+// CreateClassFreezingKey constructs the key for the freezing of the whole class of non-fungible tokens.
+func CreateClassFreezingKey(classID string) ([]byte, error) {
+	classKey, err := store.JoinKeysWithLength([]byte(classID))
+	if err != nil {
+		return nil, sdkerrors.Wrapf(ErrInvalidKey, "failed to create a class freezing key, err: %s", err)
+	}
+
+	return store.JoinKeys(NFTClassFreezingKeyPrefix, classKey), nil
+}
+
+// ParseClassFreezingKey parses class freezing key back to class id.
+func ParseClassFreezingKey(key []byte) (string, error) {
+	parsedKeys, err := store.ParseLengthPrefixedKeys(key)
+	if err != nil {
+		return "", sdkerrors.Wrapf(ErrInvalidKey, "failed to parse a class freezing key, err: %s", err)
+	}
+	if len(parsedKeys) != 1 {
+		err = sdkerrors.Wrapf(ErrInvalidKey, "class freezing key must be composed of 1 length prefixed key")
+		return "", err
+	}
+	return string(parsedKeys[0]), nil
+}
+
 // CreateWhitelistingKey constructs the key for the whitelisting of non-fungible token.
 func CreateWhitelistingKey(classID, nftID string, account sdk.AccAddress) ([]byte, error) {
 	compositeKey, err := store.JoinKeysWithLength([]byte(classID), []byte(nftID), account)
@@ -110,6 +154,16 @@ func ParseWhitelistingKey(key []byte) (string, string, sdk.AccAddress, error) {
 	return string(parsedKeys[0]), string(parsedKeys[1]), parsedKeys[2], nil
 }
 
+// CreateWhitelistingCountKey constructs the key for the number of accounts whitelisted for the non-fungible token.
+func CreateWhitelistingCountKey(classID, nftID string) ([]byte, error) {
+	compositeKey, err := store.JoinKeysWithLength([]byte(classID), []byte(nftID))
+	if err != nil {
+		return nil, sdkerrors.Wrapf(ErrInvalidKey, "failed to create a whitelisting count key, err: %s", err)
+	}
+
+	return store.JoinKeys(NFTWhitelistingCountKeyPrefix, compositeKey), nil
+}
+
 // CreateClassWhitelistingKey constructs the key for the whitelisting of class for non-fungible tokens.
 func CreateClassWhitelistingKey(classID string, account sdk.AccAddress) ([]byte, error) {
 	compositeKey, err := store.JoinKeysWithLength([]byte(classID), account)
@@ -165,3 +219,102 @@ func ParseBurningKey(key []byte) (string, string, error) {
 	}
 	return string(parsedKeys[0]), string(parsedKeys[1]), nil
 }
+
+// CreateRevocationKey constructs the key for the revocation of non-fungible token.
+func CreateRevocationKey(classID, nftID string) ([]byte, error) {
+	compositeKey, err := store.JoinKeysWithLength([]byte(classID), []byte(nftID))
+	if err != nil {
+		return nil, sdkerrors.Wrapf(ErrInvalidKey, "failed to create a revocation key, err: %s", err)
+	}
+
+	return store.JoinKeys(NFTRevocationKeyPrefix, compositeKey), nil
+}
+
+// ParseRevocationKey parses revocation key back to class id and nft id.
+func ParseRevocationKey(key []byte) (string, string, error) {
+	parsedKeys, err := store.ParseLengthPrefixedKeys(key)
+	if err != nil {
+		return "", "", sdkerrors.Wrapf(ErrInvalidKey, "failed to parse a revocation key, err: %s", err)
+	}
+	if len(parsedKeys) != 2 {
+		err = sdkerrors.Wrapf(ErrInvalidKey, "revocation key must be composed to 2 length prefixed keys")
+		return "", "", err
+	}
+	return string(parsedKeys[0]), string(parsedKeys[1]), nil
+}
+
+// CreateListingKey constructs the key for the marketplace listing.
+func CreateListingKey(listingID uint64) []byte {
+	return store.JoinKeys(ListingKeyPrefix, uint64ToBytes(listingID))
+}
+
+// CreateClassListingPrefix constructs the key prefix for the marketplace listings of the class.
+func CreateClassListingPrefix(classID string) ([]byte, error) {
+	classKey, err := store.JoinKeysWithLength([]byte(classID))
+	if err != nil {
+		return nil, sdkerrors.Wrapf(ErrInvalidKey, "failed to create a class listing prefix, err: %s", err)
+	}
+
+	return store.JoinKeys(ListingClassKeyPrefix, classKey), nil
+}
+
+// CreateClassListingKey constructs the key to index the marketplace listing by class.
+func CreateClassListingKey(classID string, listingID uint64) ([]byte, error) {
+	prefix, err := CreateClassListingPrefix(classID)
+	if err != nil {
+		return nil, err
+	}
+
+	return store.JoinKeys(prefix, uint64ToBytes(listingID)), nil
+}
+
+// CreateSellerListingPrefix constructs the key prefix for the marketplace listings of the seller.
+func CreateSellerListingPrefix(seller sdk.AccAddress) ([]byte, error) {
+	sellerKey, err := store.JoinKeysWithLength(seller)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(ErrInvalidKey, "failed to create a seller listing prefix, err: %s", err)
+	}
+
+	return store.JoinKeys(ListingSellerKeyPrefix, sellerKey), nil
+}
+
+// CreateSellerListingKey constructs the key to index the marketplace listing by seller.
+func CreateSellerListingKey(seller sdk.AccAddress, listingID uint64) ([]byte, error) {
+	prefix, err := CreateSellerListingPrefix(seller)
+	if err != nil {
+		return nil, err
+	}
+
+	return store.JoinKeys(prefix, uint64ToBytes(listingID)), nil
+}
+
+// CreateAuctionEndKey constructs the key to track the auction by its end time.
+func CreateAuctionEndKey(endTime time.Time, listingID uint64) []byte {
+	return store.JoinKeys(CreateAuctionEndPrefix(endTime), uint64ToBytes(listingID))
+}
+
+// CreateAuctionEndPrefix constructs the key prefix of the auctions ending at the provided time.
+func CreateAuctionEndPrefix(endTime time.Time) []byte {
+	// big endian is used to be sure that results are sortable lexicographically when auctions are iterated
+	return store.JoinKeys(AuctionEndKeyPrefix, uint64ToBytes(uint64(endTime.UnixNano())))
+}
+
+// CreateAuctionSettlementAttemptsKey constructs the key to track the failed settlement attempts of the auction.
+func CreateAuctionSettlementAttemptsKey(listingID uint64) []byte {
+	return store.JoinKeys(AuctionSettlementAttemptsKeyPrefix, uint64ToBytes(listingID))
+}
+
+// ParseListingID parses the listing ID from the end of the index key.
+func ParseListingID(key []byte) (uint64, error) {
+	if len(key) < 8 {
+		return 0, sdkerrors.Wrapf(ErrInvalidKey, "listing key is too short")
+	}
+
+	return binary.BigEndian.Uint64(key[len(key)-8:]), nil
+}
+
+func uint64ToBytes(v uint64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, v)
+	return b
+}
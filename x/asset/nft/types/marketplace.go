@@ -0,0 +1,105 @@
+package types
+
+import (
+	"time"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxAuctionDuration is the maximum duration of the auction.
+const MaxAuctionDuration = 30 * 24 * time.Hour
+
+// MaxAuctionSettlementAttempts is the maximum number of blocks in which the settlement of the ended auction is
+// attempted before it is removed from the settlement queue.
+const MaxAuctionSettlementAttempts = 10
+
+// ListingSettings is the model which represents the params for the non-fungible token listing.
+type ListingSettings struct {
+	Seller          sdk.AccAddress
+	ClassID         string
+	ID              string
+	Type            ListingType
+	Price           sdk.Coin
+	AuctionDuration time.Duration
+}
+
+// ValidateListingTerms validates the type, price and auction duration of the listing.
+func ValidateListingTerms(listingType ListingType, price sdk.Coin, auctionDuration time.Duration) error {
+	if _, ok := ListingType_name[int32(listingType)]; !ok {
+		return sdkerrors.Wrapf(ErrInvalidInput, "unknown listing type %d", listingType)
+	}
+
+	if !price.IsValid() || !price.IsPositive() {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid price %s, it must be positive", price)
+	}
+
+	if listingType == ListingType_fixed_price {
+		if auctionDuration != 0 {
+			return sdkerrors.Wrap(ErrInvalidInput, "auction duration must not be set for the fixed-price listing")
+		}
+		return nil
+	}
+
+	if auctionDuration <= 0 || auctionDuration > MaxAuctionDuration {
+		return sdkerrors.Wrapf(
+			ErrInvalidInput, "invalid auction duration %s, it must be positive and not greater than %s",
+			auctionDuration, MaxAuctionDuration,
+		)
+	}
+
+	return nil
+}
+
+// HasBid returns true if any bid has been placed on the auction.
+func (l Listing) HasBid() bool {
+	return l.HighestBidder != ""
+}
+
+// Validate performs basic validation on the fields of Listing.
+func (l Listing) Validate() error {
+	if l.ID == 0 {
+		return sdkerrors.Wrap(ErrInvalidInput, "listing ID must be positive")
+	}
+
+	if _, _, err := DeconstructClassID(l.ClassID); err != nil {
+		return err
+	}
+
+	if err := ValidateTokenID(l.NftID); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(l.Seller); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid seller account %s", l.Seller)
+	}
+
+	if _, ok := ListingType_name[int32(l.Type)]; !ok {
+		return sdkerrors.Wrapf(ErrInvalidInput, "unknown listing type %d", l.Type)
+	}
+
+	if !l.Price.IsValid() || !l.Price.IsPositive() {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid price %s, it must be positive", l.Price)
+	}
+
+	if l.Type == ListingType_fixed_price {
+		if l.HasBid() {
+			return sdkerrors.Wrap(ErrInvalidInput, "fixed-price listing can't have bids")
+		}
+		return nil
+	}
+
+	if !l.HasBid() {
+		return nil
+	}
+
+	if _, err := sdk.AccAddressFromBech32(l.HighestBidder); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid bidder account %s", l.HighestBidder)
+	}
+
+	if !l.HighestBid.IsValid() || l.HighestBid.Denom != l.Price.Denom || l.HighestBid.IsLT(l.Price) {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid highest bid %s", l.HighestBid)
+	}
+
+	return nil
+}
@@ -0,0 +1,754 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: coreum/asset/nft/v1/marketplace.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ListingType defines the way the listed non-fungible token is sold.
+type ListingType int32
+
+const (
+	ListingType_fixed_price ListingType = 0
+	ListingType_auction     ListingType = 1
+)
+
+var ListingType_name = map[int32]string{
+	0: "fixed_price",
+	1: "auction",
+}
+
+var ListingType_value = map[string]int32{
+	"fixed_price": 0,
+	"auction":     1,
+}
+
+func (x ListingType) String() string {
+	return proto.EnumName(ListingType_name, int32(x))
+}
+
+func (ListingType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_d8ef51c206e46982, []int{0}
+}
+
+// Listing defines the non-fungible token put on sale on the marketplace.
+type Listing struct {
+	ID      uint64      `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ClassID string      `protobuf:"bytes,2,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	NftID   string      `protobuf:"bytes,3,opt,name=nft_id,json=nftId,proto3" json:"nft_id,omitempty"`
+	Seller  string      `protobuf:"bytes,4,opt,name=seller,proto3" json:"seller,omitempty"`
+	Type    ListingType `protobuf:"varint,5,opt,name=type,proto3,enum=coreum.asset.nft.v1.ListingType" json:"type,omitempty"`
+	// price is the price of the fixed-price listing or the minimal bid of the auction.
+	Price types.Coin `protobuf:"bytes,6,opt,name=price,proto3" json:"price"`
+	// end_time is the time when the auction is settled, it is not set for the fixed-price listing.
+	EndTime time.Time `protobuf:"bytes,7,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+	// highest_bidder is the account placed the highest bid of the auction.
+	HighestBidder string `protobuf:"bytes,8,opt,name=highest_bidder,json=highestBidder,proto3" json:"highest_bidder,omitempty"`
+	// highest_bid is the highest bid of the auction, the amount is kept by the module until the auction is settled.
+	HighestBid types.Coin `protobuf:"bytes,9,opt,name=highest_bid,json=highestBid,proto3" json:"highest_bid"`
+}
+
+func (m *Listing) Reset()         { *m = Listing{} }
+func (m *Listing) String() string { return proto.CompactTextString(m) }
+func (*Listing) ProtoMessage()    {}
+func (*Listing) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8ef51c206e46982, []int{0}
+}
+func (m *Listing) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Listing) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Listing.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Listing) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Listing.Merge(m, src)
+}
+func (m *Listing) XXX_Size() int {
+	return m.Size()
+}
+func (m *Listing) XXX_DiscardUnknown() {
+	xxx_messageInfo_Listing.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Listing proto.InternalMessageInfo
+
+func (m *Listing) GetID() uint64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *Listing) GetClassID() string {
+	if m != nil {
+		return m.ClassID
+	}
+	return ""
+}
+
+func (m *Listing) GetNftID() string {
+	if m != nil {
+		return m.NftID
+	}
+	return ""
+}
+
+func (m *Listing) GetSeller() string {
+	if m != nil {
+		return m.Seller
+	}
+	return ""
+}
+
+func (m *Listing) GetType() ListingType {
+	if m != nil {
+		return m.Type
+	}
+	return ListingType_fixed_price
+}
+
+func (m *Listing) GetPrice() types.Coin {
+	if m != nil {
+		return m.Price
+	}
+	return types.Coin{}
+}
+
+func (m *Listing) GetEndTime() time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return time.Time{}
+}
+
+func (m *Listing) GetHighestBidder() string {
+	if m != nil {
+		return m.HighestBidder
+	}
+	return ""
+}
+
+func (m *Listing) GetHighestBid() types.Coin {
+	if m != nil {
+		return m.HighestBid
+	}
+	return types.Coin{}
+}
+
+func init() {
+	proto.RegisterEnum("coreum.asset.nft.v1.ListingType", ListingType_name, ListingType_value)
+	proto.RegisterType((*Listing)(nil), "coreum.asset.nft.v1.Listing")
+}
+
+func init() {
+	proto.RegisterFile("coreum/asset/nft/v1/marketplace.proto", fileDescriptor_d8ef51c206e46982)
+}
+
+var fileDescriptor_d8ef51c206e46982 = []byte{
+	// 475 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x41, 0x6b, 0xdb, 0x30,
+	0x18, 0x86, 0xe3, 0x34, 0x89, 0x13, 0x99, 0x75, 0x45, 0x1b, 0xc5, 0xcb, 0xc1, 0x36, 0x83, 0x8e,
+	0xb0, 0x81, 0x44, 0xda, 0x8d, 0x1d, 0x37, 0x9c, 0x30, 0x08, 0x8c, 0xc1, 0x4c, 0x4f, 0xbb, 0x04,
+	0xdb, 0x92, 0x1d, 0xb1, 0x58, 0x32, 0x96, 0x1c, 0xda, 0x7f, 0xd1, 0xf3, 0x7e, 0x51, 0x8f, 0x3d,
+	0xee, 0x94, 0x0d, 0xe7, 0x8f, 0x0c, 0xc9, 0x2e, 0xcb, 0x61, 0x87, 0xdd, 0xa4, 0xd7, 0xcf, 0x6b,
+	0xf4, 0x3d, 0x7c, 0xe0, 0x22, 0x15, 0x15, 0xad, 0x0b, 0x1c, 0x4b, 0x49, 0x15, 0xe6, 0x99, 0xc2,
+	0xbb, 0x39, 0x2e, 0xe2, 0xea, 0x3b, 0x55, 0xe5, 0x36, 0x4e, 0x29, 0x2a, 0x2b, 0xa1, 0x04, 0x7c,
+	0xd6, 0x62, 0xc8, 0x60, 0x88, 0x67, 0x0a, 0xed, 0xe6, 0x53, 0x2f, 0x15, 0xb2, 0x10, 0x12, 0x27,
+	0xb1, 0xa4, 0x78, 0x37, 0x4f, 0xa8, 0x8a, 0xe7, 0x38, 0x15, 0x8c, 0xb7, 0xa5, 0xe9, 0xf3, 0x5c,
+	0xe4, 0xc2, 0x1c, 0xb1, 0x3e, 0x75, 0xa9, 0x9f, 0x0b, 0x91, 0x6f, 0x29, 0x36, 0xb7, 0xa4, 0xce,
+	0xb0, 0x62, 0x05, 0x95, 0x2a, 0x2e, 0xca, 0x16, 0x78, 0xf9, 0xe3, 0x04, 0xd8, 0x9f, 0x99, 0x54,
+	0x8c, 0xe7, 0xf0, 0x1c, 0xf4, 0x19, 0x71, 0xad, 0xc0, 0x9a, 0x0d, 0xc2, 0x51, 0xb3, 0xf7, 0xfb,
+	0xab, 0x65, 0xd4, 0x67, 0x04, 0xbe, 0x02, 0xe3, 0x74, 0x1b, 0x4b, 0xb9, 0x66, 0xc4, 0xed, 0x07,
+	0xd6, 0x6c, 0x12, 0x3a, 0xcd, 0xde, 0xb7, 0x17, 0x3a, 0x5b, 0x2d, 0x23, 0xdb, 0x7c, 0x5c, 0x11,
+	0x18, 0x80, 0x11, 0xcf, 0x94, 0xa6, 0x4e, 0x0c, 0x35, 0x69, 0xf6, 0xfe, 0xf0, 0x4b, 0xa6, 0x56,
+	0xcb, 0x68, 0xc8, 0x33, 0xb5, 0x22, 0xf0, 0x1c, 0x8c, 0x24, 0xdd, 0x6e, 0x69, 0xe5, 0x0e, 0x34,
+	0x11, 0x75, 0x37, 0xf8, 0x16, 0x0c, 0xd4, 0x6d, 0x49, 0xdd, 0x61, 0x60, 0xcd, 0x4e, 0x2f, 0x03,
+	0xf4, 0x0f, 0x01, 0xa8, 0x7b, 0xe5, 0xf5, 0x6d, 0x49, 0x23, 0x43, 0xc3, 0x77, 0x60, 0x58, 0x56,
+	0x2c, 0xa5, 0xee, 0x28, 0xb0, 0x66, 0xce, 0xe5, 0x0b, 0xd4, 0x2a, 0x42, 0x5a, 0x11, 0xea, 0x14,
+	0xa1, 0x85, 0x60, 0x3c, 0x1c, 0xdc, 0xef, 0xfd, 0x5e, 0xd4, 0xd2, 0xf0, 0x03, 0x18, 0x53, 0x4e,
+	0xd6, 0xda, 0x84, 0x6b, 0x9b, 0xe6, 0x14, 0xb5, 0x9a, 0xd0, 0xa3, 0x26, 0x74, 0xfd, 0xa8, 0x29,
+	0x1c, 0xeb, 0xea, 0xdd, 0x2f, 0xdf, 0x8a, 0x6c, 0xca, 0x89, 0xce, 0xe1, 0x05, 0x38, 0xdd, 0xb0,
+	0x7c, 0x43, 0xa5, 0x5a, 0x27, 0x8c, 0x10, 0x5a, 0xb9, 0x63, 0x33, 0xcd, 0x93, 0x2e, 0x0d, 0x4d,
+	0x08, 0x3f, 0x02, 0xe7, 0x08, 0x73, 0x27, 0xff, 0xf7, 0x48, 0xf0, 0xf7, 0x27, 0xaf, 0xdf, 0x00,
+	0xe7, 0x68, 0x6a, 0xf8, 0x14, 0x38, 0x19, 0xbb, 0xa1, 0x64, 0x6d, 0xe6, 0x38, 0xeb, 0x41, 0x07,
+	0xd8, 0x71, 0x9d, 0x2a, 0x26, 0xf8, 0x99, 0x15, 0x7e, 0xbd, 0x6f, 0x3c, 0xeb, 0xa1, 0xf1, 0xac,
+	0xdf, 0x8d, 0x67, 0xdd, 0x1d, 0xbc, 0xde, 0xc3, 0xc1, 0xeb, 0xfd, 0x3c, 0x78, 0xbd, 0x6f, 0xef,
+	0x73, 0xa6, 0x36, 0x75, 0x82, 0x52, 0x51, 0xe0, 0x85, 0x31, 0xfb, 0x49, 0xd4, 0x9c, 0xc4, 0xba,
+	0x88, 0xbb, 0x95, 0xdc, 0x5d, 0xe1, 0x9b, 0xa3, 0xbd, 0xd4, 0x7e, 0x65, 0x32, 0x32, 0x3e, 0xae,
+	0xfe, 0x0c, 0x00, 0x4e, 0x92, 0x0f, 0x9c, 0xb8, 0x02, 0x00, 0x00,
+}
+
+func (m *Listing) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Listing) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Listing) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.HighestBid.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMarketplace(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if len(m.HighestBidder) > 0 {
+		i -= len(m.HighestBidder)
+		copy(dAtA[i:], m.HighestBidder)
+		i = encodeVarintMarketplace(dAtA, i, uint64(len(m.HighestBidder)))
+		i--
+		dAtA[i] = 0x42
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintMarketplace(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x3a
+	{
+		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMarketplace(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.Type != 0 {
+		i = encodeVarintMarketplace(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Seller) > 0 {
+		i -= len(m.Seller)
+		copy(dAtA[i:], m.Seller)
+		i = encodeVarintMarketplace(dAtA, i, uint64(len(m.Seller)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.NftID) > 0 {
+		i -= len(m.NftID)
+		copy(dAtA[i:], m.NftID)
+		i = encodeVarintMarketplace(dAtA, i, uint64(len(m.NftID)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ClassID) > 0 {
+		i -= len(m.ClassID)
+		copy(dAtA[i:], m.ClassID)
+		i = encodeVarintMarketplace(dAtA, i, uint64(len(m.ClassID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ID != 0 {
+		i = encodeVarintMarketplace(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMarketplace(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMarketplace(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Listing) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovMarketplace(uint64(m.ID))
+	}
+	l = len(m.ClassID)
+	if l > 0 {
+		n += 1 + l + sovMarketplace(uint64(l))
+	}
+	l = len(m.NftID)
+	if l > 0 {
+		n += 1 + l + sovMarketplace(uint64(l))
+	}
+	l = len(m.Seller)
+	if l > 0 {
+		n += 1 + l + sovMarketplace(uint64(l))
+	}
+	if m.Type != 0 {
+		n += 1 + sovMarketplace(uint64(m.Type))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovMarketplace(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovMarketplace(uint64(l))
+	l = len(m.HighestBidder)
+	if l > 0 {
+		n += 1 + l + sovMarketplace(uint64(l))
+	}
+	l = m.HighestBid.Size()
+	n += 1 + l + sovMarketplace(uint64(l))
+	return n
+}
+
+func sovMarketplace(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMarketplace(x uint64) (n int) {
+	return sovMarketplace(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Listing) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarketplace
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Listing: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Listing: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarketplace
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarketplace
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarketplace
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarketplace
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NftID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarketplace
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarketplace
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarketplace
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NftID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarketplace
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarketplace
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarketplace
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Seller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarketplace
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= ListingType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarketplace
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarketplace
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarketplace
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarketplace
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarketplace
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarketplace
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HighestBidder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarketplace
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarketplace
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarketplace
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HighestBidder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HighestBid", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarketplace
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarketplace
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarketplace
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.HighestBid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarketplace(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarketplace
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipMarketplace(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowMarketplace
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMarketplace
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMarketplace
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthMarketplace
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupMarketplace
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthMarketplace
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthMarketplace        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowMarketplace          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupMarketplace = fmt.Errorf("proto: unexpected end of group")
+)
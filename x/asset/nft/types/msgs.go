@@ -16,14 +16,24 @@ import (
 const (
 	TypeMsgIssueClass               = "issue-class"
 	TypeMsgMint                     = "mint"
+	TypeMsgMintBatch                = "mint-batch"
+	TypeMsgUpdateData               = "update-data"
 	TypeMsgBurn                     = "burn"
+	TypeMsgBurnBatch                = "burn-batch"
+	TypeMsgRevoke                   = "revoke"
 	TypeMsgFreeze                   = "freeze"
 	TypeMsgUnfreeze                 = "unfreeze"
+	TypeMsgClassFreeze              = "class-freeze"
+	TypeMsgClassUnfreeze            = "class-unfreeze"
 	TypeMsgAddToWhitelist           = "whitelist"
 	TypeMsgRemoveFromWhitelist      = "remove-from-whitelist"
 	TypeMsgAddToClassWhitelist      = "class-whitelist"
 	TypeMsgRemoveFromClassWhitelist = "remove-from-class-whitelist"
 	TypeMsgUpdateParams             = "update-params"
+	TypeMsgListNFT                  = "list-nft"
+	TypeMsgBuyNFT                   = "buy-nft"
+	TypeMsgPlaceBid                 = "place-bid"
+	TypeMsgCancelListing            = "cancel-listing"
 )
 
 type msgAndLegacyMsg interface {
@@ -34,14 +44,24 @@ type msgAndLegacyMsg interface {
 var (
 	_ msgAndLegacyMsg = &MsgIssueClass{}
 	_ msgAndLegacyMsg = &MsgMint{}
+	_ msgAndLegacyMsg = &MsgMintBatch{}
+	_ msgAndLegacyMsg = &MsgUpdateData{}
 	_ msgAndLegacyMsg = &MsgBurn{}
+	_ msgAndLegacyMsg = &MsgBurnBatch{}
+	_ msgAndLegacyMsg = &MsgRevoke{}
 	_ msgAndLegacyMsg = &MsgFreeze{}
 	_ msgAndLegacyMsg = &MsgUnfreeze{}
+	_ msgAndLegacyMsg = &MsgClassFreeze{}
+	_ msgAndLegacyMsg = &MsgClassUnfreeze{}
 	_ msgAndLegacyMsg = &MsgAddToWhitelist{}
 	_ msgAndLegacyMsg = &MsgRemoveFromWhitelist{}
 	_ msgAndLegacyMsg = &MsgAddToClassWhitelist{}
 	_ msgAndLegacyMsg = &MsgRemoveFromClassWhitelist{}
 	_ msgAndLegacyMsg = &MsgUpdateParams{}
+	_ msgAndLegacyMsg = &MsgListNFT{}
+	_ msgAndLegacyMsg = &MsgBuyNFT{}
+	_ msgAndLegacyMsg = &MsgPlaceBid{}
+	_ msgAndLegacyMsg = &MsgCancelListing{}
 )
 
 // Constraints.
@@ -51,20 +71,31 @@ const (
 	MaxURILength              = 256
 	MaxURIHashLength          = 128
 	MaxDataSize               = 5 * 1024 // 5KB
+	MaxRevocationReasonLength = 256
 )
 
 // RegisterLegacyAminoCodec registers the amino types and interfaces.
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgIssueClass{}, fmt.Sprintf("%s/MsgIssueClass", ModuleName), nil)
 	cdc.RegisterConcrete(&MsgMint{}, fmt.Sprintf("%s/MsgMint", ModuleName), nil)
+	cdc.RegisterConcrete(&MsgMintBatch{}, fmt.Sprintf("%s/MsgMintBatch", ModuleName), nil)
+	cdc.RegisterConcrete(&MsgUpdateData{}, fmt.Sprintf("%s/MsgUpdateData", ModuleName), nil)
 	cdc.RegisterConcrete(&MsgBurn{}, fmt.Sprintf("%s/MsgBurn", ModuleName), nil)
+	cdc.RegisterConcrete(&MsgBurnBatch{}, fmt.Sprintf("%s/MsgBurnBatch", ModuleName), nil)
+	cdc.RegisterConcrete(&MsgRevoke{}, fmt.Sprintf("%s/MsgRevoke", ModuleName), nil)
 	cdc.RegisterConcrete(&MsgFreeze{}, fmt.Sprintf("%s/MsgFreeze", ModuleName), nil)
 	cdc.RegisterConcrete(&MsgUnfreeze{}, fmt.Sprintf("%s/MsgUnfreeze", ModuleName), nil)
+	cdc.RegisterConcrete(&MsgClassFreeze{}, fmt.Sprintf("%s/MsgClassFreeze", ModuleName), nil)
+	cdc.RegisterConcrete(&MsgClassUnfreeze{}, fmt.Sprintf("%s/MsgClassUnfreeze", ModuleName), nil)
 	cdc.RegisterConcrete(&MsgAddToWhitelist{}, fmt.Sprintf("%s/MsgAddToWhitelist", ModuleName), nil)
 	cdc.RegisterConcrete(&MsgRemoveFromWhitelist{}, fmt.Sprintf("%s/MsgRemoveFromWhitelist", ModuleName), nil)
 	cdc.RegisterConcrete(&MsgAddToClassWhitelist{}, fmt.Sprintf("%s/MsgAddToClassWhitelist", ModuleName), nil)
 	cdc.RegisterConcrete(&MsgRemoveFromClassWhitelist{}, fmt.Sprintf("%s/MsgRemoveFromClassWhitelist", ModuleName), nil)
 	cdc.RegisterConcrete(&MsgUpdateParams{}, fmt.Sprintf("%s/MsgUpdateParams", ModuleName), nil)
+	cdc.RegisterConcrete(&MsgListNFT{}, fmt.Sprintf("%s/MsgListNFT", ModuleName), nil)
+	cdc.RegisterConcrete(&MsgBuyNFT{}, fmt.Sprintf("%s/MsgBuyNFT", ModuleName), nil)
+	cdc.RegisterConcrete(&MsgPlaceBid{}, fmt.Sprintf("%s/MsgPlaceBid", ModuleName), nil)
+	cdc.RegisterConcrete(&MsgCancelListing{}, fmt.Sprintf("%s/MsgCancelListing", ModuleName), nil)
 }
 
 // ValidateBasic checks that message fields are valid.
@@ -106,7 +137,7 @@ func (m *MsgIssueClass) ValidateBasic() error {
 		return sdkerrors.Wrapf(ErrInvalidInput, "duplicated features in the class features list, duplicates: %v", duplicates)
 	}
 
-	return nil
+	return ValidateDataEditors(m.Features, m.DataEditors)
 }
 
 // GetSigners returns the required signers of this message type.
@@ -182,6 +213,120 @@ func (m MsgMint) Type() string {
 	return TypeMsgMint
 }
 
+// ValidateBasic checks that message fields are valid.
+func (m *MsgMintBatch) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(cosmoserrors.ErrInvalidAddress, "invalid sender account %s", m.Sender)
+	}
+
+	if len(m.Items) == 0 {
+		return sdkerrors.Wrap(ErrInvalidInput, "items must not be empty")
+	}
+
+	seen := make(map[string]struct{}, len(m.Items))
+	for _, item := range m.Items {
+		mintMsg := MsgMint{
+			Sender:    m.Sender,
+			ClassID:   item.ClassID,
+			ID:        item.ID,
+			URI:       item.URI,
+			URIHash:   item.URIHash,
+			Data:      item.Data,
+			Recipient: item.Recipient,
+		}
+		if err := mintMsg.ValidateBasic(); err != nil {
+			return err
+		}
+
+		if item.Recipient != "" {
+			if _, err := sdk.AccAddressFromBech32(item.Recipient); err != nil {
+				return sdkerrors.Wrapf(cosmoserrors.ErrInvalidAddress, "invalid recipient account %s", item.Recipient)
+			}
+		}
+
+		key := item.ClassID + "/" + item.ID
+		if _, ok := seen[key]; ok {
+			return sdkerrors.Wrapf(ErrInvalidInput, "duplicated item with classID %s and ID %s", item.ClassID, item.ID)
+		}
+		seen[key] = struct{}{}
+	}
+
+	return nil
+}
+
+// GetSigners returns the required signers of this message type.
+func (m *MsgMintBatch) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(m.Sender),
+	}
+}
+
+// GetSignBytes returns sign bytes for LegacyMsg.
+func (m MsgMintBatch) GetSignBytes() []byte {
+	return sdk.MustSortJSON(moduleAminoCdc.MustMarshalJSON(&m))
+}
+
+// Route returns message route for LegacyMsg.
+func (m MsgMintBatch) Route() string {
+	return RouterKey
+}
+
+// Type returns message type for LegacyMsg.
+func (m MsgMintBatch) Type() string {
+	return TypeMsgMintBatch
+}
+
+// ValidateBasic checks that message fields are valid.
+func (m *MsgUpdateData) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(cosmoserrors.ErrInvalidAddress, "invalid sender account %s", m.Sender)
+	}
+
+	if err := ValidateTokenID(m.ID); err != nil {
+		return sdkerrors.Wrap(ErrInvalidInput, err.Error())
+	}
+
+	if err := ValidateData(m.Data); err != nil {
+		return sdkerrors.Wrap(ErrInvalidInput, err.Error())
+	}
+
+	if _, _, err := DeconstructClassID(m.ClassID); err != nil {
+		return sdkerrors.Wrap(ErrInvalidInput, err.Error())
+	}
+
+	if len(m.URI) > MaxURILength {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid URI %q, the length must be less than or equal %d", len(m.URI), MaxURILength)
+	}
+
+	if len(m.URIHash) > MaxURIHashLength {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid URI hash %q, the length must be less than or equal %d", len(m.URIHash), MaxURIHashLength)
+	}
+
+	return nil
+}
+
+// GetSigners returns the required signers of this message type.
+func (m *MsgUpdateData) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(m.Sender),
+	}
+}
+
+// GetSignBytes returns sign bytes for LegacyMsg.
+func (m MsgUpdateData) GetSignBytes() []byte {
+	return sdk.MustSortJSON(moduleAminoCdc.MustMarshalJSON(&m))
+}
+
+// Route returns message route for LegacyMsg.
+func (m MsgUpdateData) Route() string {
+	return RouterKey
+}
+
+// Type returns message type for LegacyMsg.
+func (m MsgUpdateData) Type() string {
+	return TypeMsgUpdateData
+}
+
 // ValidateBasic checks that message fields are valid.
 func (m *MsgBurn) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
@@ -221,6 +366,98 @@ func (m MsgBurn) Type() string {
 	return TypeMsgBurn
 }
 
+// ValidateBasic checks that message fields are valid.
+func (m *MsgBurnBatch) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(cosmoserrors.ErrInvalidAddress, "invalid sender account %s", m.Sender)
+	}
+
+	if len(m.Items) == 0 {
+		return sdkerrors.Wrap(ErrInvalidInput, "items must not be empty")
+	}
+
+	seen := make(map[string]struct{}, len(m.Items))
+	for _, item := range m.Items {
+		burnMsg := MsgBurn{
+			Sender:  m.Sender,
+			ClassID: item.ClassID,
+			ID:      item.ID,
+		}
+		if err := burnMsg.ValidateBasic(); err != nil {
+			return err
+		}
+
+		key := item.ClassID + "/" + item.ID
+		if _, ok := seen[key]; ok {
+			return sdkerrors.Wrapf(ErrInvalidInput, "duplicated item with classID %s and ID %s", item.ClassID, item.ID)
+		}
+		seen[key] = struct{}{}
+	}
+
+	return nil
+}
+
+// GetSigners returns the required signers of this message type.
+func (m *MsgBurnBatch) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(m.Sender),
+	}
+}
+
+// GetSignBytes returns sign bytes for LegacyMsg.
+func (m MsgBurnBatch) GetSignBytes() []byte {
+	return sdk.MustSortJSON(moduleAminoCdc.MustMarshalJSON(&m))
+}
+
+// Route returns message route for LegacyMsg.
+func (m MsgBurnBatch) Route() string {
+	return RouterKey
+}
+
+// Type returns message type for LegacyMsg.
+func (m MsgBurnBatch) Type() string {
+	return TypeMsgBurnBatch
+}
+
+// ValidateBasic checks that message fields are valid.
+func (m *MsgRevoke) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(cosmoserrors.ErrInvalidAddress, "invalid sender account %s", m.Sender)
+	}
+
+	if err := ValidateTokenID(m.ID); err != nil {
+		return sdkerrors.Wrap(ErrInvalidInput, err.Error())
+	}
+
+	if _, _, err := DeconstructClassID(m.ClassID); err != nil {
+		return sdkerrors.Wrap(ErrInvalidInput, err.Error())
+	}
+
+	return ValidateRevocationReason(m.Reason)
+}
+
+// GetSigners returns the required signers of this message type.
+func (m *MsgRevoke) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(m.Sender),
+	}
+}
+
+// GetSignBytes returns sign bytes for LegacyMsg.
+func (m MsgRevoke) GetSignBytes() []byte {
+	return sdk.MustSortJSON(moduleAminoCdc.MustMarshalJSON(&m))
+}
+
+// Route returns message route for LegacyMsg.
+func (m MsgRevoke) Route() string {
+	return RouterKey
+}
+
+// Type returns message type for LegacyMsg.
+func (m MsgRevoke) Type() string {
+	return TypeMsgRevoke
+}
+
 // ValidateBasic checks that message fields are valid.
 func (m *MsgFreeze) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
@@ -299,6 +536,76 @@ func (m MsgUnfreeze) Type() string {
 	return TypeMsgUnfreeze
 }
 
+// ValidateBasic checks that message fields are valid.
+func (m *MsgClassFreeze) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(cosmoserrors.ErrInvalidAddress, "invalid sender account %s", m.Sender)
+	}
+
+	if _, _, err := DeconstructClassID(m.ClassID); err != nil {
+		return sdkerrors.Wrap(ErrInvalidInput, err.Error())
+	}
+
+	return nil
+}
+
+// GetSigners returns the required signers of this message type.
+func (m *MsgClassFreeze) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(m.Sender),
+	}
+}
+
+// GetSignBytes returns sign bytes for LegacyMsg.
+func (m MsgClassFreeze) GetSignBytes() []byte {
+	return sdk.MustSortJSON(moduleAminoCdc.MustMarshalJSON(&m))
+}
+
+// Route returns message route for LegacyMsg.
+func (m MsgClassFreeze) Route() string {
+	return RouterKey
+}
+
+// Type returns message type for LegacyMsg.
+func (m MsgClassFreeze) Type() string {
+	return TypeMsgClassFreeze
+}
+
+// ValidateBasic checks that message fields are valid.
+func (m *MsgClassUnfreeze) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(cosmoserrors.ErrInvalidAddress, "invalid sender account %s", m.Sender)
+	}
+
+	if _, _, err := DeconstructClassID(m.ClassID); err != nil {
+		return sdkerrors.Wrap(ErrInvalidInput, err.Error())
+	}
+
+	return nil
+}
+
+// GetSigners returns the required signers of this message type.
+func (m *MsgClassUnfreeze) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(m.Sender),
+	}
+}
+
+// GetSignBytes returns sign bytes for LegacyMsg.
+func (m MsgClassUnfreeze) GetSignBytes() []byte {
+	return sdk.MustSortJSON(moduleAminoCdc.MustMarshalJSON(&m))
+}
+
+// Route returns message route for LegacyMsg.
+func (m MsgClassUnfreeze) Route() string {
+	return RouterKey
+}
+
+// Type returns message type for LegacyMsg.
+func (m MsgClassUnfreeze) Type() string {
+	return TypeMsgClassUnfreeze
+}
+
 // ValidateBasic checks that message fields are valid.
 func (m *MsgAddToWhitelist) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
@@ -493,6 +800,154 @@ func (m MsgRemoveFromClassWhitelist) Type() string {
 	return TypeMsgRemoveFromClassWhitelist
 }
 
+// ValidateBasic checks that message fields are valid.
+func (m *MsgListNFT) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(cosmoserrors.ErrInvalidAddress, "invalid sender account %s", m.Sender)
+	}
+
+	if err := ValidateTokenID(m.ID); err != nil {
+		return sdkerrors.Wrap(ErrInvalidInput, err.Error())
+	}
+
+	if _, _, err := DeconstructClassID(m.ClassID); err != nil {
+		return sdkerrors.Wrap(ErrInvalidInput, err.Error())
+	}
+
+	return ValidateListingTerms(m.ListingType, m.Price, m.AuctionDuration)
+}
+
+// GetSigners returns the required signers of this message type.
+func (m *MsgListNFT) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(m.Sender),
+	}
+}
+
+// GetSignBytes returns sign bytes for LegacyMsg.
+func (m MsgListNFT) GetSignBytes() []byte {
+	return sdk.MustSortJSON(moduleAminoCdc.MustMarshalJSON(&m))
+}
+
+// Route returns message route for LegacyMsg.
+func (m MsgListNFT) Route() string {
+	return RouterKey
+}
+
+// Type returns message type for LegacyMsg.
+func (m MsgListNFT) Type() string {
+	return TypeMsgListNFT
+}
+
+// ValidateBasic checks that message fields are valid.
+func (m *MsgBuyNFT) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(cosmoserrors.ErrInvalidAddress, "invalid sender account %s", m.Sender)
+	}
+
+	if m.ListingID == 0 {
+		return sdkerrors.Wrap(ErrInvalidInput, "listing ID must be positive")
+	}
+
+	return nil
+}
+
+// GetSigners returns the required signers of this message type.
+func (m *MsgBuyNFT) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(m.Sender),
+	}
+}
+
+// GetSignBytes returns sign bytes for LegacyMsg.
+func (m MsgBuyNFT) GetSignBytes() []byte {
+	return sdk.MustSortJSON(moduleAminoCdc.MustMarshalJSON(&m))
+}
+
+// Route returns message route for LegacyMsg.
+func (m MsgBuyNFT) Route() string {
+	return RouterKey
+}
+
+// Type returns message type for LegacyMsg.
+func (m MsgBuyNFT) Type() string {
+	return TypeMsgBuyNFT
+}
+
+// ValidateBasic checks that message fields are valid.
+func (m *MsgPlaceBid) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(cosmoserrors.ErrInvalidAddress, "invalid sender account %s", m.Sender)
+	}
+
+	if m.ListingID == 0 {
+		return sdkerrors.Wrap(ErrInvalidInput, "listing ID must be positive")
+	}
+
+	if !m.Amount.IsValid() || !m.Amount.IsPositive() {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid bid amount %s, it must be positive", m.Amount)
+	}
+
+	return nil
+}
+
+// GetSigners returns the required signers of this message type.
+func (m *MsgPlaceBid) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(m.Sender),
+	}
+}
+
+// GetSignBytes returns sign bytes for LegacyMsg.
+func (m MsgPlaceBid) GetSignBytes() []byte {
+	return sdk.MustSortJSON(moduleAminoCdc.MustMarshalJSON(&m))
+}
+
+// Route returns message route for LegacyMsg.
+func (m MsgPlaceBid) Route() string {
+	return RouterKey
+}
+
+// Type returns message type for LegacyMsg.
+func (m MsgPlaceBid) Type() string {
+	return TypeMsgPlaceBid
+}
+
+// ValidateBasic checks that message fields are valid.
+func (m *MsgCancelListing) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(cosmoserrors.ErrInvalidAddress, "invalid sender account %s", m.Sender)
+	}
+
+	if m.ListingID == 0 {
+		return sdkerrors.Wrap(ErrInvalidInput, "listing ID must be positive")
+	}
+
+	return nil
+}
+
+// GetSigners returns the required signers of this message type.
+func (m *MsgCancelListing) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(m.Sender),
+	}
+}
+
+// GetSignBytes returns sign bytes for LegacyMsg.
+func (m MsgCancelListing) GetSignBytes() []byte {
+	return sdk.MustSortJSON(moduleAminoCdc.MustMarshalJSON(&m))
+}
+
+// Route returns message route for LegacyMsg.
+func (m MsgCancelListing) Route() string {
+	return RouterKey
+}
+
+// Type returns message type for LegacyMsg.
+func (m MsgCancelListing) Type() string {
+	return TypeMsgCancelListing
+}
+
 var (
 	amino          = codec.NewLegacyAmino()
 	moduleAminoCdc = codec.NewAminoCodec(amino)
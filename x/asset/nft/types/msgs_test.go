@@ -178,6 +178,33 @@ func TestMsgIssueClass_ValidateBasic(t *testing.T) {
 			},
 			expectedError: types.ErrInvalidInput,
 		},
+		{
+			name: "valid msg with data editors",
+			messageFunc: func() *types.MsgIssueClass {
+				msg := validMessage
+				msg.Features = []types.ClassFeature{types.ClassFeature_updatable_data}
+				msg.DataEditors = []types.DataEditor{types.DataEditor_issuer, types.DataEditor_owner}
+				return &msg
+			},
+		},
+		{
+			name: "invalid data editors without updatable data feature",
+			messageFunc: func() *types.MsgIssueClass {
+				msg := validMessage
+				msg.DataEditors = []types.DataEditor{types.DataEditor_owner}
+				return &msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid updatable data feature without data editors",
+			messageFunc: func() *types.MsgIssueClass {
+				msg := validMessage
+				msg.Features = []types.ClassFeature{types.ClassFeature_updatable_data}
+				return &msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -324,6 +351,209 @@ func TestMsgMint_ValidateBasic(t *testing.T) {
 	}
 }
 
+func TestMsgMintBatch_ValidateBasic(t *testing.T) {
+	validItem := types.MintBatchItem{
+		ClassID:   "symbol-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+		ID:        "my-id",
+		URI:       "https://my-nft-meta.invalid/1",
+		URIHash:   "e000624",
+		Recipient: "devcore1phjrez5j2wp5qzp0zvlqavasvw60mkp2zmfe6h",
+	}
+	testCases := []struct {
+		name          string
+		messageFunc   func() *types.MsgMintBatch
+		expectedError error
+	}{
+		{
+			name: "valid msg",
+			messageFunc: func() *types.MsgMintBatch {
+				secondItem := validItem
+				secondItem.ID = "my-id2"
+				secondItem.Recipient = ""
+				return &types.MsgMintBatch{
+					Sender: "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+					Items:  []types.MintBatchItem{validItem, secondItem},
+				}
+			},
+		},
+		{
+			name: "invalid sender",
+			messageFunc: func() *types.MsgMintBatch {
+				return &types.MsgMintBatch{
+					Sender: invalidAccount,
+					Items:  []types.MintBatchItem{validItem},
+				}
+			},
+			expectedError: cosmoserrors.ErrInvalidAddress,
+		},
+		{
+			name: "empty items",
+			messageFunc: func() *types.MsgMintBatch {
+				return &types.MsgMintBatch{
+					Sender: "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				}
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid item id",
+			messageFunc: func() *types.MsgMintBatch {
+				item := validItem
+				item.ID = invalidNFTID
+				return &types.MsgMintBatch{
+					Sender: "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+					Items:  []types.MintBatchItem{item},
+				}
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid item recipient",
+			messageFunc: func() *types.MsgMintBatch {
+				item := validItem
+				item.Recipient = invalidAccount
+				return &types.MsgMintBatch{
+					Sender: "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+					Items:  []types.MintBatchItem{item},
+				}
+			},
+			expectedError: cosmoserrors.ErrInvalidAddress,
+		},
+		{
+			name: "duplicated item",
+			messageFunc: func() *types.MsgMintBatch {
+				return &types.MsgMintBatch{
+					Sender: "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+					Items:  []types.MintBatchItem{validItem, validItem},
+				}
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			assertT := assert.New(t)
+			err := tc.messageFunc().ValidateBasic()
+			if tc.expectedError == nil {
+				assertT.NoError(err)
+			} else {
+				assertT.True(sdkerrors.IsOf(err, tc.expectedError))
+			}
+		})
+	}
+}
+
+func TestMsgUpdateData_ValidateBasic(t *testing.T) {
+	requireT := require.New(t)
+
+	dataValue, err := codectypes.NewAnyWithValue(&types.DataBytes{Data: []byte("metadata")})
+	requireT.NoError(err)
+
+	validMessage := types.MsgUpdateData{
+		Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+		ID:      "my-id",
+		ClassID: "symbol-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+		URI:     "https://my.invalid",
+		URIHash: "content-hash",
+		Data:    dataValue,
+	}
+	testCases := []struct {
+		name          string
+		messageFunc   func() *types.MsgUpdateData
+		expectedError error
+	}{
+		{
+			name: "valid msg",
+			messageFunc: func() *types.MsgUpdateData {
+				msg := validMessage
+				return &msg
+			},
+		},
+		{
+			name: "valid msg with empty uri and data",
+			messageFunc: func() *types.MsgUpdateData {
+				msg := validMessage
+				msg.URI = ""
+				msg.URIHash = ""
+				msg.Data = nil
+				return &msg
+			},
+		},
+		{
+			name: "invalid id",
+			messageFunc: func() *types.MsgUpdateData {
+				msg := validMessage
+				msg.ID = invalidNFTID
+				return &msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid sender",
+			messageFunc: func() *types.MsgUpdateData {
+				msg := validMessage
+				msg.Sender = invalidAccount
+				return &msg
+			},
+			expectedError: cosmoserrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid classID",
+			messageFunc: func() *types.MsgUpdateData {
+				msg := validMessage
+				msg.ClassID = "x"
+				return &msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid uri",
+			messageFunc: func() *types.MsgUpdateData {
+				msg := validMessage
+				msg.URI = string(make([]byte, 257))
+				return &msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid uri hash",
+			messageFunc: func() *types.MsgUpdateData {
+				msg := validMessage
+				msg.URIHash = strings.Repeat("x", 129)
+				return &msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid data - too long",
+			messageFunc: func() *types.MsgUpdateData {
+				msg := validMessage
+				msg.Data = &codectypes.Any{
+					TypeUrl: "/" + proto.MessageName((*types.DataBytes)(nil)),
+					Value:   bytes.Repeat([]byte{0x01}, types.MaxDataSize+1),
+				}
+				return &msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			assertT := assert.New(t)
+			err := tc.messageFunc().ValidateBasic()
+			if tc.expectedError == nil {
+				assertT.NoError(err)
+			} else {
+				assertT.True(sdkerrors.IsOf(err, tc.expectedError))
+			}
+		})
+	}
+}
+
 //nolint:dupl // test case duplicates are ok
 func TestMsgBurn_ValidateBasic(t *testing.T) {
 	validMessage := types.MsgBurn{
@@ -386,6 +616,164 @@ func TestMsgBurn_ValidateBasic(t *testing.T) {
 	}
 }
 
+func TestMsgBurnBatch_ValidateBasic(t *testing.T) {
+	validItem := types.BurnBatchItem{
+		ClassID: "symbol-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+		ID:      "my-id",
+	}
+	testCases := []struct {
+		name          string
+		messageFunc   func() *types.MsgBurnBatch
+		expectedError error
+	}{
+		{
+			name: "valid msg",
+			messageFunc: func() *types.MsgBurnBatch {
+				secondItem := validItem
+				secondItem.ID = "my-id2"
+				return &types.MsgBurnBatch{
+					Sender: "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+					Items:  []types.BurnBatchItem{validItem, secondItem},
+				}
+			},
+		},
+		{
+			name: "invalid sender",
+			messageFunc: func() *types.MsgBurnBatch {
+				return &types.MsgBurnBatch{
+					Sender: invalidAccount,
+					Items:  []types.BurnBatchItem{validItem},
+				}
+			},
+			expectedError: cosmoserrors.ErrInvalidAddress,
+		},
+		{
+			name: "empty items",
+			messageFunc: func() *types.MsgBurnBatch {
+				return &types.MsgBurnBatch{
+					Sender: "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				}
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid item classID",
+			messageFunc: func() *types.MsgBurnBatch {
+				item := validItem
+				item.ClassID = "x"
+				return &types.MsgBurnBatch{
+					Sender: "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+					Items:  []types.BurnBatchItem{item},
+				}
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "duplicated item",
+			messageFunc: func() *types.MsgBurnBatch {
+				return &types.MsgBurnBatch{
+					Sender: "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+					Items:  []types.BurnBatchItem{validItem, validItem},
+				}
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			assertT := assert.New(t)
+			err := tc.messageFunc().ValidateBasic()
+			if tc.expectedError == nil {
+				assertT.NoError(err)
+			} else {
+				assertT.True(sdkerrors.IsOf(err, tc.expectedError))
+			}
+		})
+	}
+}
+
+func TestMsgRevoke_ValidateBasic(t *testing.T) {
+	validMessage := types.MsgRevoke{
+		Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+		ClassID: "symbol-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+		ID:      "my-id",
+		Reason:  "certificate expired",
+	}
+	testCases := []struct {
+		name          string
+		messageFunc   func() *types.MsgRevoke
+		expectedError error
+	}{
+		{
+			name: "valid msg",
+			messageFunc: func() *types.MsgRevoke {
+				msg := validMessage
+				return &msg
+			},
+		},
+		{
+			name: "invalid id",
+			messageFunc: func() *types.MsgRevoke {
+				msg := validMessage
+				msg.ID = invalidNFTID
+				return &msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "invalid sender",
+			messageFunc: func() *types.MsgRevoke {
+				msg := validMessage
+				msg.Sender = invalidAccount
+				return &msg
+			},
+			expectedError: cosmoserrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid classID",
+			messageFunc: func() *types.MsgRevoke {
+				msg := validMessage
+				msg.ClassID = "x"
+				return &msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "empty reason",
+			messageFunc: func() *types.MsgRevoke {
+				msg := validMessage
+				msg.Reason = ""
+				return &msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "too long reason",
+			messageFunc: func() *types.MsgRevoke {
+				msg := validMessage
+				msg.Reason = strings.Repeat("x", types.MaxRevocationReasonLength+1)
+				return &msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			assertT := assert.New(t)
+			err := tc.messageFunc().ValidateBasic()
+			if tc.expectedError == nil {
+				assertT.NoError(err)
+			} else {
+				assertT.True(sdkerrors.IsOf(err, tc.expectedError))
+			}
+		})
+	}
+}
+
 //nolint:dupl // test case duplicates are ok
 func TestMsgFreeze_ValidateBasic(t *testing.T) {
 	validMessage := types.MsgFreeze{
@@ -510,6 +898,110 @@ func TestMsgUnfreeze_ValidateBasic(t *testing.T) {
 	}
 }
 
+//nolint:dupl // test case duplicates are ok
+func TestMsgClassFreeze_ValidateBasic(t *testing.T) {
+	validMessage := types.MsgClassFreeze{
+		Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+		ClassID: "symbol-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+	}
+	testCases := []struct {
+		name          string
+		messageFunc   func() *types.MsgClassFreeze
+		expectedError error
+	}{
+		{
+			name: "valid msg",
+			messageFunc: func() *types.MsgClassFreeze {
+				msg := validMessage
+				return &msg
+			},
+		},
+		{
+			name: "invalid sender",
+			messageFunc: func() *types.MsgClassFreeze {
+				msg := validMessage
+				msg.Sender = invalidAccount
+				return &msg
+			},
+			expectedError: cosmoserrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid classID",
+			messageFunc: func() *types.MsgClassFreeze {
+				msg := validMessage
+				msg.ClassID = "x"
+				return &msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			assertT := assert.New(t)
+			err := tc.messageFunc().ValidateBasic()
+			if tc.expectedError == nil {
+				assertT.NoError(err)
+			} else {
+				assertT.True(sdkerrors.IsOf(err, tc.expectedError))
+			}
+		})
+	}
+}
+
+//nolint:dupl // test case duplicates are ok
+func TestMsgClassUnfreeze_ValidateBasic(t *testing.T) {
+	validMessage := types.MsgClassUnfreeze{
+		Sender:  "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+		ClassID: "symbol-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+	}
+	testCases := []struct {
+		name          string
+		messageFunc   func() *types.MsgClassUnfreeze
+		expectedError error
+	}{
+		{
+			name: "valid msg",
+			messageFunc: func() *types.MsgClassUnfreeze {
+				msg := validMessage
+				return &msg
+			},
+		},
+		{
+			name: "invalid sender",
+			messageFunc: func() *types.MsgClassUnfreeze {
+				msg := validMessage
+				msg.Sender = invalidAccount
+				return &msg
+			},
+			expectedError: cosmoserrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid classID",
+			messageFunc: func() *types.MsgClassUnfreeze {
+				msg := validMessage
+				msg.ClassID = "x"
+				return &msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			assertT := assert.New(t)
+			err := tc.messageFunc().ValidateBasic()
+			if tc.expectedError == nil {
+				assertT.NoError(err)
+			} else {
+				assertT.True(sdkerrors.IsOf(err, tc.expectedError))
+			}
+		})
+	}
+}
+
 //nolint:dupl // test case duplicates are ok
 func TestMsgAddToWhitelist_ValidateBasic(t *testing.T) {
 	validMessage := types.MsgAddToWhitelist{
@@ -678,6 +1170,26 @@ func TestAmino(t *testing.T) {
 			},
 			wantAminoJSON: `{"type":"assetnft/MsgMint","value":{"class_id":"classID","sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"}}`,
 		},
+		{
+			name: types.TypeMsgMintBatch,
+			msg: &types.MsgMintBatch{
+				Sender: address,
+				Items: []types.MintBatchItem{
+					{ClassID: "classID", ID: "nftID", Recipient: address},
+				},
+			},
+			wantAminoJSON: `{"type":"assetnft/MsgMintBatch","value":{"items":[{"class_id":"classID","id":"nftID","recipient":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"}],"sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"}}`,
+		},
+		{
+			name: types.TypeMsgUpdateData,
+			msg: &types.MsgUpdateData{
+				Sender:  address,
+				ClassID: "classID",
+				ID:      "nftID",
+				URI:     "uri",
+			},
+			wantAminoJSON: `{"type":"assetnft/MsgUpdateData","value":{"class_id":"classID","id":"nftID","sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5","uri":"uri"}}`,
+		},
 		{
 			name: types.TypeMsgBurn,
 			msg: &types.MsgBurn{
@@ -687,6 +1199,26 @@ func TestAmino(t *testing.T) {
 			},
 			wantAminoJSON: `{"type":"assetnft/MsgBurn","value":{"class_id":"classID","id":"nftID","sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"}}`,
 		},
+		{
+			name: types.TypeMsgBurnBatch,
+			msg: &types.MsgBurnBatch{
+				Sender: address,
+				Items: []types.BurnBatchItem{
+					{ClassID: "classID", ID: "nftID"},
+				},
+			},
+			wantAminoJSON: `{"type":"assetnft/MsgBurnBatch","value":{"items":[{"class_id":"classID","id":"nftID"}],"sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"}}`,
+		},
+		{
+			name: types.TypeMsgRevoke,
+			msg: &types.MsgRevoke{
+				Sender:  address,
+				ClassID: "classID",
+				ID:      "nftID",
+				Reason:  "expired",
+			},
+			wantAminoJSON: `{"type":"assetnft/MsgRevoke","value":{"class_id":"classID","id":"nftID","reason":"expired","sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"}}`,
+		},
 		{
 			name: types.TypeMsgFreeze,
 			msg: &types.MsgFreeze{
@@ -705,6 +1237,22 @@ func TestAmino(t *testing.T) {
 			},
 			wantAminoJSON: `{"type":"assetnft/MsgUnfreeze","value":{"class_id":"classID","id":"nftID","sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"}}`,
 		},
+		{
+			name: types.TypeMsgClassFreeze,
+			msg: &types.MsgClassFreeze{
+				Sender:  address,
+				ClassID: "classID",
+			},
+			wantAminoJSON: `{"type":"assetnft/MsgClassFreeze","value":{"class_id":"classID","sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"}}`,
+		},
+		{
+			name: types.TypeMsgClassUnfreeze,
+			msg: &types.MsgClassUnfreeze{
+				Sender:  address,
+				ClassID: "classID",
+			},
+			wantAminoJSON: `{"type":"assetnft/MsgClassUnfreeze","value":{"class_id":"classID","sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"}}`,
+		},
 		{
 			name: types.TypeMsgAddToWhitelist,
 			msg: &types.MsgAddToWhitelist{
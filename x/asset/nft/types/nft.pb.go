@@ -33,6 +33,8 @@ const (
 	ClassFeature_freezing        ClassFeature = 1
 	ClassFeature_whitelisting    ClassFeature = 2
 	ClassFeature_disable_sending ClassFeature = 3
+	ClassFeature_updatable_data  ClassFeature = 4
+	ClassFeature_soulbound       ClassFeature = 5
 )
 
 var ClassFeature_name = map[int32]string{
@@ -40,6 +42,8 @@ var ClassFeature_name = map[int32]string{
 	1: "freezing",
 	2: "whitelisting",
 	3: "disable_sending",
+	4: "updatable_data",
+	5: "soulbound",
 }
 
 var ClassFeature_value = map[string]int32{
@@ -47,6 +51,8 @@ var ClassFeature_value = map[string]int32{
 	"freezing":        1,
 	"whitelisting":    2,
 	"disable_sending": 3,
+	"updatable_data":  4,
+	"soulbound":       5,
 }
 
 func (x ClassFeature) String() string {
@@ -57,6 +63,32 @@ func (ClassFeature) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_5b9231d6a69d6d06, []int{0}
 }
 
+// DataEditor defines the party allowed to update the data of the non-fungible token.
+type DataEditor int32
+
+const (
+	DataEditor_issuer DataEditor = 0
+	DataEditor_owner  DataEditor = 1
+)
+
+var DataEditor_name = map[int32]string{
+	0: "issuer",
+	1: "owner",
+}
+
+var DataEditor_value = map[string]int32{
+	"issuer": 0,
+	"owner":  1,
+}
+
+func (x DataEditor) String() string {
+	return proto.EnumName(DataEditor_name, int32(x))
+}
+
+func (DataEditor) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_5b9231d6a69d6d06, []int{1}
+}
+
 // ClassDefinition defines the non-fungible token class settings to store.
 type ClassDefinition struct {
 	ID       string         `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -66,6 +98,9 @@ type ClassDefinition struct {
 	// whenever an NFT this class is traded on the Dex, the traded amount will be multiplied by this value
 	// that will be transferred to the issuer of the NFT.
 	RoyaltyRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=royalty_rate,json=royaltyRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"royalty_rate"`
+	// data_editors are the parties allowed to update the data of the non-fungible tokens if the updatable_data
+	// feature is enabled.
+	DataEditors []DataEditor `protobuf:"varint,5,rep,packed,name=data_editors,json=dataEditors,proto3,enum=coreum.asset.nft.v1.DataEditor" json:"data_editors,omitempty"`
 }
 
 func (m *ClassDefinition) Reset()         { *m = ClassDefinition{} }
@@ -122,6 +157,13 @@ func (m *ClassDefinition) GetFeatures() []ClassFeature {
 	return nil
 }
 
+func (m *ClassDefinition) GetDataEditors() []DataEditor {
+	if m != nil {
+		return m.DataEditors
+	}
+	return nil
+}
+
 // Class is a full representation of the non-fungible token class.
 type Class struct {
 	Id          string         `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -137,6 +179,9 @@ type Class struct {
 	// whenever an NFT this class is traded on the Dex, the traded amount will be multiplied by this value
 	// that will be transferred to the issuer of the NFT.
 	RoyaltyRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=royalty_rate,json=royaltyRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"royalty_rate"`
+	// data_editors are the parties allowed to update the data of the non-fungible tokens if the updatable_data
+	// feature is enabled.
+	DataEditors []DataEditor `protobuf:"varint,11,rep,packed,name=data_editors,json=dataEditors,proto3,enum=coreum.asset.nft.v1.DataEditor" json:"data_editors,omitempty"`
 }
 
 func (m *Class) Reset()         { *m = Class{} }
@@ -235,48 +280,229 @@ func (m *Class) GetFeatures() []ClassFeature {
 	return nil
 }
 
+func (m *Class) GetDataEditors() []DataEditor {
+	if m != nil {
+		return m.DataEditors
+	}
+	return nil
+}
+
+// Revocation is stored in the revocations store if the token is revoked by the issuer.
+type Revocation struct {
+	Reason string `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *Revocation) Reset()         { *m = Revocation{} }
+func (m *Revocation) String() string { return proto.CompactTextString(m) }
+func (*Revocation) ProtoMessage()    {}
+func (*Revocation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5b9231d6a69d6d06, []int{2}
+}
+func (m *Revocation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Revocation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Revocation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Revocation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Revocation.Merge(m, src)
+}
+func (m *Revocation) XXX_Size() int {
+	return m.Size()
+}
+func (m *Revocation) XXX_DiscardUnknown() {
+	xxx_messageInfo_Revocation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Revocation proto.InternalMessageInfo
+
+func (m *Revocation) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// NFTRecord is the non-fungible token together with its state defined by the class features.
+type NFTRecord struct {
+	ClassId string     `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	Id      string     `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	URI     string     `protobuf:"bytes,3,opt,name=uri,proto3" json:"uri,omitempty"`
+	URIHash string     `protobuf:"bytes,4,opt,name=uri_hash,json=uriHash,proto3" json:"uri_hash,omitempty"`
+	Data    *types.Any `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`
+	Frozen  bool       `protobuf:"varint,6,opt,name=frozen,proto3" json:"frozen,omitempty"`
+	// whitelisted_accounts_count is the number of accounts whitelisted for the non-fungible token, excluding the
+	// accounts whitelisted for the whole class.
+	WhitelistedAccountsCount uint64         `protobuf:"varint,7,opt,name=whitelisted_accounts_count,json=whitelistedAccountsCount,proto3" json:"whitelisted_accounts_count,omitempty"`
+	ClassFeatures            []ClassFeature `protobuf:"varint,8,rep,packed,name=class_features,json=classFeatures,proto3,enum=coreum.asset.nft.v1.ClassFeature" json:"class_features,omitempty"`
+	// class_frozen is true if the whole class is frozen, so the non-fungible token is frozen regardless of its own
+	// frozen flag.
+	ClassFrozen bool `protobuf:"varint,9,opt,name=class_frozen,json=classFrozen,proto3" json:"class_frozen,omitempty"`
+}
+
+func (m *NFTRecord) Reset()         { *m = NFTRecord{} }
+func (m *NFTRecord) String() string { return proto.CompactTextString(m) }
+func (*NFTRecord) ProtoMessage()    {}
+func (*NFTRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5b9231d6a69d6d06, []int{3}
+}
+func (m *NFTRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NFTRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NFTRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NFTRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NFTRecord.Merge(m, src)
+}
+func (m *NFTRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *NFTRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_NFTRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NFTRecord proto.InternalMessageInfo
+
+func (m *NFTRecord) GetClassId() string {
+	if m != nil {
+		return m.ClassId
+	}
+	return ""
+}
+
+func (m *NFTRecord) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *NFTRecord) GetURI() string {
+	if m != nil {
+		return m.URI
+	}
+	return ""
+}
+
+func (m *NFTRecord) GetURIHash() string {
+	if m != nil {
+		return m.URIHash
+	}
+	return ""
+}
+
+func (m *NFTRecord) GetData() *types.Any {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *NFTRecord) GetFrozen() bool {
+	if m != nil {
+		return m.Frozen
+	}
+	return false
+}
+
+func (m *NFTRecord) GetWhitelistedAccountsCount() uint64 {
+	if m != nil {
+		return m.WhitelistedAccountsCount
+	}
+	return 0
+}
+
+func (m *NFTRecord) GetClassFeatures() []ClassFeature {
+	if m != nil {
+		return m.ClassFeatures
+	}
+	return nil
+}
+
+func (m *NFTRecord) GetClassFrozen() bool {
+	if m != nil {
+		return m.ClassFrozen
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterEnum("coreum.asset.nft.v1.ClassFeature", ClassFeature_name, ClassFeature_value)
+	proto.RegisterEnum("coreum.asset.nft.v1.DataEditor", DataEditor_name, DataEditor_value)
 	proto.RegisterType((*ClassDefinition)(nil), "coreum.asset.nft.v1.ClassDefinition")
 	proto.RegisterType((*Class)(nil), "coreum.asset.nft.v1.Class")
+	proto.RegisterType((*Revocation)(nil), "coreum.asset.nft.v1.Revocation")
+	proto.RegisterType((*NFTRecord)(nil), "coreum.asset.nft.v1.NFTRecord")
 }
 
 func init() { proto.RegisterFile("coreum/asset/nft/v1/nft.proto", fileDescriptor_5b9231d6a69d6d06) }
 
 var fileDescriptor_5b9231d6a69d6d06 = []byte{
-	// 508 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0x4f, 0x6b, 0xdb, 0x4e,
-	0x10, 0xb5, 0x24, 0xc7, 0x76, 0xd6, 0x26, 0x09, 0x9b, 0x10, 0x94, 0xc0, 0x4f, 0xf6, 0x2f, 0x87,
-	0x60, 0x0a, 0x5d, 0x91, 0xe4, 0xd0, 0x53, 0x0f, 0x4d, 0x4c, 0xa8, 0x6f, 0xcd, 0x42, 0x2e, 0xbd,
-	0x98, 0x95, 0xb5, 0x96, 0x97, 0xca, 0xbb, 0x66, 0xff, 0xb8, 0x55, 0x3f, 0x45, 0x3f, 0x56, 0x8e,
-	0x81, 0x5e, 0x4a, 0x0f, 0xa6, 0xc8, 0x5f, 0xa3, 0x87, 0xb2, 0x2b, 0xb5, 0x75, 0xa1, 0xf4, 0x92,
-	0xd3, 0xce, 0xbc, 0x37, 0xd2, 0xcc, 0x7b, 0xc3, 0x80, 0xff, 0xa6, 0x42, 0x52, 0xb3, 0x88, 0x89,
-	0x52, 0x54, 0xc7, 0x7c, 0xa6, 0xe3, 0xd5, 0x85, 0x7d, 0xd0, 0x52, 0x0a, 0x2d, 0xe0, 0x61, 0x45,
-	0x23, 0x47, 0x23, 0x8b, 0xaf, 0x2e, 0x4e, 0x8f, 0x32, 0x91, 0x09, 0xc7, 0xc7, 0x36, 0xaa, 0x4a,
-	0x4f, 0x4f, 0x32, 0x21, 0xb2, 0x9c, 0xc6, 0x2e, 0x4b, 0xcc, 0x2c, 0x26, 0xbc, 0xa8, 0xa8, 0xb3,
-	0xcf, 0x1e, 0xd8, 0xbf, 0xc9, 0x89, 0x52, 0x23, 0x3a, 0x63, 0x9c, 0x69, 0x26, 0x38, 0x3c, 0x06,
-	0x3e, 0x4b, 0x43, 0x6f, 0xe0, 0x0d, 0x77, 0xaf, 0x5b, 0xe5, 0xba, 0xef, 0x8f, 0x47, 0xd8, 0x67,
-	0x29, 0x3c, 0x06, 0x2d, 0xa6, 0x94, 0xa1, 0x32, 0xf4, 0x2d, 0x87, 0xeb, 0x0c, 0xbe, 0x04, 0x9d,
-	0x19, 0x25, 0xda, 0x48, 0xaa, 0xc2, 0x60, 0x10, 0x0c, 0xf7, 0x2e, 0xff, 0x47, 0x7f, 0x19, 0x0e,
-	0xb9, 0x3e, 0xb7, 0x55, 0x25, 0xfe, 0xf5, 0x09, 0xbc, 0x03, 0x3d, 0x29, 0x0a, 0x92, 0xeb, 0x62,
-	0x22, 0x89, 0xa6, 0x61, 0xd3, 0x35, 0x46, 0x0f, 0xeb, 0x7e, 0xe3, 0xeb, 0xba, 0x7f, 0x9e, 0x31,
-	0x3d, 0x37, 0x09, 0x9a, 0x8a, 0x45, 0x3c, 0x15, 0x6a, 0x21, 0x54, 0xfd, 0x3c, 0x57, 0xe9, 0xbb,
-	0x58, 0x17, 0x4b, 0xaa, 0xd0, 0x88, 0x4e, 0x71, 0xb7, 0xfe, 0x07, 0x26, 0x9a, 0x9e, 0x7d, 0xf7,
-	0xc1, 0x8e, 0xeb, 0x06, 0xf7, 0x7e, 0x6b, 0xf9, 0xa7, 0x06, 0x08, 0x9a, 0x9c, 0x2c, 0x68, 0x18,
-	0x38, 0xd4, 0xc5, 0xb6, 0x56, 0x15, 0x8b, 0x44, 0xe4, 0xd5, 0x48, 0xb8, 0xce, 0xe0, 0x00, 0x74,
-	0x53, 0xaa, 0xa6, 0x92, 0x2d, 0xad, 0x5d, 0xe1, 0x8e, 0x23, 0xb7, 0x21, 0x78, 0x02, 0x02, 0x23,
-	0x59, 0xd8, 0x72, 0x4a, 0xda, 0xe5, 0xba, 0x1f, 0xdc, 0xe3, 0x31, 0xb6, 0x18, 0x3c, 0x07, 0x1d,
-	0x23, 0xd9, 0x64, 0x4e, 0xd4, 0x3c, 0x6c, 0x3b, 0xbe, 0x5b, 0xae, 0xfb, 0xed, 0x7b, 0x3c, 0x7e,
-	0x4d, 0xd4, 0x1c, 0xb7, 0x8d, 0x64, 0x36, 0x80, 0x43, 0xd0, 0x4c, 0x89, 0x26, 0x61, 0x67, 0xe0,
-	0x0d, 0xbb, 0x97, 0x47, 0xa8, 0x5a, 0x21, 0xfa, 0xb9, 0x42, 0xf4, 0x8a, 0x17, 0xd8, 0x55, 0xfc,
-	0x61, 0xff, 0xee, 0xd3, 0xed, 0x07, 0x4f, 0xb6, 0xff, 0xd9, 0x1b, 0xd0, 0xdb, 0x6e, 0x06, 0xbb,
-	0xa0, 0x9d, 0x18, 0xc9, 0x19, 0xcf, 0x0e, 0x1a, 0xb0, 0x07, 0x3a, 0x33, 0x49, 0xe9, 0x47, 0x9b,
-	0x79, 0xf0, 0x00, 0xf4, 0xde, 0xcf, 0x99, 0xa6, 0x39, 0x53, 0xda, 0x22, 0x3e, 0x3c, 0x04, 0xfb,
-	0x29, 0x53, 0x24, 0xc9, 0xe9, 0x44, 0x51, 0x9e, 0x5a, 0x30, 0xb8, 0xbe, 0x7b, 0x28, 0x23, 0xef,
-	0xb1, 0x8c, 0xbc, 0x6f, 0x65, 0xe4, 0x7d, 0xda, 0x44, 0x8d, 0xc7, 0x4d, 0xd4, 0xf8, 0xb2, 0x89,
-	0x1a, 0x6f, 0x5f, 0x6c, 0x0d, 0x78, 0xe3, 0x54, 0xdf, 0x0a, 0xc3, 0x53, 0x62, 0xf7, 0x10, 0xd7,
-	0x17, 0xb4, 0xba, 0x8a, 0x3f, 0x6c, 0x9d, 0x91, 0x9b, 0x3a, 0x69, 0x39, 0x2b, 0xaf, 0x7e, 0x04,
-	0x00, 0x00, 0xff, 0xff, 0x4f, 0x7b, 0x20, 0x8f, 0x67, 0x03, 0x00, 0x00,
+	// 713 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xcf, 0x4f, 0xe3, 0x46,
+	0x18, 0x8d, 0xed, 0xfc, 0xfc, 0x1c, 0x42, 0x34, 0x20, 0x64, 0x90, 0x9a, 0x04, 0x5a, 0xa1, 0x08,
+	0xa9, 0xb6, 0x80, 0x43, 0x2f, 0xed, 0x01, 0x48, 0x11, 0xb9, 0x54, 0x62, 0x54, 0x2e, 0xbd, 0x44,
+	0x13, 0x7b, 0x92, 0x8c, 0x9a, 0x78, 0xa2, 0x99, 0x71, 0x68, 0xf8, 0x2b, 0x7a, 0xeb, 0xbf, 0xd3,
+	0x23, 0x47, 0x8e, 0xab, 0x3d, 0x44, 0xab, 0x20, 0xed, 0xdf, 0xb1, 0x9a, 0xb1, 0x09, 0x59, 0x09,
+	0x2d, 0x68, 0x77, 0x2f, 0x99, 0x79, 0xef, 0x7b, 0x19, 0x7f, 0xf3, 0xbd, 0x67, 0xc3, 0x0f, 0x21,
+	0x17, 0x34, 0x99, 0x04, 0x44, 0x4a, 0xaa, 0x82, 0x78, 0xa0, 0x82, 0xd9, 0xb1, 0x5e, 0xfc, 0xa9,
+	0xe0, 0x8a, 0xa3, 0xad, 0xb4, 0xec, 0x9b, 0xb2, 0xaf, 0xf9, 0xd9, 0xf1, 0xde, 0xf6, 0x90, 0x0f,
+	0xb9, 0xa9, 0x07, 0x7a, 0x97, 0x4a, 0xf7, 0x76, 0x87, 0x9c, 0x0f, 0xc7, 0x34, 0x30, 0xa8, 0x9f,
+	0x0c, 0x02, 0x12, 0xcf, 0xd3, 0xd2, 0xc1, 0x7f, 0x36, 0x6c, 0x5e, 0x8c, 0x89, 0x94, 0x1d, 0x3a,
+	0x60, 0x31, 0x53, 0x8c, 0xc7, 0x68, 0x07, 0x6c, 0x16, 0x79, 0x56, 0xcb, 0x6a, 0x57, 0xce, 0x8b,
+	0xcb, 0x45, 0xd3, 0xee, 0x76, 0xb0, 0xcd, 0x22, 0xb4, 0x03, 0x45, 0x26, 0x65, 0x42, 0x85, 0x67,
+	0xeb, 0x1a, 0xce, 0x10, 0xfa, 0x0d, 0xca, 0x03, 0x4a, 0x54, 0x22, 0xa8, 0xf4, 0x9c, 0x96, 0xd3,
+	0xae, 0x9d, 0xec, 0xfb, 0x2f, 0x34, 0xe7, 0x9b, 0xe7, 0x5c, 0xa6, 0x4a, 0xbc, 0xfa, 0x0b, 0xba,
+	0x86, 0xaa, 0xe0, 0x73, 0x32, 0x56, 0xf3, 0x9e, 0x20, 0x8a, 0x7a, 0x79, 0xf3, 0x60, 0xff, 0x7e,
+	0xd1, 0xcc, 0xbd, 0x5f, 0x34, 0x0f, 0x87, 0x4c, 0x8d, 0x92, 0xbe, 0x1f, 0xf2, 0x49, 0x10, 0x72,
+	0x39, 0xe1, 0x32, 0x5b, 0x7e, 0x96, 0xd1, 0xdf, 0x81, 0x9a, 0x4f, 0xa9, 0xf4, 0x3b, 0x34, 0xc4,
+	0x6e, 0x76, 0x06, 0x26, 0x8a, 0xa2, 0x73, 0xa8, 0x46, 0x44, 0x91, 0x1e, 0x8d, 0x98, 0xe2, 0x42,
+	0x7a, 0x05, 0xd3, 0x55, 0xf3, 0xc5, 0xae, 0x3a, 0x44, 0x91, 0xdf, 0x8d, 0x0e, 0xbb, 0xd1, 0x6a,
+	0x2f, 0x0f, 0xfe, 0x77, 0xa0, 0x60, 0x3a, 0x46, 0xb5, 0xe7, 0x79, 0x7c, 0x71, 0x0e, 0x08, 0xf2,
+	0x31, 0x99, 0x50, 0xcf, 0x31, 0xac, 0xd9, 0x6b, 0xad, 0x9c, 0x4f, 0xfa, 0x7c, 0x9c, 0x5e, 0x0b,
+	0x67, 0x08, 0xb5, 0xc0, 0x8d, 0xa8, 0x0c, 0x05, 0x9b, 0xea, 0x91, 0x7b, 0x05, 0x53, 0x5c, 0xa7,
+	0xd0, 0x2e, 0x38, 0x89, 0x60, 0x5e, 0xd1, 0x4c, 0xa3, 0xb4, 0x5c, 0x34, 0x9d, 0x1b, 0xdc, 0xc5,
+	0x9a, 0x43, 0x87, 0x50, 0x4e, 0x04, 0xeb, 0x8d, 0x88, 0x1c, 0x79, 0x25, 0x53, 0x77, 0x97, 0x8b,
+	0x66, 0xe9, 0x06, 0x77, 0xaf, 0x88, 0x1c, 0xe1, 0x52, 0x22, 0x98, 0xde, 0xa0, 0x36, 0xe4, 0xf5,
+	0x8d, 0xbc, 0x72, 0xcb, 0x6a, 0xbb, 0x27, 0xdb, 0x7e, 0x1a, 0x03, 0xff, 0x29, 0x06, 0xfe, 0x59,
+	0x3c, 0xc7, 0x46, 0xf1, 0x99, 0x85, 0x95, 0x6f, 0xb7, 0x10, 0xbe, 0xbf, 0x85, 0xee, 0x57, 0x58,
+	0xf8, 0x13, 0x00, 0xa6, 0x33, 0x1e, 0x92, 0x2c, 0xd6, 0x45, 0x41, 0x89, 0xe4, 0x71, 0x66, 0x65,
+	0x86, 0x0e, 0x3e, 0xda, 0x50, 0xf9, 0xe3, 0xf2, 0x4f, 0x4c, 0x43, 0x2e, 0x22, 0xb4, 0x0b, 0xe5,
+	0x50, 0x5f, 0xb2, 0xb7, 0xb2, 0xbc, 0x64, 0x70, 0x37, 0xca, 0x72, 0x60, 0xaf, 0x72, 0x90, 0x39,
+	0xe4, 0xbc, 0xe2, 0x50, 0xfe, 0x0d, 0x0e, 0x15, 0x5e, 0x75, 0x68, 0x07, 0x8a, 0x03, 0xc1, 0xef,
+	0x68, 0x6c, 0x12, 0x51, 0xc6, 0x19, 0x42, 0xbf, 0xc2, 0xde, 0xed, 0x88, 0x29, 0x3a, 0x66, 0x52,
+	0xd1, 0xa8, 0x47, 0xc2, 0x90, 0x27, 0xb1, 0x92, 0x3d, 0xb3, 0x98, 0x74, 0xe4, 0xb1, 0xb7, 0xa6,
+	0x38, 0xcb, 0x04, 0x17, 0xfa, 0x17, 0x5d, 0x41, 0x2d, 0xbd, 0xed, 0xca, 0xfd, 0xf2, 0x5b, 0xdd,
+	0xdf, 0x08, 0xd7, 0x90, 0x44, 0xfb, 0x50, 0xcd, 0x4e, 0x4a, 0xbb, 0xac, 0x98, 0x2e, 0xdd, 0x54,
+	0x64, 0xa8, 0x23, 0x09, 0xd5, 0xf5, 0x13, 0x90, 0x0b, 0xa5, 0x7e, 0x22, 0x62, 0x16, 0x0f, 0xeb,
+	0x39, 0x54, 0x85, 0xf2, 0x40, 0x50, 0x7a, 0xa7, 0x91, 0x85, 0xea, 0x50, 0x5d, 0xf5, 0xac, 0x19,
+	0x1b, 0x6d, 0xc1, 0x66, 0xc4, 0x24, 0xe9, 0x8f, 0x69, 0x4f, 0xd2, 0x38, 0xd2, 0xa4, 0x83, 0x10,
+	0xd4, 0x92, 0xa9, 0x1e, 0x8f, 0xa6, 0xf5, 0x5a, 0xcf, 0xa3, 0x0d, 0xa8, 0x48, 0x9e, 0x8c, 0xfb,
+	0x3c, 0x89, 0xa3, 0x7a, 0xe1, 0xe8, 0x47, 0x80, 0xe7, 0x78, 0x20, 0x78, 0x7a, 0x75, 0xeb, 0x39,
+	0x54, 0x81, 0x02, 0xbf, 0x8d, 0xa9, 0xa8, 0x5b, 0xe7, 0xd7, 0xf7, 0xcb, 0x86, 0xf5, 0xb0, 0x6c,
+	0x58, 0x1f, 0x96, 0x0d, 0xeb, 0xdf, 0xc7, 0x46, 0xee, 0xe1, 0xb1, 0x91, 0x7b, 0xf7, 0xd8, 0xc8,
+	0xfd, 0xf5, 0xcb, 0x5a, 0x76, 0x2f, 0xcc, 0x48, 0x2e, 0xf5, 0xc1, 0x26, 0x51, 0x41, 0xf6, 0x81,
+	0x9e, 0x9d, 0x06, 0xff, 0xac, 0x7d, 0xa5, 0x4d, 0xa0, 0xfb, 0x45, 0xe3, 0xe1, 0xe9, 0xa7, 0x01,
+	0x00, 0xd0, 0x63, 0xfa, 0xa3, 0xc6, 0x05, 0x00, 0x00,
 }
 
 func (m *ClassDefinition) Marshal() (dAtA []byte, err error) {
@@ -299,6 +525,24 @@ func (m *ClassDefinition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DataEditors) > 0 {
+		dAtA2 := make([]byte, len(m.DataEditors)*10)
+		var j1 int
+		for _, num := range m.DataEditors {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintNft(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x2a
+	}
 	{
 		size := m.RoyaltyRate.Size()
 		i -= size
@@ -310,20 +554,20 @@ func (m *ClassDefinition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i--
 	dAtA[i] = 0x22
 	if len(m.Features) > 0 {
-		dAtA2 := make([]byte, len(m.Features)*10)
-		var j1 int
+		dAtA4 := make([]byte, len(m.Features)*10)
+		var j3 int
 		for _, num := range m.Features {
 			for num >= 1<<7 {
-				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j1++
+				j3++
 			}
-			dAtA2[j1] = uint8(num)
-			j1++
+			dAtA4[j3] = uint8(num)
+			j3++
 		}
-		i -= j1
-		copy(dAtA[i:], dAtA2[:j1])
-		i = encodeVarintNft(dAtA, i, uint64(j1))
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintNft(dAtA, i, uint64(j3))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -364,6 +608,24 @@ func (m *Class) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DataEditors) > 0 {
+		dAtA6 := make([]byte, len(m.DataEditors)*10)
+		var j5 int
+		for _, num := range m.DataEditors {
+			for num >= 1<<7 {
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
+			}
+			dAtA6[j5] = uint8(num)
+			j5++
+		}
+		i -= j5
+		copy(dAtA[i:], dAtA6[:j5])
+		i = encodeVarintNft(dAtA, i, uint64(j5))
+		i--
+		dAtA[i] = 0x5a
+	}
 	{
 		size := m.RoyaltyRate.Size()
 		i -= size
@@ -375,20 +637,20 @@ func (m *Class) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i--
 	dAtA[i] = 0x52
 	if len(m.Features) > 0 {
-		dAtA4 := make([]byte, len(m.Features)*10)
-		var j3 int
+		dAtA8 := make([]byte, len(m.Features)*10)
+		var j7 int
 		for _, num := range m.Features {
 			for num >= 1<<7 {
-				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA8[j7] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j3++
+				j7++
 			}
-			dAtA4[j3] = uint8(num)
-			j3++
+			dAtA8[j7] = uint8(num)
+			j7++
 		}
-		i -= j3
-		copy(dAtA[i:], dAtA4[:j3])
-		i = encodeVarintNft(dAtA, i, uint64(j3))
+		i -= j7
+		copy(dAtA[i:], dAtA8[:j7])
+		i = encodeVarintNft(dAtA, i, uint64(j7))
 		i--
 		dAtA[i] = 0x4a
 	}
@@ -456,70 +718,213 @@ func (m *Class) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintNft(dAtA []byte, offset int, v uint64) int {
-	offset -= sovNft(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *Revocation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *ClassDefinition) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *Revocation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Revocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ID)
-	if l > 0 {
-		n += 1 + l + sovNft(uint64(l))
-	}
-	l = len(m.Issuer)
-	if l > 0 {
-		n += 1 + l + sovNft(uint64(l))
-	}
-	if len(m.Features) > 0 {
-		l = 0
-		for _, e := range m.Features {
-			l += sovNft(uint64(e))
-		}
-		n += 1 + sovNft(uint64(l)) + l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = m.RoyaltyRate.Size()
-	n += 1 + l + sovNft(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Class) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *NFTRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *NFTRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NFTRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovNft(uint64(l))
-	}
-	l = len(m.Issuer)
-	if l > 0 {
-		n += 1 + l + sovNft(uint64(l))
+	if m.ClassFrozen {
+		i--
+		if m.ClassFrozen {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
 	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovNft(uint64(l))
+	if len(m.ClassFeatures) > 0 {
+		dAtA11 := make([]byte, len(m.ClassFeatures)*10)
+		var j10 int
+		for _, num := range m.ClassFeatures {
+			for num >= 1<<7 {
+				dAtA11[j10] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j10++
+			}
+			dAtA11[j10] = uint8(num)
+			j10++
+		}
+		i -= j10
+		copy(dAtA[i:], dAtA11[:j10])
+		i = encodeVarintNft(dAtA, i, uint64(j10))
+		i--
+		dAtA[i] = 0x42
 	}
-	l = len(m.Symbol)
-	if l > 0 {
-		n += 1 + l + sovNft(uint64(l))
+	if m.WhitelistedAccountsCount != 0 {
+		i = encodeVarintNft(dAtA, i, uint64(m.WhitelistedAccountsCount))
+		i--
+		dAtA[i] = 0x38
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovNft(uint64(l))
+	if m.Frozen {
+		i--
+		if m.Frozen {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
 	}
-	l = len(m.URI)
+	if m.Data != nil {
+		{
+			size, err := m.Data.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintNft(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.URIHash) > 0 {
+		i -= len(m.URIHash)
+		copy(dAtA[i:], m.URIHash)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.URIHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.URI) > 0 {
+		i -= len(m.URI)
+		copy(dAtA[i:], m.URI)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.URI)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintNft(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintNft(dAtA []byte, offset int, v uint64) int {
+	offset -= sovNft(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ClassDefinition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	l = len(m.Issuer)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	if len(m.Features) > 0 {
+		l = 0
+		for _, e := range m.Features {
+			l += sovNft(uint64(e))
+		}
+		n += 1 + sovNft(uint64(l)) + l
+	}
+	l = m.RoyaltyRate.Size()
+	n += 1 + l + sovNft(uint64(l))
+	if len(m.DataEditors) > 0 {
+		l = 0
+		for _, e := range m.DataEditors {
+			l += sovNft(uint64(e))
+		}
+		n += 1 + sovNft(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *Class) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	l = len(m.Issuer)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	l = len(m.URI)
 	if l > 0 {
 		n += 1 + l + sovNft(uint64(l))
 	}
@@ -540,6 +945,71 @@ func (m *Class) Size() (n int) {
 	}
 	l = m.RoyaltyRate.Size()
 	n += 1 + l + sovNft(uint64(l))
+	if len(m.DataEditors) > 0 {
+		l = 0
+		for _, e := range m.DataEditors {
+			l += sovNft(uint64(e))
+		}
+		n += 1 + sovNft(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *Revocation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	return n
+}
+
+func (m *NFTRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	l = len(m.URI)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	l = len(m.URIHash)
+	if l > 0 {
+		n += 1 + l + sovNft(uint64(l))
+	}
+	if m.Data != nil {
+		l = m.Data.Size()
+		n += 1 + l + sovNft(uint64(l))
+	}
+	if m.Frozen {
+		n += 2
+	}
+	if m.WhitelistedAccountsCount != 0 {
+		n += 1 + sovNft(uint64(m.WhitelistedAccountsCount))
+	}
+	if len(m.ClassFeatures) > 0 {
+		l = 0
+		for _, e := range m.ClassFeatures {
+			l += sovNft(uint64(e))
+		}
+		n += 1 + sovNft(uint64(l)) + l
+	}
+	if m.ClassFrozen {
+		n += 2
+	}
 	return n
 }
 
@@ -580,7 +1050,485 @@ func (m *ClassDefinition) Unmarshal(dAtA []byte) error {
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Issuer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v ClassFeature
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowNft
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= ClassFeature(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Features = append(m.Features, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowNft
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthNft
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthNft
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Features) == 0 {
+					m.Features = make([]ClassFeature, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v ClassFeature
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowNft
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= ClassFeature(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Features = append(m.Features, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Features", wireType)
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoyaltyRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RoyaltyRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType == 0 {
+				var v DataEditor
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowNft
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= DataEditor(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.DataEditors = append(m.DataEditors, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowNft
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthNft
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthNft
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.DataEditors) == 0 {
+					m.DataEditors = make([]DataEditor, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v DataEditor
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowNft
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= DataEditor(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.DataEditors = append(m.DataEditors, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataEditors", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNft(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNft
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Class) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNft
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Class: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Class: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Issuer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URI", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNft
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNft
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNft
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.URI = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field URIHash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -608,13 +1556,13 @@ func (m *ClassDefinition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = string(dAtA[iNdEx:postIndex])
+			m.URIHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNft
@@ -624,25 +1572,29 @@ func (m *ClassDefinition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthNft
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthNft
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Issuer = string(dAtA[iNdEx:postIndex])
+			if m.Data == nil {
+				m.Data = &types.Any{}
+			}
+			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 9:
 			if wireType == 0 {
 				var v ClassFeature
 				for shift := uint(0); ; shift += 7 {
@@ -711,7 +1663,7 @@ func (m *ClassDefinition) Unmarshal(dAtA []byte) error {
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field Features", wireType)
 			}
-		case 4:
+		case 10:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field RoyaltyRate", wireType)
 			}
@@ -745,6 +1697,75 @@ func (m *ClassDefinition) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType == 0 {
+				var v DataEditor
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowNft
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= DataEditor(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.DataEditors = append(m.DataEditors, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowNft
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthNft
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthNft
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.DataEditors) == 0 {
+					m.DataEditors = make([]DataEditor, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v DataEditor
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowNft
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= DataEditor(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.DataEditors = append(m.DataEditors, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataEditors", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNft(dAtA[iNdEx:])
@@ -766,7 +1787,7 @@ func (m *ClassDefinition) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Class) Unmarshal(dAtA []byte) error {
+func (m *Revocation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -789,15 +1810,15 @@ func (m *Class) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Class: wiretype end group for non-group")
+			return fmt.Errorf("proto: Revocation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Class: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Revocation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -825,11 +1846,61 @@ func (m *Class) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNft(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNft
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NFTRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNft
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NFTRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NFTRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -857,11 +1928,11 @@ func (m *Class) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Issuer = string(dAtA[iNdEx:postIndex])
+			m.ClassId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -889,11 +1960,11 @@ func (m *Class) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URI", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -921,11 +1992,11 @@ func (m *Class) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Symbol = string(dAtA[iNdEx:postIndex])
+			m.URI = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URIHash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -953,13 +2024,13 @@ func (m *Class) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.URIHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URI", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNft
@@ -969,29 +2040,33 @@ func (m *Class) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthNft
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthNft
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.URI = string(dAtA[iNdEx:postIndex])
+			if m.Data == nil {
+				m.Data = &types.Any{}
+			}
+			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field URIHash", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Frozen", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNft
@@ -1001,29 +2076,17 @@ func (m *Class) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthNft
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthNft
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.URIHash = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			m.Frozen = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WhitelistedAccountsCount", wireType)
 			}
-			var msglen int
+			m.WhitelistedAccountsCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNft
@@ -1033,29 +2096,12 @@ func (m *Class) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.WhitelistedAccountsCount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthNft
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthNft
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Data == nil {
-				m.Data = &types.Any{}
-			}
-			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 9:
+		case 8:
 			if wireType == 0 {
 				var v ClassFeature
 				for shift := uint(0); ; shift += 7 {
@@ -1072,7 +2118,7 @@ func (m *Class) Unmarshal(dAtA []byte) error {
 						break
 					}
 				}
-				m.Features = append(m.Features, v)
+				m.ClassFeatures = append(m.ClassFeatures, v)
 			} else if wireType == 2 {
 				var packedLen int
 				for shift := uint(0); ; shift += 7 {
@@ -1100,8 +2146,8 @@ func (m *Class) Unmarshal(dAtA []byte) error {
 					return io.ErrUnexpectedEOF
 				}
 				var elementCount int
-				if elementCount != 0 && len(m.Features) == 0 {
-					m.Features = make([]ClassFeature, 0, elementCount)
+				if elementCount != 0 && len(m.ClassFeatures) == 0 {
+					m.ClassFeatures = make([]ClassFeature, 0, elementCount)
 				}
 				for iNdEx < postIndex {
 					var v ClassFeature
@@ -1119,16 +2165,16 @@ func (m *Class) Unmarshal(dAtA []byte) error {
 							break
 						}
 					}
-					m.Features = append(m.Features, v)
+					m.ClassFeatures = append(m.ClassFeatures, v)
 				}
 			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Features", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassFeatures", wireType)
 			}
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RoyaltyRate", wireType)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassFrozen", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowNft
@@ -1138,26 +2184,12 @@ func (m *Class) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthNft
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthNft
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.RoyaltyRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.ClassFrozen = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNft(dAtA[iNdEx:])
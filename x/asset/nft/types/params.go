@@ -9,6 +9,9 @@ import (
 // KeyMintFee represents the mint fee param key.
 var KeyMintFee = []byte("MintFee")
 
+// DefaultMaxAuctionSettlementsPerBlock is the default maximum number of ended auctions settled in a single block.
+const DefaultMaxAuctionSettlementsPerBlock = 100
+
 // ParamSetPairs implements the ParamSet interface and returns all the key/value pairs
 // of module parameters.
 func (m *Params) ParamSetPairs() paramtypes.ParamSetPairs {
@@ -20,13 +23,20 @@ func (m *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 // DefaultParams returns params with default values.
 func DefaultParams() Params {
 	return Params{
-		MintFee: sdk.NewInt64Coin(sdk.DefaultBondDenom, 0),
+		MintFee:                       sdk.NewInt64Coin(sdk.DefaultBondDenom, 0),
+		MaxAuctionSettlementsPerBlock: DefaultMaxAuctionSettlementsPerBlock,
 	}
 }
 
 // ValidateBasic validates parameters.
 func (m Params) ValidateBasic() error {
-	return validateMintFee(m.MintFee)
+	if err := validateMintFee(m.MintFee); err != nil {
+		return err
+	}
+	if m.MaxAuctionSettlementsPerBlock == 0 {
+		return errors.New("max auction settlements per block must be positive")
+	}
+	return nil
 }
 
 func validateMintFee(i interface{}) error {
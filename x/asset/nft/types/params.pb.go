@@ -28,6 +28,8 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type Params struct {
 	// mint_fee is the fee burnt each time new NFT is minted
 	MintFee types.Coin `protobuf:"bytes,1,opt,name=mint_fee,json=mintFee,proto3" json:"mint_fee" yaml:"mint_fee"`
+	// max_auction_settlements_per_block is the maximum number of ended auctions settled in a single block
+	MaxAuctionSettlementsPerBlock uint32 `protobuf:"varint,2,opt,name=max_auction_settlements_per_block,json=maxAuctionSettlementsPerBlock,proto3" json:"max_auction_settlements_per_block,omitempty" yaml:"max_auction_settlements_per_block"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -70,6 +72,13 @@ func (m *Params) GetMintFee() types.Coin {
 	return types.Coin{}
 }
 
+func (m *Params) GetMaxAuctionSettlementsPerBlock() uint32 {
+	if m != nil {
+		return m.MaxAuctionSettlementsPerBlock
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "coreum.asset.nft.v1.Params")
 }
@@ -77,23 +86,27 @@ func init() {
 func init() { proto.RegisterFile("coreum/asset/nft/v1/params.proto", fileDescriptor_685317fc76ff1819) }
 
 var fileDescriptor_685317fc76ff1819 = []byte{
-	// 254 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x48, 0xce, 0x2f, 0x4a,
-	0x2d, 0xcd, 0xd5, 0x4f, 0x2c, 0x2e, 0x4e, 0x2d, 0xd1, 0xcf, 0x4b, 0x2b, 0xd1, 0x2f, 0x33, 0xd4,
-	0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x86, 0xa8,
-	0xd0, 0x03, 0xab, 0xd0, 0xcb, 0x4b, 0x2b, 0xd1, 0x2b, 0x33, 0x94, 0x92, 0x4b, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x4a, 0x2c, 0x4e, 0xd5, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4,
-	0x4f, 0xce, 0xcf, 0xcc, 0x83, 0x68, 0x92, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x33, 0xf5, 0x41,
-	0x2c, 0x88, 0xa8, 0x52, 0x38, 0x17, 0x5b, 0x00, 0xd8, 0x68, 0x21, 0x5f, 0x2e, 0x8e, 0xdc, 0xcc,
-	0xbc, 0x92, 0xf8, 0xb4, 0xd4, 0x54, 0x09, 0x46, 0x05, 0x46, 0x0d, 0x6e, 0x23, 0x49, 0x3d, 0x88,
-	0x91, 0x7a, 0x20, 0x23, 0xf5, 0xa0, 0x46, 0xea, 0x39, 0xe7, 0x67, 0xe6, 0x39, 0x89, 0x9f, 0xb8,
-	0x27, 0xcf, 0xf0, 0xe9, 0x9e, 0x3c, 0x7f, 0x65, 0x62, 0x6e, 0x8e, 0x95, 0x12, 0x4c, 0xa3, 0x52,
-	0x10, 0x3b, 0x88, 0xe9, 0x96, 0x9a, 0xea, 0x14, 0x78, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72,
-	0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7,
-	0x72, 0x0c, 0x51, 0xe6, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0xce,
-	0x60, 0x8f, 0xb8, 0xe5, 0x97, 0xe6, 0xa5, 0x24, 0x96, 0x64, 0xe6, 0xe7, 0xe9, 0x43, 0xfd, 0x5e,
-	0x66, 0xac, 0x5f, 0x81, 0x14, 0x00, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0x60, 0x27, 0x1b,
-	0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0x2d, 0x48, 0x93, 0x05, 0x21, 0x01, 0x00, 0x00,
+	// 312 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x90, 0xc1, 0x4a, 0xf3, 0x40,
+	0x14, 0x85, 0x33, 0xff, 0xa2, 0xbf, 0x44, 0x44, 0xa8, 0x82, 0xb5, 0xe0, 0xb4, 0x66, 0xd5, 0x85,
+	0xcc, 0x10, 0xbb, 0x10, 0xdc, 0x99, 0x42, 0x77, 0x42, 0xad, 0x3b, 0x37, 0x61, 0x12, 0x6f, 0x6b,
+	0xb0, 0x33, 0x37, 0x64, 0x6e, 0x42, 0xfb, 0x16, 0x3e, 0x56, 0x77, 0x76, 0xe9, 0xaa, 0x48, 0xfb,
+	0x06, 0x7d, 0x02, 0x49, 0x52, 0xd1, 0x9d, 0xbb, 0xc3, 0xbd, 0xe7, 0x7c, 0x8b, 0xcf, 0xed, 0xc6,
+	0x98, 0x41, 0xae, 0xa5, 0xb2, 0x16, 0x48, 0x9a, 0x09, 0xc9, 0xc2, 0x97, 0xa9, 0xca, 0x94, 0xb6,
+	0x22, 0xcd, 0x90, 0xb0, 0x79, 0x52, 0x37, 0x44, 0xd5, 0x10, 0x66, 0x42, 0xa2, 0xf0, 0xdb, 0x3c,
+	0x46, 0xab, 0xd1, 0xca, 0x48, 0x59, 0x90, 0x85, 0x1f, 0x01, 0x29, 0x5f, 0xc6, 0x98, 0x98, 0x7a,
+	0xd4, 0x3e, 0x9d, 0xe2, 0x14, 0xab, 0x28, 0xcb, 0x54, 0x5f, 0xbd, 0x77, 0xe6, 0x36, 0x46, 0x15,
+	0xbb, 0x79, 0xef, 0x1e, 0xe8, 0xc4, 0x50, 0x38, 0x01, 0x68, 0xb1, 0x2e, 0xeb, 0x1d, 0x5e, 0x9f,
+	0x8b, 0x9a, 0x29, 0x4a, 0xa6, 0xd8, 0x33, 0xc5, 0x00, 0x13, 0x13, 0x9c, 0x2d, 0xd7, 0x1d, 0x67,
+	0xb7, 0xee, 0x1c, 0x2f, 0x94, 0x9e, 0xdd, 0x7a, 0xdf, 0x43, 0x6f, 0xfc, 0xbf, 0x8c, 0x43, 0x80,
+	0x66, 0xe1, 0x5e, 0x6a, 0x35, 0x0f, 0x55, 0x1e, 0x53, 0x82, 0x26, 0xb4, 0x40, 0x34, 0x03, 0x0d,
+	0x86, 0x6c, 0x98, 0x42, 0x16, 0x46, 0x33, 0x8c, 0x5f, 0x5b, 0xff, 0xba, 0xac, 0x77, 0x14, 0x5c,
+	0xed, 0xd6, 0x9d, 0xde, 0x1e, 0xf4, 0xd7, 0xc4, 0x1b, 0x5f, 0x68, 0x35, 0xbf, 0xab, 0x2b, 0x8f,
+	0x3f, 0x8d, 0x11, 0x64, 0x41, 0xf9, 0x0f, 0x1e, 0x96, 0x1b, 0xce, 0x56, 0x1b, 0xce, 0x3e, 0x37,
+	0x9c, 0xbd, 0x6d, 0xb9, 0xb3, 0xda, 0x72, 0xe7, 0x63, 0xcb, 0x9d, 0xa7, 0x9b, 0x69, 0x42, 0x2f,
+	0x79, 0x24, 0x62, 0xd4, 0x72, 0x50, 0x19, 0x1c, 0x62, 0x6e, 0x9e, 0x55, 0x49, 0x92, 0x7b, 0xe9,
+	0x45, 0x5f, 0xce, 0x7f, 0x99, 0xa7, 0x45, 0x0a, 0x36, 0x6a, 0x54, 0xae, 0xfa, 0x5f, 0x03, 0x00,
+	0x9e, 0x02, 0x29, 0xb3, 0x9a, 0x01, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -116,6 +129,11 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxAuctionSettlementsPerBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxAuctionSettlementsPerBlock))
+		i--
+		dAtA[i] = 0x10
+	}
 	{
 		size, err := m.MintFee.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -148,6 +166,9 @@ func (m *Params) Size() (n int) {
 	_ = l
 	l = m.MintFee.Size()
 	n += 1 + l + sovParams(uint64(l))
+	if m.MaxAuctionSettlementsPerBlock != 0 {
+		n += 1 + sovParams(uint64(m.MaxAuctionSettlementsPerBlock))
+	}
 	return n
 }
 
@@ -219,6 +240,25 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAuctionSettlementsPerBlock", wireType)
+			}
+			m.MaxAuctionSettlementsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAuctionSettlementsPerBlock |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
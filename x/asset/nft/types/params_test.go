@@ -9,7 +9,8 @@ import (
 )
 
 var params = Params{
-	MintFee: sdk.NewInt64Coin(sdk.DefaultBondDenom, 10_000_000),
+	MintFee:                       sdk.NewInt64Coin(sdk.DefaultBondDenom, 10_000_000),
+	MaxAuctionSettlementsPerBlock: DefaultMaxAuctionSettlementsPerBlock,
 }
 
 func TestParamsValidation(t *testing.T) {
@@ -34,4 +35,8 @@ func TestParamsValidation(t *testing.T) {
 	testParams = params
 	testParams.MintFee = sdk.Coin{Denom: sdk.DefaultBondDenom, Amount: sdkmath.NewInt(-10_000_000)}
 	assert.Error(t, testParams.ValidateBasic())
+
+	testParams = params
+	testParams.MaxAuctionSettlementsPerBlock = 0
+	assert.Error(t, testParams.ValidateBasic())
 }
@@ -406,6 +406,94 @@ func (m *QueryFrozenResponse) GetFrozen() bool {
 	return false
 }
 
+type QueryClassFrozenRequest struct {
+	ClassId string `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+}
+
+func (m *QueryClassFrozenRequest) Reset()         { *m = QueryClassFrozenRequest{} }
+func (m *QueryClassFrozenRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryClassFrozenRequest) ProtoMessage()    {}
+func (*QueryClassFrozenRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_97b36b7d05006cb3, []int{8}
+}
+func (m *QueryClassFrozenRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClassFrozenRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClassFrozenRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryClassFrozenRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClassFrozenRequest.Merge(m, src)
+}
+func (m *QueryClassFrozenRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClassFrozenRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClassFrozenRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryClassFrozenRequest proto.InternalMessageInfo
+
+func (m *QueryClassFrozenRequest) GetClassId() string {
+	if m != nil {
+		return m.ClassId
+	}
+	return ""
+}
+
+type QueryClassFrozenResponse struct {
+	Frozen bool `protobuf:"varint,1,opt,name=frozen,proto3" json:"frozen,omitempty"`
+}
+
+func (m *QueryClassFrozenResponse) Reset()         { *m = QueryClassFrozenResponse{} }
+func (m *QueryClassFrozenResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryClassFrozenResponse) ProtoMessage()    {}
+func (*QueryClassFrozenResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_97b36b7d05006cb3, []int{9}
+}
+func (m *QueryClassFrozenResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClassFrozenResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClassFrozenResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryClassFrozenResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClassFrozenResponse.Merge(m, src)
+}
+func (m *QueryClassFrozenResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClassFrozenResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClassFrozenResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryClassFrozenResponse proto.InternalMessageInfo
+
+func (m *QueryClassFrozenResponse) GetFrozen() bool {
+	if m != nil {
+		return m.Frozen
+	}
+	return false
+}
+
 type QueryWhitelistedRequest struct {
 	Id      string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	ClassId string `protobuf:"bytes,2,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
@@ -416,7 +504,7 @@ func (m *QueryWhitelistedRequest) Reset()         { *m = QueryWhitelistedRequest
 func (m *QueryWhitelistedRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryWhitelistedRequest) ProtoMessage()    {}
 func (*QueryWhitelistedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_97b36b7d05006cb3, []int{8}
+	return fileDescriptor_97b36b7d05006cb3, []int{10}
 }
 func (m *QueryWhitelistedRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -474,7 +562,7 @@ func (m *QueryWhitelistedResponse) Reset()         { *m = QueryWhitelistedRespon
 func (m *QueryWhitelistedResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryWhitelistedResponse) ProtoMessage()    {}
 func (*QueryWhitelistedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_97b36b7d05006cb3, []int{9}
+	return fileDescriptor_97b36b7d05006cb3, []int{11}
 }
 func (m *QueryWhitelistedResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -521,7 +609,7 @@ func (m *QueryWhitelistedAccountsForNFTRequest) Reset()         { *m = QueryWhit
 func (m *QueryWhitelistedAccountsForNFTRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryWhitelistedAccountsForNFTRequest) ProtoMessage()    {}
 func (*QueryWhitelistedAccountsForNFTRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_97b36b7d05006cb3, []int{10}
+	return fileDescriptor_97b36b7d05006cb3, []int{12}
 }
 func (m *QueryWhitelistedAccountsForNFTRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -583,7 +671,7 @@ func (m *QueryWhitelistedAccountsForNFTResponse) Reset() {
 func (m *QueryWhitelistedAccountsForNFTResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryWhitelistedAccountsForNFTResponse) ProtoMessage()    {}
 func (*QueryWhitelistedAccountsForNFTResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_97b36b7d05006cb3, []int{11}
+	return fileDescriptor_97b36b7d05006cb3, []int{13}
 }
 func (m *QueryWhitelistedAccountsForNFTResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -636,7 +724,7 @@ func (m *QueryClassWhitelistedAccountsRequest) Reset()         { *m = QueryClass
 func (m *QueryClassWhitelistedAccountsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryClassWhitelistedAccountsRequest) ProtoMessage()    {}
 func (*QueryClassWhitelistedAccountsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_97b36b7d05006cb3, []int{12}
+	return fileDescriptor_97b36b7d05006cb3, []int{14}
 }
 func (m *QueryClassWhitelistedAccountsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -689,7 +777,7 @@ func (m *QueryClassWhitelistedAccountsResponse) Reset()         { *m = QueryClas
 func (m *QueryClassWhitelistedAccountsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryClassWhitelistedAccountsResponse) ProtoMessage()    {}
 func (*QueryClassWhitelistedAccountsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_97b36b7d05006cb3, []int{13}
+	return fileDescriptor_97b36b7d05006cb3, []int{15}
 }
 func (m *QueryClassWhitelistedAccountsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -741,7 +829,7 @@ func (m *QueryBurntNFTRequest) Reset()         { *m = QueryBurntNFTRequest{} }
 func (m *QueryBurntNFTRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryBurntNFTRequest) ProtoMessage()    {}
 func (*QueryBurntNFTRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_97b36b7d05006cb3, []int{14}
+	return fileDescriptor_97b36b7d05006cb3, []int{16}
 }
 func (m *QueryBurntNFTRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -786,13 +874,17 @@ func (m *QueryBurntNFTRequest) GetNftId() string {
 
 type QueryBurntNFTResponse struct {
 	Burnt bool `protobuf:"varint,1,opt,name=burnt,proto3" json:"burnt,omitempty"`
+	// revoked is true if the non-fungible token has been burnt by the issuer of the soulbound class, even if it
+	// has been minted again.
+	Revoked          bool   `protobuf:"varint,2,opt,name=revoked,proto3" json:"revoked,omitempty"`
+	RevocationReason string `protobuf:"bytes,3,opt,name=revocation_reason,json=revocationReason,proto3" json:"revocation_reason,omitempty"`
 }
 
 func (m *QueryBurntNFTResponse) Reset()         { *m = QueryBurntNFTResponse{} }
 func (m *QueryBurntNFTResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryBurntNFTResponse) ProtoMessage()    {}
 func (*QueryBurntNFTResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_97b36b7d05006cb3, []int{15}
+	return fileDescriptor_97b36b7d05006cb3, []int{17}
 }
 func (m *QueryBurntNFTResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -828,6 +920,20 @@ func (m *QueryBurntNFTResponse) GetBurnt() bool {
 	return false
 }
 
+func (m *QueryBurntNFTResponse) GetRevoked() bool {
+	if m != nil {
+		return m.Revoked
+	}
+	return false
+}
+
+func (m *QueryBurntNFTResponse) GetRevocationReason() string {
+	if m != nil {
+		return m.RevocationReason
+	}
+	return ""
+}
+
 type QueryBurntNFTsInClassRequest struct {
 	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 	ClassId    string             `protobuf:"bytes,2,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
@@ -837,7 +943,7 @@ func (m *QueryBurntNFTsInClassRequest) Reset()         { *m = QueryBurntNFTsInCl
 func (m *QueryBurntNFTsInClassRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryBurntNFTsInClassRequest) ProtoMessage()    {}
 func (*QueryBurntNFTsInClassRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_97b36b7d05006cb3, []int{16}
+	return fileDescriptor_97b36b7d05006cb3, []int{18}
 }
 func (m *QueryBurntNFTsInClassRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -889,7 +995,7 @@ func (m *QueryBurntNFTsInClassResponse) Reset()         { *m = QueryBurntNFTsInC
 func (m *QueryBurntNFTsInClassResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryBurntNFTsInClassResponse) ProtoMessage()    {}
 func (*QueryBurntNFTsInClassResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_97b36b7d05006cb3, []int{17}
+	return fileDescriptor_97b36b7d05006cb3, []int{19}
 }
 func (m *QueryBurntNFTsInClassResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -932,973 +1038,1135 @@ func (m *QueryBurntNFTsInClassResponse) GetNftIds() []string {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "coreum.asset.nft.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "coreum.asset.nft.v1.QueryParamsResponse")
-	proto.RegisterType((*QueryClassRequest)(nil), "coreum.asset.nft.v1.QueryClassRequest")
-	proto.RegisterType((*QueryClassResponse)(nil), "coreum.asset.nft.v1.QueryClassResponse")
-	proto.RegisterType((*QueryClassesRequest)(nil), "coreum.asset.nft.v1.QueryClassesRequest")
-	proto.RegisterType((*QueryClassesResponse)(nil), "coreum.asset.nft.v1.QueryClassesResponse")
-	proto.RegisterType((*QueryFrozenRequest)(nil), "coreum.asset.nft.v1.QueryFrozenRequest")
-	proto.RegisterType((*QueryFrozenResponse)(nil), "coreum.asset.nft.v1.QueryFrozenResponse")
-	proto.RegisterType((*QueryWhitelistedRequest)(nil), "coreum.asset.nft.v1.QueryWhitelistedRequest")
-	proto.RegisterType((*QueryWhitelistedResponse)(nil), "coreum.asset.nft.v1.QueryWhitelistedResponse")
-	proto.RegisterType((*QueryWhitelistedAccountsForNFTRequest)(nil), "coreum.asset.nft.v1.QueryWhitelistedAccountsForNFTRequest")
-	proto.RegisterType((*QueryWhitelistedAccountsForNFTResponse)(nil), "coreum.asset.nft.v1.QueryWhitelistedAccountsForNFTResponse")
-	proto.RegisterType((*QueryClassWhitelistedAccountsRequest)(nil), "coreum.asset.nft.v1.QueryClassWhitelistedAccountsRequest")
-	proto.RegisterType((*QueryClassWhitelistedAccountsResponse)(nil), "coreum.asset.nft.v1.QueryClassWhitelistedAccountsResponse")
-	proto.RegisterType((*QueryBurntNFTRequest)(nil), "coreum.asset.nft.v1.QueryBurntNFTRequest")
-	proto.RegisterType((*QueryBurntNFTResponse)(nil), "coreum.asset.nft.v1.QueryBurntNFTResponse")
-	proto.RegisterType((*QueryBurntNFTsInClassRequest)(nil), "coreum.asset.nft.v1.QueryBurntNFTsInClassRequest")
-	proto.RegisterType((*QueryBurntNFTsInClassResponse)(nil), "coreum.asset.nft.v1.QueryBurntNFTsInClassResponse")
+type QueryListingRequest struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func init() { proto.RegisterFile("coreum/asset/nft/v1/query.proto", fileDescriptor_97b36b7d05006cb3) }
-
-var fileDescriptor_97b36b7d05006cb3 = []byte{
-	// 969 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x41, 0x6f, 0x1b, 0x45,
-	0x14, 0xce, 0x38, 0xd8, 0x49, 0x5f, 0x24, 0x04, 0xaf, 0x69, 0xeb, 0x6e, 0x13, 0x37, 0x6c, 0x4a,
-	0x92, 0x56, 0x64, 0x47, 0x49, 0xd4, 0xd2, 0xa6, 0x40, 0x21, 0x15, 0x2e, 0x91, 0x10, 0xa4, 0x16,
-	0x12, 0x12, 0x07, 0xd0, 0xda, 0x5e, 0xbb, 0x2b, 0x25, 0x3b, 0xae, 0x67, 0x36, 0x50, 0xa2, 0x48,
-	0x14, 0xb8, 0x20, 0x81, 0x84, 0xc4, 0x0d, 0xc4, 0x81, 0x7f, 0xc0, 0x9d, 0x3f, 0xd0, 0x13, 0xaa,
-	0xc4, 0x05, 0x09, 0x09, 0xa1, 0x84, 0x7f, 0xc1, 0x05, 0xed, 0xcc, 0xdb, 0x64, 0xd7, 0xd9, 0xb5,
-	0x9d, 0x36, 0xea, 0xcd, 0x33, 0xf3, 0xde, 0xfb, 0xbe, 0xef, 0xcd, 0xdb, 0xf9, 0x64, 0xb8, 0xd8,
-	0x10, 0x5d, 0x2f, 0xdc, 0xe2, 0xae, 0x94, 0x9e, 0xe2, 0x41, 0x4b, 0xf1, 0xed, 0x25, 0x7e, 0x3f,
-	0xf4, 0xba, 0x0f, 0x9c, 0x4e, 0x57, 0x28, 0x81, 0xa7, 0x4d, 0x80, 0xa3, 0x03, 0x9c, 0xa0, 0xa5,
-	0x9c, 0xed, 0x25, 0x6b, 0xb2, 0x2d, 0xda, 0x42, 0x9f, 0xf3, 0xe8, 0x97, 0x09, 0xb5, 0xa6, 0xda,
-	0x42, 0xb4, 0x37, 0x3d, 0xee, 0x76, 0x7c, 0xee, 0x06, 0x81, 0x50, 0xae, 0xf2, 0x45, 0x20, 0xe9,
-	0x74, 0x3a, 0x0b, 0x29, 0xaa, 0x67, 0x8e, 0x67, 0xb2, 0x8e, 0x3b, 0x6e, 0xd7, 0xdd, 0x8a, 0x0b,
-	0x5c, 0x69, 0x08, 0xb9, 0x25, 0x24, 0xaf, 0xbb, 0xd2, 0x33, 0x14, 0xf9, 0xf6, 0x52, 0xdd, 0x53,
-	0x6e, 0x14, 0xd7, 0xf6, 0x03, 0x8d, 0x66, 0x62, 0xed, 0x49, 0xc0, 0xbb, 0x51, 0xc4, 0x86, 0x2e,
-	0x50, 0xf3, 0xee, 0x87, 0x9e, 0x54, 0xf6, 0x06, 0x9c, 0x4e, 0xed, 0xca, 0x8e, 0x08, 0xa4, 0x87,
-	0x37, 0xa0, 0x64, 0x80, 0xca, 0x6c, 0x86, 0x2d, 0x4c, 0x2c, 0x5f, 0x70, 0x32, 0x34, 0x3b, 0x26,
-	0x69, 0xed, 0xb9, 0x47, 0x7f, 0x5f, 0x1c, 0xa9, 0x51, 0x82, 0x3d, 0x0b, 0x2f, 0xea, 0x8a, 0xb7,
-	0x37, 0x5d, 0x19, 0xc3, 0xe0, 0xf3, 0x50, 0xf0, 0x9b, 0xba, 0xd6, 0xa9, 0x5a, 0xc1, 0x6f, 0xda,
-	0xef, 0x12, 0x19, 0x0a, 0x22, 0xd4, 0x6b, 0x50, 0x6c, 0x44, 0x1b, 0x04, 0x6a, 0x65, 0x82, 0xea,
-	0x14, 0xc2, 0x34, 0xe1, 0x76, 0x48, 0x22, 0xf4, 0x91, 0x77, 0x00, 0x5a, 0x05, 0x38, 0xec, 0x02,
-	0xd5, 0x9c, 0x73, 0x4c, 0xcb, 0x9c, 0xa8, 0x65, 0x8e, 0xb9, 0x55, 0x6a, 0x99, 0xb3, 0xe1, 0xb6,
-	0x3d, 0xca, 0xad, 0x25, 0x32, 0xf1, 0x2c, 0x94, 0x7c, 0x29, 0x43, 0xaf, 0x5b, 0x2e, 0x68, 0x01,
-	0xb4, 0xb2, 0x7f, 0x62, 0x30, 0x99, 0xc6, 0x25, 0x1d, 0x77, 0x32, 0x80, 0xe7, 0x07, 0x02, 0x9b,
-	0xe4, 0x14, 0xf2, 0x2a, 0x8c, 0x35, 0x4c, 0xed, 0x72, 0x61, 0x66, 0x74, 0xa8, 0x96, 0xc4, 0x09,
-	0xf6, 0x2d, 0x6a, 0x71, 0xb5, 0x2b, 0x3e, 0xf7, 0x82, 0x9c, 0x8b, 0xc0, 0xf3, 0x30, 0xae, 0x13,
-	0x3e, 0xf1, 0x9b, 0xa4, 0xce, 0x14, 0x58, 0x6f, 0xda, 0x8b, 0xd4, 0xd5, 0xb8, 0x00, 0x89, 0x3b,
-	0x0b, 0xa5, 0x96, 0xde, 0xd1, 0x55, 0xc6, 0x6b, 0xb4, 0xb2, 0x3f, 0x86, 0x73, 0x3a, 0xfc, 0xc3,
-	0x7b, 0xbe, 0xf2, 0x36, 0x7d, 0xa9, 0xbc, 0xe6, 0xf1, 0x41, 0xb1, 0x0c, 0x63, 0x6e, 0xa3, 0x21,
-	0xc2, 0x40, 0x95, 0x47, 0xcd, 0x09, 0x2d, 0xed, 0xd7, 0xa0, 0x7c, 0xb4, 0x3e, 0x71, 0x9a, 0x81,
-	0x89, 0x4f, 0x0f, 0xb7, 0x89, 0x58, 0x72, 0xcb, 0xfe, 0x91, 0xc1, 0xcb, 0xbd, 0xe9, 0x6f, 0x99,
-	0xca, 0xb2, 0x2a, 0xba, 0xef, 0x55, 0x3f, 0x38, 0xe9, 0xa9, 0x31, 0xa2, 0x0b, 0x99, 0xa2, 0x47,
-	0xd3, 0x9d, 0xfe, 0x8e, 0xc1, 0xdc, 0x20, 0x72, 0x27, 0x3d, 0x5a, 0x16, 0x8c, 0x53, 0x67, 0xcd,
-	0x6c, 0x9d, 0xaa, 0x1d, 0xac, 0xed, 0x6f, 0x18, 0x5c, 0x3a, 0x1c, 0xec, 0x0c, 0x52, 0x27, 0xdd,
-	0xab, 0x3e, 0x53, 0xf8, 0x6d, 0x7c, 0x71, 0xf9, 0x5c, 0x9e, 0x65, 0x6b, 0xde, 0xa1, 0x4f, 0x7e,
-	0x2d, 0xec, 0x06, 0x2a, 0x31, 0x35, 0x49, 0x05, 0x2c, 0x3d, 0xd2, 0x67, 0xa0, 0x14, 0xb4, 0xd4,
-	0xa1, 0xb4, 0x62, 0xd0, 0x52, 0xfa, 0xf3, 0x3a, 0xd3, 0x53, 0x89, 0x74, 0x4c, 0x42, 0xb1, 0x1e,
-	0xed, 0xd1, 0x18, 0x9b, 0x85, 0xfd, 0x90, 0xc1, 0x54, 0x2a, 0x5e, 0xae, 0x07, 0xa9, 0x27, 0xf6,
-	0x19, 0xdc, 0xc5, 0x43, 0x06, 0xd3, 0x39, 0x1c, 0x4e, 0xfa, 0x0e, 0xce, 0xc1, 0x98, 0x69, 0x5a,
-	0x7c, 0x05, 0x25, 0xdd, 0x35, 0xb9, 0xfc, 0xdf, 0x04, 0x14, 0x35, 0x07, 0xfc, 0x82, 0x41, 0xc9,
-	0x38, 0x10, 0xce, 0x67, 0x3e, 0x8b, 0x47, 0xed, 0xce, 0x5a, 0x18, 0x1c, 0x68, 0xc8, 0xd8, 0xb3,
-	0x5f, 0xfe, 0xf1, 0xef, 0x0f, 0x85, 0x69, 0xbc, 0xc0, 0xf3, 0x5d, 0x18, 0xbf, 0x62, 0x50, 0xd4,
-	0x0d, 0xc0, 0xb9, 0xfc, 0xc2, 0xc9, 0x5b, 0xb2, 0xe6, 0x07, 0xc6, 0x11, 0xfe, 0x65, 0x8d, 0x3f,
-	0x8b, 0x2f, 0x65, 0xe2, 0xd3, 0x23, 0xcf, 0x77, 0xfc, 0xe6, 0x2e, 0x7e, 0xcd, 0x60, 0x8c, 0x2c,
-	0x08, 0x17, 0x06, 0xd4, 0x3f, 0x70, 0x47, 0xeb, 0xf2, 0x10, 0x91, 0xc4, 0xe5, 0x92, 0xe6, 0x52,
-	0xc1, 0xa9, 0x7e, 0x5c, 0xf0, 0x67, 0x06, 0x25, 0xe3, 0x15, 0xfd, 0xee, 0x23, 0x65, 0x47, 0xfd,
-	0xee, 0x23, 0x6d, 0x3b, 0xf6, 0x9b, 0x9a, 0xc3, 0x2a, 0x5e, 0xef, 0xdf, 0x8f, 0x78, 0x74, 0x77,
-	0xa3, 0x13, 0xd3, 0x1f, 0x6e, 0x0c, 0x0a, 0x7f, 0x63, 0x30, 0x91, 0x78, 0x3f, 0xf0, 0x95, 0x7c,
-	0xec, 0xa3, 0x1e, 0x66, 0x2d, 0x0e, 0x19, 0x4d, 0x74, 0xdf, 0xd7, 0x74, 0xd7, 0xf1, 0xce, 0xf1,
-	0xe9, 0x26, 0x6c, 0x8b, 0xef, 0xd0, 0xc3, 0xb3, 0x8b, 0x7f, 0x31, 0x38, 0x9f, 0x6b, 0x0f, 0xb8,
-	0x3a, 0x14, 0xbb, 0x4c, 0xc3, 0xb3, 0x6e, 0x3e, 0x51, 0x2e, 0xe9, 0x7c, 0x5b, 0xeb, 0xbc, 0x85,
-	0xaf, 0x3f, 0x95, 0x4e, 0xfc, 0x9d, 0x41, 0x39, 0xef, 0x81, 0xc7, 0x1b, 0x03, 0x26, 0x35, 0xdf,
-	0xa0, 0xac, 0xd5, 0x27, 0x49, 0x25, 0x69, 0x37, 0xb5, 0xb4, 0xab, 0xb8, 0x32, 0xac, 0xb4, 0xa4,
-	0xa0, 0x5f, 0x18, 0x8c, 0xc7, 0xaf, 0x24, 0xf6, 0xf9, 0xd4, 0x7a, 0x7c, 0xc4, 0xba, 0x32, 0x4c,
-	0x28, 0x11, 0x7c, 0x43, 0x13, 0xbc, 0x8e, 0xd7, 0x86, 0x25, 0xa8, 0x9d, 0x84, 0xef, 0x98, 0x87,
-	0x75, 0x17, 0x7f, 0x65, 0xf0, 0x42, 0xef, 0x4b, 0x8e, 0x4b, 0x83, 0x09, 0xf4, 0x38, 0x8f, 0xb5,
-	0x7c, 0x9c, 0x14, 0xe2, 0x7e, 0x55, 0x73, 0xe7, 0xb8, 0x78, 0x2c, 0xee, 0x6b, 0x77, 0x1f, 0xed,
-	0x55, 0xd8, 0xe3, 0xbd, 0x0a, 0xfb, 0x67, 0xaf, 0xc2, 0xbe, 0xdf, 0xaf, 0x8c, 0x3c, 0xde, 0xaf,
-	0x8c, 0xfc, 0xb9, 0x5f, 0x19, 0xf9, 0xe8, 0xd5, 0xb6, 0xaf, 0xee, 0x85, 0x75, 0xa7, 0x21, 0xb6,
-	0xf8, 0x6d, 0x5d, 0xb2, 0x2a, 0xc2, 0xa0, 0xa9, 0xdd, 0x24, 0xc6, 0xd8, 0x5e, 0xe1, 0x9f, 0x25,
-	0x80, 0xd4, 0x83, 0x8e, 0x27, 0xeb, 0x25, 0xfd, 0xf7, 0x68, 0xe5, 0xff, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0xea, 0x1b, 0xdc, 0xba, 0xf7, 0x0d, 0x00, 0x00,
+func (m *QueryListingRequest) Reset()         { *m = QueryListingRequest{} }
+func (m *QueryListingRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryListingRequest) ProtoMessage()    {}
+func (*QueryListingRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_97b36b7d05006cb3, []int{20}
+}
+func (m *QueryListingRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryListingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListingRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryListingRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListingRequest.Merge(m, src)
+}
+func (m *QueryListingRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryListingRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListingRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryListingRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Params queries the parameters of x/asset/nft module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Class queries the non-fungible token class of the module.
-	Class(ctx context.Context, in *QueryClassRequest, opts ...grpc.CallOption) (*QueryClassResponse, error)
-	// Classes queries the non-fungible token classes of the module.
-	Classes(ctx context.Context, in *QueryClassesRequest, opts ...grpc.CallOption) (*QueryClassesResponse, error)
-	// Frozen queries to check if an NFT is frozen or not.
-	Frozen(ctx context.Context, in *QueryFrozenRequest, opts ...grpc.CallOption) (*QueryFrozenResponse, error)
-	// Whitelisted queries to check if an account is whitelited to hold an NFT or not.
-	Whitelisted(ctx context.Context, in *QueryWhitelistedRequest, opts ...grpc.CallOption) (*QueryWhitelistedResponse, error)
-	// WhitelistedAccountsForNFT returns the list of accounts which are whitelisted to hold this NFT.
-	WhitelistedAccountsForNFT(ctx context.Context, in *QueryWhitelistedAccountsForNFTRequest, opts ...grpc.CallOption) (*QueryWhitelistedAccountsForNFTResponse, error)
-	// ClassWhitelistedAccounts returns the list of accounts which are whitelisted to hold NFTs in this class.
-	ClassWhitelistedAccounts(ctx context.Context, in *QueryClassWhitelistedAccountsRequest, opts ...grpc.CallOption) (*QueryClassWhitelistedAccountsResponse, error)
-	// BurntNFTsInClass checks if an nft if is in burnt NFTs list.
-	BurntNFT(ctx context.Context, in *QueryBurntNFTRequest, opts ...grpc.CallOption) (*QueryBurntNFTResponse, error)
-	// BurntNFTsInClass returns the list of burnt nfts in a class.
-	BurntNFTsInClass(ctx context.Context, in *QueryBurntNFTsInClassRequest, opts ...grpc.CallOption) (*QueryBurntNFTsInClassResponse, error)
+func (m *QueryListingRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+type QueryListingResponse struct {
+	Listing Listing `protobuf:"bytes,1,opt,name=listing,proto3" json:"listing"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryListingResponse) Reset()         { *m = QueryListingResponse{} }
+func (m *QueryListingResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryListingResponse) ProtoMessage()    {}
+func (*QueryListingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_97b36b7d05006cb3, []int{21}
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/coreum.asset.nft.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryListingResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) Class(ctx context.Context, in *QueryClassRequest, opts ...grpc.CallOption) (*QueryClassResponse, error) {
-	out := new(QueryClassResponse)
-	err := c.cc.Invoke(ctx, "/coreum.asset.nft.v1.Query/Class", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryListingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListingResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryListingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListingResponse.Merge(m, src)
+}
+func (m *QueryListingResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryListingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListingResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) Classes(ctx context.Context, in *QueryClassesRequest, opts ...grpc.CallOption) (*QueryClassesResponse, error) {
-	out := new(QueryClassesResponse)
-	err := c.cc.Invoke(ctx, "/coreum.asset.nft.v1.Query/Classes", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryListingResponse proto.InternalMessageInfo
+
+func (m *QueryListingResponse) GetListing() Listing {
+	if m != nil {
+		return m.Listing
 	}
-	return out, nil
+	return Listing{}
 }
 
-func (c *queryClient) Frozen(ctx context.Context, in *QueryFrozenRequest, opts ...grpc.CallOption) (*QueryFrozenResponse, error) {
-	out := new(QueryFrozenResponse)
-	err := c.cc.Invoke(ctx, "/coreum.asset.nft.v1.Query/Frozen", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryListingsByClassRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	ClassId    string             `protobuf:"bytes,2,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
 }
 
-func (c *queryClient) Whitelisted(ctx context.Context, in *QueryWhitelistedRequest, opts ...grpc.CallOption) (*QueryWhitelistedResponse, error) {
-	out := new(QueryWhitelistedResponse)
-	err := c.cc.Invoke(ctx, "/coreum.asset.nft.v1.Query/Whitelisted", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryListingsByClassRequest) Reset()         { *m = QueryListingsByClassRequest{} }
+func (m *QueryListingsByClassRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryListingsByClassRequest) ProtoMessage()    {}
+func (*QueryListingsByClassRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_97b36b7d05006cb3, []int{22}
+}
+func (m *QueryListingsByClassRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryListingsByClassRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListingsByClassRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryListingsByClassRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListingsByClassRequest.Merge(m, src)
+}
+func (m *QueryListingsByClassRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryListingsByClassRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListingsByClassRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) WhitelistedAccountsForNFT(ctx context.Context, in *QueryWhitelistedAccountsForNFTRequest, opts ...grpc.CallOption) (*QueryWhitelistedAccountsForNFTResponse, error) {
-	out := new(QueryWhitelistedAccountsForNFTResponse)
-	err := c.cc.Invoke(ctx, "/coreum.asset.nft.v1.Query/WhitelistedAccountsForNFT", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryListingsByClassRequest proto.InternalMessageInfo
+
+func (m *QueryListingsByClassRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) ClassWhitelistedAccounts(ctx context.Context, in *QueryClassWhitelistedAccountsRequest, opts ...grpc.CallOption) (*QueryClassWhitelistedAccountsResponse, error) {
-	out := new(QueryClassWhitelistedAccountsResponse)
-	err := c.cc.Invoke(ctx, "/coreum.asset.nft.v1.Query/ClassWhitelistedAccounts", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryListingsByClassRequest) GetClassId() string {
+	if m != nil {
+		return m.ClassId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) BurntNFT(ctx context.Context, in *QueryBurntNFTRequest, opts ...grpc.CallOption) (*QueryBurntNFTResponse, error) {
-	out := new(QueryBurntNFTResponse)
-	err := c.cc.Invoke(ctx, "/coreum.asset.nft.v1.Query/BurntNFT", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryListingsByClassResponse struct {
+	Pagination *query.PageResponse `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Listings   []Listing           `protobuf:"bytes,2,rep,name=listings,proto3" json:"listings"`
 }
 
-func (c *queryClient) BurntNFTsInClass(ctx context.Context, in *QueryBurntNFTsInClassRequest, opts ...grpc.CallOption) (*QueryBurntNFTsInClassResponse, error) {
-	out := new(QueryBurntNFTsInClassResponse)
-	err := c.cc.Invoke(ctx, "/coreum.asset.nft.v1.Query/BurntNFTsInClass", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryListingsByClassResponse) Reset()         { *m = QueryListingsByClassResponse{} }
+func (m *QueryListingsByClassResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryListingsByClassResponse) ProtoMessage()    {}
+func (*QueryListingsByClassResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_97b36b7d05006cb3, []int{23}
+}
+func (m *QueryListingsByClassResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryListingsByClassResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListingsByClassResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryListingsByClassResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListingsByClassResponse.Merge(m, src)
+}
+func (m *QueryListingsByClassResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryListingsByClassResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListingsByClassResponse.DiscardUnknown(m)
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Params queries the parameters of x/asset/nft module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Class queries the non-fungible token class of the module.
-	Class(context.Context, *QueryClassRequest) (*QueryClassResponse, error)
-	// Classes queries the non-fungible token classes of the module.
-	Classes(context.Context, *QueryClassesRequest) (*QueryClassesResponse, error)
-	// Frozen queries to check if an NFT is frozen or not.
-	Frozen(context.Context, *QueryFrozenRequest) (*QueryFrozenResponse, error)
-	// Whitelisted queries to check if an account is whitelited to hold an NFT or not.
-	Whitelisted(context.Context, *QueryWhitelistedRequest) (*QueryWhitelistedResponse, error)
-	// WhitelistedAccountsForNFT returns the list of accounts which are whitelisted to hold this NFT.
-	WhitelistedAccountsForNFT(context.Context, *QueryWhitelistedAccountsForNFTRequest) (*QueryWhitelistedAccountsForNFTResponse, error)
-	// ClassWhitelistedAccounts returns the list of accounts which are whitelisted to hold NFTs in this class.
-	ClassWhitelistedAccounts(context.Context, *QueryClassWhitelistedAccountsRequest) (*QueryClassWhitelistedAccountsResponse, error)
-	// BurntNFTsInClass checks if an nft if is in burnt NFTs list.
-	BurntNFT(context.Context, *QueryBurntNFTRequest) (*QueryBurntNFTResponse, error)
-	// BurntNFTsInClass returns the list of burnt nfts in a class.
-	BurntNFTsInClass(context.Context, *QueryBurntNFTsInClassRequest) (*QueryBurntNFTsInClassResponse, error)
+var xxx_messageInfo_QueryListingsByClassResponse proto.InternalMessageInfo
+
+func (m *QueryListingsByClassResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryListingsByClassResponse) GetListings() []Listing {
+	if m != nil {
+		return m.Listings
+	}
+	return nil
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+type QueryListingsBySellerRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Seller     string             `protobuf:"bytes,2,opt,name=seller,proto3" json:"seller,omitempty"`
 }
-func (*UnimplementedQueryServer) Class(ctx context.Context, req *QueryClassRequest) (*QueryClassResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Class not implemented")
+
+func (m *QueryListingsBySellerRequest) Reset()         { *m = QueryListingsBySellerRequest{} }
+func (m *QueryListingsBySellerRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryListingsBySellerRequest) ProtoMessage()    {}
+func (*QueryListingsBySellerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_97b36b7d05006cb3, []int{24}
 }
-func (*UnimplementedQueryServer) Classes(ctx context.Context, req *QueryClassesRequest) (*QueryClassesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Classes not implemented")
+func (m *QueryListingsBySellerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) Frozen(ctx context.Context, req *QueryFrozenRequest) (*QueryFrozenResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Frozen not implemented")
+func (m *QueryListingsBySellerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListingsBySellerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) Whitelisted(ctx context.Context, req *QueryWhitelistedRequest) (*QueryWhitelistedResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Whitelisted not implemented")
+func (m *QueryListingsBySellerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListingsBySellerRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) WhitelistedAccountsForNFT(ctx context.Context, req *QueryWhitelistedAccountsForNFTRequest) (*QueryWhitelistedAccountsForNFTResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WhitelistedAccountsForNFT not implemented")
+func (m *QueryListingsBySellerRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) ClassWhitelistedAccounts(ctx context.Context, req *QueryClassWhitelistedAccountsRequest) (*QueryClassWhitelistedAccountsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClassWhitelistedAccounts not implemented")
+func (m *QueryListingsBySellerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListingsBySellerRequest.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) BurntNFT(ctx context.Context, req *QueryBurntNFTRequest) (*QueryBurntNFTResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BurntNFT not implemented")
+
+var xxx_messageInfo_QueryListingsBySellerRequest proto.InternalMessageInfo
+
+func (m *QueryListingsBySellerRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
-func (*UnimplementedQueryServer) BurntNFTsInClass(ctx context.Context, req *QueryBurntNFTsInClassRequest) (*QueryBurntNFTsInClassResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BurntNFTsInClass not implemented")
+
+func (m *QueryListingsBySellerRequest) GetSeller() string {
+	if m != nil {
+		return m.Seller
+	}
+	return ""
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+type QueryListingsBySellerResponse struct {
+	Pagination *query.PageResponse `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Listings   []Listing           `protobuf:"bytes,2,rep,name=listings,proto3" json:"listings"`
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/coreum.asset.nft.v1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+func (m *QueryListingsBySellerResponse) Reset()         { *m = QueryListingsBySellerResponse{} }
+func (m *QueryListingsBySellerResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryListingsBySellerResponse) ProtoMessage()    {}
+func (*QueryListingsBySellerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_97b36b7d05006cb3, []int{25}
+}
+func (m *QueryListingsBySellerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryListingsBySellerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListingsBySellerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryListingsBySellerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListingsBySellerResponse.Merge(m, src)
+}
+func (m *QueryListingsBySellerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryListingsBySellerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListingsBySellerResponse.DiscardUnknown(m)
 }
 
-func _Query_Class_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryClassRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Class(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/coreum.asset.nft.v1.Query/Class",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Class(ctx, req.(*QueryClassRequest))
+var xxx_messageInfo_QueryListingsBySellerResponse proto.InternalMessageInfo
+
+func (m *QueryListingsBySellerResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_Classes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryClassesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Classes(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/coreum.asset.nft.v1.Query/Classes",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Classes(ctx, req.(*QueryClassesRequest))
+func (m *QueryListingsBySellerResponse) GetListings() []Listing {
+	if m != nil {
+		return m.Listings
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_Frozen_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryFrozenRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Frozen(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/coreum.asset.nft.v1.Query/Frozen",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Frozen(ctx, req.(*QueryFrozenRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryNFTsByOwnerRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Owner      string             `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// class_id is optional, if set only the non-fungible tokens of the class are returned.
+	ClassId string `protobuf:"bytes,3,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
 }
 
-func _Query_Whitelisted_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryWhitelistedRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Whitelisted(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/coreum.asset.nft.v1.Query/Whitelisted",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Whitelisted(ctx, req.(*QueryWhitelistedRequest))
+func (m *QueryNFTsByOwnerRequest) Reset()         { *m = QueryNFTsByOwnerRequest{} }
+func (m *QueryNFTsByOwnerRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryNFTsByOwnerRequest) ProtoMessage()    {}
+func (*QueryNFTsByOwnerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_97b36b7d05006cb3, []int{26}
+}
+func (m *QueryNFTsByOwnerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNFTsByOwnerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNFTsByOwnerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryNFTsByOwnerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNFTsByOwnerRequest.Merge(m, src)
+}
+func (m *QueryNFTsByOwnerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNFTsByOwnerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNFTsByOwnerRequest.DiscardUnknown(m)
 }
 
-func _Query_WhitelistedAccountsForNFT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryWhitelistedAccountsForNFTRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).WhitelistedAccountsForNFT(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/coreum.asset.nft.v1.Query/WhitelistedAccountsForNFT",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).WhitelistedAccountsForNFT(ctx, req.(*QueryWhitelistedAccountsForNFTRequest))
+var xxx_messageInfo_QueryNFTsByOwnerRequest proto.InternalMessageInfo
+
+func (m *QueryNFTsByOwnerRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_ClassWhitelistedAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryClassWhitelistedAccountsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ClassWhitelistedAccounts(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/coreum.asset.nft.v1.Query/ClassWhitelistedAccounts",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ClassWhitelistedAccounts(ctx, req.(*QueryClassWhitelistedAccountsRequest))
+func (m *QueryNFTsByOwnerRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_BurntNFT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryBurntNFTRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).BurntNFT(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/coreum.asset.nft.v1.Query/BurntNFT",
+func (m *QueryNFTsByOwnerRequest) GetClassId() string {
+	if m != nil {
+		return m.ClassId
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).BurntNFT(ctx, req.(*QueryBurntNFTRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_BurntNFTsInClass_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryBurntNFTsInClassRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).BurntNFTsInClass(ctx, in)
+type QueryNFTsByOwnerResponse struct {
+	Pagination *query.PageResponse `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Nfts       []NFTRecord         `protobuf:"bytes,2,rep,name=nfts,proto3" json:"nfts"`
+}
+
+func (m *QueryNFTsByOwnerResponse) Reset()         { *m = QueryNFTsByOwnerResponse{} }
+func (m *QueryNFTsByOwnerResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryNFTsByOwnerResponse) ProtoMessage()    {}
+func (*QueryNFTsByOwnerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_97b36b7d05006cb3, []int{27}
+}
+func (m *QueryNFTsByOwnerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNFTsByOwnerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNFTsByOwnerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/coreum.asset.nft.v1.Query/BurntNFTsInClass",
+}
+func (m *QueryNFTsByOwnerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNFTsByOwnerResponse.Merge(m, src)
+}
+func (m *QueryNFTsByOwnerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNFTsByOwnerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNFTsByOwnerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNFTsByOwnerResponse proto.InternalMessageInfo
+
+func (m *QueryNFTsByOwnerResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).BurntNFTsInClass(ctx, req.(*QueryBurntNFTsInClassRequest))
+	return nil
+}
+
+func (m *QueryNFTsByOwnerResponse) GetNfts() []NFTRecord {
+	if m != nil {
+		return m.Nfts
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "coreum.asset.nft.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "Class",
-			Handler:    _Query_Class_Handler,
-		},
-		{
-			MethodName: "Classes",
-			Handler:    _Query_Classes_Handler,
-		},
-		{
-			MethodName: "Frozen",
-			Handler:    _Query_Frozen_Handler,
-		},
-		{
-			MethodName: "Whitelisted",
-			Handler:    _Query_Whitelisted_Handler,
-		},
-		{
-			MethodName: "WhitelistedAccountsForNFT",
-			Handler:    _Query_WhitelistedAccountsForNFT_Handler,
-		},
-		{
-			MethodName: "ClassWhitelistedAccounts",
-			Handler:    _Query_ClassWhitelistedAccounts_Handler,
-		},
-		{
-			MethodName: "BurntNFT",
-			Handler:    _Query_BurntNFT_Handler,
-		},
-		{
-			MethodName: "BurntNFTsInClass",
-			Handler:    _Query_BurntNFTsInClass_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "coreum/asset/nft/v1/query.proto",
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "coreum.asset.nft.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "coreum.asset.nft.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryClassRequest)(nil), "coreum.asset.nft.v1.QueryClassRequest")
+	proto.RegisterType((*QueryClassResponse)(nil), "coreum.asset.nft.v1.QueryClassResponse")
+	proto.RegisterType((*QueryClassesRequest)(nil), "coreum.asset.nft.v1.QueryClassesRequest")
+	proto.RegisterType((*QueryClassesResponse)(nil), "coreum.asset.nft.v1.QueryClassesResponse")
+	proto.RegisterType((*QueryFrozenRequest)(nil), "coreum.asset.nft.v1.QueryFrozenRequest")
+	proto.RegisterType((*QueryFrozenResponse)(nil), "coreum.asset.nft.v1.QueryFrozenResponse")
+	proto.RegisterType((*QueryClassFrozenRequest)(nil), "coreum.asset.nft.v1.QueryClassFrozenRequest")
+	proto.RegisterType((*QueryClassFrozenResponse)(nil), "coreum.asset.nft.v1.QueryClassFrozenResponse")
+	proto.RegisterType((*QueryWhitelistedRequest)(nil), "coreum.asset.nft.v1.QueryWhitelistedRequest")
+	proto.RegisterType((*QueryWhitelistedResponse)(nil), "coreum.asset.nft.v1.QueryWhitelistedResponse")
+	proto.RegisterType((*QueryWhitelistedAccountsForNFTRequest)(nil), "coreum.asset.nft.v1.QueryWhitelistedAccountsForNFTRequest")
+	proto.RegisterType((*QueryWhitelistedAccountsForNFTResponse)(nil), "coreum.asset.nft.v1.QueryWhitelistedAccountsForNFTResponse")
+	proto.RegisterType((*QueryClassWhitelistedAccountsRequest)(nil), "coreum.asset.nft.v1.QueryClassWhitelistedAccountsRequest")
+	proto.RegisterType((*QueryClassWhitelistedAccountsResponse)(nil), "coreum.asset.nft.v1.QueryClassWhitelistedAccountsResponse")
+	proto.RegisterType((*QueryBurntNFTRequest)(nil), "coreum.asset.nft.v1.QueryBurntNFTRequest")
+	proto.RegisterType((*QueryBurntNFTResponse)(nil), "coreum.asset.nft.v1.QueryBurntNFTResponse")
+	proto.RegisterType((*QueryBurntNFTsInClassRequest)(nil), "coreum.asset.nft.v1.QueryBurntNFTsInClassRequest")
+	proto.RegisterType((*QueryBurntNFTsInClassResponse)(nil), "coreum.asset.nft.v1.QueryBurntNFTsInClassResponse")
+	proto.RegisterType((*QueryListingRequest)(nil), "coreum.asset.nft.v1.QueryListingRequest")
+	proto.RegisterType((*QueryListingResponse)(nil), "coreum.asset.nft.v1.QueryListingResponse")
+	proto.RegisterType((*QueryListingsByClassRequest)(nil), "coreum.asset.nft.v1.QueryListingsByClassRequest")
+	proto.RegisterType((*QueryListingsByClassResponse)(nil), "coreum.asset.nft.v1.QueryListingsByClassResponse")
+	proto.RegisterType((*QueryListingsBySellerRequest)(nil), "coreum.asset.nft.v1.QueryListingsBySellerRequest")
+	proto.RegisterType((*QueryListingsBySellerResponse)(nil), "coreum.asset.nft.v1.QueryListingsBySellerResponse")
+	proto.RegisterType((*QueryNFTsByOwnerRequest)(nil), "coreum.asset.nft.v1.QueryNFTsByOwnerRequest")
+	proto.RegisterType((*QueryNFTsByOwnerResponse)(nil), "coreum.asset.nft.v1.QueryNFTsByOwnerResponse")
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func init() { proto.RegisterFile("coreum/asset/nft/v1/query.proto", fileDescriptor_97b36b7d05006cb3) }
+
+var fileDescriptor_97b36b7d05006cb3 = []byte{
+	// 1328 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xce, 0x38, 0xf1, 0x8f, 0xbe, 0x48, 0xd0, 0x4e, 0xdc, 0xd6, 0xdd, 0x24, 0x6e, 0xd8, 0xfc,
+	0x0e, 0x64, 0x37, 0x71, 0x68, 0x48, 0xd3, 0xd2, 0x42, 0x2a, 0x52, 0x22, 0x55, 0x34, 0x35, 0x95,
+	0x90, 0x38, 0x50, 0x6d, 0xec, 0xb1, 0xbb, 0xaa, 0xb3, 0xeb, 0xee, 0xae, 0x13, 0x42, 0x14, 0xd4,
+	0x02, 0x17, 0x10, 0x48, 0x08, 0x6e, 0x40, 0x0f, 0x70, 0x81, 0x63, 0xc5, 0x95, 0x7f, 0xa0, 0x27,
+	0x54, 0x89, 0x0b, 0x12, 0x12, 0x42, 0x09, 0x7f, 0x08, 0xf2, 0xcc, 0x5b, 0x7b, 0xd7, 0x5e, 0xdb,
+	0xeb, 0x62, 0x22, 0x4e, 0xf1, 0xcc, 0xbc, 0xf7, 0xbe, 0xef, 0x7d, 0x33, 0x3b, 0xf3, 0xb5, 0x70,
+	0x3e, 0x67, 0x5a, 0xac, 0xb2, 0xad, 0x6a, 0xb6, 0xcd, 0x1c, 0xd5, 0x28, 0x38, 0xea, 0xce, 0xa2,
+	0x7a, 0xbf, 0xc2, 0xac, 0x3d, 0xa5, 0x6c, 0x99, 0x8e, 0x49, 0x87, 0x44, 0x80, 0xc2, 0x03, 0x14,
+	0xa3, 0xe0, 0x28, 0x3b, 0x8b, 0x52, 0xb2, 0x68, 0x16, 0x4d, 0xbe, 0xae, 0x56, 0x7f, 0x89, 0x50,
+	0x69, 0xa4, 0x68, 0x9a, 0xc5, 0x12, 0x53, 0xb5, 0xb2, 0xae, 0x6a, 0x86, 0x61, 0x3a, 0x9a, 0xa3,
+	0x9b, 0x86, 0x8d, 0xab, 0x93, 0x41, 0x48, 0xdb, 0x9a, 0x75, 0x8f, 0x39, 0xe5, 0x92, 0x96, 0x63,
+	0x18, 0x36, 0x1a, 0x14, 0x56, 0x85, 0x15, 0xcb, 0x63, 0x41, 0xcb, 0x65, 0xcd, 0xd2, 0xb6, 0x5d,
+	0x9c, 0xb9, 0x9c, 0x69, 0x6f, 0x9b, 0xb6, 0xba, 0xa5, 0xd9, 0x4c, 0x74, 0xa2, 0xee, 0x2c, 0x6e,
+	0x31, 0x47, 0xab, 0xc6, 0x15, 0x75, 0x83, 0x93, 0x12, 0xb1, 0x72, 0x12, 0xe8, 0xad, 0x6a, 0xc4,
+	0x26, 0x2f, 0x90, 0x65, 0xf7, 0x2b, 0xcc, 0x76, 0xe4, 0x4d, 0x18, 0xf2, 0xcd, 0xda, 0x65, 0xd3,
+	0xb0, 0x19, 0xbd, 0x08, 0x31, 0x01, 0x94, 0x22, 0x63, 0x64, 0x66, 0x30, 0x33, 0xac, 0x04, 0x48,
+	0xa3, 0x88, 0xa4, 0xb5, 0x81, 0x27, 0x7f, 0x9e, 0xef, 0xcb, 0x62, 0x82, 0x3c, 0x0e, 0xa7, 0x78,
+	0xc5, 0x6b, 0x25, 0xcd, 0x76, 0x61, 0xe8, 0x73, 0x10, 0xd1, 0xf3, 0xbc, 0xd6, 0x89, 0x6c, 0x44,
+	0xcf, 0xcb, 0x37, 0x80, 0x7a, 0x83, 0x10, 0x75, 0x19, 0xa2, 0xb9, 0xea, 0x04, 0x82, 0x4a, 0x81,
+	0xa0, 0x3c, 0x05, 0x31, 0x45, 0xb8, 0x5c, 0xc1, 0x26, 0xf8, 0x12, 0xab, 0x81, 0xae, 0x03, 0xd4,
+	0x55, 0xc0, 0x9a, 0x53, 0x8a, 0x90, 0x4c, 0xa9, 0x4a, 0xa6, 0x88, 0xcd, 0x47, 0xc9, 0x94, 0x4d,
+	0xad, 0xc8, 0x30, 0x37, 0xeb, 0xc9, 0xa4, 0x67, 0x20, 0xa6, 0xdb, 0x76, 0x85, 0x59, 0xa9, 0x08,
+	0x6f, 0x00, 0x47, 0xf2, 0xb7, 0x04, 0x92, 0x7e, 0x5c, 0xec, 0xe3, 0x7a, 0x00, 0xf0, 0x74, 0x47,
+	0x60, 0x91, 0xec, 0x43, 0x5e, 0x85, 0x78, 0x4e, 0xd4, 0x4e, 0x45, 0xc6, 0xfa, 0x43, 0x49, 0xe2,
+	0x26, 0xc8, 0x57, 0x51, 0xe2, 0x75, 0xcb, 0xfc, 0x80, 0x19, 0x2d, 0x36, 0x82, 0x9e, 0x83, 0x04,
+	0x4f, 0xb8, 0xa3, 0xe7, 0xb1, 0x3b, 0x51, 0x60, 0x23, 0x2f, 0xcf, 0xc3, 0x90, 0xaf, 0x00, 0x36,
+	0x77, 0x06, 0x62, 0x05, 0x3e, 0xc3, 0xab, 0x24, 0xb2, 0x38, 0x92, 0x5f, 0x86, 0xb3, 0x75, 0x31,
+	0xfc, 0xa0, 0x5e, 0x10, 0xe2, 0x07, 0xc9, 0x40, 0xaa, 0x39, 0xab, 0x03, 0xd2, 0x7b, 0x88, 0xf4,
+	0xce, 0x5d, 0xdd, 0x61, 0x25, 0xdd, 0x76, 0x58, 0xbe, 0xfb, 0xf6, 0x68, 0x0a, 0xe2, 0x5a, 0x2e,
+	0x67, 0x56, 0x0c, 0x27, 0xd5, 0x2f, 0x56, 0x70, 0x28, 0x5f, 0x86, 0x54, 0x73, 0x7d, 0xe4, 0x34,
+	0x06, 0x83, 0xbb, 0xf5, 0x69, 0x24, 0xe6, 0x9d, 0x92, 0xbf, 0x21, 0x30, 0xd9, 0x98, 0xfe, 0xba,
+	0xa8, 0x6c, 0xaf, 0x9b, 0xd6, 0x5b, 0xeb, 0xb7, 0x7b, 0x7d, 0x3e, 0x45, 0xd3, 0x91, 0xc0, 0xa6,
+	0xfb, 0xfd, 0x72, 0x7f, 0x41, 0x60, 0xaa, 0x13, 0xb9, 0x5e, 0x1f, 0x62, 0x09, 0x12, 0xa8, 0xac,
+	0x38, 0xc5, 0x27, 0xb2, 0xb5, 0xb1, 0xfc, 0x29, 0x81, 0x89, 0xfa, 0xfe, 0x07, 0x90, 0xea, 0xb5,
+	0x56, 0x6d, 0xce, 0xfb, 0xe7, 0xee, 0xc6, 0xb5, 0xe6, 0x72, 0x9c, 0xd2, 0xbc, 0x89, 0x97, 0xcb,
+	0x5a, 0xc5, 0x32, 0x1c, 0xcf, 0xa9, 0x69, 0xfd, 0x31, 0xd1, 0xd3, 0x10, 0x33, 0x0a, 0x4e, 0xbd,
+	0xb5, 0xa8, 0x51, 0x70, 0x36, 0xf2, 0xb2, 0x03, 0xa7, 0x1b, 0x2a, 0x61, 0x1f, 0x49, 0x88, 0x6e,
+	0x55, 0xe7, 0xf0, 0x18, 0x8b, 0x41, 0xf5, 0xc3, 0xb0, 0xd8, 0x8e, 0x79, 0x8f, 0x89, 0x32, 0x89,
+	0xac, 0x3b, 0xa4, 0x2f, 0xc2, 0xa9, 0xea, 0xcf, 0x1c, 0x27, 0x7f, 0xc7, 0x62, 0x9a, 0x6d, 0x1a,
+	0x78, 0xc2, 0x4e, 0xd6, 0x17, 0xb2, 0x7c, 0x5e, 0x7e, 0x48, 0x60, 0xc4, 0x07, 0x6b, 0x6f, 0x18,
+	0xbe, 0x37, 0xe1, 0x18, 0xb6, 0xf4, 0x21, 0x81, 0xd1, 0x16, 0x1c, 0x7a, 0xbd, 0x95, 0x67, 0x21,
+	0x2e, 0xb4, 0x77, 0x77, 0x32, 0xc6, 0xc5, 0xb7, 0xe5, 0x49, 0xbc, 0x46, 0x6f, 0xe8, 0xb6, 0xa3,
+	0x1b, 0xc5, 0xe6, 0x9b, 0x6a, 0x80, 0xbf, 0x88, 0xb7, 0x21, 0xe9, 0x0f, 0x43, 0x82, 0x97, 0x21,
+	0x5e, 0x12, 0x53, 0xc8, 0x6e, 0x24, 0xf0, 0x09, 0xc0, 0x34, 0xf7, 0x11, 0xc0, 0x14, 0xf9, 0x01,
+	0x81, 0x61, 0x6f, 0x59, 0x7b, 0x6d, 0xef, 0xb8, 0xf7, 0xe0, 0x47, 0xf7, 0x1c, 0x34, 0x51, 0xe8,
+	0xf5, 0x16, 0x5c, 0x81, 0x04, 0xf6, 0xed, 0x3e, 0x97, 0x61, 0xb4, 0xaa, 0xe5, 0xc8, 0x1f, 0x36,
+	0x11, 0x7d, 0x9b, 0x95, 0x4a, 0xcc, 0xfa, 0x0f, 0xfc, 0x84, 0xcd, 0x0b, 0xbb, 0x7e, 0x42, 0x8c,
+	0xe4, 0x9f, 0xdc, 0xd3, 0xda, 0x4c, 0xe0, 0xff, 0x26, 0xd5, 0x57, 0x04, 0xdf, 0xe0, 0xea, 0x37,
+	0xb5, 0xb6, 0x77, 0x73, 0xd7, 0xe8, 0xbd, 0x4c, 0x49, 0x88, 0x9a, 0xbb, 0x46, 0x4d, 0x25, 0x31,
+	0x68, 0xf7, 0xb8, 0x3d, 0x22, 0x90, 0x6a, 0x26, 0xd5, 0x6b, 0xe9, 0x56, 0x60, 0xc0, 0x28, 0x38,
+	0xae, 0x6c, 0xe9, 0x40, 0xd9, 0xf8, 0x25, 0x9b, 0x33, 0xad, 0x3c, 0x0a, 0xc7, 0x33, 0x32, 0x3f,
+	0x53, 0x88, 0x72, 0x7e, 0xf4, 0x01, 0x81, 0x98, 0x30, 0xcf, 0x74, 0x3a, 0xb0, 0x40, 0xb3, 0x53,
+	0x97, 0x66, 0x3a, 0x07, 0x0a, 0xb6, 0xf2, 0xf8, 0x47, 0xbf, 0xfd, 0xfd, 0x75, 0x64, 0x94, 0x0e,
+	0xab, 0xad, 0xff, 0x01, 0x41, 0x3f, 0x26, 0x10, 0xe5, 0xdf, 0x21, 0x9d, 0x6a, 0x5d, 0xd8, 0x7b,
+	0x57, 0x48, 0xd3, 0x1d, 0xe3, 0x10, 0x7f, 0x96, 0xe3, 0x8f, 0xd3, 0x17, 0x02, 0xf1, 0xd1, 0x9f,
+	0xaa, 0xfb, 0x7a, 0xfe, 0x80, 0x7e, 0x42, 0x20, 0x8e, 0xee, 0x99, 0xce, 0x74, 0xa8, 0x5f, 0x33,
+	0xf6, 0xd2, 0x6c, 0x88, 0x48, 0xe4, 0x32, 0xc1, 0xb9, 0xa4, 0xe9, 0x48, 0x3b, 0x2e, 0xf4, 0x11,
+	0x81, 0x98, 0x30, 0x9f, 0xed, 0xf6, 0xc3, 0x67, 0x6a, 0xa5, 0x99, 0xce, 0x81, 0xc8, 0xe1, 0x35,
+	0xce, 0x61, 0x95, 0xae, 0xb4, 0xd7, 0xc3, 0x3d, 0xd6, 0x07, 0xd5, 0x15, 0xa1, 0x8f, 0x2a, 0x1c,
+	0x2f, 0xfd, 0x81, 0xc0, 0xa0, 0xc7, 0x21, 0xd3, 0x97, 0x3a, 0x08, 0xe0, 0x67, 0x3a, 0x1f, 0x32,
+	0x1a, 0xe9, 0x2e, 0x73, 0xba, 0x0b, 0x54, 0x09, 0x4b, 0x17, 0x49, 0xfe, 0x42, 0x60, 0xd0, 0xe3,
+	0x9a, 0xda, 0x91, 0x6c, 0x76, 0xee, 0xd2, 0x7c, 0xc8, 0x68, 0x24, 0x79, 0x93, 0x93, 0xdc, 0xa0,
+	0xd7, 0xbb, 0xd7, 0xd4, 0x63, 0xd6, 0xd5, 0x7d, 0xb4, 0x5b, 0x07, 0xf4, 0x0f, 0x02, 0xe7, 0x5a,
+	0x9a, 0x62, 0xba, 0x1a, 0x8a, 0x5d, 0xa0, 0xcd, 0x97, 0x2e, 0x3d, 0x53, 0x2e, 0xf6, 0xf9, 0x06,
+	0xef, 0xf3, 0x2a, 0x7d, 0xf5, 0x5f, 0xf5, 0x49, 0x7f, 0x25, 0x90, 0x6a, 0x65, 0x6b, 0xe9, 0xc5,
+	0x0e, 0xe7, 0xa3, 0xb5, 0x2d, 0x97, 0x56, 0x9f, 0x25, 0x15, 0x5b, 0xbb, 0xc4, 0x5b, 0xbb, 0x40,
+	0x97, 0xc2, 0xb6, 0xe6, 0x6d, 0xe8, 0x7b, 0x02, 0x09, 0xd7, 0xd4, 0xd1, 0x36, 0xf7, 0x41, 0x83,
+	0x7b, 0x96, 0xe6, 0xc2, 0x84, 0x22, 0xc1, 0x2b, 0x9c, 0xe0, 0x0a, 0x5d, 0x0e, 0x4b, 0x90, 0xfb,
+	0x67, 0x75, 0x5f, 0xf8, 0xc0, 0x03, 0xfa, 0x98, 0xc0, 0xc9, 0x46, 0xe3, 0x49, 0x17, 0x3b, 0x13,
+	0x68, 0x30, 0xca, 0x52, 0xa6, 0x9b, 0x14, 0xe4, 0x7e, 0x81, 0x73, 0x57, 0xe9, 0x7c, 0x57, 0xdc,
+	0xe9, 0x67, 0x04, 0xe2, 0xf8, 0xe6, 0xb7, 0xbb, 0x8f, 0xfd, 0x5e, 0x56, 0x9a, 0x0d, 0x11, 0x89,
+	0xbc, 0xe6, 0x38, 0xaf, 0x09, 0x2a, 0x07, 0xf2, 0x72, 0xfd, 0x85, 0x78, 0x1c, 0x1e, 0x13, 0x78,
+	0xbe, 0xc1, 0x34, 0xd2, 0x85, 0x8e, 0x50, 0x0d, 0x16, 0x57, 0x5a, 0xec, 0x22, 0x03, 0x49, 0xae,
+	0x70, 0x92, 0x19, 0xba, 0x10, 0x56, 0x3c, 0x97, 0x37, 0xdf, 0xf2, 0x46, 0xf7, 0x46, 0x43, 0x31,
+	0xf0, 0x59, 0x4d, 0x29, 0xd3, 0x4d, 0x4a, 0xa8, 0x2d, 0xaf, 0x49, 0x2b, 0xcc, 0xa6, 0xba, 0x2f,
+	0xfe, 0x1e, 0xd0, 0xef, 0x08, 0x0c, 0x7a, 0x0c, 0x53, 0xbb, 0x6b, 0xbb, 0xd9, 0xec, 0x49, 0xf3,
+	0x21, 0xa3, 0x91, 0xe3, 0x02, 0xe7, 0x38, 0x47, 0x67, 0x02, 0x39, 0x72, 0x87, 0x67, 0xab, 0xfb,
+	0xfc, 0xaf, 0xb8, 0xca, 0xd6, 0x6e, 0x3d, 0x39, 0x4c, 0x93, 0xa7, 0x87, 0x69, 0xf2, 0xd7, 0x61,
+	0x9a, 0x7c, 0x79, 0x94, 0xee, 0x7b, 0x7a, 0x94, 0xee, 0xfb, 0xfd, 0x28, 0xdd, 0xf7, 0xee, 0x2b,
+	0x45, 0xdd, 0xb9, 0x5b, 0xd9, 0x52, 0x72, 0xe6, 0xb6, 0x7a, 0x8d, 0x57, 0x5b, 0x37, 0x2b, 0x46,
+	0x9e, 0xbb, 0x34, 0xb7, 0xfc, 0xce, 0x92, 0xfa, 0xbe, 0x07, 0xc3, 0xd9, 0x2b, 0x33, 0x7b, 0x2b,
+	0xc6, 0xff, 0x43, 0x74, 0xe9, 0x9f, 0x01, 0x00, 0xd2, 0x8f, 0x56, 0x06, 0x10, 0x16, 0x00, 0x00,
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params queries the parameters of x/asset/nft module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Class queries the non-fungible token class of the module.
+	Class(ctx context.Context, in *QueryClassRequest, opts ...grpc.CallOption) (*QueryClassResponse, error)
+	// Classes queries the non-fungible token classes of the module.
+	Classes(ctx context.Context, in *QueryClassesRequest, opts ...grpc.CallOption) (*QueryClassesResponse, error)
+	// Frozen queries to check if an NFT is frozen or not.
+	Frozen(ctx context.Context, in *QueryFrozenRequest, opts ...grpc.CallOption) (*QueryFrozenResponse, error)
+	// ClassFrozen queries to check if the whole class is frozen or not.
+	ClassFrozen(ctx context.Context, in *QueryClassFrozenRequest, opts ...grpc.CallOption) (*QueryClassFrozenResponse, error)
+	// Whitelisted queries to check if an account is whitelited to hold an NFT or not.
+	Whitelisted(ctx context.Context, in *QueryWhitelistedRequest, opts ...grpc.CallOption) (*QueryWhitelistedResponse, error)
+	// WhitelistedAccountsForNFT returns the list of accounts which are whitelisted to hold this NFT.
+	WhitelistedAccountsForNFT(ctx context.Context, in *QueryWhitelistedAccountsForNFTRequest, opts ...grpc.CallOption) (*QueryWhitelistedAccountsForNFTResponse, error)
+	// ClassWhitelistedAccounts returns the list of accounts which are whitelisted to hold NFTs in this class.
+	ClassWhitelistedAccounts(ctx context.Context, in *QueryClassWhitelistedAccountsRequest, opts ...grpc.CallOption) (*QueryClassWhitelistedAccountsResponse, error)
+	// BurntNFTsInClass checks if an nft if is in burnt NFTs list.
+	BurntNFT(ctx context.Context, in *QueryBurntNFTRequest, opts ...grpc.CallOption) (*QueryBurntNFTResponse, error)
+	// BurntNFTsInClass returns the list of burnt nfts in a class.
+	BurntNFTsInClass(ctx context.Context, in *QueryBurntNFTsInClassRequest, opts ...grpc.CallOption) (*QueryBurntNFTsInClassResponse, error)
+	// Listing queries the marketplace listing.
+	Listing(ctx context.Context, in *QueryListingRequest, opts ...grpc.CallOption) (*QueryListingResponse, error)
+	// ListingsByClass returns the active marketplace listings of the class.
+	ListingsByClass(ctx context.Context, in *QueryListingsByClassRequest, opts ...grpc.CallOption) (*QueryListingsByClassResponse, error)
+	// ListingsBySeller returns the active marketplace listings of the seller.
+	ListingsBySeller(ctx context.Context, in *QueryListingsBySellerRequest, opts ...grpc.CallOption) (*QueryListingsBySellerResponse, error)
+	// NFTsByOwner queries the non-fungible tokens owned by the account together with their state.
+	NFTsByOwner(ctx context.Context, in *QueryNFTsByOwnerRequest, opts ...grpc.CallOption) (*QueryNFTsByOwnerResponse, error)
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type queryClient struct {
+	cc grpc1.ClientConn
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/coreum.asset.nft.v1.Query/Params", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Class(ctx context.Context, in *QueryClassRequest, opts ...grpc.CallOption) (*QueryClassResponse, error) {
+	out := new(QueryClassResponse)
+	err := c.cc.Invoke(ctx, "/coreum.asset.nft.v1.Query/Class", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) Classes(ctx context.Context, in *QueryClassesRequest, opts ...grpc.CallOption) (*QueryClassesResponse, error) {
+	out := new(QueryClassesResponse)
+	err := c.cc.Invoke(ctx, "/coreum.asset.nft.v1.Query/Classes", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryClassRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Frozen(ctx context.Context, in *QueryFrozenRequest, opts ...grpc.CallOption) (*QueryFrozenResponse, error) {
+	out := new(QueryFrozenResponse)
+	err := c.cc.Invoke(ctx, "/coreum.asset.nft.v1.Query/Frozen", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryClassRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ClassFrozen(ctx context.Context, in *QueryClassFrozenRequest, opts ...grpc.CallOption) (*QueryClassFrozenResponse, error) {
+	out := new(QueryClassFrozenResponse)
+	err := c.cc.Invoke(ctx, "/coreum.asset.nft.v1.Query/ClassFrozen", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryClassRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) Whitelisted(ctx context.Context, in *QueryWhitelistedRequest, opts ...grpc.CallOption) (*QueryWhitelistedResponse, error) {
+	out := new(QueryWhitelistedResponse)
+	err := c.cc.Invoke(ctx, "/coreum.asset.nft.v1.Query/Whitelisted", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryClassResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) WhitelistedAccountsForNFT(ctx context.Context, in *QueryWhitelistedAccountsForNFTRequest, opts ...grpc.CallOption) (*QueryWhitelistedAccountsForNFTResponse, error) {
+	out := new(QueryWhitelistedAccountsForNFTResponse)
+	err := c.cc.Invoke(ctx, "/coreum.asset.nft.v1.Query/WhitelistedAccountsForNFT", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryClassResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ClassWhitelistedAccounts(ctx context.Context, in *QueryClassWhitelistedAccountsRequest, opts ...grpc.CallOption) (*QueryClassWhitelistedAccountsResponse, error) {
+	out := new(QueryClassWhitelistedAccountsResponse)
+	err := c.cc.Invoke(ctx, "/coreum.asset.nft.v1.Query/ClassWhitelistedAccounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryClassResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Class.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) BurntNFT(ctx context.Context, in *QueryBurntNFTRequest, opts ...grpc.CallOption) (*QueryBurntNFTResponse, error) {
+	out := new(QueryBurntNFTResponse)
+	err := c.cc.Invoke(ctx, "/coreum.asset.nft.v1.Query/BurntNFT", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryClassesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) BurntNFTsInClass(ctx context.Context, in *QueryBurntNFTsInClassRequest, opts ...grpc.CallOption) (*QueryBurntNFTsInClassResponse, error) {
+	out := new(QueryBurntNFTsInClassResponse)
+	err := c.cc.Invoke(ctx, "/coreum.asset.nft.v1.Query/BurntNFTsInClass", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryClassesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryClassesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Issuer) > 0 {
-		i -= len(m.Issuer)
-		copy(dAtA[i:], m.Issuer)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Issuer)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) Listing(ctx context.Context, in *QueryListingRequest, opts ...grpc.CallOption) (*QueryListingResponse, error) {
+	out := new(QueryListingResponse)
+	err := c.cc.Invoke(ctx, "/coreum.asset.nft.v1.Query/Listing", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryClassesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ListingsByClass(ctx context.Context, in *QueryListingsByClassRequest, opts ...grpc.CallOption) (*QueryListingsByClassResponse, error) {
+	out := new(QueryListingsByClassResponse)
+	err := c.cc.Invoke(ctx, "/coreum.asset.nft.v1.Query/ListingsByClass", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryClassesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryClassesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Classes) > 0 {
-		for iNdEx := len(m.Classes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Classes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) ListingsBySeller(ctx context.Context, in *QueryListingsBySellerRequest, opts ...grpc.CallOption) (*QueryListingsBySellerResponse, error) {
+	out := new(QueryListingsBySellerResponse)
+	err := c.cc.Invoke(ctx, "/coreum.asset.nft.v1.Query/ListingsBySeller", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryFrozenRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) NFTsByOwner(ctx context.Context, in *QueryNFTsByOwnerRequest, opts ...grpc.CallOption) (*QueryNFTsByOwnerResponse, error) {
+	out := new(QueryNFTsByOwnerResponse)
+	err := c.cc.Invoke(ctx, "/coreum.asset.nft.v1.Query/NFTsByOwner", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryFrozenRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params queries the parameters of x/asset/nft module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Class queries the non-fungible token class of the module.
+	Class(context.Context, *QueryClassRequest) (*QueryClassResponse, error)
+	// Classes queries the non-fungible token classes of the module.
+	Classes(context.Context, *QueryClassesRequest) (*QueryClassesResponse, error)
+	// Frozen queries to check if an NFT is frozen or not.
+	Frozen(context.Context, *QueryFrozenRequest) (*QueryFrozenResponse, error)
+	// ClassFrozen queries to check if the whole class is frozen or not.
+	ClassFrozen(context.Context, *QueryClassFrozenRequest) (*QueryClassFrozenResponse, error)
+	// Whitelisted queries to check if an account is whitelited to hold an NFT or not.
+	Whitelisted(context.Context, *QueryWhitelistedRequest) (*QueryWhitelistedResponse, error)
+	// WhitelistedAccountsForNFT returns the list of accounts which are whitelisted to hold this NFT.
+	WhitelistedAccountsForNFT(context.Context, *QueryWhitelistedAccountsForNFTRequest) (*QueryWhitelistedAccountsForNFTResponse, error)
+	// ClassWhitelistedAccounts returns the list of accounts which are whitelisted to hold NFTs in this class.
+	ClassWhitelistedAccounts(context.Context, *QueryClassWhitelistedAccountsRequest) (*QueryClassWhitelistedAccountsResponse, error)
+	// BurntNFTsInClass checks if an nft if is in burnt NFTs list.
+	BurntNFT(context.Context, *QueryBurntNFTRequest) (*QueryBurntNFTResponse, error)
+	// BurntNFTsInClass returns the list of burnt nfts in a class.
+	BurntNFTsInClass(context.Context, *QueryBurntNFTsInClassRequest) (*QueryBurntNFTsInClassResponse, error)
+	// Listing queries the marketplace listing.
+	Listing(context.Context, *QueryListingRequest) (*QueryListingResponse, error)
+	// ListingsByClass returns the active marketplace listings of the class.
+	ListingsByClass(context.Context, *QueryListingsByClassRequest) (*QueryListingsByClassResponse, error)
+	// ListingsBySeller returns the active marketplace listings of the seller.
+	ListingsBySeller(context.Context, *QueryListingsBySellerRequest) (*QueryListingsBySellerResponse, error)
+	// NFTsByOwner queries the non-fungible tokens owned by the account together with their state.
+	NFTsByOwner(context.Context, *QueryNFTsByOwnerRequest) (*QueryNFTsByOwnerResponse, error)
 }
 
-func (m *QueryFrozenRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ClassId) > 0 {
-		i -= len(m.ClassId)
-		copy(dAtA[i:], m.ClassId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClassId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *QueryFrozenResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
-
-func (m *QueryFrozenResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) Class(ctx context.Context, req *QueryClassRequest) (*QueryClassResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Class not implemented")
+}
+func (*UnimplementedQueryServer) Classes(ctx context.Context, req *QueryClassesRequest) (*QueryClassesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Classes not implemented")
+}
+func (*UnimplementedQueryServer) Frozen(ctx context.Context, req *QueryFrozenRequest) (*QueryFrozenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Frozen not implemented")
+}
+func (*UnimplementedQueryServer) ClassFrozen(ctx context.Context, req *QueryClassFrozenRequest) (*QueryClassFrozenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClassFrozen not implemented")
+}
+func (*UnimplementedQueryServer) Whitelisted(ctx context.Context, req *QueryWhitelistedRequest) (*QueryWhitelistedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Whitelisted not implemented")
+}
+func (*UnimplementedQueryServer) WhitelistedAccountsForNFT(ctx context.Context, req *QueryWhitelistedAccountsForNFTRequest) (*QueryWhitelistedAccountsForNFTResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WhitelistedAccountsForNFT not implemented")
+}
+func (*UnimplementedQueryServer) ClassWhitelistedAccounts(ctx context.Context, req *QueryClassWhitelistedAccountsRequest) (*QueryClassWhitelistedAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClassWhitelistedAccounts not implemented")
+}
+func (*UnimplementedQueryServer) BurntNFT(ctx context.Context, req *QueryBurntNFTRequest) (*QueryBurntNFTResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BurntNFT not implemented")
+}
+func (*UnimplementedQueryServer) BurntNFTsInClass(ctx context.Context, req *QueryBurntNFTsInClassRequest) (*QueryBurntNFTsInClassResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BurntNFTsInClass not implemented")
+}
+func (*UnimplementedQueryServer) Listing(ctx context.Context, req *QueryListingRequest) (*QueryListingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Listing not implemented")
+}
+func (*UnimplementedQueryServer) ListingsByClass(ctx context.Context, req *QueryListingsByClassRequest) (*QueryListingsByClassResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListingsByClass not implemented")
+}
+func (*UnimplementedQueryServer) ListingsBySeller(ctx context.Context, req *QueryListingsBySellerRequest) (*QueryListingsBySellerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListingsBySeller not implemented")
+}
+func (*UnimplementedQueryServer) NFTsByOwner(ctx context.Context, req *QueryNFTsByOwnerRequest) (*QueryNFTsByOwnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NFTsByOwner not implemented")
 }
 
-func (m *QueryFrozenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Frozen {
-		i--
-		if m.Frozen {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
-func (m *QueryWhitelistedRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.asset.nft.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryWhitelistedRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_Class_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClassRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Class(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.asset.nft.v1.Query/Class",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Class(ctx, req.(*QueryClassRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryWhitelistedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Account) > 0 {
-		i -= len(m.Account)
-		copy(dAtA[i:], m.Account)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
-		i--
-		dAtA[i] = 0x1a
+func _Query_Classes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClassesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.ClassId) > 0 {
-		i -= len(m.ClassId)
-		copy(dAtA[i:], m.ClassId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClassId)))
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(QueryServer).Classes(ctx, in)
 	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.asset.nft.v1.Query/Classes",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Classes(ctx, req.(*QueryClassesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryWhitelistedResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_Frozen_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFrozenRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryWhitelistedResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(QueryServer).Frozen(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.asset.nft.v1.Query/Frozen",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Frozen(ctx, req.(*QueryFrozenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryWhitelistedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Whitelisted {
-		i--
-		if m.Whitelisted {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
+func _Query_ClassFrozen_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClassFrozenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).ClassFrozen(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.asset.nft.v1.Query/ClassFrozen",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClassFrozen(ctx, req.(*QueryClassFrozenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryWhitelistedAccountsForNFTRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_Whitelisted_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWhitelistedRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).Whitelisted(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.asset.nft.v1.Query/Whitelisted",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Whitelisted(ctx, req.(*QueryWhitelistedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryWhitelistedAccountsForNFTRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_WhitelistedAccountsForNFT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWhitelistedAccountsForNFTRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).WhitelistedAccountsForNFT(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.asset.nft.v1.Query/WhitelistedAccountsForNFT",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).WhitelistedAccountsForNFT(ctx, req.(*QueryWhitelistedAccountsForNFTRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryWhitelistedAccountsForNFTRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ClassId) > 0 {
-		i -= len(m.ClassId)
-		copy(dAtA[i:], m.ClassId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClassId)))
-		i--
-		dAtA[i] = 0x1a
+func _Query_ClassWhitelistedAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClassWhitelistedAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(QueryServer).ClassWhitelistedAccounts(ctx, in)
 	}
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.asset.nft.v1.Query/ClassWhitelistedAccounts",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClassWhitelistedAccounts(ctx, req.(*QueryClassWhitelistedAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryWhitelistedAccountsForNFTResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_BurntNFT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBurntNFTRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).BurntNFT(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.asset.nft.v1.Query/BurntNFT",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BurntNFT(ctx, req.(*QueryBurntNFTRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryWhitelistedAccountsForNFTResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_BurntNFTsInClass_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBurntNFTsInClassRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BurntNFTsInClass(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.asset.nft.v1.Query/BurntNFTsInClass",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BurntNFTsInClass(ctx, req.(*QueryBurntNFTsInClassRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryWhitelistedAccountsForNFTResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Accounts) > 0 {
-		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Accounts[iNdEx])
-			copy(dAtA[i:], m.Accounts[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.Accounts[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
+func _Query_Listing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(QueryServer).Listing(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.asset.nft.v1.Query/Listing",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Listing(ctx, req.(*QueryListingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryClassWhitelistedAccountsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_ListingsByClass_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListingsByClassRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).ListingsByClass(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.asset.nft.v1.Query/ListingsByClass",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListingsByClass(ctx, req.(*QueryListingsByClassRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryClassWhitelistedAccountsRequest) MarshalTo(dAtA []byte) (int, error) {
+func _Query_ListingsBySeller_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListingsBySellerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListingsBySeller(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.asset.nft.v1.Query/ListingsBySeller",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListingsBySeller(ctx, req.(*QueryListingsBySellerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_NFTsByOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNFTsByOwnerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NFTsByOwner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coreum.asset.nft.v1.Query/NFTsByOwner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NFTsByOwner(ctx, req.(*QueryNFTsByOwnerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "coreum.asset.nft.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "Class",
+			Handler:    _Query_Class_Handler,
+		},
+		{
+			MethodName: "Classes",
+			Handler:    _Query_Classes_Handler,
+		},
+		{
+			MethodName: "Frozen",
+			Handler:    _Query_Frozen_Handler,
+		},
+		{
+			MethodName: "ClassFrozen",
+			Handler:    _Query_ClassFrozen_Handler,
+		},
+		{
+			MethodName: "Whitelisted",
+			Handler:    _Query_Whitelisted_Handler,
+		},
+		{
+			MethodName: "WhitelistedAccountsForNFT",
+			Handler:    _Query_WhitelistedAccountsForNFT_Handler,
+		},
+		{
+			MethodName: "ClassWhitelistedAccounts",
+			Handler:    _Query_ClassWhitelistedAccounts_Handler,
+		},
+		{
+			MethodName: "BurntNFT",
+			Handler:    _Query_BurntNFT_Handler,
+		},
+		{
+			MethodName: "BurntNFTsInClass",
+			Handler:    _Query_BurntNFTsInClass_Handler,
+		},
+		{
+			MethodName: "Listing",
+			Handler:    _Query_Listing_Handler,
+		},
+		{
+			MethodName: "ListingsByClass",
+			Handler:    _Query_ListingsByClass_Handler,
+		},
+		{
+			MethodName: "ListingsBySeller",
+			Handler:    _Query_ListingsBySeller_Handler,
+		},
+		{
+			MethodName: "NFTsByOwner",
+			Handler:    _Query_NFTsByOwner_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "coreum/asset/nft/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryClassWhitelistedAccountsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ClassId) > 0 {
-		i -= len(m.ClassId)
-		copy(dAtA[i:], m.ClassId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClassId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryClassWhitelistedAccountsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1908,41 +2176,30 @@ func (m *QueryClassWhitelistedAccountsResponse) Marshal() (dAtA []byte, err erro
 	return dAtA[:n], nil
 }
 
-func (m *QueryClassWhitelistedAccountsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryClassWhitelistedAccountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Accounts) > 0 {
-		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Accounts[iNdEx])
-			copy(dAtA[i:], m.Accounts[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.Accounts[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0xa
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryBurntNFTRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryClassRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1952,34 +2209,27 @@ func (m *QueryBurntNFTRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryBurntNFTRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryClassRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryBurntNFTRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryClassRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.NftId) > 0 {
-		i -= len(m.NftId)
-		copy(dAtA[i:], m.NftId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.NftId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ClassId) > 0 {
-		i -= len(m.ClassId)
-		copy(dAtA[i:], m.ClassId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClassId)))
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryBurntNFTResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryClassResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1989,30 +2239,30 @@ func (m *QueryBurntNFTResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryBurntNFTResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryClassResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryBurntNFTResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryClassResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Burnt {
-		i--
-		if m.Burnt {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	{
+		size, err := m.Class.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0x8
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryBurntNFTsInClassRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryClassesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2022,20 +2272,20 @@ func (m *QueryBurntNFTsInClassRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryBurntNFTsInClassRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryClassesRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryBurntNFTsInClassRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryClassesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ClassId) > 0 {
-		i -= len(m.ClassId)
-		copy(dAtA[i:], m.ClassId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClassId)))
+	if len(m.Issuer) > 0 {
+		i -= len(m.Issuer)
+		copy(dAtA[i:], m.Issuer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Issuer)))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -2054,7 +2304,7 @@ func (m *QueryBurntNFTsInClassRequest) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryBurntNFTsInClassResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryClassesResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2064,21 +2314,26 @@ func (m *QueryBurntNFTsInClassResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryBurntNFTsInClassResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryClassesResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryBurntNFTsInClassResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryClassesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.NftIds) > 0 {
-		for iNdEx := len(m.NftIds) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.NftIds[iNdEx])
-			copy(dAtA[i:], m.NftIds[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.NftIds[iNdEx])))
+	if len(m.Classes) > 0 {
+		for iNdEx := len(m.Classes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Classes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
 			i--
 			dAtA[i] = 0x12
 		}
@@ -2098,307 +2353,2179 @@ func (m *QueryBurntNFTsInClassResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryFrozenRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *QueryFrozenRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryFrozenRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func (m *QueryClassRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryClassResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryFrozenResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Class.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryClassesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Issuer)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryFrozenResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryClassesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryFrozenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if len(m.Classes) > 0 {
-		for _, e := range m.Classes {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Frozen {
+		i--
+		if m.Frozen {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryFrozenRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryClassFrozenRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClassFrozenRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClassFrozenRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.ClassId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryFrozenResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryClassFrozenResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClassFrozenResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClassFrozenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.Frozen {
-		n += 2
+		i--
+		if m.Frozen {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryWhitelistedRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryWhitelistedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWhitelistedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWhitelistedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.ClassId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Account)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryWhitelistedResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryWhitelistedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWhitelistedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWhitelistedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.Whitelisted {
-		n += 2
+		i--
+		if m.Whitelisted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryWhitelistedAccountsForNFTRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.ClassId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryWhitelistedAccountsForNFTRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryWhitelistedAccountsForNFTResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryWhitelistedAccountsForNFTRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWhitelistedAccountsForNFTRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.Accounts) > 0 {
-		for _, s := range m.Accounts {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryClassWhitelistedAccountsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryWhitelistedAccountsForNFTResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWhitelistedAccountsForNFTResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWhitelistedAccountsForNFTResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Accounts) > 0 {
+		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Accounts[iNdEx])
+			copy(dAtA[i:], m.Accounts[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Accounts[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	l = len(m.ClassId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryClassWhitelistedAccountsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryClassWhitelistedAccountsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClassWhitelistedAccountsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClassWhitelistedAccountsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.Accounts) > 0 {
-		for _, s := range m.Accounts {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryBurntNFTRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryClassWhitelistedAccountsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClassWhitelistedAccountsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClassWhitelistedAccountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ClassId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Accounts) > 0 {
+		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Accounts[iNdEx])
+			copy(dAtA[i:], m.Accounts[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Accounts[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	l = len(m.NftId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryBurntNFTResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Burnt {
-		n += 2
+func (m *QueryBurntNFTRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryBurntNFTsInClassRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryBurntNFTRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBurntNFTRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.NftId) > 0 {
+		i -= len(m.NftId)
+		copy(dAtA[i:], m.NftId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.NftId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.ClassId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryBurntNFTsInClassResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if len(m.NftIds) > 0 {
-		for _, s := range m.NftIds {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
-		}
+func (m *QueryBurntNFTResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryBurntNFTResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBurntNFTResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RevocationReason) > 0 {
+		i -= len(m.RevocationReason)
+		copy(dAtA[i:], m.RevocationReason)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RevocationReason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Revoked {
+		i--
+		if m.Revoked {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Burnt {
+		i--
+		if m.Burnt {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBurntNFTsInClassRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBurntNFTsInClassRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBurntNFTsInClassRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBurntNFTsInClassResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBurntNFTsInClassResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBurntNFTsInClassResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NftIds) > 0 {
+		for iNdEx := len(m.NftIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.NftIds[iNdEx])
+			copy(dAtA[i:], m.NftIds[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.NftIds[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListingRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListingRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Listing.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListingsByClassRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListingsByClassRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListingsByClassRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListingsByClassResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListingsByClassResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListingsByClassResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Listings) > 0 {
+		for iNdEx := len(m.Listings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Listings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListingsBySellerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListingsBySellerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListingsBySellerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Seller) > 0 {
+		i -= len(m.Seller)
+		copy(dAtA[i:], m.Seller)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Seller)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListingsBySellerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListingsBySellerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListingsBySellerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Listings) > 0 {
+		for iNdEx := len(m.Listings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Listings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNFTsByOwnerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNFTsByOwnerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNFTsByOwnerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNFTsByOwnerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNFTsByOwnerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNFTsByOwnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Nfts) > 0 {
+		for iNdEx := len(m.Nfts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Nfts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryClassRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryClassResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Class.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryClassesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Issuer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryClassesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Classes) > 0 {
+		for _, e := range m.Classes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryFrozenRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFrozenResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Frozen {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryClassFrozenRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryClassFrozenResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Frozen {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryWhitelistedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryWhitelistedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Whitelisted {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryWhitelistedAccountsForNFTRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryWhitelistedAccountsForNFTResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Accounts) > 0 {
+		for _, s := range m.Accounts {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryClassWhitelistedAccountsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryClassWhitelistedAccountsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Accounts) > 0 {
+		for _, s := range m.Accounts {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryBurntNFTRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.NftId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBurntNFTResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Burnt {
+		n += 2
+	}
+	if m.Revoked {
+		n += 2
+	}
+	l = len(m.RevocationReason)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBurntNFTsInClassRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBurntNFTsInClassResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.NftIds) > 0 {
+		for _, s := range m.NftIds {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryListingRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryListingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Listing.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryListingsByClassRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListingsByClassResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Listings) > 0 {
+		for _, e := range m.Listings {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryListingsBySellerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Seller)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListingsBySellerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Listings) > 0 {
+		for _, e := range m.Listings {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryNFTsByOwnerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNFTsByOwnerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Nfts) > 0 {
+		for _, e := range m.Nfts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
 }
 func sozQuery(x uint64) (n int) {
 	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClassRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClassRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClassRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClassResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClassResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClassResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Class", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Class.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClassesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClassesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClassesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Issuer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClassesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClassesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClassesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Classes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Classes = append(m.Classes, Class{})
+			if err := m.Classes[len(m.Classes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFrozenRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFrozenRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFrozenRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFrozenResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFrozenResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFrozenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Frozen", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Frozen = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClassFrozenRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClassFrozenRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClassFrozenRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClassFrozenResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2421,12 +4548,32 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryClassFrozenResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryClassFrozenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Frozen", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Frozen = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2448,7 +4595,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryWhitelistedRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2471,17 +4618,17 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryWhitelistedRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryWhitelistedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2491,24 +4638,87 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2531,7 +4741,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryClassRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryWhitelistedResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2554,13 +4764,119 @@ func (m *QueryClassRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryClassRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryWhitelistedResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryClassRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryWhitelistedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Whitelisted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Whitelisted = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryWhitelistedAccountsForNFTRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWhitelistedAccountsForNFTRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWhitelistedAccountsForNFTRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
@@ -2592,6 +4908,38 @@ func (m *QueryClassRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2613,7 +4961,7 @@ func (m *QueryClassRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryClassResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryWhitelistedAccountsForNFTResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2636,15 +4984,15 @@ func (m *QueryClassResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryClassResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryWhitelistedAccountsForNFTResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryClassResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryWhitelistedAccountsForNFTResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Class", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2671,10 +5019,45 @@ func (m *QueryClassResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Class.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Accounts = append(m.Accounts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2696,7 +5079,7 @@ func (m *QueryClassResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryClassesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryClassWhitelistedAccountsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2719,10 +5102,10 @@ func (m *QueryClassesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryClassesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryClassWhitelistedAccountsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryClassesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryClassWhitelistedAccountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2763,7 +5146,7 @@ func (m *QueryClassesRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2791,7 +5174,7 @@ func (m *QueryClassesRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Issuer = string(dAtA[iNdEx:postIndex])
+			m.ClassId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2814,7 +5197,7 @@ func (m *QueryClassesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryClassesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryClassWhitelistedAccountsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2837,10 +5220,10 @@ func (m *QueryClassesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryClassesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryClassWhitelistedAccountsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryClassesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryClassWhitelistedAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2881,9 +5264,9 @@ func (m *QueryClassesResponse) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Classes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2893,25 +5276,23 @@ func (m *QueryClassesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Classes = append(m.Classes, Class{})
-			if err := m.Classes[len(m.Classes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Accounts = append(m.Accounts, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2934,7 +5315,7 @@ func (m *QueryClassesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFrozenRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBurntNFTRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2957,15 +5338,15 @@ func (m *QueryFrozenRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFrozenRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBurntNFTRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFrozenRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBurntNFTRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2993,11 +5374,11 @@ func (m *QueryFrozenRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.ClassId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NftId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3025,7 +5406,7 @@ func (m *QueryFrozenRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClassId = string(dAtA[iNdEx:postIndex])
+			m.NftId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3048,7 +5429,7 @@ func (m *QueryFrozenRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFrozenResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBurntNFTResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3071,15 +5452,15 @@ func (m *QueryFrozenResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFrozenResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBurntNFTResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFrozenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBurntNFTResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Frozen", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Burnt", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -3096,7 +5477,59 @@ func (m *QueryFrozenResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Frozen = bool(v != 0)
+			m.Burnt = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revoked", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Revoked = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevocationReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RevocationReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3118,7 +5551,7 @@ func (m *QueryFrozenResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryWhitelistedRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBurntNFTsInClassRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3141,17 +5574,17 @@ func (m *QueryWhitelistedRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryWhitelistedRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBurntNFTsInClassRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryWhitelistedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBurntNFTsInClassRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3161,23 +5594,27 @@ func (m *QueryWhitelistedRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -3209,11 +5646,97 @@ func (m *QueryWhitelistedRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClassId = string(dAtA[iNdEx:postIndex])
+			m.ClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBurntNFTsInClassResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBurntNFTsInClassResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBurntNFTsInClassResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NftIds", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3241,7 +5764,7 @@ func (m *QueryWhitelistedRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Account = string(dAtA[iNdEx:postIndex])
+			m.NftIds = append(m.NftIds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3264,7 +5787,7 @@ func (m *QueryWhitelistedRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryWhitelistedResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryListingRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3287,17 +5810,17 @@ func (m *QueryWhitelistedResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryWhitelistedResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryListingRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryWhitelistedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryListingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Whitelisted", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var v int
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3307,12 +5830,11 @@ func (m *QueryWhitelistedResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Whitelisted = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3334,7 +5856,7 @@ func (m *QueryWhitelistedResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryWhitelistedAccountsForNFTRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryListingResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3357,15 +5879,15 @@ func (m *QueryWhitelistedAccountsForNFTRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryWhitelistedAccountsForNFTRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryListingResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryWhitelistedAccountsForNFTRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryListingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Listing", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3392,77 +5914,10 @@ func (m *QueryWhitelistedAccountsForNFTRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Listing.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Id = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ClassId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3484,7 +5939,7 @@ func (m *QueryWhitelistedAccountsForNFTRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryWhitelistedAccountsForNFTResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryListingsByClassRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3507,10 +5962,10 @@ func (m *QueryWhitelistedAccountsForNFTResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryWhitelistedAccountsForNFTResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryListingsByClassRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryWhitelistedAccountsForNFTResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryListingsByClassRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3543,7 +5998,7 @@ func (m *QueryWhitelistedAccountsForNFTResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+				m.Pagination = &query.PageRequest{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -3551,7 +6006,7 @@ func (m *QueryWhitelistedAccountsForNFTResponse) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3579,7 +6034,7 @@ func (m *QueryWhitelistedAccountsForNFTResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Accounts = append(m.Accounts, string(dAtA[iNdEx:postIndex]))
+			m.ClassId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3602,7 +6057,7 @@ func (m *QueryWhitelistedAccountsForNFTResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryClassWhitelistedAccountsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryListingsByClassResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3625,10 +6080,10 @@ func (m *QueryClassWhitelistedAccountsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryClassWhitelistedAccountsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryListingsByClassResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryClassWhitelistedAccountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryListingsByClassResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3661,7 +6116,7 @@ func (m *QueryClassWhitelistedAccountsRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+				m.Pagination = &query.PageResponse{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -3669,9 +6124,9 @@ func (m *QueryClassWhitelistedAccountsRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Listings", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3681,23 +6136,25 @@ func (m *QueryClassWhitelistedAccountsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClassId = string(dAtA[iNdEx:postIndex])
+			m.Listings = append(m.Listings, Listing{})
+			if err := m.Listings[len(m.Listings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3720,7 +6177,7 @@ func (m *QueryClassWhitelistedAccountsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryClassWhitelistedAccountsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryListingsBySellerRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3743,10 +6200,10 @@ func (m *QueryClassWhitelistedAccountsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryClassWhitelistedAccountsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryListingsBySellerRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryClassWhitelistedAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryListingsBySellerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3779,7 +6236,7 @@ func (m *QueryClassWhitelistedAccountsResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+				m.Pagination = &query.PageRequest{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -3787,7 +6244,7 @@ func (m *QueryClassWhitelistedAccountsResponse) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Seller", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3815,7 +6272,7 @@ func (m *QueryClassWhitelistedAccountsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Accounts = append(m.Accounts, string(dAtA[iNdEx:postIndex]))
+			m.Seller = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3838,7 +6295,7 @@ func (m *QueryClassWhitelistedAccountsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBurntNFTRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryListingsBySellerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3861,17 +6318,17 @@ func (m *QueryBurntNFTRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBurntNFTRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryListingsBySellerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBurntNFTRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryListingsBySellerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3881,29 +6338,33 @@ func (m *QueryBurntNFTRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClassId = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NftId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Listings", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3913,23 +6374,25 @@ func (m *QueryBurntNFTRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NftId = string(dAtA[iNdEx:postIndex])
+			m.Listings = append(m.Listings, Listing{})
+			if err := m.Listings[len(m.Listings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3952,7 +6415,7 @@ func (m *QueryBurntNFTRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBurntNFTResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryNFTsByOwnerRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3975,17 +6438,17 @@ func (m *QueryBurntNFTResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBurntNFTResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryNFTsByOwnerRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBurntNFTResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryNFTsByOwnerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Burnt", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3995,67 +6458,33 @@ func (m *QueryBurntNFTResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Burnt = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryBurntNFTsInClassRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBurntNFTsInClassRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBurntNFTsInClassRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4065,29 +6494,25 @@ func (m *QueryBurntNFTsInClassRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
 			}
@@ -4140,7 +6565,7 @@ func (m *QueryBurntNFTsInClassRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBurntNFTsInClassResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryNFTsByOwnerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4163,10 +6588,10 @@ func (m *QueryBurntNFTsInClassResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBurntNFTsInClassResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryNFTsByOwnerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBurntNFTsInClassResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryNFTsByOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4207,9 +6632,9 @@ func (m *QueryBurntNFTsInClassResponse) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NftIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Nfts", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4219,23 +6644,25 @@ func (m *QueryBurntNFTsInClassResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NftIds = append(m.NftIds, string(dAtA[iNdEx:postIndex]))
+			m.Nfts = append(m.Nfts, NFTRecord{})
+			if err := m.Nfts[len(m.Nfts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -37,6 +37,7 @@ type IssueClassSettings struct {
 	Data        *codectypes.Any
 	Features    []ClassFeature
 	RoyaltyRate sdk.Dec
+	DataEditors []DataEditor
 }
 
 // MintSettings is the model which represents the params for the non-fungible token minting.
@@ -50,6 +51,16 @@ type MintSettings struct {
 	Data      *codectypes.Any
 }
 
+// UpdateDataSettings is the model which represents the params for the non-fungible token data update.
+type UpdateDataSettings struct {
+	Sender  sdk.AccAddress
+	ClassID string
+	ID      string
+	URI     string
+	URIHash string
+	Data    *codectypes.Any
+}
+
 // BuildClassID builds the non-fungible token id string from the symbol and issuer address.
 func BuildClassID(symbol string, issuer sdk.AccAddress) string {
 	return strings.ToLower(symbol) + nftClassIDSeparator + issuer.String()
@@ -105,6 +116,51 @@ func ValidateClassFeatures(features []ClassFeature) error {
 	return nil
 }
 
+// ValidateDataEditors verifies that the data editors are provided only if the updatable data feature is enabled
+// and they belong to the defined set.
+func ValidateDataEditors(features []ClassFeature, editors []DataEditor) error {
+	if !lo.Contains(features, ClassFeature_updatable_data) {
+		if len(editors) != 0 {
+			return sdkerrors.Wrapf(
+				ErrInvalidInput, "data editors might be set only if the %s feature is enabled", ClassFeature_updatable_data,
+			)
+		}
+		return nil
+	}
+
+	if len(editors) == 0 {
+		return sdkerrors.Wrapf(
+			ErrInvalidInput, "at least one data editor must be set if the %s feature is enabled", ClassFeature_updatable_data,
+		)
+	}
+
+	present := map[DataEditor]struct{}{}
+	for _, e := range editors {
+		name, exists := DataEditor_name[int32(e)]
+		if !exists {
+			return sdkerrors.Wrapf(ErrInvalidInput, "non-existing data editor provided: %d", e)
+		}
+		if _, exists := present[e]; exists {
+			return sdkerrors.Wrapf(ErrInvalidInput, "duplicated data editor: %s", name)
+		}
+		present[e] = struct{}{}
+	}
+	return nil
+}
+
+// ValidateRevocationReason checks that the reason of the non-fungible token revocation is provided and is not too long.
+func ValidateRevocationReason(reason string) error {
+	if len(reason) == 0 {
+		return sdkerrors.Wrap(ErrInvalidInput, "revocation reason must be provided")
+	}
+	if len(reason) > MaxRevocationReasonLength {
+		return sdkerrors.Wrapf(
+			ErrInvalidInput, "invalid revocation reason, the length must be less than or equal %d", MaxRevocationReasonLength,
+		)
+	}
+	return nil
+}
+
 // ValidateTokenID checks the provided non-fungible token id is valid.
 func ValidateTokenID(id string) error {
 	if !nftIDRegex.MatchString(id) {
@@ -170,6 +226,11 @@ func (nftd ClassDefinition) IsFeatureEnabled(feature ClassFeature) bool {
 	return lo.Contains(nftd.Features, feature)
 }
 
+// IsDataEditor returns true if the editor is allowed to update the data of the non-fungible tokens.
+func (nftd ClassDefinition) IsDataEditor(editor DataEditor) bool {
+	return lo.Contains(nftd.DataEditors, editor)
+}
+
 // IsIssuer returns true if the addr is the issuer.
 func (nftd ClassDefinition) IsIssuer(addr sdk.Address) bool {
 	return nftd.Issuer == addr.String()
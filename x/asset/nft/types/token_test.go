@@ -224,3 +224,74 @@ func TestValidateClassFeatures(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateDataEditors(t *testing.T) {
+	t.Parallel()
+
+	assertT := assert.New(t)
+
+	updatable := []types.ClassFeature{types.ClassFeature_updatable_data}
+
+	testCases := []struct {
+		Name     string
+		Features []types.ClassFeature
+		Editors  []types.DataEditor
+		Ok       bool
+	}{
+		// valid cases
+		{
+			Name: "no feature and no editors",
+			Ok:   true,
+		},
+		{
+			Name:     "issuer",
+			Features: updatable,
+			Editors:  []types.DataEditor{types.DataEditor_issuer},
+			Ok:       true,
+		},
+		{
+			Name:     "issuer and owner",
+			Features: updatable,
+			Editors:  []types.DataEditor{types.DataEditor_owner, types.DataEditor_issuer},
+			Ok:       true,
+		},
+
+		// invalid cases
+		{
+			Name:    "editors without feature",
+			Editors: []types.DataEditor{types.DataEditor_owner},
+			Ok:      false,
+		},
+		{
+			Name:     "feature without editors",
+			Features: updatable,
+			Ok:       false,
+		},
+		{
+			Name:     "out of scope",
+			Features: updatable,
+			Editors:  []types.DataEditor{types.DataEditor_owner, 1000},
+			Ok:       false,
+		},
+		{
+			Name:     "duplicated",
+			Features: updatable,
+			Editors:  []types.DataEditor{types.DataEditor_owner, types.DataEditor_owner},
+			Ok:       false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+
+			err := types.ValidateDataEditors(tc.Features, tc.Editors)
+			if tc.Ok {
+				assertT.NoError(err)
+			} else {
+				assertT.Error(err)
+			}
+		})
+	}
+}
@@ -9,14 +9,28 @@ import (
 
 // DefaultGenesis returns the default genesis state.
 func DefaultGenesis() *types.GenesisState {
-	return &types.GenesisState{}
+	return &types.GenesisState{
+		Params: types.DefaultParams(),
+	}
 }
 
 // InitGenesis initializes the state from a provided genesis.
 func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
+	if err := genState.Params.ValidateBasic(); err != nil {
+		panic(err)
+	}
+	if err := k.SetParams(ctx, genState.Params); err != nil {
+		panic(err)
+	}
 	if err := k.ImportDelayedItems(ctx, genState.DelayedItems); err != nil {
 		panic(err)
 	}
+	if err := k.ImportDelayedItemsAtHeight(ctx, genState.DelayedItemsAtHeight); err != nil {
+		panic(err)
+	}
+	if err := k.ImportFailedItems(ctx, genState.FailedItems); err != nil {
+		panic(err)
+	}
 }
 
 // ExportGenesis returns the module's exported genesis.
@@ -25,7 +39,18 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	if err != nil {
 		panic(err)
 	}
+	delayedItemsAtHeight, err := k.ExportDelayedItemsAtHeight(ctx)
+	if err != nil {
+		panic(err)
+	}
+	failedItems, err := k.ExportFailedItems(ctx)
+	if err != nil {
+		panic(err)
+	}
 	return &types.GenesisState{
-		DelayedItems: delayedItems,
+		DelayedItems:         delayedItems,
+		Params:               k.GetParams(ctx),
+		FailedItems:          failedItems,
+		DelayedItemsAtHeight: delayedItemsAtHeight,
 	}
 }
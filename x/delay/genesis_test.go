@@ -13,6 +13,7 @@ import (
 
 	"github.com/CoreumFoundation/coreum/v3/testutil/simapp"
 	assetfttypes "github.com/CoreumFoundation/coreum/v3/x/asset/ft/types"
+	"github.com/CoreumFoundation/coreum/v3/x/delay"
 	"github.com/CoreumFoundation/coreum/v3/x/delay/types"
 )
 
@@ -74,6 +75,44 @@ func TestInitAndExportGenesis(t *testing.T) {
 	assertT.Equal(newDelayedItemWithoutCache(items[2]), newDelayedItemWithoutCache(itemsExported[0]))
 	assertT.Equal(newDelayedItemWithoutCache(items[1]), newDelayedItemWithoutCache(itemsExported[1]))
 	assertT.Equal(newDelayedItemWithoutCache(items[0]), newDelayedItemWithoutCache(itemsExported[2]))
+
+	itemsAtHeight := []types.DelayedItemAtHeight{
+		{
+			Id:              "item5",
+			ExecutionHeight: 200,
+			Data:            anyMsg2,
+		},
+		{
+			Id:              "item4",
+			ExecutionHeight: 100,
+			Data:            anyMsg1,
+		},
+	}
+	requireT.NoError(keeper.ImportDelayedItemsAtHeight(ctx, itemsAtHeight))
+
+	genState := delay.ExportGenesis(ctx, keeper)
+	requireT.Len(genState.DelayedItems, len(items))
+	requireT.Len(genState.DelayedItemsAtHeight, len(itemsAtHeight))
+	assertT.Equal("item4", genState.DelayedItemsAtHeight[0].Id)
+	assertT.EqualValues(100, genState.DelayedItemsAtHeight[0].ExecutionHeight)
+	assertT.Equal(anyMsg1.Value, genState.DelayedItemsAtHeight[0].Data.Value)
+	assertT.Equal("item5", genState.DelayedItemsAtHeight[1].Id)
+	assertT.EqualValues(200, genState.DelayedItemsAtHeight[1].ExecutionHeight)
+	assertT.Equal(anyMsg2.Value, genState.DelayedItemsAtHeight[1].Data.Value)
+	requireT.NoError(genState.Validate())
+}
+
+func TestInitGenesisInvalidParams(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+
+	genState := delay.DefaultGenesis()
+	genState.Params.MaxItemsPerBlock = 0
+	requireT.Panics(func() {
+		delay.InitGenesis(ctx, testApp.DelayKeeper, *genState)
+	})
 }
 
 func newDelayedItemWithoutCache(item types.DelayedItem) types.DelayedItem {
@@ -0,0 +1,100 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/CoreumFoundation/coreum/v3/x/delay/types"
+)
+
+var _ types.QueryServer = QueryService{}
+
+// QueryKeeper defines subscope of keeper methods required by query service.
+type QueryKeeper interface {
+	GetParams(ctx sdk.Context) types.Params
+	GetDelayedItems(
+		ctx sdk.Context,
+		startTime, endTime *time.Time,
+		pagination *query.PageRequest,
+	) ([]types.DelayedItem, *query.PageResponse, error)
+	GetDelayedItemsByID(ctx sdk.Context, id string) ([]types.DelayedItem, error)
+	GetDelayedItemsAtHeightByID(ctx sdk.Context, id string) ([]types.DelayedItemAtHeight, error)
+	GetFailedItems(ctx sdk.Context, pagination *query.PageRequest) ([]types.FailedItem, *query.PageResponse, error)
+}
+
+// QueryService serves grpc query requests for the delay module.
+type QueryService struct {
+	keeper QueryKeeper
+}
+
+// NewQueryService initiates the new instance of query service.
+func NewQueryService(keeper QueryKeeper) QueryService {
+	return QueryService{
+		keeper: keeper,
+	}
+}
+
+// Params queries the parameters of x/delay module.
+func (qs QueryService) Params(ctx context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	return &types.QueryParamsResponse{
+		Params: qs.keeper.GetParams(sdk.UnwrapSDKContext(ctx)),
+	}, nil
+}
+
+// DelayedItems queries the delayed items waiting for the execution.
+func (qs QueryService) DelayedItems(
+	ctx context.Context,
+	req *types.QueryDelayedItemsRequest,
+) (*types.QueryDelayedItemsResponse, error) {
+	delayedItems, pageRes, err := qs.keeper.GetDelayedItems(
+		sdk.UnwrapSDKContext(ctx), req.StartTime, req.EndTime, req.Pagination,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDelayedItemsResponse{
+		Pagination:   pageRes,
+		DelayedItems: delayedItems,
+	}, nil
+}
+
+// DelayedItemsByID queries the delayed items stored under the id.
+func (qs QueryService) DelayedItemsByID(
+	ctx context.Context,
+	req *types.QueryDelayedItemsByIDRequest,
+) (*types.QueryDelayedItemsByIDResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	delayedItems, err := qs.keeper.GetDelayedItemsByID(sdkCtx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	delayedItemsAtHeight, err := qs.keeper.GetDelayedItemsAtHeightByID(sdkCtx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDelayedItemsByIDResponse{
+		DelayedItems:         delayedItems,
+		DelayedItemsAtHeight: delayedItemsAtHeight,
+	}, nil
+}
+
+// FailedItems queries the delayed items which failed to be executed.
+func (qs QueryService) FailedItems(
+	ctx context.Context,
+	req *types.QueryFailedItemsRequest,
+) (*types.QueryFailedItemsResponse, error) {
+	failedItems, pageRes, err := qs.keeper.GetFailedItems(sdk.UnwrapSDKContext(ctx), req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryFailedItemsResponse{
+		Pagination:  pageRes,
+		FailedItems: failedItems,
+	}, nil
+}
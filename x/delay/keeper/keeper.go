@@ -1,9 +1,12 @@
 package keeper
 
 import (
+	"fmt"
+	"math"
 	"time"
 
 	sdkerrors "cosmossdk.io/errors"
+	"github.com/cometbft/cometbft/libs/log"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
@@ -11,16 +14,19 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
+	pkgstore "github.com/CoreumFoundation/coreum/v3/pkg/store"
 	"github.com/CoreumFoundation/coreum/v3/x/delay/types"
 )
 
 // Keeper is delay module Keeper.
 type Keeper struct {
-	cdc      codec.BinaryCodec
-	storeKey storetypes.StoreKey
-	router   types.Router
-	registry codectypes.InterfaceRegistry
+	cdc       codec.BinaryCodec
+	storeKey  storetypes.StoreKey
+	router    types.Router
+	registry  codectypes.InterfaceRegistry
+	authority string
 }
 
 // NewKeeper returns a new Keeper instance.
@@ -29,15 +35,53 @@ func NewKeeper(
 	storeKey storetypes.StoreKey,
 	router types.Router,
 	registry codectypes.InterfaceRegistry,
+	authority string,
 ) Keeper {
 	return Keeper{
-		cdc:      cdc,
-		storeKey: storeKey,
-		router:   router,
-		registry: registry,
+		cdc:       cdc,
+		storeKey:  storeKey,
+		router:    router,
+		registry:  registry,
+		authority: authority,
 	}
 }
 
+// GetParams gets the parameters of the module.
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ParamsKey)
+	if bz == nil {
+		return types.DefaultParams()
+	}
+	var params types.Params
+	k.cdc.MustUnmarshal(bz, &params)
+	return params
+}
+
+// SetParams sets the parameters of the module.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
+	if err := params.ValidateBasic(); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	bz, err := k.cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+	store.Set(types.ParamsKey, bz)
+	return nil
+}
+
+// UpdateParams is a governance operation that sets parameters of the module.
+func (k Keeper) UpdateParams(ctx sdk.Context, authority string, params types.Params) error {
+	if k.authority != authority {
+		return sdkerrors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, authority)
+	}
+
+	return k.SetParams(ctx, params)
+}
+
 // Router returns router.
 func (k Keeper) Router() types.Router {
 	return k.router
@@ -54,6 +98,10 @@ func (k Keeper) StoreDelayedExecution(ctx sdk.Context, id string, data codec.Pro
 	if err != nil {
 		return err
 	}
+	idKey, err := types.CreateDelayedItemIDKey(id, t)
+	if err != nil {
+		return err
+	}
 
 	store := ctx.KVStore(k.storeKey)
 	if store.Has(key) {
@@ -70,52 +118,378 @@ func (k Keeper) StoreDelayedExecution(ctx sdk.Context, id string, data codec.Pro
 		return sdkerrors.Wrapf(types.ErrInvalidData, "marshaling delayed item failed: %s", err.Error())
 	}
 	store.Set(key, b)
+	store.Set(idKey, types.StoreTrue)
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventDelayedItemStored{
+		Id: id,
+		// the execution time is stored with the precision of seconds
+		ExecutionTime: time.Unix(t.Unix(), 0).UTC(),
+		DataType:      dataAny.TypeUrl,
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit EventDelayedItemStored event: %s", err)
+	}
+
+	return nil
+}
+
+// DelayExecutionAtHeight stores an item to be executed at the block height.
+func (k Keeper) DelayExecutionAtHeight(ctx sdk.Context, id string, data codec.ProtoMarshaler, height uint64) error {
+	if height <= uint64(ctx.BlockHeight()) {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidInput,
+			"execution height %d must be greater than the current one %d", height, ctx.BlockHeight(),
+		)
+	}
+
+	dataAny, err := codectypes.NewAnyWithValue(data)
+	if err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidData, "failed to construct new Any, err: %s", err)
+	}
+
+	return k.storeDelayedExecutionAtHeight(ctx, types.DelayedItemAtHeight{
+		Id:              id,
+		ExecutionHeight: height,
+		Data:            dataAny,
+	})
+}
+
+// RemoveDelayedExecution removes all the delayed items stored under the id before they are executed.
+// It covers the items scheduled both by time and by the block height.
+func (k Keeper) RemoveDelayedExecution(ctx sdk.Context, id string) error {
+	items, err := k.GetDelayedItemsByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	itemsAtHeight, err := k.GetDelayedItemsAtHeightByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if len(items) == 0 && len(itemsAtHeight) == 0 {
+		return sdkerrors.Wrapf(types.ErrNotFound, "delayed item with id %q not found", id)
+	}
+
+	for _, item := range itemsAtHeight {
+		if err := k.removeDelayedItemAtHeight(ctx, item.Id, item.ExecutionHeight); err != nil {
+			return err
+		}
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventDelayedItemRemoved{
+			Id:              item.Id,
+			DataType:        item.Data.TypeUrl,
+			ExecutionHeight: item.ExecutionHeight,
+		}); err != nil {
+			return sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit EventDelayedItemRemoved event: %s", err)
+		}
+	}
+
+	for _, item := range items {
+		if err := k.removeDelayedItem(ctx, item.Id, item.ExecutionTime); err != nil {
+			return err
+		}
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventDelayedItemRemoved{
+			Id:            item.Id,
+			ExecutionTime: item.ExecutionTime,
+			DataType:      item.Data.TypeUrl,
+		}); err != nil {
+			return sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit EventDelayedItemRemoved event: %s", err)
+		}
+	}
+
 	return nil
 }
 
 // ExecuteDelayedItems executes delayed logic.
+// The items scheduled by time are executed first, then the ones scheduled by the block height.
+// The failure of the item doesn't stop the execution of the others. Instead, the failed item is stored to be retried
+// or discarded by the governance.
 func (k Keeper) ExecuteDelayedItems(ctx sdk.Context) error {
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DelayedItemKeyPrefix)
+	maxItems := int(k.GetParams(ctx).MaxItemsPerBlock)
+	delayedItems, err := k.getItemsToExecute(ctx, maxItems)
+	if err != nil {
+		return err
+	}
 
-	// messages will be returned from this iterator in the execution time ascending order
-	iter := store.Iterator(nil, nil)
+	for _, item := range delayedItems {
+		if err := k.removeDelayedItem(ctx, item.Id, item.ExecutionTime); err != nil {
+			return err
+		}
+		if err := k.executeOrStoreFailed(ctx, item, 0); err != nil {
+			return err
+		}
+	}
 
-	blockTime := ctx.BlockTime()
+	delayedItemsAtHeight, err := k.getItemsAtHeightToExecute(ctx, maxItems-len(delayedItems))
+	if err != nil {
+		return err
+	}
+
+	// the items scheduled by the block height are reported using the time of the block they are executed in
+	blockTime := time.Unix(ctx.BlockTime().Unix(), 0).UTC()
+	for _, item := range delayedItemsAtHeight {
+		if err := k.removeDelayedItemAtHeight(ctx, item.Id, item.ExecutionHeight); err != nil {
+			return err
+		}
+		if err := k.executeOrStoreFailed(ctx, types.DelayedItem{
+			Id:            item.Id,
+			ExecutionTime: blockTime,
+			Data:          item.Data,
+		}, item.ExecutionHeight); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// RetryFailedItem is a governance operation executing again the failed items stored under the id.
+func (k Keeper) RetryFailedItem(ctx sdk.Context, authority, id string) error {
+	if k.authority != authority {
+		return sdkerrors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, authority)
+	}
+
+	failedItems, err := k.getFailedItemsByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	for _, failedItem := range failedItems {
+		if err := k.executeDelayedItem(ctx, failedItem.Item); err != nil {
+			return sdkerrors.Wrapf(err, "retry of the delayed item %q failed", id)
+		}
+		if err := k.removeFailedItem(ctx, failedItem); err != nil {
+			return err
+		}
+		if err := emitDelayedItemExecuted(ctx, failedItem.Item, failedItem.ExecutionHeight); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// DiscardFailedItem is a governance operation removing the failed items stored under the id.
+func (k Keeper) DiscardFailedItem(ctx sdk.Context, authority, id string) error {
+	if k.authority != authority {
+		return sdkerrors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, authority)
+	}
+
+	failedItems, err := k.getFailedItemsByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	for _, failedItem := range failedItems {
+		if err := k.removeFailedItem(ctx, failedItem); err != nil {
+			return err
+		}
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventFailedItemDiscarded{
+			Id:            failedItem.Item.Id,
+			ExecutionTime: failedItem.Item.ExecutionTime,
+			DataType:      failedItem.Item.Data.TypeUrl,
+		}); err != nil {
+			return sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit EventFailedItemDiscarded event: %s", err)
+		}
+	}
+
+	return nil
+}
+
+// GetFailedItems returns the delayed items which failed to be executed.
+func (k Keeper) GetFailedItems(
+	ctx sdk.Context,
+	pagination *query.PageRequest,
+) ([]types.FailedItem, *query.PageResponse, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.FailedItemKeyPrefix)
+	failedItems := []types.FailedItem{}
+	pageRes, err := query.Paginate(store, pagination, func(key, value []byte) error {
+		var failedItem types.FailedItem
+		if err := k.cdc.Unmarshal(value, &failedItem); err != nil {
+			return sdkerrors.Wrapf(types.ErrInvalidData, "unmarshaling failed item failed: %s", err)
+		}
+		failedItems = append(failedItems, failedItem)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return failedItems, pageRes, nil
+}
+
+// GetDelayedItemsByID returns the delayed items stored under the id.
+func (k Keeper) GetDelayedItemsByID(ctx sdk.Context, id string) ([]types.DelayedItem, error) {
+	idPrefix, err := types.CreateDelayedItemIDPrefix(id)
+	if err != nil {
+		return nil, err
+	}
+
+	moduleStore := ctx.KVStore(k.storeKey)
+	iter := prefix.NewStore(moduleStore, idPrefix).Iterator(nil, nil)
+	defer iter.Close()
+
+	delayedItems := []types.DelayedItem{}
 	for ; iter.Valid(); iter.Next() {
-		key := iter.Key()
+		execTime, err := types.ExtractTimeFromDelayedItemIDKey(iter.Key())
+		if err != nil {
+			return nil, err
+		}
+
+		key, err := types.CreateDelayedItemKey(id, execTime)
+		if err != nil {
+			return nil, err
+		}
+		value := moduleStore.Get(key)
+		if value == nil {
+			return nil, sdkerrors.Wrapf(types.ErrInvalidState, "delayed item %q indexed at %s is not stored", id, execTime)
+		}
 
-		execTime, _, err := types.ExtractTimeAndIDFromDelayedItemKey(key)
+		item, err := k.buildDelayedItem(id, execTime, value)
+		if err != nil {
+			return nil, err
+		}
+		delayedItems = append(delayedItems, item)
+	}
+
+	return delayedItems, nil
+}
+
+// GetDelayedItems returns the delayed items having the execution time in the optional range.
+// Both bounds are inclusive.
+func (k Keeper) GetDelayedItems(
+	ctx sdk.Context,
+	startTime, endTime *time.Time,
+	pagination *query.PageRequest,
+) ([]types.DelayedItem, *query.PageResponse, error) {
+	// the execution time is stored with the precision of seconds
+	var start, end []byte
+	startUnix, endUnix := int64(0), int64(math.MaxInt64)
+	if startTime != nil {
+		startUnix = startTime.Unix()
+		if startTime.Nanosecond() > 0 {
+			startUnix++
+		}
+		if startUnix < 0 {
+			startUnix = 0
+		}
+		start = types.CreateDelayedItemTimePrefix(startUnix)
+	}
+	if endTime != nil {
+		endUnix = endTime.Unix()
+		if endUnix < math.MaxInt64 {
+			end = types.CreateDelayedItemTimePrefix(endUnix + 1)
+		}
+	}
+	delayedItems := []types.DelayedItem{}
+	if startUnix > endUnix {
+		return delayedItems, &query.PageResponse{}, nil
+	}
+
+	// the keys are ordered by the execution time, so only the items in the range are iterated
+	store := pkgstore.NewRangeStore(prefix.NewStore(ctx.KVStore(k.storeKey), types.DelayedItemKeyPrefix), start, end)
+	pageRes, err := query.Paginate(store, pagination, func(key, value []byte) error {
+		execTime, id, err := types.ExtractTimeAndIDFromDelayedItemKey(key)
 		if err != nil {
 			return err
 		}
+		item, err := k.buildDelayedItem(id, execTime, value)
+		if err != nil {
+			return err
+		}
+		delayedItems = append(delayedItems, item)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
 
-		// due to the order of items returned by the iterator, if we find that execution time is after
-		// the current block time, then there is no reason to iterate further
-		if execTime.After(blockTime) {
-			return nil
+	return delayedItems, pageRes, nil
+}
+
+// ImportFailedItems imports failed items.
+func (k Keeper) ImportFailedItems(ctx sdk.Context, failedItems []types.FailedItem) error {
+	for _, failedItem := range failedItems {
+		if err := k.storeFailedItem(ctx, failedItem); err != nil {
+			return err
 		}
+	}
+	return nil
+}
+
+// ExportFailedItems exports failed items.
+func (k Keeper) ExportFailedItems(ctx sdk.Context) ([]types.FailedItem, error) {
+	failedItems, _, err := k.GetFailedItems(ctx, &query.PageRequest{Limit: query.MaxLimit})
+	return failedItems, err
+}
 
-		dataAny := &codectypes.Any{}
-		if err := k.cdc.Unmarshal(iter.Value(), dataAny); err != nil {
-			return sdkerrors.Wrapf(types.ErrInvalidData, "decoding delayed message failed: %s", err.Error())
+// GetDelayedItemsAtHeightByID returns the delayed items scheduled at the block height and stored under the id.
+func (k Keeper) GetDelayedItemsAtHeightByID(ctx sdk.Context, id string) ([]types.DelayedItemAtHeight, error) {
+	idPrefix, err := types.CreateDelayedItemHeightIDPrefix(id)
+	if err != nil {
+		return nil, err
+	}
+
+	moduleStore := ctx.KVStore(k.storeKey)
+	iter := prefix.NewStore(moduleStore, idPrefix).Iterator(nil, nil)
+	defer iter.Close()
+
+	delayedItems := []types.DelayedItemAtHeight{}
+	for ; iter.Valid(); iter.Next() {
+		height, err := types.ExtractHeightFromDelayedItemHeightIDKey(iter.Key())
+		if err != nil {
+			return nil, err
 		}
 
-		var data codec.ProtoMarshaler
-		if err := k.cdc.UnpackAny(dataAny, &data); err != nil {
-			return sdkerrors.Wrapf(types.ErrInvalidData, "unpacking delayed message failed: %s", err.Error())
+		key, err := types.CreateDelayedItemHeightKey(id, height)
+		if err != nil {
+			return nil, err
 		}
+		value := moduleStore.Get(key)
+		if value == nil {
+			return nil, sdkerrors.Wrapf(types.ErrInvalidState, "delayed item %q indexed at height %d is not stored", id, height)
+		}
+
+		item, err := k.buildDelayedItemAtHeight(id, height, value)
+		if err != nil {
+			return nil, err
+		}
+		delayedItems = append(delayedItems, item)
+	}
+
+	return delayedItems, nil
+}
+
+// ImportDelayedItemsAtHeight imports delayed items scheduled at the block height.
+func (k Keeper) ImportDelayedItemsAtHeight(ctx sdk.Context, items []types.DelayedItemAtHeight) error {
+	for _, item := range items {
+		if err := k.storeDelayedExecutionAtHeight(ctx, item); err != nil {
+			return err
+		}
+	}
+	return nil
+}
 
-		handler, err := k.router.Handler(data)
+// ExportDelayedItemsAtHeight exports delayed items scheduled at the block height.
+func (k Keeper) ExportDelayedItemsAtHeight(ctx sdk.Context) ([]types.DelayedItemAtHeight, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DelayedItemHeightKeyPrefix)
+	delayedItems := []types.DelayedItemAtHeight{}
+	_, err := query.Paginate(store, &query.PageRequest{Limit: query.MaxLimit}, func(key, value []byte) error {
+		height, id, err := types.ExtractHeightAndIDFromDelayedItemHeightKey(key)
 		if err != nil {
 			return err
 		}
-		if err := handler(ctx, data); err != nil {
+
+		item, err := k.buildDelayedItemAtHeight(id, height, value)
+		if err != nil {
 			return err
 		}
+		delayedItems = append(delayedItems, item)
 
-		store.Delete(key)
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
-	return nil
+
+	return delayedItems, nil
 }
 
 // ImportDelayedItems imports delayed items.
@@ -143,16 +517,11 @@ func (k Keeper) ExportDelayedItems(ctx sdk.Context) ([]types.DelayedItem, error)
 			return err
 		}
 
-		data := &codectypes.Any{}
-		if err := k.cdc.Unmarshal(value, data); err != nil {
-			return sdkerrors.Wrapf(types.ErrInvalidData, "unpacking delayed message failed: %s", err.Error())
+		item, err := k.buildDelayedItem(id, executionTime, value)
+		if err != nil {
+			return err
 		}
-
-		delayedItems = append(delayedItems, types.DelayedItem{
-			Id:            id,
-			ExecutionTime: executionTime,
-			Data:          data,
-		})
+		delayedItems = append(delayedItems, item)
 
 		return nil
 	})
@@ -162,3 +531,282 @@ func (k Keeper) ExportDelayedItems(ctx sdk.Context) ([]types.DelayedItem, error)
 
 	return delayedItems, nil
 }
+
+func (k Keeper) removeDelayedItem(ctx sdk.Context, id string, t time.Time) error {
+	key, err := types.CreateDelayedItemKey(id, t)
+	if err != nil {
+		return err
+	}
+	idKey, err := types.CreateDelayedItemIDKey(id, t)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(key)
+	store.Delete(idKey)
+	return nil
+}
+
+func (k Keeper) buildDelayedItem(id string, executionTime time.Time, value []byte) (types.DelayedItem, error) {
+	data := &codectypes.Any{}
+	if err := k.cdc.Unmarshal(value, data); err != nil {
+		return types.DelayedItem{}, sdkerrors.Wrapf(types.ErrInvalidData, "unpacking delayed message failed: %s", err.Error())
+	}
+
+	return types.DelayedItem{
+		Id:            id,
+		ExecutionTime: executionTime,
+		Data:          data,
+	}, nil
+}
+
+func (k Keeper) getItemsToExecute(ctx sdk.Context, maxItems int) ([]types.DelayedItem, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DelayedItemKeyPrefix)
+
+	// messages will be returned from this iterator in the execution time ascending order
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	blockTime := ctx.BlockTime()
+	delayedItems := []types.DelayedItem{}
+	for ; iter.Valid() && len(delayedItems) < maxItems; iter.Next() {
+		execTime, id, err := types.ExtractTimeAndIDFromDelayedItemKey(iter.Key())
+		if err != nil {
+			return nil, err
+		}
+
+		// due to the order of items returned by the iterator, if we find that execution time is after
+		// the current block time, then there is no reason to iterate further
+		if execTime.After(blockTime) {
+			break
+		}
+
+		item, err := k.buildDelayedItem(id, execTime, iter.Value())
+		if err != nil {
+			return nil, err
+		}
+		delayedItems = append(delayedItems, item)
+	}
+
+	return delayedItems, nil
+}
+
+func (k Keeper) getItemsAtHeightToExecute(ctx sdk.Context, maxItems int) ([]types.DelayedItemAtHeight, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DelayedItemHeightKeyPrefix)
+
+	// messages will be returned from this iterator in the execution height ascending order
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	blockHeight := uint64(ctx.BlockHeight())
+	delayedItems := []types.DelayedItemAtHeight{}
+	for ; iter.Valid() && len(delayedItems) < maxItems; iter.Next() {
+		height, id, err := types.ExtractHeightAndIDFromDelayedItemHeightKey(iter.Key())
+		if err != nil {
+			return nil, err
+		}
+		if height > blockHeight {
+			break
+		}
+
+		item, err := k.buildDelayedItemAtHeight(id, height, iter.Value())
+		if err != nil {
+			return nil, err
+		}
+		delayedItems = append(delayedItems, item)
+	}
+
+	return delayedItems, nil
+}
+
+// executeOrStoreFailed executes the item and stores it as failed if the execution fails.
+func (k Keeper) executeOrStoreFailed(ctx sdk.Context, item types.DelayedItem, executionHeight uint64) error {
+	execErr := k.executeDelayedItem(ctx, item)
+	if execErr == nil {
+		return emitDelayedItemExecuted(ctx, item, executionHeight)
+	}
+
+	k.logger(ctx).Error("delayed item execution failed", "id", item.Id, "error", execErr)
+	if err := k.storeFailedItem(ctx, types.FailedItem{
+		Item:            item,
+		Error:           execErr.Error(),
+		ExecutionHeight: executionHeight,
+	}); err != nil {
+		return err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventDelayedItemFailed{
+		Id:              item.Id,
+		ExecutionTime:   item.ExecutionTime,
+		DataType:        item.Data.TypeUrl,
+		Error:           execErr.Error(),
+		ExecutionHeight: executionHeight,
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit EventDelayedItemFailed event: %s", err)
+	}
+
+	return nil
+}
+
+// executeDelayedItem executes the item in the cached context, so the state is not changed if the handler fails.
+// The panic of the handler is recovered and returned as the error, so the item is stored as failed.
+func (k Keeper) executeDelayedItem(ctx sdk.Context, item types.DelayedItem) (err error) {
+	var data codec.ProtoMarshaler
+	if err := k.cdc.UnpackAny(item.Data, &data); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidData, "unpacking delayed message failed: %s", err.Error())
+	}
+
+	handler, err := k.router.Handler(data)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if recoveryObj := recover(); recoveryObj != nil {
+			err = sdkerrors.Wrapf(types.ErrInvalidState, "delayed item execution panicked: %v", recoveryObj)
+		}
+	}()
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	if err := handler(cacheCtx, data); err != nil {
+		return err
+	}
+	writeCache()
+
+	return nil
+}
+
+func (k Keeper) storeDelayedExecutionAtHeight(ctx sdk.Context, item types.DelayedItemAtHeight) error {
+	key, err := types.CreateDelayedItemHeightKey(item.Id, item.ExecutionHeight)
+	if err != nil {
+		return err
+	}
+	idKey, err := types.CreateDelayedItemHeightIDKey(item.Id, item.ExecutionHeight)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	if store.Has(key) {
+		return sdkerrors.Wrapf(
+			cosmoserrors.ErrUnauthorized, "delayed item is already stored under the key, id: %s, height: %d",
+			item.Id, item.ExecutionHeight,
+		)
+	}
+
+	b, err := k.cdc.Marshal(item.Data)
+	if err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidData, "marshaling delayed item failed: %s", err.Error())
+	}
+	store.Set(key, b)
+	store.Set(idKey, types.StoreTrue)
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventDelayedItemStored{
+		Id:              item.Id,
+		DataType:        item.Data.TypeUrl,
+		ExecutionHeight: item.ExecutionHeight,
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit EventDelayedItemStored event: %s", err)
+	}
+
+	return nil
+}
+
+func (k Keeper) removeDelayedItemAtHeight(ctx sdk.Context, id string, height uint64) error {
+	key, err := types.CreateDelayedItemHeightKey(id, height)
+	if err != nil {
+		return err
+	}
+	idKey, err := types.CreateDelayedItemHeightIDKey(id, height)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(key)
+	store.Delete(idKey)
+	return nil
+}
+
+func (k Keeper) buildDelayedItemAtHeight(id string, height uint64, value []byte) (types.DelayedItemAtHeight, error) {
+	data := &codectypes.Any{}
+	if err := k.cdc.Unmarshal(value, data); err != nil {
+		return types.DelayedItemAtHeight{}, sdkerrors.Wrapf(
+			types.ErrInvalidData, "unpacking delayed message failed: %s", err.Error(),
+		)
+	}
+
+	return types.DelayedItemAtHeight{
+		Id:              id,
+		ExecutionHeight: height,
+		Data:            data,
+	}, nil
+}
+
+func (k Keeper) storeFailedItem(ctx sdk.Context, failedItem types.FailedItem) error {
+	key, err := types.CreateFailedItemKey(
+		failedItem.Item.Id, failedItem.Item.ExecutionTime, failedItem.ExecutionHeight,
+	)
+	if err != nil {
+		return err
+	}
+
+	b, err := k.cdc.Marshal(&failedItem)
+	if err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidData, "marshaling failed item failed: %s", err)
+	}
+	ctx.KVStore(k.storeKey).Set(key, b)
+	return nil
+}
+
+func (k Keeper) removeFailedItem(ctx sdk.Context, failedItem types.FailedItem) error {
+	key, err := types.CreateFailedItemKey(
+		failedItem.Item.Id, failedItem.Item.ExecutionTime, failedItem.ExecutionHeight,
+	)
+	if err != nil {
+		return err
+	}
+	ctx.KVStore(k.storeKey).Delete(key)
+	return nil
+}
+
+func (k Keeper) getFailedItemsByID(ctx sdk.Context, id string) ([]types.FailedItem, error) {
+	failedPrefix, err := types.CreateFailedItemPrefix(id)
+	if err != nil {
+		return nil, err
+	}
+
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), failedPrefix).Iterator(nil, nil)
+	defer iter.Close()
+
+	failedItems := []types.FailedItem{}
+	for ; iter.Valid(); iter.Next() {
+		var failedItem types.FailedItem
+		if err := k.cdc.Unmarshal(iter.Value(), &failedItem); err != nil {
+			return nil, sdkerrors.Wrapf(types.ErrInvalidData, "unmarshaling failed item failed: %s", err)
+		}
+		failedItems = append(failedItems, failedItem)
+	}
+	if len(failedItems) == 0 {
+		return nil, sdkerrors.Wrapf(types.ErrNotFound, "failed item with id %q not found", id)
+	}
+
+	return failedItems, nil
+}
+
+func (k Keeper) logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+func emitDelayedItemExecuted(ctx sdk.Context, item types.DelayedItem, executionHeight uint64) error {
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventDelayedItemExecuted{
+		Id:              item.Id,
+		ExecutionTime:   item.ExecutionTime,
+		DataType:        item.Data.TypeUrl,
+		ExecutionHeight: executionHeight,
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit EventDelayedItemExecuted event: %s", err)
+	}
+	return nil
+}
@@ -1,16 +1,24 @@
 package keeper_test
 
 import (
+	"errors"
 	"testing"
 	"time"
 
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/cosmos/gogoproto/proto"
 	"github.com/stretchr/testify/require"
 
 	"github.com/CoreumFoundation/coreum/v3/testutil/simapp"
+	"github.com/CoreumFoundation/coreum/v3/x/delay"
+	"github.com/CoreumFoundation/coreum/v3/x/delay/keeper"
 	"github.com/CoreumFoundation/coreum/v3/x/delay/types"
 )
 
@@ -126,10 +134,13 @@ func TestDelayedExecution(t *testing.T) {
 
 	requireT.Equal(expectedDelayedItems, delayedItems)
 
-	// should panic because handler is not registered
-	requireT.Panics(func() {
-		testApp.BeginNextBlock(blockTime.Add(time.Second))
-	})
+	// first item fails because handler is not registered
+	ctx = testApp.BeginNextBlock(blockTime.Add(time.Second))
+	failedItems, _, err := delayKeeper.GetFailedItems(ctx, nil)
+	requireT.NoError(err)
+	requireT.Len(failedItems, 1)
+	requireT.Equal(expectedDelayedItems[0], failedItems[0].Item)
+	requireT.NotEmpty(failedItems[0].Error)
 
 	executedItems := []*delayedItem{}
 	requireT.NoError(delayKeeper.Router().RegisterHandler(&delayedItem{}, func(ctx sdk.Context, data proto.Message) error {
@@ -137,10 +148,16 @@ func TestDelayedExecution(t *testing.T) {
 		return nil
 	}))
 
-	// first item should be executed
-	testApp.BeginNextBlock(blockTime.Add(time.Second))
+	// first item should be executed on retry
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	requireT.ErrorIs(delayKeeper.RetryFailedItem(ctx, "invalid", "delayed-id-1"), govtypes.ErrInvalidSigner)
+	requireT.NoError(delayKeeper.RetryFailedItem(ctx, authority, "delayed-id-1"))
 	requireT.Len(executedItems, 1)
 	requireT.Equal(delayed1, executedItems[0])
+	failedItems, _, err = delayKeeper.GetFailedItems(ctx, nil)
+	requireT.NoError(err)
+	requireT.Empty(failedItems)
+	requireT.ErrorIs(delayKeeper.RetryFailedItem(ctx, authority, "delayed-id-1"), types.ErrNotFound)
 
 	// three items should be executed
 	executedItems = []*delayedItem{}
@@ -156,6 +173,432 @@ func TestDelayedExecution(t *testing.T) {
 	requireT.Empty(delayedItems)
 }
 
+func TestRemoveDelayedExecution(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	testApp.InterfaceRegistry().RegisterImplementations((*codec.ProtoMarshaler)(nil), &delayedItem{})
+
+	blockTime := time.Date(2023, 4, 3, 2, 3, 4, 0, time.UTC)
+	ctx := testApp.BeginNextBlock(blockTime)
+
+	delayed1 := &delayedItem{
+		Value: "value1",
+	}
+	delayed2 := &delayedItem{
+		Value: "value2",
+	}
+
+	delayKeeper := testApp.DelayKeeper
+
+	requireT.NoError(delayKeeper.DelayExecution(ctx, "delayed-id-1", delayed1, time.Second))
+	requireT.NoError(delayKeeper.DelayExecution(ctx, "delayed-id-1", delayed1, 2*time.Second))
+	requireT.NoError(delayKeeper.DelayExecution(ctx, "delayed-id-2", delayed2, time.Second))
+
+	requireT.ErrorIs(delayKeeper.RemoveDelayedExecution(ctx, "missing-id"), types.ErrNotFound)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	requireT.NoError(delayKeeper.RemoveDelayedExecution(ctx, "delayed-id-1"))
+
+	var removedEvents []types.EventDelayedItemRemoved
+	for _, ev := range ctx.EventManager().Events() {
+		if ev.Type != proto.MessageName(&types.EventDelayedItemRemoved{}) {
+			continue
+		}
+		msg, err := sdk.ParseTypedEvent(abci.Event(ev))
+		requireT.NoError(err)
+		removedEvents = append(removedEvents, *msg.(*types.EventDelayedItemRemoved))
+	}
+	requireT.Equal([]types.EventDelayedItemRemoved{
+		{
+			Id:            "delayed-id-1",
+			ExecutionTime: blockTime.Add(time.Second),
+			DataType:      "/test.DummyDelayedItem",
+		},
+		{
+			Id:            "delayed-id-1",
+			ExecutionTime: blockTime.Add(2 * time.Second),
+			DataType:      "/test.DummyDelayedItem",
+		},
+	}, removedEvents)
+
+	delayedItems, err := delayKeeper.GetDelayedItemsByID(ctx, "delayed-id-1")
+	requireT.NoError(err)
+	requireT.Empty(delayedItems)
+
+	executedItems := []*delayedItem{}
+	requireT.NoError(delayKeeper.Router().RegisterHandler(&delayedItem{}, func(ctx sdk.Context, data proto.Message) error {
+		executedItems = append(executedItems, data.(*delayedItem))
+		return nil
+	}))
+
+	// only the item which was not removed should be executed
+	testApp.BeginNextBlock(blockTime.Add(2 * time.Second))
+	requireT.Equal([]*delayedItem{delayed2}, executedItems)
+}
+
+func TestDelayedItemsQueries(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	testApp.InterfaceRegistry().RegisterImplementations((*codec.ProtoMarshaler)(nil), &delayedItem{})
+
+	blockTime := time.Date(2023, 4, 3, 2, 3, 4, 0, time.UTC)
+	ctx := testApp.BeginNextBlock(blockTime)
+
+	delayed1 := &delayedItem{
+		Value: "value1",
+	}
+	delayed2 := &delayedItem{
+		Value: "value2",
+	}
+	delayed3 := &delayedItem{
+		Value: "value3",
+	}
+
+	delayKeeper := testApp.DelayKeeper
+	queryService := keeper.NewQueryService(delayKeeper)
+
+	requireT.NoError(delayKeeper.DelayExecution(ctx, "delayed-id-1", delayed1, time.Second))
+	requireT.NoError(delayKeeper.DelayExecution(ctx, "delayed-id-1", delayed2, 2*time.Second))
+	requireT.NoError(delayKeeper.DelayExecution(ctx, "delayed-id-3", delayed3, 3*time.Second))
+
+	item1 := types.DelayedItem{
+		Id:            "delayed-id-1",
+		ExecutionTime: blockTime.Add(time.Second),
+		Data:          newAny(requireT, delayed1),
+	}
+	item2 := types.DelayedItem{
+		Id:            "delayed-id-1",
+		ExecutionTime: blockTime.Add(2 * time.Second),
+		Data:          newAny(requireT, delayed2),
+	}
+	item3 := types.DelayedItem{
+		Id:            "delayed-id-3",
+		ExecutionTime: blockTime.Add(3 * time.Second),
+		Data:          newAny(requireT, delayed3),
+	}
+
+	byIDRes, err := queryService.DelayedItemsByID(sdk.WrapSDKContext(ctx), &types.QueryDelayedItemsByIDRequest{
+		Id: "delayed-id-1",
+	})
+	requireT.NoError(err)
+	requireT.Equal([]types.DelayedItem{item1, item2}, byIDRes.DelayedItems)
+
+	_, err = queryService.DelayedItemsByID(sdk.WrapSDKContext(ctx), &types.QueryDelayedItemsByIDRequest{})
+	requireT.ErrorIs(err, types.ErrInvalidInput)
+
+	res, err := queryService.DelayedItems(sdk.WrapSDKContext(ctx), &types.QueryDelayedItemsRequest{})
+	requireT.NoError(err)
+	requireT.Equal([]types.DelayedItem{item1, item2, item3}, res.DelayedItems)
+
+	startTime := blockTime.Add(2 * time.Second)
+	res, err = queryService.DelayedItems(sdk.WrapSDKContext(ctx), &types.QueryDelayedItemsRequest{
+		StartTime: &startTime,
+	})
+	requireT.NoError(err)
+	requireT.Equal([]types.DelayedItem{item2, item3}, res.DelayedItems)
+
+	endTime := blockTime.Add(2 * time.Second)
+	res, err = queryService.DelayedItems(sdk.WrapSDKContext(ctx), &types.QueryDelayedItemsRequest{
+		EndTime: &endTime,
+	})
+	requireT.NoError(err)
+	requireT.Equal([]types.DelayedItem{item1, item2}, res.DelayedItems)
+
+	res, err = queryService.DelayedItems(sdk.WrapSDKContext(ctx), &types.QueryDelayedItemsRequest{
+		StartTime:  &startTime,
+		EndTime:    &endTime,
+		Pagination: &query.PageRequest{CountTotal: true},
+	})
+	requireT.NoError(err)
+	requireT.Equal([]types.DelayedItem{item2}, res.DelayedItems)
+	requireT.EqualValues(1, res.Pagination.Total)
+
+	res, err = queryService.DelayedItems(sdk.WrapSDKContext(ctx), &types.QueryDelayedItemsRequest{
+		Pagination: &query.PageRequest{Limit: 2},
+	})
+	requireT.NoError(err)
+	requireT.Equal([]types.DelayedItem{item1, item2}, res.DelayedItems)
+	requireT.NotEmpty(res.Pagination.NextKey)
+
+	// the pagination is limited to the range
+	res, err = queryService.DelayedItems(sdk.WrapSDKContext(ctx), &types.QueryDelayedItemsRequest{
+		StartTime:  &startTime,
+		Pagination: &query.PageRequest{Limit: 1},
+	})
+	requireT.NoError(err)
+	requireT.Equal([]types.DelayedItem{item2}, res.DelayedItems)
+	res, err = queryService.DelayedItems(sdk.WrapSDKContext(ctx), &types.QueryDelayedItemsRequest{
+		StartTime:  &startTime,
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey, Limit: 1},
+	})
+	requireT.NoError(err)
+	requireT.Equal([]types.DelayedItem{item3}, res.DelayedItems)
+	requireT.Empty(res.Pagination.NextKey)
+
+	// the bounds are inclusive, the items are executed at the full seconds
+	startTime = blockTime.Add(1500 * time.Millisecond)
+	endTime = blockTime.Add(3500 * time.Millisecond)
+	res, err = queryService.DelayedItems(sdk.WrapSDKContext(ctx), &types.QueryDelayedItemsRequest{
+		StartTime: &startTime,
+		EndTime:   &endTime,
+	})
+	requireT.NoError(err)
+	requireT.Equal([]types.DelayedItem{item2, item3}, res.DelayedItems)
+
+	res, err = queryService.DelayedItems(sdk.WrapSDKContext(ctx), &types.QueryDelayedItemsRequest{
+		StartTime: &endTime,
+		EndTime:   &startTime,
+	})
+	requireT.NoError(err)
+	requireT.Empty(res.DelayedItems)
+}
+
+func TestFailedDelayedItems(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	testApp.InterfaceRegistry().RegisterImplementations((*codec.ProtoMarshaler)(nil), &delayedItem{})
+
+	blockTime := time.Date(2023, 4, 3, 2, 3, 4, 0, time.UTC)
+	ctx := testApp.BeginNextBlock(blockTime)
+
+	delayKeeper := testApp.DelayKeeper
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	// zero items per block would stall the delayed execution
+	requireT.ErrorIs(delayKeeper.UpdateParams(ctx, authority, types.Params{
+		MaxItemsPerBlock: 0,
+	}), types.ErrInvalidInput)
+
+	// only two items might be executed in the block
+	requireT.NoError(delayKeeper.UpdateParams(ctx, authority, types.Params{
+		MaxItemsPerBlock: 2,
+	}))
+
+	executedItems := []string{}
+	requireT.NoError(delayKeeper.Router().RegisterHandler(&delayedItem{}, func(ctx sdk.Context, data proto.Message) error {
+		value := data.(*delayedItem).Value
+		executedItems = append(executedItems, value)
+		if value == "fail" {
+			// state changes of the failed item must be reverted
+			requireT.NoError(delayKeeper.DelayExecution(ctx, "from-failed", &delayedItem{Value: "value"}, time.Hour))
+			return errors.New("test failure")
+		}
+		return nil
+	}))
+
+	requireT.NoError(delayKeeper.DelayExecution(ctx, "delayed-id-1", &delayedItem{Value: "fail"}, time.Second))
+	requireT.NoError(delayKeeper.DelayExecution(ctx, "delayed-id-2", &delayedItem{Value: "value2"}, time.Second))
+	requireT.NoError(delayKeeper.DelayExecution(ctx, "delayed-id-3", &delayedItem{Value: "value3"}, time.Second))
+
+	ctx = testApp.BeginNextBlock(blockTime.Add(time.Second))
+	requireT.Equal([]string{"fail", "value2"}, executedItems)
+
+	delayedItems, err := delayKeeper.GetDelayedItemsByID(ctx, "from-failed")
+	requireT.NoError(err)
+	requireT.Empty(delayedItems)
+
+	failedItems, _, err := delayKeeper.GetFailedItems(ctx, nil)
+	requireT.NoError(err)
+	requireT.Len(failedItems, 1)
+	requireT.Equal("delayed-id-1", failedItems[0].Item.Id)
+	requireT.Equal("test failure", failedItems[0].Error)
+
+	// the remaining item is executed in the next block
+	testApp.BeginNextBlock(blockTime.Add(2 * time.Second))
+	requireT.Equal([]string{"fail", "value2", "value3"}, executedItems)
+
+	// the failed retry doesn't remove the item
+	requireT.Error(delayKeeper.RetryFailedItem(ctx, authority, "delayed-id-1"))
+
+	genesis := delay.ExportGenesis(ctx, delayKeeper)
+	requireT.Equal(types.Params{MaxItemsPerBlock: 2}, genesis.Params)
+	requireT.Len(genesis.FailedItems, 1)
+
+	requireT.ErrorIs(delayKeeper.DiscardFailedItem(ctx, "invalid", "delayed-id-1"), govtypes.ErrInvalidSigner)
+	requireT.ErrorIs(delayKeeper.DiscardFailedItem(ctx, authority, "delayed-id-2"), types.ErrNotFound)
+	requireT.NoError(delayKeeper.DiscardFailedItem(ctx, authority, "delayed-id-1"))
+	failedItems, _, err = delayKeeper.GetFailedItems(ctx, nil)
+	requireT.NoError(err)
+	requireT.Empty(failedItems)
+}
+
+func TestPanickingDelayedItems(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	testApp.InterfaceRegistry().RegisterImplementations((*codec.ProtoMarshaler)(nil), &delayedItem{})
+
+	blockTime := time.Date(2023, 4, 3, 2, 3, 4, 0, time.UTC)
+	ctx := testApp.BeginNextBlock(blockTime)
+
+	delayKeeper := testApp.DelayKeeper
+
+	executedItems := []string{}
+	requireT.NoError(delayKeeper.Router().RegisterHandler(&delayedItem{}, func(ctx sdk.Context, data proto.Message) error {
+		value := data.(*delayedItem).Value
+		executedItems = append(executedItems, value)
+		if value == "panic" {
+			// state changes of the panicking item must be reverted
+			requireT.NoError(delayKeeper.DelayExecution(ctx, "from-panicking", &delayedItem{Value: "value"}, time.Hour))
+			panic("test panic")
+		}
+		return nil
+	}))
+
+	requireT.NoError(delayKeeper.DelayExecution(ctx, "delayed-id-1", &delayedItem{Value: "panic"}, time.Second))
+	requireT.NoError(delayKeeper.DelayExecution(ctx, "delayed-id-2", &delayedItem{Value: "value2"}, time.Second))
+
+	// the panic doesn't stop the execution of the other items
+	ctx = testApp.BeginNextBlock(blockTime.Add(time.Second))
+	requireT.Equal([]string{"panic", "value2"}, executedItems)
+
+	delayedItems, err := delayKeeper.GetDelayedItemsByID(ctx, "from-panicking")
+	requireT.NoError(err)
+	requireT.Empty(delayedItems)
+
+	failedItems, _, err := delayKeeper.GetFailedItems(ctx, nil)
+	requireT.NoError(err)
+	requireT.Len(failedItems, 1)
+	requireT.Equal("delayed-id-1", failedItems[0].Item.Id)
+	requireT.Contains(failedItems[0].Error, "test panic")
+}
+
+func TestFailedDelayedItemsAtHeight(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	testApp.InterfaceRegistry().RegisterImplementations((*codec.ProtoMarshaler)(nil), &delayedItem{})
+
+	blockTime := time.Date(2023, 4, 3, 2, 3, 4, 0, time.UTC)
+	ctx := testApp.BeginNextBlock(blockTime)
+	height := uint64(ctx.BlockHeight())
+
+	delayKeeper := testApp.DelayKeeper
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	requireT.NoError(delayKeeper.Router().RegisterHandler(&delayedItem{}, func(ctx sdk.Context, data proto.Message) error {
+		return errors.New("test failure")
+	}))
+
+	requireT.NoError(delayKeeper.DelayExecutionAtHeight(ctx, "delayed-id-1", &delayedItem{Value: "value1"}, height+1))
+	requireT.NoError(delayKeeper.DelayExecutionAtHeight(ctx, "delayed-id-1", &delayedItem{Value: "value2"}, height+2))
+
+	// both items fail in the blocks having the same time, so they are stored with the same execution time
+	testApp.EndBlockAndCommit(ctx)
+	ctx = testApp.BeginNextBlock(blockTime)
+	testApp.EndBlockAndCommit(ctx)
+	ctx = testApp.BeginNextBlock(blockTime)
+
+	failedItems, _, err := delayKeeper.GetFailedItems(ctx, nil)
+	requireT.NoError(err)
+	requireT.Len(failedItems, 2)
+	requireT.Equal(height+1, failedItems[0].ExecutionHeight)
+	requireT.Equal(height+2, failedItems[1].ExecutionHeight)
+	requireT.Equal(failedItems[0].Item.ExecutionTime, failedItems[1].Item.ExecutionTime)
+
+	requireT.NoError(delayKeeper.DiscardFailedItem(ctx, authority, "delayed-id-1"))
+	failedItems, _, err = delayKeeper.GetFailedItems(ctx, nil)
+	requireT.NoError(err)
+	requireT.Empty(failedItems)
+}
+
+func TestDefaultParams(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false, tmproto.Header{})
+
+	ctx.KVStore(testApp.GetKey(types.StoreKey)).Delete(types.ParamsKey)
+	requireT.Equal(types.DefaultParams(), testApp.DelayKeeper.GetParams(ctx))
+}
+
+func TestDelayedExecutionAtHeight(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	testApp.InterfaceRegistry().RegisterImplementations((*codec.ProtoMarshaler)(nil), &delayedItem{})
+
+	blockTime := time.Date(2023, 4, 3, 2, 3, 4, 0, time.UTC)
+	ctx := testApp.BeginNextBlock(blockTime)
+	height := uint64(ctx.BlockHeight())
+
+	delayed1 := &delayedItem{
+		Value: "value1",
+	}
+	delayed2 := &delayedItem{
+		Value: "value2",
+	}
+	delayed3 := &delayedItem{
+		Value: "value3",
+	}
+
+	delayKeeper := testApp.DelayKeeper
+
+	executedItems := []*delayedItem{}
+	requireT.NoError(delayKeeper.Router().RegisterHandler(&delayedItem{}, func(ctx sdk.Context, data proto.Message) error {
+		executedItems = append(executedItems, data.(*delayedItem))
+		return nil
+	}))
+
+	// the height must be in the future
+	requireT.ErrorIs(delayKeeper.DelayExecutionAtHeight(ctx, "delayed-id-1", delayed1, height), types.ErrInvalidInput)
+
+	requireT.NoError(delayKeeper.DelayExecutionAtHeight(ctx, "delayed-id-1", delayed1, height+1))
+	// same id and height fails
+	requireT.Error(delayKeeper.DelayExecutionAtHeight(ctx, "delayed-id-1", delayed1, height+1))
+	requireT.NoError(delayKeeper.DelayExecutionAtHeight(ctx, "delayed-id-2", delayed2, height+2))
+	requireT.NoError(delayKeeper.DelayExecutionAtHeight(ctx, "delayed-id-3", delayed3, height+3))
+	// the item scheduled by time is executed first
+	requireT.NoError(delayKeeper.DelayExecution(ctx, "delayed-id-4", delayed3, time.Hour))
+
+	delayedItems, err := delayKeeper.ExportDelayedItemsAtHeight(ctx)
+	requireT.NoError(err)
+	requireT.Equal([]types.DelayedItemAtHeight{
+		{
+			Id:              "delayed-id-1",
+			ExecutionHeight: height + 1,
+			Data:            newAny(requireT, delayed1),
+		},
+		{
+			Id:              "delayed-id-2",
+			ExecutionHeight: height + 2,
+			Data:            newAny(requireT, delayed2),
+		},
+		{
+			Id:              "delayed-id-3",
+			ExecutionHeight: height + 3,
+			Data:            newAny(requireT, delayed3),
+		},
+	}, delayedItems)
+
+	byIDItems, err := delayKeeper.GetDelayedItemsAtHeightByID(ctx, "delayed-id-2")
+	requireT.NoError(err)
+	requireT.Equal(delayedItems[1:2], byIDItems)
+
+	// the time doesn't matter, the height does
+	testApp.EndBlockAndCommit(ctx)
+	ctx = testApp.BeginNextBlock(blockTime)
+	requireT.Equal([]*delayedItem{delayed1}, executedItems)
+
+	requireT.NoError(delayKeeper.RemoveDelayedExecution(ctx, "delayed-id-2"))
+
+	// only the item scheduled by time is executed
+	testApp.EndBlockAndCommit(ctx)
+	ctx = testApp.BeginNextBlock(blockTime.Add(time.Hour))
+	requireT.Equal([]*delayedItem{delayed1, delayed3}, executedItems)
+
+	testApp.EndBlockAndCommit(ctx)
+	ctx = testApp.BeginNextBlock(blockTime.Add(time.Hour))
+	requireT.Equal([]*delayedItem{delayed1, delayed3, delayed3}, executedItems)
+
+	delayedItems, err = delayKeeper.ExportDelayedItemsAtHeight(ctx)
+	requireT.NoError(err)
+	requireT.Empty(delayedItems)
+}
+
 func newAny(requireT *require.Assertions, data codec.ProtoMarshaler) *codectypes.Any {
 	v, err := codectypes.NewAnyWithValue(data)
 	requireT.NoError(err)
@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CoreumFoundation/coreum/v3/x/delay/types"
+)
+
+var _ types.MsgServer = MsgServer{}
+
+// MsgKeeper defines subscope of keeper methods required by msg service.
+type MsgKeeper interface {
+	RetryFailedItem(ctx sdk.Context, authority, id string) error
+	DiscardFailedItem(ctx sdk.Context, authority, id string) error
+	UpdateParams(ctx sdk.Context, authority string, params types.Params) error
+}
+
+// MsgServer serves grpc tx requests for the module.
+type MsgServer struct {
+	keeper MsgKeeper
+}
+
+// NewMsgServer returns a new instance of the MsgServer.
+func NewMsgServer(keeper MsgKeeper) MsgServer {
+	return MsgServer{
+		keeper: keeper,
+	}
+}
+
+// RetryFailedItem is a governance operation executing again the failed delayed items.
+func (ms MsgServer) RetryFailedItem(ctx context.Context, req *types.MsgRetryFailedItem) (*types.EmptyResponse, error) {
+	if err := ms.keeper.RetryFailedItem(sdk.UnwrapSDKContext(ctx), req.Authority, req.Id); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// DiscardFailedItem is a governance operation removing the failed delayed items.
+func (ms MsgServer) DiscardFailedItem(ctx context.Context, req *types.MsgDiscardFailedItem) (*types.EmptyResponse, error) {
+	if err := ms.keeper.DiscardFailedItem(sdk.UnwrapSDKContext(ctx), req.Authority, req.Id); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// UpdateParams is a governance operation that sets parameters of the module.
+func (ms MsgServer) UpdateParams(ctx context.Context, req *types.MsgUpdateParams) (*types.EmptyResponse, error) {
+	if err := ms.keeper.UpdateParams(sdk.UnwrapSDKContext(ctx), req.Authority, req.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
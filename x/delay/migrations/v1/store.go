@@ -0,0 +1,37 @@
+package v1
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pkg/errors"
+
+	"github.com/CoreumFoundation/coreum/v3/x/delay/types"
+)
+
+// MigrateStore migrates delay module state from v1 to v2.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey) error {
+	// the index of delayed items by id is introduced:
+	// prefix (0x02) || len(id) || id || execution time
+	moduleStore := ctx.KVStore(storeKey)
+	itemStore := prefix.NewStore(moduleStore, types.DelayedItemKeyPrefix)
+
+	itemStoreIter := itemStore.Iterator(nil, nil)
+	defer itemStoreIter.Close()
+
+	for ; itemStoreIter.Valid(); itemStoreIter.Next() {
+		execTime, id, err := types.ExtractTimeAndIDFromDelayedItemKey(itemStoreIter.Key())
+		if err != nil {
+			return errors.Errorf("can't parse delayed item key %x, err: %s", itemStoreIter.Key(), err)
+		}
+
+		idKey, err := types.CreateDelayedItemIDKey(id, execTime)
+		if err != nil {
+			return errors.Errorf("can't create delayed item id key for %s, err: %s", id, err)
+		}
+
+		moduleStore.Set(idKey, types.StoreTrue)
+	}
+
+	return nil
+}
@@ -0,0 +1,55 @@
+package v1_test
+
+import (
+	"testing"
+	"time"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreum/v3/testutil/simapp"
+	assetfttypes "github.com/CoreumFoundation/coreum/v3/x/asset/ft/types"
+	v1 "github.com/CoreumFoundation/coreum/v3/x/delay/migrations/v1"
+	"github.com/CoreumFoundation/coreum/v3/x/delay/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false, tmproto.Header{})
+
+	storeKey := testApp.GetKey(types.ModuleName)
+	moduleStore := ctx.KVStore(storeKey)
+
+	executionTime := time.Date(2023, 4, 3, 2, 3, 4, 0, time.UTC)
+	data, err := codectypes.NewAnyWithValue(&assetfttypes.DelayedTokenUpgradeV1{
+		Denom: "denom",
+	})
+	requireT.NoError(err)
+
+	// v1 stores the item without the index
+	key, err := types.CreateDelayedItemKey("delayed-id", executionTime)
+	requireT.NoError(err)
+	moduleStore.Set(key, testApp.AppCodec().MustMarshal(data))
+
+	delayedItems, err := testApp.DelayKeeper.GetDelayedItemsByID(ctx, "delayed-id")
+	requireT.NoError(err)
+	requireT.Empty(delayedItems)
+
+	requireT.NoError(v1.MigrateStore(ctx, storeKey))
+
+	delayedItems, err = testApp.DelayKeeper.GetDelayedItemsByID(ctx, "delayed-id")
+	requireT.NoError(err)
+	requireT.Len(delayedItems, 1)
+	requireT.Equal("delayed-id", delayedItems[0].Id)
+	requireT.Equal(executionTime, delayedItems[0].ExecutionTime)
+	requireT.Equal(data.TypeUrl, delayedItems[0].Data.TypeUrl)
+
+	// now the item might be removed by id
+	requireT.NoError(testApp.DelayKeeper.RemoveDelayedExecution(ctx, "delayed-id"))
+	exportedItems, err := testApp.DelayKeeper.ExportDelayedItems(ctx)
+	requireT.NoError(err)
+	requireT.Empty(exportedItems)
+}
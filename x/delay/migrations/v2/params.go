@@ -0,0 +1,17 @@
+package v2
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CoreumFoundation/coreum/v3/x/delay/types"
+)
+
+// ParamsKeeper specifies expected methods of params keeper.
+type ParamsKeeper interface {
+	SetParams(ctx sdk.Context, params types.Params) error
+}
+
+// MigrateParams sets the default params introduced in v3.
+func MigrateParams(ctx sdk.Context, keeper ParamsKeeper) error {
+	return keeper.SetParams(ctx, types.DefaultParams())
+}
@@ -0,0 +1,25 @@
+package v2_test
+
+import (
+	"testing"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreum/v3/testutil/simapp"
+	v2 "github.com/CoreumFoundation/coreum/v3/x/delay/migrations/v2"
+	"github.com/CoreumFoundation/coreum/v3/x/delay/types"
+)
+
+func TestMigrateParams(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+	ctx := testApp.NewContext(false, tmproto.Header{})
+
+	// params are not stored before the migration
+	ctx.KVStore(testApp.GetKey(types.StoreKey)).Delete(types.ParamsKey)
+
+	requireT.NoError(v2.MigrateParams(ctx, testApp.DelayKeeper))
+	requireT.Equal(types.DefaultParams(), testApp.DelayKeeper.GetParams(ctx))
+}
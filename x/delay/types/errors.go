@@ -11,4 +11,8 @@ var (
 	ErrInvalidInput = sdkerrors.Register(ModuleName, 2, "invalid input")
 	// ErrInvalidConfiguration is returned when something is wrong with the configuration.
 	ErrInvalidConfiguration = sdkerrors.Register(ModuleName, 3, "invalid configuration")
+	// ErrInvalidState is returned when the stored state is inconsistent.
+	ErrInvalidState = sdkerrors.Register(ModuleName, 4, "invalid state")
+	// ErrNotFound is returned when the delayed item is not found.
+	ErrNotFound = sdkerrors.Register(ModuleName, 5, "not found")
 )
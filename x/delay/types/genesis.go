@@ -1,14 +1,31 @@
 package types
 
-import "errors"
+import (
+	"errors"
+
+	sdkerrors "cosmossdk.io/errors"
+)
 
 // Validate performs basic genesis state validation returning an error upon any failure.
 func (gs GenesisState) Validate() error {
+	if err := gs.Params.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "invalid params")
+	}
 	for _, di := range gs.DelayedItems {
 		if err := di.Validate(); err != nil {
 			return err
 		}
 	}
+	for _, di := range gs.DelayedItemsAtHeight {
+		if err := di.Validate(); err != nil {
+			return err
+		}
+	}
+	for _, fi := range gs.FailedItems {
+		if err := fi.Item.Validate(); err != nil {
+			return sdkerrors.Wrapf(err, "invalid failed item %s", fi.Item.Id)
+		}
+	}
 	return nil
 }
 
@@ -25,3 +42,17 @@ func (di DelayedItem) Validate() error {
 	}
 	return nil
 }
+
+// Validate checks all the fields are valid.
+func (di DelayedItemAtHeight) Validate() error {
+	if di.Id == "" {
+		return errors.New("id is empty")
+	}
+	if di.Data == nil {
+		return errors.New("data is nil")
+	}
+	if di.ExecutionHeight == 0 {
+		return errors.New("execution height must be positive")
+	}
+	return nil
+}
@@ -32,6 +32,12 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	// tokens keep the fungible token state
 	DelayedItems []DelayedItem `protobuf:"bytes,1,rep,name=delayed_items,json=delayedItems,proto3" json:"delayed_items"`
+	// params defines all the parameters of the module.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+	// failed_items keep the delayed items which failed to be executed.
+	FailedItems []FailedItem `protobuf:"bytes,3,rep,name=failed_items,json=failedItems,proto3" json:"failed_items"`
+	// delayed_items_at_height keep the items scheduled to be executed at the block height.
+	DelayedItemsAtHeight []DelayedItemAtHeight `protobuf:"bytes,4,rep,name=delayed_items_at_height,json=delayedItemsAtHeight,proto3" json:"delayed_items_at_height"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -74,6 +80,27 @@ func (m *GenesisState) GetDelayedItems() []DelayedItem {
 	return nil
 }
 
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetFailedItems() []FailedItem {
+	if m != nil {
+		return m.FailedItems
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDelayedItemsAtHeight() []DelayedItemAtHeight {
+	if m != nil {
+		return m.DelayedItemsAtHeight
+	}
+	return nil
+}
+
 type DelayedItem struct {
 	Id            string     `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	ExecutionTime time.Time  `protobuf:"bytes,2,opt,name=execution_time,json=executionTime,proto3,stdtime" json:"execution_time"`
@@ -134,36 +161,172 @@ func (m *DelayedItem) GetData() *types.Any {
 	return nil
 }
 
+// DelayedItemAtHeight is the item scheduled to be executed at the block height.
+type DelayedItemAtHeight struct {
+	Id              string     `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ExecutionHeight uint64     `protobuf:"varint,2,opt,name=execution_height,json=executionHeight,proto3" json:"execution_height,omitempty"`
+	Data            *types.Any `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *DelayedItemAtHeight) Reset()         { *m = DelayedItemAtHeight{} }
+func (m *DelayedItemAtHeight) String() string { return proto.CompactTextString(m) }
+func (*DelayedItemAtHeight) ProtoMessage()    {}
+func (*DelayedItemAtHeight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_97754df78b5c97b3, []int{2}
+}
+func (m *DelayedItemAtHeight) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelayedItemAtHeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelayedItemAtHeight.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DelayedItemAtHeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelayedItemAtHeight.Merge(m, src)
+}
+func (m *DelayedItemAtHeight) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelayedItemAtHeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelayedItemAtHeight.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelayedItemAtHeight proto.InternalMessageInfo
+
+func (m *DelayedItemAtHeight) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *DelayedItemAtHeight) GetExecutionHeight() uint64 {
+	if m != nil {
+		return m.ExecutionHeight
+	}
+	return 0
+}
+
+func (m *DelayedItemAtHeight) GetData() *types.Any {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+// FailedItem is the delayed item which failed to be executed.
+type FailedItem struct {
+	Item DelayedItem `protobuf:"bytes,1,opt,name=item,proto3" json:"item"`
+	// error is the error returned by the handler of the item.
+	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	// execution_height is the height the item was scheduled for, if it was scheduled by the block height.
+	// In that case, the execution time of the item is the time of the block the item failed in.
+	ExecutionHeight uint64 `protobuf:"varint,3,opt,name=execution_height,json=executionHeight,proto3" json:"execution_height,omitempty"`
+}
+
+func (m *FailedItem) Reset()         { *m = FailedItem{} }
+func (m *FailedItem) String() string { return proto.CompactTextString(m) }
+func (*FailedItem) ProtoMessage()    {}
+func (*FailedItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_97754df78b5c97b3, []int{3}
+}
+func (m *FailedItem) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FailedItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FailedItem.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FailedItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FailedItem.Merge(m, src)
+}
+func (m *FailedItem) XXX_Size() int {
+	return m.Size()
+}
+func (m *FailedItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_FailedItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FailedItem proto.InternalMessageInfo
+
+func (m *FailedItem) GetItem() DelayedItem {
+	if m != nil {
+		return m.Item
+	}
+	return DelayedItem{}
+}
+
+func (m *FailedItem) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *FailedItem) GetExecutionHeight() uint64 {
+	if m != nil {
+		return m.ExecutionHeight
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "coreum.delay.v1.GenesisState")
 	proto.RegisterType((*DelayedItem)(nil), "coreum.delay.v1.DelayedItem")
+	proto.RegisterType((*DelayedItemAtHeight)(nil), "coreum.delay.v1.DelayedItemAtHeight")
+	proto.RegisterType((*FailedItem)(nil), "coreum.delay.v1.FailedItem")
 }
 
 func init() { proto.RegisterFile("coreum/delay/v1/genesis.proto", fileDescriptor_97754df78b5c97b3) }
 
 var fileDescriptor_97754df78b5c97b3 = []byte{
-	// 328 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x91, 0xcd, 0x4e, 0x02, 0x31,
-	0x14, 0x85, 0xa7, 0x40, 0x8c, 0x96, 0x1f, 0x93, 0x09, 0x8b, 0x91, 0xe8, 0x40, 0x58, 0xcd, 0xaa,
-	0x0d, 0xf0, 0x04, 0xa2, 0x91, 0x18, 0xe3, 0x66, 0x34, 0x31, 0x71, 0x43, 0x0a, 0xbd, 0x8e, 0x4d,
-	0x98, 0x29, 0xa1, 0x1d, 0xc2, 0xbc, 0x05, 0x0b, 0x1f, 0x8a, 0x25, 0x4b, 0x57, 0x6a, 0xe0, 0x45,
-	0xcc, 0xb4, 0xa0, 0x06, 0x77, 0x6d, 0xcf, 0xd7, 0x7b, 0xce, 0xcd, 0xc1, 0x17, 0x63, 0x39, 0x83,
-	0x34, 0xa6, 0x1c, 0x26, 0x2c, 0xa3, 0xf3, 0x0e, 0x8d, 0x20, 0x01, 0x25, 0x14, 0x99, 0xce, 0xa4,
-	0x96, 0xee, 0xa9, 0x95, 0x89, 0x91, 0xc9, 0xbc, 0xd3, 0xa8, 0x47, 0x32, 0x92, 0x46, 0xa3, 0xf9,
-	0xc9, 0x62, 0x8d, 0x66, 0x24, 0x65, 0x34, 0x01, 0x6a, 0x6e, 0xa3, 0xf4, 0x85, 0x6a, 0x11, 0x83,
-	0xd2, 0x2c, 0x9e, 0xee, 0x80, 0xb3, 0x43, 0x80, 0x25, 0x99, 0x95, 0xda, 0x4f, 0xb8, 0x32, 0xb0,
-	0x9e, 0x0f, 0x9a, 0x69, 0x70, 0x07, 0xb8, 0x6a, 0xdc, 0x80, 0x0f, 0x85, 0x86, 0x58, 0x79, 0xa8,
-	0x55, 0x0c, 0xca, 0xdd, 0x73, 0x72, 0x10, 0x85, 0x5c, 0x5b, 0xea, 0x56, 0x43, 0xdc, 0x2f, 0xad,
-	0x3e, 0x9a, 0x4e, 0x58, 0xe1, 0xbf, 0x4f, 0xaa, 0xfd, 0x86, 0x70, 0xf9, 0x0f, 0xe3, 0xd6, 0x70,
-	0x41, 0x70, 0x0f, 0xb5, 0x50, 0x70, 0x12, 0x16, 0x04, 0x77, 0xef, 0x70, 0x0d, 0x16, 0x30, 0x4e,
-	0xb5, 0x90, 0xc9, 0x30, 0x0f, 0xec, 0x15, 0x5a, 0x28, 0x28, 0x77, 0x1b, 0xc4, 0x86, 0x25, 0xfb,
-	0xb0, 0xe4, 0x71, 0xbf, 0x4d, 0xff, 0x38, 0xf7, 0x59, 0x7e, 0x36, 0x51, 0x58, 0xfd, 0xf9, 0x9b,
-	0xab, 0x6e, 0x80, 0x4b, 0x9c, 0x69, 0xe6, 0x15, 0xcd, 0x88, 0xfa, 0xbf, 0x11, 0x97, 0x49, 0x16,
-	0x1a, 0xa2, 0x7f, 0xbf, 0xda, 0xf8, 0x68, 0xbd, 0xf1, 0xd1, 0xd7, 0xc6, 0x47, 0xcb, 0xad, 0xef,
-	0xac, 0xb7, 0xbe, 0xf3, 0xbe, 0xf5, 0x9d, 0xe7, 0x5e, 0x24, 0xf4, 0x6b, 0x3a, 0x22, 0x63, 0x19,
-	0xd3, 0x2b, 0xb3, 0xec, 0x8d, 0x4c, 0x13, 0xce, 0x72, 0x13, 0xba, 0xeb, 0x69, 0xde, 0xa3, 0x8b,
-	0x5d, 0x59, 0x3a, 0x9b, 0x82, 0x1a, 0x1d, 0x19, 0x8b, 0xde, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0x9a, 0x77, 0x6f, 0x16, 0xc9, 0x01, 0x00, 0x00,
+	// 478 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x41, 0x6f, 0xd3, 0x30,
+	0x14, 0xae, 0xdb, 0x30, 0x31, 0xb7, 0xdb, 0x90, 0xa9, 0xb4, 0x50, 0x46, 0x5a, 0x55, 0x1c, 0xca,
+	0xc5, 0xd6, 0x56, 0xc1, 0x7d, 0x65, 0xda, 0x40, 0x08, 0x09, 0x05, 0x4e, 0x5c, 0x2a, 0xb7, 0x71,
+	0x53, 0x4b, 0x4d, 0x5c, 0x25, 0x4e, 0xb5, 0x72, 0xe7, 0xbe, 0x03, 0xff, 0x85, 0xbf, 0xb0, 0xe3,
+	0x8e, 0x9c, 0x00, 0xb5, 0x7f, 0x04, 0xe5, 0xc5, 0x4d, 0x4b, 0x53, 0x4d, 0xbb, 0xc5, 0xfe, 0xbe,
+	0xef, 0x7d, 0xdf, 0x7b, 0x2f, 0xc6, 0x2f, 0x86, 0x2a, 0x12, 0x49, 0xc0, 0x3c, 0x31, 0xe1, 0x73,
+	0x36, 0x3b, 0x65, 0xbe, 0x08, 0x45, 0x2c, 0x63, 0x3a, 0x8d, 0x94, 0x56, 0xe4, 0x28, 0x83, 0x29,
+	0xc0, 0x74, 0x76, 0xda, 0xa8, 0xfb, 0xca, 0x57, 0x80, 0xb1, 0xf4, 0x2b, 0xa3, 0x35, 0x9a, 0xbe,
+	0x52, 0xfe, 0x44, 0x30, 0x38, 0x0d, 0x92, 0x11, 0xd3, 0x32, 0x10, 0xb1, 0xe6, 0xc1, 0xd4, 0x10,
+	0x9e, 0x6d, 0x13, 0x78, 0x38, 0x37, 0xd0, 0xc9, 0x76, 0x82, 0x29, 0x8f, 0x78, 0x60, 0x02, 0xb4,
+	0x7f, 0x96, 0x71, 0xed, 0x2a, 0x8b, 0xf4, 0x59, 0x73, 0x2d, 0xc8, 0x15, 0x3e, 0x00, 0xa6, 0xf0,
+	0xfa, 0x52, 0x8b, 0x20, 0xb6, 0x51, 0xab, 0xd2, 0xa9, 0x9e, 0x9d, 0xd0, 0xad, 0xa4, 0xf4, 0x22,
+	0x63, 0xbd, 0xd7, 0x22, 0xe8, 0x59, 0xb7, 0xbf, 0x9b, 0x25, 0xb7, 0xe6, 0xad, 0xaf, 0x62, 0xf2,
+	0x1a, 0xef, 0x65, 0x4e, 0x76, 0xb9, 0x85, 0x3a, 0xd5, 0xb3, 0xe3, 0x42, 0x85, 0x4f, 0x00, 0x1b,
+	0xb1, 0x21, 0x93, 0x0b, 0x5c, 0x1b, 0x71, 0x39, 0xc9, 0xed, 0x2b, 0x60, 0xff, 0xbc, 0x20, 0xbe,
+	0x04, 0xd2, 0x86, 0x7b, 0x75, 0x94, 0xdf, 0xc4, 0x84, 0xe3, 0xe3, 0xff, 0xba, 0xe8, 0x73, 0xdd,
+	0x1f, 0x0b, 0xe9, 0x8f, 0xb5, 0x6d, 0x41, 0xc1, 0x97, 0xf7, 0xf5, 0x73, 0xae, 0xdf, 0x01, 0xd7,
+	0x54, 0xae, 0x6f, 0xf6, 0xb5, 0xc2, 0xda, 0x3f, 0x10, 0xae, 0x6e, 0x68, 0xc8, 0x21, 0x2e, 0x4b,
+	0xcf, 0x46, 0x2d, 0xd4, 0xd9, 0x77, 0xcb, 0xd2, 0x23, 0x1f, 0xf0, 0xa1, 0xb8, 0x16, 0xc3, 0x44,
+	0x4b, 0x15, 0xf6, 0xd3, 0x7d, 0x99, 0x39, 0x34, 0x68, 0xb6, 0x2b, 0xba, 0xda, 0x15, 0xfd, 0xb2,
+	0x5a, 0x66, 0xef, 0x71, 0xea, 0x77, 0xf3, 0xa7, 0x89, 0xdc, 0x83, 0x5c, 0x9b, 0xa2, 0xa4, 0x83,
+	0x2d, 0x8f, 0x6b, 0x6e, 0x57, 0xa0, 0x44, 0xbd, 0x50, 0xe2, 0x3c, 0x9c, 0xbb, 0xc0, 0x68, 0x7f,
+	0xc3, 0x4f, 0x77, 0x74, 0x52, 0x48, 0xf7, 0x0a, 0x3f, 0x59, 0xa7, 0x33, 0x93, 0x49, 0xf3, 0x59,
+	0xee, 0x51, 0x7e, 0x6f, 0xa4, 0x0f, 0xf7, 0xfe, 0x8e, 0x30, 0x5e, 0xef, 0x85, 0xbc, 0xc1, 0x56,
+	0x3a, 0x7c, 0x70, 0x7d, 0xd8, 0x1f, 0x04, 0x7c, 0x52, 0xc7, 0x8f, 0x44, 0x14, 0xa9, 0x08, 0x02,
+	0xed, 0xbb, 0xd9, 0x61, 0x67, 0xe2, 0xca, 0xce, 0xc4, 0xbd, 0x8f, 0xb7, 0x0b, 0x07, 0xdd, 0x2d,
+	0x1c, 0xf4, 0x77, 0xe1, 0xa0, 0x9b, 0xa5, 0x53, 0xba, 0x5b, 0x3a, 0xa5, 0x5f, 0x4b, 0xa7, 0xf4,
+	0xb5, 0xeb, 0x4b, 0x3d, 0x4e, 0x06, 0x74, 0xa8, 0x02, 0xf6, 0x16, 0xe2, 0x5c, 0xaa, 0x24, 0xf4,
+	0x78, 0x2a, 0x66, 0xe6, 0xa1, 0xcc, 0xba, 0xec, 0xda, 0xbc, 0x16, 0x3d, 0x9f, 0x8a, 0x78, 0xb0,
+	0x07, 0xad, 0x76, 0xff, 0x0d, 0x00, 0xea, 0x4f, 0x05, 0x6d, 0xcc, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -186,6 +349,44 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DelayedItemsAtHeight) > 0 {
+		for iNdEx := len(m.DelayedItemsAtHeight) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelayedItemsAtHeight[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.FailedItems) > 0 {
+		for iNdEx := len(m.FailedItems) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FailedItems[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	if len(m.DelayedItems) > 0 {
 		for iNdEx := len(m.DelayedItems) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -235,12 +436,12 @@ func (m *DelayedItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x1a
 	}
-	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ExecutionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExecutionTime):])
-	if err2 != nil {
-		return 0, err2
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ExecutionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExecutionTime):])
+	if err3 != nil {
+		return 0, err3
 	}
-	i -= n2
-	i = encodeVarintGenesis(dAtA, i, uint64(n2))
+	i -= n3
+	i = encodeVarintGenesis(dAtA, i, uint64(n3))
 	i--
 	dAtA[i] = 0x12
 	if len(m.Id) > 0 {
@@ -253,6 +454,98 @@ func (m *DelayedItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DelayedItemAtHeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelayedItemAtHeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelayedItemAtHeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Data != nil {
+		{
+			size, err := m.Data.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ExecutionHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ExecutionHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FailedItem) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FailedItem) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FailedItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExecutionHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ExecutionHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Item.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -276,6 +569,20 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.FailedItems) > 0 {
+		for _, e := range m.FailedItems {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DelayedItemsAtHeight) > 0 {
+		for _, e := range m.DelayedItemsAtHeight {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -298,6 +605,44 @@ func (m *DelayedItem) Size() (n int) {
 	return n
 }
 
+func (m *DelayedItemAtHeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.ExecutionHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.ExecutionHeight))
+	}
+	if m.Data != nil {
+		l = m.Data.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *FailedItem) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Item.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.ExecutionHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.ExecutionHeight))
+	}
+	return n
+}
+
 func sovGenesis(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -367,26 +712,127 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedItems", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FailedItems = append(m.FailedItems, FailedItem{})
+			if err := m.FailedItems[len(m.FailedItems)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelayedItemsAtHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelayedItemsAtHeight = append(m.DelayedItemsAtHeight, DelayedItemAtHeight{})
+			if err := m.DelayedItemsAtHeight[len(m.DelayedItemsAtHeight)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
 func (m *DelayedItem) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
@@ -539,6 +985,277 @@ func (m *DelayedItem) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *DelayedItemAtHeight) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelayedItemAtHeight: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelayedItemAtHeight: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionHeight", wireType)
+			}
+			m.ExecutionHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutionHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Data == nil {
+				m.Data = &types.Any{}
+			}
+			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FailedItem) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FailedItem: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FailedItem: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Item", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Item.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionHeight", wireType)
+			}
+			m.ExecutionHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutionHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGenesis(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
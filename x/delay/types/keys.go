@@ -20,10 +20,29 @@ const (
 	RouterKey = ModuleName
 )
 
-// DelayedItemKeyPrefix defines the key prefix for the delayed item.
-var DelayedItemKeyPrefix = []byte{0x01}
+var (
+	// DelayedItemKeyPrefix defines the key prefix for the delayed item.
+	DelayedItemKeyPrefix = []byte{0x01}
+	// DelayedItemIDKeyPrefix defines the key prefix for the index of delayed items by id.
+	DelayedItemIDKeyPrefix = []byte{0x02}
+	// FailedItemKeyPrefix defines the key prefix for the delayed items which failed to be executed.
+	FailedItemKeyPrefix = []byte{0x03}
+	// ParamsKey defines the key to store parameters of the module, set via governance.
+	ParamsKey = []byte{0x04}
+	// DelayedItemHeightKeyPrefix defines the key prefix for the delayed item scheduled at the block height.
+	DelayedItemHeightKeyPrefix = []byte{0x05}
+	// DelayedItemHeightIDKeyPrefix defines the key prefix for the index of delayed items scheduled at the block height
+	// by id.
+	DelayedItemHeightIDKeyPrefix = []byte{0x06}
+)
+
+// StoreTrue keeps a value used by stores to indicate that key is present.
+var StoreTrue = []byte{0x01}
 
-const timestampLength = 8
+const (
+	timestampLength = 8
+	heightLength    = 8
+)
 
 // CreateDelayedItemKey creates key for delayed item.
 func CreateDelayedItemKey(id string, t time.Time) ([]byte, error) {
@@ -36,11 +55,136 @@ func CreateDelayedItemKey(id string, t time.Time) ([]byte, error) {
 		return nil, sdkerrors.Wrap(ErrInvalidInput, "unix timestamp of the execution time must be non-negative")
 	}
 
-	key := make([]byte, timestampLength)
-	// big endian is used to be sure that results are sortable lexicographically when stored messages are iterated
-	binary.BigEndian.PutUint64(key, uint64(execTime))
+	return store.JoinKeys(DelayedItemKeyPrefix, timeToBytes(execTime), []byte(id)), nil
+}
 
-	return store.JoinKeys(DelayedItemKeyPrefix, key, []byte(id)), nil
+// CreateDelayedItemIDPrefix creates the prefix of the index keys for delayed items stored under the id.
+func CreateDelayedItemIDPrefix(id string) ([]byte, error) {
+	if id == "" {
+		return nil, sdkerrors.Wrap(ErrInvalidInput, "id cannot be empty")
+	}
+
+	compositeKey, err := store.JoinKeysWithLength([]byte(id))
+	if err != nil {
+		return nil, sdkerrors.Wrapf(ErrInvalidInput, "failed to create a composite key for id %s: %s", id, err)
+	}
+
+	return store.JoinKeys(DelayedItemIDKeyPrefix, compositeKey), nil
+}
+
+// CreateDelayedItemIDKey creates the index key for delayed item.
+func CreateDelayedItemIDKey(id string, t time.Time) ([]byte, error) {
+	prefix, err := CreateDelayedItemIDPrefix(id)
+	if err != nil {
+		return nil, err
+	}
+
+	execTime := t.Unix()
+	if execTime < 0 {
+		return nil, sdkerrors.Wrap(ErrInvalidInput, "unix timestamp of the execution time must be non-negative")
+	}
+
+	return store.JoinKeys(prefix, timeToBytes(execTime)), nil
+}
+
+// CreateDelayedItemHeightKey creates key for delayed item scheduled at the block height.
+func CreateDelayedItemHeightKey(id string, height uint64) ([]byte, error) {
+	if id == "" {
+		return nil, sdkerrors.Wrap(ErrInvalidInput, "id cannot be empty")
+	}
+	if height == 0 {
+		return nil, sdkerrors.Wrap(ErrInvalidInput, "execution height must be positive")
+	}
+
+	return store.JoinKeys(DelayedItemHeightKeyPrefix, heightToBytes(height), []byte(id)), nil
+}
+
+// ExtractHeightAndIDFromDelayedItemHeightKey extracts from the key the height and ID of delayed message execution.
+func ExtractHeightAndIDFromDelayedItemHeightKey(key []byte) (uint64, string, error) {
+	if len(key) < heightLength+1 {
+		return 0, "", sdkerrors.Wrap(ErrInvalidInput, "key is too short")
+	}
+
+	return binary.BigEndian.Uint64(key[:heightLength]), string(key[heightLength:]), nil
+}
+
+// CreateDelayedItemHeightIDPrefix creates the prefix of the index keys for delayed items scheduled at the block
+// height and stored under the id.
+func CreateDelayedItemHeightIDPrefix(id string) ([]byte, error) {
+	if id == "" {
+		return nil, sdkerrors.Wrap(ErrInvalidInput, "id cannot be empty")
+	}
+
+	compositeKey, err := store.JoinKeysWithLength([]byte(id))
+	if err != nil {
+		return nil, sdkerrors.Wrapf(ErrInvalidInput, "failed to create a composite key for id %s: %s", id, err)
+	}
+
+	return store.JoinKeys(DelayedItemHeightIDKeyPrefix, compositeKey), nil
+}
+
+// CreateDelayedItemHeightIDKey creates the index key for delayed item scheduled at the block height.
+func CreateDelayedItemHeightIDKey(id string, height uint64) ([]byte, error) {
+	prefix, err := CreateDelayedItemHeightIDPrefix(id)
+	if err != nil {
+		return nil, err
+	}
+	if height == 0 {
+		return nil, sdkerrors.Wrap(ErrInvalidInput, "execution height must be positive")
+	}
+
+	return store.JoinKeys(prefix, heightToBytes(height)), nil
+}
+
+// ExtractHeightFromDelayedItemHeightIDKey extracts the execution height from the index key of the delayed item.
+// The key is expected to be stripped of the id prefix.
+func ExtractHeightFromDelayedItemHeightIDKey(key []byte) (uint64, error) {
+	if len(key) != heightLength {
+		return 0, sdkerrors.Wrap(ErrInvalidInput, "invalid key length")
+	}
+
+	return binary.BigEndian.Uint64(key), nil
+}
+
+// CreateFailedItemPrefix creates the prefix of the keys for failed items stored under the id.
+func CreateFailedItemPrefix(id string) ([]byte, error) {
+	if id == "" {
+		return nil, sdkerrors.Wrap(ErrInvalidInput, "id cannot be empty")
+	}
+
+	compositeKey, err := store.JoinKeysWithLength([]byte(id))
+	if err != nil {
+		return nil, sdkerrors.Wrapf(ErrInvalidInput, "failed to create a composite key for id %s: %s", id, err)
+	}
+
+	return store.JoinKeys(FailedItemKeyPrefix, compositeKey), nil
+}
+
+// CreateFailedItemKey creates the key for failed item.
+// The execution height is a part of the key because the items scheduled for different heights under the same id
+// might fail in the same block, so they get the same execution time. It is zero for the items scheduled by time.
+func CreateFailedItemKey(id string, t time.Time, height uint64) ([]byte, error) {
+	prefix, err := CreateFailedItemPrefix(id)
+	if err != nil {
+		return nil, err
+	}
+
+	execTime := t.Unix()
+	if execTime < 0 {
+		return nil, sdkerrors.Wrap(ErrInvalidInput, "unix timestamp of the execution time must be non-negative")
+	}
+
+	return store.JoinKeys(prefix, timeToBytes(execTime), heightToBytes(height)), nil
+}
+
+// ExtractTimeFromDelayedItemIDKey extracts the execution time from the index key of the delayed item.
+// The key is expected to be stripped of the id prefix.
+func ExtractTimeFromDelayedItemIDKey(key []byte) (time.Time, error) {
+	if len(key) != timestampLength {
+		return time.Time{}, sdkerrors.Wrap(ErrInvalidInput, "invalid key length")
+	}
+
+	return time.Unix(int64(binary.BigEndian.Uint64(key)), 0).UTC(), nil
 }
 
 // ExtractTimeAndIDFromDelayedItemKey extracts from the key the timestamp and ID of delayed message execution.
@@ -51,3 +195,22 @@ func ExtractTimeAndIDFromDelayedItemKey(key []byte) (time.Time, string, error) {
 
 	return time.Unix(int64(binary.BigEndian.Uint64(key[:timestampLength])), 0).UTC(), string(key[timestampLength:]), nil
 }
+
+// CreateDelayedItemTimePrefix creates the prefix of the keys of the delayed items executed at the unix time, stripped of
+// the delayed item key prefix. The keys are ordered by the execution time, so it is used to iterate over the time range.
+func CreateDelayedItemTimePrefix(execTime int64) []byte {
+	return timeToBytes(execTime)
+}
+
+func timeToBytes(execTime int64) []byte {
+	key := make([]byte, timestampLength)
+	// big endian is used to be sure that results are sortable lexicographically when stored messages are iterated
+	binary.BigEndian.PutUint64(key, uint64(execTime))
+	return key
+}
+
+func heightToBytes(height uint64) []byte {
+	key := make([]byte, heightLength)
+	binary.BigEndian.PutUint64(key, height)
+	return key
+}
@@ -0,0 +1,142 @@
+package types
+
+import (
+	"fmt"
+
+	sdkerrors "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/codec"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+)
+
+// Type of messages for amino.
+const (
+	TypeMsgRetryFailedItem   = "retry-failed-item"
+	TypeMsgDiscardFailedItem = "discard-failed-item"
+	TypeMsgUpdateParams      = "update-params"
+)
+
+var (
+	_ sdk.Msg            = &MsgRetryFailedItem{}
+	_ legacytx.LegacyMsg = &MsgRetryFailedItem{}
+	_ sdk.Msg            = &MsgDiscardFailedItem{}
+	_ legacytx.LegacyMsg = &MsgDiscardFailedItem{}
+	_ sdk.Msg            = &MsgUpdateParams{}
+	_ legacytx.LegacyMsg = &MsgUpdateParams{}
+)
+
+// RegisterLegacyAminoCodec registers the amino types and interfaces.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgRetryFailedItem{}, fmt.Sprintf("%s/MsgRetryFailedItem", ModuleName), nil)
+	cdc.RegisterConcrete(&MsgDiscardFailedItem{}, fmt.Sprintf("%s/MsgDiscardFailedItem", ModuleName), nil)
+	cdc.RegisterConcrete(&MsgUpdateParams{}, fmt.Sprintf("%s/MsgUpdateParams", ModuleName), nil)
+}
+
+// ValidateBasic checks that message fields are valid.
+func (m MsgRetryFailedItem) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return cosmoserrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+	if m.Id == "" {
+		return sdkerrors.Wrap(ErrInvalidInput, "id cannot be empty")
+	}
+
+	return nil
+}
+
+// GetSigners returns the required signers of this message type.
+func (m MsgRetryFailedItem) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// GetSignBytes returns sign bytes for LegacyMsg.
+func (m MsgRetryFailedItem) GetSignBytes() []byte {
+	return sdk.MustSortJSON(moduleAminoCdc.MustMarshalJSON(&m))
+}
+
+// Route returns message route for LegacyMsg.
+func (m MsgRetryFailedItem) Route() string {
+	return RouterKey
+}
+
+// Type returns message type for LegacyMsg.
+func (m MsgRetryFailedItem) Type() string {
+	return TypeMsgRetryFailedItem
+}
+
+// ValidateBasic checks that message fields are valid.
+func (m MsgDiscardFailedItem) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return cosmoserrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+	if m.Id == "" {
+		return sdkerrors.Wrap(ErrInvalidInput, "id cannot be empty")
+	}
+
+	return nil
+}
+
+// GetSigners returns the required signers of this message type.
+func (m MsgDiscardFailedItem) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// GetSignBytes returns sign bytes for LegacyMsg.
+func (m MsgDiscardFailedItem) GetSignBytes() []byte {
+	return sdk.MustSortJSON(moduleAminoCdc.MustMarshalJSON(&m))
+}
+
+// Route returns message route for LegacyMsg.
+func (m MsgDiscardFailedItem) Route() string {
+	return RouterKey
+}
+
+// Type returns message type for LegacyMsg.
+func (m MsgDiscardFailedItem) Type() string {
+	return TypeMsgDiscardFailedItem
+}
+
+// ValidateBasic checks that message fields are valid.
+func (m MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return cosmoserrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	return m.Params.ValidateBasic()
+}
+
+// GetSigners returns the required signers of this message type.
+func (m MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// GetSignBytes returns sign bytes for LegacyMsg.
+func (m MsgUpdateParams) GetSignBytes() []byte {
+	return sdk.MustSortJSON(moduleAminoCdc.MustMarshalJSON(&m))
+}
+
+// Route returns message route for LegacyMsg.
+func (m MsgUpdateParams) Route() string {
+	return RouterKey
+}
+
+// Type returns message type for LegacyMsg.
+func (m MsgUpdateParams) Type() string {
+	return TypeMsgUpdateParams
+}
+
+var (
+	amino          = codec.NewLegacyAmino()
+	moduleAminoCdc = codec.NewAminoCodec(amino)
+)
+
+func init() {
+	RegisterLegacyAminoCodec(amino)
+	cryptocodec.RegisterCrypto(amino)
+	amino.Seal()
+}
@@ -0,0 +1,23 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+)
+
+// DefaultMaxItemsPerBlock is the default maximum number of delayed items executed in a single block.
+const DefaultMaxItemsPerBlock = 100
+
+// DefaultParams returns params with default values.
+func DefaultParams() Params {
+	return Params{
+		MaxItemsPerBlock: DefaultMaxItemsPerBlock,
+	}
+}
+
+// ValidateBasic validates parameters.
+func (m Params) ValidateBasic() error {
+	if m.MaxItemsPerBlock == 0 {
+		return sdkerrors.Wrap(ErrInvalidInput, "max items per block must be positive")
+	}
+	return nil
+}
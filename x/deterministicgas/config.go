@@ -28,7 +28,9 @@ import (
 
 	assetfttypes "github.com/CoreumFoundation/coreum/v3/x/asset/ft/types"
 	assetnfttypes "github.com/CoreumFoundation/coreum/v3/x/asset/nft/types"
+	dextypes "github.com/CoreumFoundation/coreum/v3/x/dex/types"
 	cnfttypes "github.com/CoreumFoundation/coreum/v3/x/nft"
+	wnfttypes "github.com/CoreumFoundation/coreum/v3/x/wnft/types"
 )
 
 // These constants define gas for messages which have custom calculation logic.
@@ -36,6 +38,19 @@ const (
 	BankSendPerCoinGas            = 50000
 	BankMultiSendPerOperationsGas = 35000
 	AuthzExecOverhead             = 2000
+
+	AssetFTMultiMintPerEntryGas                = 31000
+	AssetFTMultiFreezePerEntryGas              = 8500
+	AssetFTMultiSetWhitelistedLimitPerEntryGas = 9000
+	AssetFTBlockAccountsPerEntryGas            = 5000
+	AssetFTUnblockAccountsPerEntryGas          = 5000
+
+	AssetNFTMintBatchPerItemGas  = 39000
+	AssetNFTBurnBatchPerItemGas  = 26000
+	AssetNFTUpdateDataGas        = 8000
+	AssetNFTUpdateDataPerByteGas = 30
+
+	NFTSendBatchPerItemGas = 25000
 )
 
 type (
@@ -78,19 +93,73 @@ func DefaultConfig() Config {
 		MsgToMsgURL(&assetfttypes.MsgGloballyFreeze{}):      constantGasFunc(5000),
 		MsgToMsgURL(&assetfttypes.MsgGloballyUnfreeze{}):    constantGasFunc(5000),
 		MsgToMsgURL(&assetfttypes.MsgSetWhitelistedLimit{}): constantGasFunc(9000),
+		MsgToMsgURL(&assetfttypes.MsgClawback{}):            constantGasFunc(15500),
+		MsgToMsgURL(&assetfttypes.MsgTransferAdmin{}):       constantGasFunc(5000),
+		MsgToMsgURL(&assetfttypes.MsgClearAdmin{}):          constantGasFunc(5000),
+		MsgToMsgURL(&assetfttypes.MsgUpdateMetadata{}):      constantGasFunc(15000),
+		MsgToMsgURL(&assetfttypes.MsgSetRateExemption{}):    constantGasFunc(5000),
+		MsgToMsgURL(&assetfttypes.MsgRemoveRateExemption{}): constantGasFunc(5000),
+		MsgToMsgURL(&assetfttypes.MsgUpdateRates{}):         constantGasFunc(15000),
+		MsgToMsgURL(&assetfttypes.MsgSetTransferLimit{}):    constantGasFunc(5000),
+		MsgToMsgURL(&assetfttypes.MsgRemoveTransferLimit{}): constantGasFunc(5000),
+		MsgToMsgURL(&assetfttypes.MsgDistribute{}):          constantGasFunc(35000),
+		MsgToMsgURL(&assetfttypes.MsgMultiMint{}): perEntryMsgGasFunc(
+			AssetFTMultiMintPerEntryGas,
+			func(m *assetfttypes.MsgMultiMint) int { return len(m.Entries) },
+		),
+		MsgToMsgURL(&assetfttypes.MsgMultiFreeze{}): perEntryMsgGasFunc(
+			AssetFTMultiFreezePerEntryGas,
+			func(m *assetfttypes.MsgMultiFreeze) int { return len(m.Entries) },
+		),
+		MsgToMsgURL(&assetfttypes.MsgMultiSetWhitelistedLimit{}): perEntryMsgGasFunc(
+			AssetFTMultiSetWhitelistedLimitPerEntryGas,
+			func(m *assetfttypes.MsgMultiSetWhitelistedLimit) int { return len(m.Entries) },
+		),
+		MsgToMsgURL(&assetfttypes.MsgBlockAccounts{}): perEntryMsgGasFunc(
+			AssetFTBlockAccountsPerEntryGas,
+			func(m *assetfttypes.MsgBlockAccounts) int { return len(m.Accounts) },
+		),
+		MsgToMsgURL(&assetfttypes.MsgUnblockAccounts{}): perEntryMsgGasFunc(
+			AssetFTUnblockAccountsPerEntryGas,
+			func(m *assetfttypes.MsgUnblockAccounts) int { return len(m.Accounts) },
+		),
+		MsgToMsgURL(&assetfttypes.MsgCreateVestingSchedule{}): constantGasFunc(35000),
 		// TODO: Reestimate when next token upgrade is prepared
 		MsgToMsgURL(&assetfttypes.MsgUpgradeTokenV1{}): constantGasFunc(25000),
+		MsgToMsgURL(&assetfttypes.MsgUpgradeToken{}):   constantGasFunc(25000),
 
 		// asset/nft
-		MsgToMsgURL(&assetnfttypes.MsgBurn{}):                     constantGasFunc(26000),
-		MsgToMsgURL(&assetnfttypes.MsgIssueClass{}):               constantGasFunc(16000),
-		MsgToMsgURL(&assetnfttypes.MsgMint{}):                     constantGasFunc(39000),
+		MsgToMsgURL(&assetnfttypes.MsgBurn{}):       constantGasFunc(26000),
+		MsgToMsgURL(&assetnfttypes.MsgRevoke{}):     constantGasFunc(26000),
+		MsgToMsgURL(&assetnfttypes.MsgIssueClass{}): constantGasFunc(16000),
+		MsgToMsgURL(&assetnfttypes.MsgMint{}):       constantGasFunc(39000),
+		MsgToMsgURL(&assetnfttypes.MsgMintBatch{}): perEntryMsgGasFunc(
+			AssetNFTMintBatchPerItemGas,
+			func(m *assetnfttypes.MsgMintBatch) int { return len(m.Items) },
+		),
+		MsgToMsgURL(&assetnfttypes.MsgBurnBatch{}): perEntryMsgGasFunc(
+			AssetNFTBurnBatchPerItemGas,
+			func(m *assetnfttypes.MsgBurnBatch) int { return len(m.Items) },
+		),
+		MsgToMsgURL(&assetnfttypes.MsgUpdateData{}): assetNFTUpdateDataMsgGasFunc(
+			AssetNFTUpdateDataGas,
+			AssetNFTUpdateDataPerByteGas,
+		),
 		MsgToMsgURL(&assetnfttypes.MsgFreeze{}):                   constantGasFunc(8000),
 		MsgToMsgURL(&assetnfttypes.MsgUnfreeze{}):                 constantGasFunc(5000),
+		MsgToMsgURL(&assetnfttypes.MsgClassFreeze{}):              constantGasFunc(8000),
+		MsgToMsgURL(&assetnfttypes.MsgClassUnfreeze{}):            constantGasFunc(5000),
 		MsgToMsgURL(&assetnfttypes.MsgAddToWhitelist{}):           constantGasFunc(7000),
 		MsgToMsgURL(&assetnfttypes.MsgRemoveFromWhitelist{}):      constantGasFunc(3500),
 		MsgToMsgURL(&assetnfttypes.MsgAddToClassWhitelist{}):      constantGasFunc(7000),
 		MsgToMsgURL(&assetnfttypes.MsgRemoveFromClassWhitelist{}): constantGasFunc(3500),
+		MsgToMsgURL(&assetnfttypes.MsgListNFT{}):                  constantGasFunc(30000),
+		MsgToMsgURL(&assetnfttypes.MsgBuyNFT{}):                   constantGasFunc(40000),
+		MsgToMsgURL(&assetnfttypes.MsgPlaceBid{}):                 constantGasFunc(30000),
+		MsgToMsgURL(&assetnfttypes.MsgCancelListing{}):            constantGasFunc(25000),
+
+		// dex
+		MsgToMsgURL(&dextypes.MsgCancelOrder{}): constantGasFunc(10000),
 
 		// authz
 		// FIXME (v47-deterministic): We need a procedure to estimate the overhead of the authz. Proposal:
@@ -129,6 +198,10 @@ func DefaultConfig() Config {
 
 		// nft
 		MsgToMsgURL(&nfttypes.MsgSend{}): constantGasFunc(25000),
+		MsgToMsgURL(&wnfttypes.MsgSendBatch{}): perEntryMsgGasFunc(
+			NFTSendBatchPerItemGas,
+			func(m *wnfttypes.MsgSendBatch) int { return len(m.Items) },
+		),
 
 		// cnft
 		// Deprecated: this will be removed in the next release alongside the cnft types.
@@ -191,6 +264,11 @@ func DefaultConfig() Config {
 			// charged by this tx type is defined as param inside module.
 			&crisistypes.MsgVerifyInvariant{},
 
+			// dex
+			// MsgPlaceOrder is defined as nondeterministic because the number of orders matched against
+			// the placed one depends on the state of the order book.
+			&dextypes.MsgPlaceOrder{},
+
 			// evidence
 			// MsgSubmitEvidence is defined as nondeterministic since we do not
 			// have any custom evidence type implemented, so it should fail on
@@ -377,6 +455,37 @@ func bankMultiSendMsgGasFunc(bankMultiSendPerOperationGas uint64) gasByMsgFunc {
 	}
 }
 
+// assetNFTUpdateDataMsgGasFunc charges for the bytes written by the update, since the data might be up to
+// the max data size.
+func assetNFTUpdateDataMsgGasFunc(updateDataGas, perByteGas uint64) gasByMsgFunc {
+	return func(msg sdk.Msg) (uint64, bool) {
+		m, ok := msg.(*assetnfttypes.MsgUpdateData)
+		if !ok {
+			return 0, false
+		}
+
+		size := len(m.URI) + len(m.URIHash)
+		if m.Data != nil {
+			size += len(m.Data.Value)
+		}
+
+		return updateDataGas + uint64(size)*perByteGas, true
+	}
+}
+
+// perEntryMsgGasFunc returns the gas func charging the gas for each entry of the message.
+// At least one entry is charged even if the message has none.
+func perEntryMsgGasFunc[T sdk.Msg](perEntryGas uint64, entriesNumFunc func(msg T) int) gasByMsgFunc {
+	return func(msg sdk.Msg) (uint64, bool) {
+		m, ok := msg.(T)
+		if !ok {
+			return 0, false
+		}
+
+		return uint64(lo.Max([]int{entriesNumFunc(m), 1})) * perEntryGas, true
+	}
+}
+
 func reportUnknownMessageMetric(msgURL MsgURL) {
 	metrics.IncrCounterWithLabels([]string{"deterministic_gas_unknown_message"}, 1, []metrics.Label{
 		{Name: "msg_name", Value: string(msgURL)},
@@ -7,6 +7,7 @@ import (
 
 	sdkmath "cosmossdk.io/math"
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/authz"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
@@ -15,7 +16,9 @@ import (
 
 	"github.com/CoreumFoundation/coreum/v3/testutil/simapp"
 	assetfttypes "github.com/CoreumFoundation/coreum/v3/x/asset/ft/types"
+	assetnfttypes "github.com/CoreumFoundation/coreum/v3/x/asset/nft/types"
 	"github.com/CoreumFoundation/coreum/v3/x/deterministicgas"
+	wnfttypes "github.com/CoreumFoundation/coreum/v3/x/wnft/types"
 )
 
 // To access private variable from github.com/cosmos/gogoproto we link it to local variable.
@@ -51,6 +54,14 @@ func TestDeterministicGas_DeterministicMessages(t *testing.T) {
 		// asset nft
 		"/coreum.asset.nft.v1.MsgUpdateParams",
 
+		// delay
+		"/coreum.delay.v1.MsgRetryFailedItem",
+		"/coreum.delay.v1.MsgDiscardFailedItem",
+		"/coreum.delay.v1.MsgUpdateParams",
+
+		// dex
+		"/coreum.dex.v1.MsgPlaceOrder",
+
 		// feemodel
 		"/coreum.feemodel.v1.MsgUpdateParams",
 
@@ -190,8 +201,8 @@ func TestDeterministicGas_DeterministicMessages(t *testing.T) {
 	// To make sure we do not increase/decrease deterministic types accidentally
 	// we assert length to be equal to exact number, so each change requires
 	// explicit adjustment of tests.
-	assert.Equal(t, 60, len(nondeterministicMsgs))
-	assert.Equal(t, 48, len(deterministicMsgs))
+	assert.Equal(t, 64, len(nondeterministicMsgs))
+	assert.Equal(t, 77, len(deterministicMsgs))
 
 	for _, sdkMsg := range deterministicMsgs {
 		sdkMsg := sdkMsg
@@ -217,10 +228,18 @@ func TestDeterministicGas_GasRequiredByMessage(t *testing.T) {
 		denom   = "ducore"
 		address = "devcore15eqsya33vx9p5zt7ad8fg3k674tlsllk3pvqp6"
 
-		assetFTIssue                 = 70000
-		bankSendPerCoinGas           = deterministicgas.BankSendPerCoinGas
-		bankMultiSendPerOperationGas = deterministicgas.BankMultiSendPerOperationsGas
-		authzMsgExecOverhead         = deterministicgas.AuthzExecOverhead
+		assetFTIssue                    = 70000
+		bankSendPerCoinGas              = deterministicgas.BankSendPerCoinGas
+		bankMultiSendPerOperationGas    = deterministicgas.BankMultiSendPerOperationsGas
+		authzMsgExecOverhead            = deterministicgas.AuthzExecOverhead
+		assetFTMultiMintPerEntryGas     = deterministicgas.AssetFTMultiMintPerEntryGas
+		assetFTMultiFreezePerEntryGas   = deterministicgas.AssetFTMultiFreezePerEntryGas
+		assetFTBlockAccountsPerEntryGas = deterministicgas.AssetFTBlockAccountsPerEntryGas
+		assetNFTMintBatchPerItemGas     = deterministicgas.AssetNFTMintBatchPerItemGas
+		assetNFTBurnBatchPerItemGas     = deterministicgas.AssetNFTBurnBatchPerItemGas
+		assetNFTUpdateDataGas           = deterministicgas.AssetNFTUpdateDataGas
+		assetNFTUpdateDataPerByteGas    = deterministicgas.AssetNFTUpdateDataPerByteGas
+		nftSendBatchPerItemGas          = deterministicgas.NFTSendBatchPerItemGas
 	)
 
 	cfg := deterministicgas.DefaultConfig()
@@ -314,6 +333,82 @@ func TestDeterministicGas_GasRequiredByMessage(t *testing.T) {
 			expectedGas:             5 * bankMultiSendPerOperationGas,
 			expectedIsDeterministic: true,
 		},
+		{
+			name:                    "assetft.MsgMultiMint: 0 entries",
+			msg:                     &assetfttypes.MsgMultiMint{},
+			expectedGas:             assetFTMultiMintPerEntryGas,
+			expectedIsDeterministic: true,
+		},
+		{
+			name: "assetft.MsgMultiMint: 3 entries",
+			msg: &assetfttypes.MsgMultiMint{
+				Entries: make([]assetfttypes.AccountCoin, 3),
+			},
+			expectedGas:             3 * assetFTMultiMintPerEntryGas,
+			expectedIsDeterministic: true,
+		},
+		{
+			name: "assetft.MsgMultiFreeze: 2 entries",
+			msg: &assetfttypes.MsgMultiFreeze{
+				Entries: make([]assetfttypes.AccountCoin, 2),
+			},
+			expectedGas:             2 * assetFTMultiFreezePerEntryGas,
+			expectedIsDeterministic: true,
+		},
+		{
+			name: "assetft.MsgBlockAccounts: 3 accounts",
+			msg: &assetfttypes.MsgBlockAccounts{
+				Accounts: make([]string, 3),
+			},
+			expectedGas:             3 * assetFTBlockAccountsPerEntryGas,
+			expectedIsDeterministic: true,
+		},
+		{
+			name:                    "assetnft.MsgMintBatch: 0 items",
+			msg:                     &assetnfttypes.MsgMintBatch{},
+			expectedGas:             assetNFTMintBatchPerItemGas,
+			expectedIsDeterministic: true,
+		},
+		{
+			name: "assetnft.MsgMintBatch: 3 items",
+			msg: &assetnfttypes.MsgMintBatch{
+				Items: make([]assetnfttypes.MintBatchItem, 3),
+			},
+			expectedGas:             3 * assetNFTMintBatchPerItemGas,
+			expectedIsDeterministic: true,
+		},
+		{
+			name: "assetnft.MsgBurnBatch: 2 items",
+			msg: &assetnfttypes.MsgBurnBatch{
+				Items: make([]assetnfttypes.BurnBatchItem, 2),
+			},
+			expectedGas:             2 * assetNFTBurnBatchPerItemGas,
+			expectedIsDeterministic: true,
+		},
+		{
+			name:                    "assetnft.MsgUpdateData: no data",
+			msg:                     &assetnfttypes.MsgUpdateData{},
+			expectedGas:             assetNFTUpdateDataGas,
+			expectedIsDeterministic: true,
+		},
+		{
+			name: "assetnft.MsgUpdateData: max data",
+			msg: &assetnfttypes.MsgUpdateData{
+				URI:     "uri",
+				URIHash: "hash",
+				Data:    &codectypes.Any{Value: make([]byte, assetnfttypes.MaxDataSize)},
+			},
+			expectedGas:             assetNFTUpdateDataGas + (7+assetnfttypes.MaxDataSize)*assetNFTUpdateDataPerByteGas,
+			expectedIsDeterministic: true,
+		},
+		{
+			name: "wnft.MsgSendBatch: 4 items",
+			msg: &wnfttypes.MsgSendBatch{
+				Items: make([]wnfttypes.SendBatchItem, 4),
+			},
+			expectedGas:             4 * nftSendBatchPerItemGas,
+			expectedIsDeterministic: true,
+		},
 		{
 			name:                    "authz.MsgExec: 0 messages",
 			msg:                     &authz.MsgExec{},
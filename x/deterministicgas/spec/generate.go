@@ -86,6 +86,17 @@ func main() {
 		BankMultiSendPerOperationsGas uint64
 		AuthzExecOverhead             uint64
 
+		AssetFTMultiMintPerEntryGas                uint64
+		AssetFTMultiFreezePerEntryGas              uint64
+		AssetFTMultiSetWhitelistedLimitPerEntryGas uint64
+		AssetFTBlockAccountsPerEntryGas            uint64
+		AssetFTUnblockAccountsPerEntryGas          uint64
+		AssetNFTMintBatchPerItemGas                uint64
+		AssetNFTBurnBatchPerItemGas                uint64
+		AssetNFTUpdateDataGas                      uint64
+		AssetNFTUpdateDataPerByteGas               uint64
+		NFTSendBatchPerItemGas                     uint64
+
 		DetermMsgsSpecialCases []deterministicgas.MsgURL
 		DetermMsgs             []determMsg
 		NonDetermMsgs          []deterministicgas.MsgURL
@@ -105,6 +116,17 @@ func main() {
 		BankMultiSendPerOperationsGas: deterministicgas.BankMultiSendPerOperationsGas,
 		AuthzExecOverhead:             deterministicgas.AuthzExecOverhead,
 
+		AssetFTMultiMintPerEntryGas:                deterministicgas.AssetFTMultiMintPerEntryGas,
+		AssetFTMultiFreezePerEntryGas:              deterministicgas.AssetFTMultiFreezePerEntryGas,
+		AssetFTMultiSetWhitelistedLimitPerEntryGas: deterministicgas.AssetFTMultiSetWhitelistedLimitPerEntryGas,
+		AssetFTBlockAccountsPerEntryGas:            deterministicgas.AssetFTBlockAccountsPerEntryGas,
+		AssetFTUnblockAccountsPerEntryGas:          deterministicgas.AssetFTUnblockAccountsPerEntryGas,
+		AssetNFTMintBatchPerItemGas:                deterministicgas.AssetNFTMintBatchPerItemGas,
+		AssetNFTBurnBatchPerItemGas:                deterministicgas.AssetNFTBurnBatchPerItemGas,
+		AssetNFTUpdateDataGas:                      deterministicgas.AssetNFTUpdateDataGas,
+		AssetNFTUpdateDataPerByteGas:               deterministicgas.AssetNFTUpdateDataPerByteGas,
+		NFTSendBatchPerItemGas:                     deterministicgas.NFTSendBatchPerItemGas,
+
 		DetermMsgsSpecialCases: determSpeicialCaseMsgURLs,
 		DetermMsgs:             determMsgs,
 		NonDetermMsgs:          nonDetermMsgURLs,
@@ -0,0 +1,158 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/CoreumFoundation/coreum/v3/pkg/config/constant"
+	"github.com/CoreumFoundation/coreum/v3/x/dex/types"
+)
+
+// GetQueryCmd returns the cli query commands for the module.
+func GetQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("Querying commands for the %s module", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		CmdQueryOrder(),
+		CmdQueryOrders(),
+		CmdQueryOrderBookOrders(),
+	)
+
+	return cmd
+}
+
+// CmdQueryOrder return the QueryOrder cobra command.
+func CmdQueryOrder() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "order [id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query order",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query order details.
+
+Example:
+$ %[1]s query %s order 1
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			orderID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return errors.Wrap(err, "invalid order id")
+			}
+
+			res, err := queryClient.Order(cmd.Context(), &types.QueryOrderRequest{
+				Id: orderID,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryOrders return the QueryOrders cobra command.
+func CmdQueryOrders() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "orders [creator]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query orders placed by the creator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query orders placed by the creator.
+
+Example:
+$ %[1]s query %s orders %s
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Orders(cmd.Context(), &types.QueryOrdersRequest{
+				Pagination: pageReq,
+				Creator:    args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "orders")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdQueryOrderBookOrders return the QueryOrderBookOrders cobra command.
+func CmdQueryOrderBookOrders() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "order-book-orders [offered_denom] [desired_denom]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query orders offering offered_denom in exchange for desired_denom",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query orders offering offered_denom in exchange for desired_denom, sorted in the execution sequence.
+
+Example:
+$ %[1]s query %s order-book-orders ucore uatom
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.OrderBookOrders(cmd.Context(), &types.QueryOrderBookOrdersRequest{
+				Pagination:   pageReq,
+				OfferedDenom: args[0],
+				DesiredDenom: args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "orders")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,143 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/CoreumFoundation/coreum/v3/x/dex/types"
+)
+
+// Flags defined on transactions.
+const (
+	OrderTypeFlag = "type"
+)
+
+// GetTxCmd returns the transaction commands for this module.
+func GetTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("%s transactions subcommands", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		CmdTxPlaceOrder(),
+		CmdTxCancelOrder(),
+	)
+
+	return cmd
+}
+
+// CmdTxPlaceOrder returns PlaceOrder cobra command.
+func CmdTxPlaceOrder() *cobra.Command {
+	allowedTypes := make([]string, 0, len(types.OrderType_name))
+	for i := 0; i < len(types.OrderType_name); i++ {
+		allowedTypes = append(allowedTypes, types.OrderType_name[int32(i)])
+	}
+	allowedTypesString := strings.Join(allowedTypes, ",")
+
+	cmd := &cobra.Command{
+		Use:   fmt.Sprintf("place-order [offered_amount] [desired_amount] --from [sender] --%s=[%s]", OrderTypeFlag, strings.Join(allowedTypes, "|")),
+		Args:  cobra.ExactArgs(2),
+		Short: "Place the order offering offered_amount in exchange for desired_amount",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Place the order offering offered_amount in exchange for desired_amount.
+For the market orders only the denom of the desired amount is taken into account.
+
+Example:
+$ %s tx %s place-order 1000ucore 250uatom --from [sender] --%s=limit
+`,
+				version.AppName, types.ModuleName, OrderTypeFlag,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			offeredAmount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return errors.Wrap(err, "invalid offered amount")
+			}
+			desiredAmount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return errors.Wrap(err, "invalid desired amount")
+			}
+
+			orderTypeString, err := cmd.Flags().GetString(OrderTypeFlag)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			orderType, ok := types.OrderType_value[orderTypeString]
+			if !ok {
+				return errors.Errorf("unknown order type '%s', allowed types: %s", orderTypeString, allowedTypesString)
+			}
+
+			msg := &types.MsgPlaceOrder{
+				Sender:        clientCtx.GetFromAddress().String(),
+				OrderType:     types.OrderType(orderType),
+				OfferedAmount: offeredAmount,
+				DesiredAmount: desiredAmount,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(OrderTypeFlag, types.OrderType_limit.String(), fmt.Sprintf("Type of the order, allowed types: %s", allowedTypesString))
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// CmdTxCancelOrder returns CancelOrder cobra command.
+func CmdTxCancelOrder() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-order [id] --from [sender]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Cancel the order",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Cancel the order.
+
+Example:
+$ %s tx %s cancel-order 1 --from [sender]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			orderID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return errors.Wrap(err, "invalid order id")
+			}
+
+			msg := &types.MsgCancelOrder{
+				Sender: clientCtx.GetFromAddress().String(),
+				ID:     orderID,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,31 @@
+package dex
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CoreumFoundation/coreum/v3/x/dex/keeper"
+	"github.com/CoreumFoundation/coreum/v3/x/dex/types"
+)
+
+// InitGenesis initializes the module's state from a provided genesis state.
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
+	k.SetOrderSequence(ctx, genState.OrderSequence)
+	for _, order := range genState.Orders {
+		if err := k.ImportOrder(ctx, order); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// ExportGenesis returns the module's exported genesis.
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	orders, err := k.GetAllOrders(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	return &types.GenesisState{
+		Orders:        orders,
+		OrderSequence: k.GetOrderSequence(ctx),
+	}
+}
@@ -0,0 +1,65 @@
+package dex_test
+
+import (
+	"testing"
+
+	"github.com/cometbft/cometbft/crypto/secp256k1"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreum/v3/testutil/simapp"
+	"github.com/CoreumFoundation/coreum/v3/x/dex"
+	"github.com/CoreumFoundation/coreum/v3/x/dex/types"
+)
+
+func TestInitAndExportGenesis(t *testing.T) {
+	requireT := require.New(t)
+
+	testApp := simapp.New()
+
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+	dexKeeper := testApp.DEXKeeper
+
+	creator := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	genState := types.GenesisState{
+		Orders: []types.Order{
+			{
+				ID:            1,
+				Creator:       creator.String(),
+				Type:          types.OrderType_limit,
+				OfferedAmount: sdk.NewInt64Coin("uaaa", 100),
+				DesiredAmount: sdk.NewInt64Coin("ubbb", 200),
+			},
+			{
+				ID:            3,
+				Creator:       creator.String(),
+				Type:          types.OrderType_limit,
+				OfferedAmount: sdk.NewInt64Coin("ubbb", 50),
+				DesiredAmount: sdk.NewInt64Coin("uaaa", 20),
+			},
+		},
+		OrderSequence: 5,
+	}
+	requireT.NoError(genState.Validate())
+
+	dex.InitGenesis(ctx, dexKeeper, genState)
+
+	order, err := dexKeeper.GetOrder(ctx, 3)
+	requireT.NoError(err)
+	requireT.Equal(genState.Orders[1], order)
+
+	// the offered amounts are locked
+	requireT.Equal(
+		sdk.NewInt64Coin("uaaa", 100).String(),
+		testApp.AssetFTKeeper.GetDEXLockedBalance(ctx, creator, "uaaa").String(),
+	)
+	requireT.Equal(
+		sdk.NewInt64Coin("ubbb", 50).String(),
+		testApp.AssetFTKeeper.GetDEXLockedBalance(ctx, creator, "ubbb").String(),
+	)
+
+	exportedGenState := dex.ExportGenesis(ctx, dexKeeper)
+	requireT.Equal(genState.OrderSequence, exportedGenState.OrderSequence)
+	requireT.ElementsMatch(genState.Orders, exportedGenState.Orders)
+}
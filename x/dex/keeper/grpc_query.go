@@ -0,0 +1,84 @@
+package keeper
+
+import (
+	"context"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/CoreumFoundation/coreum/v3/x/dex/types"
+)
+
+var _ types.QueryServer = QueryService{}
+
+// QueryKeeper defines subscope of keeper methods required by query service.
+type QueryKeeper interface {
+	GetOrder(ctx sdk.Context, orderID uint64) (types.Order, error)
+	GetOrders(ctx sdk.Context, creator sdk.AccAddress, pagination *query.PageRequest) ([]types.Order, *query.PageResponse, error)
+	GetOrderBookOrders(
+		ctx sdk.Context,
+		offeredDenom, desiredDenom string,
+		pagination *query.PageRequest,
+	) ([]types.Order, *query.PageResponse, error)
+}
+
+// QueryService serves grpc query requests for the module.
+type QueryService struct {
+	keeper QueryKeeper
+}
+
+// NewQueryService initiates the new instance of query service.
+func NewQueryService(keeper QueryKeeper) QueryService {
+	return QueryService{
+		keeper: keeper,
+	}
+}
+
+// Order queries the order by its ID.
+func (qs QueryService) Order(ctx context.Context, req *types.QueryOrderRequest) (*types.QueryOrderResponse, error) {
+	order, err := qs.keeper.GetOrder(sdk.UnwrapSDKContext(ctx), req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryOrderResponse{
+		Order: order,
+	}, nil
+}
+
+// Orders queries the orders placed by the creator.
+func (qs QueryService) Orders(ctx context.Context, req *types.QueryOrdersRequest) (*types.QueryOrdersResponse, error) {
+	creator, err := sdk.AccAddressFromBech32(req.Creator)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidInput, "invalid creator account")
+	}
+
+	orders, pageRes, err := qs.keeper.GetOrders(sdk.UnwrapSDKContext(ctx), creator, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryOrdersResponse{
+		Pagination: pageRes,
+		Orders:     orders,
+	}, nil
+}
+
+// OrderBookOrders queries the orders of the order book queue.
+func (qs QueryService) OrderBookOrders(
+	ctx context.Context,
+	req *types.QueryOrderBookOrdersRequest,
+) (*types.QueryOrderBookOrdersResponse, error) {
+	orders, pageRes, err := qs.keeper.GetOrderBookOrders(
+		sdk.UnwrapSDKContext(ctx), req.OfferedDenom, req.DesiredDenom, req.Pagination,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryOrderBookOrdersResponse{
+		Pagination: pageRes,
+		Orders:     orders,
+	}, nil
+}
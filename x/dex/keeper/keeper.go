@@ -0,0 +1,598 @@
+package keeper
+
+import (
+	"math/big"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/CoreumFoundation/coreum/v3/x/dex/types"
+)
+
+// Keeper is the dex module keeper.
+type Keeper struct {
+	cdc           codec.BinaryCodec
+	storeKey      storetypes.StoreKey
+	bankKeeper    types.BankKeeper
+	assetFTKeeper types.AssetFTKeeper
+}
+
+// NewKeeper creates a new instance of the Keeper.
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeKey storetypes.StoreKey,
+	bankKeeper types.BankKeeper,
+	assetFTKeeper types.AssetFTKeeper,
+) Keeper {
+	return Keeper{
+		cdc:           cdc,
+		storeKey:      storeKey,
+		bankKeeper:    bankKeeper,
+		assetFTKeeper: assetFTKeeper,
+	}
+}
+
+// PlaceOrder matches the order against the opposite queue of the order book and stores the not fully executed
+// remainder of the limit order. The amount offered by the stored order is locked until the order is executed
+// or cancelled. The matched orders which can't be executed because of their creators are closed.
+func (k Keeper) PlaceOrder(ctx sdk.Context, order types.Order) error {
+	if err := types.ValidateOrderAmounts(order.Type, order.OfferedAmount, order.DesiredAmount); err != nil {
+		return err
+	}
+
+	creator, err := sdk.AccAddressFromBech32(order.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(cosmoserrors.ErrInvalidAddress, "invalid creator account %s", order.Creator)
+	}
+
+	// the frozen amount and the amounts locked by the vesting schedules and the other orders can't be offered
+	if err := k.assetFTKeeper.DEXCheckSpendable(ctx, creator, order.OfferedAmount); err != nil {
+		return err
+	}
+
+	// the order which can't be executed because of its creator would be closed once it is matched
+	if err := k.assetFTKeeper.DEXCheckReceivable(ctx, creator, order.DesiredAmount); err != nil {
+		return err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventOrderPlaced{
+		Order: order,
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit EventOrderPlaced event: %s", err)
+	}
+
+	order, err = k.matchOrder(ctx, creator, order)
+	if err != nil {
+		return err
+	}
+
+	// the remainder of the market order is discarded
+	if order.Type != types.OrderType_limit || order.IsCleared() {
+		return nil
+	}
+
+	if err := k.assetFTKeeper.DEXLock(ctx, creator, order.OfferedAmount); err != nil {
+		return err
+	}
+
+	order.ID = k.nextOrderID(ctx)
+	if err := k.saveOrder(ctx, order); err != nil {
+		return err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventOrderCreated{
+		Order: order,
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit EventOrderCreated event: %s", err)
+	}
+
+	return nil
+}
+
+// CancelOrder removes the order from the order book.
+func (k Keeper) CancelOrder(ctx sdk.Context, sender sdk.AccAddress, orderID uint64) error {
+	order, err := k.GetOrder(ctx, orderID)
+	if err != nil {
+		return err
+	}
+
+	if order.Creator != sender.String() {
+		return sdkerrors.Wrapf(cosmoserrors.ErrUnauthorized, "only creator can cancel the order %d", orderID)
+	}
+
+	return k.closeOrder(ctx, order, types.OrderClosedReasonCancelled)
+}
+
+// GetOrder returns the order by its ID.
+func (k Keeper) GetOrder(ctx sdk.Context, orderID uint64) (types.Order, error) {
+	store := ctx.KVStore(k.storeKey)
+	orderBookKey := store.Get(types.CreateOrderKey(orderID))
+	if orderBookKey == nil {
+		return types.Order{}, sdkerrors.Wrapf(types.ErrOrderNotFound, "order with ID %d not found", orderID)
+	}
+
+	bz := store.Get(orderBookKey)
+	if bz == nil {
+		return types.Order{}, sdkerrors.Wrapf(types.ErrInvalidState, "order with ID %d is missing in the order book", orderID)
+	}
+
+	var order types.Order
+	if err := k.cdc.Unmarshal(bz, &order); err != nil {
+		return types.Order{}, err
+	}
+
+	return order, nil
+}
+
+// GetOrders returns the orders placed by the account.
+func (k Keeper) GetOrders(
+	ctx sdk.Context,
+	creator sdk.AccAddress,
+	pagination *query.PageRequest,
+) ([]types.Order, *query.PageResponse, error) {
+	accountPrefix, err := types.CreateAccountOrderPrefix(creator)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	orders := make([]types.Order, 0)
+	pageRes, err := query.Paginate(
+		prefix.NewStore(ctx.KVStore(k.storeKey), accountPrefix),
+		pagination,
+		func(key, _ []byte) error {
+			orderID, err := types.BytesToUint64(key)
+			if err != nil {
+				return err
+			}
+			order, err := k.GetOrder(ctx, orderID)
+			if err != nil {
+				return err
+			}
+			orders = append(orders, order)
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, nil, sdkerrors.Wrapf(types.ErrInvalidInput, "failed to paginate: %s", err)
+	}
+
+	return orders, pageRes, nil
+}
+
+// GetOrderBookOrders returns the orders offering the offered denom in exchange for the desired one, sorted
+// in the execution sequence.
+func (k Keeper) GetOrderBookOrders(
+	ctx sdk.Context,
+	offeredDenom, desiredDenom string,
+	pagination *query.PageRequest,
+) ([]types.Order, *query.PageResponse, error) {
+	queuePrefix, found := k.getQueuePrefix(ctx, offeredDenom, desiredDenom)
+	if !found {
+		return []types.Order{}, &query.PageResponse{}, nil
+	}
+
+	orders := make([]types.Order, 0)
+	pageRes, err := query.Paginate(
+		prefix.NewStore(ctx.KVStore(k.storeKey), queuePrefix),
+		pagination,
+		func(_, value []byte) error {
+			var order types.Order
+			if err := k.cdc.Unmarshal(value, &order); err != nil {
+				return err
+			}
+			orders = append(orders, order)
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, nil, sdkerrors.Wrapf(types.ErrInvalidInput, "failed to paginate: %s", err)
+	}
+
+	return orders, pageRes, nil
+}
+
+// GetAllOrders returns all the orders stored in the order books.
+func (k Keeper) GetAllOrders(ctx sdk.Context) ([]types.Order, error) {
+	iterator := prefix.NewStore(ctx.KVStore(k.storeKey), types.OrderBookKeyPrefix).Iterator(nil, nil)
+	defer iterator.Close()
+
+	orders := make([]types.Order, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var order types.Order
+		if err := k.cdc.Unmarshal(iterator.Value(), &order); err != nil {
+			return nil, err
+		}
+		orders = append(orders, order)
+	}
+
+	return orders, nil
+}
+
+// ImportOrder stores the order in the order book and locks its offered amount, it is used by the genesis import.
+func (k Keeper) ImportOrder(ctx sdk.Context, order types.Order) error {
+	if err := order.Validate(); err != nil {
+		return err
+	}
+	if ctx.KVStore(k.storeKey).Has(types.CreateOrderKey(order.ID)) {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "order with ID %d already exists", order.ID)
+	}
+
+	creator, err := sdk.AccAddressFromBech32(order.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(cosmoserrors.ErrInvalidAddress, "invalid creator account %s", order.Creator)
+	}
+	if err := k.assetFTKeeper.ImportDEXLock(ctx, creator, order.OfferedAmount); err != nil {
+		return err
+	}
+
+	return k.saveOrder(ctx, order)
+}
+
+// GetOrderSequence returns the last used order ID.
+func (k Keeper) GetOrderSequence(ctx sdk.Context) uint64 {
+	return k.getSequence(ctx, types.OrderSequenceKey)
+}
+
+// SetOrderSequence sets the last used order ID.
+func (k Keeper) SetOrderSequence(ctx sdk.Context, sequence uint64) {
+	ctx.KVStore(k.storeKey).Set(types.OrderSequenceKey, types.Uint64ToBytes(sequence))
+}
+
+func (k Keeper) matchOrder(ctx sdk.Context, taker sdk.AccAddress, order types.Order) (types.Order, error) {
+	// the orders which can't be reduced with the taker order without rounding the amount down to zero
+	skippedOrders := map[uint64]struct{}{}
+	for matchedOrders := 0; !order.IsCleared() && matchedOrders < types.MaxMatchedOrders; matchedOrders++ {
+		makerOrder, found, err := k.getTopOrder(
+			ctx, order.DesiredAmount.Denom, order.OfferedAmount.Denom, skippedOrders,
+		)
+		if err != nil {
+			return types.Order{}, err
+		}
+		if !found {
+			break
+		}
+
+		if order.Type == types.OrderType_limit && !isPriceAcceptable(order, makerOrder) {
+			break
+		}
+
+		takerCoin, makerCoin := computeReduction(order, makerOrder)
+		if !takerCoin.IsPositive() || !makerCoin.IsPositive() {
+			// The amount is too small to be executed at the maker price without rounding it down to zero,
+			// so the next order is tried.
+			skippedOrders[makerOrder.ID] = struct{}{}
+			continue
+		}
+
+		maker, err := sdk.AccAddressFromBech32(makerOrder.Creator)
+		if err != nil {
+			return types.Order{}, sdkerrors.Wrapf(types.ErrInvalidState, "invalid creator of the order %d", makerOrder.ID)
+		}
+
+		cacheCtx, writeCache := ctx.CacheContext()
+		makerFailed, err := k.exchange(cacheCtx, taker, maker, takerCoin, makerCoin)
+		if err != nil {
+			if !makerFailed {
+				return types.Order{}, err
+			}
+			// The order which can't be executed would block all the orders placed after it, so it is closed.
+			if err := k.closeOrder(ctx, makerOrder, types.OrderClosedReasonUnfillable); err != nil {
+				return types.Order{}, err
+			}
+			continue
+		}
+		writeCache()
+
+		// The price of the reduced order might change due to the rounding, so the order is stored under the new key.
+		if err := k.removeOrder(ctx, makerOrder); err != nil {
+			return types.Order{}, err
+		}
+		makerOrder.OfferedAmount = makerOrder.OfferedAmount.Sub(makerCoin)
+		makerOrder.DesiredAmount = makerOrder.DesiredAmount.Sub(takerCoin)
+		if makerOrder.IsCleared() {
+			// The amount left due to the rounding is never executed, so it is unlocked.
+			if err := k.unlockOfferedAmount(ctx, maker, makerOrder); err != nil {
+				return types.Order{}, err
+			}
+		} else {
+			if err := k.saveOrder(ctx, makerOrder); err != nil {
+				return types.Order{}, err
+			}
+		}
+
+		order.OfferedAmount = order.OfferedAmount.Sub(takerCoin)
+		if order.Type == types.OrderType_limit {
+			order.DesiredAmount = order.DesiredAmount.Sub(makerCoin)
+		}
+
+		if err := emitOrderReduced(ctx, makerOrder, makerCoin, takerCoin); err != nil {
+			return types.Order{}, err
+		}
+		if err := emitOrderReduced(ctx, order, takerCoin, makerCoin); err != nil {
+			return types.Order{}, err
+		}
+	}
+
+	return order, nil
+}
+
+// exchange executes the transfers between the taker and the maker using the bank keeper, so all the rules
+// defined for the tokens are applied. The coin sent by the maker is unlocked right before it is sent.
+// If the transfer fails, the returned flag tells if it is caused by the maker.
+func (k Keeper) exchange(
+	ctx sdk.Context,
+	taker, maker sdk.AccAddress,
+	takerCoin, makerCoin sdk.Coin,
+) (bool, error) {
+	if err := k.bankKeeper.SendCoins(ctx, taker, maker, sdk.NewCoins(takerCoin)); err != nil {
+		// the taker can't pay for the order unless the maker can't receive the coin
+		return k.assetFTKeeper.DEXCheckReceivable(ctx, maker, takerCoin) != nil, err
+	}
+	// the locked amount might be reduced by the clawback
+	if err := k.assetFTKeeper.DEXUnlock(ctx, maker, makerCoin); err != nil {
+		return true, err
+	}
+	if err := k.bankKeeper.SendCoins(ctx, maker, taker, sdk.NewCoins(makerCoin)); err != nil {
+		// the maker can't pay for the order unless the taker can't receive the coin
+		return k.assetFTKeeper.DEXCheckReceivable(ctx, taker, makerCoin) == nil, err
+	}
+
+	return false, nil
+}
+
+// isPriceAcceptable checks if the maker offers at least the amount desired by the taker at the price of the taker.
+func isPriceAcceptable(takerOrder, makerOrder types.Order) bool {
+	offered := new(big.Int).Mul(makerOrder.OfferedAmount.Amount.BigInt(), takerOrder.OfferedAmount.Amount.BigInt())
+	desired := new(big.Int).Mul(takerOrder.DesiredAmount.Amount.BigInt(), makerOrder.DesiredAmount.Amount.BigInt())
+	return offered.Cmp(desired) >= 0
+}
+
+// computeReduction returns the coins sent by the taker and the maker when the orders are reduced at the maker price.
+// The amounts are limited by the amounts offered and desired by both orders, so at least one of them is cleared.
+// Whenever the amount must be computed from the proportion, it is rounded down.
+func computeReduction(takerOrder, makerOrder types.Order) (sdk.Coin, sdk.Coin) {
+	makerCoin := makerOrder.OfferedAmount
+	if takerOrder.Type == types.OrderType_limit && takerOrder.DesiredAmount.IsLT(makerCoin) {
+		makerCoin = takerOrder.DesiredAmount
+	}
+	takerCoin := takerOrder.OfferedAmount
+	if makerOrder.DesiredAmount.IsLT(takerCoin) {
+		takerCoin = makerOrder.DesiredAmount
+	}
+
+	takerAmount := makerOrder.DesiredAmount.Amount
+	if !makerCoin.IsEqual(makerOrder.OfferedAmount) {
+		takerAmount = mulQuo(makerCoin.Amount, makerOrder.DesiredAmount.Amount, makerOrder.OfferedAmount.Amount)
+	}
+	if takerAmount.LTE(takerCoin.Amount) {
+		return sdk.NewCoin(takerCoin.Denom, takerAmount), makerCoin
+	}
+
+	makerAmount := makerOrder.OfferedAmount.Amount
+	if !takerCoin.IsEqual(makerOrder.DesiredAmount) {
+		makerAmount = mulQuo(takerCoin.Amount, makerOrder.OfferedAmount.Amount, makerOrder.DesiredAmount.Amount)
+	}
+	return takerCoin, sdk.NewCoin(makerCoin.Denom, makerAmount)
+}
+
+// mulQuo returns a * b / c rounded down, the intermediate product is not limited by the size of sdkmath.Int.
+func mulQuo(a, b, c sdkmath.Int) sdkmath.Int {
+	result := new(big.Int).Mul(a.BigInt(), b.BigInt())
+	return sdkmath.NewIntFromBigInt(result.Quo(result, c.BigInt()))
+}
+
+func emitOrderReduced(ctx sdk.Context, order types.Order, sentCoin, receivedCoin sdk.Coin) error {
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventOrderReduced{
+		ID:           order.ID,
+		Creator:      order.Creator,
+		SentCoin:     sentCoin,
+		ReceivedCoin: receivedCoin,
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit EventOrderReduced event: %s", err)
+	}
+
+	return nil
+}
+
+func (k Keeper) closeOrder(ctx sdk.Context, order types.Order, reason string) error {
+	creator, err := sdk.AccAddressFromBech32(order.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(cosmoserrors.ErrInvalidAddress, "invalid creator account %s", order.Creator)
+	}
+
+	if err := k.removeOrder(ctx, order); err != nil {
+		return err
+	}
+	if err := k.unlockOfferedAmount(ctx, creator, order); err != nil {
+		return err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventOrderClosed{
+		ID:      order.ID,
+		Creator: order.Creator,
+		Reason:  reason,
+	}); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidState, "failed to emit EventOrderClosed event: %s", err)
+	}
+
+	return nil
+}
+
+func (k Keeper) unlockOfferedAmount(ctx sdk.Context, creator sdk.AccAddress, order types.Order) error {
+	// the locked amount might be reduced by the clawback, so only the remaining part of it is unlocked
+	unlockAmount := order.OfferedAmount
+	if locked := k.assetFTKeeper.GetDEXLockedBalance(ctx, creator, unlockAmount.Denom); locked.IsLT(unlockAmount) {
+		unlockAmount = locked
+	}
+	if !unlockAmount.IsPositive() {
+		return nil
+	}
+
+	return k.assetFTKeeper.DEXUnlock(ctx, creator, unlockAmount)
+}
+
+func (k Keeper) getTopOrder(
+	ctx sdk.Context,
+	offeredDenom, desiredDenom string,
+	skippedOrders map[uint64]struct{},
+) (types.Order, bool, error) {
+	queuePrefix, found := k.getQueuePrefix(ctx, offeredDenom, desiredDenom)
+	if !found {
+		return types.Order{}, false, nil
+	}
+
+	iterator := prefix.NewStore(ctx.KVStore(k.storeKey), queuePrefix).Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var order types.Order
+		if err := k.cdc.Unmarshal(iterator.Value(), &order); err != nil {
+			return types.Order{}, false, err
+		}
+		if _, skipped := skippedOrders[order.ID]; !skipped {
+			return order, true, nil
+		}
+	}
+
+	return types.Order{}, false, nil
+}
+
+func (k Keeper) saveOrder(ctx sdk.Context, order types.Order) error {
+	creator, err := sdk.AccAddressFromBech32(order.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(cosmoserrors.ErrInvalidAddress, "invalid creator account %s", order.Creator)
+	}
+
+	orderBookKey, err := k.createOrderBookKey(ctx, order, true)
+	if err != nil {
+		return err
+	}
+	accountOrderKey, err := types.CreateAccountOrderKey(creator, order.ID)
+	if err != nil {
+		return err
+	}
+
+	bz, err := k.cdc.Marshal(&order)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(orderBookKey, bz)
+	store.Set(types.CreateOrderKey(order.ID), orderBookKey)
+	store.Set(accountOrderKey, types.StoreTrue)
+
+	return nil
+}
+
+func (k Keeper) removeOrder(ctx sdk.Context, order types.Order) error {
+	creator, err := sdk.AccAddressFromBech32(order.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(cosmoserrors.ErrInvalidAddress, "invalid creator account %s", order.Creator)
+	}
+
+	orderBookKey, err := k.createOrderBookKey(ctx, order, false)
+	if err != nil {
+		return err
+	}
+	accountOrderKey, err := types.CreateAccountOrderKey(creator, order.ID)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(orderBookKey)
+	store.Delete(types.CreateOrderKey(order.ID))
+	store.Delete(accountOrderKey)
+
+	return nil
+}
+
+func (k Keeper) createOrderBookKey(ctx sdk.Context, order types.Order, createDenomPrefixes bool) ([]byte, error) {
+	price, err := order.Price()
+	if err != nil {
+		return nil, err
+	}
+
+	var queuePrefix []byte
+	if createDenomPrefixes {
+		queuePrefix = types.CreateQueuePrefix(
+			k.getOrCreateDenomPrefix(ctx, order.OfferedAmount.Denom),
+			k.getOrCreateDenomPrefix(ctx, order.DesiredAmount.Denom),
+		)
+	} else {
+		var found bool
+		queuePrefix, found = k.getQueuePrefix(ctx, order.OfferedAmount.Denom, order.DesiredAmount.Denom)
+		if !found {
+			return nil, sdkerrors.Wrapf(types.ErrInvalidState, "denom prefixes of the order %d not found", order.ID)
+		}
+	}
+
+	return types.CreateOrderBookKey(queuePrefix, price, order.ID), nil
+}
+
+func (k Keeper) getQueuePrefix(ctx sdk.Context, offeredDenom, desiredDenom string) ([]byte, bool) {
+	offeredDenomPrefix, found := k.getDenomPrefix(ctx, offeredDenom)
+	if !found {
+		return nil, false
+	}
+	desiredDenomPrefix, found := k.getDenomPrefix(ctx, desiredDenom)
+	if !found {
+		return nil, false
+	}
+
+	return types.CreateQueuePrefix(offeredDenomPrefix, desiredDenomPrefix), true
+}
+
+func (k Keeper) getDenomPrefix(ctx sdk.Context, denom string) (uint64, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.CreateDenomPrefixKey(denom))
+	if bz == nil {
+		return 0, false
+	}
+
+	denomPrefix, err := types.BytesToUint64(bz)
+	if err != nil {
+		panic(err)
+	}
+
+	return denomPrefix, true
+}
+
+func (k Keeper) getOrCreateDenomPrefix(ctx sdk.Context, denom string) uint64 {
+	if denomPrefix, found := k.getDenomPrefix(ctx, denom); found {
+		return denomPrefix
+	}
+
+	denomPrefix := k.getSequence(ctx, types.DenomSequenceKey) + 1
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.DenomSequenceKey, types.Uint64ToBytes(denomPrefix))
+	store.Set(types.CreateDenomPrefixKey(denom), types.Uint64ToBytes(denomPrefix))
+
+	return denomPrefix
+}
+
+func (k Keeper) nextOrderID(ctx sdk.Context) uint64 {
+	orderID := k.GetOrderSequence(ctx) + 1
+	k.SetOrderSequence(ctx, orderID)
+	return orderID
+}
+
+func (k Keeper) getSequence(ctx sdk.Context, key []byte) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(key)
+	if bz == nil {
+		return 0
+	}
+
+	sequence, err := types.BytesToUint64(bz)
+	if err != nil {
+		panic(err)
+	}
+
+	return sequence
+}
@@ -0,0 +1,477 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreum/v3/pkg/config/constant"
+	"github.com/CoreumFoundation/coreum/v3/testutil/simapp"
+	assetfttypes "github.com/CoreumFoundation/coreum/v3/x/asset/ft/types"
+	"github.com/CoreumFoundation/coreum/v3/x/dex/types"
+)
+
+func TestKeeper_PlaceOrder_OrderBookSequence(t *testing.T) {
+	requireT := require.New(t)
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+	dexKeeper := testApp.DEXKeeper
+
+	creator1, _ := testApp.GenAccount(ctx)
+	creator2, _ := testApp.GenAccount(ctx)
+	requireT.NoError(testApp.FundAccount(ctx, creator1, sdk.NewCoins(sdk.NewInt64Coin("uaaa", 1000))))
+	requireT.NoError(testApp.FundAccount(ctx, creator2, sdk.NewCoins(sdk.NewInt64Coin("uaaa", 1000))))
+
+	// sell side of the uaaa/ubbb order book
+	orders := []types.Order{
+		limitOrder(creator1, sdk.NewInt64Coin("uaaa", 100), sdk.NewInt64Coin("ubbb", 2000)),
+		limitOrder(creator2, sdk.NewInt64Coin("uaaa", 50), sdk.NewInt64Coin("ubbb", 1000)),
+		limitOrder(creator1, sdk.NewInt64Coin("uaaa", 300), sdk.NewInt64Coin("ubbb", 4500)),
+		limitOrder(creator2, sdk.NewInt64Coin("uaaa", 3), sdk.NewInt64Coin("ubbb", 1)),
+	}
+	for _, order := range orders {
+		requireT.NoError(dexKeeper.PlaceOrder(ctx, order))
+	}
+	requireT.EqualValues(4, dexKeeper.GetOrderSequence(ctx))
+
+	// orders are sorted by price and then by the time of creation
+	bookOrders, _, err := dexKeeper.GetOrderBookOrders(ctx, "uaaa", "ubbb", &query.PageRequest{})
+	requireT.NoError(err)
+	requireT.Equal([]uint64{4, 3, 1, 2}, orderIDs(bookOrders))
+
+	// the opposite queue is empty
+	bookOrders, _, err = dexKeeper.GetOrderBookOrders(ctx, "ubbb", "uaaa", &query.PageRequest{})
+	requireT.NoError(err)
+	requireT.Empty(bookOrders)
+
+	creatorOrders, _, err := dexKeeper.GetOrders(ctx, creator1, &query.PageRequest{})
+	requireT.NoError(err)
+	requireT.Equal([]uint64{1, 3}, orderIDs(creatorOrders))
+
+	order, err := dexKeeper.GetOrder(ctx, 2)
+	requireT.NoError(err)
+	requireT.Equal(creator2.String(), order.Creator)
+	requireT.Equal(types.OrderType_limit, order.Type)
+	requireT.Equal(sdk.NewInt64Coin("uaaa", 50).String(), order.OfferedAmount.String())
+	requireT.Equal(sdk.NewInt64Coin("ubbb", 1000).String(), order.DesiredAmount.String())
+
+	// not enough funds
+	requireT.ErrorIs(dexKeeper.PlaceOrder(
+		ctx, limitOrder(creator1, sdk.NewInt64Coin("uaaa", 1001), sdk.NewInt64Coin("ubbb", 1)),
+	), cosmoserrors.ErrInsufficientFunds)
+
+	// price out of range
+	requireT.ErrorIs(dexKeeper.PlaceOrder(
+		ctx, limitOrder(creator1, sdk.NewInt64Coin("uaaa", 1), sdk.NewCoin("ubbb", sdkmath.NewIntFromUint64(1<<63).MulRaw(2))),
+	), types.ErrPriceOutOfRange)
+}
+
+func TestKeeper_PlaceOrder_Matching(t *testing.T) {
+	requireT := require.New(t)
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+	dexKeeper := testApp.DEXKeeper
+	bankKeeper := testApp.BankKeeper
+
+	alice, _ := testApp.GenAccount(ctx)
+	bob, _ := testApp.GenAccount(ctx)
+	requireT.NoError(testApp.FundAccount(ctx, alice, sdk.NewCoins(sdk.NewInt64Coin("uaaa", 60))))
+	requireT.NoError(testApp.FundAccount(ctx, bob, sdk.NewCoins(sdk.NewInt64Coin("ubbb", 20))))
+
+	requireT.NoError(dexKeeper.PlaceOrder(ctx, limitOrder(alice, sdk.NewInt64Coin("uaaa", 60), sdk.NewInt64Coin("ubbb", 10))))
+	requireT.NoError(dexKeeper.PlaceOrder(ctx, limitOrder(bob, sdk.NewInt64Coin("ubbb", 20), sdk.NewInt64Coin("uaaa", 30))))
+
+	// the orders are reduced at the price of alice
+	requireT.Equal("30uaaa,5ubbb", bankKeeper.GetAllBalances(ctx, alice).String())
+	requireT.Equal("30uaaa,15ubbb", bankKeeper.GetAllBalances(ctx, bob).String())
+
+	// order of bob is cleared, so it is not stored
+	requireT.EqualValues(1, dexKeeper.GetOrderSequence(ctx))
+	order, err := dexKeeper.GetOrder(ctx, 1)
+	requireT.NoError(err)
+	requireT.Equal(sdk.NewInt64Coin("uaaa", 30).String(), order.OfferedAmount.String())
+	requireT.Equal(sdk.NewInt64Coin("ubbb", 5).String(), order.DesiredAmount.String())
+
+	// price not acceptable for the order of alice
+	requireT.NoError(dexKeeper.PlaceOrder(ctx, limitOrder(bob, sdk.NewInt64Coin("ubbb", 4), sdk.NewInt64Coin("uaaa", 30))))
+	requireT.EqualValues(2, dexKeeper.GetOrderSequence(ctx))
+	requireT.Equal("30uaaa,15ubbb", bankKeeper.GetAllBalances(ctx, bob).String())
+
+	// the order of alice is cleared, the remaining part of the new order is stored
+	requireT.NoError(dexKeeper.PlaceOrder(ctx, limitOrder(bob, sdk.NewInt64Coin("ubbb", 10), sdk.NewInt64Coin("uaaa", 60))))
+	requireT.EqualValues(3, dexKeeper.GetOrderSequence(ctx))
+	requireT.Equal("10ubbb", bankKeeper.GetAllBalances(ctx, alice).String())
+	requireT.Equal("60uaaa,10ubbb", bankKeeper.GetAllBalances(ctx, bob).String())
+
+	_, err = dexKeeper.GetOrder(ctx, 1)
+	requireT.ErrorIs(err, types.ErrOrderNotFound)
+	order, err = dexKeeper.GetOrder(ctx, 3)
+	requireT.NoError(err)
+	requireT.Equal(sdk.NewInt64Coin("ubbb", 5).String(), order.OfferedAmount.String())
+	requireT.Equal(sdk.NewInt64Coin("uaaa", 30).String(), order.DesiredAmount.String())
+
+	bookOrders, _, err := dexKeeper.GetOrderBookOrders(ctx, "ubbb", "uaaa", &query.PageRequest{})
+	requireT.NoError(err)
+	requireT.Equal([]uint64{3, 2}, orderIDs(bookOrders))
+}
+
+func TestKeeper_PlaceOrder_Market(t *testing.T) {
+	requireT := require.New(t)
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+	dexKeeper := testApp.DEXKeeper
+	bankKeeper := testApp.BankKeeper
+
+	maker, _ := testApp.GenAccount(ctx)
+	taker, _ := testApp.GenAccount(ctx)
+	requireT.NoError(testApp.FundAccount(ctx, maker, sdk.NewCoins(sdk.NewInt64Coin("uaaa", 300))))
+	requireT.NoError(testApp.FundAccount(ctx, taker, sdk.NewCoins(sdk.NewInt64Coin("ubbb", 1000))))
+
+	requireT.NoError(dexKeeper.PlaceOrder(ctx, limitOrder(maker, sdk.NewInt64Coin("uaaa", 100), sdk.NewInt64Coin("ubbb", 200))))
+	requireT.NoError(dexKeeper.PlaceOrder(ctx, limitOrder(maker, sdk.NewInt64Coin("uaaa", 100), sdk.NewInt64Coin("ubbb", 100))))
+	requireT.NoError(dexKeeper.PlaceOrder(ctx, limitOrder(maker, sdk.NewInt64Coin("uaaa", 100), sdk.NewInt64Coin("ubbb", 400))))
+
+	// market order is executed at any price, starting from the best one
+	requireT.NoError(dexKeeper.PlaceOrder(ctx, types.Order{
+		Creator:       taker.String(),
+		Type:          types.OrderType_market,
+		OfferedAmount: sdk.NewInt64Coin("ubbb", 400),
+		DesiredAmount: sdk.NewInt64Coin("uaaa", 0),
+	}))
+	requireT.Equal("225uaaa,600ubbb", bankKeeper.GetAllBalances(ctx, taker).String())
+	requireT.Equal("75uaaa,400ubbb", bankKeeper.GetAllBalances(ctx, maker).String())
+
+	bookOrders, _, err := dexKeeper.GetOrderBookOrders(ctx, "uaaa", "ubbb", &query.PageRequest{})
+	requireT.NoError(err)
+	requireT.Equal([]uint64{3}, orderIDs(bookOrders))
+	requireT.Equal(sdk.NewInt64Coin("uaaa", 75).String(), bookOrders[0].OfferedAmount.String())
+	requireT.Equal(sdk.NewInt64Coin("ubbb", 300).String(), bookOrders[0].DesiredAmount.String())
+
+	// the remainder of the market order is not stored
+	requireT.NoError(dexKeeper.PlaceOrder(ctx, types.Order{
+		Creator:       taker.String(),
+		Type:          types.OrderType_market,
+		OfferedAmount: sdk.NewInt64Coin("ubbb", 600),
+		DesiredAmount: sdk.NewInt64Coin("uaaa", 0),
+	}))
+	requireT.Equal("300uaaa,300ubbb", bankKeeper.GetAllBalances(ctx, taker).String())
+	requireT.EqualValues(3, dexKeeper.GetOrderSequence(ctx))
+	bookOrders, _, err = dexKeeper.GetOrderBookOrders(ctx, "ubbb", "uaaa", &query.PageRequest{})
+	requireT.NoError(err)
+	requireT.Empty(bookOrders)
+}
+
+func TestKeeper_PlaceOrder_FundsLocking(t *testing.T) {
+	requireT := require.New(t)
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+	dexKeeper := testApp.DEXKeeper
+	bankKeeper := testApp.BankKeeper
+	ftKeeper := testApp.AssetFTKeeper
+
+	maker, _ := testApp.GenAccount(ctx)
+	taker, _ := testApp.GenAccount(ctx)
+	recipient, _ := testApp.GenAccount(ctx)
+	requireT.NoError(testApp.FundAccount(ctx, maker, sdk.NewCoins(sdk.NewInt64Coin("uaaa", 100))))
+	requireT.NoError(testApp.FundAccount(ctx, taker, sdk.NewCoins(sdk.NewInt64Coin("ubbb", 100))))
+
+	requireT.NoError(dexKeeper.PlaceOrder(ctx, limitOrder(maker, sdk.NewInt64Coin("uaaa", 60), sdk.NewInt64Coin("ubbb", 60))))
+	requireT.Equal(sdk.NewInt64Coin("uaaa", 60).String(), ftKeeper.GetDEXLockedBalance(ctx, maker, "uaaa").String())
+
+	// the locked funds can't back another order
+	requireT.ErrorIs(dexKeeper.PlaceOrder(
+		ctx, limitOrder(maker, sdk.NewInt64Coin("uaaa", 50), sdk.NewInt64Coin("ubbb", 50)),
+	), cosmoserrors.ErrInsufficientFunds)
+
+	// the locked funds can't be sent
+	requireT.ErrorIs(
+		bankKeeper.SendCoins(ctx, maker, recipient, sdk.NewCoins(sdk.NewInt64Coin("uaaa", 50))),
+		cosmoserrors.ErrInsufficientFunds,
+	)
+	requireT.NoError(bankKeeper.SendCoins(ctx, maker, recipient, sdk.NewCoins(sdk.NewInt64Coin("uaaa", 40))))
+
+	// the executed amount is unlocked
+	requireT.NoError(dexKeeper.PlaceOrder(ctx, limitOrder(taker, sdk.NewInt64Coin("ubbb", 20), sdk.NewInt64Coin("uaaa", 20))))
+	requireT.Equal("20uaaa,80ubbb", bankKeeper.GetAllBalances(ctx, taker).String())
+	requireT.Equal(sdk.NewInt64Coin("uaaa", 40).String(), ftKeeper.GetDEXLockedBalance(ctx, maker, "uaaa").String())
+
+	// the remaining amount is unlocked when the order is cancelled
+	requireT.NoError(dexKeeper.CancelOrder(ctx, maker, 1))
+	requireT.True(ftKeeper.GetDEXLockedBalance(ctx, maker, "uaaa").IsZero())
+	requireT.NoError(bankKeeper.SendCoins(ctx, maker, recipient, sdk.NewCoins(sdk.NewInt64Coin("uaaa", 40))))
+}
+
+func TestKeeper_PlaceOrder_MaxMatchedOrders(t *testing.T) {
+	requireT := require.New(t)
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+	dexKeeper := testApp.DEXKeeper
+	bankKeeper := testApp.BankKeeper
+
+	maker, _ := testApp.GenAccount(ctx)
+	taker, _ := testApp.GenAccount(ctx)
+	requireT.NoError(testApp.FundAccount(ctx, maker, sdk.NewCoins(sdk.NewInt64Coin("uaaa", types.MaxMatchedOrders+1))))
+	requireT.NoError(testApp.FundAccount(ctx, taker, sdk.NewCoins(sdk.NewInt64Coin("ubbb", types.MaxMatchedOrders+1))))
+
+	for i := 0; i < types.MaxMatchedOrders+1; i++ {
+		requireT.NoError(dexKeeper.PlaceOrder(ctx, limitOrder(maker, sdk.NewInt64Coin("uaaa", 1), sdk.NewInt64Coin("ubbb", 1))))
+	}
+
+	// the order stops matching once the maximum number of orders is matched, and the remainder is stored
+	requireT.NoError(dexKeeper.PlaceOrder(ctx, limitOrder(
+		taker, sdk.NewInt64Coin("ubbb", types.MaxMatchedOrders+1), sdk.NewInt64Coin("uaaa", types.MaxMatchedOrders+1),
+	)))
+	requireT.Equal(
+		sdk.NewInt64Coin("uaaa", types.MaxMatchedOrders).String(),
+		bankKeeper.GetBalance(ctx, taker, "uaaa").String(),
+	)
+	bookOrders, _, err := dexKeeper.GetOrderBookOrders(ctx, "uaaa", "ubbb", &query.PageRequest{})
+	requireT.NoError(err)
+	requireT.Len(bookOrders, 1)
+	bookOrders, _, err = dexKeeper.GetOrderBookOrders(ctx, "ubbb", "uaaa", &query.PageRequest{})
+	requireT.NoError(err)
+	requireT.Len(bookOrders, 1)
+	requireT.Equal(sdk.NewInt64Coin("ubbb", 1).String(), bookOrders[0].OfferedAmount.String())
+}
+
+func TestKeeper_PlaceOrder_DustOrders(t *testing.T) {
+	requireT := require.New(t)
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+	dexKeeper := testApp.DEXKeeper
+	bankKeeper := testApp.BankKeeper
+
+	maker, _ := testApp.GenAccount(ctx)
+	taker, _ := testApp.GenAccount(ctx)
+	requireT.NoError(testApp.FundAccount(ctx, maker, sdk.NewCoins(sdk.NewInt64Coin("uaaa", 15))))
+	requireT.NoError(testApp.FundAccount(ctx, taker, sdk.NewCoins(sdk.NewInt64Coin("ubbb", 2))))
+
+	requireT.NoError(dexKeeper.PlaceOrder(ctx, limitOrder(maker, sdk.NewInt64Coin("uaaa", 5), sdk.NewInt64Coin("ubbb", 1))))
+	requireT.NoError(dexKeeper.PlaceOrder(ctx, limitOrder(maker, sdk.NewInt64Coin("uaaa", 10), sdk.NewInt64Coin("ubbb", 4))))
+
+	// the top order can't be reduced by 4uaaa without rounding the amount paid by the taker down to zero,
+	// so the taker order is matched with the next one
+	requireT.NoError(dexKeeper.PlaceOrder(ctx, limitOrder(taker, sdk.NewInt64Coin("ubbb", 2), sdk.NewInt64Coin("uaaa", 4))))
+	requireT.Equal("4uaaa,1ubbb", bankKeeper.GetAllBalances(ctx, taker).String())
+
+	bookOrders, _, err := dexKeeper.GetOrderBookOrders(ctx, "uaaa", "ubbb", &query.PageRequest{})
+	requireT.NoError(err)
+	requireT.Equal([]uint64{1, 2}, orderIDs(bookOrders))
+	requireT.Equal(sdk.NewInt64Coin("uaaa", 5).String(), bookOrders[0].OfferedAmount.String())
+	requireT.Equal(sdk.NewInt64Coin("uaaa", 6).String(), bookOrders[1].OfferedAmount.String())
+}
+
+func TestKeeper_PlaceOrder_AssetFTRules(t *testing.T) {
+	requireT := require.New(t)
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+	dexKeeper := testApp.DEXKeeper
+	bankKeeper := testApp.BankKeeper
+
+	issuer, _ := testApp.GenAccount(ctx)
+	maker, _ := testApp.GenAccount(ctx)
+	taker, _ := testApp.GenAccount(ctx)
+
+	ftParams := testApp.AssetFTKeeper.GetParams(ctx)
+	requireT.NoError(testApp.FundAccount(ctx, issuer, sdk.NewCoins(ftParams.IssueFee)))
+	denom, err := testApp.AssetFTKeeper.Issue(ctx, assetfttypes.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "ABC",
+		Subunit:       "abc",
+		Precision:     6,
+		InitialAmount: sdkmath.NewInt(1000),
+		Features:      []assetfttypes.Feature{assetfttypes.Feature_freezing},
+		BurnRate:      sdk.MustNewDecFromStr("0.1"),
+	})
+	requireT.NoError(err)
+	requireT.NoError(bankKeeper.SendCoins(ctx, issuer, maker, sdk.NewCoins(sdk.NewInt64Coin(denom, 200))))
+	requireT.NoError(testApp.FundAccount(ctx, taker, sdk.NewCoins(sdk.NewInt64Coin(constant.DenomDev, 1000))))
+
+	requireT.NoError(dexKeeper.PlaceOrder(ctx, limitOrder(maker, sdk.NewInt64Coin(denom, 100), sdk.NewInt64Coin(constant.DenomDev, 100))))
+
+	// the maker can't send the frozen coins, so the order is closed and the taker order is stored
+	requireT.NoError(testApp.AssetFTKeeper.Freeze(ctx, issuer, maker, sdk.NewInt64Coin(denom, 200)))
+	requireT.NoError(dexKeeper.PlaceOrder(
+		ctx, limitOrder(taker, sdk.NewInt64Coin(constant.DenomDev, 100), sdk.NewInt64Coin(denom, 50)),
+	))
+	_, err = dexKeeper.GetOrder(ctx, 1)
+	requireT.ErrorIs(err, types.ErrOrderNotFound)
+	requireT.True(testApp.AssetFTKeeper.GetDEXLockedBalance(ctx, maker, denom).IsZero())
+	bookOrders, _, err := dexKeeper.GetOrderBookOrders(ctx, constant.DenomDev, denom, &query.PageRequest{})
+	requireT.NoError(err)
+	requireT.Equal([]uint64{2}, orderIDs(bookOrders))
+
+	// the frozen coins can't be offered, so the order is rejected before it is matched with the taker order
+	requireT.ErrorIs(dexKeeper.PlaceOrder(
+		ctx, limitOrder(maker, sdk.NewInt64Coin(denom, 50), sdk.NewInt64Coin(constant.DenomDev, 50)),
+	), cosmoserrors.ErrInsufficientFunds)
+	order, err := dexKeeper.GetOrder(ctx, 2)
+	requireT.NoError(err)
+	requireT.Equal(sdk.NewInt64Coin(constant.DenomDev, 100).String(), order.OfferedAmount.String())
+	requireT.True(bankKeeper.GetBalance(ctx, taker, denom).IsZero())
+	requireT.NoError(dexKeeper.CancelOrder(ctx, taker, 2))
+
+	requireT.NoError(testApp.AssetFTKeeper.Unfreeze(ctx, issuer, maker, sdk.NewInt64Coin(denom, 200)))
+	requireT.NoError(dexKeeper.PlaceOrder(ctx, limitOrder(maker, sdk.NewInt64Coin(denom, 100), sdk.NewInt64Coin(constant.DenomDev, 100))))
+	requireT.Equal(sdk.NewInt64Coin(constant.DenomDev, 1000).String(), bankKeeper.GetBalance(ctx, taker, constant.DenomDev).String())
+
+	// the burn rate is charged from the maker sending the token
+	requireT.NoError(dexKeeper.PlaceOrder(ctx, limitOrder(taker, sdk.NewInt64Coin(constant.DenomDev, 100), sdk.NewInt64Coin(denom, 50))))
+	requireT.Equal(sdk.NewInt64Coin(denom, 50).String(), bankKeeper.GetBalance(ctx, taker, denom).String())
+	requireT.Equal(sdk.NewInt64Coin(denom, 145).String(), bankKeeper.GetBalance(ctx, maker, denom).String())
+	requireT.Equal(sdk.NewInt64Coin(constant.DenomDev, 50).String(), bankKeeper.GetBalance(ctx, maker, constant.DenomDev).String())
+}
+
+func TestKeeper_PlaceOrder_Whitelisting(t *testing.T) {
+	requireT := require.New(t)
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+	dexKeeper := testApp.DEXKeeper
+	bankKeeper := testApp.BankKeeper
+	ftKeeper := testApp.AssetFTKeeper
+
+	issuer, _ := testApp.GenAccount(ctx)
+	maker1, _ := testApp.GenAccount(ctx)
+	maker2, _ := testApp.GenAccount(ctx)
+	taker, _ := testApp.GenAccount(ctx)
+
+	ftParams := ftKeeper.GetParams(ctx)
+	requireT.NoError(testApp.FundAccount(ctx, issuer, sdk.NewCoins(ftParams.IssueFee)))
+	denom, err := ftKeeper.Issue(ctx, assetfttypes.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "ABC",
+		Subunit:       "abc",
+		Precision:     6,
+		InitialAmount: sdkmath.NewInt(1000),
+		Features:      []assetfttypes.Feature{assetfttypes.Feature_whitelisting},
+	})
+	requireT.NoError(err)
+	requireT.NoError(ftKeeper.SetWhitelistedBalance(ctx, issuer, taker, sdk.NewInt64Coin(denom, 100)))
+	requireT.NoError(bankKeeper.SendCoins(ctx, issuer, taker, sdk.NewCoins(sdk.NewInt64Coin(denom, 100))))
+	requireT.NoError(testApp.FundAccount(ctx, maker1, sdk.NewCoins(sdk.NewInt64Coin(constant.DenomDev, 100))))
+	requireT.NoError(testApp.FundAccount(ctx, maker2, sdk.NewCoins(sdk.NewInt64Coin(constant.DenomDev, 100))))
+
+	// the order can't be placed if the desired token can't be received
+	requireT.ErrorIs(dexKeeper.PlaceOrder(
+		ctx, limitOrder(maker1, sdk.NewInt64Coin(constant.DenomDev, 100), sdk.NewInt64Coin(denom, 100)),
+	), assetfttypes.ErrWhitelistedLimitExceeded)
+
+	requireT.NoError(ftKeeper.SetWhitelistedBalance(ctx, issuer, maker1, sdk.NewInt64Coin(denom, 100)))
+	requireT.NoError(ftKeeper.SetWhitelistedBalance(ctx, issuer, maker2, sdk.NewInt64Coin(denom, 100)))
+	requireT.NoError(dexKeeper.PlaceOrder(
+		ctx, limitOrder(maker1, sdk.NewInt64Coin(constant.DenomDev, 100), sdk.NewInt64Coin(denom, 100)),
+	))
+	requireT.NoError(dexKeeper.PlaceOrder(
+		ctx, limitOrder(maker2, sdk.NewInt64Coin(constant.DenomDev, 100), sdk.NewInt64Coin(denom, 100)),
+	))
+
+	// the first maker can't receive the token anymore, so its order is closed and the next one is executed
+	requireT.NoError(ftKeeper.SetWhitelistedBalance(ctx, issuer, maker1, sdk.NewInt64Coin(denom, 0)))
+	requireT.NoError(dexKeeper.PlaceOrder(
+		ctx, limitOrder(taker, sdk.NewInt64Coin(denom, 100), sdk.NewInt64Coin(constant.DenomDev, 100)),
+	))
+	_, err = dexKeeper.GetOrder(ctx, 1)
+	requireT.ErrorIs(err, types.ErrOrderNotFound)
+	_, err = dexKeeper.GetOrder(ctx, 2)
+	requireT.ErrorIs(err, types.ErrOrderNotFound)
+	requireT.True(ftKeeper.GetDEXLockedBalance(ctx, maker1, constant.DenomDev).IsZero())
+	requireT.Equal(sdk.NewInt64Coin(constant.DenomDev, 100).String(), bankKeeper.GetBalance(ctx, maker1, constant.DenomDev).String())
+	requireT.Equal(sdk.NewInt64Coin(denom, 100).String(), bankKeeper.GetBalance(ctx, maker2, denom).String())
+	requireT.Equal(sdk.NewInt64Coin(constant.DenomDev, 100).String(), bankKeeper.GetBalance(ctx, taker, constant.DenomDev).String())
+}
+
+func TestKeeper_PlaceOrder_Clawback(t *testing.T) {
+	requireT := require.New(t)
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+	dexKeeper := testApp.DEXKeeper
+	bankKeeper := testApp.BankKeeper
+	ftKeeper := testApp.AssetFTKeeper
+
+	issuer, _ := testApp.GenAccount(ctx)
+	maker, _ := testApp.GenAccount(ctx)
+	taker, _ := testApp.GenAccount(ctx)
+
+	ftParams := ftKeeper.GetParams(ctx)
+	requireT.NoError(testApp.FundAccount(ctx, issuer, sdk.NewCoins(ftParams.IssueFee)))
+	denom, err := ftKeeper.Issue(ctx, assetfttypes.IssueSettings{
+		Issuer:        issuer,
+		Symbol:        "ABC",
+		Subunit:       "abc",
+		Precision:     6,
+		InitialAmount: sdkmath.NewInt(1000),
+		Features:      []assetfttypes.Feature{assetfttypes.Feature_clawback},
+	})
+	requireT.NoError(err)
+	requireT.NoError(bankKeeper.SendCoins(ctx, issuer, maker, sdk.NewCoins(sdk.NewInt64Coin(denom, 200))))
+	requireT.NoError(testApp.FundAccount(ctx, taker, sdk.NewCoins(sdk.NewInt64Coin(constant.DenomDev, 200))))
+
+	// the order at the price which is never accepted doesn't prevent the clawback
+	requireT.NoError(dexKeeper.PlaceOrder(ctx, limitOrder(maker, sdk.NewInt64Coin(denom, 100), sdk.NewInt64Coin(constant.DenomDev, 100))))
+	requireT.NoError(dexKeeper.PlaceOrder(ctx, limitOrder(maker, sdk.NewInt64Coin(denom, 100), sdk.NewInt64Coin(constant.DenomDev, 100))))
+	requireT.NoError(ftKeeper.Clawback(ctx, issuer, maker, sdk.NewInt64Coin(denom, 150)))
+	requireT.Equal(sdk.NewInt64Coin(denom, 50).String(), ftKeeper.GetDEXLockedBalance(ctx, maker, denom).String())
+
+	// the remaining lock covers only a part of each order, so the orders which can't be executed anymore are closed
+	requireT.NoError(dexKeeper.PlaceOrder(ctx, limitOrder(taker, sdk.NewInt64Coin(constant.DenomDev, 100), sdk.NewInt64Coin(denom, 100))))
+	_, err = dexKeeper.GetOrder(ctx, 1)
+	requireT.ErrorIs(err, types.ErrOrderNotFound)
+	_, err = dexKeeper.GetOrder(ctx, 2)
+	requireT.ErrorIs(err, types.ErrOrderNotFound)
+	requireT.True(ftKeeper.GetDEXLockedBalance(ctx, maker, denom).IsZero())
+	requireT.True(bankKeeper.GetBalance(ctx, taker, denom).IsZero())
+	requireT.NoError(bankKeeper.SendCoins(ctx, maker, issuer, sdk.NewCoins(sdk.NewInt64Coin(denom, 50))))
+
+	// the taker order rests in the order book
+	takerOrder, err := dexKeeper.GetOrder(ctx, 3)
+	requireT.NoError(err)
+	requireT.Equal(sdk.NewInt64Coin(constant.DenomDev, 100).String(), takerOrder.OfferedAmount.String())
+}
+
+func TestKeeper_CancelOrder(t *testing.T) {
+	requireT := require.New(t)
+	testApp := simapp.New()
+	ctx := testApp.BaseApp.NewContext(false, tmproto.Header{})
+	dexKeeper := testApp.DEXKeeper
+
+	creator, _ := testApp.GenAccount(ctx)
+	randomAddr, _ := testApp.GenAccount(ctx)
+	requireT.NoError(testApp.FundAccount(ctx, creator, sdk.NewCoins(sdk.NewInt64Coin("uaaa", 100))))
+
+	requireT.NoError(dexKeeper.PlaceOrder(ctx, limitOrder(creator, sdk.NewInt64Coin("uaaa", 100), sdk.NewInt64Coin("ubbb", 100))))
+
+	requireT.ErrorIs(dexKeeper.CancelOrder(ctx, randomAddr, 1), cosmoserrors.ErrUnauthorized)
+	requireT.ErrorIs(dexKeeper.CancelOrder(ctx, creator, 2), types.ErrOrderNotFound)
+	requireT.NoError(dexKeeper.CancelOrder(ctx, creator, 1))
+
+	_, err := dexKeeper.GetOrder(ctx, 1)
+	requireT.ErrorIs(err, types.ErrOrderNotFound)
+	creatorOrders, _, err := dexKeeper.GetOrders(ctx, creator, &query.PageRequest{})
+	requireT.NoError(err)
+	requireT.Empty(creatorOrders)
+	bookOrders, _, err := dexKeeper.GetOrderBookOrders(ctx, "uaaa", "ubbb", &query.PageRequest{})
+	requireT.NoError(err)
+	requireT.Empty(bookOrders)
+}
+
+func limitOrder(creator sdk.AccAddress, offered, desired sdk.Coin) types.Order {
+	return types.Order{
+		Creator:       creator.String(),
+		Type:          types.OrderType_limit,
+		OfferedAmount: offered,
+		DesiredAmount: desired,
+	}
+}
+
+func orderIDs(orders []types.Order) []uint64 {
+	ids := make([]uint64, 0, len(orders))
+	for _, order := range orders {
+		ids = append(ids, order.ID)
+	}
+	return ids
+}
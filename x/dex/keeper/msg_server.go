@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	"context"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/CoreumFoundation/coreum/v3/x/dex/types"
+)
+
+var _ types.MsgServer = MsgServer{}
+
+// MsgKeeper defines subscope of keeper methods required by msg service.
+type MsgKeeper interface {
+	PlaceOrder(ctx sdk.Context, order types.Order) error
+	CancelOrder(ctx sdk.Context, sender sdk.AccAddress, orderID uint64) error
+}
+
+// MsgServer serves grpc tx requests for the module.
+type MsgServer struct {
+	keeper MsgKeeper
+}
+
+// NewMsgServer returns a new instance of the MsgServer.
+func NewMsgServer(keeper MsgKeeper) MsgServer {
+	return MsgServer{
+		keeper: keeper,
+	}
+}
+
+// PlaceOrder places the order.
+func (ms MsgServer) PlaceOrder(ctx context.Context, req *types.MsgPlaceOrder) (*types.EmptyResponse, error) {
+	if _, err := sdk.AccAddressFromBech32(req.Sender); err != nil {
+		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if err := ms.keeper.PlaceOrder(sdk.UnwrapSDKContext(ctx), types.Order{
+		Creator:       req.Sender,
+		Type:          req.OrderType,
+		OfferedAmount: req.OfferedAmount,
+		DesiredAmount: req.DesiredAmount,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
+
+// CancelOrder cancels the order.
+func (ms MsgServer) CancelOrder(ctx context.Context, req *types.MsgCancelOrder) (*types.EmptyResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(cosmoserrors.ErrInvalidAddress, "invalid sender address")
+	}
+
+	if err := ms.keeper.CancelOrder(sdk.UnwrapSDKContext(ctx), sender, req.ID); err != nil {
+		return nil, err
+	}
+
+	return &types.EmptyResponse{}, nil
+}
@@ -0,0 +1,18 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+)
+
+var (
+	// ErrInvalidInput defines the common error for the invalid input.
+	ErrInvalidInput = sdkerrors.Register(ModuleName, 1, "invalid input")
+	// ErrOrderNotFound is returned when order is not found in the store.
+	ErrOrderNotFound = sdkerrors.Register(ModuleName, 2, "order not found")
+	// ErrInvalidKey is returned when the provided store key is invalid.
+	ErrInvalidKey = sdkerrors.Register(ModuleName, 3, "invalid key")
+	// ErrInvalidState is returned when state of the module is invalid.
+	ErrInvalidState = sdkerrors.Register(ModuleName, 4, "invalid state")
+	// ErrPriceOutOfRange is returned when the order price can't be represented by the execution price prefix.
+	ErrPriceOutOfRange = sdkerrors.Register(ModuleName, 5, "price out of range")
+)
@@ -0,0 +1,20 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BankKeeper defines the expected bank interface.
+type BankKeeper interface {
+	SendCoins(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error
+}
+
+// AssetFTKeeper defines the expected asset ft interface used to lock the funds of the orders.
+type AssetFTKeeper interface {
+	DEXLock(ctx sdk.Context, addr sdk.AccAddress, coin sdk.Coin) error
+	DEXUnlock(ctx sdk.Context, addr sdk.AccAddress, coin sdk.Coin) error
+	ImportDEXLock(ctx sdk.Context, addr sdk.AccAddress, coin sdk.Coin) error
+	DEXCheckSpendable(ctx sdk.Context, addr sdk.AccAddress, coin sdk.Coin) error
+	DEXCheckReceivable(ctx sdk.Context, addr sdk.AccAddress, coin sdk.Coin) error
+	GetDEXLockedBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
+}
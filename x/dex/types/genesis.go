@@ -0,0 +1,31 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+)
+
+// DefaultGenesis returns the default dex genesis state.
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{}
+}
+
+// Validate performs basic genesis state validation returning an error upon any failure.
+func (gs GenesisState) Validate() error {
+	orderIDs := make(map[uint64]struct{}, len(gs.Orders))
+	for _, order := range gs.Orders {
+		if err := order.Validate(); err != nil {
+			return err
+		}
+		if order.ID > gs.OrderSequence {
+			return sdkerrors.Wrapf(
+				ErrInvalidState, "order ID %d is greater than the order sequence %d", order.ID, gs.OrderSequence,
+			)
+		}
+		if _, ok := orderIDs[order.ID]; ok {
+			return sdkerrors.Wrapf(ErrInvalidState, "duplicate order ID %d", order.ID)
+		}
+		orderIDs[order.ID] = struct{}{}
+	}
+
+	return nil
+}
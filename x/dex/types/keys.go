@@ -0,0 +1,98 @@
+package types
+
+import (
+	"encoding/binary"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/CoreumFoundation/coreum/v3/pkg/store"
+)
+
+const (
+	// ModuleName defines the module name.
+	ModuleName = "dex"
+
+	// StoreKey defines the primary module store key.
+	StoreKey = ModuleName
+
+	// QuerierRoute defines the module's query routing key.
+	QuerierRoute = ModuleName
+
+	// RouterKey is the message route for module.
+	RouterKey = ModuleName
+)
+
+// Store key prefixes.
+var (
+	// OrderKeyPrefix defines the key prefix to map the order ID to the order book key.
+	OrderKeyPrefix = []byte{0x01}
+	// OrderBookKeyPrefix defines the key prefix for the orders stored in the order books.
+	OrderBookKeyPrefix = []byte{0x02}
+	// DenomPrefixKeyPrefix defines the key prefix to map the denom to its denom prefix.
+	DenomPrefixKeyPrefix = []byte{0x03}
+	// AccountOrderKeyPrefix defines the key prefix to track the orders placed by the account.
+	AccountOrderKeyPrefix = []byte{0x04}
+	// OrderSequenceKey defines the key to store the last used order ID.
+	OrderSequenceKey = []byte{0x05}
+	// DenomSequenceKey defines the key to store the last used denom prefix.
+	DenomSequenceKey = []byte{0x06}
+)
+
+// StoreTrue keeps a value used by stores to indicate that key is present.
+var StoreTrue = []byte{0x01}
+
+// CreateOrderKey constructs the key for the order ID.
+func CreateOrderKey(orderID uint64) []byte {
+	return store.JoinKeys(OrderKeyPrefix, Uint64ToBytes(orderID))
+}
+
+// CreateDenomPrefixKey constructs the key for the denom prefix mapping.
+func CreateDenomPrefixKey(denom string) []byte {
+	return store.JoinKeys(DenomPrefixKeyPrefix, []byte(denom))
+}
+
+// CreateQueuePrefix constructs the key prefix of the queue of orders offering the offered denom
+// in exchange for the desired one.
+func CreateQueuePrefix(offeredDenomPrefix, desiredDenomPrefix uint64) []byte {
+	return store.JoinKeys(OrderBookKeyPrefix, Uint64ToBytes(offeredDenomPrefix), Uint64ToBytes(desiredDenomPrefix))
+}
+
+// CreateOrderBookKey constructs the key of the order in the order book. The key is built from the queue prefix,
+// execution price prefix and order ID, so the orders in the queue are iterated in the execution sequence.
+func CreateOrderBookKey(queuePrefix []byte, price Price, orderID uint64) []byte {
+	return store.JoinKeys(queuePrefix, price.Key(), Uint64ToBytes(orderID))
+}
+
+// CreateAccountOrderPrefix constructs the key prefix of the orders placed by the account.
+func CreateAccountOrderPrefix(account sdk.AccAddress) ([]byte, error) {
+	accountKey, err := store.JoinKeysWithLength(account)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(ErrInvalidKey, "can't join account order key with length, account:%s, err:%s", account, err)
+	}
+	return store.JoinKeys(AccountOrderKeyPrefix, accountKey), nil
+}
+
+// CreateAccountOrderKey constructs the key of the order placed by the account.
+func CreateAccountOrderKey(account sdk.AccAddress, orderID uint64) ([]byte, error) {
+	accountPrefix, err := CreateAccountOrderPrefix(account)
+	if err != nil {
+		return nil, err
+	}
+	return store.JoinKeys(accountPrefix, Uint64ToBytes(orderID)), nil
+}
+
+// Uint64ToBytes encodes the number using big endian, so the encoded numbers are sorted in the store.
+func Uint64ToBytes(v uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, v)
+	return bz
+}
+
+// BytesToUint64 decodes the big endian encoded number.
+func BytesToUint64(bz []byte) (uint64, error) {
+	if len(bz) != 8 {
+		return 0, sdkerrors.Wrapf(ErrInvalidKey, "invalid uint64 length %d", len(bz))
+	}
+	return binary.BigEndian.Uint64(bz), nil
+}
@@ -0,0 +1,111 @@
+package types
+
+import (
+	"fmt"
+
+	sdkerrors "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/codec"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+)
+
+// Type of messages for amino.
+const (
+	TypeMsgPlaceOrder  = "place-order"
+	TypeMsgCancelOrder = "cancel-order"
+)
+
+type msgAndLegacyMsg interface {
+	sdk.Msg
+	legacytx.LegacyMsg
+}
+
+var (
+	_ msgAndLegacyMsg = &MsgPlaceOrder{}
+	_ msgAndLegacyMsg = &MsgCancelOrder{}
+)
+
+// RegisterLegacyAminoCodec registers the amino types and interfaces.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgPlaceOrder{}, fmt.Sprintf("%s/MsgPlaceOrder", ModuleName), nil)
+	cdc.RegisterConcrete(&MsgCancelOrder{}, fmt.Sprintf("%s/MsgCancelOrder", ModuleName), nil)
+}
+
+// ValidateBasic checks that message fields are valid.
+func (m *MsgPlaceOrder) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(cosmoserrors.ErrInvalidAddress, "invalid sender account %s", m.Sender)
+	}
+
+	return ValidateOrderAmounts(m.OrderType, m.OfferedAmount, m.DesiredAmount)
+}
+
+// GetSigners returns the required signers of this message type.
+func (m *MsgPlaceOrder) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(m.Sender),
+	}
+}
+
+// GetSignBytes returns sign bytes for LegacyMsg.
+func (m MsgPlaceOrder) GetSignBytes() []byte {
+	return sdk.MustSortJSON(moduleAminoCdc.MustMarshalJSON(&m))
+}
+
+// Route returns message route for LegacyMsg.
+func (m MsgPlaceOrder) Route() string {
+	return RouterKey
+}
+
+// Type returns message type for LegacyMsg.
+func (m MsgPlaceOrder) Type() string {
+	return TypeMsgPlaceOrder
+}
+
+// ValidateBasic checks that message fields are valid.
+func (m *MsgCancelOrder) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(cosmoserrors.ErrInvalidAddress, "invalid sender account %s", m.Sender)
+	}
+
+	if m.ID == 0 {
+		return sdkerrors.Wrap(ErrInvalidInput, "order ID must be positive")
+	}
+
+	return nil
+}
+
+// GetSigners returns the required signers of this message type.
+func (m *MsgCancelOrder) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(m.Sender),
+	}
+}
+
+// GetSignBytes returns sign bytes for LegacyMsg.
+func (m MsgCancelOrder) GetSignBytes() []byte {
+	return sdk.MustSortJSON(moduleAminoCdc.MustMarshalJSON(&m))
+}
+
+// Route returns message route for LegacyMsg.
+func (m MsgCancelOrder) Route() string {
+	return RouterKey
+}
+
+// Type returns message type for LegacyMsg.
+func (m MsgCancelOrder) Type() string {
+	return TypeMsgCancelOrder
+}
+
+var (
+	amino          = codec.NewLegacyAmino()
+	moduleAminoCdc = codec.NewAminoCodec(amino)
+)
+
+func init() {
+	RegisterLegacyAminoCodec(amino)
+	cryptocodec.RegisterCrypto(amino)
+	amino.Seal()
+}
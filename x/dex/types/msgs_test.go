@@ -0,0 +1,138 @@
+package types_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreum/v3/pkg/config"
+	"github.com/CoreumFoundation/coreum/v3/pkg/config/constant"
+	"github.com/CoreumFoundation/coreum/v3/x/dex/types"
+)
+
+func TestMain(m *testing.M) {
+	n, err := config.NetworkConfigByChainID(constant.ChainIDDev)
+	if err != nil {
+		panic(err)
+	}
+	n.SetSDKConfig()
+	m.Run()
+}
+
+func TestMsgPlaceOrder_ValidateBasic(t *testing.T) {
+	validMessage := types.MsgPlaceOrder{
+		Sender:        "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+		OrderType:     types.OrderType_limit,
+		OfferedAmount: sdk.NewInt64Coin("uaaa", 60),
+		DesiredAmount: sdk.NewInt64Coin("ubbb", 10),
+	}
+
+	testCases := []struct {
+		name          string
+		messageFunc   func() *types.MsgPlaceOrder
+		expectedError error
+	}{
+		{
+			name: "valid limit order",
+			messageFunc: func() *types.MsgPlaceOrder {
+				msg := validMessage
+				return &msg
+			},
+		},
+		{
+			name: "valid market order",
+			messageFunc: func() *types.MsgPlaceOrder {
+				msg := validMessage
+				msg.OrderType = types.OrderType_market
+				msg.DesiredAmount = sdk.NewInt64Coin("ubbb", 0)
+				return &msg
+			},
+		},
+		{
+			name: "invalid sender",
+			messageFunc: func() *types.MsgPlaceOrder {
+				msg := validMessage
+				msg.Sender = "devcore172rx"
+				return &msg
+			},
+			expectedError: cosmoserrors.ErrInvalidAddress,
+		},
+		{
+			name: "invalid order type",
+			messageFunc: func() *types.MsgPlaceOrder {
+				msg := validMessage
+				msg.OrderType = 5
+				return &msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "zero offered amount",
+			messageFunc: func() *types.MsgPlaceOrder {
+				msg := validMessage
+				msg.OfferedAmount = sdk.NewInt64Coin("uaaa", 0)
+				return &msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "zero desired amount of limit order",
+			messageFunc: func() *types.MsgPlaceOrder {
+				msg := validMessage
+				msg.DesiredAmount = sdk.NewInt64Coin("ubbb", 0)
+				return &msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "same denoms",
+			messageFunc: func() *types.MsgPlaceOrder {
+				msg := validMessage
+				msg.DesiredAmount = sdk.NewInt64Coin("uaaa", 10)
+				return &msg
+			},
+			expectedError: types.ErrInvalidInput,
+		},
+		{
+			name: "price out of range",
+			messageFunc: func() *types.MsgPlaceOrder {
+				msg := validMessage
+				msg.OfferedAmount = sdk.NewInt64Coin("uaaa", 1)
+				msg.DesiredAmount = sdk.NewCoin("ubbb", sdkmath.NewIntFromUint64(1<<63).MulRaw(2))
+				return &msg
+			},
+			expectedError: types.ErrPriceOutOfRange,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.messageFunc().ValidateBasic()
+			if tc.expectedError == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expectedError)
+			}
+		})
+	}
+}
+
+func TestPrice_Key(t *testing.T) {
+	requireT := require.New(t)
+
+	lower, err := types.NewPrice(sdkmath.NewInt(3), sdkmath.NewInt(1))
+	requireT.NoError(err)
+	middle, err := types.NewPrice(sdkmath.NewInt(1), sdkmath.NewInt(1))
+	requireT.NoError(err)
+	higher, err := types.NewPrice(sdkmath.NewInt(2), sdkmath.NewInt(3))
+	requireT.NoError(err)
+
+	requireT.EqualValues(1, higher.Whole)
+	requireT.EqualValues(5_000_000_000_000_000_000, higher.Decimal)
+	requireT.Less(string(lower.Key()), string(middle.Key()))
+	requireT.Less(string(middle.Key()), string(higher.Key()))
+}
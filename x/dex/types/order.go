@@ -0,0 +1,126 @@
+package types
+
+import (
+	"math/big"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/CoreumFoundation/coreum/v3/pkg/store"
+)
+
+// Reasons of closing the order before it is fully executed.
+const (
+	OrderClosedReasonCancelled = "cancelled"
+	// OrderClosedReasonUnfillable is used when the order can't be executed because of its creator, e.g. the creator
+	// can't receive the desired token or the offered token can't be sent anymore.
+	OrderClosedReasonUnfillable = "unfillable"
+)
+
+// MaxMatchedOrders is the maximum number of the orders from the order book matched by a single placed order,
+// the remainder of the limit order exceeding it is stored in the order book.
+const MaxMatchedOrders = 100
+
+// PriceDecimalPlaces is the number of decimal places kept in the execution price prefix.
+const PriceDecimalPlaces = 19
+
+var priceDecimalMultiplier = new(big.Int).Exp(big.NewInt(10), big.NewInt(PriceDecimalPlaces), nil)
+
+// Price is the execution price of the order, defined as the desired amount divided by the offered amount.
+// It is used only to sort the orders in the queue, the amounts exchanged during the matching are always computed
+// from the amounts stored in the orders.
+type Price struct {
+	Whole   uint64
+	Decimal uint64
+}
+
+// NewPrice computes the execution price of the order offering the offered amount in exchange for the desired one.
+func NewPrice(offered, desired sdkmath.Int) (Price, error) {
+	if !offered.IsPositive() {
+		return Price{}, sdkerrors.Wrap(ErrInvalidInput, "offered amount must be positive")
+	}
+
+	whole, remainder := new(big.Int).QuoRem(desired.BigInt(), offered.BigInt(), new(big.Int))
+	if !whole.IsUint64() {
+		return Price{}, sdkerrors.Wrapf(
+			ErrPriceOutOfRange, "the price of %s/%s exceeds the maximum price", desired, offered,
+		)
+	}
+	decimal := remainder.Mul(remainder, priceDecimalMultiplier)
+	decimal.Quo(decimal, offered.BigInt())
+
+	return Price{
+		Whole:   whole.Uint64(),
+		Decimal: decimal.Uint64(),
+	}, nil
+}
+
+// Key returns the execution price prefix used to sort the orders in ascending order by price.
+func (p Price) Key() []byte {
+	return store.JoinKeys(Uint64ToBytes(p.Whole), Uint64ToBytes(p.Decimal))
+}
+
+// Price returns the execution price of the order.
+func (o Order) Price() (Price, error) {
+	return NewPrice(o.OfferedAmount.Amount, o.DesiredAmount.Amount)
+}
+
+// IsCleared returns true if there is nothing left to be exchanged in the order.
+func (o Order) IsCleared() bool {
+	if !o.OfferedAmount.IsPositive() {
+		return true
+	}
+	return o.Type == OrderType_limit && !o.DesiredAmount.IsPositive()
+}
+
+// Validate performs basic validation of the order stored in the order book.
+func (o Order) Validate() error {
+	if o.ID == 0 {
+		return sdkerrors.Wrap(ErrInvalidInput, "order ID must be positive")
+	}
+	if _, err := sdk.AccAddressFromBech32(o.Creator); err != nil {
+		return sdkerrors.Wrapf(cosmoserrors.ErrInvalidAddress, "invalid creator account %s", o.Creator)
+	}
+	if o.Type != OrderType_limit {
+		return sdkerrors.Wrapf(ErrInvalidInput, "only limit orders are stored in the order book, got %s", o.Type)
+	}
+	if err := ValidateOrderAmounts(o.Type, o.OfferedAmount, o.DesiredAmount); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ValidateOrderAmounts validates the offered and desired amounts of the order.
+func ValidateOrderAmounts(orderType OrderType, offered, desired sdk.Coin) error {
+	if _, ok := OrderType_name[int32(orderType)]; !ok {
+		return sdkerrors.Wrapf(ErrInvalidInput, "unknown order type %d", orderType)
+	}
+	if err := offered.Validate(); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid offered amount: %s", err)
+	}
+	if !offered.IsPositive() {
+		return sdkerrors.Wrap(ErrInvalidInput, "offered amount must be positive")
+	}
+	if err := desired.Validate(); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidInput, "invalid desired amount: %s", err)
+	}
+	if offered.Denom == desired.Denom {
+		return sdkerrors.Wrap(ErrInvalidInput, "offered and desired denoms must be different")
+	}
+
+	if orderType != OrderType_limit {
+		return nil
+	}
+
+	if !desired.IsPositive() {
+		return sdkerrors.Wrap(ErrInvalidInput, "desired amount of the limit order must be positive")
+	}
+	if _, err := NewPrice(offered.Amount, desired.Amount); err != nil {
+		return err
+	}
+
+	return nil
+}
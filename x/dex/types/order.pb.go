@@ -0,0 +1,540 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: coreum/dex/v1/order.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// OrderType defines the type of the order.
+type OrderType int32
+
+const (
+	// limit order is matched only if the price offered by the counterparty is acceptable,
+	// the not fully executed remainder is stored in the order book.
+	OrderType_limit OrderType = 0
+	// market order is matched at any price, the not fully executed remainder is discarded.
+	OrderType_market OrderType = 1
+)
+
+var OrderType_name = map[int32]string{
+	0: "limit",
+	1: "market",
+}
+
+var OrderType_value = map[string]int32{
+	"limit":  0,
+	"market": 1,
+}
+
+func (x OrderType) String() string {
+	return proto.EnumName(OrderType_name, int32(x))
+}
+
+func (OrderType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_302bb6c9a553771c, []int{0}
+}
+
+// Order is the order stored in the order book.
+type Order struct {
+	// id is the unique sequential identifier of the order.
+	ID uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// creator is the account which placed the order.
+	Creator string `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	// type is the type of the order.
+	Type OrderType `protobuf:"varint,3,opt,name=type,proto3,enum=coreum.dex.v1.OrderType" json:"type,omitempty"`
+	// offered_amount is the remaining amount offered by the creator.
+	OfferedAmount types.Coin `protobuf:"bytes,4,opt,name=offered_amount,json=offeredAmount,proto3" json:"offered_amount"`
+	// desired_amount is the remaining amount the creator wants to receive in exchange for offered_amount.
+	DesiredAmount types.Coin `protobuf:"bytes,5,opt,name=desired_amount,json=desiredAmount,proto3" json:"desired_amount"`
+}
+
+func (m *Order) Reset()         { *m = Order{} }
+func (m *Order) String() string { return proto.CompactTextString(m) }
+func (*Order) ProtoMessage()    {}
+func (*Order) Descriptor() ([]byte, []int) {
+	return fileDescriptor_302bb6c9a553771c, []int{0}
+}
+func (m *Order) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Order) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Order.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Order) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Order.Merge(m, src)
+}
+func (m *Order) XXX_Size() int {
+	return m.Size()
+}
+func (m *Order) XXX_DiscardUnknown() {
+	xxx_messageInfo_Order.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Order proto.InternalMessageInfo
+
+func (m *Order) GetID() uint64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *Order) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *Order) GetType() OrderType {
+	if m != nil {
+		return m.Type
+	}
+	return OrderType_limit
+}
+
+func (m *Order) GetOfferedAmount() types.Coin {
+	if m != nil {
+		return m.OfferedAmount
+	}
+	return types.Coin{}
+}
+
+func (m *Order) GetDesiredAmount() types.Coin {
+	if m != nil {
+		return m.DesiredAmount
+	}
+	return types.Coin{}
+}
+
+func init() {
+	proto.RegisterEnum("coreum.dex.v1.OrderType", OrderType_name, OrderType_value)
+	proto.RegisterType((*Order)(nil), "coreum.dex.v1.Order")
+}
+
+func init() { proto.RegisterFile("coreum/dex/v1/order.proto", fileDescriptor_302bb6c9a553771c) }
+
+var fileDescriptor_302bb6c9a553771c = []byte{
+	// 338 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0xcf, 0x4a, 0xc3, 0x40,
+	0x10, 0x87, 0xb3, 0x31, 0xad, 0x74, 0xa5, 0xa5, 0x04, 0x91, 0xb4, 0x87, 0x34, 0xf4, 0x14, 0x44,
+	0x76, 0x49, 0xfb, 0x04, 0xb6, 0x52, 0x10, 0x0f, 0x42, 0xf0, 0xe4, 0x45, 0x92, 0xec, 0xb6, 0x2e,
+	0x9a, 0x4c, 0xd9, 0x6c, 0x42, 0xfb, 0x16, 0x3e, 0x56, 0x8f, 0x3d, 0x7a, 0x2a, 0x92, 0x3e, 0x84,
+	0x57, 0xc9, 0x1f, 0x45, 0x6f, 0xde, 0x66, 0x77, 0xe7, 0xf7, 0xf1, 0xcd, 0x0e, 0x1e, 0x44, 0x20,
+	0x79, 0x16, 0x53, 0xc6, 0x37, 0x34, 0xf7, 0x28, 0x48, 0xc6, 0x25, 0x59, 0x4b, 0x50, 0x60, 0x76,
+	0xeb, 0x27, 0xc2, 0xf8, 0x86, 0xe4, 0xde, 0xd0, 0x8e, 0x20, 0x8d, 0x21, 0xa5, 0x61, 0x90, 0x72,
+	0x9a, 0x7b, 0x21, 0x57, 0x81, 0x47, 0x23, 0x10, 0x49, 0xdd, 0x3e, 0x3c, 0x5f, 0xc1, 0x0a, 0xaa,
+	0x92, 0x96, 0x55, 0x7d, 0x3b, 0xfe, 0x44, 0xb8, 0x75, 0x5f, 0x42, 0xcd, 0x0b, 0xac, 0x0b, 0x66,
+	0x21, 0x07, 0xb9, 0xc6, 0xac, 0x5d, 0x1c, 0x46, 0xfa, 0xed, 0x8d, 0xaf, 0x0b, 0x66, 0x5a, 0xf8,
+	0x34, 0x92, 0x3c, 0x50, 0x20, 0x2d, 0xdd, 0x41, 0x6e, 0xc7, 0xff, 0x3e, 0x9a, 0x57, 0xd8, 0x50,
+	0xdb, 0x35, 0xb7, 0x4e, 0x1c, 0xe4, 0xf6, 0x26, 0x16, 0xf9, 0xe3, 0x43, 0x2a, 0xea, 0xc3, 0x76,
+	0xcd, 0xfd, 0xaa, 0xcb, 0x5c, 0xe0, 0x1e, 0x2c, 0x97, 0x5c, 0x72, 0xf6, 0x14, 0xc4, 0x90, 0x25,
+	0xca, 0x32, 0x1c, 0xe4, 0x9e, 0x4d, 0x06, 0xa4, 0x16, 0x27, 0xa5, 0x38, 0x69, 0xc4, 0xc9, 0x1c,
+	0x44, 0x32, 0x33, 0x76, 0x87, 0x91, 0xe6, 0x77, 0x9b, 0xd8, 0x75, 0x95, 0x2a, 0x39, 0x8c, 0xa7,
+	0xe2, 0x17, 0xa7, 0xf5, 0x4f, 0x4e, 0x13, 0xab, 0x39, 0x97, 0x63, 0xdc, 0xf9, 0x51, 0x34, 0x3b,
+	0xb8, 0xf5, 0x2a, 0x62, 0xa1, 0xfa, 0x9a, 0x89, 0x71, 0x3b, 0x0e, 0xe4, 0x0b, 0x57, 0x7d, 0x34,
+	0xbb, 0xdb, 0x15, 0x36, 0xda, 0x17, 0x36, 0xfa, 0x28, 0x6c, 0xf4, 0x76, 0xb4, 0xb5, 0xfd, 0xd1,
+	0xd6, 0xde, 0x8f, 0xb6, 0xf6, 0xe8, 0xad, 0x84, 0x7a, 0xce, 0x42, 0x12, 0x41, 0x4c, 0xe7, 0xd5,
+	0xdc, 0x0b, 0xc8, 0x12, 0x16, 0x28, 0x01, 0x09, 0x6d, 0x76, 0x96, 0x4f, 0xe9, 0xa6, 0x5a, 0x5c,
+	0x39, 0x7f, 0x1a, 0xb6, 0xab, 0x1f, 0x9f, 0x7e, 0x0d, 0x00, 0x8e, 0x88, 0x18, 0xc1, 0xd3, 0x01,
+	0x00, 0x00,
+}
+
+func (m *Order) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Order) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Order) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.DesiredAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintOrder(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.OfferedAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintOrder(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Type != 0 {
+		i = encodeVarintOrder(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintOrder(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ID != 0 {
+		i = encodeVarintOrder(dAtA, i, uint64(m.ID))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintOrder(dAtA []byte, offset int, v uint64) int {
+	offset -= sovOrder(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Order) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovOrder(uint64(m.ID))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovOrder(uint64(l))
+	}
+	if m.Type != 0 {
+		n += 1 + sovOrder(uint64(m.Type))
+	}
+	l = m.OfferedAmount.Size()
+	n += 1 + l + sovOrder(uint64(l))
+	l = m.DesiredAmount.Size()
+	n += 1 + l + sovOrder(uint64(l))
+	return n
+}
+
+func sovOrder(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozOrder(x uint64) (n int) {
+	return sovOrder(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Order) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOrder
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Order: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Order: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= OrderType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OfferedAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OfferedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DesiredAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrder
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DesiredAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOrder(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOrder
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipOrder(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowOrder
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowOrder
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthOrder
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupOrder
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthOrder
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthOrder        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowOrder          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupOrder = fmt.Errorf("proto: unexpected end of group")
+)
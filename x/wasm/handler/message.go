@@ -3,6 +3,7 @@ package handler
 import (
 	"encoding/json"
 
+	sdkmath "cosmossdk.io/math"
 	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -11,21 +12,66 @@ import (
 
 	assetfttypes "github.com/CoreumFoundation/coreum/v3/x/asset/ft/types"
 	assetnfttypes "github.com/CoreumFoundation/coreum/v3/x/asset/nft/types"
+	wnfttypes "github.com/CoreumFoundation/coreum/v3/x/wnft/types"
 )
 
 // assetFTMsg represents asset ft module messages integrated with the wasm handler.
 //
 //nolint:tagliatelle // we keep the name same as consume
 type assetFTMsg struct {
-	Issue               *assetfttypes.MsgIssue               `json:"Issue"`
-	Mint                *assetfttypes.MsgMint                `json:"Mint"`
-	Burn                *assetfttypes.MsgBurn                `json:"Burn"`
-	Freeze              *assetfttypes.MsgFreeze              `json:"Freeze"`
-	Unfreeze            *assetfttypes.MsgUnfreeze            `json:"Unfreeze"`
-	GloballyFreeze      *assetfttypes.MsgGloballyFreeze      `json:"GloballyFreeze"`
-	GloballyUnfreeze    *assetfttypes.MsgGloballyUnfreeze    `json:"GloballyUnfreeze"`
-	SetWhitelistedLimit *assetfttypes.MsgSetWhitelistedLimit `json:"SetWhitelistedLimit"`
-	UpgradeTokenV1      *assetfttypes.MsgUpgradeTokenV1      `json:"UpgradeTokenV1"`
+	Issue                 *assetFTMsgIssue                       `json:"Issue"`
+	Mint                  *assetfttypes.MsgMint                  `json:"Mint"`
+	Burn                  *assetfttypes.MsgBurn                  `json:"Burn"`
+	Freeze                *assetfttypes.MsgFreeze                `json:"Freeze"`
+	Unfreeze              *assetfttypes.MsgUnfreeze              `json:"Unfreeze"`
+	GloballyFreeze        *assetfttypes.MsgGloballyFreeze        `json:"GloballyFreeze"`
+	GloballyUnfreeze      *assetfttypes.MsgGloballyUnfreeze      `json:"GloballyUnfreeze"`
+	SetWhitelistedLimit   *assetfttypes.MsgSetWhitelistedLimit   `json:"SetWhitelistedLimit"`
+	Clawback              *assetfttypes.MsgClawback              `json:"Clawback"`
+	TransferAdmin         *assetfttypes.MsgTransferAdmin         `json:"TransferAdmin"`
+	ClearAdmin            *assetfttypes.MsgClearAdmin            `json:"ClearAdmin"`
+	UpdateMetadata        *assetFTMsgUpdateMetadata              `json:"UpdateMetadata"`
+	SetRateExemption      *assetfttypes.MsgSetRateExemption      `json:"SetRateExemption"`
+	RemoveRateExemption   *assetfttypes.MsgRemoveRateExemption   `json:"RemoveRateExemption"`
+	UpdateRates           *assetfttypes.MsgUpdateRates           `json:"UpdateRates"`
+	SetTransferLimit      *assetfttypes.MsgSetTransferLimit      `json:"SetTransferLimit"`
+	RemoveTransferLimit   *assetfttypes.MsgRemoveTransferLimit   `json:"RemoveTransferLimit"`
+	BlockAccounts         *assetfttypes.MsgBlockAccounts         `json:"BlockAccounts"`
+	UnblockAccounts       *assetfttypes.MsgUnblockAccounts       `json:"UnblockAccounts"`
+	CreateVestingSchedule *assetfttypes.MsgCreateVestingSchedule `json:"CreateVestingSchedule"`
+	Distribute            *assetfttypes.MsgDistribute            `json:"Distribute"`
+	UpgradeTokenV1        *assetfttypes.MsgUpgradeTokenV1        `json:"UpgradeTokenV1"`
+	UpgradeToken          *assetfttypes.MsgUpgradeToken          `json:"UpgradeToken"`
+}
+
+// assetFTMsgIssue defines message for the Issue method with string represented data field.
+//
+//nolint:tagliatelle // we keep the name same as consume
+type assetFTMsgIssue struct {
+	Symbol             string                      `json:"symbol"`
+	Subunit            string                      `json:"subunit"`
+	Precision          uint32                      `json:"precision"`
+	InitialAmount      sdkmath.Int                 `json:"initial_amount"`
+	Description        string                      `json:"description"`
+	Features           []assetfttypes.Feature      `json:"features"`
+	BurnRate           sdk.Dec                     `json:"burn_rate"`
+	SendCommissionRate sdk.Dec                     `json:"send_commission_rate"`
+	URI                string                      `json:"uri"`
+	URIHash            string                      `json:"uri_hash"`
+	Data               string                      `json:"data"`
+	MaxSupply          *sdkmath.Int                `json:"max_supply"`
+	MintAllowance      *assetfttypes.MintAllowance `json:"mint_allowance"`
+}
+
+// assetFTMsgUpdateMetadata defines message for the UpdateMetadata method with string represented data field.
+//
+//nolint:tagliatelle // we keep the name same as consume
+type assetFTMsgUpdateMetadata struct {
+	Denom       string `json:"denom"`
+	Description string `json:"description"`
+	URI         string `json:"uri"`
+	URIHash     string `json:"uri_hash"`
+	Data        string `json:"data"`
 }
 
 // assetNFTMsgIssueClass defines message for the IssueClass method with string represented data field.
@@ -40,6 +86,7 @@ type assetNFTMsgIssueClass struct {
 	Data        string                       `json:"data"`
 	Features    []assetnfttypes.ClassFeature `json:"features"`
 	RoyaltyRate sdk.Dec                      `json:"royalty_rate"`
+	DataEditors []assetnfttypes.DataEditor   `json:"data_editors"`
 }
 
 // assetNFTMsgMint defines message for the Mint method with string represented data field.
@@ -53,15 +100,49 @@ type assetNFTMsgMint struct {
 	Data    string `json:"data"`
 }
 
+// assetNFTMsgMintBatchItem defines item of the MintBatch method with string represented data field.
+//
+//nolint:tagliatelle // we keep the name same as consume
+type assetNFTMsgMintBatchItem struct {
+	ClassID   string `json:"class_id"`
+	ID        string `json:"id"`
+	URI       string `json:"uri"`
+	URIHash   string `json:"uri_hash"`
+	Data      string `json:"data"`
+	Recipient string `json:"recipient"`
+}
+
+// assetNFTMsgMintBatch defines message for the MintBatch method with string represented data fields.
+type assetNFTMsgMintBatch struct {
+	Items []assetNFTMsgMintBatchItem `json:"items"`
+}
+
+// assetNFTMsgUpdateData defines message for the UpdateData method with string represented data field.
+//
+//nolint:tagliatelle // we keep the name same as consume
+type assetNFTMsgUpdateData struct {
+	ClassID string `json:"class_id"`
+	ID      string `json:"id"`
+	URI     string `json:"uri"`
+	URIHash string `json:"uri_hash"`
+	Data    string `json:"data"`
+}
+
 // assetNFTMsg represents asset nft module messages integrated with the wasm handler.
 //
 //nolint:tagliatelle // we keep the name same as consume
 type assetNFTMsg struct {
 	IssueClass          *assetNFTMsgIssueClass                `json:"IssueClass"`
 	Mint                *assetNFTMsgMint                      `json:"Mint"`
+	MintBatch           *assetNFTMsgMintBatch                 `json:"MintBatch"`
+	UpdateData          *assetNFTMsgUpdateData                `json:"UpdateData"`
 	Burn                *assetnfttypes.MsgBurn                `json:"Burn"`
+	BurnBatch           *assetnfttypes.MsgBurnBatch           `json:"BurnBatch"`
+	Revoke              *assetnfttypes.MsgRevoke              `json:"Revoke"`
 	Freeze              *assetnfttypes.MsgFreeze              `json:"Freeze"`
 	Unfreeze            *assetnfttypes.MsgUnfreeze            `json:"Unfreeze"`
+	ClassFreeze         *assetnfttypes.MsgClassFreeze         `json:"ClassFreeze"`
+	ClassUnfreeze       *assetnfttypes.MsgClassUnfreeze       `json:"ClassUnfreeze"`
 	AddToWhitelist      *assetnfttypes.MsgAddToWhitelist      `json:"AddToWhitelist"`
 	RemoveFromWhitelist *assetnfttypes.MsgRemoveFromWhitelist `json:"RemoveFromWhitelist"`
 }
@@ -70,7 +151,8 @@ type assetNFTMsg struct {
 //
 //nolint:tagliatelle // we keep the name same as consume
 type nftMsg struct {
-	Send *nfttypes.MsgSend `json:"Send"`
+	Send      *nfttypes.MsgSend       `json:"Send"`
+	SendBatch *wnfttypes.MsgSendBatch `json:"SendBatch"`
 }
 
 // coreumMsg represents all supported custom messages integrated with the wasm handler.
@@ -125,8 +207,32 @@ func decodeCoreumMessage(coreumMessages coreumMsg, sender sdk.AccAddress) (sdk.M
 
 func decodeAssetFTMessage(assetFTMsg *assetFTMsg, sender string) (sdk.Msg, error) {
 	if assetFTMsg.Issue != nil {
-		assetFTMsg.Issue.Issuer = sender
-		return assetFTMsg.Issue, nil
+		var (
+			data *codectypes.Any
+			err  error
+		)
+		if assetFTMsg.Issue.Data != "" {
+			data, err = convertStringToAssetFTDataBytes(assetFTMsg.Issue.Data)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return &assetfttypes.MsgIssue{
+			Issuer:             sender,
+			Symbol:             assetFTMsg.Issue.Symbol,
+			Subunit:            assetFTMsg.Issue.Subunit,
+			Precision:          assetFTMsg.Issue.Precision,
+			InitialAmount:      assetFTMsg.Issue.InitialAmount,
+			Description:        assetFTMsg.Issue.Description,
+			Features:           assetFTMsg.Issue.Features,
+			BurnRate:           assetFTMsg.Issue.BurnRate,
+			SendCommissionRate: assetFTMsg.Issue.SendCommissionRate,
+			URI:                assetFTMsg.Issue.URI,
+			URIHash:            assetFTMsg.Issue.URIHash,
+			Data:               data,
+			MaxSupply:          assetFTMsg.Issue.MaxSupply,
+			MintAllowance:      assetFTMsg.Issue.MintAllowance,
+		}, nil
 	}
 	if assetFTMsg.Mint != nil {
 		assetFTMsg.Mint.Sender = sender
@@ -156,10 +262,82 @@ func decodeAssetFTMessage(assetFTMsg *assetFTMsg, sender string) (sdk.Msg, error
 		assetFTMsg.SetWhitelistedLimit.Sender = sender
 		return assetFTMsg.SetWhitelistedLimit, nil
 	}
+	if assetFTMsg.Clawback != nil {
+		assetFTMsg.Clawback.Sender = sender
+		return assetFTMsg.Clawback, nil
+	}
+	if assetFTMsg.TransferAdmin != nil {
+		assetFTMsg.TransferAdmin.Sender = sender
+		return assetFTMsg.TransferAdmin, nil
+	}
+	if assetFTMsg.ClearAdmin != nil {
+		assetFTMsg.ClearAdmin.Sender = sender
+		return assetFTMsg.ClearAdmin, nil
+	}
+	if assetFTMsg.SetRateExemption != nil {
+		assetFTMsg.SetRateExemption.Sender = sender
+		return assetFTMsg.SetRateExemption, nil
+	}
+	if assetFTMsg.RemoveRateExemption != nil {
+		assetFTMsg.RemoveRateExemption.Sender = sender
+		return assetFTMsg.RemoveRateExemption, nil
+	}
+	if assetFTMsg.UpdateRates != nil {
+		assetFTMsg.UpdateRates.Sender = sender
+		return assetFTMsg.UpdateRates, nil
+	}
+	if assetFTMsg.SetTransferLimit != nil {
+		assetFTMsg.SetTransferLimit.Sender = sender
+		return assetFTMsg.SetTransferLimit, nil
+	}
+	if assetFTMsg.RemoveTransferLimit != nil {
+		assetFTMsg.RemoveTransferLimit.Sender = sender
+		return assetFTMsg.RemoveTransferLimit, nil
+	}
+	if assetFTMsg.BlockAccounts != nil {
+		assetFTMsg.BlockAccounts.Sender = sender
+		return assetFTMsg.BlockAccounts, nil
+	}
+	if assetFTMsg.UnblockAccounts != nil {
+		assetFTMsg.UnblockAccounts.Sender = sender
+		return assetFTMsg.UnblockAccounts, nil
+	}
+	if assetFTMsg.CreateVestingSchedule != nil {
+		assetFTMsg.CreateVestingSchedule.Sender = sender
+		return assetFTMsg.CreateVestingSchedule, nil
+	}
+	if assetFTMsg.Distribute != nil {
+		assetFTMsg.Distribute.Sender = sender
+		return assetFTMsg.Distribute, nil
+	}
+	if assetFTMsg.UpdateMetadata != nil {
+		var (
+			data *codectypes.Any
+			err  error
+		)
+		if assetFTMsg.UpdateMetadata.Data != "" {
+			data, err = convertStringToAssetFTDataBytes(assetFTMsg.UpdateMetadata.Data)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return &assetfttypes.MsgUpdateMetadata{
+			Sender:      sender,
+			Denom:       assetFTMsg.UpdateMetadata.Denom,
+			Description: assetFTMsg.UpdateMetadata.Description,
+			URI:         assetFTMsg.UpdateMetadata.URI,
+			URIHash:     assetFTMsg.UpdateMetadata.URIHash,
+			Data:        data,
+		}, nil
+	}
 	if assetFTMsg.UpgradeTokenV1 != nil {
 		assetFTMsg.UpgradeTokenV1.Sender = sender
 		return assetFTMsg.UpgradeTokenV1, nil
 	}
+	if assetFTMsg.UpgradeToken != nil {
+		assetFTMsg.UpgradeToken.Sender = sender
+		return assetFTMsg.UpgradeToken, nil
+	}
 
 	return nil, nil
 }
@@ -186,6 +364,7 @@ func decodeAssetNFTMessage(assetNFTMsg *assetNFTMsg, sender string) (sdk.Msg, er
 			Data:        data,
 			Features:    assetNFTMsg.IssueClass.Features,
 			RoyaltyRate: assetNFTMsg.IssueClass.RoyaltyRate,
+			DataEditors: assetNFTMsg.IssueClass.DataEditors,
 		}, nil
 	}
 	if assetNFTMsg.Mint != nil {
@@ -208,10 +387,65 @@ func decodeAssetNFTMessage(assetNFTMsg *assetNFTMsg, sender string) (sdk.Msg, er
 			Data:    data,
 		}, nil
 	}
+	if assetNFTMsg.MintBatch != nil {
+		items := make([]assetnfttypes.MintBatchItem, 0, len(assetNFTMsg.MintBatch.Items))
+		for _, item := range assetNFTMsg.MintBatch.Items {
+			var (
+				data *codectypes.Any
+				err  error
+			)
+			if item.Data != "" {
+				data, err = convertStringToDataBytes(item.Data)
+				if err != nil {
+					return nil, err
+				}
+			}
+			items = append(items, assetnfttypes.MintBatchItem{
+				ClassID:   item.ClassID,
+				ID:        item.ID,
+				URI:       item.URI,
+				URIHash:   item.URIHash,
+				Data:      data,
+				Recipient: item.Recipient,
+			})
+		}
+		return &assetnfttypes.MsgMintBatch{
+			Sender: sender,
+			Items:  items,
+		}, nil
+	}
+	if assetNFTMsg.UpdateData != nil {
+		var (
+			data *codectypes.Any
+			err  error
+		)
+		if assetNFTMsg.UpdateData.Data != "" {
+			data, err = convertStringToDataBytes(assetNFTMsg.UpdateData.Data)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return &assetnfttypes.MsgUpdateData{
+			Sender:  sender,
+			ClassID: assetNFTMsg.UpdateData.ClassID,
+			ID:      assetNFTMsg.UpdateData.ID,
+			URI:     assetNFTMsg.UpdateData.URI,
+			URIHash: assetNFTMsg.UpdateData.URIHash,
+			Data:    data,
+		}, nil
+	}
 	if assetNFTMsg.Burn != nil {
 		assetNFTMsg.Burn.Sender = sender
 		return assetNFTMsg.Burn, nil
 	}
+	if assetNFTMsg.BurnBatch != nil {
+		assetNFTMsg.BurnBatch.Sender = sender
+		return assetNFTMsg.BurnBatch, nil
+	}
+	if assetNFTMsg.Revoke != nil {
+		assetNFTMsg.Revoke.Sender = sender
+		return assetNFTMsg.Revoke, nil
+	}
 	if assetNFTMsg.Freeze != nil {
 		assetNFTMsg.Freeze.Sender = sender
 		return assetNFTMsg.Freeze, nil
@@ -220,6 +454,14 @@ func decodeAssetNFTMessage(assetNFTMsg *assetNFTMsg, sender string) (sdk.Msg, er
 		assetNFTMsg.Unfreeze.Sender = sender
 		return assetNFTMsg.Unfreeze, nil
 	}
+	if assetNFTMsg.ClassFreeze != nil {
+		assetNFTMsg.ClassFreeze.Sender = sender
+		return assetNFTMsg.ClassFreeze, nil
+	}
+	if assetNFTMsg.ClassUnfreeze != nil {
+		assetNFTMsg.ClassUnfreeze.Sender = sender
+		return assetNFTMsg.ClassUnfreeze, nil
+	}
 	if assetNFTMsg.AddToWhitelist != nil {
 		assetNFTMsg.AddToWhitelist.Sender = sender
 		return assetNFTMsg.AddToWhitelist, nil
@@ -237,6 +479,10 @@ func decodeNFTMessage(nftMsg *nftMsg, sender string) (sdk.Msg, error) {
 		nftMsg.Send.Sender = sender
 		return nftMsg.Send, nil
 	}
+	if nftMsg.SendBatch != nil {
+		nftMsg.SendBatch.Sender = sender
+		return nftMsg.SendBatch, nil
+	}
 
 	return nil, nil
 }
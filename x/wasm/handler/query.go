@@ -5,6 +5,7 @@ import (
 	"encoding/base64"
 	"encoding/json"
 
+	sdkmath "cosmossdk.io/math"
 	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -20,14 +21,58 @@ import (
 //
 //nolint:tagliatelle // we keep the name same as consume
 type assetFTQuery struct {
-	Params              *assetfttypes.QueryParamsRequest              `json:"Params"`
-	Token               *assetfttypes.QueryTokenRequest               `json:"Token"`
-	Tokens              *assetfttypes.QueryTokensRequest              `json:"Tokens"`
-	Balance             *assetfttypes.QueryBalanceRequest             `json:"Balance"`
-	FrozenBalance       *assetfttypes.QueryFrozenBalanceRequest       `json:"FrozenBalance"`
-	FrozenBalances      *assetfttypes.QueryFrozenBalancesRequest      `json:"FrozenBalances"`
-	WhitelistedBalance  *assetfttypes.QueryWhitelistedBalanceRequest  `json:"WhitelistedBalance"`
-	WhitelistedBalances *assetfttypes.QueryWhitelistedBalancesRequest `json:"WhitelistedBalances"`
+	Params                *assetfttypes.QueryParamsRequest                `json:"Params"`
+	Token                 *assetfttypes.QueryTokenRequest                 `json:"Token"`
+	Tokens                *assetfttypes.QueryTokensRequest                `json:"Tokens"`
+	Balance               *assetfttypes.QueryBalanceRequest               `json:"Balance"`
+	FrozenBalance         *assetfttypes.QueryFrozenBalanceRequest         `json:"FrozenBalance"`
+	FrozenBalances        *assetfttypes.QueryFrozenBalancesRequest        `json:"FrozenBalances"`
+	WhitelistedBalance    *assetfttypes.QueryWhitelistedBalanceRequest    `json:"WhitelistedBalance"`
+	WhitelistedBalances   *assetfttypes.QueryWhitelistedBalancesRequest   `json:"WhitelistedBalances"`
+	RateExemptions        *assetfttypes.QueryRateExemptionsRequest        `json:"RateExemptions"`
+	BlockedAccounts       *assetfttypes.QueryBlockedAccountsRequest       `json:"BlockedAccounts"`
+	BlockedAccount        *assetfttypes.QueryBlockedAccountRequest        `json:"BlockedAccount"`
+	Distribution          *assetfttypes.QueryDistributionRequest          `json:"Distribution"`
+	DistributionClaimable *assetfttypes.QueryDistributionClaimableRequest `json:"DistributionClaimable"`
+	Holders               *assetfttypes.QueryHoldersRequest               `json:"Holders"`
+	TransferLimits        *assetfttypes.QueryTransferLimitsRequest        `json:"TransferLimits"`
+	TransferLimit         *assetfttypes.QueryTransferLimitRequest         `json:"TransferLimit"`
+	VestingSchedules      *assetfttypes.QueryVestingSchedulesRequest      `json:"VestingSchedules"`
+}
+
+// assetFTToken is the asset ft Token with string data.
+//
+//nolint:tagliatelle // we keep the name same as consume
+type assetFTToken struct {
+	Denom              string                      `json:"denom"`
+	Issuer             string                      `json:"issuer"`
+	Symbol             string                      `json:"symbol"`
+	Subunit            string                      `json:"subunit"`
+	Precision          uint32                      `json:"precision"`
+	Description        string                      `json:"description"`
+	GloballyFrozen     bool                        `json:"globally_frozen"`
+	Features           []assetfttypes.Feature      `json:"features"`
+	BurnRate           sdk.Dec                     `json:"burn_rate"`
+	SendCommissionRate sdk.Dec                     `json:"send_commission_rate"`
+	Version            uint32                      `json:"version"`
+	Admin              string                      `json:"admin"`
+	URI                string                      `json:"uri"`
+	URIHash            string                      `json:"uri_hash"`
+	Data               string                      `json:"data"`
+	MaxSupply          *sdkmath.Int                `json:"max_supply"`
+	MintAllowance      *assetfttypes.MintAllowance `json:"mint_allowance"`
+	MintableAmount     *sdkmath.Int                `json:"mintable_amount"`
+}
+
+// assetFTTokenResponse is the asset ft Token response with string data.
+type assetFTTokenResponse struct {
+	Token assetFTToken `json:"token"`
+}
+
+// assetFTTokensResponse is the asset ft Tokens response with string data.
+type assetFTTokensResponse struct {
+	Pagination pageResponse   `json:"pagination"`
+	Tokens     []assetFTToken `json:"tokens"`
 }
 
 // assetNFTClass is the asset nft Class with string data.
@@ -44,6 +89,7 @@ type assetNFTClass struct {
 	Data        string                       `json:"data"`
 	Features    []assetnfttypes.ClassFeature `json:"features"`
 	RoyaltyRate sdk.Dec                      `json:"royalty_rate"`
+	DataEditors []assetnfttypes.DataEditor   `json:"data_editors"`
 }
 
 // assetNFTClassResponse is the asset nft Class response with string data.
@@ -73,10 +119,31 @@ type assetNFTQuery struct {
 	Class                     *assetnfttypes.QueryClassRequest                     `json:"Class"`
 	Classes                   *assetnfttypes.QueryClassesRequest                   `json:"Classes"`
 	Frozen                    *assetnfttypes.QueryFrozenRequest                    `json:"Frozen"`
+	ClassFrozen               *assetnfttypes.QueryClassFrozenRequest               `json:"ClassFrozen"`
 	Whitelisted               *assetnfttypes.QueryWhitelistedRequest               `json:"Whitelisted"`
 	WhitelistedAccountsforNFT *assetnfttypes.QueryWhitelistedAccountsForNFTRequest `json:"WhitelistedAccountsforNft"`
 	BurntNFT                  *assetnfttypes.QueryBurntNFTRequest                  `json:"BurntNft"`
 	BurntNFTsInClass          *assetnfttypes.QueryBurntNFTsInClassRequest          `json:"BurntNftsInClass"`
+	NFTsByOwner               *assetnfttypes.QueryNFTsByOwnerRequest               `json:"NftsByOwner"`
+}
+
+// assetNFTRecord is the asset nft record with string data.
+type assetNFTRecord struct {
+	ClassID                  string                       `json:"class_id"`
+	ID                       string                       `json:"id"`
+	URI                      string                       `json:"uri"`
+	URIHash                  string                       `json:"uri_hash"`
+	Data                     string                       `json:"data"`
+	Frozen                   bool                         `json:"frozen"`
+	ClassFrozen              bool                         `json:"class_frozen"`
+	WhitelistedAccountsCount uint64                       `json:"whitelisted_accounts_count"`
+	ClassFeatures            []assetnfttypes.ClassFeature `json:"class_features"`
+}
+
+// assetNFTsByOwnerResponse is the asset nft NFTsByOwner response with string data.
+type assetNFTsByOwnerResponse struct {
+	Pagination pageResponse     `json:"pagination"`
+	NFTs       []assetNFTRecord `json:"nfts"`
 }
 
 // nft is the nft with string data.
@@ -165,6 +232,18 @@ func NewCoreumQueryHandler(
 	}
 }
 
+func convertStringToAssetFTDataBytes(dataString string) (*codectypes.Any, error) {
+	databytes, err := base64.StdEncoding.DecodeString(dataString)
+	if err != nil {
+		return nil, err
+	}
+	dataValue, err := codectypes.NewAnyWithValue(&assetfttypes.DataBytes{Data: databytes})
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return dataValue, nil
+}
+
 func convertStringToDataBytes(dataString string) (*codectypes.Any, error) {
 	databytes, err := base64.StdEncoding.DecodeString(dataString)
 	if err != nil {
@@ -204,13 +283,40 @@ func processAssetFTQuery(ctx sdk.Context, assetFTQuery *assetFTQuery, assetFTQue
 		})
 	}
 	if assetFTQuery.Token != nil {
-		return executeQuery(ctx, assetFTQuery.Token, func(ctx context.Context, req *assetfttypes.QueryTokenRequest) (*assetfttypes.QueryTokenResponse, error) {
-			return assetFTQueryServer.Token(ctx, req)
+		return executeQuery(ctx, assetFTQuery.Token, func(ctx context.Context, req *assetfttypes.QueryTokenRequest) (*assetFTTokenResponse, error) {
+			tokenRes, err := assetFTQueryServer.Token(ctx, req)
+			if err != nil {
+				return nil, err
+			}
+
+			token, err := convertAssetFTToken(tokenRes.Token)
+			if err != nil {
+				return nil, err
+			}
+
+			return &assetFTTokenResponse{Token: token}, nil
 		})
 	}
 	if assetFTQuery.Tokens != nil {
-		return executeQuery(ctx, assetFTQuery.Tokens, func(ctx context.Context, req *assetfttypes.QueryTokensRequest) (*assetfttypes.QueryTokensResponse, error) {
-			return assetFTQueryServer.Tokens(ctx, req)
+		return executeQuery(ctx, assetFTQuery.Tokens, func(ctx context.Context, req *assetfttypes.QueryTokensRequest) (*assetFTTokensResponse, error) {
+			tokensRes, err := assetFTQueryServer.Tokens(ctx, req)
+			if err != nil {
+				return nil, err
+			}
+
+			var tokensResponse assetFTTokensResponse
+			if tokensRes.Pagination != nil {
+				tokensResponse.Pagination.NextKey = tokensRes.Pagination.NextKey
+				tokensResponse.Pagination.Total = tokensRes.Pagination.Total
+			}
+			for i := 0; i < len(tokensRes.Tokens); i++ {
+				token, err := convertAssetFTToken(tokensRes.Tokens[i])
+				if err != nil {
+					return nil, err
+				}
+				tokensResponse.Tokens = append(tokensResponse.Tokens, token)
+			}
+			return &tokensResponse, nil
 		})
 	}
 	if assetFTQuery.Balance != nil {
@@ -238,10 +344,56 @@ func processAssetFTQuery(ctx sdk.Context, assetFTQuery *assetFTQuery, assetFTQue
 			return assetFTQueryServer.WhitelistedBalances(ctx, req)
 		})
 	}
+	if assetFTQuery.RateExemptions != nil {
+		return executeQuery(ctx, assetFTQuery.RateExemptions, func(ctx context.Context, req *assetfttypes.QueryRateExemptionsRequest) (*assetfttypes.QueryRateExemptionsResponse, error) {
+			return assetFTQueryServer.RateExemptions(ctx, req)
+		})
+	}
+	if assetFTQuery.BlockedAccounts != nil {
+		return executeQuery(ctx, assetFTQuery.BlockedAccounts, func(ctx context.Context, req *assetfttypes.QueryBlockedAccountsRequest) (*assetfttypes.QueryBlockedAccountsResponse, error) {
+			return assetFTQueryServer.BlockedAccounts(ctx, req)
+		})
+	}
+	if assetFTQuery.BlockedAccount != nil {
+		return executeQuery(ctx, assetFTQuery.BlockedAccount, func(ctx context.Context, req *assetfttypes.QueryBlockedAccountRequest) (*assetfttypes.QueryBlockedAccountResponse, error) {
+			return assetFTQueryServer.BlockedAccount(ctx, req)
+		})
+	}
+	if assetFTQuery.Distribution != nil {
+		return executeQuery(ctx, assetFTQuery.Distribution, func(ctx context.Context, req *assetfttypes.QueryDistributionRequest) (*assetfttypes.QueryDistributionResponse, error) {
+			return assetFTQueryServer.Distribution(ctx, req)
+		})
+	}
+	if assetFTQuery.DistributionClaimable != nil {
+		return executeQuery(ctx, assetFTQuery.DistributionClaimable, func(ctx context.Context, req *assetfttypes.QueryDistributionClaimableRequest) (*assetfttypes.QueryDistributionClaimableResponse, error) {
+			return assetFTQueryServer.DistributionClaimable(ctx, req)
+		})
+	}
+	if assetFTQuery.Holders != nil {
+		return executeQuery(ctx, assetFTQuery.Holders, func(ctx context.Context, req *assetfttypes.QueryHoldersRequest) (*assetfttypes.QueryHoldersResponse, error) {
+			return assetFTQueryServer.Holders(ctx, req)
+		})
+	}
+	if assetFTQuery.TransferLimits != nil {
+		return executeQuery(ctx, assetFTQuery.TransferLimits, func(ctx context.Context, req *assetfttypes.QueryTransferLimitsRequest) (*assetfttypes.QueryTransferLimitsResponse, error) {
+			return assetFTQueryServer.TransferLimits(ctx, req)
+		})
+	}
+	if assetFTQuery.TransferLimit != nil {
+		return executeQuery(ctx, assetFTQuery.TransferLimit, func(ctx context.Context, req *assetfttypes.QueryTransferLimitRequest) (*assetfttypes.QueryTransferLimitResponse, error) {
+			return assetFTQueryServer.TransferLimit(ctx, req)
+		})
+	}
+	if assetFTQuery.VestingSchedules != nil {
+		return executeQuery(ctx, assetFTQuery.VestingSchedules, func(ctx context.Context, req *assetfttypes.QueryVestingSchedulesRequest) (*assetfttypes.QueryVestingSchedulesResponse, error) {
+			return assetFTQueryServer.VestingSchedules(ctx, req)
+		})
+	}
 
 	return nil, nil
 }
 
+//nolint:funlen
 func processAssetNFTQuery(ctx sdk.Context, assetNFTQuery *assetNFTQuery, assetNFTQueryServer assetnfttypes.QueryServer) ([]byte, error) {
 	if assetNFTQuery.Params != nil {
 		return executeQuery(ctx, assetNFTQuery.Params, func(ctx context.Context, req *assetnfttypes.QueryParamsRequest) (*assetnfttypes.QueryParamsResponse, error) {
@@ -274,6 +426,7 @@ func processAssetNFTQuery(ctx sdk.Context, assetNFTQuery *assetNFTQuery, assetNF
 					Data:        dataString,
 					Features:    classRes.Class.Features,
 					RoyaltyRate: classRes.Class.RoyaltyRate,
+					DataEditors: classRes.Class.DataEditors,
 				},
 			}, nil
 		})
@@ -308,6 +461,7 @@ func processAssetNFTQuery(ctx sdk.Context, assetNFTQuery *assetNFTQuery, assetNF
 					Data:        dataString,
 					Features:    classesRes.Classes[i].Features,
 					RoyaltyRate: classesRes.Classes[i].RoyaltyRate,
+					DataEditors: classesRes.Classes[i].DataEditors,
 				})
 			}
 			return &classesResponse, nil
@@ -319,6 +473,11 @@ func processAssetNFTQuery(ctx sdk.Context, assetNFTQuery *assetNFTQuery, assetNF
 			return assetNFTQueryServer.Frozen(ctx, req)
 		})
 	}
+	if assetNFTQuery.ClassFrozen != nil {
+		return executeQuery(ctx, assetNFTQuery.ClassFrozen, func(ctx context.Context, req *assetnfttypes.QueryClassFrozenRequest) (*assetnfttypes.QueryClassFrozenResponse, error) {
+			return assetNFTQueryServer.ClassFrozen(ctx, req)
+		})
+	}
 	if assetNFTQuery.Whitelisted != nil {
 		return executeQuery(ctx, assetNFTQuery.Whitelisted, func(ctx context.Context, req *assetnfttypes.QueryWhitelistedRequest) (*assetnfttypes.QueryWhitelistedResponse, error) {
 			return assetNFTQueryServer.Whitelisted(ctx, req)
@@ -342,6 +501,42 @@ func processAssetNFTQuery(ctx sdk.Context, assetNFTQuery *assetNFTQuery, assetNF
 		})
 	}
 
+	if assetNFTQuery.NFTsByOwner != nil {
+		return executeQuery(ctx, assetNFTQuery.NFTsByOwner, func(ctx context.Context, req *assetnfttypes.QueryNFTsByOwnerRequest) (*assetNFTsByOwnerResponse, error) {
+			nftsRes, err := assetNFTQueryServer.NFTsByOwner(ctx, req)
+			if err != nil {
+				return nil, err
+			}
+
+			var nftsResponse assetNFTsByOwnerResponse
+			if nftsRes.Pagination != nil {
+				nftsResponse.Pagination.NextKey = nftsRes.Pagination.NextKey
+				nftsResponse.Pagination.Total = nftsRes.Pagination.Total
+			}
+			for _, record := range nftsRes.Nfts {
+				var dataString string
+				if record.Data != nil {
+					dataString, err = unmarshalDataBytes(record.Data)
+					if err != nil {
+						return nil, err
+					}
+				}
+				nftsResponse.NFTs = append(nftsResponse.NFTs, assetNFTRecord{
+					ClassID:                  record.ClassId,
+					ID:                       record.Id,
+					URI:                      record.URI,
+					URIHash:                  record.URIHash,
+					Data:                     dataString,
+					Frozen:                   record.Frozen,
+					ClassFrozen:              record.ClassFrozen,
+					WhitelistedAccountsCount: record.WhitelistedAccountsCount,
+					ClassFeatures:            record.ClassFeatures,
+				})
+			}
+			return &nftsResponse, nil
+		})
+	}
+
 	return nil, nil
 }
 
@@ -515,6 +710,38 @@ func executeQuery[T, K any](
 	return raw, nil
 }
 
+func convertAssetFTToken(token assetfttypes.Token) (assetFTToken, error) {
+	var dataString string
+	if token.Data != nil {
+		var dataBytes assetfttypes.DataBytes
+		if err := proto.Unmarshal(token.Data.Value, &dataBytes); err != nil {
+			return assetFTToken{}, errors.WithStack(err)
+		}
+		dataString = base64.StdEncoding.EncodeToString(dataBytes.Data)
+	}
+
+	return assetFTToken{
+		Denom:              token.Denom,
+		Issuer:             token.Issuer,
+		Symbol:             token.Symbol,
+		Subunit:            token.Subunit,
+		Precision:          token.Precision,
+		Description:        token.Description,
+		GloballyFrozen:     token.GloballyFrozen,
+		Features:           token.Features,
+		BurnRate:           token.BurnRate,
+		SendCommissionRate: token.SendCommissionRate,
+		Version:            token.Version,
+		Admin:              token.Admin,
+		URI:                token.URI,
+		URIHash:            token.URIHash,
+		Data:               dataString,
+		MaxSupply:          token.MaxSupply,
+		MintAllowance:      token.MintAllowance,
+		MintableAmount:     token.MintableAmount,
+	}, nil
+}
+
 func unmarshalDataBytes(data *codectypes.Any) (string, error) {
 	var dataBytes assetnfttypes.DataBytes
 	err := proto.Unmarshal(data.Value, &dataBytes)
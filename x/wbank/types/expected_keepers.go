@@ -9,4 +9,5 @@ import (
 type FungibleTokenProvider interface {
 	BeforeSendCoins(ctx sdk.Context, fromAddress, toAddress sdk.AccAddress, coins sdk.Coins) error
 	BeforeInputOutputCoins(ctx sdk.Context, inputs []banktypes.Input, outputs []banktypes.Output) error
+	BeforeDelegateCoins(ctx sdk.Context, delegatorAddress sdk.AccAddress, coins sdk.Coins) error
 }
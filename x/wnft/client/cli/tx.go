@@ -0,0 +1,78 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/CoreumFoundation/coreum/v3/pkg/config/constant"
+	"github.com/CoreumFoundation/coreum/v3/x/wnft/types"
+)
+
+// CmdTxSendBatch returns SendBatch cobra command.
+func CmdTxSendBatch() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "send-batch [items_file] --from [sender]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Send multiple non-fungible tokens",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Send multiple non-fungible tokens atomically.
+
+The items file is the JSON file containing the list of non-fungible tokens and their receivers.
+
+Example:
+$ %s tx %s send-batch items.json --from [sender]
+
+items.json:
+[
+  {"class_id": "abc-%s", "id": "id1", "receiver": "[receiver_address]"}
+]
+`,
+				version.AppName, types.ModuleName, constant.AddressSampleTest,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			items, err := readSendBatchItems(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgSendBatch{
+				Sender: clientCtx.GetFromAddress().String(),
+				Items:  items,
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func readSendBatchItems(path string) ([]types.SendBatchItem, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read items file %s", path)
+	}
+
+	var items []types.SendBatchItem
+	if err := json.Unmarshal(bz, &items); err != nil {
+		return nil, errors.Wrapf(err, "failed to decode items file %s", path)
+	}
+
+	return items, nil
+}
@@ -12,6 +12,8 @@ import (
 	"github.com/CoreumFoundation/coreum/v3/x/wnft/types"
 )
 
+var _ types.MsgServer = Wrapper{}
+
 // Wrapper wraps the original nft keeper and intercepts its original methods if needed.
 type Wrapper struct {
 	nftkeeper.Keeper
@@ -63,6 +65,22 @@ func (wk Wrapper) Send(goCtx context.Context, msg *nft.MsgSend) (*nft.MsgSendRes
 	return &nft.MsgSendResponse{}, nil
 }
 
+// SendBatch sends multiple non-fungible tokens atomically, applying the same rules as the Send method to each of them.
+func (wk Wrapper) SendBatch(goCtx context.Context, msg *types.MsgSendBatch) (*types.MsgSendBatchResponse, error) {
+	for _, item := range msg.Items {
+		if _, err := wk.Send(goCtx, &nft.MsgSend{
+			ClassId:  item.ClassID,
+			Id:       item.ID,
+			Sender:   msg.Sender,
+			Receiver: item.Receiver,
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.MsgSendBatchResponse{}, nil
+}
+
 // Transfer overwrites the original transfer function to include our custom interceptor.
 func (wk Wrapper) Transfer(ctx sdk.Context, classID, nftID string, receiver sdk.AccAddress) error {
 	return wk.nonFungibleTokenProvider.Transfer(ctx, classID, nftID, receiver)
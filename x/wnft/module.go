@@ -6,8 +6,11 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/x/nft"
 	nftmodule "github.com/cosmos/cosmos-sdk/x/nft/module"
+	"github.com/spf13/cobra"
 
+	"github.com/CoreumFoundation/coreum/v3/x/wnft/client/cli"
 	"github.com/CoreumFoundation/coreum/v3/x/wnft/keeper"
+	"github.com/CoreumFoundation/coreum/v3/x/wnft/types"
 )
 
 // AppModuleBasic implements the basic application module for the wrapped nft module.
@@ -15,6 +18,24 @@ type AppModuleBasic struct {
 	nftmodule.AppModuleBasic
 }
 
+// RegisterLegacyAminoCodec registers the wnft module's types for the given codec.
+func (AppModuleBasic) RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	types.RegisterLegacyAminoCodec(cdc)
+}
+
+// RegisterInterfaces registers the original nft module's interface types and the wnft ones.
+func (a AppModuleBasic) RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	a.AppModuleBasic.RegisterInterfaces(registry)
+	types.RegisterInterfaces(registry)
+}
+
+// GetTxCmd returns the transaction commands of the original nft module extended with the wnft ones.
+func (a AppModuleBasic) GetTxCmd() *cobra.Command {
+	cmd := a.AppModuleBasic.GetTxCmd()
+	cmd.AddCommand(cli.CmdTxSendBatch())
+	return cmd
+}
+
 // AppModule implements an application module for the wnft module.
 type AppModule struct {
 	nftmodule.AppModule
@@ -33,5 +54,6 @@ func NewAppModule(cdc codec.Codec, keeper keeper.Wrapper, ak nft.AccountKeeper,
 // RegisterServices registers module services.
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	nft.RegisterMsgServer(cfg.MsgServer(), am.keeper)
+	types.RegisterMsgServer(cfg.MsgServer(), am.keeper)
 	nft.RegisterQueryServer(cfg.QueryServer(), am.keeper)
 }
@@ -0,0 +1,11 @@
+package types
+
+import "github.com/cosmos/cosmos-sdk/x/nft"
+
+const (
+	// ModuleName defines the module name, which is the name of the wrapped nft module.
+	ModuleName = nft.ModuleName
+
+	// RouterKey is the message route for module.
+	RouterKey = ModuleName
+)
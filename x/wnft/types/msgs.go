@@ -0,0 +1,95 @@
+package types
+
+import (
+	"fmt"
+
+	sdkerrors "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/codec"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+)
+
+// Type of messages for amino.
+const (
+	TypeMsgSendBatch = "send-batch"
+)
+
+var (
+	_ sdk.Msg            = &MsgSendBatch{}
+	_ legacytx.LegacyMsg = &MsgSendBatch{}
+)
+
+// RegisterLegacyAminoCodec registers the amino types and interfaces.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgSendBatch{}, fmt.Sprintf("%s/MsgSendBatch", ModuleName), nil)
+}
+
+// ValidateBasic checks that message fields are valid.
+func (m MsgSendBatch) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(cosmoserrors.ErrInvalidAddress, "invalid sender address (%s)", m.Sender)
+	}
+
+	if len(m.Items) == 0 {
+		return sdkerrors.Wrap(cosmoserrors.ErrInvalidRequest, "items must not be empty")
+	}
+
+	seen := make(map[string]struct{}, len(m.Items))
+	for _, item := range m.Items {
+		sendMsg := nft.MsgSend{
+			ClassId:  item.ClassID,
+			Id:       item.ID,
+			Sender:   m.Sender,
+			Receiver: item.Receiver,
+		}
+		if err := sendMsg.ValidateBasic(); err != nil {
+			return err
+		}
+
+		key := item.ClassID + "/" + item.ID
+		if _, ok := seen[key]; ok {
+			return sdkerrors.Wrapf(
+				cosmoserrors.ErrInvalidRequest, "duplicated item with class id %s and id %s", item.ClassID, item.ID,
+			)
+		}
+		seen[key] = struct{}{}
+	}
+
+	return nil
+}
+
+// GetSigners returns the required signers of this message type.
+func (m MsgSendBatch) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{
+		sdk.MustAccAddressFromBech32(m.Sender),
+	}
+}
+
+// GetSignBytes returns sign bytes for LegacyMsg.
+func (m MsgSendBatch) GetSignBytes() []byte {
+	return sdk.MustSortJSON(moduleAminoCdc.MustMarshalJSON(&m))
+}
+
+// Route returns message route for LegacyMsg.
+func (m MsgSendBatch) Route() string {
+	return RouterKey
+}
+
+// Type returns message type for LegacyMsg.
+func (m MsgSendBatch) Type() string {
+	return TypeMsgSendBatch
+}
+
+var (
+	amino          = codec.NewLegacyAmino()
+	moduleAminoCdc = codec.NewAminoCodec(amino)
+)
+
+func init() {
+	RegisterLegacyAminoCodec(amino)
+	cryptocodec.RegisterCrypto(amino)
+	amino.Seal()
+}
@@ -0,0 +1,119 @@
+package types_test
+
+import (
+	"testing"
+
+	sdkerrors "cosmossdk.io/errors"
+	cosmoserrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/CoreumFoundation/coreum/v3/pkg/config"
+	"github.com/CoreumFoundation/coreum/v3/pkg/config/constant"
+	"github.com/CoreumFoundation/coreum/v3/x/wnft/types"
+)
+
+func TestMain(m *testing.M) {
+	n, err := config.NetworkConfigByChainID(constant.ChainIDDev)
+	if err != nil {
+		panic(err)
+	}
+	n.SetSDKConfig()
+	m.Run()
+}
+
+func TestMsgSendBatch_ValidateBasic(t *testing.T) {
+	validItem := types.SendBatchItem{
+		ClassID:  "symbol-devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+		ID:       "my-id",
+		Receiver: "devcore1phjrez5j2wp5qzp0zvlqavasvw60mkp2zmfe6h",
+	}
+	testCases := []struct {
+		name          string
+		messageFunc   func() types.MsgSendBatch
+		expectedError error
+	}{
+		{
+			name: "valid msg",
+			messageFunc: func() types.MsgSendBatch {
+				secondItem := validItem
+				secondItem.ID = "my-id2"
+				return types.MsgSendBatch{
+					Sender: "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+					Items:  []types.SendBatchItem{validItem, secondItem},
+				}
+			},
+		},
+		{
+			name: "invalid sender",
+			messageFunc: func() types.MsgSendBatch {
+				return types.MsgSendBatch{
+					Sender: "devcore172rx",
+					Items:  []types.SendBatchItem{validItem},
+				}
+			},
+			expectedError: cosmoserrors.ErrInvalidAddress,
+		},
+		{
+			name: "empty items",
+			messageFunc: func() types.MsgSendBatch {
+				return types.MsgSendBatch{
+					Sender: "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+				}
+			},
+			expectedError: cosmoserrors.ErrInvalidRequest,
+		},
+		{
+			name: "invalid item receiver",
+			messageFunc: func() types.MsgSendBatch {
+				item := validItem
+				item.Receiver = "devcore172rx"
+				return types.MsgSendBatch{
+					Sender: "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+					Items:  []types.SendBatchItem{item},
+				}
+			},
+			expectedError: cosmoserrors.ErrInvalidAddress,
+		},
+		{
+			name: "duplicated item",
+			messageFunc: func() types.MsgSendBatch {
+				return types.MsgSendBatch{
+					Sender: "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+					Items:  []types.SendBatchItem{validItem, validItem},
+				}
+			},
+			expectedError: cosmoserrors.ErrInvalidRequest,
+		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+		t.Run(tc.name, func(t *testing.T) {
+			assertT := assert.New(t)
+			err := tc.messageFunc().ValidateBasic()
+			if tc.expectedError == nil {
+				assertT.NoError(err)
+			} else {
+				assertT.True(sdkerrors.IsOf(err, tc.expectedError))
+			}
+		})
+	}
+}
+
+func TestAmino(t *testing.T) {
+	msg := types.MsgSendBatch{
+		Sender: "devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5",
+		Items: []types.SendBatchItem{
+			{ClassID: "classID", ID: "nftID", Receiver: "devcore1phjrez5j2wp5qzp0zvlqavasvw60mkp2zmfe6h"},
+		},
+	}
+
+	var legacyMsg legacytx.LegacyMsg = &msg
+	require.Equal(
+		t,
+		`{"type":"nft/MsgSendBatch","value":{"items":[{"class_id":"classID","id":"nftID","receiver":"devcore1phjrez5j2wp5qzp0zvlqavasvw60mkp2zmfe6h"}],"sender":"devcore172rc5sz2uclpsy3vvx3y79ah5dk450z5ruq2r5"}}`,
+		string(legacyMsg.GetSignBytes()),
+	)
+}